@@ -0,0 +1,137 @@
+// Package clientlib provides reusable helpers for clients of the grades microservice.
+package clientlib
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// RetryAfterKey is the trailer key a server may set to hint how long clients should wait, in seconds.
+// The grades microservice itself never sets it; it is honored for proxies and gateways in front of
+// the service that do.
+const RetryAfterKey = "retry-after"
+
+// readOnlyPrefixes are the method name prefixes of the grades service's read-only RPCs.
+var readOnlyPrefixes = []string{"Get", "Search", "Detect", "Compare", "Simulate", "Preview", "Project"}
+
+const (
+	defaultMaxAttempts    = 4
+	defaultInitialBackoff = 100 * time.Millisecond
+	defaultMaxBackoff     = 2 * time.Second
+	defaultMultiplier     = 2
+)
+
+// RetryOptions configures the retry behavior of UnaryRetryInterceptor.
+type RetryOptions struct {
+	// MaxAttempts is the total number of attempts, including the first call.
+	MaxAttempts int
+	// InitialBackoff is the wait before the first retry.
+	InitialBackoff time.Duration
+	// MaxBackoff caps the exponential backoff.
+	MaxBackoff time.Duration
+	// Multiplier grows the backoff after each retry.
+	Multiplier float64
+	// Idempotent reports whether a full method name is safe to call again. Nil retries only the
+	// methods accepted by ReadOnlyMethod, so writes are never repeated by accident.
+	Idempotent func(method string) bool
+}
+
+// DefaultRetryOptions returns the retry options used by the example clients.
+func DefaultRetryOptions() RetryOptions {
+	return RetryOptions{
+		MaxAttempts:    defaultMaxAttempts,
+		InitialBackoff: defaultInitialBackoff,
+		MaxBackoff:     defaultMaxBackoff,
+		Multiplier:     defaultMultiplier,
+		Idempotent:     ReadOnlyMethod,
+	}
+}
+
+// ReadOnlyMethod reports whether a full gRPC method name names a read-only RPC of the grades service.
+func ReadOnlyMethod(method string) bool {
+	name := method[strings.LastIndex(method, "/")+1:]
+	for _, prefix := range readOnlyPrefixes {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// UnaryRetryInterceptor retries idempotent calls failing with Unavailable or DeadlineExceeded using
+// exponential backoff, up to MaxAttempts. A retry-after trailer overrides the computed backoff. Calls
+// to other methods are made once.
+func UnaryRetryInterceptor(opts RetryOptions) grpc.UnaryClientInterceptor {
+	idempotent := opts.Idempotent
+	if idempotent == nil {
+		idempotent = ReadOnlyMethod
+	}
+
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn,
+		invoker grpc.UnaryInvoker, callOpts ...grpc.CallOption,
+	) error {
+		if !idempotent(method) {
+			return invoker(ctx, method, req, reply, cc, callOpts...)
+		}
+
+		backoff := opts.InitialBackoff
+
+		for attempt := 1; ; attempt++ {
+			var trailer metadata.MD
+
+			err := invoker(ctx, method, req, reply, cc, append(callOpts, grpc.Trailer(&trailer))...)
+			if err == nil || attempt >= opts.MaxAttempts || !isRetryable(err) || ctx.Err() != nil {
+				return err
+			}
+
+			wait := backoff
+			if hint, ok := retryAfter(trailer); ok {
+				wait = hint
+			}
+
+			timer := time.NewTimer(wait)
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+
+				return err
+			case <-timer.C:
+			}
+
+			backoff = min(time.Duration(float64(backoff)*opts.Multiplier), opts.MaxBackoff)
+		}
+	}
+}
+
+// isRetryable reports whether the error is transient.
+func isRetryable(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded:
+		return true
+	default:
+		return false
+	}
+}
+
+// retryAfter extracts the server's retry-after hint from the trailer.
+func retryAfter(trailer metadata.MD) (time.Duration, bool) {
+	values := trailer.Get(RetryAfterKey)
+	if len(values) == 0 {
+		return 0, false
+	}
+
+	seconds, err := strconv.ParseFloat(values[0], 64)
+	if err != nil || seconds < 0 {
+		return 0, false
+	}
+
+	return time.Duration(seconds * float64(time.Second)), true
+}
@@ -0,0 +1,147 @@
+package clientlib
+
+import (
+	"context"
+	"net"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	gpb "github.com/BetterGR/grades-microservice/protos"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// flakyServer fails the first failures calls with the given code before succeeding.
+type flakyServer struct {
+	gpb.UnimplementedGradesServiceServer
+	failures   int32
+	code       codes.Code
+	retryAfter string
+	calls      atomic.Int32
+}
+
+func (f *flakyServer) GetGrade(ctx context.Context, req *gpb.GetGradeRequest) (*gpb.GetGradeResponse, error) {
+	if f.calls.Add(1) <= f.failures {
+		if f.retryAfter != "" {
+			_ = grpc.SetTrailer(ctx, metadata.Pairs(RetryAfterKey, f.retryAfter))
+		}
+
+		return nil, status.Error(f.code, "transient failure")
+	}
+
+	return &gpb.GetGradeResponse{Grade: &gpb.SingleGrade{GradeID: req.GetGradeID()}}, nil
+}
+
+func (f *flakyServer) AddSingleGrade(context.Context, *gpb.AddSingleGradeRequest) (*gpb.AddSingleGradeResponse, error) {
+	if f.calls.Add(1) <= f.failures {
+		return nil, status.Error(f.code, "transient failure")
+	}
+
+	return &gpb.AddSingleGradeResponse{}, nil
+}
+
+func setupFlakyClient(t *testing.T, server *flakyServer, opts RetryOptions) gpb.GradesServiceClient {
+	t.Helper()
+
+	listener, err := net.Listen("tcp", "localhost:0")
+	require.NoError(t, err)
+
+	grpcServer := grpc.NewServer()
+	gpb.RegisterGradesServiceServer(grpcServer, server)
+
+	go func() {
+		_ = grpcServer.Serve(listener)
+	}()
+
+	t.Cleanup(grpcServer.Stop)
+
+	conn, err := grpc.NewClient(listener.Addr().String(),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithUnaryInterceptor(UnaryRetryInterceptor(opts)))
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		conn.Close()
+	})
+
+	return gpb.NewGradesServiceClient(conn)
+}
+
+func testRetryOptions() RetryOptions {
+	return RetryOptions{
+		MaxAttempts:    3,
+		InitialBackoff: time.Millisecond,
+		MaxBackoff:     5 * time.Millisecond,
+		Multiplier:     2,
+	}
+}
+
+func TestRetryInterceptorRecovers(t *testing.T) {
+	server := &flakyServer{failures: 2, code: codes.Unavailable}
+	client := setupFlakyClient(t, server, testRetryOptions())
+
+	resp, err := client.GetGrade(context.Background(), &gpb.GetGradeRequest{GradeID: "grade-1"})
+	require.NoError(t, err)
+	assert.Equal(t, "grade-1", resp.GetGrade().GetGradeID())
+	assert.Equal(t, int32(3), server.calls.Load())
+}
+
+func TestRetryInterceptorGivesUp(t *testing.T) {
+	server := &flakyServer{failures: 5, code: codes.Unavailable}
+	client := setupFlakyClient(t, server, testRetryOptions())
+
+	_, err := client.GetGrade(context.Background(), &gpb.GetGradeRequest{GradeID: "grade-1"})
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+	assert.Equal(t, int32(3), server.calls.Load())
+}
+
+func TestRetryInterceptorSkipsPermanentErrors(t *testing.T) {
+	server := &flakyServer{failures: 1, code: codes.InvalidArgument}
+	client := setupFlakyClient(t, server, testRetryOptions())
+
+	_, err := client.GetGrade(context.Background(), &gpb.GetGradeRequest{GradeID: "grade-1"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.Equal(t, int32(1), server.calls.Load())
+}
+
+func TestRetryInterceptorHonorsRetryAfter(t *testing.T) {
+	server := &flakyServer{failures: 1, code: codes.Unavailable, retryAfter: "0.05"}
+	client := setupFlakyClient(t, server, testRetryOptions())
+
+	start := time.Now()
+	_, err := client.GetGrade(context.Background(), &gpb.GetGradeRequest{GradeID: "grade-1"})
+	require.NoError(t, err)
+	assert.GreaterOrEqual(t, time.Since(start), 50*time.Millisecond)
+}
+
+func TestRetryInterceptorSkipsWrites(t *testing.T) {
+	server := &flakyServer{failures: 1, code: codes.Unavailable}
+	client := setupFlakyClient(t, server, testRetryOptions())
+
+	_, err := client.AddSingleGrade(context.Background(), &gpb.AddSingleGradeRequest{})
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+	assert.Equal(t, int32(1), server.calls.Load())
+}
+
+func TestRetryInterceptorRetriesIdempotentMethods(t *testing.T) {
+	server := &flakyServer{failures: 1, code: codes.Unavailable}
+	opts := testRetryOptions()
+	opts.Idempotent = func(string) bool { return true }
+	client := setupFlakyClient(t, server, opts)
+
+	_, err := client.AddSingleGrade(context.Background(), &gpb.AddSingleGradeRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), server.calls.Load())
+}
+
+func TestReadOnlyMethod(t *testing.T) {
+	assert.True(t, ReadOnlyMethod("/com.bettergr.grades.v1.GradesService/GetGrade"))
+	assert.True(t, ReadOnlyMethod("/com.bettergr.grades.v1.GradesService/SearchGradeComments"))
+	assert.False(t, ReadOnlyMethod("/com.bettergr.grades.v1.GradesService/AddSingleGrade"))
+	assert.False(t, ReadOnlyMethod("/com.bettergr.grades.v1.GradesService/RemoveSingleGrade"))
+}
@@ -0,0 +1,30 @@
+package clientlib
+
+import (
+	"crypto/ed25519"
+	"errors"
+	"fmt"
+)
+
+// SignedExportAlgorithm is the signature algorithm of signed grade exports.
+const SignedExportAlgorithm = "ed25519"
+
+var (
+	ErrSignatureInvalid = errors.New("signature does not match the export")
+	ErrPublicKeyInvalid = errors.New("invalid signing public key")
+)
+
+// VerifySignedExport checks that signature is the institution's signature over the content of a
+// signed grade export, as returned by ExportCourseGradesSigned. The content must be verified as
+// received, byte for byte, before it is parsed; any change to it fails verification.
+func VerifySignedExport(publicKey ed25519.PublicKey, content, signature []byte) error {
+	if len(publicKey) != ed25519.PublicKeySize {
+		return fmt.Errorf("%w: expected %d bytes, got %d", ErrPublicKeyInvalid, ed25519.PublicKeySize, len(publicKey))
+	}
+
+	if !ed25519.Verify(publicKey, content, signature) {
+		return ErrSignatureInvalid
+	}
+
+	return nil
+}
@@ -0,0 +1,26 @@
+package clientlib
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerifySignedExport(t *testing.T) {
+	publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	content := []byte(`{"grades":[]}`)
+	signature := ed25519.Sign(privateKey, content)
+
+	require.NoError(t, VerifySignedExport(publicKey, content, signature))
+	require.ErrorIs(t, VerifySignedExport(publicKey, []byte(`{"grades":[{}]}`), signature), ErrSignatureInvalid)
+	require.ErrorIs(t, VerifySignedExport(publicKey[:8], content, signature), ErrPublicKeyInvalid)
+
+	otherKey, _, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	assert.ErrorIs(t, VerifySignedExport(otherKey, content, signature), ErrSignatureInvalid)
+}
@@ -0,0 +1,238 @@
+// Package gradesclient is a typed client for the grades microservice. It injects the caller's
+// token into every request, retries transient failures and maps gRPC statuses to Go errors.
+package gradesclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/BetterGR/grades-microservice/clientlib"
+	gpb "github.com/BetterGR/grades-microservice/protos"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Errors returned by Client methods. They wrap the server's message and can be matched with errors.Is.
+var (
+	ErrNotFound         = errors.New("not found")
+	ErrInvalidArgument  = errors.New("invalid argument")
+	ErrUnauthenticated  = errors.New("unauthenticated")
+	ErrPermissionDenied = errors.New("permission denied")
+	ErrConflict         = errors.New("conflict")
+	ErrUnavailable      = errors.New("service unavailable")
+	ErrServer           = errors.New("server error")
+)
+
+// TokenSource supplies the authentication token sent with every request.
+type TokenSource interface {
+	Token(ctx context.Context) (string, error)
+}
+
+// StaticToken is a TokenSource that always returns the same token.
+type StaticToken string
+
+// Token returns the static token.
+func (t StaticToken) Token(context.Context) (string, error) {
+	return string(t), nil
+}
+
+// Client calls the grades microservice.
+type Client struct {
+	grades gpb.GradesServiceClient
+	tokens TokenSource
+	conn   *grpc.ClientConn
+}
+
+// New creates a client on an existing connection. The connection stays owned by the caller.
+func New(conn grpc.ClientConnInterface, tokens TokenSource) *Client {
+	return &Client{grades: gpb.NewGradesServiceClient(conn), tokens: tokens}
+}
+
+// Dial connects to the grades microservice at target, retrying transient failures of read-only calls
+// with the default retry options. Writes are sent once. Transport credentials must be given in opts.
+func Dial(target string, tokens TokenSource, opts ...grpc.DialOption) (*Client, error) {
+	opts = append(opts, grpc.WithChainUnaryInterceptor(
+		clientlib.UnaryRetryInterceptor(clientlib.DefaultRetryOptions())))
+
+	conn, err := grpc.NewClient(target, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to grades service: %w", err)
+	}
+
+	client := New(conn, tokens)
+	client.conn = conn
+
+	return client, nil
+}
+
+// Close closes the connection opened by Dial. It does nothing for clients created with New.
+func (c *Client) Close() error {
+	if c.conn == nil {
+		return nil
+	}
+
+	if err := c.conn.Close(); err != nil {
+		return fmt.Errorf("failed to close connection: %w", err)
+	}
+
+	return nil
+}
+
+// token fetches the token for a request.
+func (c *Client) token(ctx context.Context) (string, error) {
+	token, err := c.tokens.Token(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to get token: %w", err)
+	}
+
+	return token, nil
+}
+
+// mapError converts a gRPC status error into one of the package errors.
+func mapError(err error) error {
+	st, ok := status.FromError(err)
+	if !ok {
+		return err
+	}
+
+	var kind error
+
+	switch st.Code() {
+	case codes.OK:
+		return nil
+	case codes.NotFound:
+		kind = ErrNotFound
+	case codes.InvalidArgument, codes.OutOfRange:
+		kind = ErrInvalidArgument
+	case codes.Unauthenticated:
+		kind = ErrUnauthenticated
+	case codes.PermissionDenied:
+		kind = ErrPermissionDenied
+	case codes.Aborted, codes.AlreadyExists, codes.FailedPrecondition:
+		kind = ErrConflict
+	case codes.Unavailable, codes.DeadlineExceeded, codes.ResourceExhausted:
+		kind = ErrUnavailable
+	default:
+		kind = ErrServer
+	}
+
+	return fmt.Errorf("%w: %s", kind, st.Message())
+}
+
+// AddGrade adds a grade.
+func (c *Client) AddGrade(ctx context.Context, grade *gpb.SingleGrade) (*gpb.SingleGrade, error) {
+	token, err := c.token(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.grades.AddSingleGrade(ctx, &gpb.AddSingleGradeRequest{Token: token, Grade: grade})
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	return resp.GetGrade(), nil
+}
+
+// UpdateGrade updates the non-empty fields of a grade and returns its new state.
+func (c *Client) UpdateGrade(ctx context.Context, grade *gpb.SingleGrade) (*gpb.SingleGrade, error) {
+	token, err := c.token(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.grades.UpdateSingleGrade(ctx, &gpb.UpdateSingleGradeRequest{Token: token, Grade: grade})
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	return resp.GetGrade(), nil
+}
+
+// RemoveGrade removes a grade.
+func (c *Client) RemoveGrade(ctx context.Context, gradeID string) error {
+	token, err := c.token(ctx)
+	if err != nil {
+		return err
+	}
+
+	if _, err := c.grades.RemoveSingleGrade(ctx, &gpb.RemoveSingleGradeRequest{
+		Token: token, GradeID: gradeID,
+	}); err != nil {
+		return mapError(err)
+	}
+
+	return nil
+}
+
+// GetGrade retrieves the latest state of a grade.
+func (c *Client) GetGrade(ctx context.Context, gradeID string) (*gpb.SingleGrade, error) {
+	token, err := c.token(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.grades.GetGrade(ctx, &gpb.GetGradeRequest{Token: token, GradeID: gradeID})
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	return resp.GetGrade(), nil
+}
+
+// GetCourseGrades retrieves the grades of a course during a semester.
+func (c *Client) GetCourseGrades(ctx context.Context, courseID, semester string) ([]*gpb.SingleGrade, error) {
+	token, err := c.token(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.grades.GetCourseGrades(ctx, &gpb.GetCourseGradesRequest{
+		Token: token, CourseID: courseID, Semester: semester,
+	})
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	return resp.GetGrades(), nil
+}
+
+// GetStudentCourseGrades retrieves the grades of a student in a course during a semester.
+func (c *Client) GetStudentCourseGrades(ctx context.Context,
+	courseID, semester, studentID string,
+) ([]*gpb.SingleGrade, error) {
+	token, err := c.token(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.grades.GetStudentCourseGrades(ctx, &gpb.GetStudentCourseGradesRequest{
+		Token: token, CourseID: courseID, Semester: semester, StudentID: studentID,
+	})
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	return resp.GetGrades(), nil
+}
+
+// GetStudentSemesterGrades retrieves the grades of a student across all courses during a semester.
+func (c *Client) GetStudentSemesterGrades(ctx context.Context,
+	studentID, semester string,
+) ([]*gpb.SingleGrade, error) {
+	token, err := c.token(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.grades.GetStudentSemesterGrades(ctx, &gpb.GetStudentSemesterGradesRequest{
+		Token: token, StudentID: studentID, Semester: semester,
+	})
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	return resp.GetGrades(), nil
+}
@@ -0,0 +1,191 @@
+package gradesclient
+
+import (
+	"context"
+	"errors"
+	"net"
+	"strconv"
+	"sync"
+	"testing"
+
+	gpb "github.com/BetterGR/grades-microservice/protos"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+)
+
+// fakeServer records the tokens it receives and fails with err when set.
+type fakeServer struct {
+	gpb.UnimplementedGradesServiceServer
+	mutex  sync.Mutex
+	tokens []string
+	err    error
+}
+
+func (f *fakeServer) record(token string) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	f.tokens = append(f.tokens, token)
+
+	return f.err
+}
+
+func (f *fakeServer) GetGrade(_ context.Context, req *gpb.GetGradeRequest) (*gpb.GetGradeResponse, error) {
+	if err := f.record(req.GetToken()); err != nil {
+		return nil, err
+	}
+
+	return &gpb.GetGradeResponse{Grade: &gpb.SingleGrade{GradeID: req.GetGradeID()}}, nil
+}
+
+func (f *fakeServer) AddSingleGrade(_ context.Context,
+	req *gpb.AddSingleGradeRequest,
+) (*gpb.AddSingleGradeResponse, error) {
+	if err := f.record(req.GetToken()); err != nil {
+		return nil, err
+	}
+
+	return &gpb.AddSingleGradeResponse{Grade: req.GetGrade()}, nil
+}
+
+func (f *fakeServer) GetCourseGrades(_ context.Context,
+	req *gpb.GetCourseGradesRequest,
+) (*gpb.GetCourseGradesResponse, error) {
+	if err := f.record(req.GetToken()); err != nil {
+		return nil, err
+	}
+
+	return &gpb.GetCourseGradesResponse{Grades: []*gpb.SingleGrade{{CourseID: req.GetCourseID()}}}, nil
+}
+
+// rotatingTokens returns a new token on every call.
+type rotatingTokens struct {
+	mutex sync.Mutex
+	next  int
+}
+
+func (r *rotatingTokens) Token(context.Context) (string, error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.next++
+
+	return "token-" + strconv.Itoa(r.next), nil
+}
+
+var errNoToken = errors.New("no token")
+
+// failingTokens never returns a token.
+type failingTokens struct{}
+
+func (failingTokens) Token(context.Context) (string, error) {
+	return "", errNoToken
+}
+
+func setupClient(t *testing.T, server *fakeServer, tokens TokenSource) *Client {
+	t.Helper()
+
+	listener, err := net.Listen("tcp", "localhost:0")
+	require.NoError(t, err)
+
+	grpcServer := grpc.NewServer()
+	gpb.RegisterGradesServiceServer(grpcServer, server)
+
+	go func() {
+		_ = grpcServer.Serve(listener)
+	}()
+
+	t.Cleanup(grpcServer.Stop)
+
+	client, err := Dial(listener.Addr().String(), tokens, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = client.Close()
+	})
+
+	return client
+}
+
+func TestClientInjectsToken(t *testing.T) {
+	server := &fakeServer{}
+	client := setupClient(t, server, StaticToken("static-token"))
+
+	grade, err := client.GetGrade(context.Background(), "g1")
+	require.NoError(t, err)
+	assert.Equal(t, "g1", grade.GetGradeID())
+
+	added, err := client.AddGrade(context.Background(), &gpb.SingleGrade{GradeID: "g2"})
+	require.NoError(t, err)
+	assert.Equal(t, "g2", added.GetGradeID())
+
+	grades, err := client.GetCourseGrades(context.Background(), "c1", "S24")
+	require.NoError(t, err)
+	require.Len(t, grades, 1)
+	assert.Equal(t, "c1", grades[0].GetCourseID())
+
+	assert.Equal(t, []string{"static-token", "static-token", "static-token"}, server.tokens)
+}
+
+func TestClientRetriesOnlyReads(t *testing.T) {
+	server := &fakeServer{err: status.Error(codes.Unavailable, "down")}
+	client := setupClient(t, server, StaticToken("static-token"))
+
+	_, err := client.AddGrade(context.Background(), &gpb.SingleGrade{GradeID: "g1"})
+	require.ErrorIs(t, err, ErrUnavailable)
+	assert.Len(t, server.tokens, 1)
+
+	_, err = client.GetGrade(context.Background(), "g1")
+	require.ErrorIs(t, err, ErrUnavailable)
+	assert.Len(t, server.tokens, 5)
+}
+
+func TestClientFetchesTokenPerCall(t *testing.T) {
+	server := &fakeServer{}
+	client := setupClient(t, server, &rotatingTokens{})
+
+	for range 2 {
+		_, err := client.GetGrade(context.Background(), "g1")
+		require.NoError(t, err)
+	}
+
+	assert.Equal(t, []string{"token-1", "token-2"}, server.tokens)
+}
+
+func TestClientTokenSourceError(t *testing.T) {
+	server := &fakeServer{}
+	client := setupClient(t, server, failingTokens{})
+
+	_, err := client.GetGrade(context.Background(), "g1")
+	require.ErrorIs(t, err, errNoToken)
+	assert.Empty(t, server.tokens, "no request is sent without a token")
+}
+
+func TestClientMapsStatusErrors(t *testing.T) {
+	tests := []struct {
+		code     codes.Code
+		expected error
+	}{
+		{codes.NotFound, ErrNotFound},
+		{codes.InvalidArgument, ErrInvalidArgument},
+		{codes.Unauthenticated, ErrUnauthenticated},
+		{codes.PermissionDenied, ErrPermissionDenied},
+		{codes.Aborted, ErrConflict},
+		{codes.FailedPrecondition, ErrConflict},
+		{codes.Internal, ErrServer},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.code.String(), func(t *testing.T) {
+			server := &fakeServer{err: status.Error(tt.code, "details")}
+			client := setupClient(t, server, StaticToken("t"))
+
+			_, err := client.GetGrade(context.Background(), "g1")
+			require.ErrorIs(t, err, tt.expected)
+			assert.Contains(t, err.Error(), "details")
+		})
+	}
+}
@@ -14,15 +14,18 @@
 
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.36.0
+// 	protoc-gen-go v1.36.1
 // 	protoc        v5.28.3
 // source: grades-microservice.proto
 
+// Use a versioned, domain-specific package name.
+
 package protos
 
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -34,18 +37,269 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
-// AddSingleGradeRequest is a request message to add a single grade for a student in a course.
+// Represents a single grade entry.
+type SingleGrade struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The academic semester.
+	Semester string `protobuf:"bytes,1,opt,name=semester,proto3" json:"semester,omitempty"`
+	// Unique identifier for the grade entry.
+	GradeID string `protobuf:"bytes,2,opt,name=gradeID,proto3" json:"gradeID,omitempty"`
+	// Identifier for the student.
+	StudentID string `protobuf:"bytes,3,opt,name=studentID,proto3" json:"studentID,omitempty"`
+	// Identifier for the course.
+	CourseID string `protobuf:"bytes,4,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	// Type of the grade (e.g., "Homework", "Exam", "Quiz"). Consider using an enum if values are fixed.
+	GradeType string `protobuf:"bytes,5,opt,name=grade_type,json=gradeType,proto3" json:"grade_type,omitempty"`
+	// Identifier for the specific graded item (e.g., assignment ID).
+	ItemID string `protobuf:"bytes,6,opt,name=itemID,proto3" json:"itemID,omitempty"`
+	// The value or score of the grade.
+	GradeValue string `protobuf:"bytes,7,opt,name=grade_value,json=gradeValue,proto3" json:"grade_value,omitempty"`
+	// Identifier of the user who assigned the grade.
+	GradedBy string `protobuf:"bytes,8,opt,name=graded_by,json=gradedBy,proto3" json:"graded_by,omitempty"`
+	// Optional comments related to the grade.
+	Comments string `protobuf:"bytes,9,opt,name=comments,proto3" json:"comments,omitempty"`
+	// Version of the grade, incremented on every update.
+	Version int64 `protobuf:"varint,10,opt,name=version,proto3" json:"version,omitempty"`
+	// Whether the grade is marked for review.
+	Flagged bool `protobuf:"varint,11,opt,name=flagged,proto3" json:"flagged,omitempty"`
+	// Optional per-criterion breakdown when the grade follows a rubric.
+	RubricScores []*RubricScore `protobuf:"bytes,12,rep,name=rubric_scores,json=rubricScores,proto3" json:"rubric_scores,omitempty"`
+	// Institution the grade belongs to. Set by the server from the caller's institution.
+	InstitutionID string `protobuf:"bytes,13,opt,name=institutionID,proto3" json:"institutionID,omitempty"`
+	// When the graded item was submitted, in RFC 3339 format; empty when unknown.
+	SubmittedAt string `protobuf:"bytes,14,opt,name=submitted_at,json=submittedAt,proto3" json:"submitted_at,omitempty"`
+	// When the graded item was due, in RFC 3339 format; empty when unknown.
+	DueAt string `protobuf:"bytes,15,opt,name=due_at,json=dueAt,proto3" json:"due_at,omitempty"`
+	// The grade value after late penalties. Set by the server in GetStudentCourseGrades responses;
+	// grade_value stays the raw value.
+	EffectiveValue string `protobuf:"bytes,16,opt,name=effective_value,json=effectiveValue,proto3" json:"effective_value,omitempty"`
+	// When the grade was assigned.
+	GradedAt *timestamppb.Timestamp `protobuf:"bytes,17,opt,name=graded_at,json=gradedAt,proto3" json:"graded_at,omitempty"`
+	// When the grade was last updated.
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,18,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// True when the grade is hidden from the caller until its course's release time; its value and
+	// comments are then left empty.
+	PendingRelease bool `protobuf:"varint,19,opt,name=pending_release,json=pendingRelease,proto3" json:"pending_release,omitempty"`
+	// When a grade pending release becomes visible, in RFC 3339 format.
+	ReleaseAt string `protobuf:"bytes,20,opt,name=release_at,json=releaseAt,proto3" json:"release_at,omitempty"`
+	// The grade value in the representation of the locale the caller asked for, or grade_value
+	// when the locale has no labels. Empty when no locale was requested.
+	DisplayValue string `protobuf:"bytes,21,opt,name=display_value,json=displayValue,proto3" json:"display_value,omitempty"`
+	// The student's appeal of the grade: "under_appeal" while it awaits resolution, then "accepted"
+	// or "rejected". Empty when the grade was never appealed. Set by the server.
+	AppealStatus string `protobuf:"bytes,22,opt,name=appeal_status,json=appealStatus,proto3" json:"appeal_status,omitempty"`
+	// Identifier for the section of the course the student attends; empty when the course is not
+	// split into sections.
+	SectionID     string `protobuf:"bytes,23,opt,name=sectionID,proto3" json:"sectionID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SingleGrade) Reset() {
+	*x = SingleGrade{}
+	mi := &file_grades_microservice_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SingleGrade) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SingleGrade) ProtoMessage() {}
+
+func (x *SingleGrade) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_microservice_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SingleGrade.ProtoReflect.Descriptor instead.
+func (*SingleGrade) Descriptor() ([]byte, []int) {
+	return file_grades_microservice_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SingleGrade) GetSemester() string {
+	if x != nil {
+		return x.Semester
+	}
+	return ""
+}
+
+func (x *SingleGrade) GetGradeID() string {
+	if x != nil {
+		return x.GradeID
+	}
+	return ""
+}
+
+func (x *SingleGrade) GetStudentID() string {
+	if x != nil {
+		return x.StudentID
+	}
+	return ""
+}
+
+func (x *SingleGrade) GetCourseID() string {
+	if x != nil {
+		return x.CourseID
+	}
+	return ""
+}
+
+func (x *SingleGrade) GetGradeType() string {
+	if x != nil {
+		return x.GradeType
+	}
+	return ""
+}
+
+func (x *SingleGrade) GetItemID() string {
+	if x != nil {
+		return x.ItemID
+	}
+	return ""
+}
+
+func (x *SingleGrade) GetGradeValue() string {
+	if x != nil {
+		return x.GradeValue
+	}
+	return ""
+}
+
+func (x *SingleGrade) GetGradedBy() string {
+	if x != nil {
+		return x.GradedBy
+	}
+	return ""
+}
+
+func (x *SingleGrade) GetComments() string {
+	if x != nil {
+		return x.Comments
+	}
+	return ""
+}
+
+func (x *SingleGrade) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *SingleGrade) GetFlagged() bool {
+	if x != nil {
+		return x.Flagged
+	}
+	return false
+}
+
+func (x *SingleGrade) GetRubricScores() []*RubricScore {
+	if x != nil {
+		return x.RubricScores
+	}
+	return nil
+}
+
+func (x *SingleGrade) GetInstitutionID() string {
+	if x != nil {
+		return x.InstitutionID
+	}
+	return ""
+}
+
+func (x *SingleGrade) GetSubmittedAt() string {
+	if x != nil {
+		return x.SubmittedAt
+	}
+	return ""
+}
+
+func (x *SingleGrade) GetDueAt() string {
+	if x != nil {
+		return x.DueAt
+	}
+	return ""
+}
+
+func (x *SingleGrade) GetEffectiveValue() string {
+	if x != nil {
+		return x.EffectiveValue
+	}
+	return ""
+}
+
+func (x *SingleGrade) GetGradedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.GradedAt
+	}
+	return nil
+}
+
+func (x *SingleGrade) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *SingleGrade) GetPendingRelease() bool {
+	if x != nil {
+		return x.PendingRelease
+	}
+	return false
+}
+
+func (x *SingleGrade) GetReleaseAt() string {
+	if x != nil {
+		return x.ReleaseAt
+	}
+	return ""
+}
+
+func (x *SingleGrade) GetDisplayValue() string {
+	if x != nil {
+		return x.DisplayValue
+	}
+	return ""
+}
+
+func (x *SingleGrade) GetAppealStatus() string {
+	if x != nil {
+		return x.AppealStatus
+	}
+	return ""
+}
+
+func (x *SingleGrade) GetSectionID() string {
+	if x != nil {
+		return x.SectionID
+	}
+	return ""
+}
+
+// Request message for adding a single grade.
 type AddSingleGradeRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
-	Grade         *SingleGrade           `protobuf:"bytes,2,opt,name=grade,proto3" json:"grade,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Authentication token for authorization.
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// The grade details to add.
+	Grade         *SingleGrade `protobuf:"bytes,2,opt,name=grade,proto3" json:"grade,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *AddSingleGradeRequest) Reset() {
 	*x = AddSingleGradeRequest{}
-	mi := &file_grades_microservice_proto_msgTypes[0]
+	mi := &file_grades_microservice_proto_msgTypes[1]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -57,7 +311,7 @@ func (x *AddSingleGradeRequest) String() string {
 func (*AddSingleGradeRequest) ProtoMessage() {}
 
 func (x *AddSingleGradeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_grades_microservice_proto_msgTypes[0]
+	mi := &file_grades_microservice_proto_msgTypes[1]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -70,7 +324,7 @@ func (x *AddSingleGradeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddSingleGradeRequest.ProtoReflect.Descriptor instead.
 func (*AddSingleGradeRequest) Descriptor() ([]byte, []int) {
-	return file_grades_microservice_proto_rawDescGZIP(), []int{0}
+	return file_grades_microservice_proto_rawDescGZIP(), []int{1}
 }
 
 func (x *AddSingleGradeRequest) GetToken() string {
@@ -87,17 +341,18 @@ func (x *AddSingleGradeRequest) GetGrade() *SingleGrade {
 	return nil
 }
 
-// AddSingleGradeResponse is a response message to add a single grade for a specific student in a specific course for a specific semester.
+// Response message after adding a single grade.
 type AddSingleGradeResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Grade         *SingleGrade           `protobuf:"bytes,1,opt,name=grade,proto3" json:"grade,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The newly added grade details.
+	Grade         *SingleGrade `protobuf:"bytes,1,opt,name=grade,proto3" json:"grade,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *AddSingleGradeResponse) Reset() {
 	*x = AddSingleGradeResponse{}
-	mi := &file_grades_microservice_proto_msgTypes[1]
+	mi := &file_grades_microservice_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -109,7 +364,7 @@ func (x *AddSingleGradeResponse) String() string {
 func (*AddSingleGradeResponse) ProtoMessage() {}
 
 func (x *AddSingleGradeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_grades_microservice_proto_msgTypes[1]
+	mi := &file_grades_microservice_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -122,7 +377,7 @@ func (x *AddSingleGradeResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddSingleGradeResponse.ProtoReflect.Descriptor instead.
 func (*AddSingleGradeResponse) Descriptor() ([]byte, []int) {
-	return file_grades_microservice_proto_rawDescGZIP(), []int{1}
+	return file_grades_microservice_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *AddSingleGradeResponse) GetGrade() *SingleGrade {
@@ -132,20 +387,36 @@ func (x *AddSingleGradeResponse) GetGrade() *SingleGrade {
 	return nil
 }
 
-// GetStundetCourseGradesRequest is a request message to get all grades for a student in a specific course for a specific semester.
+// Request message for retrieving grades of a specific student in a specific course.
 type GetStudentCourseGradesRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
-	CourseID      string                 `protobuf:"bytes,2,opt,name=courseID,proto3" json:"courseID,omitempty"`
-	Semester      string                 `protobuf:"bytes,3,opt,name=semester,proto3" json:"semester,omitempty"`
-	StudentID     string                 `protobuf:"bytes,4,opt,name=studentID,proto3" json:"studentID,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Authentication token for authorization.
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// Identifier for the course.
+	CourseID string `protobuf:"bytes,2,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	// The academic semester.
+	Semester string `protobuf:"bytes,3,opt,name=semester,proto3" json:"semester,omitempty"`
+	// Identifier for the student.
+	StudentID string `protobuf:"bytes,4,opt,name=studentID,proto3" json:"studentID,omitempty"`
+	// The maximum number of grades to return.
+	PageSize int32 `protobuf:"varint,5,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// A token identifying a page of results the server should return.
+	PageToken string `protobuf:"bytes,6,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Locale, such as "de" or "en-US", whose labels fill display_value of the returned grades.
+	Locale string `protobuf:"bytes,7,opt,name=locale,proto3" json:"locale,omitempty"`
+	// Return every attempt at an item instead of aggregating them by the course's attempt policy.
+	RawAttempts bool `protobuf:"varint,8,opt,name=raw_attempts,json=rawAttempts,proto3" json:"raw_attempts,omitempty"`
+	// Read consistency, "eventual" or "strong". Eventual reads may be served by a read replica that
+	// lags behind the latest writes; strong reads always go to the primary database, at a latency
+	// cost for distant clients. Defaults to the server's READ_CONSISTENCY, itself eventual unless set.
+	Consistency   string `protobuf:"bytes,9,opt,name=consistency,proto3" json:"consistency,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetStudentCourseGradesRequest) Reset() {
 	*x = GetStudentCourseGradesRequest{}
-	mi := &file_grades_microservice_proto_msgTypes[2]
+	mi := &file_grades_microservice_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -157,7 +428,7 @@ func (x *GetStudentCourseGradesRequest) String() string {
 func (*GetStudentCourseGradesRequest) ProtoMessage() {}
 
 func (x *GetStudentCourseGradesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_grades_microservice_proto_msgTypes[2]
+	mi := &file_grades_microservice_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -170,7 +441,7 @@ func (x *GetStudentCourseGradesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetStudentCourseGradesRequest.ProtoReflect.Descriptor instead.
 func (*GetStudentCourseGradesRequest) Descriptor() ([]byte, []int) {
-	return file_grades_microservice_proto_rawDescGZIP(), []int{2}
+	return file_grades_microservice_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *GetStudentCourseGradesRequest) GetToken() string {
@@ -201,17 +472,65 @@ func (x *GetStudentCourseGradesRequest) GetStudentID() string {
 	return ""
 }
 
-// GetStudentCourseGradesResponse is a response message to get all grades for a student in a specific course for a specific semester.
+func (x *GetStudentCourseGradesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetStudentCourseGradesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *GetStudentCourseGradesRequest) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+func (x *GetStudentCourseGradesRequest) GetRawAttempts() bool {
+	if x != nil {
+		return x.RawAttempts
+	}
+	return false
+}
+
+func (x *GetStudentCourseGradesRequest) GetConsistency() string {
+	if x != nil {
+		return x.Consistency
+	}
+	return ""
+}
+
+// Response message containing grades for a specific student in a specific course.
 type GetStudentCourseGradesResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Grades        []*SingleGrade         `protobuf:"bytes,1,rep,name=grades,proto3" json:"grades,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// List of grades matching the request criteria.
+	Grades []*SingleGrade `protobuf:"bytes,1,rep,name=grades,proto3" json:"grades,omitempty"`
+	// Token to retrieve the next page of results, or empty if there are no more results.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	// True when the database was unreachable and the grades were served from cache.
+	Stale bool `protobuf:"varint,3,opt,name=stale,proto3" json:"stale,omitempty"`
+	// Whether numeric grades are "improving", "declining" or "stable" over time,
+	// or "insufficient data" when fewer than two grades are numeric.
+	Trend string `protobuf:"bytes,4,opt,name=trend,proto3" json:"trend,omitempty"`
+	// True when the result exceeded the configured row limit and was cut short.
+	Truncated bool `protobuf:"varint,5,opt,name=truncated,proto3" json:"truncated,omitempty"`
+	// The attempt policy that aggregated the grades to one per item, or empty when every attempt
+	// is returned.
+	AttemptPolicy string `protobuf:"bytes,6,opt,name=attempt_policy,json=attemptPolicy,proto3" json:"attempt_policy,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetStudentCourseGradesResponse) Reset() {
 	*x = GetStudentCourseGradesResponse{}
-	mi := &file_grades_microservice_proto_msgTypes[3]
+	mi := &file_grades_microservice_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -223,7 +542,7 @@ func (x *GetStudentCourseGradesResponse) String() string {
 func (*GetStudentCourseGradesResponse) ProtoMessage() {}
 
 func (x *GetStudentCourseGradesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_grades_microservice_proto_msgTypes[3]
+	mi := &file_grades_microservice_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -236,7 +555,7 @@ func (x *GetStudentCourseGradesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetStudentCourseGradesResponse.ProtoReflect.Descriptor instead.
 func (*GetStudentCourseGradesResponse) Descriptor() ([]byte, []int) {
-	return file_grades_microservice_proto_rawDescGZIP(), []int{3}
+	return file_grades_microservice_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *GetStudentCourseGradesResponse) GetGrades() []*SingleGrade {
@@ -246,18 +565,55 @@ func (x *GetStudentCourseGradesResponse) GetGrades() []*SingleGrade {
 	return nil
 }
 
-// UpdateSingleGradeRequest is a request message to update a single grade for a specific student in a specific course for a specific semester.
+func (x *GetStudentCourseGradesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *GetStudentCourseGradesResponse) GetStale() bool {
+	if x != nil {
+		return x.Stale
+	}
+	return false
+}
+
+func (x *GetStudentCourseGradesResponse) GetTrend() string {
+	if x != nil {
+		return x.Trend
+	}
+	return ""
+}
+
+func (x *GetStudentCourseGradesResponse) GetTruncated() bool {
+	if x != nil {
+		return x.Truncated
+	}
+	return false
+}
+
+func (x *GetStudentCourseGradesResponse) GetAttemptPolicy() string {
+	if x != nil {
+		return x.AttemptPolicy
+	}
+	return ""
+}
+
+// Request message for updating a single grade.
 type UpdateSingleGradeRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
-	Grade         *SingleGrade           `protobuf:"bytes,2,opt,name=grade,proto3" json:"grade,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Authentication token for authorization.
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// The updated grade details. `grade_id` must match an existing grade.
+	Grade         *SingleGrade `protobuf:"bytes,2,opt,name=grade,proto3" json:"grade,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *UpdateSingleGradeRequest) Reset() {
 	*x = UpdateSingleGradeRequest{}
-	mi := &file_grades_microservice_proto_msgTypes[4]
+	mi := &file_grades_microservice_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -269,7 +625,7 @@ func (x *UpdateSingleGradeRequest) String() string {
 func (*UpdateSingleGradeRequest) ProtoMessage() {}
 
 func (x *UpdateSingleGradeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_grades_microservice_proto_msgTypes[4]
+	mi := &file_grades_microservice_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -282,7 +638,7 @@ func (x *UpdateSingleGradeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateSingleGradeRequest.ProtoReflect.Descriptor instead.
 func (*UpdateSingleGradeRequest) Descriptor() ([]byte, []int) {
-	return file_grades_microservice_proto_rawDescGZIP(), []int{4}
+	return file_grades_microservice_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *UpdateSingleGradeRequest) GetToken() string {
@@ -299,17 +655,18 @@ func (x *UpdateSingleGradeRequest) GetGrade() *SingleGrade {
 	return nil
 }
 
-// UpdateSingleGradeResponse is a response message to update a single grade for a specific student in a specific course for a specific semester.
+// Response message after updating a single grade.
 type UpdateSingleGradeResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Grade         *SingleGrade           `protobuf:"bytes,1,opt,name=grade,proto3" json:"grade,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The updated grade details.
+	Grade         *SingleGrade `protobuf:"bytes,1,opt,name=grade,proto3" json:"grade,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *UpdateSingleGradeResponse) Reset() {
 	*x = UpdateSingleGradeResponse{}
-	mi := &file_grades_microservice_proto_msgTypes[5]
+	mi := &file_grades_microservice_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -321,7 +678,7 @@ func (x *UpdateSingleGradeResponse) String() string {
 func (*UpdateSingleGradeResponse) ProtoMessage() {}
 
 func (x *UpdateSingleGradeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_grades_microservice_proto_msgTypes[5]
+	mi := &file_grades_microservice_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -334,7 +691,7 @@ func (x *UpdateSingleGradeResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateSingleGradeResponse.ProtoReflect.Descriptor instead.
 func (*UpdateSingleGradeResponse) Descriptor() ([]byte, []int) {
-	return file_grades_microservice_proto_rawDescGZIP(), []int{5}
+	return file_grades_microservice_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *UpdateSingleGradeResponse) GetGrade() *SingleGrade {
@@ -344,23 +701,20 @@ func (x *UpdateSingleGradeResponse) GetGrade() *SingleGrade {
 	return nil
 }
 
-// RemoveSingleGradeRequest is a request message to remove a single grade for a specific student in a specific course for a specific semester.
+// Request message for removing a single grade.
 type RemoveSingleGradeRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
-	StudentID     string                 `protobuf:"bytes,2,opt,name=studentID,proto3" json:"studentID,omitempty"`
-	CourseID      string                 `protobuf:"bytes,3,opt,name=courseID,proto3" json:"courseID,omitempty"`
-	Semester      string                 `protobuf:"bytes,4,opt,name=semester,proto3" json:"semester,omitempty"`
-	GradeType     string                 `protobuf:"bytes,5,opt,name=gradeType,proto3" json:"gradeType,omitempty"`
-	ItemID        string                 `protobuf:"bytes,6,opt,name=itemID,proto3" json:"itemID,omitempty"`
-	GradeID       string                 `protobuf:"bytes,7,opt,name=gradeID,proto3" json:"gradeID,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Authentication token for authorization.
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// Identifier for the grade entry to remove.
+	GradeID       string `protobuf:"bytes,2,opt,name=gradeID,proto3" json:"gradeID,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *RemoveSingleGradeRequest) Reset() {
 	*x = RemoveSingleGradeRequest{}
-	mi := &file_grades_microservice_proto_msgTypes[6]
+	mi := &file_grades_microservice_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -372,7 +726,7 @@ func (x *RemoveSingleGradeRequest) String() string {
 func (*RemoveSingleGradeRequest) ProtoMessage() {}
 
 func (x *RemoveSingleGradeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_grades_microservice_proto_msgTypes[6]
+	mi := &file_grades_microservice_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -385,7 +739,7 @@ func (x *RemoveSingleGradeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RemoveSingleGradeRequest.ProtoReflect.Descriptor instead.
 func (*RemoveSingleGradeRequest) Descriptor() ([]byte, []int) {
-	return file_grades_microservice_proto_rawDescGZIP(), []int{6}
+	return file_grades_microservice_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *RemoveSingleGradeRequest) GetToken() string {
@@ -395,41 +749,6 @@ func (x *RemoveSingleGradeRequest) GetToken() string {
 	return ""
 }
 
-func (x *RemoveSingleGradeRequest) GetStudentID() string {
-	if x != nil {
-		return x.StudentID
-	}
-	return ""
-}
-
-func (x *RemoveSingleGradeRequest) GetCourseID() string {
-	if x != nil {
-		return x.CourseID
-	}
-	return ""
-}
-
-func (x *RemoveSingleGradeRequest) GetSemester() string {
-	if x != nil {
-		return x.Semester
-	}
-	return ""
-}
-
-func (x *RemoveSingleGradeRequest) GetGradeType() string {
-	if x != nil {
-		return x.GradeType
-	}
-	return ""
-}
-
-func (x *RemoveSingleGradeRequest) GetItemID() string {
-	if x != nil {
-		return x.ItemID
-	}
-	return ""
-}
-
 func (x *RemoveSingleGradeRequest) GetGradeID() string {
 	if x != nil {
 		return x.GradeID
@@ -437,7 +756,7 @@ func (x *RemoveSingleGradeRequest) GetGradeID() string {
 	return ""
 }
 
-// RemoveSingleGradeResponse is a response message to remove a single grade for a specific student in a specific course for a specific semester.
+// Response message after removing a single grade.
 type RemoveSingleGradeResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -446,7 +765,7 @@ type RemoveSingleGradeResponse struct {
 
 func (x *RemoveSingleGradeResponse) Reset() {
 	*x = RemoveSingleGradeResponse{}
-	mi := &file_grades_microservice_proto_msgTypes[7]
+	mi := &file_grades_microservice_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -458,7 +777,7 @@ func (x *RemoveSingleGradeResponse) String() string {
 func (*RemoveSingleGradeResponse) ProtoMessage() {}
 
 func (x *RemoveSingleGradeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_grades_microservice_proto_msgTypes[7]
+	mi := &file_grades_microservice_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -471,22 +790,45 @@ func (x *RemoveSingleGradeResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RemoveSingleGradeResponse.ProtoReflect.Descriptor instead.
 func (*RemoveSingleGradeResponse) Descriptor() ([]byte, []int) {
-	return file_grades_microservice_proto_rawDescGZIP(), []int{7}
+	return file_grades_microservice_proto_rawDescGZIP(), []int{8}
 }
 
-// GetCourseGradesRequest is a request message to get all students grades for a specific course for a specific semester.
+// Request message for retrieving all grades for a specific course.
 type GetCourseGradesRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
-	CourseID      string                 `protobuf:"bytes,2,opt,name=courseID,proto3" json:"courseID,omitempty"`
-	Semester      string                 `protobuf:"bytes,3,opt,name=semester,proto3" json:"semester,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Authentication token for authorization.
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// Identifier for the course.
+	CourseID string `protobuf:"bytes,2,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	// The academic semester.
+	Semester string `protobuf:"bytes,3,opt,name=semester,proto3" json:"semester,omitempty"`
+	// The maximum number of grades to return.
+	PageSize int32 `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// A token identifying a page of results the server should return.
+	PageToken string `protobuf:"bytes,5,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Whether grades moved to cold storage should be included in the results.
+	IncludeArchived bool `protobuf:"varint,6,opt,name=include_archived,json=includeArchived,proto3" json:"include_archived,omitempty"`
+	// ETag from a previous response; when it still matches, the grades are not resent.
+	IfNoneMatch string `protobuf:"bytes,7,opt,name=if_none_match,json=ifNoneMatch,proto3" json:"if_none_match,omitempty"`
+	// Whether student IDs should be replaced by pseudonymous tokens, stable within the response.
+	// Restricted to staff.
+	Anonymize bool `protobuf:"varint,8,opt,name=anonymize,proto3" json:"anonymize,omitempty"`
+	// Academic semesters to read at once. When set, it replaces semester and the results are
+	// grouped by semester; etag, if_none_match and stale serving only apply to single-semester reads.
+	Semesters []string `protobuf:"bytes,9,rep,name=semesters,proto3" json:"semesters,omitempty"`
+	// Locale, such as "de" or "en-US", whose labels fill display_value of the returned grades.
+	Locale string `protobuf:"bytes,10,opt,name=locale,proto3" json:"locale,omitempty"`
+	// Read consistency, "eventual" or "strong". Eventual reads may be served by a read replica that
+	// lags behind the latest writes; strong reads always go to the primary database, at a latency
+	// cost for distant clients. Defaults to the server's READ_CONSISTENCY, itself eventual unless set.
+	Consistency   string `protobuf:"bytes,11,opt,name=consistency,proto3" json:"consistency,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetCourseGradesRequest) Reset() {
 	*x = GetCourseGradesRequest{}
-	mi := &file_grades_microservice_proto_msgTypes[8]
+	mi := &file_grades_microservice_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -498,7 +840,7 @@ func (x *GetCourseGradesRequest) String() string {
 func (*GetCourseGradesRequest) ProtoMessage() {}
 
 func (x *GetCourseGradesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_grades_microservice_proto_msgTypes[8]
+	mi := &file_grades_microservice_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -511,7 +853,7 @@ func (x *GetCourseGradesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetCourseGradesRequest.ProtoReflect.Descriptor instead.
 func (*GetCourseGradesRequest) Descriptor() ([]byte, []int) {
-	return file_grades_microservice_proto_rawDescGZIP(), []int{8}
+	return file_grades_microservice_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *GetCourseGradesRequest) GetToken() string {
@@ -535,17 +877,86 @@ func (x *GetCourseGradesRequest) GetSemester() string {
 	return ""
 }
 
-// GetCourseGradesResponse is a response message to get all students grades for a specific course for a specific semester.
+func (x *GetCourseGradesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetCourseGradesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *GetCourseGradesRequest) GetIncludeArchived() bool {
+	if x != nil {
+		return x.IncludeArchived
+	}
+	return false
+}
+
+func (x *GetCourseGradesRequest) GetIfNoneMatch() string {
+	if x != nil {
+		return x.IfNoneMatch
+	}
+	return ""
+}
+
+func (x *GetCourseGradesRequest) GetAnonymize() bool {
+	if x != nil {
+		return x.Anonymize
+	}
+	return false
+}
+
+func (x *GetCourseGradesRequest) GetSemesters() []string {
+	if x != nil {
+		return x.Semesters
+	}
+	return nil
+}
+
+func (x *GetCourseGradesRequest) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+func (x *GetCourseGradesRequest) GetConsistency() string {
+	if x != nil {
+		return x.Consistency
+	}
+	return ""
+}
+
+// Response message containing all grades for a specific course.
 type GetCourseGradesResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Grades        []*SingleGrade         `protobuf:"bytes,1,rep,name=grades,proto3" json:"grades,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// List of grades matching the request criteria.
+	Grades []*SingleGrade `protobuf:"bytes,1,rep,name=grades,proto3" json:"grades,omitempty"`
+	// Token to retrieve the next page of results, or empty if there are no more results.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	// ETag identifying the current state of the course grades.
+	Etag string `protobuf:"bytes,3,opt,name=etag,proto3" json:"etag,omitempty"`
+	// True when the grades match if_none_match and were therefore omitted.
+	NotModified bool `protobuf:"varint,4,opt,name=not_modified,json=notModified,proto3" json:"not_modified,omitempty"`
+	// True when the database was unreachable and the grades were served from cache.
+	Stale bool `protobuf:"varint,5,opt,name=stale,proto3" json:"stale,omitempty"`
+	// True when the result exceeded the configured row limit and was cut short.
+	Truncated bool `protobuf:"varint,6,opt,name=truncated,proto3" json:"truncated,omitempty"`
+	// Grades grouped by semester, in the order requested, when semesters was set in the request.
+	SemesterGrades []*SemesterGrades `protobuf:"bytes,7,rep,name=semester_grades,json=semesterGrades,proto3" json:"semester_grades,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *GetCourseGradesResponse) Reset() {
 	*x = GetCourseGradesResponse{}
-	mi := &file_grades_microservice_proto_msgTypes[9]
+	mi := &file_grades_microservice_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -557,7 +968,7 @@ func (x *GetCourseGradesResponse) String() string {
 func (*GetCourseGradesResponse) ProtoMessage() {}
 
 func (x *GetCourseGradesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_grades_microservice_proto_msgTypes[9]
+	mi := &file_grades_microservice_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -570,7 +981,7 @@ func (x *GetCourseGradesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetCourseGradesResponse.ProtoReflect.Descriptor instead.
 func (*GetCourseGradesResponse) Descriptor() ([]byte, []int) {
-	return file_grades_microservice_proto_rawDescGZIP(), []int{9}
+	return file_grades_microservice_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *GetCourseGradesResponse) GetGrades() []*SingleGrade {
@@ -580,19 +991,76 @@ func (x *GetCourseGradesResponse) GetGrades() []*SingleGrade {
 	return nil
 }
 
-// GetStudentSemesterGradesRequest is a request message to get all grades for a specific student for a specific semester.
+func (x *GetCourseGradesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *GetCourseGradesResponse) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+func (x *GetCourseGradesResponse) GetNotModified() bool {
+	if x != nil {
+		return x.NotModified
+	}
+	return false
+}
+
+func (x *GetCourseGradesResponse) GetStale() bool {
+	if x != nil {
+		return x.Stale
+	}
+	return false
+}
+
+func (x *GetCourseGradesResponse) GetTruncated() bool {
+	if x != nil {
+		return x.Truncated
+	}
+	return false
+}
+
+func (x *GetCourseGradesResponse) GetSemesterGrades() []*SemesterGrades {
+	if x != nil {
+		return x.SemesterGrades
+	}
+	return nil
+}
+
+// Request message for retrieving all grades for a specific student in a specific semester.
 type GetStudentSemesterGradesRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
-	Semester      string                 `protobuf:"bytes,2,opt,name=semester,proto3" json:"semester,omitempty"`
-	StudentID     string                 `protobuf:"bytes,3,opt,name=studentID,proto3" json:"studentID,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Authentication token for authorization.
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// The academic semester.
+	Semester string `protobuf:"bytes,2,opt,name=semester,proto3" json:"semester,omitempty"`
+	// Identifier for the student.
+	StudentID string `protobuf:"bytes,3,opt,name=studentID,proto3" json:"studentID,omitempty"`
+	// The maximum number of grades to return.
+	PageSize int32 `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// A token identifying a page of results the server should return.
+	PageToken string `protobuf:"bytes,5,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Whether to attach course names and credits to the response.
+	IncludeCourseInfo bool `protobuf:"varint,6,opt,name=include_course_info,json=includeCourseInfo,proto3" json:"include_course_info,omitempty"`
+	// Locale, such as "de" or "en-US", whose labels fill display_value of the returned grades.
+	Locale string `protobuf:"bytes,7,opt,name=locale,proto3" json:"locale,omitempty"`
+	// Read consistency, "eventual" or "strong". Eventual reads may be served by a read replica that
+	// lags behind the latest writes; strong reads always go to the primary database, at a latency
+	// cost for distant clients. Defaults to the server's READ_CONSISTENCY, itself eventual unless set.
+	Consistency   string `protobuf:"bytes,8,opt,name=consistency,proto3" json:"consistency,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetStudentSemesterGradesRequest) Reset() {
 	*x = GetStudentSemesterGradesRequest{}
-	mi := &file_grades_microservice_proto_msgTypes[10]
+	mi := &file_grades_microservice_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -604,7 +1072,7 @@ func (x *GetStudentSemesterGradesRequest) String() string {
 func (*GetStudentSemesterGradesRequest) ProtoMessage() {}
 
 func (x *GetStudentSemesterGradesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_grades_microservice_proto_msgTypes[10]
+	mi := &file_grades_microservice_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -617,7 +1085,7 @@ func (x *GetStudentSemesterGradesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetStudentSemesterGradesRequest.ProtoReflect.Descriptor instead.
 func (*GetStudentSemesterGradesRequest) Descriptor() ([]byte, []int) {
-	return file_grades_microservice_proto_rawDescGZIP(), []int{10}
+	return file_grades_microservice_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *GetStudentSemesterGradesRequest) GetToken() string {
@@ -641,17 +1109,63 @@ func (x *GetStudentSemesterGradesRequest) GetStudentID() string {
 	return ""
 }
 
-// GetStudentSemesterGradesResponse is a response message to get all grades for a specific student for a specific semester.
+func (x *GetStudentSemesterGradesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetStudentSemesterGradesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *GetStudentSemesterGradesRequest) GetIncludeCourseInfo() bool {
+	if x != nil {
+		return x.IncludeCourseInfo
+	}
+	return false
+}
+
+func (x *GetStudentSemesterGradesRequest) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+func (x *GetStudentSemesterGradesRequest) GetConsistency() string {
+	if x != nil {
+		return x.Consistency
+	}
+	return ""
+}
+
+// Response message containing all grades for a specific student in a specific semester.
 type GetStudentSemesterGradesResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Grades        []*SingleGrade         `protobuf:"bytes,1,rep,name=grades,proto3" json:"grades,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// List of grades matching the request criteria.
+	Grades []*SingleGrade `protobuf:"bytes,1,rep,name=grades,proto3" json:"grades,omitempty"`
+	// Token to retrieve the next page of results, or empty if there are no more results.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	// Course details keyed by course ID, when requested and available.
+	Courses map[string]*CourseInfo `protobuf:"bytes,3,rep,name=courses,proto3" json:"courses,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Courses referenced by the grades that could not be resolved.
+	UnresolvedCourseIDs []string `protobuf:"bytes,4,rep,name=unresolvedCourseIDs,proto3" json:"unresolvedCourseIDs,omitempty"`
+	// True when the database was unreachable and the grades were served from cache.
+	Stale bool `protobuf:"varint,5,opt,name=stale,proto3" json:"stale,omitempty"`
+	// True when the result exceeded the configured row limit and was cut short.
+	Truncated     bool `protobuf:"varint,6,opt,name=truncated,proto3" json:"truncated,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetStudentSemesterGradesResponse) Reset() {
 	*x = GetStudentSemesterGradesResponse{}
-	mi := &file_grades_microservice_proto_msgTypes[11]
+	mi := &file_grades_microservice_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -663,7 +1177,7 @@ func (x *GetStudentSemesterGradesResponse) String() string {
 func (*GetStudentSemesterGradesResponse) ProtoMessage() {}
 
 func (x *GetStudentSemesterGradesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_grades_microservice_proto_msgTypes[11]
+	mi := &file_grades_microservice_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -676,7 +1190,7 @@ func (x *GetStudentSemesterGradesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetStudentSemesterGradesResponse.ProtoReflect.Descriptor instead.
 func (*GetStudentSemesterGradesResponse) Descriptor() ([]byte, []int) {
-	return file_grades_microservice_proto_rawDescGZIP(), []int{11}
+	return file_grades_microservice_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *GetStudentSemesterGradesResponse) GetGrades() []*SingleGrade {
@@ -686,37 +1200,69 @@ func (x *GetStudentSemesterGradesResponse) GetGrades() []*SingleGrade {
 	return nil
 }
 
-// SingleGrade is a single grade message.
-type SingleGrade struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Semester      string                 `protobuf:"bytes,1,opt,name=semester,proto3" json:"semester,omitempty"`
-	GradeID       string                 `protobuf:"bytes,2,opt,name=gradeID,proto3" json:"gradeID,omitempty"`
-	StudentID     string                 `protobuf:"bytes,3,opt,name=studentID,proto3" json:"studentID,omitempty"`
-	CourseID      string                 `protobuf:"bytes,4,opt,name=courseID,proto3" json:"courseID,omitempty"`
-	GradeType     string                 `protobuf:"bytes,5,opt,name=gradeType,proto3" json:"gradeType,omitempty"`
-	ItemID        string                 `protobuf:"bytes,6,opt,name=itemID,proto3" json:"itemID,omitempty"`
-	GradeValue    string                 `protobuf:"bytes,7,opt,name=gradeValue,proto3" json:"gradeValue,omitempty"`
-	GradedBy      string                 `protobuf:"bytes,8,opt,name=gradedBy,proto3" json:"gradedBy,omitempty"`
-	Comments      string                 `protobuf:"bytes,9,opt,name=comments,proto3" json:"comments,omitempty"`
+func (x *GetStudentSemesterGradesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *GetStudentSemesterGradesResponse) GetCourses() map[string]*CourseInfo {
+	if x != nil {
+		return x.Courses
+	}
+	return nil
+}
+
+func (x *GetStudentSemesterGradesResponse) GetUnresolvedCourseIDs() []string {
+	if x != nil {
+		return x.UnresolvedCourseIDs
+	}
+	return nil
+}
+
+func (x *GetStudentSemesterGradesResponse) GetStale() bool {
+	if x != nil {
+		return x.Stale
+	}
+	return false
+}
+
+func (x *GetStudentSemesterGradesResponse) GetTruncated() bool {
+	if x != nil {
+		return x.Truncated
+	}
+	return false
+}
+
+// Represents the details of a course.
+type CourseInfo struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Identifier for the course.
+	CourseID string `protobuf:"bytes,1,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	// Display name of the course.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Number of credits the course is worth.
+	Credits       float64 `protobuf:"fixed64,3,opt,name=credits,proto3" json:"credits,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SingleGrade) Reset() {
-	*x = SingleGrade{}
-	mi := &file_grades_microservice_proto_msgTypes[12]
+func (x *CourseInfo) Reset() {
+	*x = CourseInfo{}
+	mi := &file_grades_microservice_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SingleGrade) String() string {
+func (x *CourseInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SingleGrade) ProtoMessage() {}
+func (*CourseInfo) ProtoMessage() {}
 
-func (x *SingleGrade) ProtoReflect() protoreflect.Message {
-	mi := &file_grades_microservice_proto_msgTypes[12]
+func (x *CourseInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_microservice_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -727,70 +1273,8928 @@ func (x *SingleGrade) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SingleGrade.ProtoReflect.Descriptor instead.
-func (*SingleGrade) Descriptor() ([]byte, []int) {
-	return file_grades_microservice_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use CourseInfo.ProtoReflect.Descriptor instead.
+func (*CourseInfo) Descriptor() ([]byte, []int) {
+	return file_grades_microservice_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *SingleGrade) GetSemester() string {
+func (x *CourseInfo) GetCourseID() string {
 	if x != nil {
-		return x.Semester
+		return x.CourseID
 	}
 	return ""
 }
 
-func (x *SingleGrade) GetGradeID() string {
+func (x *CourseInfo) GetName() string {
 	if x != nil {
-		return x.GradeID
+		return x.Name
 	}
 	return ""
 }
 
-func (x *SingleGrade) GetStudentID() string {
+func (x *CourseInfo) GetCredits() float64 {
 	if x != nil {
-		return x.StudentID
+		return x.Credits
 	}
-	return ""
+	return 0
 }
 
-func (x *SingleGrade) GetCourseID() string {
+// Request message for archiving all grades of a semester.
+type ArchiveSemesterRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Authentication token for authorization.
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// The academic semester to archive.
+	Semester      string `protobuf:"bytes,2,opt,name=semester,proto3" json:"semester,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArchiveSemesterRequest) Reset() {
+	*x = ArchiveSemesterRequest{}
+	mi := &file_grades_microservice_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArchiveSemesterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchiveSemesterRequest) ProtoMessage() {}
+
+func (x *ArchiveSemesterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_microservice_proto_msgTypes[14]
 	if x != nil {
-		return x.CourseID
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *SingleGrade) GetGradeType() string {
+// Deprecated: Use ArchiveSemesterRequest.ProtoReflect.Descriptor instead.
+func (*ArchiveSemesterRequest) Descriptor() ([]byte, []int) {
+	return file_grades_microservice_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ArchiveSemesterRequest) GetToken() string {
 	if x != nil {
-		return x.GradeType
+		return x.Token
 	}
 	return ""
 }
 
-func (x *SingleGrade) GetItemID() string {
+func (x *ArchiveSemesterRequest) GetSemester() string {
 	if x != nil {
-		return x.ItemID
+		return x.Semester
 	}
 	return ""
 }
 
-func (x *SingleGrade) GetGradeValue() string {
+// Response message after archiving a semester.
+type ArchiveSemesterResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Number of grades moved to cold storage.
+	ArchivedCount int64 `protobuf:"varint,1,opt,name=archived_count,json=archivedCount,proto3" json:"archived_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArchiveSemesterResponse) Reset() {
+	*x = ArchiveSemesterResponse{}
+	mi := &file_grades_microservice_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArchiveSemesterResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchiveSemesterResponse) ProtoMessage() {}
+
+func (x *ArchiveSemesterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_microservice_proto_msgTypes[15]
 	if x != nil {
-		return x.GradeValue
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *SingleGrade) GetGradedBy() string {
+// Deprecated: Use ArchiveSemesterResponse.ProtoReflect.Descriptor instead.
+func (*ArchiveSemesterResponse) Descriptor() ([]byte, []int) {
+	return file_grades_microservice_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ArchiveSemesterResponse) GetArchivedCount() int64 {
 	if x != nil {
-		return x.GradedBy
+		return x.ArchivedCount
 	}
-	return ""
+	return 0
 }
 
-func (x *SingleGrade) GetComments() string {
+// Request message for retrieving a single grade.
+type GetGradeRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Authentication token for authorization.
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// Identifier for the grade entry.
+	GradeID string `protobuf:"bytes,2,opt,name=gradeID,proto3" json:"gradeID,omitempty"`
+	// The version to retrieve, or zero for the latest version.
+	Version int64 `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	// Read from the primary database instead of the read replica, so a grade the caller just
+	// wrote is always found.
+	ReadAfterWrite bool `protobuf:"varint,4,opt,name=read_after_write,json=readAfterWrite,proto3" json:"read_after_write,omitempty"`
+	// Locale, such as "de" or "en-US", whose labels fill display_value of the returned grade.
+	Locale string `protobuf:"bytes,5,opt,name=locale,proto3" json:"locale,omitempty"`
+	// The version the caller already holds. When the grade is still at this version, not_modified
+	// is set instead of returning the grade. Zero always returns the grade.
+	IfVersionNot int64 `protobuf:"varint,6,opt,name=if_version_not,json=ifVersionNot,proto3" json:"if_version_not,omitempty"`
+	// Read consistency, "eventual" or "strong". Eventual reads may be served by a read replica that
+	// lags behind the latest writes; strong reads always go to the primary database, at a latency
+	// cost for distant clients. Defaults to strong with read_after_write, which implies it, and to
+	// the server's READ_CONSISTENCY, itself eventual unless set, without.
+	Consistency   string `protobuf:"bytes,7,opt,name=consistency,proto3" json:"consistency,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGradeRequest) Reset() {
+	*x = GetGradeRequest{}
+	mi := &file_grades_microservice_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGradeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGradeRequest) ProtoMessage() {}
+
+func (x *GetGradeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_microservice_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGradeRequest.ProtoReflect.Descriptor instead.
+func (*GetGradeRequest) Descriptor() ([]byte, []int) {
+	return file_grades_microservice_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetGradeRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *GetGradeRequest) GetGradeID() string {
+	if x != nil {
+		return x.GradeID
+	}
+	return ""
+}
+
+func (x *GetGradeRequest) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *GetGradeRequest) GetReadAfterWrite() bool {
+	if x != nil {
+		return x.ReadAfterWrite
+	}
+	return false
+}
+
+func (x *GetGradeRequest) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+func (x *GetGradeRequest) GetIfVersionNot() int64 {
+	if x != nil {
+		return x.IfVersionNot
+	}
+	return 0
+}
+
+func (x *GetGradeRequest) GetConsistency() string {
+	if x != nil {
+		return x.Consistency
+	}
+	return ""
+}
+
+// Response message containing a single grade.
+type GetGradeResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The grade details at the requested version, unset when not_modified is set.
+	Grade *SingleGrade `protobuf:"bytes,1,opt,name=grade,proto3" json:"grade,omitempty"`
+	// The grade is still at the version given in if_version_not.
+	NotModified   bool `protobuf:"varint,2,opt,name=not_modified,json=notModified,proto3" json:"not_modified,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGradeResponse) Reset() {
+	*x = GetGradeResponse{}
+	mi := &file_grades_microservice_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGradeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGradeResponse) ProtoMessage() {}
+
+func (x *GetGradeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_microservice_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGradeResponse.ProtoReflect.Descriptor instead.
+func (*GetGradeResponse) Descriptor() ([]byte, []int) {
+	return file_grades_microservice_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetGradeResponse) GetGrade() *SingleGrade {
+	if x != nil {
+		return x.Grade
+	}
+	return nil
+}
+
+func (x *GetGradeResponse) GetNotModified() bool {
+	if x != nil {
+		return x.NotModified
+	}
+	return false
+}
+
+// Request message for searching grade comments within a course.
+type SearchGradeCommentsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Authentication token for authorization.
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// Identifier for the course.
+	CourseID string `protobuf:"bytes,2,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	// The academic semester.
+	Semester string `protobuf:"bytes,3,opt,name=semester,proto3" json:"semester,omitempty"`
+	// Text to look for in the comments, matched literally and case-insensitively.
+	Query         string `protobuf:"bytes,4,opt,name=query,proto3" json:"query,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchGradeCommentsRequest) Reset() {
+	*x = SearchGradeCommentsRequest{}
+	mi := &file_grades_microservice_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchGradeCommentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchGradeCommentsRequest) ProtoMessage() {}
+
+func (x *SearchGradeCommentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_microservice_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchGradeCommentsRequest.ProtoReflect.Descriptor instead.
+func (*SearchGradeCommentsRequest) Descriptor() ([]byte, []int) {
+	return file_grades_microservice_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *SearchGradeCommentsRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *SearchGradeCommentsRequest) GetCourseID() string {
+	if x != nil {
+		return x.CourseID
+	}
+	return ""
+}
+
+func (x *SearchGradeCommentsRequest) GetSemester() string {
+	if x != nil {
+		return x.Semester
+	}
+	return ""
+}
+
+func (x *SearchGradeCommentsRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+// Response message containing grades whose comments match the search term.
+type SearchGradeCommentsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// List of grades matching the request criteria.
+	Grades []*SingleGrade `protobuf:"bytes,1,rep,name=grades,proto3" json:"grades,omitempty"`
+	// True when the result exceeded the configured row limit and was cut short.
+	Truncated     bool `protobuf:"varint,2,opt,name=truncated,proto3" json:"truncated,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchGradeCommentsResponse) Reset() {
+	*x = SearchGradeCommentsResponse{}
+	mi := &file_grades_microservice_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchGradeCommentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchGradeCommentsResponse) ProtoMessage() {}
+
+func (x *SearchGradeCommentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_microservice_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchGradeCommentsResponse.ProtoReflect.Descriptor instead.
+func (*SearchGradeCommentsResponse) Descriptor() ([]byte, []int) {
+	return file_grades_microservice_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *SearchGradeCommentsResponse) GetGrades() []*SingleGrade {
+	if x != nil {
+		return x.Grades
+	}
+	return nil
+}
+
+func (x *SearchGradeCommentsResponse) GetTruncated() bool {
+	if x != nil {
+		return x.Truncated
+	}
+	return false
+}
+
+// Request message for marking or unmarking a grade for review.
+type SetGradeFlagRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Authentication token for authorization.
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// Identifier for the grade entry.
+	GradeID string `protobuf:"bytes,2,opt,name=gradeID,proto3" json:"gradeID,omitempty"`
+	// Whether the grade should be marked for review.
+	Flagged       bool `protobuf:"varint,3,opt,name=flagged,proto3" json:"flagged,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetGradeFlagRequest) Reset() {
+	*x = SetGradeFlagRequest{}
+	mi := &file_grades_microservice_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetGradeFlagRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetGradeFlagRequest) ProtoMessage() {}
+
+func (x *SetGradeFlagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_microservice_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetGradeFlagRequest.ProtoReflect.Descriptor instead.
+func (*SetGradeFlagRequest) Descriptor() ([]byte, []int) {
+	return file_grades_microservice_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *SetGradeFlagRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *SetGradeFlagRequest) GetGradeID() string {
+	if x != nil {
+		return x.GradeID
+	}
+	return ""
+}
+
+func (x *SetGradeFlagRequest) GetFlagged() bool {
+	if x != nil {
+		return x.Flagged
+	}
+	return false
+}
+
+// Response message after changing a grade's review flag.
+type SetGradeFlagResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The grade details with the updated flag.
+	Grade         *SingleGrade `protobuf:"bytes,1,opt,name=grade,proto3" json:"grade,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetGradeFlagResponse) Reset() {
+	*x = SetGradeFlagResponse{}
+	mi := &file_grades_microservice_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetGradeFlagResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetGradeFlagResponse) ProtoMessage() {}
+
+func (x *SetGradeFlagResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_microservice_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetGradeFlagResponse.ProtoReflect.Descriptor instead.
+func (*SetGradeFlagResponse) Descriptor() ([]byte, []int) {
+	return file_grades_microservice_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *SetGradeFlagResponse) GetGrade() *SingleGrade {
+	if x != nil {
+		return x.Grade
+	}
+	return nil
+}
+
+// Request message for retrieving the grades marked for review in a course.
+type GetFlaggedGradesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Authentication token for authorization.
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// Identifier for the course.
+	CourseID string `protobuf:"bytes,2,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	// The academic semester.
+	Semester      string `protobuf:"bytes,3,opt,name=semester,proto3" json:"semester,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFlaggedGradesRequest) Reset() {
+	*x = GetFlaggedGradesRequest{}
+	mi := &file_grades_microservice_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFlaggedGradesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFlaggedGradesRequest) ProtoMessage() {}
+
+func (x *GetFlaggedGradesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_microservice_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFlaggedGradesRequest.ProtoReflect.Descriptor instead.
+func (*GetFlaggedGradesRequest) Descriptor() ([]byte, []int) {
+	return file_grades_microservice_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetFlaggedGradesRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *GetFlaggedGradesRequest) GetCourseID() string {
+	if x != nil {
+		return x.CourseID
+	}
+	return ""
+}
+
+func (x *GetFlaggedGradesRequest) GetSemester() string {
+	if x != nil {
+		return x.Semester
+	}
+	return ""
+}
+
+// Response message containing the grades marked for review.
+type GetFlaggedGradesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// List of flagged grades.
+	Grades []*SingleGrade `protobuf:"bytes,1,rep,name=grades,proto3" json:"grades,omitempty"`
+	// True when the result exceeded the configured row limit and was cut short.
+	Truncated     bool `protobuf:"varint,2,opt,name=truncated,proto3" json:"truncated,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFlaggedGradesResponse) Reset() {
+	*x = GetFlaggedGradesResponse{}
+	mi := &file_grades_microservice_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFlaggedGradesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFlaggedGradesResponse) ProtoMessage() {}
+
+func (x *GetFlaggedGradesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_microservice_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFlaggedGradesResponse.ProtoReflect.Descriptor instead.
+func (*GetFlaggedGradesResponse) Descriptor() ([]byte, []int) {
+	return file_grades_microservice_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetFlaggedGradesResponse) GetGrades() []*SingleGrade {
+	if x != nil {
+		return x.Grades
+	}
+	return nil
+}
+
+func (x *GetFlaggedGradesResponse) GetTruncated() bool {
+	if x != nil {
+		return x.Truncated
+	}
+	return false
+}
+
+// Request message for retrieving the grades of several courses at once.
+type GetMultiCourseGradesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Authentication token for authorization.
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// Identifiers for the courses.
+	CourseIDs []string `protobuf:"bytes,2,rep,name=courseIDs,proto3" json:"courseIDs,omitempty"`
+	// The academic semester.
+	Semester string `protobuf:"bytes,3,opt,name=semester,proto3" json:"semester,omitempty"`
+	// Whether student IDs should be replaced by pseudonymous tokens, stable within the response.
+	// Restricted to staff.
+	Anonymize     bool `protobuf:"varint,4,opt,name=anonymize,proto3" json:"anonymize,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMultiCourseGradesRequest) Reset() {
+	*x = GetMultiCourseGradesRequest{}
+	mi := &file_grades_microservice_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMultiCourseGradesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMultiCourseGradesRequest) ProtoMessage() {}
+
+func (x *GetMultiCourseGradesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_microservice_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMultiCourseGradesRequest.ProtoReflect.Descriptor instead.
+func (*GetMultiCourseGradesRequest) Descriptor() ([]byte, []int) {
+	return file_grades_microservice_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetMultiCourseGradesRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *GetMultiCourseGradesRequest) GetCourseIDs() []string {
+	if x != nil {
+		return x.CourseIDs
+	}
+	return nil
+}
+
+func (x *GetMultiCourseGradesRequest) GetSemester() string {
+	if x != nil {
+		return x.Semester
+	}
+	return ""
+}
+
+func (x *GetMultiCourseGradesRequest) GetAnonymize() bool {
+	if x != nil {
+		return x.Anonymize
+	}
+	return false
+}
+
+// Grades of a single course.
+type CourseGrades struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Identifier for the course.
+	CourseID string `protobuf:"bytes,1,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	// List of grades in the course, empty when the course has none.
+	Grades []*SingleGrade `protobuf:"bytes,2,rep,name=grades,proto3" json:"grades,omitempty"`
+	// True when the result exceeded the configured row limit and was cut short.
+	Truncated     bool `protobuf:"varint,3,opt,name=truncated,proto3" json:"truncated,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CourseGrades) Reset() {
+	*x = CourseGrades{}
+	mi := &file_grades_microservice_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CourseGrades) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CourseGrades) ProtoMessage() {}
+
+func (x *CourseGrades) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_microservice_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CourseGrades.ProtoReflect.Descriptor instead.
+func (*CourseGrades) Descriptor() ([]byte, []int) {
+	return file_grades_microservice_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *CourseGrades) GetCourseID() string {
+	if x != nil {
+		return x.CourseID
+	}
+	return ""
+}
+
+func (x *CourseGrades) GetGrades() []*SingleGrade {
+	if x != nil {
+		return x.Grades
+	}
+	return nil
+}
+
+func (x *CourseGrades) GetTruncated() bool {
+	if x != nil {
+		return x.Truncated
+	}
+	return false
+}
+
+// Response message containing grades grouped by course, in the order the courses were requested.
+type GetMultiCourseGradesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// One group per requested course.
+	Courses       []*CourseGrades `protobuf:"bytes,1,rep,name=courses,proto3" json:"courses,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMultiCourseGradesResponse) Reset() {
+	*x = GetMultiCourseGradesResponse{}
+	mi := &file_grades_microservice_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMultiCourseGradesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMultiCourseGradesResponse) ProtoMessage() {}
+
+func (x *GetMultiCourseGradesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_microservice_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMultiCourseGradesResponse.ProtoReflect.Descriptor instead.
+func (*GetMultiCourseGradesResponse) Descriptor() ([]byte, []int) {
+	return file_grades_microservice_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GetMultiCourseGradesResponse) GetCourses() []*CourseGrades {
+	if x != nil {
+		return x.Courses
+	}
+	return nil
+}
+
+// Request message for looking up grades by a partial student ID.
+type SearchStudentGradesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Authentication token for authorization.
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// Leading characters of the student ID, matched literally.
+	Prefix        string `protobuf:"bytes,2,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchStudentGradesRequest) Reset() {
+	*x = SearchStudentGradesRequest{}
+	mi := &file_grades_microservice_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchStudentGradesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchStudentGradesRequest) ProtoMessage() {}
+
+func (x *SearchStudentGradesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_microservice_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchStudentGradesRequest.ProtoReflect.Descriptor instead.
+func (*SearchStudentGradesRequest) Descriptor() ([]byte, []int) {
+	return file_grades_microservice_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *SearchStudentGradesRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *SearchStudentGradesRequest) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+// Response message containing the grades of the matching students.
+type SearchStudentGradesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// List of grades, ordered by student ID.
+	Grades []*SingleGrade `protobuf:"bytes,1,rep,name=grades,proto3" json:"grades,omitempty"`
+	// True when more grades matched than the result cap allows.
+	Truncated     bool `protobuf:"varint,2,opt,name=truncated,proto3" json:"truncated,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchStudentGradesResponse) Reset() {
+	*x = SearchStudentGradesResponse{}
+	mi := &file_grades_microservice_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchStudentGradesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchStudentGradesResponse) ProtoMessage() {}
+
+func (x *SearchStudentGradesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_microservice_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchStudentGradesResponse.ProtoReflect.Descriptor instead.
+func (*SearchStudentGradesResponse) Descriptor() ([]byte, []int) {
+	return file_grades_microservice_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *SearchStudentGradesResponse) GetGrades() []*SingleGrade {
+	if x != nil {
+		return x.Grades
+	}
+	return nil
+}
+
+func (x *SearchStudentGradesResponse) GetTruncated() bool {
+	if x != nil {
+		return x.Truncated
+	}
+	return false
+}
+
+// Request message for retrying grade events whose delivery failed.
+type RetryFailedEventsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Authentication token for authorization.
+	Token         string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RetryFailedEventsRequest) Reset() {
+	*x = RetryFailedEventsRequest{}
+	mi := &file_grades_microservice_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RetryFailedEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetryFailedEventsRequest) ProtoMessage() {}
+
+func (x *RetryFailedEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_microservice_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetryFailedEventsRequest.ProtoReflect.Descriptor instead.
+func (*RetryFailedEventsRequest) Descriptor() ([]byte, []int) {
+	return file_grades_microservice_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *RetryFailedEventsRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+// Response message summarizing a retry of failed grade events.
+type RetryFailedEventsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Number of events delivered and removed from the dead-letter store.
+	DeliveredCount int32 `protobuf:"varint,1,opt,name=delivered_count,json=deliveredCount,proto3" json:"delivered_count,omitempty"`
+	// Number of events that failed again and remain in the dead-letter store.
+	StillFailingCount int32 `protobuf:"varint,2,opt,name=still_failing_count,json=stillFailingCount,proto3" json:"still_failing_count,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *RetryFailedEventsResponse) Reset() {
+	*x = RetryFailedEventsResponse{}
+	mi := &file_grades_microservice_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RetryFailedEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetryFailedEventsResponse) ProtoMessage() {}
+
+func (x *RetryFailedEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_microservice_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetryFailedEventsResponse.ProtoReflect.Descriptor instead.
+func (*RetryFailedEventsResponse) Descriptor() ([]byte, []int) {
+	return file_grades_microservice_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *RetryFailedEventsResponse) GetDeliveredCount() int32 {
+	if x != nil {
+		return x.DeliveredCount
+	}
+	return 0
+}
+
+func (x *RetryFailedEventsResponse) GetStillFailingCount() int32 {
+	if x != nil {
+		return x.StillFailingCount
+	}
+	return 0
+}
+
+// Grades of a course or student during a single semester.
+type SemesterGrades struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The academic semester.
+	Semester string `protobuf:"bytes,1,opt,name=semester,proto3" json:"semester,omitempty"`
+	// List of grades in the semester, empty when there are none.
+	Grades []*SingleGrade `protobuf:"bytes,2,rep,name=grades,proto3" json:"grades,omitempty"`
+	// True when the result exceeded the configured row limit and was cut short.
+	Truncated     bool `protobuf:"varint,3,opt,name=truncated,proto3" json:"truncated,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SemesterGrades) Reset() {
+	*x = SemesterGrades{}
+	mi := &file_grades_microservice_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SemesterGrades) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SemesterGrades) ProtoMessage() {}
+
+func (x *SemesterGrades) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_microservice_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SemesterGrades.ProtoReflect.Descriptor instead.
+func (*SemesterGrades) Descriptor() ([]byte, []int) {
+	return file_grades_microservice_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *SemesterGrades) GetSemester() string {
+	if x != nil {
+		return x.Semester
+	}
+	return ""
+}
+
+func (x *SemesterGrades) GetGrades() []*SingleGrade {
+	if x != nil {
+		return x.Grades
+	}
+	return nil
+}
+
+func (x *SemesterGrades) GetTruncated() bool {
+	if x != nil {
+		return x.Truncated
+	}
+	return false
+}
+
+// Request message for retrieving the provenance of a grade.
+type GetGradeProvenanceRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Authentication token for authorization.
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// Identifier for the grade entry.
+	GradeID       string `protobuf:"bytes,2,opt,name=gradeID,proto3" json:"gradeID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGradeProvenanceRequest) Reset() {
+	*x = GetGradeProvenanceRequest{}
+	mi := &file_grades_microservice_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGradeProvenanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGradeProvenanceRequest) ProtoMessage() {}
+
+func (x *GetGradeProvenanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_microservice_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGradeProvenanceRequest.ProtoReflect.Descriptor instead.
+func (*GetGradeProvenanceRequest) Descriptor() ([]byte, []int) {
+	return file_grades_microservice_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *GetGradeProvenanceRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *GetGradeProvenanceRequest) GetGradeID() string {
+	if x != nil {
+		return x.GradeID
+	}
+	return ""
+}
+
+// A single entry in the provenance of a grade.
+type ProvenanceEvent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// What happened: "created", "updated" or "viewed".
+	Kind string `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	// When it happened, in RFC 3339 format.
+	OccurredAt string `protobuf:"bytes,2,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	// Who graded the version for "created" and "updated", or who read the grade for "viewed".
+	Actor string `protobuf:"bytes,3,opt,name=actor,proto3" json:"actor,omitempty"`
+	// Version of the grade after the change; 0 for "viewed".
+	Version int64 `protobuf:"varint,4,opt,name=version,proto3" json:"version,omitempty"`
+	// Grade value after the change; empty for "viewed".
+	GradeValue    string `protobuf:"bytes,5,opt,name=grade_value,json=gradeValue,proto3" json:"grade_value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProvenanceEvent) Reset() {
+	*x = ProvenanceEvent{}
+	mi := &file_grades_microservice_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProvenanceEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProvenanceEvent) ProtoMessage() {}
+
+func (x *ProvenanceEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_microservice_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProvenanceEvent.ProtoReflect.Descriptor instead.
+func (*ProvenanceEvent) Descriptor() ([]byte, []int) {
+	return file_grades_microservice_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ProvenanceEvent) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *ProvenanceEvent) GetOccurredAt() string {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return ""
+}
+
+func (x *ProvenanceEvent) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *ProvenanceEvent) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *ProvenanceEvent) GetGradeValue() string {
+	if x != nil {
+		return x.GradeValue
+	}
+	return ""
+}
+
+// Response message containing the provenance of a grade.
+type GetGradeProvenanceResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Provenance events, oldest first.
+	Events        []*ProvenanceEvent `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGradeProvenanceResponse) Reset() {
+	*x = GetGradeProvenanceResponse{}
+	mi := &file_grades_microservice_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGradeProvenanceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGradeProvenanceResponse) ProtoMessage() {}
+
+func (x *GetGradeProvenanceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_microservice_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGradeProvenanceResponse.ProtoReflect.Descriptor instead.
+func (*GetGradeProvenanceResponse) Descriptor() ([]byte, []int) {
+	return file_grades_microservice_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *GetGradeProvenanceResponse) GetEvents() []*ProvenanceEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+// Request message for projecting the grade needed to reach a target final grade.
+type ProjectRequiredGradeRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Authentication token for authorization.
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// Identifier for the course.
+	CourseID string `protobuf:"bytes,2,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	// The academic semester.
+	Semester string `protobuf:"bytes,3,opt,name=semester,proto3" json:"semester,omitempty"`
+	// Identifier for the student.
+	StudentID string `protobuf:"bytes,4,opt,name=studentID,proto3" json:"studentID,omitempty"`
+	// The final grade the student wants to reach, between 0 and 100.
+	TargetGrade float64 `protobuf:"fixed64,5,opt,name=target_grade,json=targetGrade,proto3" json:"target_grade,omitempty"`
+	// Weight of each course component, keyed by grade type; the configured weights are used when empty.
+	Weights       map[string]float64 `protobuf:"bytes,6,rep,name=weights,proto3" json:"weights,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"fixed64,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProjectRequiredGradeRequest) Reset() {
+	*x = ProjectRequiredGradeRequest{}
+	mi := &file_grades_microservice_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProjectRequiredGradeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProjectRequiredGradeRequest) ProtoMessage() {}
+
+func (x *ProjectRequiredGradeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_microservice_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProjectRequiredGradeRequest.ProtoReflect.Descriptor instead.
+func (*ProjectRequiredGradeRequest) Descriptor() ([]byte, []int) {
+	return file_grades_microservice_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ProjectRequiredGradeRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *ProjectRequiredGradeRequest) GetCourseID() string {
+	if x != nil {
+		return x.CourseID
+	}
+	return ""
+}
+
+func (x *ProjectRequiredGradeRequest) GetSemester() string {
+	if x != nil {
+		return x.Semester
+	}
+	return ""
+}
+
+func (x *ProjectRequiredGradeRequest) GetStudentID() string {
+	if x != nil {
+		return x.StudentID
+	}
+	return ""
+}
+
+func (x *ProjectRequiredGradeRequest) GetTargetGrade() float64 {
+	if x != nil {
+		return x.TargetGrade
+	}
+	return 0
+}
+
+func (x *ProjectRequiredGradeRequest) GetWeights() map[string]float64 {
+	if x != nil {
+		return x.Weights
+	}
+	return nil
+}
+
+// Response message containing the projected required grade.
+type ProjectRequiredGradeResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Score needed on every remaining component; 0 when the target is already secured or nothing remains.
+	RequiredGrade float64 `protobuf:"fixed64,1,opt,name=required_grade,json=requiredGrade,proto3" json:"required_grade,omitempty"`
+	// False when the target cannot be reached even with full marks on the remaining components.
+	Reachable bool `protobuf:"varint,2,opt,name=reachable,proto3" json:"reachable,omitempty"`
+	// Weighted average of the components graded so far.
+	CurrentGrade float64 `protobuf:"fixed64,3,opt,name=current_grade,json=currentGrade,proto3" json:"current_grade,omitempty"`
+	// Weighted components that have no numeric grade yet.
+	RemainingComponents []string `protobuf:"bytes,4,rep,name=remaining_components,json=remainingComponents,proto3" json:"remaining_components,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *ProjectRequiredGradeResponse) Reset() {
+	*x = ProjectRequiredGradeResponse{}
+	mi := &file_grades_microservice_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProjectRequiredGradeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProjectRequiredGradeResponse) ProtoMessage() {}
+
+func (x *ProjectRequiredGradeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_microservice_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProjectRequiredGradeResponse.ProtoReflect.Descriptor instead.
+func (*ProjectRequiredGradeResponse) Descriptor() ([]byte, []int) {
+	return file_grades_microservice_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ProjectRequiredGradeResponse) GetRequiredGrade() float64 {
+	if x != nil {
+		return x.RequiredGrade
+	}
+	return 0
+}
+
+func (x *ProjectRequiredGradeResponse) GetReachable() bool {
+	if x != nil {
+		return x.Reachable
+	}
+	return false
+}
+
+func (x *ProjectRequiredGradeResponse) GetCurrentGrade() float64 {
+	if x != nil {
+		return x.CurrentGrade
+	}
+	return 0
+}
+
+func (x *ProjectRequiredGradeResponse) GetRemainingComponents() []string {
+	if x != nil {
+		return x.RemainingComponents
+	}
+	return nil
+}
+
+// Request message for adding a list of grades.
+type BatchAddGradesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Authentication token for authorization.
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// The grades to add.
+	Grades        []*SingleGrade `protobuf:"bytes,2,rep,name=grades,proto3" json:"grades,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchAddGradesRequest) Reset() {
+	*x = BatchAddGradesRequest{}
+	mi := &file_grades_microservice_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchAddGradesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchAddGradesRequest) ProtoMessage() {}
+
+func (x *BatchAddGradesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_microservice_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchAddGradesRequest.ProtoReflect.Descriptor instead.
+func (*BatchAddGradesRequest) Descriptor() ([]byte, []int) {
+	return file_grades_microservice_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *BatchAddGradesRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *BatchAddGradesRequest) GetGrades() []*SingleGrade {
+	if x != nil {
+		return x.Grades
+	}
+	return nil
+}
+
+// A grade of a batch that could not be added.
+type BatchGradeError struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Position of the grade in the request.
+	Index int32 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	// Why the grade was not added.
+	Message       string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchGradeError) Reset() {
+	*x = BatchGradeError{}
+	mi := &file_grades_microservice_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchGradeError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGradeError) ProtoMessage() {}
+
+func (x *BatchGradeError) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_microservice_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGradeError.ProtoReflect.Descriptor instead.
+func (*BatchGradeError) Descriptor() ([]byte, []int) {
+	return file_grades_microservice_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *BatchGradeError) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *BatchGradeError) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Progress of a batch add.
+type BatchAddGradesProgress struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Number of grades processed so far.
+	ProcessedCount int32 `protobuf:"varint,1,opt,name=processed_count,json=processedCount,proto3" json:"processed_count,omitempty"`
+	// Number of grades added so far.
+	AddedCount int32 `protobuf:"varint,2,opt,name=added_count,json=addedCount,proto3" json:"added_count,omitempty"`
+	// Number of grades that failed so far.
+	ErrorCount int32 `protobuf:"varint,3,opt,name=error_count,json=errorCount,proto3" json:"error_count,omitempty"`
+	// Grades that failed since the previous update.
+	Errors []*BatchGradeError `protobuf:"bytes,4,rep,name=errors,proto3" json:"errors,omitempty"`
+	// True on the final summary, sent once the whole list was processed.
+	Done          bool `protobuf:"varint,5,opt,name=done,proto3" json:"done,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchAddGradesProgress) Reset() {
+	*x = BatchAddGradesProgress{}
+	mi := &file_grades_microservice_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchAddGradesProgress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchAddGradesProgress) ProtoMessage() {}
+
+func (x *BatchAddGradesProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_microservice_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchAddGradesProgress.ProtoReflect.Descriptor instead.
+func (*BatchAddGradesProgress) Descriptor() ([]byte, []int) {
+	return file_grades_microservice_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *BatchAddGradesProgress) GetProcessedCount() int32 {
+	if x != nil {
+		return x.ProcessedCount
+	}
+	return 0
+}
+
+func (x *BatchAddGradesProgress) GetAddedCount() int32 {
+	if x != nil {
+		return x.AddedCount
+	}
+	return 0
+}
+
+func (x *BatchAddGradesProgress) GetErrorCount() int32 {
+	if x != nil {
+		return x.ErrorCount
+	}
+	return 0
+}
+
+func (x *BatchAddGradesProgress) GetErrors() []*BatchGradeError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+func (x *BatchAddGradesProgress) GetDone() bool {
+	if x != nil {
+		return x.Done
+	}
+	return false
+}
+
+// Request message for detecting cross-grader edits.
+type DetectGradeConflictsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Authentication token for authorization.
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// Identifier for the course.
+	CourseID string `protobuf:"bytes,2,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	// The academic semester.
+	Semester      string `protobuf:"bytes,3,opt,name=semester,proto3" json:"semester,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DetectGradeConflictsRequest) Reset() {
+	*x = DetectGradeConflictsRequest{}
+	mi := &file_grades_microservice_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DetectGradeConflictsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DetectGradeConflictsRequest) ProtoMessage() {}
+
+func (x *DetectGradeConflictsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_microservice_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DetectGradeConflictsRequest.ProtoReflect.Descriptor instead.
+func (*DetectGradeConflictsRequest) Descriptor() ([]byte, []int) {
+	return file_grades_microservice_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *DetectGradeConflictsRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *DetectGradeConflictsRequest) GetCourseID() string {
+	if x != nil {
+		return x.CourseID
+	}
+	return ""
+}
+
+func (x *DetectGradeConflictsRequest) GetSemester() string {
+	if x != nil {
+		return x.Semester
+	}
+	return ""
+}
+
+// A grade changed by a different grader than the one who originally graded it.
+type GradeConflict struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The current state of the grade.
+	Grade *SingleGrade `protobuf:"bytes,1,opt,name=grade,proto3" json:"grade,omitempty"`
+	// The grader of the first version.
+	OriginalGrader string `protobuf:"bytes,2,opt,name=original_grader,json=originalGrader,proto3" json:"original_grader,omitempty"`
+	// The most recent other grader to change the grade.
+	UpdatedBy string `protobuf:"bytes,3,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	// The version written by updated_by.
+	UpdatedVersion int64 `protobuf:"varint,4,opt,name=updated_version,json=updatedVersion,proto3" json:"updated_version,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GradeConflict) Reset() {
+	*x = GradeConflict{}
+	mi := &file_grades_microservice_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GradeConflict) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GradeConflict) ProtoMessage() {}
+
+func (x *GradeConflict) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_microservice_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GradeConflict.ProtoReflect.Descriptor instead.
+func (*GradeConflict) Descriptor() ([]byte, []int) {
+	return file_grades_microservice_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *GradeConflict) GetGrade() *SingleGrade {
+	if x != nil {
+		return x.Grade
+	}
+	return nil
+}
+
+func (x *GradeConflict) GetOriginalGrader() string {
+	if x != nil {
+		return x.OriginalGrader
+	}
+	return ""
+}
+
+func (x *GradeConflict) GetUpdatedBy() string {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return ""
+}
+
+func (x *GradeConflict) GetUpdatedVersion() int64 {
+	if x != nil {
+		return x.UpdatedVersion
+	}
+	return 0
+}
+
+// Response message containing the cross-grader edits.
+type DetectGradeConflictsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Conflicting grades, sorted by grade ID.
+	Conflicts     []*GradeConflict `protobuf:"bytes,1,rep,name=conflicts,proto3" json:"conflicts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DetectGradeConflictsResponse) Reset() {
+	*x = DetectGradeConflictsResponse{}
+	mi := &file_grades_microservice_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DetectGradeConflictsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DetectGradeConflictsResponse) ProtoMessage() {}
+
+func (x *DetectGradeConflictsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_microservice_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DetectGradeConflictsResponse.ProtoReflect.Descriptor instead.
+func (*DetectGradeConflictsResponse) Descriptor() ([]byte, []int) {
+	return file_grades_microservice_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *DetectGradeConflictsResponse) GetConflicts() []*GradeConflict {
+	if x != nil {
+		return x.Conflicts
+	}
+	return nil
+}
+
+// Request message for assigning a default grade to ungraded students.
+type AssignDefaultToUngradedRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Authentication token for authorization.
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// Identifier for the course.
+	CourseID string `protobuf:"bytes,2,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	// The academic semester.
+	Semester string `protobuf:"bytes,3,opt,name=semester,proto3" json:"semester,omitempty"`
+	// Identifier for the graded item.
+	ItemID string `protobuf:"bytes,4,opt,name=itemID,proto3" json:"itemID,omitempty"`
+	// Type of the grade, e.g., "Homework".
+	GradeType string `protobuf:"bytes,5,opt,name=grade_type,json=gradeType,proto3" json:"grade_type,omitempty"`
+	// The students enrolled in the course.
+	StudentIDs []string `protobuf:"bytes,6,rep,name=studentIDs,proto3" json:"studentIDs,omitempty"`
+	// The grade given to students without a grade for the item, e.g., "0".
+	DefaultValue string `protobuf:"bytes,7,opt,name=default_value,json=defaultValue,proto3" json:"default_value,omitempty"`
+	// The grader recorded on the default grades.
+	GradedBy      string `protobuf:"bytes,8,opt,name=graded_by,json=gradedBy,proto3" json:"graded_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AssignDefaultToUngradedRequest) Reset() {
+	*x = AssignDefaultToUngradedRequest{}
+	mi := &file_grades_microservice_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssignDefaultToUngradedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignDefaultToUngradedRequest) ProtoMessage() {}
+
+func (x *AssignDefaultToUngradedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_microservice_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignDefaultToUngradedRequest.ProtoReflect.Descriptor instead.
+func (*AssignDefaultToUngradedRequest) Descriptor() ([]byte, []int) {
+	return file_grades_microservice_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *AssignDefaultToUngradedRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *AssignDefaultToUngradedRequest) GetCourseID() string {
+	if x != nil {
+		return x.CourseID
+	}
+	return ""
+}
+
+func (x *AssignDefaultToUngradedRequest) GetSemester() string {
+	if x != nil {
+		return x.Semester
+	}
+	return ""
+}
+
+func (x *AssignDefaultToUngradedRequest) GetItemID() string {
+	if x != nil {
+		return x.ItemID
+	}
+	return ""
+}
+
+func (x *AssignDefaultToUngradedRequest) GetGradeType() string {
+	if x != nil {
+		return x.GradeType
+	}
+	return ""
+}
+
+func (x *AssignDefaultToUngradedRequest) GetStudentIDs() []string {
+	if x != nil {
+		return x.StudentIDs
+	}
+	return nil
+}
+
+func (x *AssignDefaultToUngradedRequest) GetDefaultValue() string {
+	if x != nil {
+		return x.DefaultValue
+	}
+	return ""
+}
+
+func (x *AssignDefaultToUngradedRequest) GetGradedBy() string {
+	if x != nil {
+		return x.GradedBy
+	}
+	return ""
+}
+
+// Response message for assigning a default grade to ungraded students.
+type AssignDefaultToUngradedResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Number of default grades added.
+	InsertedCount int32 `protobuf:"varint,1,opt,name=inserted_count,json=insertedCount,proto3" json:"inserted_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AssignDefaultToUngradedResponse) Reset() {
+	*x = AssignDefaultToUngradedResponse{}
+	mi := &file_grades_microservice_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssignDefaultToUngradedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignDefaultToUngradedResponse) ProtoMessage() {}
+
+func (x *AssignDefaultToUngradedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_microservice_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignDefaultToUngradedResponse.ProtoReflect.Descriptor instead.
+func (*AssignDefaultToUngradedResponse) Descriptor() ([]byte, []int) {
+	return file_grades_microservice_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *AssignDefaultToUngradedResponse) GetInsertedCount() int32 {
+	if x != nil {
+		return x.InsertedCount
+	}
+	return 0
+}
+
+// Request message for streaming the grades of a course.
+type StreamCourseGradesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Authentication token for authorization.
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// Identifier for the course.
+	CourseID string `protobuf:"bytes,2,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	// The academic semester.
+	Semester string `protobuf:"bytes,3,opt,name=semester,proto3" json:"semester,omitempty"`
+	// Locale, such as "de" or "en-US", whose labels fill display_value of the returned grades.
+	Locale        string `protobuf:"bytes,4,opt,name=locale,proto3" json:"locale,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamCourseGradesRequest) Reset() {
+	*x = StreamCourseGradesRequest{}
+	mi := &file_grades_microservice_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamCourseGradesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamCourseGradesRequest) ProtoMessage() {}
+
+func (x *StreamCourseGradesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_microservice_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamCourseGradesRequest.ProtoReflect.Descriptor instead.
+func (*StreamCourseGradesRequest) Descriptor() ([]byte, []int) {
+	return file_grades_microservice_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *StreamCourseGradesRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *StreamCourseGradesRequest) GetCourseID() string {
+	if x != nil {
+		return x.CourseID
+	}
+	return ""
+}
+
+func (x *StreamCourseGradesRequest) GetSemester() string {
+	if x != nil {
+		return x.Semester
+	}
+	return ""
+}
+
+func (x *StreamCourseGradesRequest) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+// Request message for the grading velocity of an item.
+type GetGradingVelocityRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Authentication token for authorization.
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// Identifier for the course.
+	CourseID string `protobuf:"bytes,2,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	// The academic semester.
+	Semester string `protobuf:"bytes,3,opt,name=semester,proto3" json:"semester,omitempty"`
+	// Identifier for the graded item.
+	ItemID string `protobuf:"bytes,4,opt,name=itemID,proto3" json:"itemID,omitempty"`
+	// Number of students who must be graded.
+	RosterSize int32 `protobuf:"varint,5,opt,name=roster_size,json=rosterSize,proto3" json:"roster_size,omitempty"`
+	// Grading deadline in RFC 3339 format; no deadline is checked when empty.
+	Deadline      string `protobuf:"bytes,6,opt,name=deadline,proto3" json:"deadline,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGradingVelocityRequest) Reset() {
+	*x = GetGradingVelocityRequest{}
+	mi := &file_grades_microservice_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGradingVelocityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGradingVelocityRequest) ProtoMessage() {}
+
+func (x *GetGradingVelocityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_microservice_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGradingVelocityRequest.ProtoReflect.Descriptor instead.
+func (*GetGradingVelocityRequest) Descriptor() ([]byte, []int) {
+	return file_grades_microservice_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *GetGradingVelocityRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *GetGradingVelocityRequest) GetCourseID() string {
+	if x != nil {
+		return x.CourseID
+	}
+	return ""
+}
+
+func (x *GetGradingVelocityRequest) GetSemester() string {
+	if x != nil {
+		return x.Semester
+	}
+	return ""
+}
+
+func (x *GetGradingVelocityRequest) GetItemID() string {
+	if x != nil {
+		return x.ItemID
+	}
+	return ""
+}
+
+func (x *GetGradingVelocityRequest) GetRosterSize() int32 {
+	if x != nil {
+		return x.RosterSize
+	}
+	return 0
+}
+
+func (x *GetGradingVelocityRequest) GetDeadline() string {
+	if x != nil {
+		return x.Deadline
+	}
+	return ""
+}
+
+// Number of students graded on a day.
+type DailyGradingCount struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The day, as YYYY-MM-DD in UTC.
+	Date string `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	// Number of students graded that day.
+	Count         int32 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DailyGradingCount) Reset() {
+	*x = DailyGradingCount{}
+	mi := &file_grades_microservice_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DailyGradingCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DailyGradingCount) ProtoMessage() {}
+
+func (x *DailyGradingCount) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_microservice_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DailyGradingCount.ProtoReflect.Descriptor instead.
+func (*DailyGradingCount) Descriptor() ([]byte, []int) {
+	return file_grades_microservice_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *DailyGradingCount) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *DailyGradingCount) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// Response message containing the grading velocity of an item.
+type GetGradingVelocityResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Average number of students graded per day over the last 7 days.
+	GradesPerDay float64 `protobuf:"fixed64,1,opt,name=grades_per_day,json=gradesPerDay,proto3" json:"grades_per_day,omitempty"`
+	// Number of students graded so far.
+	GradedCount int32 `protobuf:"varint,2,opt,name=graded_count,json=gradedCount,proto3" json:"graded_count,omitempty"`
+	// Number of students still to grade.
+	RemainingCount int32 `protobuf:"varint,3,opt,name=remaining_count,json=remainingCount,proto3" json:"remaining_count,omitempty"`
+	// Projected completion time in RFC 3339 format; empty when complete or stalled.
+	ProjectedCompletion string `protobuf:"bytes,4,opt,name=projected_completion,json=projectedCompletion,proto3" json:"projected_completion,omitempty"`
+	// "complete", "on track", "behind" or "stalled".
+	Status string `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	// True when the projection ends after the deadline, or grading is stalled with a deadline set.
+	MissesDeadline bool `protobuf:"varint,6,opt,name=misses_deadline,json=missesDeadline,proto3" json:"misses_deadline,omitempty"`
+	// Students graded per day, oldest first.
+	DailyCounts   []*DailyGradingCount `protobuf:"bytes,7,rep,name=daily_counts,json=dailyCounts,proto3" json:"daily_counts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGradingVelocityResponse) Reset() {
+	*x = GetGradingVelocityResponse{}
+	mi := &file_grades_microservice_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGradingVelocityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGradingVelocityResponse) ProtoMessage() {}
+
+func (x *GetGradingVelocityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_microservice_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGradingVelocityResponse.ProtoReflect.Descriptor instead.
+func (*GetGradingVelocityResponse) Descriptor() ([]byte, []int) {
+	return file_grades_microservice_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *GetGradingVelocityResponse) GetGradesPerDay() float64 {
+	if x != nil {
+		return x.GradesPerDay
+	}
+	return 0
+}
+
+func (x *GetGradingVelocityResponse) GetGradedCount() int32 {
+	if x != nil {
+		return x.GradedCount
+	}
+	return 0
+}
+
+func (x *GetGradingVelocityResponse) GetRemainingCount() int32 {
+	if x != nil {
+		return x.RemainingCount
+	}
+	return 0
+}
+
+func (x *GetGradingVelocityResponse) GetProjectedCompletion() string {
+	if x != nil {
+		return x.ProjectedCompletion
+	}
+	return ""
+}
+
+func (x *GetGradingVelocityResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *GetGradingVelocityResponse) GetMissesDeadline() bool {
+	if x != nil {
+		return x.MissesDeadline
+	}
+	return false
+}
+
+func (x *GetGradingVelocityResponse) GetDailyCounts() []*DailyGradingCount {
+	if x != nil {
+		return x.DailyCounts
+	}
+	return nil
+}
+
+// Request message for purging removed grades.
+type PurgeTombstonesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Authentication token for authorization.
+	Token         string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurgeTombstonesRequest) Reset() {
+	*x = PurgeTombstonesRequest{}
+	mi := &file_grades_microservice_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgeTombstonesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeTombstonesRequest) ProtoMessage() {}
+
+func (x *PurgeTombstonesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_microservice_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeTombstonesRequest.ProtoReflect.Descriptor instead.
+func (*PurgeTombstonesRequest) Descriptor() ([]byte, []int) {
+	return file_grades_microservice_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *PurgeTombstonesRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+// Response message for purging removed grades.
+type PurgeTombstonesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Number of removed grades deleted for good.
+	PurgedCount   int64 `protobuf:"varint,1,opt,name=purged_count,json=purgedCount,proto3" json:"purged_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurgeTombstonesResponse) Reset() {
+	*x = PurgeTombstonesResponse{}
+	mi := &file_grades_microservice_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgeTombstonesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeTombstonesResponse) ProtoMessage() {}
+
+func (x *PurgeTombstonesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_microservice_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeTombstonesResponse.ProtoReflect.Descriptor instead.
+func (*PurgeTombstonesResponse) Descriptor() ([]byte, []int) {
+	return file_grades_microservice_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *PurgeTombstonesResponse) GetPurgedCount() int64 {
+	if x != nil {
+		return x.PurgedCount
+	}
+	return 0
+}
+
+// The score of a grade on a single rubric criterion.
+type RubricScore struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Name of the criterion, unique within the grade.
+	Criterion string `protobuf:"bytes,1,opt,name=criterion,proto3" json:"criterion,omitempty"`
+	// Points awarded, between 0 and max.
+	Points float64 `protobuf:"fixed64,2,opt,name=points,proto3" json:"points,omitempty"`
+	// Maximum points of the criterion, positive.
+	Max           float64 `protobuf:"fixed64,3,opt,name=max,proto3" json:"max,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RubricScore) Reset() {
+	*x = RubricScore{}
+	mi := &file_grades_microservice_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RubricScore) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RubricScore) ProtoMessage() {}
+
+func (x *RubricScore) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_microservice_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RubricScore.ProtoReflect.Descriptor instead.
+func (*RubricScore) Descriptor() ([]byte, []int) {
+	return file_grades_microservice_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *RubricScore) GetCriterion() string {
+	if x != nil {
+		return x.Criterion
+	}
+	return ""
+}
+
+func (x *RubricScore) GetPoints() float64 {
+	if x != nil {
+		return x.Points
+	}
+	return 0
+}
+
+func (x *RubricScore) GetMax() float64 {
+	if x != nil {
+		return x.Max
+	}
+	return 0
+}
+
+// Request message for comparing a course between two semesters.
+type CompareCourseSemestersRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Authentication token for authorization.
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// Identifier for the course.
+	CourseID string `protobuf:"bytes,2,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	// The semester compared from.
+	SemesterA string `protobuf:"bytes,3,opt,name=semester_a,json=semesterA,proto3" json:"semester_a,omitempty"`
+	// The semester compared to.
+	SemesterB     string `protobuf:"bytes,4,opt,name=semester_b,json=semesterB,proto3" json:"semester_b,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompareCourseSemestersRequest) Reset() {
+	*x = CompareCourseSemestersRequest{}
+	mi := &file_grades_microservice_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompareCourseSemestersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompareCourseSemestersRequest) ProtoMessage() {}
+
+func (x *CompareCourseSemestersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_microservice_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompareCourseSemestersRequest.ProtoReflect.Descriptor instead.
+func (*CompareCourseSemestersRequest) Descriptor() ([]byte, []int) {
+	return file_grades_microservice_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *CompareCourseSemestersRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *CompareCourseSemestersRequest) GetCourseID() string {
+	if x != nil {
+		return x.CourseID
+	}
+	return ""
+}
+
+func (x *CompareCourseSemestersRequest) GetSemesterA() string {
+	if x != nil {
+		return x.SemesterA
+	}
+	return ""
+}
+
+func (x *CompareCourseSemestersRequest) GetSemesterB() string {
+	if x != nil {
+		return x.SemesterB
+	}
+	return ""
+}
+
+// The change of a student's average grade between two semesters.
+type StudentSemesterDelta struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Identifier for the student.
+	StudentID string `protobuf:"bytes,1,opt,name=studentID,proto3" json:"studentID,omitempty"`
+	// Average numeric grade in semester A.
+	AverageA float64 `protobuf:"fixed64,2,opt,name=average_a,json=averageA,proto3" json:"average_a,omitempty"`
+	// Average numeric grade in semester B.
+	AverageB float64 `protobuf:"fixed64,3,opt,name=average_b,json=averageB,proto3" json:"average_b,omitempty"`
+	// average_b - average_a; zero unless the student was graded in both semesters.
+	Delta float64 `protobuf:"fixed64,4,opt,name=delta,proto3" json:"delta,omitempty"`
+	// True when the student was graded only in semester A.
+	OnlyInA bool `protobuf:"varint,5,opt,name=only_in_a,json=onlyInA,proto3" json:"only_in_a,omitempty"`
+	// True when the student was graded only in semester B.
+	OnlyInB       bool `protobuf:"varint,6,opt,name=only_in_b,json=onlyInB,proto3" json:"only_in_b,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StudentSemesterDelta) Reset() {
+	*x = StudentSemesterDelta{}
+	mi := &file_grades_microservice_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StudentSemesterDelta) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StudentSemesterDelta) ProtoMessage() {}
+
+func (x *StudentSemesterDelta) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_microservice_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StudentSemesterDelta.ProtoReflect.Descriptor instead.
+func (*StudentSemesterDelta) Descriptor() ([]byte, []int) {
+	return file_grades_microservice_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *StudentSemesterDelta) GetStudentID() string {
+	if x != nil {
+		return x.StudentID
+	}
+	return ""
+}
+
+func (x *StudentSemesterDelta) GetAverageA() float64 {
+	if x != nil {
+		return x.AverageA
+	}
+	return 0
+}
+
+func (x *StudentSemesterDelta) GetAverageB() float64 {
+	if x != nil {
+		return x.AverageB
+	}
+	return 0
+}
+
+func (x *StudentSemesterDelta) GetDelta() float64 {
+	if x != nil {
+		return x.Delta
+	}
+	return 0
+}
+
+func (x *StudentSemesterDelta) GetOnlyInA() bool {
+	if x != nil {
+		return x.OnlyInA
+	}
+	return false
+}
+
+func (x *StudentSemesterDelta) GetOnlyInB() bool {
+	if x != nil {
+		return x.OnlyInB
+	}
+	return false
+}
+
+// Response message comparing a course between two semesters.
+type CompareCourseSemestersResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Per-student changes, ordered by student ID.
+	Students []*StudentSemesterDelta `protobuf:"bytes,1,rep,name=students,proto3" json:"students,omitempty"`
+	// Average of all numeric grades in semester A.
+	AverageA float64 `protobuf:"fixed64,2,opt,name=average_a,json=averageA,proto3" json:"average_a,omitempty"`
+	// Average of all numeric grades in semester B.
+	AverageB float64 `protobuf:"fixed64,3,opt,name=average_b,json=averageB,proto3" json:"average_b,omitempty"`
+	// average_b - average_a.
+	AverageDelta  float64 `protobuf:"fixed64,4,opt,name=average_delta,json=averageDelta,proto3" json:"average_delta,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompareCourseSemestersResponse) Reset() {
+	*x = CompareCourseSemestersResponse{}
+	mi := &file_grades_microservice_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompareCourseSemestersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompareCourseSemestersResponse) ProtoMessage() {}
+
+func (x *CompareCourseSemestersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_microservice_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompareCourseSemestersResponse.ProtoReflect.Descriptor instead.
+func (*CompareCourseSemestersResponse) Descriptor() ([]byte, []int) {
+	return file_grades_microservice_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *CompareCourseSemestersResponse) GetStudents() []*StudentSemesterDelta {
+	if x != nil {
+		return x.Students
+	}
+	return nil
+}
+
+func (x *CompareCourseSemestersResponse) GetAverageA() float64 {
+	if x != nil {
+		return x.AverageA
+	}
+	return 0
+}
+
+func (x *CompareCourseSemestersResponse) GetAverageB() float64 {
+	if x != nil {
+		return x.AverageB
+	}
+	return 0
+}
+
+func (x *CompareCourseSemestersResponse) GetAverageDelta() float64 {
+	if x != nil {
+		return x.AverageDelta
+	}
+	return 0
+}
+
+// Request message for the grade type summary of a semester.
+type GetSemesterGradeTypeSummaryRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Authentication token for authorization.
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// The academic semester.
+	Semester      string `protobuf:"bytes,2,opt,name=semester,proto3" json:"semester,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSemesterGradeTypeSummaryRequest) Reset() {
+	*x = GetSemesterGradeTypeSummaryRequest{}
+	mi := &file_grades_microservice_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSemesterGradeTypeSummaryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSemesterGradeTypeSummaryRequest) ProtoMessage() {}
+
+func (x *GetSemesterGradeTypeSummaryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_microservice_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSemesterGradeTypeSummaryRequest.ProtoReflect.Descriptor instead.
+func (*GetSemesterGradeTypeSummaryRequest) Descriptor() ([]byte, []int) {
+	return file_grades_microservice_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *GetSemesterGradeTypeSummaryRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *GetSemesterGradeTypeSummaryRequest) GetSemester() string {
+	if x != nil {
+		return x.Semester
+	}
+	return ""
+}
+
+// Count and average of the grades of one grade type.
+type GradeTypeSummary struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Type of the grades.
+	GradeType string `protobuf:"bytes,1,opt,name=grade_type,json=gradeType,proto3" json:"grade_type,omitempty"`
+	// Number of grades, numeric or not.
+	Count int64 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	// Number of numeric grades.
+	NumericCount int64 `protobuf:"varint,3,opt,name=numeric_count,json=numericCount,proto3" json:"numeric_count,omitempty"`
+	// Average of the numeric grades; zero when numeric_count is zero.
+	Average       float64 `protobuf:"fixed64,4,opt,name=average,proto3" json:"average,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GradeTypeSummary) Reset() {
+	*x = GradeTypeSummary{}
+	mi := &file_grades_microservice_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GradeTypeSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GradeTypeSummary) ProtoMessage() {}
+
+func (x *GradeTypeSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_microservice_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GradeTypeSummary.ProtoReflect.Descriptor instead.
+func (*GradeTypeSummary) Descriptor() ([]byte, []int) {
+	return file_grades_microservice_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *GradeTypeSummary) GetGradeType() string {
+	if x != nil {
+		return x.GradeType
+	}
+	return ""
+}
+
+func (x *GradeTypeSummary) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *GradeTypeSummary) GetNumericCount() int64 {
+	if x != nil {
+		return x.NumericCount
+	}
+	return 0
+}
+
+func (x *GradeTypeSummary) GetAverage() float64 {
+	if x != nil {
+		return x.Average
+	}
+	return 0
+}
+
+// Response message containing the grade type summary of a semester.
+type GetSemesterGradeTypeSummaryResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// One summary per grade type, ordered by grade type; empty when the semester has no grades.
+	Summaries     []*GradeTypeSummary `protobuf:"bytes,1,rep,name=summaries,proto3" json:"summaries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSemesterGradeTypeSummaryResponse) Reset() {
+	*x = GetSemesterGradeTypeSummaryResponse{}
+	mi := &file_grades_microservice_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSemesterGradeTypeSummaryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSemesterGradeTypeSummaryResponse) ProtoMessage() {}
+
+func (x *GetSemesterGradeTypeSummaryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_microservice_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSemesterGradeTypeSummaryResponse.ProtoReflect.Descriptor instead.
+func (*GetSemesterGradeTypeSummaryResponse) Descriptor() ([]byte, []int) {
+	return file_grades_microservice_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *GetSemesterGradeTypeSummaryResponse) GetSummaries() []*GradeTypeSummary {
+	if x != nil {
+		return x.Summaries
+	}
+	return nil
+}
+
+// Request message for opting a student out of grade change notifications.
+type SetNotificationOptOutRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Authentication token for authorization.
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// Identifier for the student.
+	StudentID string `protobuf:"bytes,2,opt,name=studentID,proto3" json:"studentID,omitempty"`
+	// True to stop notifications, false to resume them.
+	OptOut        bool `protobuf:"varint,3,opt,name=opt_out,json=optOut,proto3" json:"opt_out,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetNotificationOptOutRequest) Reset() {
+	*x = SetNotificationOptOutRequest{}
+	mi := &file_grades_microservice_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetNotificationOptOutRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetNotificationOptOutRequest) ProtoMessage() {}
+
+func (x *SetNotificationOptOutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_microservice_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetNotificationOptOutRequest.ProtoReflect.Descriptor instead.
+func (*SetNotificationOptOutRequest) Descriptor() ([]byte, []int) {
+	return file_grades_microservice_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *SetNotificationOptOutRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *SetNotificationOptOutRequest) GetStudentID() string {
+	if x != nil {
+		return x.StudentID
+	}
+	return ""
+}
+
+func (x *SetNotificationOptOutRequest) GetOptOut() bool {
+	if x != nil {
+		return x.OptOut
+	}
+	return false
+}
+
+// Response message after setting a notification opt-out.
+type SetNotificationOptOutResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetNotificationOptOutResponse) Reset() {
+	*x = SetNotificationOptOutResponse{}
+	mi := &file_grades_microservice_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetNotificationOptOutResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetNotificationOptOutResponse) ProtoMessage() {}
+
+func (x *SetNotificationOptOutResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_microservice_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetNotificationOptOutResponse.ProtoReflect.Descriptor instead.
+func (*SetNotificationOptOutResponse) Descriptor() ([]byte, []int) {
+	return file_grades_microservice_proto_rawDescGZIP(), []int{59}
+}
+
+// Request message for exporting the grades of a course as an Excel workbook.
+type ExportCourseGradesXLSXRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Authentication token for authorization.
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// Identifier for the course.
+	CourseID string `protobuf:"bytes,2,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	// Semester to export.
+	Semester      string `protobuf:"bytes,3,opt,name=semester,proto3" json:"semester,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportCourseGradesXLSXRequest) Reset() {
+	*x = ExportCourseGradesXLSXRequest{}
+	mi := &file_grades_microservice_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportCourseGradesXLSXRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportCourseGradesXLSXRequest) ProtoMessage() {}
+
+func (x *ExportCourseGradesXLSXRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_microservice_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportCourseGradesXLSXRequest.ProtoReflect.Descriptor instead.
+func (*ExportCourseGradesXLSXRequest) Descriptor() ([]byte, []int) {
+	return file_grades_microservice_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *ExportCourseGradesXLSXRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *ExportCourseGradesXLSXRequest) GetCourseID() string {
+	if x != nil {
+		return x.CourseID
+	}
+	return ""
+}
+
+func (x *ExportCourseGradesXLSXRequest) GetSemester() string {
+	if x != nil {
+		return x.Semester
+	}
+	return ""
+}
+
+// Response message containing the exported workbook.
+type ExportCourseGradesXLSXResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The xlsx workbook.
+	Workbook []byte `protobuf:"bytes,1,opt,name=workbook,proto3" json:"workbook,omitempty"`
+	// Suggested file name for the workbook.
+	Filename      string `protobuf:"bytes,2,opt,name=filename,proto3" json:"filename,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportCourseGradesXLSXResponse) Reset() {
+	*x = ExportCourseGradesXLSXResponse{}
+	mi := &file_grades_microservice_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportCourseGradesXLSXResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportCourseGradesXLSXResponse) ProtoMessage() {}
+
+func (x *ExportCourseGradesXLSXResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_microservice_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportCourseGradesXLSXResponse.ProtoReflect.Descriptor instead.
+func (*ExportCourseGradesXLSXResponse) Descriptor() ([]byte, []int) {
+	return file_grades_microservice_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *ExportCourseGradesXLSXResponse) GetWorkbook() []byte {
+	if x != nil {
+		return x.Workbook
+	}
+	return nil
+}
+
+func (x *ExportCourseGradesXLSXResponse) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+// Request message for retrieving the grades of a student in an academic year.
+type GetStudentYearGradesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Authentication token for authorization.
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// Identifier for the student.
+	StudentID string `protobuf:"bytes,2,opt,name=studentID,proto3" json:"studentID,omitempty"`
+	// Calendar year the academic year starts in, e.g. 2024 for the 2024/25 academic year.
+	AcademicYear  int32 `protobuf:"varint,3,opt,name=academic_year,json=academicYear,proto3" json:"academic_year,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStudentYearGradesRequest) Reset() {
+	*x = GetStudentYearGradesRequest{}
+	mi := &file_grades_microservice_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStudentYearGradesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStudentYearGradesRequest) ProtoMessage() {}
+
+func (x *GetStudentYearGradesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_microservice_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStudentYearGradesRequest.ProtoReflect.Descriptor instead.
+func (*GetStudentYearGradesRequest) Descriptor() ([]byte, []int) {
+	return file_grades_microservice_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *GetStudentYearGradesRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *GetStudentYearGradesRequest) GetStudentID() string {
+	if x != nil {
+		return x.StudentID
+	}
+	return ""
+}
+
+func (x *GetStudentYearGradesRequest) GetAcademicYear() int32 {
+	if x != nil {
+		return x.AcademicYear
+	}
+	return 0
+}
+
+// Response message containing the grades of a student in an academic year.
+type GetStudentYearGradesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Grades of each semester of the academic year, in calendar order.
+	Semesters     []*SemesterGrades `protobuf:"bytes,1,rep,name=semesters,proto3" json:"semesters,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStudentYearGradesResponse) Reset() {
+	*x = GetStudentYearGradesResponse{}
+	mi := &file_grades_microservice_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStudentYearGradesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStudentYearGradesResponse) ProtoMessage() {}
+
+func (x *GetStudentYearGradesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_microservice_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStudentYearGradesResponse.ProtoReflect.Descriptor instead.
+func (*GetStudentYearGradesResponse) Descriptor() ([]byte, []int) {
+	return file_grades_microservice_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *GetStudentYearGradesResponse) GetSemesters() []*SemesterGrades {
+	if x != nil {
+		return x.Semesters
+	}
+	return nil
+}
+
+// Request message for retrieving only the value of a grade. The grade is identified by grade_id
+// or, when it is empty, by student_id, course_id, semester and item_id.
+type GetGradeValueRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Authentication token for authorization.
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// Unique identifier for the grade entry.
+	GradeID string `protobuf:"bytes,2,opt,name=gradeID,proto3" json:"gradeID,omitempty"`
+	// Identifier for the student.
+	StudentID string `protobuf:"bytes,3,opt,name=studentID,proto3" json:"studentID,omitempty"`
+	// Identifier for the course.
+	CourseID string `protobuf:"bytes,4,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	// The academic semester.
+	Semester string `protobuf:"bytes,5,opt,name=semester,proto3" json:"semester,omitempty"`
+	// Identifier for the graded item.
+	ItemID string `protobuf:"bytes,6,opt,name=itemID,proto3" json:"itemID,omitempty"`
+	// Read consistency, "eventual" or "strong". Eventual reads may be served by a read replica that
+	// lags behind the latest writes; strong reads always go to the primary database, at a latency
+	// cost for distant clients. Defaults to the server's READ_CONSISTENCY, itself eventual unless set.
+	Consistency   string `protobuf:"bytes,7,opt,name=consistency,proto3" json:"consistency,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGradeValueRequest) Reset() {
+	*x = GetGradeValueRequest{}
+	mi := &file_grades_microservice_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGradeValueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGradeValueRequest) ProtoMessage() {}
+
+func (x *GetGradeValueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_microservice_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGradeValueRequest.ProtoReflect.Descriptor instead.
+func (*GetGradeValueRequest) Descriptor() ([]byte, []int) {
+	return file_grades_microservice_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *GetGradeValueRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *GetGradeValueRequest) GetGradeID() string {
+	if x != nil {
+		return x.GradeID
+	}
+	return ""
+}
+
+func (x *GetGradeValueRequest) GetStudentID() string {
+	if x != nil {
+		return x.StudentID
+	}
+	return ""
+}
+
+func (x *GetGradeValueRequest) GetCourseID() string {
+	if x != nil {
+		return x.CourseID
+	}
+	return ""
+}
+
+func (x *GetGradeValueRequest) GetSemester() string {
+	if x != nil {
+		return x.Semester
+	}
+	return ""
+}
+
+func (x *GetGradeValueRequest) GetItemID() string {
+	if x != nil {
+		return x.ItemID
+	}
+	return ""
+}
+
+func (x *GetGradeValueRequest) GetConsistency() string {
+	if x != nil {
+		return x.Consistency
+	}
+	return ""
+}
+
+// Response message containing only the value of a grade.
+type GetGradeValueResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The value or score of the grade.
+	GradeValue    string `protobuf:"bytes,1,opt,name=grade_value,json=gradeValue,proto3" json:"grade_value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGradeValueResponse) Reset() {
+	*x = GetGradeValueResponse{}
+	mi := &file_grades_microservice_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGradeValueResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGradeValueResponse) ProtoMessage() {}
+
+func (x *GetGradeValueResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_microservice_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGradeValueResponse.ProtoReflect.Descriptor instead.
+func (*GetGradeValueResponse) Descriptor() ([]byte, []int) {
+	return file_grades_microservice_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *GetGradeValueResponse) GetGradeValue() string {
+	if x != nil {
+		return x.GradeValue
+	}
+	return ""
+}
+
+// Request message for allowing a grader to grade a course.
+type AddCourseGraderRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Authentication token for authorization.
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// Identifier for the course.
+	CourseID string `protobuf:"bytes,2,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	// Identifier of the grader, as named by the subject of their token.
+	GraderID      string `protobuf:"bytes,3,opt,name=graderID,proto3" json:"graderID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddCourseGraderRequest) Reset() {
+	*x = AddCourseGraderRequest{}
+	mi := &file_grades_microservice_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddCourseGraderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddCourseGraderRequest) ProtoMessage() {}
+
+func (x *AddCourseGraderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_microservice_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddCourseGraderRequest.ProtoReflect.Descriptor instead.
+func (*AddCourseGraderRequest) Descriptor() ([]byte, []int) {
+	return file_grades_microservice_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *AddCourseGraderRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *AddCourseGraderRequest) GetCourseID() string {
+	if x != nil {
+		return x.CourseID
+	}
+	return ""
+}
+
+func (x *AddCourseGraderRequest) GetGraderID() string {
+	if x != nil {
+		return x.GraderID
+	}
+	return ""
+}
+
+// Response message after allowing a grader to grade a course.
+type AddCourseGraderResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddCourseGraderResponse) Reset() {
+	*x = AddCourseGraderResponse{}
+	mi := &file_grades_microservice_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddCourseGraderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddCourseGraderResponse) ProtoMessage() {}
+
+func (x *AddCourseGraderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_microservice_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddCourseGraderResponse.ProtoReflect.Descriptor instead.
+func (*AddCourseGraderResponse) Descriptor() ([]byte, []int) {
+	return file_grades_microservice_proto_rawDescGZIP(), []int{67}
+}
+
+// Request message for removing a grader from the graders of a course.
+type RemoveCourseGraderRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Authentication token for authorization.
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// Identifier for the course.
+	CourseID string `protobuf:"bytes,2,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	// Identifier of the grader.
+	GraderID      string `protobuf:"bytes,3,opt,name=graderID,proto3" json:"graderID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveCourseGraderRequest) Reset() {
+	*x = RemoveCourseGraderRequest{}
+	mi := &file_grades_microservice_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveCourseGraderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveCourseGraderRequest) ProtoMessage() {}
+
+func (x *RemoveCourseGraderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_microservice_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveCourseGraderRequest.ProtoReflect.Descriptor instead.
+func (*RemoveCourseGraderRequest) Descriptor() ([]byte, []int) {
+	return file_grades_microservice_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *RemoveCourseGraderRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *RemoveCourseGraderRequest) GetCourseID() string {
+	if x != nil {
+		return x.CourseID
+	}
+	return ""
+}
+
+func (x *RemoveCourseGraderRequest) GetGraderID() string {
+	if x != nil {
+		return x.GraderID
+	}
+	return ""
+}
+
+// Response message after removing a grader from the graders of a course.
+type RemoveCourseGraderResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveCourseGraderResponse) Reset() {
+	*x = RemoveCourseGraderResponse{}
+	mi := &file_grades_microservice_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveCourseGraderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveCourseGraderResponse) ProtoMessage() {}
+
+func (x *RemoveCourseGraderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_microservice_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveCourseGraderResponse.ProtoReflect.Descriptor instead.
+func (*RemoveCourseGraderResponse) Descriptor() ([]byte, []int) {
+	return file_grades_microservice_proto_rawDescGZIP(), []int{69}
+}
+
+// Request message for listing the graders of a course.
+type GetCourseGradersRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Authentication token for authorization.
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// Identifier for the course.
+	CourseID      string `protobuf:"bytes,2,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCourseGradersRequest) Reset() {
+	*x = GetCourseGradersRequest{}
+	mi := &file_grades_microservice_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCourseGradersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCourseGradersRequest) ProtoMessage() {}
+
+func (x *GetCourseGradersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_microservice_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCourseGradersRequest.ProtoReflect.Descriptor instead.
+func (*GetCourseGradersRequest) Descriptor() ([]byte, []int) {
+	return file_grades_microservice_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *GetCourseGradersRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *GetCourseGradersRequest) GetCourseID() string {
+	if x != nil {
+		return x.CourseID
+	}
+	return ""
+}
+
+// Response message containing the graders of a course.
+type GetCourseGradersResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Identifiers of the graders allowed to grade the course, empty when anyone may.
+	GraderIDs     []string `protobuf:"bytes,1,rep,name=graderIDs,proto3" json:"graderIDs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCourseGradersResponse) Reset() {
+	*x = GetCourseGradersResponse{}
+	mi := &file_grades_microservice_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCourseGradersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCourseGradersResponse) ProtoMessage() {}
+
+func (x *GetCourseGradersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_microservice_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCourseGradersResponse.ProtoReflect.Descriptor instead.
+func (*GetCourseGradersResponse) Descriptor() ([]byte, []int) {
+	return file_grades_microservice_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *GetCourseGradersResponse) GetGraderIDs() []string {
+	if x != nil {
+		return x.GraderIDs
+	}
+	return nil
+}
+
+// Request message for retrieving the statistics of a course.
+type GetCourseStatsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Authentication token for authorization.
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// Identifier for the course.
+	CourseID string `protobuf:"bytes,2,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	// The academic semester.
+	Semester      string `protobuf:"bytes,3,opt,name=semester,proto3" json:"semester,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCourseStatsRequest) Reset() {
+	*x = GetCourseStatsRequest{}
+	mi := &file_grades_microservice_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCourseStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCourseStatsRequest) ProtoMessage() {}
+
+func (x *GetCourseStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_microservice_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCourseStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetCourseStatsRequest) Descriptor() ([]byte, []int) {
+	return file_grades_microservice_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *GetCourseStatsRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *GetCourseStatsRequest) GetCourseID() string {
+	if x != nil {
+		return x.CourseID
+	}
+	return ""
+}
+
+func (x *GetCourseStatsRequest) GetSemester() string {
+	if x != nil {
+		return x.Semester
+	}
+	return ""
+}
+
+// Response message containing the statistics of a course.
+type GetCourseStatsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Number of grades, numeric or not.
+	Count int64 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	// Number of numeric grades.
+	NumericCount int64 `protobuf:"varint,2,opt,name=numeric_count,json=numericCount,proto3" json:"numeric_count,omitempty"`
+	// Average numeric grade, zero when there is none.
+	Average float64 `protobuf:"fixed64,3,opt,name=average,proto3" json:"average,omitempty"`
+	// Lowest numeric grade, zero when there is none.
+	Minimum float64 `protobuf:"fixed64,4,opt,name=minimum,proto3" json:"minimum,omitempty"`
+	// Highest numeric grade, zero when there is none.
+	Maximum float64 `protobuf:"fixed64,5,opt,name=maximum,proto3" json:"maximum,omitempty"`
+	// When the statistics were computed, in RFC 3339 format.
+	RefreshedAt string `protobuf:"bytes,6,opt,name=refreshed_at,json=refreshedAt,proto3" json:"refreshed_at,omitempty"`
+	// True when the statistics were computed live because the refreshed ones were stale.
+	Live          bool `protobuf:"varint,7,opt,name=live,proto3" json:"live,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCourseStatsResponse) Reset() {
+	*x = GetCourseStatsResponse{}
+	mi := &file_grades_microservice_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCourseStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCourseStatsResponse) ProtoMessage() {}
+
+func (x *GetCourseStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_microservice_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCourseStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetCourseStatsResponse) Descriptor() ([]byte, []int) {
+	return file_grades_microservice_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *GetCourseStatsResponse) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *GetCourseStatsResponse) GetNumericCount() int64 {
+	if x != nil {
+		return x.NumericCount
+	}
+	return 0
+}
+
+func (x *GetCourseStatsResponse) GetAverage() float64 {
+	if x != nil {
+		return x.Average
+	}
+	return 0
+}
+
+func (x *GetCourseStatsResponse) GetMinimum() float64 {
+	if x != nil {
+		return x.Minimum
+	}
+	return 0
+}
+
+func (x *GetCourseStatsResponse) GetMaximum() float64 {
+	if x != nil {
+		return x.Maximum
+	}
+	return 0
+}
+
+func (x *GetCourseStatsResponse) GetRefreshedAt() string {
+	if x != nil {
+		return x.RefreshedAt
+	}
+	return ""
+}
+
+func (x *GetCourseStatsResponse) GetLive() bool {
+	if x != nil {
+		return x.Live
+	}
+	return false
+}
+
+// Request message for refreshing the statistics of every course.
+type RefreshCourseStatsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Authentication token for authorization.
+	Token         string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefreshCourseStatsRequest) Reset() {
+	*x = RefreshCourseStatsRequest{}
+	mi := &file_grades_microservice_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefreshCourseStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshCourseStatsRequest) ProtoMessage() {}
+
+func (x *RefreshCourseStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_microservice_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshCourseStatsRequest.ProtoReflect.Descriptor instead.
+func (*RefreshCourseStatsRequest) Descriptor() ([]byte, []int) {
+	return file_grades_microservice_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *RefreshCourseStatsRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+// Response message after refreshing the course statistics.
+type RefreshCourseStatsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefreshCourseStatsResponse) Reset() {
+	*x = RefreshCourseStatsResponse{}
+	mi := &file_grades_microservice_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefreshCourseStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshCourseStatsResponse) ProtoMessage() {}
+
+func (x *RefreshCourseStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_microservice_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshCourseStatsResponse.ProtoReflect.Descriptor instead.
+func (*RefreshCourseStatsResponse) Descriptor() ([]byte, []int) {
+	return file_grades_microservice_proto_rawDescGZIP(), []int{75}
+}
+
+// Request message for exporting the grades of a semester to the export bucket.
+type ExportSemesterToBucketRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Authentication token for authorization.
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// The academic semester to export.
+	Semester string `protobuf:"bytes,2,opt,name=semester,proto3" json:"semester,omitempty"`
+	// Either "csv" or "jsonl"; the server's default format when empty.
+	Format        string `protobuf:"bytes,3,opt,name=format,proto3" json:"format,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportSemesterToBucketRequest) Reset() {
+	*x = ExportSemesterToBucketRequest{}
+	mi := &file_grades_microservice_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportSemesterToBucketRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportSemesterToBucketRequest) ProtoMessage() {}
+
+func (x *ExportSemesterToBucketRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_microservice_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportSemesterToBucketRequest.ProtoReflect.Descriptor instead.
+func (*ExportSemesterToBucketRequest) Descriptor() ([]byte, []int) {
+	return file_grades_microservice_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *ExportSemesterToBucketRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *ExportSemesterToBucketRequest) GetSemester() string {
+	if x != nil {
+		return x.Semester
+	}
+	return ""
+}
+
+func (x *ExportSemesterToBucketRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+// Response message after exporting a semester.
+type ExportSemesterToBucketResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The bucket the export was uploaded to.
+	Bucket string `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	// The key of the uploaded object.
+	ObjectKey string `protobuf:"bytes,2,opt,name=object_key,json=objectKey,proto3" json:"object_key,omitempty"`
+	// Number of grades exported.
+	GradeCount    int64 `protobuf:"varint,3,opt,name=grade_count,json=gradeCount,proto3" json:"grade_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportSemesterToBucketResponse) Reset() {
+	*x = ExportSemesterToBucketResponse{}
+	mi := &file_grades_microservice_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportSemesterToBucketResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportSemesterToBucketResponse) ProtoMessage() {}
+
+func (x *ExportSemesterToBucketResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_microservice_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportSemesterToBucketResponse.ProtoReflect.Descriptor instead.
+func (*ExportSemesterToBucketResponse) Descriptor() ([]byte, []int) {
+	return file_grades_microservice_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *ExportSemesterToBucketResponse) GetBucket() string {
+	if x != nil {
+		return x.Bucket
+	}
+	return ""
+}
+
+func (x *ExportSemesterToBucketResponse) GetObjectKey() string {
+	if x != nil {
+		return x.ObjectKey
+	}
+	return ""
+}
+
+func (x *ExportSemesterToBucketResponse) GetGradeCount() int64 {
+	if x != nil {
+		return x.GradeCount
+	}
+	return 0
+}
+
+// A hypothetical change to a student's grades.
+type GradeOverride struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The grade whose value changes; when empty, a hypothetical new grade is added instead.
+	GradeID string `protobuf:"bytes,1,opt,name=gradeID,proto3" json:"gradeID,omitempty"`
+	// Course of a new hypothetical grade.
+	CourseID string `protobuf:"bytes,2,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	// Semester of a new hypothetical grade.
+	Semester string `protobuf:"bytes,3,opt,name=semester,proto3" json:"semester,omitempty"`
+	// Type of a new hypothetical grade, matched against the course component weights.
+	GradeType string `protobuf:"bytes,4,opt,name=grade_type,json=gradeType,proto3" json:"grade_type,omitempty"`
+	// The hypothetical grade value.
+	GradeValue    string `protobuf:"bytes,5,opt,name=grade_value,json=gradeValue,proto3" json:"grade_value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GradeOverride) Reset() {
+	*x = GradeOverride{}
+	mi := &file_grades_microservice_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GradeOverride) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GradeOverride) ProtoMessage() {}
+
+func (x *GradeOverride) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_microservice_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GradeOverride.ProtoReflect.Descriptor instead.
+func (*GradeOverride) Descriptor() ([]byte, []int) {
+	return file_grades_microservice_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *GradeOverride) GetGradeID() string {
+	if x != nil {
+		return x.GradeID
+	}
+	return ""
+}
+
+func (x *GradeOverride) GetCourseID() string {
+	if x != nil {
+		return x.CourseID
+	}
+	return ""
+}
+
+func (x *GradeOverride) GetSemester() string {
+	if x != nil {
+		return x.Semester
+	}
+	return ""
+}
+
+func (x *GradeOverride) GetGradeType() string {
+	if x != nil {
+		return x.GradeType
+	}
+	return ""
+}
+
+func (x *GradeOverride) GetGradeValue() string {
+	if x != nil {
+		return x.GradeValue
+	}
+	return ""
+}
+
+// Request message for simulating changes to a student's grades.
+type SimulateGradeChangeRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Authentication token for authorization.
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// Identifier for the student.
+	StudentID string `protobuf:"bytes,2,opt,name=studentID,proto3" json:"studentID,omitempty"`
+	// The semester whose GPA is reported.
+	Semester string `protobuf:"bytes,3,opt,name=semester,proto3" json:"semester,omitempty"`
+	// The hypothetical changes to apply.
+	Overrides     []*GradeOverride `protobuf:"bytes,4,rep,name=overrides,proto3" json:"overrides,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SimulateGradeChangeRequest) Reset() {
+	*x = SimulateGradeChangeRequest{}
+	mi := &file_grades_microservice_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SimulateGradeChangeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimulateGradeChangeRequest) ProtoMessage() {}
+
+func (x *SimulateGradeChangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_microservice_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SimulateGradeChangeRequest.ProtoReflect.Descriptor instead.
+func (*SimulateGradeChangeRequest) Descriptor() ([]byte, []int) {
+	return file_grades_microservice_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *SimulateGradeChangeRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *SimulateGradeChangeRequest) GetStudentID() string {
+	if x != nil {
+		return x.StudentID
+	}
+	return ""
+}
+
+func (x *SimulateGradeChangeRequest) GetSemester() string {
+	if x != nil {
+		return x.Semester
+	}
+	return ""
+}
+
+func (x *SimulateGradeChangeRequest) GetOverrides() []*GradeOverride {
+	if x != nil {
+		return x.Overrides
+	}
+	return nil
+}
+
+// Response message with the GPA of a student with and without the hypothetical changes.
+type SimulateGradeChangeResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Semester GPA with the changes applied.
+	SemesterGpa float64 `protobuf:"fixed64,1,opt,name=semester_gpa,json=semesterGpa,proto3" json:"semester_gpa,omitempty"`
+	// Cumulative GPA over all semesters with the changes applied.
+	CumulativeGpa float64 `protobuf:"fixed64,2,opt,name=cumulative_gpa,json=cumulativeGpa,proto3" json:"cumulative_gpa,omitempty"`
+	// Semester GPA of the stored grades.
+	CurrentSemesterGpa float64 `protobuf:"fixed64,3,opt,name=current_semester_gpa,json=currentSemesterGpa,proto3" json:"current_semester_gpa,omitempty"`
+	// Cumulative GPA of the stored grades.
+	CurrentCumulativeGpa float64 `protobuf:"fixed64,4,opt,name=current_cumulative_gpa,json=currentCumulativeGpa,proto3" json:"current_cumulative_gpa,omitempty"`
+	// Number of courses in the simulated semester GPA.
+	SemesterCourseCount int32 `protobuf:"varint,5,opt,name=semester_course_count,json=semesterCourseCount,proto3" json:"semester_course_count,omitempty"`
+	// Number of courses in the simulated cumulative GPA.
+	CumulativeCourseCount int32 `protobuf:"varint,6,opt,name=cumulative_course_count,json=cumulativeCourseCount,proto3" json:"cumulative_course_count,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *SimulateGradeChangeResponse) Reset() {
+	*x = SimulateGradeChangeResponse{}
+	mi := &file_grades_microservice_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SimulateGradeChangeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimulateGradeChangeResponse) ProtoMessage() {}
+
+func (x *SimulateGradeChangeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_microservice_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SimulateGradeChangeResponse.ProtoReflect.Descriptor instead.
+func (*SimulateGradeChangeResponse) Descriptor() ([]byte, []int) {
+	return file_grades_microservice_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *SimulateGradeChangeResponse) GetSemesterGpa() float64 {
+	if x != nil {
+		return x.SemesterGpa
+	}
+	return 0
+}
+
+func (x *SimulateGradeChangeResponse) GetCumulativeGpa() float64 {
+	if x != nil {
+		return x.CumulativeGpa
+	}
+	return 0
+}
+
+func (x *SimulateGradeChangeResponse) GetCurrentSemesterGpa() float64 {
+	if x != nil {
+		return x.CurrentSemesterGpa
+	}
+	return 0
+}
+
+func (x *SimulateGradeChangeResponse) GetCurrentCumulativeGpa() float64 {
+	if x != nil {
+		return x.CurrentCumulativeGpa
+	}
+	return 0
+}
+
+func (x *SimulateGradeChangeResponse) GetSemesterCourseCount() int32 {
+	if x != nil {
+		return x.SemesterCourseCount
+	}
+	return 0
+}
+
+func (x *SimulateGradeChangeResponse) GetCumulativeCourseCount() int32 {
+	if x != nil {
+		return x.CumulativeCourseCount
+	}
+	return 0
+}
+
+// Request message for setting when the grades of a course offering are released to students.
+type SetCourseReleaseTimeRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Authentication token for authorization.
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// Identifier for the course.
+	CourseID string `protobuf:"bytes,2,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	// The academic semester.
+	Semester string `protobuf:"bytes,3,opt,name=semester,proto3" json:"semester,omitempty"`
+	// Release time in RFC 3339 format; empty releases the grades immediately.
+	ReleaseAt     string `protobuf:"bytes,4,opt,name=release_at,json=releaseAt,proto3" json:"release_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetCourseReleaseTimeRequest) Reset() {
+	*x = SetCourseReleaseTimeRequest{}
+	mi := &file_grades_microservice_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetCourseReleaseTimeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetCourseReleaseTimeRequest) ProtoMessage() {}
+
+func (x *SetCourseReleaseTimeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_microservice_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetCourseReleaseTimeRequest.ProtoReflect.Descriptor instead.
+func (*SetCourseReleaseTimeRequest) Descriptor() ([]byte, []int) {
+	return file_grades_microservice_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *SetCourseReleaseTimeRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *SetCourseReleaseTimeRequest) GetCourseID() string {
+	if x != nil {
+		return x.CourseID
+	}
+	return ""
+}
+
+func (x *SetCourseReleaseTimeRequest) GetSemester() string {
+	if x != nil {
+		return x.Semester
+	}
+	return ""
+}
+
+func (x *SetCourseReleaseTimeRequest) GetReleaseAt() string {
+	if x != nil {
+		return x.ReleaseAt
+	}
+	return ""
+}
+
+// Response message after setting a course release time.
+type SetCourseReleaseTimeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetCourseReleaseTimeResponse) Reset() {
+	*x = SetCourseReleaseTimeResponse{}
+	mi := &file_grades_microservice_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetCourseReleaseTimeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetCourseReleaseTimeResponse) ProtoMessage() {}
+
+func (x *SetCourseReleaseTimeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_microservice_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetCourseReleaseTimeResponse.ProtoReflect.Descriptor instead.
+func (*SetCourseReleaseTimeResponse) Descriptor() ([]byte, []int) {
+	return file_grades_microservice_proto_rawDescGZIP(), []int{82}
+}
+
+// Request message for the top students of a semester.
+type GetSemesterLeaderboardRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Authentication token for authorization.
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// The academic semester.
+	Semester string `protobuf:"bytes,2,opt,name=semester,proto3" json:"semester,omitempty"`
+	// Number of students to return, capped by the server; the cap when zero.
+	Limit         int32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSemesterLeaderboardRequest) Reset() {
+	*x = GetSemesterLeaderboardRequest{}
+	mi := &file_grades_microservice_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSemesterLeaderboardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSemesterLeaderboardRequest) ProtoMessage() {}
+
+func (x *GetSemesterLeaderboardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_microservice_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSemesterLeaderboardRequest.ProtoReflect.Descriptor instead.
+func (*GetSemesterLeaderboardRequest) Descriptor() ([]byte, []int) {
+	return file_grades_microservice_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *GetSemesterLeaderboardRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *GetSemesterLeaderboardRequest) GetSemester() string {
+	if x != nil {
+		return x.Semester
+	}
+	return ""
+}
+
+func (x *GetSemesterLeaderboardRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// A student's standing in a semester leaderboard.
+type LeaderboardEntry struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Position in the leaderboard, starting at 1. Tied students get consecutive ranks.
+	Rank int32 `protobuf:"varint,1,opt,name=rank,proto3" json:"rank,omitempty"`
+	// Identifier for the student.
+	StudentID string `protobuf:"bytes,2,opt,name=studentID,proto3" json:"studentID,omitempty"`
+	// The student's GPA in the semester.
+	Gpa float64 `protobuf:"fixed64,3,opt,name=gpa,proto3" json:"gpa,omitempty"`
+	// Number of courses the GPA covers.
+	CourseCount   int32 `protobuf:"varint,4,opt,name=course_count,json=courseCount,proto3" json:"course_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LeaderboardEntry) Reset() {
+	*x = LeaderboardEntry{}
+	mi := &file_grades_microservice_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeaderboardEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaderboardEntry) ProtoMessage() {}
+
+func (x *LeaderboardEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_microservice_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaderboardEntry.ProtoReflect.Descriptor instead.
+func (*LeaderboardEntry) Descriptor() ([]byte, []int) {
+	return file_grades_microservice_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *LeaderboardEntry) GetRank() int32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *LeaderboardEntry) GetStudentID() string {
+	if x != nil {
+		return x.StudentID
+	}
+	return ""
+}
+
+func (x *LeaderboardEntry) GetGpa() float64 {
+	if x != nil {
+		return x.Gpa
+	}
+	return 0
+}
+
+func (x *LeaderboardEntry) GetCourseCount() int32 {
+	if x != nil {
+		return x.CourseCount
+	}
+	return 0
+}
+
+// Response message with the top students of a semester, best first.
+type GetSemesterLeaderboardResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ranked students.
+	Entries       []*LeaderboardEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSemesterLeaderboardResponse) Reset() {
+	*x = GetSemesterLeaderboardResponse{}
+	mi := &file_grades_microservice_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSemesterLeaderboardResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSemesterLeaderboardResponse) ProtoMessage() {}
+
+func (x *GetSemesterLeaderboardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_microservice_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSemesterLeaderboardResponse.ProtoReflect.Descriptor instead.
+func (*GetSemesterLeaderboardResponse) Descriptor() ([]byte, []int) {
+	return file_grades_microservice_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *GetSemesterLeaderboardResponse) GetEntries() []*LeaderboardEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+// Request message for a grade in a chosen encoding.
+type GetGradeFlexibleRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Authentication token for authorization.
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// Identifier for the grade entry.
+	GradeID string `protobuf:"bytes,2,opt,name=gradeID,proto3" json:"gradeID,omitempty"`
+	// The version to retrieve, or zero for the latest version.
+	Version int64 `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	// The encoding: proto, json or compact; proto when empty.
+	Format        string `protobuf:"bytes,4,opt,name=format,proto3" json:"format,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGradeFlexibleRequest) Reset() {
+	*x = GetGradeFlexibleRequest{}
+	mi := &file_grades_microservice_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGradeFlexibleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGradeFlexibleRequest) ProtoMessage() {}
+
+func (x *GetGradeFlexibleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_microservice_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGradeFlexibleRequest.ProtoReflect.Descriptor instead.
+func (*GetGradeFlexibleRequest) Descriptor() ([]byte, []int) {
+	return file_grades_microservice_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *GetGradeFlexibleRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *GetGradeFlexibleRequest) GetGradeID() string {
+	if x != nil {
+		return x.GradeID
+	}
+	return ""
+}
+
+func (x *GetGradeFlexibleRequest) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *GetGradeFlexibleRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+// Response message containing an encoded grade.
+type GetGradeFlexibleResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The grade serialized in the encoding.
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	// The encoding of data.
+	Format string `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"`
+	// The media type of data, such as application/json.
+	ContentType   string `protobuf:"bytes,3,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGradeFlexibleResponse) Reset() {
+	*x = GetGradeFlexibleResponse{}
+	mi := &file_grades_microservice_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGradeFlexibleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGradeFlexibleResponse) ProtoMessage() {}
+
+func (x *GetGradeFlexibleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_microservice_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGradeFlexibleResponse.ProtoReflect.Descriptor instead.
+func (*GetGradeFlexibleResponse) Descriptor() ([]byte, []int) {
+	return file_grades_microservice_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *GetGradeFlexibleResponse) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *GetGradeFlexibleResponse) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *GetGradeFlexibleResponse) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+// Request message for removing every grade of a student.
+type SoftDeleteStudentGradesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Authentication token for authorization.
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// Identifier for the student.
+	StudentID     string `protobuf:"bytes,2,opt,name=studentID,proto3" json:"studentID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SoftDeleteStudentGradesRequest) Reset() {
+	*x = SoftDeleteStudentGradesRequest{}
+	mi := &file_grades_microservice_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SoftDeleteStudentGradesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SoftDeleteStudentGradesRequest) ProtoMessage() {}
+
+func (x *SoftDeleteStudentGradesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_microservice_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SoftDeleteStudentGradesRequest.ProtoReflect.Descriptor instead.
+func (*SoftDeleteStudentGradesRequest) Descriptor() ([]byte, []int) {
+	return file_grades_microservice_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *SoftDeleteStudentGradesRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *SoftDeleteStudentGradesRequest) GetStudentID() string {
+	if x != nil {
+		return x.StudentID
+	}
+	return ""
+}
+
+// Response message for removing every grade of a student.
+type SoftDeleteStudentGradesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Number of grades removed.
+	DeletedCount  int32 `protobuf:"varint,1,opt,name=deleted_count,json=deletedCount,proto3" json:"deleted_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SoftDeleteStudentGradesResponse) Reset() {
+	*x = SoftDeleteStudentGradesResponse{}
+	mi := &file_grades_microservice_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SoftDeleteStudentGradesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SoftDeleteStudentGradesResponse) ProtoMessage() {}
+
+func (x *SoftDeleteStudentGradesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_microservice_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SoftDeleteStudentGradesResponse.ProtoReflect.Descriptor instead.
+func (*SoftDeleteStudentGradesResponse) Descriptor() ([]byte, []int) {
+	return file_grades_microservice_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *SoftDeleteStudentGradesResponse) GetDeletedCount() int32 {
+	if x != nil {
+		return x.DeletedCount
+	}
+	return 0
+}
+
+// A curve applied to numeric grades. Results are kept between 0 and 100.
+type CurveOperation struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The curve: add adds amount, scale multiplies by amount and sqrt maps x to 10 * sqrt(x).
+	Kind string `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	// The points to add or the factor to scale by; unused by sqrt.
+	Amount        float64 `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CurveOperation) Reset() {
+	*x = CurveOperation{}
+	mi := &file_grades_microservice_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CurveOperation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CurveOperation) ProtoMessage() {}
+
+func (x *CurveOperation) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_microservice_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CurveOperation.ProtoReflect.Descriptor instead.
+func (*CurveOperation) Descriptor() ([]byte, []int) {
+	return file_grades_microservice_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *CurveOperation) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *CurveOperation) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+// Request message for previewing a curve over the grades of a course item.
+type PreviewCurveRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Authentication token for authorization.
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// Identifier for the course.
+	CourseID string `protobuf:"bytes,2,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	// The academic semester.
+	Semester string `protobuf:"bytes,3,opt,name=semester,proto3" json:"semester,omitempty"`
+	// Identifier for the graded item.
+	ItemID string `protobuf:"bytes,4,opt,name=itemID,proto3" json:"itemID,omitempty"`
+	// The curve to preview.
+	Curve         *CurveOperation `protobuf:"bytes,5,opt,name=curve,proto3" json:"curve,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PreviewCurveRequest) Reset() {
+	*x = PreviewCurveRequest{}
+	mi := &file_grades_microservice_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PreviewCurveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreviewCurveRequest) ProtoMessage() {}
+
+func (x *PreviewCurveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_microservice_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreviewCurveRequest.ProtoReflect.Descriptor instead.
+func (*PreviewCurveRequest) Descriptor() ([]byte, []int) {
+	return file_grades_microservice_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *PreviewCurveRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *PreviewCurveRequest) GetCourseID() string {
+	if x != nil {
+		return x.CourseID
+	}
+	return ""
+}
+
+func (x *PreviewCurveRequest) GetSemester() string {
+	if x != nil {
+		return x.Semester
+	}
+	return ""
+}
+
+func (x *PreviewCurveRequest) GetItemID() string {
+	if x != nil {
+		return x.ItemID
+	}
+	return ""
+}
+
+func (x *PreviewCurveRequest) GetCurve() *CurveOperation {
+	if x != nil {
+		return x.Curve
+	}
+	return nil
+}
+
+// The number of grades with a value.
+type GradeValueCount struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The rounded grade value.
+	GradeValue string `protobuf:"bytes,1,opt,name=grade_value,json=gradeValue,proto3" json:"grade_value,omitempty"`
+	// Number of grades with the value.
+	Count         int32 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GradeValueCount) Reset() {
+	*x = GradeValueCount{}
+	mi := &file_grades_microservice_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GradeValueCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GradeValueCount) ProtoMessage() {}
+
+func (x *GradeValueCount) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_microservice_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GradeValueCount.ProtoReflect.Descriptor instead.
+func (*GradeValueCount) Descriptor() ([]byte, []int) {
+	return file_grades_microservice_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *GradeValueCount) GetGradeValue() string {
+	if x != nil {
+		return x.GradeValue
+	}
+	return ""
+}
+
+func (x *GradeValueCount) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// A student's grade before and after a curve.
+type CurvedGrade struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Identifier for the grade entry.
+	GradeID string `protobuf:"bytes,1,opt,name=gradeID,proto3" json:"gradeID,omitempty"`
+	// Identifier for the student.
+	StudentID string `protobuf:"bytes,2,opt,name=studentID,proto3" json:"studentID,omitempty"`
+	// The current grade value.
+	Before string `protobuf:"bytes,3,opt,name=before,proto3" json:"before,omitempty"`
+	// The grade value after the curve.
+	After         string `protobuf:"bytes,4,opt,name=after,proto3" json:"after,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CurvedGrade) Reset() {
+	*x = CurvedGrade{}
+	mi := &file_grades_microservice_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CurvedGrade) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CurvedGrade) ProtoMessage() {}
+
+func (x *CurvedGrade) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_microservice_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CurvedGrade.ProtoReflect.Descriptor instead.
+func (*CurvedGrade) Descriptor() ([]byte, []int) {
+	return file_grades_microservice_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *CurvedGrade) GetGradeID() string {
+	if x != nil {
+		return x.GradeID
+	}
+	return ""
+}
+
+func (x *CurvedGrade) GetStudentID() string {
+	if x != nil {
+		return x.StudentID
+	}
+	return ""
+}
+
+func (x *CurvedGrade) GetBefore() string {
+	if x != nil {
+		return x.Before
+	}
+	return ""
+}
+
+func (x *CurvedGrade) GetAfter() string {
+	if x != nil {
+		return x.After
+	}
+	return ""
+}
+
+// Response message with the effect of a curve.
+type PreviewCurveResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Distribution of the current values, numeric values first in ascending order.
+	Before []*GradeValueCount `protobuf:"bytes,1,rep,name=before,proto3" json:"before,omitempty"`
+	// Distribution of the curved values, numeric values first in ascending order.
+	After []*GradeValueCount `protobuf:"bytes,2,rep,name=after,proto3" json:"after,omitempty"`
+	// Every grade of the item before and after the curve.
+	Grades        []*CurvedGrade `protobuf:"bytes,3,rep,name=grades,proto3" json:"grades,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PreviewCurveResponse) Reset() {
+	*x = PreviewCurveResponse{}
+	mi := &file_grades_microservice_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PreviewCurveResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreviewCurveResponse) ProtoMessage() {}
+
+func (x *PreviewCurveResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_microservice_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreviewCurveResponse.ProtoReflect.Descriptor instead.
+func (*PreviewCurveResponse) Descriptor() ([]byte, []int) {
+	return file_grades_microservice_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *PreviewCurveResponse) GetBefore() []*GradeValueCount {
+	if x != nil {
+		return x.Before
+	}
+	return nil
+}
+
+func (x *PreviewCurveResponse) GetAfter() []*GradeValueCount {
+	if x != nil {
+		return x.After
+	}
+	return nil
+}
+
+func (x *PreviewCurveResponse) GetGrades() []*CurvedGrade {
+	if x != nil {
+		return x.Grades
+	}
+	return nil
+}
+
+// Request message for the percentiles of a course's grades.
+type GetGradePercentilesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Authentication token for authorization.
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// Identifier for the course.
+	CourseID string `protobuf:"bytes,2,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	// The academic semester.
+	Semester      string `protobuf:"bytes,3,opt,name=semester,proto3" json:"semester,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGradePercentilesRequest) Reset() {
+	*x = GetGradePercentilesRequest{}
+	mi := &file_grades_microservice_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGradePercentilesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGradePercentilesRequest) ProtoMessage() {}
+
+func (x *GetGradePercentilesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_microservice_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGradePercentilesRequest.ProtoReflect.Descriptor instead.
+func (*GetGradePercentilesRequest) Descriptor() ([]byte, []int) {
+	return file_grades_microservice_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *GetGradePercentilesRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *GetGradePercentilesRequest) GetCourseID() string {
+	if x != nil {
+		return x.CourseID
+	}
+	return ""
+}
+
+func (x *GetGradePercentilesRequest) GetSemester() string {
+	if x != nil {
+		return x.Semester
+	}
+	return ""
+}
+
+// Response message with the percentiles of a course's numeric grades.
+type GetGradePercentilesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Percentage of numeric grades at or below each rounded grade value.
+	Percentiles map[string]float64 `protobuf:"bytes,1,rep,name=percentiles,proto3" json:"percentiles,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"fixed64,2,opt,name=value"`
+	// The 25th percentile, zero when there are no numeric grades.
+	P25 float64 `protobuf:"fixed64,2,opt,name=p25,proto3" json:"p25,omitempty"`
+	// The median, zero when there are no numeric grades.
+	P50 float64 `protobuf:"fixed64,3,opt,name=p50,proto3" json:"p50,omitempty"`
+	// The 75th percentile, zero when there are no numeric grades.
+	P75 float64 `protobuf:"fixed64,4,opt,name=p75,proto3" json:"p75,omitempty"`
+	// Number of numeric grades.
+	Count         int32 `protobuf:"varint,5,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGradePercentilesResponse) Reset() {
+	*x = GetGradePercentilesResponse{}
+	mi := &file_grades_microservice_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGradePercentilesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGradePercentilesResponse) ProtoMessage() {}
+
+func (x *GetGradePercentilesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_microservice_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGradePercentilesResponse.ProtoReflect.Descriptor instead.
+func (*GetGradePercentilesResponse) Descriptor() ([]byte, []int) {
+	return file_grades_microservice_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *GetGradePercentilesResponse) GetPercentiles() map[string]float64 {
+	if x != nil {
+		return x.Percentiles
+	}
+	return nil
+}
+
+func (x *GetGradePercentilesResponse) GetP25() float64 {
+	if x != nil {
+		return x.P25
+	}
+	return 0
+}
+
+func (x *GetGradePercentilesResponse) GetP50() float64 {
+	if x != nil {
+		return x.P50
+	}
+	return 0
+}
+
+func (x *GetGradePercentilesResponse) GetP75() float64 {
+	if x != nil {
+		return x.P75
+	}
+	return 0
+}
+
+func (x *GetGradePercentilesResponse) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// Request message for setting the grading scheme of a grade type in a course.
+type SetGradeTypeSchemeRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Authentication token for authorization.
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// Identifier for the course.
+	CourseID string `protobuf:"bytes,2,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	// The grade type, such as Lab or Exam.
+	GradeType string `protobuf:"bytes,3,opt,name=grade_type,json=gradeType,proto3" json:"grade_type,omitempty"`
+	// The scheme: any, numeric, letter, auto or passfail. Empty removes the mapping, so the grade
+	// type uses the global scheme.
+	Scheme        string `protobuf:"bytes,4,opt,name=scheme,proto3" json:"scheme,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetGradeTypeSchemeRequest) Reset() {
+	*x = SetGradeTypeSchemeRequest{}
+	mi := &file_grades_microservice_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetGradeTypeSchemeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetGradeTypeSchemeRequest) ProtoMessage() {}
+
+func (x *SetGradeTypeSchemeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_microservice_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetGradeTypeSchemeRequest.ProtoReflect.Descriptor instead.
+func (*SetGradeTypeSchemeRequest) Descriptor() ([]byte, []int) {
+	return file_grades_microservice_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *SetGradeTypeSchemeRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *SetGradeTypeSchemeRequest) GetCourseID() string {
+	if x != nil {
+		return x.CourseID
+	}
+	return ""
+}
+
+func (x *SetGradeTypeSchemeRequest) GetGradeType() string {
+	if x != nil {
+		return x.GradeType
+	}
+	return ""
+}
+
+func (x *SetGradeTypeSchemeRequest) GetScheme() string {
+	if x != nil {
+		return x.Scheme
+	}
+	return ""
+}
+
+// Response message for setting the grading scheme of a grade type.
+type SetGradeTypeSchemeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetGradeTypeSchemeResponse) Reset() {
+	*x = SetGradeTypeSchemeResponse{}
+	mi := &file_grades_microservice_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetGradeTypeSchemeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetGradeTypeSchemeResponse) ProtoMessage() {}
+
+func (x *SetGradeTypeSchemeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_microservice_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetGradeTypeSchemeResponse.ProtoReflect.Descriptor instead.
+func (*SetGradeTypeSchemeResponse) Descriptor() ([]byte, []int) {
+	return file_grades_microservice_proto_rawDescGZIP(), []int{98}
+}
+
+// Request message for the grading schemes of a course's grade types.
+type GetGradeTypeSchemesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Authentication token for authorization.
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// Identifier for the course.
+	CourseID      string `protobuf:"bytes,2,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGradeTypeSchemesRequest) Reset() {
+	*x = GetGradeTypeSchemesRequest{}
+	mi := &file_grades_microservice_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGradeTypeSchemesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGradeTypeSchemesRequest) ProtoMessage() {}
+
+func (x *GetGradeTypeSchemesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_microservice_proto_msgTypes[99]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGradeTypeSchemesRequest.ProtoReflect.Descriptor instead.
+func (*GetGradeTypeSchemesRequest) Descriptor() ([]byte, []int) {
+	return file_grades_microservice_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *GetGradeTypeSchemesRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *GetGradeTypeSchemesRequest) GetCourseID() string {
+	if x != nil {
+		return x.CourseID
+	}
+	return ""
+}
+
+// The grading scheme of a grade type.
+type GradeTypeScheme struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The grade type.
+	GradeType string `protobuf:"bytes,1,opt,name=grade_type,json=gradeType,proto3" json:"grade_type,omitempty"`
+	// The grading scheme its grades validate against.
+	Scheme        string `protobuf:"bytes,2,opt,name=scheme,proto3" json:"scheme,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GradeTypeScheme) Reset() {
+	*x = GradeTypeScheme{}
+	mi := &file_grades_microservice_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GradeTypeScheme) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GradeTypeScheme) ProtoMessage() {}
+
+func (x *GradeTypeScheme) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_microservice_proto_msgTypes[100]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GradeTypeScheme.ProtoReflect.Descriptor instead.
+func (*GradeTypeScheme) Descriptor() ([]byte, []int) {
+	return file_grades_microservice_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *GradeTypeScheme) GetGradeType() string {
+	if x != nil {
+		return x.GradeType
+	}
+	return ""
+}
+
+func (x *GradeTypeScheme) GetScheme() string {
+	if x != nil {
+		return x.Scheme
+	}
+	return ""
+}
+
+// Response message with the grading schemes of a course's grade types.
+type GetGradeTypeSchemesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The mapped grade types, ordered by grade type.
+	Schemes       []*GradeTypeScheme `protobuf:"bytes,1,rep,name=schemes,proto3" json:"schemes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGradeTypeSchemesResponse) Reset() {
+	*x = GetGradeTypeSchemesResponse{}
+	mi := &file_grades_microservice_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGradeTypeSchemesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGradeTypeSchemesResponse) ProtoMessage() {}
+
+func (x *GetGradeTypeSchemesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_microservice_proto_msgTypes[101]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGradeTypeSchemesResponse.ProtoReflect.Descriptor instead.
+func (*GetGradeTypeSchemesResponse) Descriptor() ([]byte, []int) {
+	return file_grades_microservice_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *GetGradeTypeSchemesResponse) GetSchemes() []*GradeTypeScheme {
+	if x != nil {
+		return x.Schemes
+	}
+	return nil
+}
+
+// Request message for setting the attempt policy of a course.
+type SetAttemptPolicyRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Authentication token for authorization.
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// Identifier for the course.
+	CourseID string `protobuf:"bytes,2,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	// How several attempts at the same item become one grade: best, latest or average. Empty
+	// removes the policy, so every attempt is returned.
+	Policy        string `protobuf:"bytes,3,opt,name=policy,proto3" json:"policy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetAttemptPolicyRequest) Reset() {
+	*x = SetAttemptPolicyRequest{}
+	mi := &file_grades_microservice_proto_msgTypes[102]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetAttemptPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetAttemptPolicyRequest) ProtoMessage() {}
+
+func (x *SetAttemptPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_microservice_proto_msgTypes[102]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetAttemptPolicyRequest.ProtoReflect.Descriptor instead.
+func (*SetAttemptPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_grades_microservice_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *SetAttemptPolicyRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *SetAttemptPolicyRequest) GetCourseID() string {
+	if x != nil {
+		return x.CourseID
+	}
+	return ""
+}
+
+func (x *SetAttemptPolicyRequest) GetPolicy() string {
+	if x != nil {
+		return x.Policy
+	}
+	return ""
+}
+
+// Response message for setting the attempt policy of a course.
+type SetAttemptPolicyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetAttemptPolicyResponse) Reset() {
+	*x = SetAttemptPolicyResponse{}
+	mi := &file_grades_microservice_proto_msgTypes[103]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetAttemptPolicyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetAttemptPolicyResponse) ProtoMessage() {}
+
+func (x *SetAttemptPolicyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_microservice_proto_msgTypes[103]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetAttemptPolicyResponse.ProtoReflect.Descriptor instead.
+func (*SetAttemptPolicyResponse) Descriptor() ([]byte, []int) {
+	return file_grades_microservice_proto_rawDescGZIP(), []int{103}
+}
+
+// Request message for the value timeline of a grade.
+type GetGradeTimelineRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Authentication token for authorization.
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// Identifier for the grade entry.
+	GradeID       string `protobuf:"bytes,2,opt,name=gradeID,proto3" json:"gradeID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGradeTimelineRequest) Reset() {
+	*x = GetGradeTimelineRequest{}
+	mi := &file_grades_microservice_proto_msgTypes[104]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGradeTimelineRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGradeTimelineRequest) ProtoMessage() {}
+
+func (x *GetGradeTimelineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_microservice_proto_msgTypes[104]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGradeTimelineRequest.ProtoReflect.Descriptor instead.
+func (*GetGradeTimelineRequest) Descriptor() ([]byte, []int) {
+	return file_grades_microservice_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *GetGradeTimelineRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *GetGradeTimelineRequest) GetGradeID() string {
+	if x != nil {
+		return x.GradeID
+	}
+	return ""
+}
+
+// A single change of a grade's value.
+type GradeTransition struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// What happened: "created" for the first entry, "updated" for the rest.
+	Kind string `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	// The value before the change; empty for "created".
+	FromValue string `protobuf:"bytes,2,opt,name=from_value,json=fromValue,proto3" json:"from_value,omitempty"`
+	// The value after the change.
+	ToValue string `protobuf:"bytes,3,opt,name=to_value,json=toValue,proto3" json:"to_value,omitempty"`
+	// Who graded the version the change produced.
+	ChangedBy string `protobuf:"bytes,4,opt,name=changed_by,json=changedBy,proto3" json:"changed_by,omitempty"`
+	// When the change happened, in RFC 3339 format.
+	ChangedAt string `protobuf:"bytes,5,opt,name=changed_at,json=changedAt,proto3" json:"changed_at,omitempty"`
+	// Version of the grade after the change.
+	Version       int64 `protobuf:"varint,6,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GradeTransition) Reset() {
+	*x = GradeTransition{}
+	mi := &file_grades_microservice_proto_msgTypes[105]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GradeTransition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GradeTransition) ProtoMessage() {}
+
+func (x *GradeTransition) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_microservice_proto_msgTypes[105]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GradeTransition.ProtoReflect.Descriptor instead.
+func (*GradeTransition) Descriptor() ([]byte, []int) {
+	return file_grades_microservice_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *GradeTransition) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *GradeTransition) GetFromValue() string {
+	if x != nil {
+		return x.FromValue
+	}
+	return ""
+}
+
+func (x *GradeTransition) GetToValue() string {
+	if x != nil {
+		return x.ToValue
+	}
+	return ""
+}
+
+func (x *GradeTransition) GetChangedBy() string {
+	if x != nil {
+		return x.ChangedBy
+	}
+	return ""
+}
+
+func (x *GradeTransition) GetChangedAt() string {
+	if x != nil {
+		return x.ChangedAt
+	}
+	return ""
+}
+
+func (x *GradeTransition) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+// Response message containing the value timeline of a grade.
+type GetGradeTimelineResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The creation of the grade followed by every change of its value, oldest first.
+	Transitions   []*GradeTransition `protobuf:"bytes,1,rep,name=transitions,proto3" json:"transitions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGradeTimelineResponse) Reset() {
+	*x = GetGradeTimelineResponse{}
+	mi := &file_grades_microservice_proto_msgTypes[106]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGradeTimelineResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGradeTimelineResponse) ProtoMessage() {}
+
+func (x *GetGradeTimelineResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_microservice_proto_msgTypes[106]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGradeTimelineResponse.ProtoReflect.Descriptor instead.
+func (*GetGradeTimelineResponse) Descriptor() ([]byte, []int) {
+	return file_grades_microservice_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *GetGradeTimelineResponse) GetTransitions() []*GradeTransition {
+	if x != nil {
+		return x.Transitions
+	}
+	return nil
+}
+
+// Request message for the weighted class average of a course.
+type GetCourseWeightedAverageRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Authentication token for authorization.
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// Identifier for the course.
+	CourseID string `protobuf:"bytes,2,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	// The academic semester.
+	Semester string `protobuf:"bytes,3,opt,name=semester,proto3" json:"semester,omitempty"`
+	// Weight of each course component, keyed by grade type; the configured weights are used when empty.
+	Weights map[string]float64 `protobuf:"bytes,4,rep,name=weights,proto3" json:"weights,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"fixed64,2,opt,name=value"`
+	// Give grade types without a weight the mean of the configured weights instead of leaving them out.
+	EqualWeightUnweighted bool `protobuf:"varint,5,opt,name=equal_weight_unweighted,json=equalWeightUnweighted,proto3" json:"equal_weight_unweighted,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *GetCourseWeightedAverageRequest) Reset() {
+	*x = GetCourseWeightedAverageRequest{}
+	mi := &file_grades_microservice_proto_msgTypes[107]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCourseWeightedAverageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCourseWeightedAverageRequest) ProtoMessage() {}
+
+func (x *GetCourseWeightedAverageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_microservice_proto_msgTypes[107]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCourseWeightedAverageRequest.ProtoReflect.Descriptor instead.
+func (*GetCourseWeightedAverageRequest) Descriptor() ([]byte, []int) {
+	return file_grades_microservice_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *GetCourseWeightedAverageRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *GetCourseWeightedAverageRequest) GetCourseID() string {
+	if x != nil {
+		return x.CourseID
+	}
+	return ""
+}
+
+func (x *GetCourseWeightedAverageRequest) GetSemester() string {
+	if x != nil {
+		return x.Semester
+	}
+	return ""
+}
+
+func (x *GetCourseWeightedAverageRequest) GetWeights() map[string]float64 {
+	if x != nil {
+		return x.Weights
+	}
+	return nil
+}
+
+func (x *GetCourseWeightedAverageRequest) GetEqualWeightUnweighted() bool {
+	if x != nil {
+		return x.EqualWeightUnweighted
+	}
+	return false
+}
+
+// The class average of a single course component.
+type ComponentAverage struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The component, the lower-case grade type.
+	GradeType string `protobuf:"bytes,1,opt,name=grade_type,json=gradeType,proto3" json:"grade_type,omitempty"`
+	// Mean of the component's numeric grades.
+	Average float64 `protobuf:"fixed64,2,opt,name=average,proto3" json:"average,omitempty"`
+	// Weight the component counted with.
+	Weight float64 `protobuf:"fixed64,3,opt,name=weight,proto3" json:"weight,omitempty"`
+	// Number of numeric grades of the component.
+	Count         int32 `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ComponentAverage) Reset() {
+	*x = ComponentAverage{}
+	mi := &file_grades_microservice_proto_msgTypes[108]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ComponentAverage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ComponentAverage) ProtoMessage() {}
+
+func (x *ComponentAverage) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_microservice_proto_msgTypes[108]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ComponentAverage.ProtoReflect.Descriptor instead.
+func (*ComponentAverage) Descriptor() ([]byte, []int) {
+	return file_grades_microservice_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *ComponentAverage) GetGradeType() string {
+	if x != nil {
+		return x.GradeType
+	}
+	return ""
+}
+
+func (x *ComponentAverage) GetAverage() float64 {
+	if x != nil {
+		return x.Average
+	}
+	return 0
+}
+
+func (x *ComponentAverage) GetWeight() float64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *ComponentAverage) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// Response message containing the weighted class average of a course.
+type GetCourseWeightedAverageResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Average of the component averages, each counting with its weight; 0 when no component counts.
+	WeightedAverage float64 `protobuf:"fixed64,1,opt,name=weighted_average,json=weightedAverage,proto3" json:"weighted_average,omitempty"`
+	// Plain mean of every numeric grade, for comparison.
+	UnweightedAverage float64 `protobuf:"fixed64,2,opt,name=unweighted_average,json=unweightedAverage,proto3" json:"unweighted_average,omitempty"`
+	// The components that counted, ordered by grade type.
+	Components []*ComponentAverage `protobuf:"bytes,3,rep,name=components,proto3" json:"components,omitempty"`
+	// Grade types with numeric grades left out for having no weight, ordered.
+	ExcludedGradeTypes []string `protobuf:"bytes,4,rep,name=excluded_grade_types,json=excludedGradeTypes,proto3" json:"excluded_grade_types,omitempty"`
+	// Number of numeric grades.
+	Count         int32 `protobuf:"varint,5,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCourseWeightedAverageResponse) Reset() {
+	*x = GetCourseWeightedAverageResponse{}
+	mi := &file_grades_microservice_proto_msgTypes[109]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCourseWeightedAverageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCourseWeightedAverageResponse) ProtoMessage() {}
+
+func (x *GetCourseWeightedAverageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_microservice_proto_msgTypes[109]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCourseWeightedAverageResponse.ProtoReflect.Descriptor instead.
+func (*GetCourseWeightedAverageResponse) Descriptor() ([]byte, []int) {
+	return file_grades_microservice_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *GetCourseWeightedAverageResponse) GetWeightedAverage() float64 {
+	if x != nil {
+		return x.WeightedAverage
+	}
+	return 0
+}
+
+func (x *GetCourseWeightedAverageResponse) GetUnweightedAverage() float64 {
+	if x != nil {
+		return x.UnweightedAverage
+	}
+	return 0
+}
+
+func (x *GetCourseWeightedAverageResponse) GetComponents() []*ComponentAverage {
+	if x != nil {
+		return x.Components
+	}
+	return nil
+}
+
+func (x *GetCourseWeightedAverageResponse) GetExcludedGradeTypes() []string {
+	if x != nil {
+		return x.ExcludedGradeTypes
+	}
+	return nil
+}
+
+func (x *GetCourseWeightedAverageResponse) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// Request message for a student's honor roll status in a semester.
+type GetHonorRollStatusRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Authentication token for authorization.
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// Identifier for the student.
+	StudentID string `protobuf:"bytes,2,opt,name=studentID,proto3" json:"studentID,omitempty"`
+	// The academic semester.
+	Semester      string `protobuf:"bytes,3,opt,name=semester,proto3" json:"semester,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetHonorRollStatusRequest) Reset() {
+	*x = GetHonorRollStatusRequest{}
+	mi := &file_grades_microservice_proto_msgTypes[110]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHonorRollStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHonorRollStatusRequest) ProtoMessage() {}
+
+func (x *GetHonorRollStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_microservice_proto_msgTypes[110]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHonorRollStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetHonorRollStatusRequest) Descriptor() ([]byte, []int) {
+	return file_grades_microservice_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *GetHonorRollStatusRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *GetHonorRollStatusRequest) GetStudentID() string {
+	if x != nil {
+		return x.StudentID
+	}
+	return ""
+}
+
+func (x *GetHonorRollStatusRequest) GetSemester() string {
+	if x != nil {
+		return x.Semester
+	}
+	return ""
+}
+
+// Response message with a student's honor roll status in a semester.
+type GetHonorRollStatusResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// "qualified" or "not_qualified" by GPA, or "not_eligible" when the student took too few
+	// courses or credits to be considered.
+	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// The student's GPA in the semester.
+	Gpa float64 `protobuf:"fixed64,2,opt,name=gpa,proto3" json:"gpa,omitempty"`
+	// The GPA the honor roll requires.
+	Threshold float64 `protobuf:"fixed64,3,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	// Number of courses the GPA covers.
+	CourseCount int32 `protobuf:"varint,4,opt,name=course_count,json=courseCount,proto3" json:"course_count,omitempty"`
+	// Number of courses required to be eligible.
+	MinCourses int32 `protobuf:"varint,5,opt,name=min_courses,json=minCourses,proto3" json:"min_courses,omitempty"`
+	// Credits of the courses the GPA covers; 0 when no credit minimum is configured.
+	Credits float64 `protobuf:"fixed64,6,opt,name=credits,proto3" json:"credits,omitempty"`
+	// Credits required to be eligible; 0 when none are.
+	MinCredits    float64 `protobuf:"fixed64,7,opt,name=min_credits,json=minCredits,proto3" json:"min_credits,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetHonorRollStatusResponse) Reset() {
+	*x = GetHonorRollStatusResponse{}
+	mi := &file_grades_microservice_proto_msgTypes[111]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHonorRollStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHonorRollStatusResponse) ProtoMessage() {}
+
+func (x *GetHonorRollStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_microservice_proto_msgTypes[111]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHonorRollStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetHonorRollStatusResponse) Descriptor() ([]byte, []int) {
+	return file_grades_microservice_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *GetHonorRollStatusResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *GetHonorRollStatusResponse) GetGpa() float64 {
+	if x != nil {
+		return x.Gpa
+	}
+	return 0
+}
+
+func (x *GetHonorRollStatusResponse) GetThreshold() float64 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+func (x *GetHonorRollStatusResponse) GetCourseCount() int32 {
+	if x != nil {
+		return x.CourseCount
+	}
+	return 0
+}
+
+func (x *GetHonorRollStatusResponse) GetMinCourses() int32 {
+	if x != nil {
+		return x.MinCourses
+	}
+	return 0
+}
+
+func (x *GetHonorRollStatusResponse) GetCredits() float64 {
+	if x != nil {
+		return x.Credits
+	}
+	return 0
+}
+
+func (x *GetHonorRollStatusResponse) GetMinCredits() float64 {
+	if x != nil {
+		return x.MinCredits
+	}
+	return 0
+}
+
+// Request message for the checksum of a course's grades.
+type GetCourseGradesChecksumRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Authentication token for authorization.
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// Identifier for the course.
+	CourseID string `protobuf:"bytes,2,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	// The academic semester.
+	Semester string `protobuf:"bytes,3,opt,name=semester,proto3" json:"semester,omitempty"`
+	// Also return a checksum per student, to narrow a mismatch down without transferring the grades.
+	ByStudent     bool `protobuf:"varint,4,opt,name=by_student,json=byStudent,proto3" json:"by_student,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCourseGradesChecksumRequest) Reset() {
+	*x = GetCourseGradesChecksumRequest{}
+	mi := &file_grades_microservice_proto_msgTypes[112]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCourseGradesChecksumRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCourseGradesChecksumRequest) ProtoMessage() {}
+
+func (x *GetCourseGradesChecksumRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_microservice_proto_msgTypes[112]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCourseGradesChecksumRequest.ProtoReflect.Descriptor instead.
+func (*GetCourseGradesChecksumRequest) Descriptor() ([]byte, []int) {
+	return file_grades_microservice_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *GetCourseGradesChecksumRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *GetCourseGradesChecksumRequest) GetCourseID() string {
+	if x != nil {
+		return x.CourseID
+	}
+	return ""
+}
+
+func (x *GetCourseGradesChecksumRequest) GetSemester() string {
+	if x != nil {
+		return x.Semester
+	}
+	return ""
+}
+
+func (x *GetCourseGradesChecksumRequest) GetByStudent() bool {
+	if x != nil {
+		return x.ByStudent
+	}
+	return false
+}
+
+// Response message with the checksum of a course's grades. Each grade row is hashed with SHA-256
+// over its grade_id, student_id, course_id, semester, grade_type, item_id, grade_value, graded_by,
+// version and flagged fields, each followed by a 0x1f byte, with version in decimal and flagged as
+// "true" or "false". The checksum is the hex SHA-256 of the sorted raw row hashes concatenated, so
+// it does not depend on the order of the rows.
+type GetCourseGradesChecksumResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Hex checksum of all the grades of the course.
+	Checksum string `protobuf:"bytes,1,opt,name=checksum,proto3" json:"checksum,omitempty"`
+	// Name of the checksum scheme, which changes whenever the hashed fields or encoding do.
+	Algorithm string `protobuf:"bytes,2,opt,name=algorithm,proto3" json:"algorithm,omitempty"`
+	// Number of grades hashed.
+	Count int32 `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	// Checksum of each student's grades by student ID, computed the same way; set only when requested.
+	StudentChecksums map[string]string `protobuf:"bytes,4,rep,name=student_checksums,json=studentChecksums,proto3" json:"student_checksums,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *GetCourseGradesChecksumResponse) Reset() {
+	*x = GetCourseGradesChecksumResponse{}
+	mi := &file_grades_microservice_proto_msgTypes[113]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCourseGradesChecksumResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCourseGradesChecksumResponse) ProtoMessage() {}
+
+func (x *GetCourseGradesChecksumResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_microservice_proto_msgTypes[113]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCourseGradesChecksumResponse.ProtoReflect.Descriptor instead.
+func (*GetCourseGradesChecksumResponse) Descriptor() ([]byte, []int) {
+	return file_grades_microservice_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *GetCourseGradesChecksumResponse) GetChecksum() string {
+	if x != nil {
+		return x.Checksum
+	}
+	return ""
+}
+
+func (x *GetCourseGradesChecksumResponse) GetAlgorithm() string {
+	if x != nil {
+		return x.Algorithm
+	}
+	return ""
+}
+
+func (x *GetCourseGradesChecksumResponse) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *GetCourseGradesChecksumResponse) GetStudentChecksums() map[string]string {
+	if x != nil {
+		return x.StudentChecksums
+	}
+	return nil
+}
+
+// Request message for choosing between a notification per grade change and a periodic digest.
+type SetNotificationDigestRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Authentication token for authorization.
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// Identifier for the student.
+	StudentID string `protobuf:"bytes,2,opt,name=studentID,proto3" json:"studentID,omitempty"`
+	// True for a periodic digest, false for a notification per change. Digests only take effect
+	// while the server sends them; otherwise every change is notified right away.
+	Digest        bool `protobuf:"varint,3,opt,name=digest,proto3" json:"digest,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetNotificationDigestRequest) Reset() {
+	*x = SetNotificationDigestRequest{}
+	mi := &file_grades_microservice_proto_msgTypes[114]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetNotificationDigestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetNotificationDigestRequest) ProtoMessage() {}
+
+func (x *SetNotificationDigestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_microservice_proto_msgTypes[114]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetNotificationDigestRequest.ProtoReflect.Descriptor instead.
+func (*SetNotificationDigestRequest) Descriptor() ([]byte, []int) {
+	return file_grades_microservice_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *SetNotificationDigestRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *SetNotificationDigestRequest) GetStudentID() string {
+	if x != nil {
+		return x.StudentID
+	}
+	return ""
+}
+
+func (x *SetNotificationDigestRequest) GetDigest() bool {
+	if x != nil {
+		return x.Digest
+	}
+	return false
+}
+
+// Response message after setting a notification digest preference.
+type SetNotificationDigestResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetNotificationDigestResponse) Reset() {
+	*x = SetNotificationDigestResponse{}
+	mi := &file_grades_microservice_proto_msgTypes[115]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetNotificationDigestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetNotificationDigestResponse) ProtoMessage() {}
+
+func (x *SetNotificationDigestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_microservice_proto_msgTypes[115]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetNotificationDigestResponse.ProtoReflect.Descriptor instead.
+func (*SetNotificationDigestResponse) Descriptor() ([]byte, []int) {
+	return file_grades_microservice_proto_rawDescGZIP(), []int{115}
+}
+
+// Request message for a student's academic probation status.
+type GetProbationStatusRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Authentication token for authorization.
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// Identifier for the student.
+	StudentID string `protobuf:"bytes,2,opt,name=studentID,proto3" json:"studentID,omitempty"`
+	// The academic semester to judge; required when the server judges semester GPAs, ignored when
+	// it judges cumulative ones.
+	Semester      string `protobuf:"bytes,3,opt,name=semester,proto3" json:"semester,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProbationStatusRequest) Reset() {
+	*x = GetProbationStatusRequest{}
+	mi := &file_grades_microservice_proto_msgTypes[116]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProbationStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProbationStatusRequest) ProtoMessage() {}
+
+func (x *GetProbationStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_microservice_proto_msgTypes[116]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProbationStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetProbationStatusRequest) Descriptor() ([]byte, []int) {
+	return file_grades_microservice_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *GetProbationStatusRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *GetProbationStatusRequest) GetStudentID() string {
+	if x != nil {
+		return x.StudentID
+	}
+	return ""
+}
+
+func (x *GetProbationStatusRequest) GetSemester() string {
+	if x != nil {
+		return x.Semester
+	}
+	return ""
+}
+
+// Response message with a student's academic probation status.
+type GetProbationStatusResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// "probation" or "good_standing" by GPA, or "insufficient_data" when the student has too few
+	// graded courses to be judged.
+	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// The GPA compared to the threshold.
+	Gpa float64 `protobuf:"fixed64,2,opt,name=gpa,proto3" json:"gpa,omitempty"`
+	// The lowest GPA in good standing.
+	Threshold float64 `protobuf:"fixed64,3,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	// How far the GPA falls short of the threshold; 0 unless on probation.
+	Deficit float64 `protobuf:"fixed64,4,opt,name=deficit,proto3" json:"deficit,omitempty"`
+	// "cumulative" or "semester", the GPA the server judges.
+	Mode string `protobuf:"bytes,5,opt,name=mode,proto3" json:"mode,omitempty"`
+	// Number of courses the GPA covers.
+	CourseCount int32 `protobuf:"varint,6,opt,name=course_count,json=courseCount,proto3" json:"course_count,omitempty"`
+	// Number of courses required to be judged.
+	MinCourses    int32 `protobuf:"varint,7,opt,name=min_courses,json=minCourses,proto3" json:"min_courses,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProbationStatusResponse) Reset() {
+	*x = GetProbationStatusResponse{}
+	mi := &file_grades_microservice_proto_msgTypes[117]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProbationStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProbationStatusResponse) ProtoMessage() {}
+
+func (x *GetProbationStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_microservice_proto_msgTypes[117]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProbationStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetProbationStatusResponse) Descriptor() ([]byte, []int) {
+	return file_grades_microservice_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *GetProbationStatusResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *GetProbationStatusResponse) GetGpa() float64 {
+	if x != nil {
+		return x.Gpa
+	}
+	return 0
+}
+
+func (x *GetProbationStatusResponse) GetThreshold() float64 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+func (x *GetProbationStatusResponse) GetDeficit() float64 {
+	if x != nil {
+		return x.Deficit
+	}
+	return 0
+}
+
+func (x *GetProbationStatusResponse) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *GetProbationStatusResponse) GetCourseCount() int32 {
+	if x != nil {
+		return x.CourseCount
+	}
+	return 0
+}
+
+func (x *GetProbationStatusResponse) GetMinCourses() int32 {
+	if x != nil {
+		return x.MinCourses
+	}
+	return 0
+}
+
+// Request message for a signed export of the grades of a course.
+type ExportCourseGradesSignedRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Authentication token for authorization.
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// Identifier for the course.
+	CourseID string `protobuf:"bytes,2,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	// Semester to export.
+	Semester      string `protobuf:"bytes,3,opt,name=semester,proto3" json:"semester,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportCourseGradesSignedRequest) Reset() {
+	*x = ExportCourseGradesSignedRequest{}
+	mi := &file_grades_microservice_proto_msgTypes[118]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportCourseGradesSignedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportCourseGradesSignedRequest) ProtoMessage() {}
+
+func (x *ExportCourseGradesSignedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_microservice_proto_msgTypes[118]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportCourseGradesSignedRequest.ProtoReflect.Descriptor instead.
+func (*ExportCourseGradesSignedRequest) Descriptor() ([]byte, []int) {
+	return file_grades_microservice_proto_rawDescGZIP(), []int{118}
+}
+
+func (x *ExportCourseGradesSignedRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *ExportCourseGradesSignedRequest) GetCourseID() string {
+	if x != nil {
+		return x.CourseID
+	}
+	return ""
+}
+
+func (x *ExportCourseGradesSignedRequest) GetSemester() string {
+	if x != nil {
+		return x.Semester
+	}
+	return ""
+}
+
+// Response message containing a signed export. Verify the signature over content exactly as
+// received before parsing it.
+type ExportCourseGradesSignedResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The grades as compact JSON in canonical form: fixed field order, grades ordered by grade_id
+	// and times in UTC RFC 3339.
+	Content []byte `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	// Detached signature over content.
+	Signature []byte `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	// The signature algorithm, "ed25519".
+	Algorithm string `protobuf:"bytes,3,opt,name=algorithm,proto3" json:"algorithm,omitempty"`
+	// Hex of the first 8 bytes of the SHA-256 of the public key that verifies the signature.
+	KeyID string `protobuf:"bytes,4,opt,name=keyID,proto3" json:"keyID,omitempty"`
+	// Suggested file name for the content.
+	Filename      string `protobuf:"bytes,5,opt,name=filename,proto3" json:"filename,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportCourseGradesSignedResponse) Reset() {
+	*x = ExportCourseGradesSignedResponse{}
+	mi := &file_grades_microservice_proto_msgTypes[119]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportCourseGradesSignedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportCourseGradesSignedResponse) ProtoMessage() {}
+
+func (x *ExportCourseGradesSignedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_microservice_proto_msgTypes[119]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportCourseGradesSignedResponse.ProtoReflect.Descriptor instead.
+func (*ExportCourseGradesSignedResponse) Descriptor() ([]byte, []int) {
+	return file_grades_microservice_proto_rawDescGZIP(), []int{119}
+}
+
+func (x *ExportCourseGradesSignedResponse) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+func (x *ExportCourseGradesSignedResponse) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+func (x *ExportCourseGradesSignedResponse) GetAlgorithm() string {
+	if x != nil {
+		return x.Algorithm
+	}
+	return ""
+}
+
+func (x *ExportCourseGradesSignedResponse) GetKeyID() string {
+	if x != nil {
+		return x.KeyID
+	}
+	return ""
+}
+
+func (x *ExportCourseGradesSignedResponse) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+// Request message for undoing the latest change of a grade.
+type UndoGradeChangeRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Authentication token for authorization.
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// Identifier for the grade entry.
+	GradeID       string `protobuf:"bytes,2,opt,name=gradeID,proto3" json:"gradeID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UndoGradeChangeRequest) Reset() {
+	*x = UndoGradeChangeRequest{}
+	mi := &file_grades_microservice_proto_msgTypes[120]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UndoGradeChangeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UndoGradeChangeRequest) ProtoMessage() {}
+
+func (x *UndoGradeChangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_microservice_proto_msgTypes[120]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UndoGradeChangeRequest.ProtoReflect.Descriptor instead.
+func (*UndoGradeChangeRequest) Descriptor() ([]byte, []int) {
+	return file_grades_microservice_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *UndoGradeChangeRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *UndoGradeChangeRequest) GetGradeID() string {
+	if x != nil {
+		return x.GradeID
+	}
+	return ""
+}
+
+// Response message containing the reverted grade.
+type UndoGradeChangeResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The grade with its previous value restored.
+	Grade *SingleGrade `protobuf:"bytes,1,opt,name=grade,proto3" json:"grade,omitempty"`
+	// The value the undo replaced.
+	UndoneValue   string `protobuf:"bytes,2,opt,name=undone_value,json=undoneValue,proto3" json:"undone_value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UndoGradeChangeResponse) Reset() {
+	*x = UndoGradeChangeResponse{}
+	mi := &file_grades_microservice_proto_msgTypes[121]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UndoGradeChangeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UndoGradeChangeResponse) ProtoMessage() {}
+
+func (x *UndoGradeChangeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_microservice_proto_msgTypes[121]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UndoGradeChangeResponse.ProtoReflect.Descriptor instead.
+func (*UndoGradeChangeResponse) Descriptor() ([]byte, []int) {
+	return file_grades_microservice_proto_rawDescGZIP(), []int{121}
+}
+
+func (x *UndoGradeChangeResponse) GetGrade() *SingleGrade {
+	if x != nil {
+		return x.Grade
+	}
+	return nil
+}
+
+func (x *UndoGradeChangeResponse) GetUndoneValue() string {
+	if x != nil {
+		return x.UndoneValue
+	}
+	return ""
+}
+
+// Maps the columns and values of a CSV file to grades.
+type ImportColumnMapping struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The file column filling each grade field, by header name, such as
+	// {"student_id": "ID", "grade_value": "Final Grade"}. Header names match regardless of case.
+	// Fields are student_id, course_id, semester, grade_type, item_id, grade_value, graded_by and
+	// comments; student_id and grade_value are required.
+	Columns map[string]string `protobuf:"bytes,1,rep,name=columns,proto3" json:"columns,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Values of the grade fields no column fills, or whose column is empty in a row.
+	Defaults map[string]string `protobuf:"bytes,2,rep,name=defaults,proto3" json:"defaults,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Transforms applied to grade values, in order: "strip_percent" drops a trailing "%",
+	// "decimal_comma" reads a decimal comma as a point and "uppercase" uppercases letter grades.
+	ValueTransforms []string `protobuf:"bytes,3,rep,name=value_transforms,json=valueTransforms,proto3" json:"value_transforms,omitempty"`
+	// The field delimiter, "," when empty.
+	Delimiter     string `protobuf:"bytes,4,opt,name=delimiter,proto3" json:"delimiter,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportColumnMapping) Reset() {
+	*x = ImportColumnMapping{}
+	mi := &file_grades_microservice_proto_msgTypes[122]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportColumnMapping) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportColumnMapping) ProtoMessage() {}
+
+func (x *ImportColumnMapping) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_microservice_proto_msgTypes[122]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportColumnMapping.ProtoReflect.Descriptor instead.
+func (*ImportColumnMapping) Descriptor() ([]byte, []int) {
+	return file_grades_microservice_proto_rawDescGZIP(), []int{122}
+}
+
+func (x *ImportColumnMapping) GetColumns() map[string]string {
+	if x != nil {
+		return x.Columns
+	}
+	return nil
+}
+
+func (x *ImportColumnMapping) GetDefaults() map[string]string {
+	if x != nil {
+		return x.Defaults
+	}
+	return nil
+}
+
+func (x *ImportColumnMapping) GetValueTransforms() []string {
+	if x != nil {
+		return x.ValueTransforms
+	}
+	return nil
+}
+
+func (x *ImportColumnMapping) GetDelimiter() string {
+	if x != nil {
+		return x.Delimiter
+	}
+	return ""
+}
+
+// Request message for importing grades from a CSV file.
+type ImportGradesCSVRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Authentication token for authorization.
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// The CSV file, starting with a header row.
+	Content []byte `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	// How the file's columns and values map to grades.
+	Mapping *ImportColumnMapping `protobuf:"bytes,3,opt,name=mapping,proto3" json:"mapping,omitempty"`
+	// Map and validate the rows without adding any grade.
+	DryRun        bool `protobuf:"varint,4,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportGradesCSVRequest) Reset() {
+	*x = ImportGradesCSVRequest{}
+	mi := &file_grades_microservice_proto_msgTypes[123]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportGradesCSVRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportGradesCSVRequest) ProtoMessage() {}
+
+func (x *ImportGradesCSVRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_microservice_proto_msgTypes[123]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportGradesCSVRequest.ProtoReflect.Descriptor instead.
+func (*ImportGradesCSVRequest) Descriptor() ([]byte, []int) {
+	return file_grades_microservice_proto_rawDescGZIP(), []int{123}
+}
+
+func (x *ImportGradesCSVRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *ImportGradesCSVRequest) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+func (x *ImportGradesCSVRequest) GetMapping() *ImportColumnMapping {
+	if x != nil {
+		return x.Mapping
+	}
+	return nil
+}
+
+func (x *ImportGradesCSVRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+// A row of an import that was not added.
+type ImportRowError struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Line of the row in the file, the header being line 1.
+	Line int32 `protobuf:"varint,1,opt,name=line,proto3" json:"line,omitempty"`
+	// Why the row was not added.
+	Message       string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportRowError) Reset() {
+	*x = ImportRowError{}
+	mi := &file_grades_microservice_proto_msgTypes[124]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportRowError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportRowError) ProtoMessage() {}
+
+func (x *ImportRowError) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_microservice_proto_msgTypes[124]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportRowError.ProtoReflect.Descriptor instead.
+func (*ImportRowError) Descriptor() ([]byte, []int) {
+	return file_grades_microservice_proto_rawDescGZIP(), []int{124}
+}
+
+func (x *ImportRowError) GetLine() int32 {
+	if x != nil {
+		return x.Line
+	}
+	return 0
+}
+
+func (x *ImportRowError) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Response message after importing grades from a CSV file.
+type ImportGradesCSVResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Number of grades added; 0 on a dry run.
+	ImportedCount int32 `protobuf:"varint,1,opt,name=imported_count,json=importedCount,proto3" json:"imported_count,omitempty"`
+	// Rows that did not match the mapping or failed validation, by line.
+	Errors []*ImportRowError `protobuf:"bytes,2,rep,name=errors,proto3" json:"errors,omitempty"`
+	// The grades the rows map to, on a dry run.
+	Grades        []*SingleGrade `protobuf:"bytes,3,rep,name=grades,proto3" json:"grades,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportGradesCSVResponse) Reset() {
+	*x = ImportGradesCSVResponse{}
+	mi := &file_grades_microservice_proto_msgTypes[125]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportGradesCSVResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportGradesCSVResponse) ProtoMessage() {}
+
+func (x *ImportGradesCSVResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_microservice_proto_msgTypes[125]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportGradesCSVResponse.ProtoReflect.Descriptor instead.
+func (*ImportGradesCSVResponse) Descriptor() ([]byte, []int) {
+	return file_grades_microservice_proto_rawDescGZIP(), []int{125}
+}
+
+func (x *ImportGradesCSVResponse) GetImportedCount() int32 {
+	if x != nil {
+		return x.ImportedCount
+	}
+	return 0
+}
+
+func (x *ImportGradesCSVResponse) GetErrors() []*ImportRowError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+func (x *ImportGradesCSVResponse) GetGrades() []*SingleGrade {
+	if x != nil {
+		return x.Grades
+	}
+	return nil
+}
+
+// Request message for appealing a grade.
+type FileAppealRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Authentication token for authorization.
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// Identifier for the grade entry.
+	GradeID       string `protobuf:"bytes,2,opt,name=gradeID,proto3" json:"gradeID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FileAppealRequest) Reset() {
+	*x = FileAppealRequest{}
+	mi := &file_grades_microservice_proto_msgTypes[126]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FileAppealRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileAppealRequest) ProtoMessage() {}
+
+func (x *FileAppealRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_microservice_proto_msgTypes[126]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileAppealRequest.ProtoReflect.Descriptor instead.
+func (*FileAppealRequest) Descriptor() ([]byte, []int) {
+	return file_grades_microservice_proto_rawDescGZIP(), []int{126}
+}
+
+func (x *FileAppealRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *FileAppealRequest) GetGradeID() string {
+	if x != nil {
+		return x.GradeID
+	}
+	return ""
+}
+
+// Response message containing the appealed grade.
+type FileAppealResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The grade, now under appeal.
+	Grade         *SingleGrade `protobuf:"bytes,1,opt,name=grade,proto3" json:"grade,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FileAppealResponse) Reset() {
+	*x = FileAppealResponse{}
+	mi := &file_grades_microservice_proto_msgTypes[127]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FileAppealResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileAppealResponse) ProtoMessage() {}
+
+func (x *FileAppealResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_microservice_proto_msgTypes[127]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileAppealResponse.ProtoReflect.Descriptor instead.
+func (*FileAppealResponse) Descriptor() ([]byte, []int) {
+	return file_grades_microservice_proto_rawDescGZIP(), []int{127}
+}
+
+func (x *FileAppealResponse) GetGrade() *SingleGrade {
+	if x != nil {
+		return x.Grade
+	}
+	return nil
+}
+
+// Request message for resolving the appeal of a grade.
+type ResolveAppealRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Authentication token for authorization.
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// Identifier for the grade entry.
+	GradeID string `protobuf:"bytes,2,opt,name=gradeID,proto3" json:"gradeID,omitempty"`
+	// "accepted" or "rejected".
+	Outcome string `protobuf:"bytes,3,opt,name=outcome,proto3" json:"outcome,omitempty"`
+	// The new value of the grade; required when accepting, and must be empty when rejecting.
+	GradeValue    string `protobuf:"bytes,4,opt,name=grade_value,json=gradeValue,proto3" json:"grade_value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveAppealRequest) Reset() {
+	*x = ResolveAppealRequest{}
+	mi := &file_grades_microservice_proto_msgTypes[128]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveAppealRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveAppealRequest) ProtoMessage() {}
+
+func (x *ResolveAppealRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_microservice_proto_msgTypes[128]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveAppealRequest.ProtoReflect.Descriptor instead.
+func (*ResolveAppealRequest) Descriptor() ([]byte, []int) {
+	return file_grades_microservice_proto_rawDescGZIP(), []int{128}
+}
+
+func (x *ResolveAppealRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *ResolveAppealRequest) GetGradeID() string {
+	if x != nil {
+		return x.GradeID
+	}
+	return ""
+}
+
+func (x *ResolveAppealRequest) GetOutcome() string {
+	if x != nil {
+		return x.Outcome
+	}
+	return ""
+}
+
+func (x *ResolveAppealRequest) GetGradeValue() string {
+	if x != nil {
+		return x.GradeValue
+	}
+	return ""
+}
+
+// Response message containing the grade after its appeal was resolved.
+type ResolveAppealResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The grade with its appeal resolved.
+	Grade         *SingleGrade `protobuf:"bytes,1,opt,name=grade,proto3" json:"grade,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveAppealResponse) Reset() {
+	*x = ResolveAppealResponse{}
+	mi := &file_grades_microservice_proto_msgTypes[129]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveAppealResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveAppealResponse) ProtoMessage() {}
+
+func (x *ResolveAppealResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_microservice_proto_msgTypes[129]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveAppealResponse.ProtoReflect.Descriptor instead.
+func (*ResolveAppealResponse) Descriptor() ([]byte, []int) {
+	return file_grades_microservice_proto_rawDescGZIP(), []int{129}
+}
+
+func (x *ResolveAppealResponse) GetGrade() *SingleGrade {
+	if x != nil {
+		return x.Grade
+	}
+	return nil
+}
+
+// Request message for watching the grades of a course.
+type WatchCourseGradesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Authentication token for authorization.
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// Identifier for the course.
+	CourseID string `protobuf:"bytes,2,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	// The academic semester.
+	Semester string `protobuf:"bytes,3,opt,name=semester,proto3" json:"semester,omitempty"`
+	// Locale, such as "de" or "en-US", whose labels fill display_value of the returned grades.
+	Locale        string `protobuf:"bytes,4,opt,name=locale,proto3" json:"locale,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchCourseGradesRequest) Reset() {
+	*x = WatchCourseGradesRequest{}
+	mi := &file_grades_microservice_proto_msgTypes[130]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchCourseGradesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchCourseGradesRequest) ProtoMessage() {}
+
+func (x *WatchCourseGradesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_microservice_proto_msgTypes[130]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchCourseGradesRequest.ProtoReflect.Descriptor instead.
+func (*WatchCourseGradesRequest) Descriptor() ([]byte, []int) {
+	return file_grades_microservice_proto_rawDescGZIP(), []int{130}
+}
+
+func (x *WatchCourseGradesRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *WatchCourseGradesRequest) GetCourseID() string {
+	if x != nil {
+		return x.CourseID
+	}
+	return ""
+}
+
+func (x *WatchCourseGradesRequest) GetSemester() string {
+	if x != nil {
+		return x.Semester
+	}
+	return ""
+}
+
+func (x *WatchCourseGradesRequest) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+// A grade of the watched course, or a change to one.
+type GradeChange struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// "snapshot" for the grades the watch starts with, "snapshot.complete" once they were all sent,
+	// then "grade.added", "grade.updated" or "grade.removed".
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	// The grade; only grade_id is set for removed grades, and it is unset for snapshot.complete.
+	Grade         *SingleGrade `protobuf:"bytes,2,opt,name=grade,proto3" json:"grade,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GradeChange) Reset() {
+	*x = GradeChange{}
+	mi := &file_grades_microservice_proto_msgTypes[131]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GradeChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GradeChange) ProtoMessage() {}
+
+func (x *GradeChange) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_microservice_proto_msgTypes[131]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GradeChange.ProtoReflect.Descriptor instead.
+func (*GradeChange) Descriptor() ([]byte, []int) {
+	return file_grades_microservice_proto_rawDescGZIP(), []int{131}
+}
+
+func (x *GradeChange) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *GradeChange) GetGrade() *SingleGrade {
+	if x != nil {
+		return x.Grade
+	}
+	return nil
+}
+
+// Request message for exporting a de-identified research dataset.
+type ExportResearchDatasetRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Authentication token for authorization.
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// The academic semesters to export.
+	Semesters []string `protobuf:"bytes,2,rep,name=semesters,proto3" json:"semesters,omitempty"`
+	// Minimum number of students sharing the course, period and grade type of a record; raises the
+	// configured minimum, never lowers it.
+	K int32 `protobuf:"varint,3,opt,name=k,proto3" json:"k,omitempty"`
+	// Number of calendar years semesters are bucketed into as the period of a record; 1 when unset.
+	PeriodYears   int32 `protobuf:"varint,4,opt,name=period_years,json=periodYears,proto3" json:"period_years,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportResearchDatasetRequest) Reset() {
+	*x = ExportResearchDatasetRequest{}
+	mi := &file_grades_microservice_proto_msgTypes[132]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportResearchDatasetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportResearchDatasetRequest) ProtoMessage() {}
+
+func (x *ExportResearchDatasetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_microservice_proto_msgTypes[132]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportResearchDatasetRequest.ProtoReflect.Descriptor instead.
+func (*ExportResearchDatasetRequest) Descriptor() ([]byte, []int) {
+	return file_grades_microservice_proto_rawDescGZIP(), []int{132}
+}
+
+func (x *ExportResearchDatasetRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *ExportResearchDatasetRequest) GetSemesters() []string {
+	if x != nil {
+		return x.Semesters
+	}
+	return nil
+}
+
+func (x *ExportResearchDatasetRequest) GetK() int32 {
+	if x != nil {
+		return x.K
+	}
+	return 0
+}
+
+func (x *ExportResearchDatasetRequest) GetPeriodYears() int32 {
+	if x != nil {
+		return x.PeriodYears
+	}
+	return 0
+}
+
+// A de-identified grade.
+type ResearchRecord struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Identifier for the course.
+	CourseID string `protobuf:"bytes,1,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	// The range of calendar years of the semester, such as "2023" or "2022-2023".
+	Period string `protobuf:"bytes,2,opt,name=period,proto3" json:"period,omitempty"`
+	// Type of the grade, or "*" when generalized away to reach k.
+	GradeType string `protobuf:"bytes,3,opt,name=grade_type,json=gradeType,proto3" json:"grade_type,omitempty"`
+	// The value of the grade.
+	GradeValue    string `protobuf:"bytes,4,opt,name=grade_value,json=gradeValue,proto3" json:"grade_value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResearchRecord) Reset() {
+	*x = ResearchRecord{}
+	mi := &file_grades_microservice_proto_msgTypes[133]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResearchRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResearchRecord) ProtoMessage() {}
+
+func (x *ResearchRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_microservice_proto_msgTypes[133]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResearchRecord.ProtoReflect.Descriptor instead.
+func (*ResearchRecord) Descriptor() ([]byte, []int) {
+	return file_grades_microservice_proto_rawDescGZIP(), []int{133}
+}
+
+func (x *ResearchRecord) GetCourseID() string {
+	if x != nil {
+		return x.CourseID
+	}
+	return ""
+}
+
+func (x *ResearchRecord) GetPeriod() string {
+	if x != nil {
+		return x.Period
+	}
+	return ""
+}
+
+func (x *ResearchRecord) GetGradeType() string {
+	if x != nil {
+		return x.GradeType
+	}
+	return ""
+}
+
+func (x *ResearchRecord) GetGradeValue() string {
+	if x != nil {
+		return x.GradeValue
+	}
+	return ""
+}
+
+// Response message containing a de-identified research dataset.
+type ExportResearchDatasetResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The records, sorted by course, period, grade type and value.
+	Records []*ResearchRecord `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"`
+	// The k the dataset is anonymous for.
+	K int32 `protobuf:"varint,2,opt,name=k,proto3" json:"k,omitempty"`
+	// Number of grades read.
+	TotalCount int32 `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	// Number of records whose grade type was generalized to reach k.
+	GeneralizedCount int32 `protobuf:"varint,4,opt,name=generalized_count,json=generalizedCount,proto3" json:"generalized_count,omitempty"`
+	// Number of grades left out as their group stayed smaller than k.
+	SuppressedCount int32 `protobuf:"varint,5,opt,name=suppressed_count,json=suppressedCount,proto3" json:"suppressed_count,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ExportResearchDatasetResponse) Reset() {
+	*x = ExportResearchDatasetResponse{}
+	mi := &file_grades_microservice_proto_msgTypes[134]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportResearchDatasetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportResearchDatasetResponse) ProtoMessage() {}
+
+func (x *ExportResearchDatasetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_microservice_proto_msgTypes[134]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportResearchDatasetResponse.ProtoReflect.Descriptor instead.
+func (*ExportResearchDatasetResponse) Descriptor() ([]byte, []int) {
+	return file_grades_microservice_proto_rawDescGZIP(), []int{134}
+}
+
+func (x *ExportResearchDatasetResponse) GetRecords() []*ResearchRecord {
+	if x != nil {
+		return x.Records
+	}
+	return nil
+}
+
+func (x *ExportResearchDatasetResponse) GetK() int32 {
+	if x != nil {
+		return x.K
+	}
+	return 0
+}
+
+func (x *ExportResearchDatasetResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *ExportResearchDatasetResponse) GetGeneralizedCount() int32 {
+	if x != nil {
+		return x.GeneralizedCount
+	}
+	return 0
+}
+
+func (x *ExportResearchDatasetResponse) GetSuppressedCount() int32 {
+	if x != nil {
+		return x.SuppressedCount
+	}
+	return 0
+}
+
+// Request message for exporting the transcript of a student as a PDF document.
+type ExportTranscriptPDFRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Authentication token for authorization.
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// Identifier for the student.
+	StudentID     string `protobuf:"bytes,2,opt,name=studentID,proto3" json:"studentID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportTranscriptPDFRequest) Reset() {
+	*x = ExportTranscriptPDFRequest{}
+	mi := &file_grades_microservice_proto_msgTypes[135]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportTranscriptPDFRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportTranscriptPDFRequest) ProtoMessage() {}
+
+func (x *ExportTranscriptPDFRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_microservice_proto_msgTypes[135]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportTranscriptPDFRequest.ProtoReflect.Descriptor instead.
+func (*ExportTranscriptPDFRequest) Descriptor() ([]byte, []int) {
+	return file_grades_microservice_proto_rawDescGZIP(), []int{135}
+}
+
+func (x *ExportTranscriptPDFRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *ExportTranscriptPDFRequest) GetStudentID() string {
+	if x != nil {
+		return x.StudentID
+	}
+	return ""
+}
+
+// Response message containing a transcript as a PDF document.
+type ExportTranscriptPDFResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The PDF document.
+	Pdf []byte `protobuf:"bytes,1,opt,name=pdf,proto3" json:"pdf,omitempty"`
+	// Suggested file name for the document.
+	Filename      string `protobuf:"bytes,2,opt,name=filename,proto3" json:"filename,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportTranscriptPDFResponse) Reset() {
+	*x = ExportTranscriptPDFResponse{}
+	mi := &file_grades_microservice_proto_msgTypes[136]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportTranscriptPDFResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportTranscriptPDFResponse) ProtoMessage() {}
+
+func (x *ExportTranscriptPDFResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_microservice_proto_msgTypes[136]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportTranscriptPDFResponse.ProtoReflect.Descriptor instead.
+func (*ExportTranscriptPDFResponse) Descriptor() ([]byte, []int) {
+	return file_grades_microservice_proto_rawDescGZIP(), []int{136}
+}
+
+func (x *ExportTranscriptPDFResponse) GetPdf() []byte {
+	if x != nil {
+		return x.Pdf
+	}
+	return nil
+}
+
+func (x *ExportTranscriptPDFResponse) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+// Response message containing the grades added by an atomic batch add.
+type BatchAddGradesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The added grades, with their generated IDs, in request order.
+	Grades        []*SingleGrade `protobuf:"bytes,1,rep,name=grades,proto3" json:"grades,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchAddGradesResponse) Reset() {
+	*x = BatchAddGradesResponse{}
+	mi := &file_grades_microservice_proto_msgTypes[137]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchAddGradesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchAddGradesResponse) ProtoMessage() {}
+
+func (x *BatchAddGradesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_microservice_proto_msgTypes[137]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchAddGradesResponse.ProtoReflect.Descriptor instead.
+func (*BatchAddGradesResponse) Descriptor() ([]byte, []int) {
+	return file_grades_microservice_proto_rawDescGZIP(), []int{137}
+}
+
+func (x *BatchAddGradesResponse) GetGrades() []*SingleGrade {
+	if x != nil {
+		return x.Grades
+	}
+	return nil
+}
+
+// Request message for normalizing the grades of a course item across its sections.
+type NormalizeAcrossSectionsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Authentication token for authorization.
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// Identifier for the course.
+	CourseID string `protobuf:"bytes,2,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	// The academic semester.
+	Semester string `protobuf:"bytes,3,opt,name=semester,proto3" json:"semester,omitempty"`
+	// Identifier for the graded item.
+	ItemID string `protobuf:"bytes,4,opt,name=itemID,proto3" json:"itemID,omitempty"`
+	// The normalization: z_score maps each section onto the mean and standard deviation of the
+	// course, mean_shift moves each section's mean onto the course mean. Defaults to z_score.
+	Method string `protobuf:"bytes,5,opt,name=method,proto3" json:"method,omitempty"`
+	// Store the normalized grades; when false they are only previewed.
+	Apply         bool `protobuf:"varint,6,opt,name=apply,proto3" json:"apply,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NormalizeAcrossSectionsRequest) Reset() {
+	*x = NormalizeAcrossSectionsRequest{}
+	mi := &file_grades_microservice_proto_msgTypes[138]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NormalizeAcrossSectionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NormalizeAcrossSectionsRequest) ProtoMessage() {}
+
+func (x *NormalizeAcrossSectionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_microservice_proto_msgTypes[138]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NormalizeAcrossSectionsRequest.ProtoReflect.Descriptor instead.
+func (*NormalizeAcrossSectionsRequest) Descriptor() ([]byte, []int) {
+	return file_grades_microservice_proto_rawDescGZIP(), []int{138}
+}
+
+func (x *NormalizeAcrossSectionsRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *NormalizeAcrossSectionsRequest) GetCourseID() string {
+	if x != nil {
+		return x.CourseID
+	}
+	return ""
+}
+
+func (x *NormalizeAcrossSectionsRequest) GetSemester() string {
+	if x != nil {
+		return x.Semester
+	}
+	return ""
+}
+
+func (x *NormalizeAcrossSectionsRequest) GetItemID() string {
+	if x != nil {
+		return x.ItemID
+	}
+	return ""
+}
+
+func (x *NormalizeAcrossSectionsRequest) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *NormalizeAcrossSectionsRequest) GetApply() bool {
+	if x != nil {
+		return x.Apply
+	}
+	return false
+}
+
+// The numeric grades of a section before and after normalization.
+type SectionStats struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Identifier for the section; empty for grades without a section.
+	SectionID string `protobuf:"bytes,1,opt,name=sectionID,proto3" json:"sectionID,omitempty"`
+	// Number of numeric grades in the section.
+	Count int32 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	// Mean of the grades before normalization.
+	Mean float64 `protobuf:"fixed64,3,opt,name=mean,proto3" json:"mean,omitempty"`
+	// Population standard deviation of the grades before normalization.
+	StdDev float64 `protobuf:"fixed64,4,opt,name=std_dev,json=stdDev,proto3" json:"std_dev,omitempty"`
+	// Mean of the normalized grades.
+	NormalizedMean float64 `protobuf:"fixed64,5,opt,name=normalized_mean,json=normalizedMean,proto3" json:"normalized_mean,omitempty"`
+	// Distribution of the normalized values, in ascending order.
+	After         []*GradeValueCount `protobuf:"bytes,6,rep,name=after,proto3" json:"after,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SectionStats) Reset() {
+	*x = SectionStats{}
+	mi := &file_grades_microservice_proto_msgTypes[139]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SectionStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SectionStats) ProtoMessage() {}
+
+func (x *SectionStats) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_microservice_proto_msgTypes[139]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SectionStats.ProtoReflect.Descriptor instead.
+func (*SectionStats) Descriptor() ([]byte, []int) {
+	return file_grades_microservice_proto_rawDescGZIP(), []int{139}
+}
+
+func (x *SectionStats) GetSectionID() string {
+	if x != nil {
+		return x.SectionID
+	}
+	return ""
+}
+
+func (x *SectionStats) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *SectionStats) GetMean() float64 {
+	if x != nil {
+		return x.Mean
+	}
+	return 0
+}
+
+func (x *SectionStats) GetStdDev() float64 {
+	if x != nil {
+		return x.StdDev
+	}
+	return 0
+}
+
+func (x *SectionStats) GetNormalizedMean() float64 {
+	if x != nil {
+		return x.NormalizedMean
+	}
+	return 0
+}
+
+func (x *SectionStats) GetAfter() []*GradeValueCount {
+	if x != nil {
+		return x.After
+	}
+	return nil
+}
+
+// A student's grade before and after normalization.
+type NormalizedGrade struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Identifier for the grade entry.
+	GradeID string `protobuf:"bytes,1,opt,name=gradeID,proto3" json:"gradeID,omitempty"`
+	// Identifier for the student.
+	StudentID string `protobuf:"bytes,2,opt,name=studentID,proto3" json:"studentID,omitempty"`
+	// Identifier for the section of the grade.
+	SectionID string `protobuf:"bytes,3,opt,name=sectionID,proto3" json:"sectionID,omitempty"`
+	// The current grade value.
+	Before string `protobuf:"bytes,4,opt,name=before,proto3" json:"before,omitempty"`
+	// The normalized grade value.
+	After         string `protobuf:"bytes,5,opt,name=after,proto3" json:"after,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NormalizedGrade) Reset() {
+	*x = NormalizedGrade{}
+	mi := &file_grades_microservice_proto_msgTypes[140]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NormalizedGrade) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NormalizedGrade) ProtoMessage() {}
+
+func (x *NormalizedGrade) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_microservice_proto_msgTypes[140]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NormalizedGrade.ProtoReflect.Descriptor instead.
+func (*NormalizedGrade) Descriptor() ([]byte, []int) {
+	return file_grades_microservice_proto_rawDescGZIP(), []int{140}
+}
+
+func (x *NormalizedGrade) GetGradeID() string {
+	if x != nil {
+		return x.GradeID
+	}
+	return ""
+}
+
+func (x *NormalizedGrade) GetStudentID() string {
+	if x != nil {
+		return x.StudentID
+	}
+	return ""
+}
+
+func (x *NormalizedGrade) GetSectionID() string {
+	if x != nil {
+		return x.SectionID
+	}
+	return ""
+}
+
+func (x *NormalizedGrade) GetBefore() string {
+	if x != nil {
+		return x.Before
+	}
+	return ""
+}
+
+func (x *NormalizedGrade) GetAfter() string {
+	if x != nil {
+		return x.After
+	}
+	return ""
+}
+
+// Response message with the normalization of a course item across its sections.
+type NormalizeAcrossSectionsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Every section with numeric grades, by section ID.
+	Sections []*SectionStats `protobuf:"bytes,1,rep,name=sections,proto3" json:"sections,omitempty"`
+	// Every numeric grade of the item before and after normalization.
+	Grades []*NormalizedGrade `protobuf:"bytes,2,rep,name=grades,proto3" json:"grades,omitempty"`
+	// Mean of the numeric grades of the whole course item.
+	CourseMean float64 `protobuf:"fixed64,3,opt,name=course_mean,json=courseMean,proto3" json:"course_mean,omitempty"`
+	// Population standard deviation of the numeric grades of the whole course item.
+	CourseStdDev float64 `protobuf:"fixed64,4,opt,name=course_std_dev,json=courseStdDev,proto3" json:"course_std_dev,omitempty"`
+	// True when the normalized grades were stored.
+	Applied bool `protobuf:"varint,5,opt,name=applied,proto3" json:"applied,omitempty"`
+	// Number of grades left unchanged by an apply because they are under appeal.
+	SkippedCount  int32 `protobuf:"varint,6,opt,name=skipped_count,json=skippedCount,proto3" json:"skipped_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NormalizeAcrossSectionsResponse) Reset() {
+	*x = NormalizeAcrossSectionsResponse{}
+	mi := &file_grades_microservice_proto_msgTypes[141]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NormalizeAcrossSectionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NormalizeAcrossSectionsResponse) ProtoMessage() {}
+
+func (x *NormalizeAcrossSectionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_microservice_proto_msgTypes[141]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NormalizeAcrossSectionsResponse.ProtoReflect.Descriptor instead.
+func (*NormalizeAcrossSectionsResponse) Descriptor() ([]byte, []int) {
+	return file_grades_microservice_proto_rawDescGZIP(), []int{141}
+}
+
+func (x *NormalizeAcrossSectionsResponse) GetSections() []*SectionStats {
+	if x != nil {
+		return x.Sections
+	}
+	return nil
+}
+
+func (x *NormalizeAcrossSectionsResponse) GetGrades() []*NormalizedGrade {
+	if x != nil {
+		return x.Grades
+	}
+	return nil
+}
+
+func (x *NormalizeAcrossSectionsResponse) GetCourseMean() float64 {
+	if x != nil {
+		return x.CourseMean
+	}
+	return 0
+}
+
+func (x *NormalizeAcrossSectionsResponse) GetCourseStdDev() float64 {
+	if x != nil {
+		return x.CourseStdDev
+	}
+	return 0
+}
+
+func (x *NormalizeAcrossSectionsResponse) GetApplied() bool {
+	if x != nil {
+		return x.Applied
+	}
+	return false
+}
+
+func (x *NormalizeAcrossSectionsResponse) GetSkippedCount() int32 {
+	if x != nil {
+		return x.SkippedCount
+	}
+	return 0
+}
+
+// Request message for a student's semester GPA.
+type GetStudentSemesterGPARequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Authentication token for authorization.
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// Identifier for the student.
+	StudentID string `protobuf:"bytes,2,opt,name=studentID,proto3" json:"studentID,omitempty"`
+	// The academic semester.
+	Semester      string `protobuf:"bytes,3,opt,name=semester,proto3" json:"semester,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStudentSemesterGPARequest) Reset() {
+	*x = GetStudentSemesterGPARequest{}
+	mi := &file_grades_microservice_proto_msgTypes[142]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStudentSemesterGPARequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStudentSemesterGPARequest) ProtoMessage() {}
+
+func (x *GetStudentSemesterGPARequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_microservice_proto_msgTypes[142]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStudentSemesterGPARequest.ProtoReflect.Descriptor instead.
+func (*GetStudentSemesterGPARequest) Descriptor() ([]byte, []int) {
+	return file_grades_microservice_proto_rawDescGZIP(), []int{142}
+}
+
+func (x *GetStudentSemesterGPARequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *GetStudentSemesterGPARequest) GetStudentID() string {
+	if x != nil {
+		return x.StudentID
+	}
+	return ""
+}
+
+func (x *GetStudentSemesterGPARequest) GetSemester() string {
+	if x != nil {
+		return x.Semester
+	}
+	return ""
+}
+
+// Response message with a student's semester GPA.
+type GetStudentSemesterGPAResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Average grade points of the included grades, weighted by course credits, to two decimals.
+	// Zero when no grade is included.
+	Gpa float64 `protobuf:"fixed64,1,opt,name=gpa,proto3" json:"gpa,omitempty"`
+	// Number of letter grades averaged.
+	IncludedCount int32 `protobuf:"varint,2,opt,name=included_count,json=includedCount,proto3" json:"included_count,omitempty"`
+	// Number of grades left out as the grade point scale does not cover them, such as numeric or
+	// pass/fail grades.
+	ExcludedCount int32 `protobuf:"varint,3,opt,name=excluded_count,json=excludedCount,proto3" json:"excluded_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStudentSemesterGPAResponse) Reset() {
+	*x = GetStudentSemesterGPAResponse{}
+	mi := &file_grades_microservice_proto_msgTypes[143]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStudentSemesterGPAResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStudentSemesterGPAResponse) ProtoMessage() {}
+
+func (x *GetStudentSemesterGPAResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_microservice_proto_msgTypes[143]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStudentSemesterGPAResponse.ProtoReflect.Descriptor instead.
+func (*GetStudentSemesterGPAResponse) Descriptor() ([]byte, []int) {
+	return file_grades_microservice_proto_rawDescGZIP(), []int{143}
+}
+
+func (x *GetStudentSemesterGPAResponse) GetGpa() float64 {
+	if x != nil {
+		return x.Gpa
+	}
+	return 0
+}
+
+func (x *GetStudentSemesterGPAResponse) GetIncludedCount() int32 {
+	if x != nil {
+		return x.IncludedCount
+	}
+	return 0
+}
+
+func (x *GetStudentSemesterGPAResponse) GetExcludedCount() int32 {
+	if x != nil {
+		return x.ExcludedCount
+	}
+	return 0
+}
+
+// Request message for ranking the students of a course by weighted final grade.
+type GetWeightedCourseRankingRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Authentication token for authorization.
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// Identifier for the course.
+	CourseID string `protobuf:"bytes,2,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	// The academic semester.
+	Semester string `protobuf:"bytes,3,opt,name=semester,proto3" json:"semester,omitempty"`
+	// How students missing weighted components are handled: zero scores the missing components 0,
+	// renormalize averages over the graded components only, and exclude leaves the students
+	// unranked. The server's policy when empty.
+	MissingPolicy string `protobuf:"bytes,4,opt,name=missing_policy,json=missingPolicy,proto3" json:"missing_policy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWeightedCourseRankingRequest) Reset() {
+	*x = GetWeightedCourseRankingRequest{}
+	mi := &file_grades_microservice_proto_msgTypes[144]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWeightedCourseRankingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWeightedCourseRankingRequest) ProtoMessage() {}
+
+func (x *GetWeightedCourseRankingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_microservice_proto_msgTypes[144]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWeightedCourseRankingRequest.ProtoReflect.Descriptor instead.
+func (*GetWeightedCourseRankingRequest) Descriptor() ([]byte, []int) {
+	return file_grades_microservice_proto_rawDescGZIP(), []int{144}
+}
+
+func (x *GetWeightedCourseRankingRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *GetWeightedCourseRankingRequest) GetCourseID() string {
+	if x != nil {
+		return x.CourseID
+	}
+	return ""
+}
+
+func (x *GetWeightedCourseRankingRequest) GetSemester() string {
+	if x != nil {
+		return x.Semester
+	}
+	return ""
+}
+
+func (x *GetWeightedCourseRankingRequest) GetMissingPolicy() string {
+	if x != nil {
+		return x.MissingPolicy
+	}
+	return ""
+}
+
+// A student's place in a course ranked by weighted final grade.
+type WeightedRankingEntry struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Position in the ranking, starting at 1. Tied students share the best rank of their group.
+	Rank int32 `protobuf:"varint,1,opt,name=rank,proto3" json:"rank,omitempty"`
+	// Identifier for the student.
+	StudentID string `protobuf:"bytes,2,opt,name=studentID,proto3" json:"studentID,omitempty"`
+	// The student's weighted final grade.
+	WeightedFinal float64 `protobuf:"fixed64,3,opt,name=weighted_final,json=weightedFinal,proto3" json:"weighted_final,omitempty"`
+	// Percentage of ranked students with a weighted final at or below the student's.
+	Percentile float64 `protobuf:"fixed64,4,opt,name=percentile,proto3" json:"percentile,omitempty"`
+	// The weighted components the student has no numeric grade for.
+	MissingComponents []string `protobuf:"bytes,5,rep,name=missing_components,json=missingComponents,proto3" json:"missing_components,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *WeightedRankingEntry) Reset() {
+	*x = WeightedRankingEntry{}
+	mi := &file_grades_microservice_proto_msgTypes[145]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WeightedRankingEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WeightedRankingEntry) ProtoMessage() {}
+
+func (x *WeightedRankingEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_microservice_proto_msgTypes[145]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WeightedRankingEntry.ProtoReflect.Descriptor instead.
+func (*WeightedRankingEntry) Descriptor() ([]byte, []int) {
+	return file_grades_microservice_proto_rawDescGZIP(), []int{145}
+}
+
+func (x *WeightedRankingEntry) GetRank() int32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *WeightedRankingEntry) GetStudentID() string {
+	if x != nil {
+		return x.StudentID
+	}
+	return ""
+}
+
+func (x *WeightedRankingEntry) GetWeightedFinal() float64 {
+	if x != nil {
+		return x.WeightedFinal
+	}
+	return 0
+}
+
+func (x *WeightedRankingEntry) GetPercentile() float64 {
 	if x != nil {
-		return x.Comments
+		return x.Percentile
+	}
+	return 0
+}
+
+func (x *WeightedRankingEntry) GetMissingComponents() []string {
+	if x != nil {
+		return x.MissingComponents
+	}
+	return nil
+}
+
+// Response message with the students of a course ranked by weighted final grade, best first.
+type GetWeightedCourseRankingResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ranked students.
+	Entries []*WeightedRankingEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	// Students left unranked, having nothing graded or missing components under the exclude policy.
+	UnrankedStudents []string `protobuf:"bytes,2,rep,name=unranked_students,json=unrankedStudents,proto3" json:"unranked_students,omitempty"`
+	// The missing component policy applied.
+	MissingPolicy string `protobuf:"bytes,3,opt,name=missing_policy,json=missingPolicy,proto3" json:"missing_policy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWeightedCourseRankingResponse) Reset() {
+	*x = GetWeightedCourseRankingResponse{}
+	mi := &file_grades_microservice_proto_msgTypes[146]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWeightedCourseRankingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWeightedCourseRankingResponse) ProtoMessage() {}
+
+func (x *GetWeightedCourseRankingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_microservice_proto_msgTypes[146]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWeightedCourseRankingResponse.ProtoReflect.Descriptor instead.
+func (*GetWeightedCourseRankingResponse) Descriptor() ([]byte, []int) {
+	return file_grades_microservice_proto_rawDescGZIP(), []int{146}
+}
+
+func (x *GetWeightedCourseRankingResponse) GetEntries() []*WeightedRankingEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *GetWeightedCourseRankingResponse) GetUnrankedStudents() []string {
+	if x != nil {
+		return x.UnrankedStudents
+	}
+	return nil
+}
+
+func (x *GetWeightedCourseRankingResponse) GetMissingPolicy() string {
+	if x != nil {
+		return x.MissingPolicy
+	}
+	return ""
+}
+
+// Request message for the statistics of a course's numeric grades.
+type GetCourseGradeStatisticsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Authentication token for authorization.
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// Identifier for the course.
+	CourseID string `protobuf:"bytes,2,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	// The academic semester.
+	Semester string `protobuf:"bytes,3,opt,name=semester,proto3" json:"semester,omitempty"`
+	// Only grades of this type, matched case-insensitively; every grade when empty.
+	GradeType     string `protobuf:"bytes,4,opt,name=grade_type,json=gradeType,proto3" json:"grade_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCourseGradeStatisticsRequest) Reset() {
+	*x = GetCourseGradeStatisticsRequest{}
+	mi := &file_grades_microservice_proto_msgTypes[147]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCourseGradeStatisticsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCourseGradeStatisticsRequest) ProtoMessage() {}
+
+func (x *GetCourseGradeStatisticsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_microservice_proto_msgTypes[147]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCourseGradeStatisticsRequest.ProtoReflect.Descriptor instead.
+func (*GetCourseGradeStatisticsRequest) Descriptor() ([]byte, []int) {
+	return file_grades_microservice_proto_rawDescGZIP(), []int{147}
+}
+
+func (x *GetCourseGradeStatisticsRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *GetCourseGradeStatisticsRequest) GetCourseID() string {
+	if x != nil {
+		return x.CourseID
+	}
+	return ""
+}
+
+func (x *GetCourseGradeStatisticsRequest) GetSemester() string {
+	if x != nil {
+		return x.Semester
+	}
+	return ""
+}
+
+func (x *GetCourseGradeStatisticsRequest) GetGradeType() string {
+	if x != nil {
+		return x.GradeType
+	}
+	return ""
+}
+
+// Response message with the statistics of a course's numeric grades. Every statistic is zero when
+// there are no numeric grades.
+type GetCourseGradeStatisticsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Mean of the numeric grades.
+	Mean float64 `protobuf:"fixed64,1,opt,name=mean,proto3" json:"mean,omitempty"`
+	// Median of the numeric grades, the mean of the two middle grades when their number is even.
+	Median float64 `protobuf:"fixed64,2,opt,name=median,proto3" json:"median,omitempty"`
+	// Lowest numeric grade.
+	Min float64 `protobuf:"fixed64,3,opt,name=min,proto3" json:"min,omitempty"`
+	// Highest numeric grade.
+	Max float64 `protobuf:"fixed64,4,opt,name=max,proto3" json:"max,omitempty"`
+	// Population standard deviation of the numeric grades.
+	StdDev float64 `protobuf:"fixed64,5,opt,name=std_dev,json=stdDev,proto3" json:"std_dev,omitempty"`
+	// Number of grades whose value is a number.
+	NumericCount int32 `protobuf:"varint,6,opt,name=numeric_count,json=numericCount,proto3" json:"numeric_count,omitempty"`
+	// Number of grades skipped as their value is not a number.
+	NonNumericCount int32 `protobuf:"varint,7,opt,name=non_numeric_count,json=nonNumericCount,proto3" json:"non_numeric_count,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetCourseGradeStatisticsResponse) Reset() {
+	*x = GetCourseGradeStatisticsResponse{}
+	mi := &file_grades_microservice_proto_msgTypes[148]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCourseGradeStatisticsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCourseGradeStatisticsResponse) ProtoMessage() {}
+
+func (x *GetCourseGradeStatisticsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_microservice_proto_msgTypes[148]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCourseGradeStatisticsResponse.ProtoReflect.Descriptor instead.
+func (*GetCourseGradeStatisticsResponse) Descriptor() ([]byte, []int) {
+	return file_grades_microservice_proto_rawDescGZIP(), []int{148}
+}
+
+func (x *GetCourseGradeStatisticsResponse) GetMean() float64 {
+	if x != nil {
+		return x.Mean
+	}
+	return 0
+}
+
+func (x *GetCourseGradeStatisticsResponse) GetMedian() float64 {
+	if x != nil {
+		return x.Median
+	}
+	return 0
+}
+
+func (x *GetCourseGradeStatisticsResponse) GetMin() float64 {
+	if x != nil {
+		return x.Min
+	}
+	return 0
+}
+
+func (x *GetCourseGradeStatisticsResponse) GetMax() float64 {
+	if x != nil {
+		return x.Max
+	}
+	return 0
+}
+
+func (x *GetCourseGradeStatisticsResponse) GetStdDev() float64 {
+	if x != nil {
+		return x.StdDev
+	}
+	return 0
+}
+
+func (x *GetCourseGradeStatisticsResponse) GetNumericCount() int32 {
+	if x != nil {
+		return x.NumericCount
+	}
+	return 0
+}
+
+func (x *GetCourseGradeStatisticsResponse) GetNonNumericCount() int32 {
+	if x != nil {
+		return x.NonNumericCount
+	}
+	return 0
+}
+
+// Request message to insert synthetic grades into a course for load testing.
+type GenerateTestGradesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Authentication token.
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// The course the grades are inserted into.
+	CourseID string `protobuf:"bytes,2,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	// The academic semester.
+	Semester string `protobuf:"bytes,3,opt,name=semester,proto3" json:"semester,omitempty"`
+	// Number of grades to insert.
+	Count         int32 `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenerateTestGradesRequest) Reset() {
+	*x = GenerateTestGradesRequest{}
+	mi := &file_grades_microservice_proto_msgTypes[149]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateTestGradesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateTestGradesRequest) ProtoMessage() {}
+
+func (x *GenerateTestGradesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_microservice_proto_msgTypes[149]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateTestGradesRequest.ProtoReflect.Descriptor instead.
+func (*GenerateTestGradesRequest) Descriptor() ([]byte, []int) {
+	return file_grades_microservice_proto_rawDescGZIP(), []int{149}
+}
+
+func (x *GenerateTestGradesRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *GenerateTestGradesRequest) GetCourseID() string {
+	if x != nil {
+		return x.CourseID
+	}
+	return ""
+}
+
+func (x *GenerateTestGradesRequest) GetSemester() string {
+	if x != nil {
+		return x.Semester
+	}
+	return ""
+}
+
+func (x *GenerateTestGradesRequest) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// Response message with the number of synthetic grades inserted.
+type GenerateTestGradesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Number of grades inserted.
+	CreatedCount int32 `protobuf:"varint,1,opt,name=created_count,json=createdCount,proto3" json:"created_count,omitempty"`
+	// Identifier of the run, named in the comments of its grades.
+	Run           string `protobuf:"bytes,2,opt,name=run,proto3" json:"run,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenerateTestGradesResponse) Reset() {
+	*x = GenerateTestGradesResponse{}
+	mi := &file_grades_microservice_proto_msgTypes[150]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateTestGradesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateTestGradesResponse) ProtoMessage() {}
+
+func (x *GenerateTestGradesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_microservice_proto_msgTypes[150]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateTestGradesResponse.ProtoReflect.Descriptor instead.
+func (*GenerateTestGradesResponse) Descriptor() ([]byte, []int) {
+	return file_grades_microservice_proto_rawDescGZIP(), []int{150}
+}
+
+func (x *GenerateTestGradesResponse) GetCreatedCount() int32 {
+	if x != nil {
+		return x.CreatedCount
+	}
+	return 0
+}
+
+func (x *GenerateTestGradesResponse) GetRun() string {
+	if x != nil {
+		return x.Run
 	}
 	return ""
 }
@@ -799,19 +10203,368 @@ var File_grades_microservice_proto protoreflect.FileDescriptor
 
 var file_grades_microservice_proto_rawDesc = []byte{
 	0x0a, 0x19, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x2d, 0x6d, 0x69, 0x63, 0x72, 0x6f, 0x73, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x06, 0x67, 0x72, 0x61,
-	0x64, 0x65, 0x73, 0x22, 0x58, 0x0a, 0x15, 0x41, 0x64, 0x64, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65,
-	0x47, 0x72, 0x61, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05,
-	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b,
-	0x65, 0x6e, 0x12, 0x29, 0x0a, 0x05, 0x67, 0x72, 0x61, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x13, 0x2e, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x2e, 0x53, 0x69, 0x6e, 0x67, 0x6c,
-	0x65, 0x47, 0x72, 0x61, 0x64, 0x65, 0x52, 0x05, 0x67, 0x72, 0x61, 0x64, 0x65, 0x22, 0x43, 0x0a,
-	0x16, 0x41, 0x64, 0x64, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x47, 0x72, 0x61, 0x64, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x05, 0x67, 0x72, 0x61, 0x64, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x2e,
-	0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x47, 0x72, 0x61, 0x64, 0x65, 0x52, 0x05, 0x67, 0x72, 0x61,
-	0x64, 0x65, 0x22, 0x8b, 0x01, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e,
-	0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x47, 0x72, 0x61, 0x64, 0x65, 0x73, 0x52, 0x65, 0x71,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x16, 0x63, 0x6f, 0x6d,
+	0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x67, 0x72, 0x2e, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73,
+	0x2e, 0x76, 0x31, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x22, 0xb9, 0x06, 0x0a, 0x0b, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x47,
+	0x72, 0x61, 0x64, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x72,
+	0x12, 0x18, 0x0a, 0x07, 0x67, 0x72, 0x61, 0x64, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x67, 0x72, 0x61, 0x64, 0x65, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74,
+	0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73,
+	0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72,
+	0x73, 0x65, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72,
+	0x73, 0x65, 0x49, 0x44, 0x12, 0x1d, 0x0a, 0x0a, 0x67, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x67, 0x72, 0x61, 0x64, 0x65, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x74, 0x65, 0x6d, 0x49, 0x44, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x69, 0x74, 0x65, 0x6d, 0x49, 0x44, 0x12, 0x1f, 0x0a, 0x0b, 0x67,
+	0x72, 0x61, 0x64, 0x65, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x67, 0x72, 0x61, 0x64, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1b, 0x0a, 0x09,
+	0x67, 0x72, 0x61, 0x64, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x67, 0x72, 0x61, 0x64, 0x65, 0x64, 0x42, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x18, 0x0a, 0x07, 0x66, 0x6c, 0x61, 0x67, 0x67, 0x65, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x66, 0x6c, 0x61, 0x67, 0x67, 0x65, 0x64, 0x12, 0x48, 0x0a, 0x0d, 0x72, 0x75, 0x62,
+	0x72, 0x69, 0x63, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x23, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x67, 0x72, 0x2e,
+	0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x75, 0x62, 0x72, 0x69, 0x63,
+	0x53, 0x63, 0x6f, 0x72, 0x65, 0x52, 0x0c, 0x72, 0x75, 0x62, 0x72, 0x69, 0x63, 0x53, 0x63, 0x6f,
+	0x72, 0x65, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x69, 0x6e, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x44, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x69, 0x6e, 0x73, 0x74,
+	0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x75, 0x62,
+	0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x15, 0x0a, 0x06,
+	0x64, 0x75, 0x65, 0x5f, 0x61, 0x74, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x75,
+	0x65, 0x41, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65,
+	0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x65, 0x66,
+	0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x37, 0x0a, 0x09,
+	0x67, 0x72, 0x61, 0x64, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x67, 0x72, 0x61,
+	0x64, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x12, 0x27, 0x0a, 0x0f, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x65, 0x6c, 0x65,
+	0x61, 0x73, 0x65, 0x18, 0x13, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x70, 0x65, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x6c,
+	0x65, 0x61, 0x73, 0x65, 0x5f, 0x61, 0x74, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72,
+	0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x41, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x69, 0x73, 0x70,
+	0x6c, 0x61, 0x79, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x15, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x23, 0x0a,
+	0x0d, 0x61, 0x70, 0x70, 0x65, 0x61, 0x6c, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x16,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x70, 0x70, 0x65, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18,
+	0x17, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44,
+	0x22, 0x68, 0x0a, 0x15, 0x41, 0x64, 0x64, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x47, 0x72, 0x61,
+	0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
+	0x39, 0x0a, 0x05, 0x67, 0x72, 0x61, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x67, 0x72, 0x2e, 0x67, 0x72,
+	0x61, 0x64, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x47, 0x72,
+	0x61, 0x64, 0x65, 0x52, 0x05, 0x67, 0x72, 0x61, 0x64, 0x65, 0x22, 0x53, 0x0a, 0x16, 0x41, 0x64,
+	0x64, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x47, 0x72, 0x61, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x05, 0x67, 0x72, 0x61, 0x64, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72,
+	0x67, 0x72, 0x2e, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x6e,
+	0x67, 0x6c, 0x65, 0x47, 0x72, 0x61, 0x64, 0x65, 0x52, 0x05, 0x67, 0x72, 0x61, 0x64, 0x65, 0x22,
+	0xa4, 0x02, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x43, 0x6f,
+	0x75, 0x72, 0x73, 0x65, 0x47, 0x72, 0x61, 0x64, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73,
+	0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73,
+	0x65, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x72, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x72, 0x12,
+	0x1c, 0x0a, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x1b, 0x0a,
+	0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61,
+	0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x6f, 0x63,
+	0x61, 0x6c, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c,
+	0x65, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x61, 0x77, 0x5f, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74,
+	0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x72, 0x61, 0x77, 0x41, 0x74, 0x74, 0x65,
+	0x6d, 0x70, 0x74, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65,
+	0x6e, 0x63, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x73, 0x69,
+	0x73, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x22, 0xf6, 0x01, 0x0a, 0x1e, 0x47, 0x65, 0x74, 0x53, 0x74,
+	0x75, 0x64, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x47, 0x72, 0x61, 0x64, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x06, 0x67, 0x72, 0x61,
+	0x64, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x63, 0x6f, 0x6d, 0x2e,
+	0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x67, 0x72, 0x2e, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x47, 0x72, 0x61, 0x64, 0x65, 0x52, 0x06,
+	0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70,
+	0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x14,
+	0x0a, 0x05, 0x73, 0x74, 0x61, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x73,
+	0x74, 0x61, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x72, 0x65, 0x6e, 0x64, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x72, 0x65, 0x6e, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x72,
+	0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x74,
+	0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x61, 0x74, 0x74, 0x65,
+	0x6d, 0x70, 0x74, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x22,
+	0x6b, 0x0a, 0x18, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x47,
+	0x72, 0x61, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x12, 0x39, 0x0a, 0x05, 0x67, 0x72, 0x61, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x23, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x67, 0x72, 0x2e,
+	0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65,
+	0x47, 0x72, 0x61, 0x64, 0x65, 0x52, 0x05, 0x67, 0x72, 0x61, 0x64, 0x65, 0x22, 0x56, 0x0a, 0x19,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x47, 0x72, 0x61, 0x64,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x05, 0x67, 0x72, 0x61,
+	0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x62,
+	0x65, 0x74, 0x74, 0x65, 0x72, 0x67, 0x72, 0x2e, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x47, 0x72, 0x61, 0x64, 0x65, 0x52, 0x05, 0x67,
+	0x72, 0x61, 0x64, 0x65, 0x22, 0x4a, 0x0a, 0x18, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x69,
+	0x6e, 0x67, 0x6c, 0x65, 0x47, 0x72, 0x61, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x72, 0x61, 0x64, 0x65, 0x49,
+	0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x72, 0x61, 0x64, 0x65, 0x49, 0x44,
+	0x22, 0x1b, 0x0a, 0x19, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65,
+	0x47, 0x72, 0x61, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xe7, 0x02,
+	0x0a, 0x16, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x47, 0x72, 0x61, 0x64, 0x65,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1a,
+	0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65,
+	0x6d, 0x65, 0x73, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65,
+	0x6d, 0x65, 0x73, 0x74, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73,
+	0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53,
+	0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x12, 0x29, 0x0a, 0x10, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x61, 0x72,
+	0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x69, 0x6e,
+	0x63, 0x6c, 0x75, 0x64, 0x65, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x12, 0x22, 0x0a,
+	0x0d, 0x69, 0x66, 0x5f, 0x6e, 0x6f, 0x6e, 0x65, 0x5f, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x69, 0x66, 0x4e, 0x6f, 0x6e, 0x65, 0x4d, 0x61, 0x74, 0x63,
+	0x68, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x6d, 0x69, 0x7a, 0x65, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x6d, 0x69, 0x7a, 0x65, 0x12,
+	0x1c, 0x0a, 0x09, 0x73, 0x65, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x72, 0x73, 0x18, 0x09, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x72, 0x73, 0x12, 0x16, 0x0a,
+	0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c,
+	0x6f, 0x63, 0x61, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74,
+	0x65, 0x6e, 0x63, 0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x73,
+	0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x22, 0xba, 0x02, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x43,
+	0x6f, 0x75, 0x72, 0x73, 0x65, 0x47, 0x72, 0x61, 0x64, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x06, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72,
+	0x67, 0x72, 0x2e, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x6e,
+	0x67, 0x6c, 0x65, 0x47, 0x72, 0x61, 0x64, 0x65, 0x52, 0x06, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73,
+	0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50,
+	0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x65, 0x74, 0x61, 0x67,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x65, 0x74, 0x61, 0x67, 0x12, 0x21, 0x0a, 0x0c,
+	0x6e, 0x6f, 0x74, 0x5f, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0b, 0x6e, 0x6f, 0x74, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x12,
+	0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05,
+	0x73, 0x74, 0x61, 0x6c, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74,
+	0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x74, 0x72, 0x75, 0x6e, 0x63, 0x61,
+	0x74, 0x65, 0x64, 0x12, 0x4f, 0x0a, 0x0f, 0x73, 0x65, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x72, 0x5f,
+	0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x67, 0x72, 0x2e, 0x67, 0x72, 0x61, 0x64,
+	0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x72, 0x47, 0x72,
+	0x61, 0x64, 0x65, 0x73, 0x52, 0x0e, 0x73, 0x65, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x72, 0x47, 0x72,
+	0x61, 0x64, 0x65, 0x73, 0x22, 0x97, 0x02, 0x0a, 0x1f, 0x47, 0x65, 0x74, 0x53, 0x74, 0x75, 0x64,
+	0x65, 0x6e, 0x74, 0x53, 0x65, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x72, 0x47, 0x72, 0x61, 0x64, 0x65,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1a,
+	0x0a, 0x08, 0x73, 0x65, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x73, 0x65, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74,
+	0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73,
+	0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65,
+	0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67,
+	0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x2e, 0x0a, 0x13, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f,
+	0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x11, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65,
+	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b,
+	0x63, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x22, 0xae,
+	0x03, 0x0a, 0x20, 0x47, 0x65, 0x74, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x6d,
+	0x65, 0x73, 0x74, 0x65, 0x72, 0x47, 0x72, 0x61, 0x64, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x06, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72,
+	0x67, 0x72, 0x2e, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x6e,
+	0x67, 0x6c, 0x65, 0x47, 0x72, 0x61, 0x64, 0x65, 0x52, 0x06, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73,
+	0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50,
+	0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x5f, 0x0a, 0x07, 0x63, 0x6f, 0x75, 0x72,
+	0x73, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x45, 0x2e, 0x63, 0x6f, 0x6d, 0x2e,
+	0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x67, 0x72, 0x2e, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x6d,
+	0x65, 0x73, 0x74, 0x65, 0x72, 0x47, 0x72, 0x61, 0x64, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x07, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x12, 0x30, 0x0a, 0x13, 0x75, 0x6e, 0x72,
+	0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x73,
+	0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x13, 0x75, 0x6e, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76,
+	0x65, 0x64, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x73,
+	0x74, 0x61, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x73, 0x74, 0x61, 0x6c,
+	0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x74, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x64, 0x1a,
+	0x5e, 0x0a, 0x0c, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x38, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x22, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x67, 0x72, 0x2e,
+	0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22,
+	0x56, 0x0a, 0x0a, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1a, 0x0a,
+	0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07,
+	0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x73, 0x22, 0x4a, 0x0a, 0x16, 0x41, 0x72, 0x63, 0x68, 0x69,
+	0x76, 0x65, 0x53, 0x65, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x6d, 0x65, 0x73,
+	0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x6d, 0x65, 0x73,
+	0x74, 0x65, 0x72, 0x22, 0x40, 0x0a, 0x17, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x53, 0x65,
+	0x6d, 0x65, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25,
+	0x0a, 0x0e, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xe5, 0x01, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x47, 0x72, 0x61,
+	0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
+	0x18, 0x0a, 0x07, 0x67, 0x72, 0x61, 0x64, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x67, 0x72, 0x61, 0x64, 0x65, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x28, 0x0a, 0x10, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x61, 0x66, 0x74, 0x65,
+	0x72, 0x5f, 0x77, 0x72, 0x69, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x72,
+	0x65, 0x61, 0x64, 0x41, 0x66, 0x74, 0x65, 0x72, 0x57, 0x72, 0x69, 0x74, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c,
+	0x6f, 0x63, 0x61, 0x6c, 0x65, 0x12, 0x24, 0x0a, 0x0e, 0x69, 0x66, 0x5f, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x6f, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x69,
+	0x66, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x63,
+	0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x22, 0x70, 0x0a,
+	0x10, 0x47, 0x65, 0x74, 0x47, 0x72, 0x61, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x39, 0x0a, 0x05, 0x67, 0x72, 0x61, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x23, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x67, 0x72, 0x2e,
+	0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65,
+	0x47, 0x72, 0x61, 0x64, 0x65, 0x52, 0x05, 0x67, 0x72, 0x61, 0x64, 0x65, 0x12, 0x21, 0x0a, 0x0c,
+	0x6e, 0x6f, 0x74, 0x5f, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0b, 0x6e, 0x6f, 0x74, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x22,
+	0x80, 0x01, 0x0a, 0x1a, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x47, 0x72, 0x61, 0x64, 0x65, 0x43,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44,
+	0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05,
+	0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x65,
+	0x72, 0x79, 0x22, 0x78, 0x0a, 0x1b, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x47, 0x72, 0x61, 0x64,
+	0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x3b, 0x0a, 0x06, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x23, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x67, 0x72,
+	0x2e, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x6e, 0x67, 0x6c,
+	0x65, 0x47, 0x72, 0x61, 0x64, 0x65, 0x52, 0x06, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x12, 0x1c,
+	0x0a, 0x09, 0x74, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x09, 0x74, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x64, 0x22, 0x5f, 0x0a, 0x13,
+	0x53, 0x65, 0x74, 0x47, 0x72, 0x61, 0x64, 0x65, 0x46, 0x6c, 0x61, 0x67, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x72, 0x61,
+	0x64, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x72, 0x61, 0x64,
+	0x65, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x6c, 0x61, 0x67, 0x67, 0x65, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x66, 0x6c, 0x61, 0x67, 0x67, 0x65, 0x64, 0x22, 0x51, 0x0a,
+	0x14, 0x53, 0x65, 0x74, 0x47, 0x72, 0x61, 0x64, 0x65, 0x46, 0x6c, 0x61, 0x67, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x05, 0x67, 0x72, 0x61, 0x64, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65,
+	0x72, 0x67, 0x72, 0x2e, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69,
+	0x6e, 0x67, 0x6c, 0x65, 0x47, 0x72, 0x61, 0x64, 0x65, 0x52, 0x05, 0x67, 0x72, 0x61, 0x64, 0x65,
+	0x22, 0x67, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x46, 0x6c, 0x61, 0x67, 0x67, 0x65, 0x64, 0x47, 0x72,
+	0x61, 0x64, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x1a, 0x0a,
+	0x08, 0x73, 0x65, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x73, 0x65, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x72, 0x22, 0x75, 0x0a, 0x18, 0x47, 0x65, 0x74,
+	0x46, 0x6c, 0x61, 0x67, 0x67, 0x65, 0x64, 0x47, 0x72, 0x61, 0x64, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x06, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x65, 0x74, 0x74,
+	0x65, 0x72, 0x67, 0x72, 0x2e, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x69, 0x6e, 0x67, 0x6c, 0x65, 0x47, 0x72, 0x61, 0x64, 0x65, 0x52, 0x06, 0x67, 0x72, 0x61, 0x64,
+	0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x74, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x64,
+	0x22, 0x8b, 0x01, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x43, 0x6f, 0x75,
+	0x72, 0x73, 0x65, 0x47, 0x72, 0x61, 0x64, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65,
+	0x49, 0x44, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x75, 0x72, 0x73,
+	0x65, 0x49, 0x44, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x72,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x72,
+	0x12, 0x1c, 0x0a, 0x09, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x6d, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x09, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x6d, 0x69, 0x7a, 0x65, 0x22, 0x85,
+	0x01, 0x0a, 0x0c, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x47, 0x72, 0x61, 0x64, 0x65, 0x73, 0x12,
+	0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x3b, 0x0a, 0x06, 0x67,
+	0x72, 0x61, 0x64, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x67, 0x72, 0x2e, 0x67, 0x72, 0x61, 0x64, 0x65,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x47, 0x72, 0x61, 0x64, 0x65,
+	0x52, 0x06, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x72, 0x75, 0x6e,
+	0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x74, 0x72, 0x75,
+	0x6e, 0x63, 0x61, 0x74, 0x65, 0x64, 0x22, 0x5e, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x4d, 0x75, 0x6c,
+	0x74, 0x69, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x47, 0x72, 0x61, 0x64, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x07, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x65,
+	0x74, 0x74, 0x65, 0x72, 0x67, 0x72, 0x2e, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x47, 0x72, 0x61, 0x64, 0x65, 0x73, 0x52, 0x07, 0x63,
+	0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x22, 0x4a, 0x0a, 0x1a, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x61, 0x64, 0x65, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x72,
+	0x65, 0x66, 0x69, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x72, 0x65, 0x66,
+	0x69, 0x78, 0x22, 0x78, 0x0a, 0x1b, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x53, 0x74, 0x75, 0x64,
+	0x65, 0x6e, 0x74, 0x47, 0x72, 0x61, 0x64, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x3b, 0x0a, 0x06, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x23, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x67, 0x72,
+	0x2e, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x6e, 0x67, 0x6c,
+	0x65, 0x47, 0x72, 0x61, 0x64, 0x65, 0x52, 0x06, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x12, 0x1c,
+	0x0a, 0x09, 0x74, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x09, 0x74, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x64, 0x22, 0x30, 0x0a, 0x18,
+	0x52, 0x65, 0x74, 0x72, 0x79, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x74,
+	0x0a, 0x19, 0x52, 0x65, 0x74, 0x72, 0x79, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x64,
+	0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x65, 0x64, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2e, 0x0a, 0x13, 0x73, 0x74, 0x69, 0x6c, 0x6c, 0x5f, 0x66, 0x61,
+	0x69, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x11, 0x73, 0x74, 0x69, 0x6c, 0x6c, 0x46, 0x61, 0x69, 0x6c, 0x69, 0x6e, 0x67, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x22, 0x87, 0x01, 0x0a, 0x0e, 0x53, 0x65, 0x6d, 0x65, 0x73, 0x74, 0x65,
+	0x72, 0x47, 0x72, 0x61, 0x64, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x6d, 0x65, 0x73,
+	0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x6d, 0x65, 0x73,
+	0x74, 0x65, 0x72, 0x12, 0x3b, 0x0a, 0x06, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72,
+	0x67, 0x72, 0x2e, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x6e,
+	0x67, 0x6c, 0x65, 0x47, 0x72, 0x61, 0x64, 0x65, 0x52, 0x06, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73,
+	0x12, 0x1c, 0x0a, 0x09, 0x74, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x09, 0x74, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x64, 0x22, 0x4b,
+	0x0a, 0x19, 0x47, 0x65, 0x74, 0x47, 0x72, 0x61, 0x64, 0x65, 0x50, 0x72, 0x6f, 0x76, 0x65, 0x6e,
+	0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x72, 0x61, 0x64, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x67, 0x72, 0x61, 0x64, 0x65, 0x49, 0x44, 0x22, 0x97, 0x01, 0x0a, 0x0f,
+	0x50, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b,
+	0x69, 0x6e, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6f, 0x63, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x67, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x67, 0x72, 0x61, 0x64, 0x65,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x5d, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x47, 0x72, 0x61, 0x64,
+	0x65, 0x50, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72,
+	0x67, 0x72, 0x2e, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f,
+	0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x73, 0x22, 0xc4, 0x02, 0x0a, 0x1b, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x47, 0x72, 0x61, 0x64, 0x65, 0x52, 0x65, 0x71,
 	0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20,
 	0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f,
 	0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f,
@@ -819,116 +10572,1485 @@ var file_grades_microservice_proto_rawDesc = []byte{
 	0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x6d, 0x65, 0x73, 0x74,
 	0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18,
 	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x44,
-	0x22, 0x4d, 0x0a, 0x1e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x43, 0x6f,
-	0x75, 0x72, 0x73, 0x65, 0x47, 0x72, 0x61, 0x64, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x2b, 0x0a, 0x06, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x2e, 0x53, 0x69, 0x6e, 0x67,
-	0x6c, 0x65, 0x47, 0x72, 0x61, 0x64, 0x65, 0x52, 0x06, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x22,
-	0x5b, 0x0a, 0x18, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x47,
-	0x72, 0x61, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74,
+	0x12, 0x21, 0x0a, 0x0c, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x67, 0x72, 0x61, 0x64, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x47, 0x72,
+	0x61, 0x64, 0x65, 0x12, 0x5a, 0x0a, 0x07, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x73, 0x18, 0x06,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x40, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65,
+	0x72, 0x67, 0x72, 0x2e, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72,
+	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x47, 0x72, 0x61,
+	0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x73, 0x1a,
+	0x3a, 0x0a, 0x0c, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xbb, 0x01, 0x0a, 0x1c,
+	0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x47,
+	0x72, 0x61, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x0e,
+	0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x5f, 0x67, 0x72, 0x61, 0x64, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x47, 0x72,
+	0x61, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x61, 0x63, 0x68, 0x61, 0x62, 0x6c, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x72, 0x65, 0x61, 0x63, 0x68, 0x61, 0x62, 0x6c,
+	0x65, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x67, 0x72, 0x61,
+	0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x74, 0x47, 0x72, 0x61, 0x64, 0x65, 0x12, 0x31, 0x0a, 0x14, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e,
+	0x69, 0x6e, 0x67, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x13, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x43,
+	0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x6a, 0x0a, 0x15, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x41, 0x64, 0x64, 0x47, 0x72, 0x61, 0x64, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x3b, 0x0a, 0x06, 0x67, 0x72, 0x61, 0x64,
+	0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x62,
+	0x65, 0x74, 0x74, 0x65, 0x72, 0x67, 0x72, 0x2e, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x47, 0x72, 0x61, 0x64, 0x65, 0x52, 0x06, 0x67,
+	0x72, 0x61, 0x64, 0x65, 0x73, 0x22, 0x41, 0x0a, 0x0f, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x72,
+	0x61, 0x64, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x18,
+	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xd8, 0x01, 0x0a, 0x16, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x41, 0x64, 0x64, 0x47, 0x72, 0x61, 0x64, 0x65, 0x73, 0x50, 0x72, 0x6f, 0x67, 0x72,
+	0x65, 0x73, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x64,
+	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x70, 0x72,
+	0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b,
+	0x61, 0x64, 0x64, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0a, 0x61, 0x64, 0x64, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1f, 0x0a,
+	0x0b, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x3f,
+	0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x67, 0x72, 0x2e, 0x67, 0x72,
+	0x61, 0x64, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x72, 0x61,
+	0x64, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x12,
+	0x12, 0x0a, 0x04, 0x64, 0x6f, 0x6e, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x64,
+	0x6f, 0x6e, 0x65, 0x22, 0x6b, 0x0a, 0x1b, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x47, 0x72, 0x61,
+	0x64, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72,
+	0x73, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72,
+	0x73, 0x65, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x72,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x72,
+	0x22, 0xbb, 0x01, 0x0a, 0x0d, 0x47, 0x72, 0x61, 0x64, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69,
+	0x63, 0x74, 0x12, 0x39, 0x0a, 0x05, 0x67, 0x72, 0x61, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x23, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x67, 0x72,
+	0x2e, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x6e, 0x67, 0x6c,
+	0x65, 0x47, 0x72, 0x61, 0x64, 0x65, 0x52, 0x05, 0x67, 0x72, 0x61, 0x64, 0x65, 0x12, 0x27, 0x0a,
+	0x0f, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x5f, 0x67, 0x72, 0x61, 0x64, 0x65, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c,
+	0x47, 0x72, 0x61, 0x64, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x62, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x27, 0x0a, 0x0f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x63,
+	0x0a, 0x1c, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x47, 0x72, 0x61, 0x64, 0x65, 0x43, 0x6f, 0x6e,
+	0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43,
+	0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x25, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x67, 0x72,
+	0x2e, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x65,
+	0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69,
+	0x63, 0x74, 0x73, 0x22, 0x87, 0x02, 0x0a, 0x1e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x44, 0x65,
+	0x66, 0x61, 0x75, 0x6c, 0x74, 0x54, 0x6f, 0x55, 0x6e, 0x67, 0x72, 0x61, 0x64, 0x65, 0x64, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1a, 0x0a, 0x08,
+	0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x6d, 0x65,
+	0x73, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x6d, 0x65,
+	0x73, 0x74, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x74, 0x65, 0x6d, 0x49, 0x44, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x69, 0x74, 0x65, 0x6d, 0x49, 0x44, 0x12, 0x1d, 0x0a, 0x0a,
+	0x67, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x67, 0x72, 0x61, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x73,
+	0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x0a, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x64,
+	0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x12, 0x1b, 0x0a, 0x09, 0x67, 0x72, 0x61, 0x64, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x67, 0x72, 0x61, 0x64, 0x65, 0x64, 0x42, 0x79, 0x22, 0x48, 0x0a,
+	0x1f, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x54, 0x6f,
+	0x55, 0x6e, 0x67, 0x72, 0x61, 0x64, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x25, 0x0a, 0x0e, 0x69, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x69, 0x6e, 0x73, 0x65, 0x72, 0x74,
+	0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x81, 0x01, 0x0a, 0x19, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x47, 0x72, 0x61, 0x64, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x63,
+	0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63,
+	0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x6d, 0x65, 0x73,
+	0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x6d, 0x65, 0x73,
+	0x74, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x22, 0xbe, 0x01, 0x0a, 0x19,
+	0x47, 0x65, 0x74, 0x47, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x56, 0x65, 0x6c, 0x6f, 0x63, 0x69,
+	0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
+	0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x73,
+	0x65, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73,
+	0x65, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x74, 0x65, 0x6d, 0x49,
+	0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x69, 0x74, 0x65, 0x6d, 0x49, 0x44, 0x12,
+	0x1f, 0x0a, 0x0b, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x53, 0x69, 0x7a, 0x65,
+	0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x22, 0x3d, 0x0a, 0x11,
+	0x44, 0x61, 0x69, 0x6c, 0x79, 0x47, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x64, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xd0, 0x02, 0x0a, 0x1a,
+	0x47, 0x65, 0x74, 0x47, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x56, 0x65, 0x6c, 0x6f, 0x63, 0x69,
+	0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x0e, 0x67, 0x72,
+	0x61, 0x64, 0x65, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x64, 0x61, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x0c, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x50, 0x65, 0x72, 0x44, 0x61, 0x79,
+	0x12, 0x21, 0x0a, 0x0c, 0x67, 0x72, 0x61, 0x64, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x67, 0x72, 0x61, 0x64, 0x65, 0x64, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67,
+	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x72, 0x65,
+	0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x31, 0x0a, 0x14,
+	0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x70, 0x72, 0x6f, 0x6a,
+	0x65, 0x63, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x6d, 0x69, 0x73, 0x73, 0x65,
+	0x73, 0x5f, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0e, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x73, 0x44, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65,
+	0x12, 0x4c, 0x0a, 0x0c, 0x64, 0x61, 0x69, 0x6c, 0x79, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73,
+	0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x65, 0x74,
+	0x74, 0x65, 0x72, 0x67, 0x72, 0x2e, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x44, 0x61, 0x69, 0x6c, 0x79, 0x47, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x52, 0x0b, 0x64, 0x61, 0x69, 0x6c, 0x79, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x22, 0x2e,
+	0x0a, 0x16, 0x50, 0x75, 0x72, 0x67, 0x65, 0x54, 0x6f, 0x6d, 0x62, 0x73, 0x74, 0x6f, 0x6e, 0x65,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x3c,
+	0x0a, 0x17, 0x50, 0x75, 0x72, 0x67, 0x65, 0x54, 0x6f, 0x6d, 0x62, 0x73, 0x74, 0x6f, 0x6e, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x75, 0x72,
+	0x67, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0b, 0x70, 0x75, 0x72, 0x67, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x55, 0x0a, 0x0b,
+	0x52, 0x75, 0x62, 0x72, 0x69, 0x63, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x63,
+	0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x63, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x73, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x61, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03,
+	0x6d, 0x61, 0x78, 0x22, 0x8f, 0x01, 0x0a, 0x1d, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x43,
+	0x6f, 0x75, 0x72, 0x73, 0x65, 0x53, 0x65, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x63,
+	0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63,
+	0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x6d, 0x65, 0x73,
+	0x74, 0x65, 0x72, 0x5f, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x6d,
+	0x65, 0x73, 0x74, 0x65, 0x72, 0x41, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x6d, 0x65, 0x73, 0x74,
+	0x65, 0x72, 0x5f, 0x62, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x6d, 0x65,
+	0x73, 0x74, 0x65, 0x72, 0x42, 0x22, 0xbc, 0x01, 0x0a, 0x14, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e,
+	0x74, 0x53, 0x65, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x72, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x12, 0x1c,
+	0x0a, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x1b, 0x0a, 0x09,
+	0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x08, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x41, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x76, 0x65,
+	0x72, 0x61, 0x67, 0x65, 0x5f, 0x62, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x61, 0x76,
+	0x65, 0x72, 0x61, 0x67, 0x65, 0x42, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x12, 0x1a, 0x0a, 0x09,
+	0x6f, 0x6e, 0x6c, 0x79, 0x5f, 0x69, 0x6e, 0x5f, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x6f, 0x6e, 0x6c, 0x79, 0x49, 0x6e, 0x41, 0x12, 0x1a, 0x0a, 0x09, 0x6f, 0x6e, 0x6c, 0x79,
+	0x5f, 0x69, 0x6e, 0x5f, 0x62, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x6f, 0x6e, 0x6c,
+	0x79, 0x49, 0x6e, 0x42, 0x22, 0xc9, 0x01, 0x0a, 0x1e, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65,
+	0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x53, 0x65, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x72, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x08, 0x73, 0x74, 0x75, 0x64, 0x65,
+	0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x63, 0x6f, 0x6d, 0x2e,
+	0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x67, 0x72, 0x2e, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x6d, 0x65, 0x73, 0x74,
+	0x65, 0x72, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x52, 0x08, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74,
+	0x73, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x61, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x41, 0x12, 0x1b,
+	0x0a, 0x09, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x62, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x08, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x42, 0x12, 0x23, 0x0a, 0x0d, 0x61,
+	0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x0c, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x44, 0x65, 0x6c, 0x74, 0x61,
+	0x22, 0x56, 0x0a, 0x22, 0x47, 0x65, 0x74, 0x53, 0x65, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x72, 0x47,
+	0x72, 0x61, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1a, 0x0a, 0x08,
+	0x73, 0x65, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x73, 0x65, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x72, 0x22, 0x86, 0x01, 0x0a, 0x10, 0x47, 0x72, 0x61,
+	0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x1d, 0x0a,
+	0x0a, 0x67, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x67, 0x72, 0x61, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x6e, 0x75, 0x6d, 0x65, 0x72, 0x69, 0x63, 0x5f, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x6e, 0x75, 0x6d, 0x65, 0x72,
+	0x69, 0x63, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x76, 0x65, 0x72, 0x61,
+	0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67,
+	0x65, 0x22, 0x6d, 0x0a, 0x23, 0x47, 0x65, 0x74, 0x53, 0x65, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x72,
+	0x47, 0x72, 0x61, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a, 0x09, 0x73, 0x75, 0x6d, 0x6d,
+	0x61, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x67, 0x72, 0x2e, 0x67, 0x72, 0x61, 0x64, 0x65,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x53, 0x75,
+	0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x09, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x69, 0x65, 0x73,
+	0x22, 0x6b, 0x0a, 0x1c, 0x53, 0x65, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x4f, 0x70, 0x74, 0x4f, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e,
+	0x74, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65,
+	0x6e, 0x74, 0x49, 0x44, 0x12, 0x17, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x5f, 0x6f, 0x75, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x6f, 0x70, 0x74, 0x4f, 0x75, 0x74, 0x22, 0x1f, 0x0a,
+	0x1d, 0x53, 0x65, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x4f, 0x70, 0x74, 0x4f, 0x75, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x6d,
+	0x0a, 0x1d, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x47, 0x72,
+	0x61, 0x64, 0x65, 0x73, 0x58, 0x4c, 0x53, 0x58, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49,
+	0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49,
+	0x44, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x72, 0x22, 0x58, 0x0a,
+	0x1e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x47, 0x72, 0x61,
+	0x64, 0x65, 0x73, 0x58, 0x4c, 0x53, 0x58, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x1a, 0x0a, 0x08, 0x77, 0x6f, 0x72, 0x6b, 0x62, 0x6f, 0x6f, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x08, 0x77, 0x6f, 0x72, 0x6b, 0x62, 0x6f, 0x6f, 0x6b, 0x12, 0x1a, 0x0a, 0x08, 0x66,
+	0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66,
+	0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x76, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x53, 0x74,
+	0x75, 0x64, 0x65, 0x6e, 0x74, 0x59, 0x65, 0x61, 0x72, 0x47, 0x72, 0x61, 0x64, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1c, 0x0a, 0x09,
+	0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x63,
+	0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x5f, 0x79, 0x65, 0x61, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0c, 0x61, 0x63, 0x61, 0x64, 0x65, 0x6d, 0x69, 0x63, 0x59, 0x65, 0x61, 0x72, 0x22,
+	0x64, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x59, 0x65, 0x61,
+	0x72, 0x47, 0x72, 0x61, 0x64, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x44, 0x0a, 0x09, 0x73, 0x65, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x67,
+	0x72, 0x2e, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x6d, 0x65,
+	0x73, 0x74, 0x65, 0x72, 0x47, 0x72, 0x61, 0x64, 0x65, 0x73, 0x52, 0x09, 0x73, 0x65, 0x6d, 0x65,
+	0x73, 0x74, 0x65, 0x72, 0x73, 0x22, 0xd6, 0x01, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x47, 0x72, 0x61,
+	0x64, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x72, 0x61, 0x64, 0x65, 0x49, 0x44, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x72, 0x61, 0x64, 0x65, 0x49, 0x44, 0x12, 0x1c,
+	0x0a, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08,
+	0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x6d, 0x65,
+	0x73, 0x74, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x6d, 0x65,
+	0x73, 0x74, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x74, 0x65, 0x6d, 0x49, 0x44, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x69, 0x74, 0x65, 0x6d, 0x49, 0x44, 0x12, 0x20, 0x0a, 0x0b,
+	0x63, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x22, 0x38,
+	0x0a, 0x15, 0x47, 0x65, 0x74, 0x47, 0x72, 0x61, 0x64, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x67, 0x72, 0x61, 0x64, 0x65,
+	0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x67, 0x72,
+	0x61, 0x64, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x66, 0x0a, 0x16, 0x41, 0x64, 0x64, 0x43,
+	0x6f, 0x75, 0x72, 0x73, 0x65, 0x47, 0x72, 0x61, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72,
+	0x73, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72,
+	0x73, 0x65, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x67, 0x72, 0x61, 0x64, 0x65, 0x72, 0x49, 0x44,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x67, 0x72, 0x61, 0x64, 0x65, 0x72, 0x49, 0x44,
+	0x22, 0x19, 0x0a, 0x17, 0x41, 0x64, 0x64, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x47, 0x72, 0x61,
+	0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x69, 0x0a, 0x19, 0x52,
+	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x47, 0x72, 0x61, 0x64, 0x65,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1a,
+	0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x67, 0x72,
+	0x61, 0x64, 0x65, 0x72, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x67, 0x72,
+	0x61, 0x64, 0x65, 0x72, 0x49, 0x44, 0x22, 0x1c, 0x0a, 0x1a, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65,
+	0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x47, 0x72, 0x61, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4b, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73,
+	0x65, 0x47, 0x72, 0x61, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49,
+	0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49,
+	0x44, 0x22, 0x38, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x47, 0x72,
+	0x61, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a,
+	0x09, 0x67, 0x72, 0x61, 0x64, 0x65, 0x72, 0x49, 0x44, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x09, 0x67, 0x72, 0x61, 0x64, 0x65, 0x72, 0x49, 0x44, 0x73, 0x22, 0x65, 0x0a, 0x15, 0x47,
+	0x65, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f,
+	0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f,
+	0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x6d, 0x65, 0x73, 0x74,
+	0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x6d, 0x65, 0x73, 0x74,
+	0x65, 0x72, 0x22, 0xd8, 0x01, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65,
+	0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x6e, 0x75, 0x6d, 0x65, 0x72, 0x69, 0x63, 0x5f, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x6e, 0x75, 0x6d, 0x65,
+	0x72, 0x69, 0x63, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x76, 0x65, 0x72,
+	0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x61, 0x76, 0x65, 0x72, 0x61,
+	0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x07, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x12, 0x18, 0x0a, 0x07,
+	0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x6d,
+	0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73,
+	0x68, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65,
+	0x66, 0x72, 0x65, 0x73, 0x68, 0x65, 0x64, 0x41, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69, 0x76,
+	0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x6c, 0x69, 0x76, 0x65, 0x22, 0x31, 0x0a,
+	0x19, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x22, 0x1c, 0x0a, 0x1a, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x43, 0x6f, 0x75, 0x72, 0x73,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x69,
+	0x0a, 0x1d, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x65, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x72,
+	0x54, 0x6f, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x6d, 0x65, 0x73, 0x74, 0x65,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x6d, 0x65, 0x73, 0x74, 0x65,
+	0x72, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x22, 0x78, 0x0a, 0x1e, 0x45, 0x78, 0x70,
+	0x6f, 0x72, 0x74, 0x53, 0x65, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x72, 0x54, 0x6f, 0x42, 0x75, 0x63,
+	0x6b, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x62,
+	0x75, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x75, 0x63,
+	0x6b, 0x65, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x6b, 0x65,
+	0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x4b,
+	0x65, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x67, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x67, 0x72, 0x61, 0x64, 0x65, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x22, 0xa1, 0x01, 0x0a, 0x0d, 0x47, 0x72, 0x61, 0x64, 0x65, 0x4f, 0x76, 0x65,
+	0x72, 0x72, 0x69, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x72, 0x61, 0x64, 0x65, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x72, 0x61, 0x64, 0x65, 0x49, 0x44, 0x12,
+	0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x73,
+	0x65, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73,
+	0x65, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x67, 0x72, 0x61, 0x64, 0x65,
+	0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x67, 0x72, 0x61,
+	0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x67, 0x72, 0x61, 0x64, 0x65, 0x5f,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x67, 0x72, 0x61,
+	0x64, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xb1, 0x01, 0x0a, 0x1a, 0x53, 0x69, 0x6d, 0x75,
+	0x6c, 0x61, 0x74, 0x65, 0x47, 0x72, 0x61, 0x64, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1c, 0x0a, 0x09,
+	0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65,
+	0x6d, 0x65, 0x73, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65,
+	0x6d, 0x65, 0x73, 0x74, 0x65, 0x72, 0x12, 0x43, 0x0a, 0x09, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69,
+	0x64, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x63, 0x6f, 0x6d, 0x2e,
+	0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x67, 0x72, 0x2e, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x65, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65,
+	0x52, 0x09, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x22, 0xbb, 0x02, 0x0a, 0x1b,
+	0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x47, 0x72, 0x61, 0x64, 0x65, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x73,
+	0x65, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x67, 0x70, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x0b, 0x73, 0x65, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x72, 0x47, 0x70, 0x61, 0x12, 0x25,
+	0x0a, 0x0e, 0x63, 0x75, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x67, 0x70, 0x61,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x63, 0x75, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69,
+	0x76, 0x65, 0x47, 0x70, 0x61, 0x12, 0x30, 0x0a, 0x14, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74,
+	0x5f, 0x73, 0x65, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x67, 0x70, 0x61, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x12, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x6d, 0x65,
+	0x73, 0x74, 0x65, 0x72, 0x47, 0x70, 0x61, 0x12, 0x34, 0x0a, 0x16, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x74, 0x5f, 0x63, 0x75, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x67, 0x70,
+	0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x14, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74,
+	0x43, 0x75, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x47, 0x70, 0x61, 0x12, 0x32, 0x0a,
+	0x15, 0x73, 0x65, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65,
+	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x13, 0x73, 0x65,
+	0x6d, 0x65, 0x73, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x36, 0x0a, 0x17, 0x63, 0x75, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f,
+	0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x15, 0x63, 0x75, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x43, 0x6f,
+	0x75, 0x72, 0x73, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x8a, 0x01, 0x0a, 0x1b, 0x53, 0x65,
+	0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x54, 0x69,
+	0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
+	0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x73,
+	0x65, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73,
+	0x65, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x6c, 0x65, 0x61,
+	0x73, 0x65, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x6c,
+	0x65, 0x61, 0x73, 0x65, 0x41, 0x74, 0x22, 0x1e, 0x0a, 0x1c, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x75,
+	0x72, 0x73, 0x65, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x67, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x53, 0x65, 0x6d,
+	0x65, 0x73, 0x74, 0x65, 0x72, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1a, 0x0a,
+	0x08, 0x73, 0x65, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x73, 0x65, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22,
+	0x79, 0x0a, 0x10, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65,
+	0x6e, 0x74, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x75, 0x64,
+	0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x10, 0x0a, 0x03, 0x67, 0x70, 0x61, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x03, 0x67, 0x70, 0x61, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x75, 0x72, 0x73,
+	0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x63,
+	0x6f, 0x75, 0x72, 0x73, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x64, 0x0a, 0x1e, 0x47, 0x65,
+	0x74, 0x53, 0x65, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x72, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62,
+	0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x07,
+	0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x67, 0x72, 0x2e, 0x67, 0x72, 0x61,
+	0x64, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61,
+	0x72, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73,
+	0x22, 0x7b, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x47, 0x72, 0x61, 0x64, 0x65, 0x46, 0x6c, 0x65, 0x78,
+	0x69, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74,
 	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65,
-	0x6e, 0x12, 0x29, 0x0a, 0x05, 0x67, 0x72, 0x61, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x13, 0x2e, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x2e, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65,
-	0x47, 0x72, 0x61, 0x64, 0x65, 0x52, 0x05, 0x67, 0x72, 0x61, 0x64, 0x65, 0x22, 0x46, 0x0a, 0x19,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x47, 0x72, 0x61, 0x64,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x05, 0x67, 0x72, 0x61,
-	0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x67, 0x72, 0x61, 0x64, 0x65,
-	0x73, 0x2e, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x47, 0x72, 0x61, 0x64, 0x65, 0x52, 0x05, 0x67,
-	0x72, 0x61, 0x64, 0x65, 0x22, 0xd6, 0x01, 0x0a, 0x18, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53,
-	0x69, 0x6e, 0x67, 0x6c, 0x65, 0x47, 0x72, 0x61, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x6e, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x72, 0x61, 0x64, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x67, 0x72, 0x61, 0x64, 0x65, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x22, 0x69, 0x0a,
+	0x18, 0x47, 0x65, 0x74, 0x47, 0x72, 0x61, 0x64, 0x65, 0x46, 0x6c, 0x65, 0x78, 0x69, 0x62, 0x6c,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x16, 0x0a,
+	0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66,
+	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e,
+	0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x22, 0x54, 0x0a, 0x1e, 0x53, 0x6f, 0x66, 0x74,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x61,
+	0x64, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x22, 0x46,
+	0x0a, 0x1f, 0x53, 0x6f, 0x66, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x74, 0x75, 0x64,
+	0x65, 0x6e, 0x74, 0x47, 0x72, 0x61, 0x64, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x3c, 0x0a, 0x0e, 0x43, 0x75, 0x72, 0x76, 0x65, 0x4f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x16, 0x0a, 0x06,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x22, 0xb9, 0x01, 0x0a, 0x13, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77,
+	0x43, 0x75, 0x72, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x1a,
+	0x0a, 0x08, 0x73, 0x65, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x73, 0x65, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x74,
+	0x65, 0x6d, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x69, 0x74, 0x65, 0x6d,
+	0x49, 0x44, 0x12, 0x3c, 0x0a, 0x05, 0x63, 0x75, 0x72, 0x76, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x67, 0x72,
+	0x2e, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x75, 0x72, 0x76, 0x65,
+	0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x05, 0x63, 0x75, 0x72, 0x76, 0x65,
+	0x22, 0x48, 0x0a, 0x0f, 0x47, 0x72, 0x61, 0x64, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x67, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x67, 0x72, 0x61, 0x64, 0x65, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x73, 0x0a, 0x0b, 0x43, 0x75,
+	0x72, 0x76, 0x65, 0x64, 0x47, 0x72, 0x61, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x72, 0x61,
+	0x64, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x72, 0x61, 0x64,
+	0x65, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x44,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49,
+	0x44, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x66, 0x74,
+	0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x22,
+	0xd3, 0x01, 0x0a, 0x14, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x43, 0x75, 0x72, 0x76, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x06, 0x62, 0x65, 0x66, 0x6f,
+	0x72, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x62,
+	0x65, 0x74, 0x74, 0x65, 0x72, 0x67, 0x72, 0x2e, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x52, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x3d, 0x0a, 0x05, 0x61, 0x66, 0x74,
+	0x65, 0x72, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x62,
+	0x65, 0x74, 0x74, 0x65, 0x72, 0x67, 0x72, 0x2e, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x52, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x12, 0x3b, 0x0a, 0x06, 0x67, 0x72, 0x61, 0x64,
+	0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x62,
+	0x65, 0x74, 0x74, 0x65, 0x72, 0x67, 0x72, 0x2e, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x43, 0x75, 0x72, 0x76, 0x65, 0x64, 0x47, 0x72, 0x61, 0x64, 0x65, 0x52, 0x06, 0x67,
+	0x72, 0x61, 0x64, 0x65, 0x73, 0x22, 0x6a, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x47, 0x72, 0x61, 0x64,
+	0x65, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75,
+	0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x75,
+	0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x6d, 0x65, 0x73, 0x74, 0x65,
+	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x6d, 0x65, 0x73, 0x74, 0x65,
+	0x72, 0x22, 0x91, 0x02, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x47, 0x72, 0x61, 0x64, 0x65, 0x50, 0x65,
+	0x72, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x66, 0x0a, 0x0b, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x6c, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x44, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x65, 0x74,
+	0x74, 0x65, 0x72, 0x67, 0x72, 0x2e, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x47, 0x65, 0x74, 0x47, 0x72, 0x61, 0x64, 0x65, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x69,
+	0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x50, 0x65, 0x72, 0x63,
+	0x65, 0x6e, 0x74, 0x69, 0x6c, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x70, 0x65,
+	0x72, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x70, 0x32, 0x35,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x70, 0x32, 0x35, 0x12, 0x10, 0x0a, 0x03, 0x70,
+	0x35, 0x30, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x70, 0x35, 0x30, 0x12, 0x10, 0x0a,
+	0x03, 0x70, 0x37, 0x35, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x70, 0x37, 0x35, 0x12,
+	0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0x3e, 0x0a, 0x10, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74,
+	0x69, 0x6c, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x84, 0x01, 0x0a, 0x19, 0x53, 0x65, 0x74, 0x47, 0x72, 0x61,
+	0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75,
+	0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x75,
+	0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x1d, 0x0a, 0x0a, 0x67, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x67, 0x72, 0x61, 0x64, 0x65,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x22, 0x1c, 0x0a, 0x1a,
+	0x53, 0x65, 0x74, 0x47, 0x72, 0x61, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x53, 0x63, 0x68, 0x65,
+	0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4e, 0x0a, 0x1a, 0x47, 0x65,
+	0x74, 0x47, 0x72, 0x61, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x65,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1a,
+	0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x22, 0x48, 0x0a, 0x0f, 0x47, 0x72,
+	0x61, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x12, 0x1d, 0x0a,
+	0x0a, 0x67, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x67, 0x72, 0x61, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x63,
+	0x68, 0x65, 0x6d, 0x65, 0x22, 0x60, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x47, 0x72, 0x61, 0x64, 0x65,
+	0x54, 0x79, 0x70, 0x65, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x07, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65,
+	0x72, 0x67, 0x72, 0x2e, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x72,
+	0x61, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x52, 0x07, 0x73,
+	0x63, 0x68, 0x65, 0x6d, 0x65, 0x73, 0x22, 0x63, 0x0a, 0x17, 0x53, 0x65, 0x74, 0x41, 0x74, 0x74,
+	0x65, 0x6d, 0x70, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
 	0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65,
-	0x6e, 0x74, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x75, 0x64,
-	0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49,
-	0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49,
-	0x44, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x72, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x72, 0x12, 0x1c, 0x0a,
-	0x09, 0x67, 0x72, 0x61, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x67, 0x72, 0x61, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x69,
-	0x74, 0x65, 0x6d, 0x49, 0x44, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x69, 0x74, 0x65,
-	0x6d, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x72, 0x61, 0x64, 0x65, 0x49, 0x44, 0x18, 0x07,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x72, 0x61, 0x64, 0x65, 0x49, 0x44, 0x22, 0x1b, 0x0a,
-	0x19, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x47, 0x72, 0x61,
-	0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x66, 0x0a, 0x16, 0x47, 0x65,
-	0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x47, 0x72, 0x61, 0x64, 0x65, 0x73, 0x52, 0x65, 0x71,
+	0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73,
+	0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73,
+	0x65, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x22, 0x1a, 0x0a, 0x18, 0x53,
+	0x65, 0x74, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x49, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x47, 0x72,
+	0x61, 0x64, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x72, 0x61, 0x64,
+	0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x72, 0x61, 0x64, 0x65,
+	0x49, 0x44, 0x22, 0xb7, 0x01, 0x0a, 0x0f, 0x47, 0x72, 0x61, 0x64, 0x65, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x72,
+	0x6f, 0x6d, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x66, 0x72, 0x6f, 0x6d, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x6f, 0x5f,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x6f, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x5f,
+	0x62, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x64, 0x42, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64,
+	0x41, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x65, 0x0a, 0x18,
+	0x47, 0x65, 0x74, 0x47, 0x72, 0x61, 0x64, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x6c, 0x69, 0x6e, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x6e,
+	0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x67, 0x72, 0x2e, 0x67, 0x72, 0x61,
+	0x64, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x65, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x22, 0xc3, 0x02, 0x0a, 0x1f, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73,
+	0x65, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x65, 0x64, 0x41, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1a, 0x0a,
+	0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x6d,
+	0x65, 0x73, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x6d,
+	0x65, 0x73, 0x74, 0x65, 0x72, 0x12, 0x5e, 0x0a, 0x07, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x73,
+	0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x44, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x65, 0x74,
+	0x74, 0x65, 0x72, 0x67, 0x72, 0x2e, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x47, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x65,
+	0x64, 0x41, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e,
+	0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x77, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x73, 0x12, 0x36, 0x0a, 0x17, 0x65, 0x71, 0x75, 0x61, 0x6c, 0x5f, 0x77,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x75, 0x6e, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x65, 0x64,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x15, 0x65, 0x71, 0x75, 0x61, 0x6c, 0x57, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x55, 0x6e, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x65, 0x64, 0x1a, 0x3a, 0x0a,
+	0x0c, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x79, 0x0a, 0x10, 0x43, 0x6f, 0x6d,
+	0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x41, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x12, 0x1d, 0x0a,
+	0x0a, 0x67, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x67, 0x72, 0x61, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x61,
+	0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x22, 0x8e, 0x02, 0x0a, 0x20, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x72,
+	0x73, 0x65, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x65, 0x64, 0x41, 0x76, 0x65, 0x72, 0x61, 0x67,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x77, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x0f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x65, 0x64, 0x41, 0x76, 0x65,
+	0x72, 0x61, 0x67, 0x65, 0x12, 0x2d, 0x0a, 0x12, 0x75, 0x6e, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x65, 0x64, 0x5f, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x11, 0x75, 0x6e, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x65, 0x64, 0x41, 0x76, 0x65, 0x72,
+	0x61, 0x67, 0x65, 0x12, 0x48, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x65,
+	0x74, 0x74, 0x65, 0x72, 0x67, 0x72, 0x2e, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x41, 0x76, 0x65, 0x72, 0x61, 0x67,
+	0x65, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x30, 0x0a,
+	0x14, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x5f, 0x67, 0x72, 0x61, 0x64, 0x65, 0x5f,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x12, 0x65, 0x78, 0x63,
+	0x6c, 0x75, 0x64, 0x65, 0x64, 0x47, 0x72, 0x61, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12,
+	0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x6b, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x48, 0x6f, 0x6e, 0x6f,
+	0x72, 0x52, 0x6f, 0x6c, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x75, 0x64,
+	0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x75,
+	0x64, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x6d, 0x65, 0x73, 0x74,
+	0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x6d, 0x65, 0x73, 0x74,
+	0x65, 0x72, 0x22, 0xe3, 0x01, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x48, 0x6f, 0x6e, 0x6f, 0x72, 0x52,
+	0x6f, 0x6c, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x67, 0x70, 0x61,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x67, 0x70, 0x61, 0x12, 0x1c, 0x0a, 0x09, 0x74,
+	0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09,
+	0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x75,
+	0x72, 0x73, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0b, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b,
+	0x6d, 0x69, 0x6e, 0x5f, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0a, 0x6d, 0x69, 0x6e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x12, 0x18, 0x0a,
+	0x07, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07,
+	0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x69, 0x6e, 0x5f, 0x63,
+	0x72, 0x65, 0x64, 0x69, 0x74, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x6d, 0x69,
+	0x6e, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x73, 0x22, 0x8d, 0x01, 0x0a, 0x1e, 0x47, 0x65, 0x74,
+	0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x47, 0x72, 0x61, 0x64, 0x65, 0x73, 0x43, 0x68, 0x65, 0x63,
+	0x6b, 0x73, 0x75, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x1a, 0x0a,
+	0x08, 0x73, 0x65, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x73, 0x65, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x79, 0x5f,
+	0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x62,
+	0x79, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x22, 0xb2, 0x02, 0x0a, 0x1f, 0x47, 0x65, 0x74,
+	0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x47, 0x72, 0x61, 0x64, 0x65, 0x73, 0x43, 0x68, 0x65, 0x63,
+	0x6b, 0x73, 0x75, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08,
+	0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x6c, 0x67, 0x6f,
+	0x72, 0x69, 0x74, 0x68, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x6c, 0x67,
+	0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x7a, 0x0a, 0x11,
+	0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x4d, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x65,
+	0x74, 0x74, 0x65, 0x72, 0x67, 0x72, 0x2e, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x47, 0x72, 0x61, 0x64, 0x65, 0x73,
+	0x43, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x2e, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x10, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x43,
+	0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x73, 0x1a, 0x43, 0x0a, 0x15, 0x53, 0x74, 0x75, 0x64,
+	0x65, 0x6e, 0x74, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x6a, 0x0a,
+	0x1c, 0x53, 0x65, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x44,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49,
+	0x44, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x06, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x22, 0x1f, 0x0a, 0x1d, 0x53, 0x65, 0x74,
+	0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x69, 0x67, 0x65,
+	0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x6b, 0x0a, 0x19, 0x47, 0x65,
+	0x74, 0x50, 0x72, 0x6f, 0x62, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1c, 0x0a,
+	0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x73,
+	0x65, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73,
+	0x65, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x72, 0x22, 0xd6, 0x01, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x50,
+	0x72, 0x6f, 0x62, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x10,
+	0x0a, 0x03, 0x67, 0x70, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x67, 0x70, 0x61,
+	0x12, 0x1c, 0x0a, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x18,
+	0x0a, 0x07, 0x64, 0x65, 0x66, 0x69, 0x63, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x07, 0x64, 0x65, 0x66, 0x69, 0x63, 0x69, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x21, 0x0a, 0x0c,
+	0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0b, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x1f, 0x0a, 0x0b, 0x6d, 0x69, 0x6e, 0x5f, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6d, 0x69, 0x6e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73,
+	0x22, 0x6f, 0x0a, 0x1f, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65,
+	0x47, 0x72, 0x61, 0x64, 0x65, 0x73, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75,
+	0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x75,
+	0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x6d, 0x65, 0x73, 0x74, 0x65,
+	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x6d, 0x65, 0x73, 0x74, 0x65,
+	0x72, 0x22, 0xaa, 0x01, 0x0a, 0x20, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x75, 0x72,
+	0x73, 0x65, 0x47, 0x72, 0x61, 0x64, 0x65, 0x73, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x1c,
+	0x0a, 0x09, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x12, 0x14, 0x0a, 0x05,
+	0x6b, 0x65, 0x79, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6b, 0x65, 0x79,
+	0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x48,
+	0x0a, 0x16, 0x55, 0x6e, 0x64, 0x6f, 0x47, 0x72, 0x61, 0x64, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x18,
+	0x0a, 0x07, 0x67, 0x72, 0x61, 0x64, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x67, 0x72, 0x61, 0x64, 0x65, 0x49, 0x44, 0x22, 0x77, 0x0a, 0x17, 0x55, 0x6e, 0x64, 0x6f,
+	0x47, 0x72, 0x61, 0x64, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x05, 0x67, 0x72, 0x61, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x23, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x67,
+	0x72, 0x2e, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x6e, 0x67,
+	0x6c, 0x65, 0x47, 0x72, 0x61, 0x64, 0x65, 0x52, 0x05, 0x67, 0x72, 0x61, 0x64, 0x65, 0x12, 0x21,
+	0x0a, 0x0c, 0x75, 0x6e, 0x64, 0x6f, 0x6e, 0x65, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x75, 0x6e, 0x64, 0x6f, 0x6e, 0x65, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x22, 0x82, 0x03, 0x0a, 0x13, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x6c, 0x75,
+	0x6d, 0x6e, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x12, 0x52, 0x0a, 0x07, 0x63, 0x6f, 0x6c,
+	0x75, 0x6d, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x38, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x67, 0x72, 0x2e, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e,
+	0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x12, 0x55, 0x0a,
+	0x08, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x39, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x67, 0x72, 0x2e, 0x67,
+	0x72, 0x61, 0x64, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x43,
+	0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x2e, 0x44, 0x65, 0x66,
+	0x61, 0x75, 0x6c, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x64, 0x65, 0x66, 0x61,
+	0x75, 0x6c, 0x74, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x5f, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x73, 0x12,
+	0x1c, 0x0a, 0x09, 0x64, 0x65, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x72, 0x1a, 0x3a, 0x0a,
+	0x0c, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3b, 0x0a, 0x0d, 0x44, 0x65, 0x66,
+	0x61, 0x75, 0x6c, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xa8, 0x01, 0x0a, 0x16, 0x49, 0x6d, 0x70, 0x6f, 0x72,
+	0x74, 0x47, 0x72, 0x61, 0x64, 0x65, 0x73, 0x43, 0x53, 0x56, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x12, 0x45, 0x0a, 0x07, 0x6d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x67,
+	0x72, 0x2e, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6d, 0x70, 0x6f,
+	0x72, 0x74, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x52,
+	0x07, 0x6d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f,
+	0x72, 0x75, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75,
+	0x6e, 0x22, 0x3e, 0x0a, 0x0e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x6f, 0x77, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x04, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x22, 0xbd, 0x01, 0x0a, 0x17, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x47, 0x72, 0x61, 0x64,
+	0x65, 0x73, 0x43, 0x53, 0x56, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a,
+	0x0e, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x3e, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65,
+	0x72, 0x67, 0x72, 0x2e, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6d,
+	0x70, 0x6f, 0x72, 0x74, 0x52, 0x6f, 0x77, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x06, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x73, 0x12, 0x3b, 0x0a, 0x06, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65,
+	0x72, 0x67, 0x72, 0x2e, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69,
+	0x6e, 0x67, 0x6c, 0x65, 0x47, 0x72, 0x61, 0x64, 0x65, 0x52, 0x06, 0x67, 0x72, 0x61, 0x64, 0x65,
+	0x73, 0x22, 0x43, 0x0a, 0x11, 0x46, 0x69, 0x6c, 0x65, 0x41, 0x70, 0x70, 0x65, 0x61, 0x6c, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x18, 0x0a, 0x07,
+	0x67, 0x72, 0x61, 0x64, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67,
+	0x72, 0x61, 0x64, 0x65, 0x49, 0x44, 0x22, 0x4f, 0x0a, 0x12, 0x46, 0x69, 0x6c, 0x65, 0x41, 0x70,
+	0x70, 0x65, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x05,
+	0x67, 0x72, 0x61, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x67, 0x72, 0x2e, 0x67, 0x72, 0x61, 0x64, 0x65,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x47, 0x72, 0x61, 0x64, 0x65,
+	0x52, 0x05, 0x67, 0x72, 0x61, 0x64, 0x65, 0x22, 0x81, 0x01, 0x0a, 0x14, 0x52, 0x65, 0x73, 0x6f,
+	0x6c, 0x76, 0x65, 0x41, 0x70, 0x70, 0x65, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x72, 0x61, 0x64, 0x65, 0x49,
+	0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x72, 0x61, 0x64, 0x65, 0x49, 0x44,
+	0x12, 0x18, 0x0a, 0x07, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x67, 0x72,
+	0x61, 0x64, 0x65, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x67, 0x72, 0x61, 0x64, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x52, 0x0a, 0x15, 0x52,
+	0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x41, 0x70, 0x70, 0x65, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x05, 0x67, 0x72, 0x61, 0x64, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72,
+	0x67, 0x72, 0x2e, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x6e,
+	0x67, 0x6c, 0x65, 0x47, 0x72, 0x61, 0x64, 0x65, 0x52, 0x05, 0x67, 0x72, 0x61, 0x64, 0x65, 0x22,
+	0x80, 0x01, 0x0a, 0x18, 0x57, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x47,
+	0x72, 0x61, 0x64, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x1a,
+	0x0a, 0x08, 0x73, 0x65, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x73, 0x65, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x6f,
+	0x63, 0x61, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x6f, 0x63, 0x61,
+	0x6c, 0x65, 0x22, 0x5c, 0x0a, 0x0b, 0x47, 0x72, 0x61, 0x64, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x39, 0x0a, 0x05, 0x67, 0x72, 0x61, 0x64, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65,
+	0x72, 0x67, 0x72, 0x2e, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69,
+	0x6e, 0x67, 0x6c, 0x65, 0x47, 0x72, 0x61, 0x64, 0x65, 0x52, 0x05, 0x67, 0x72, 0x61, 0x64, 0x65,
+	0x22, 0x83, 0x01, 0x0a, 0x1c, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x6d, 0x65, 0x73,
+	0x74, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x6d, 0x65,
+	0x73, 0x74, 0x65, 0x72, 0x73, 0x12, 0x0c, 0x0a, 0x01, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x01, 0x6b, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x5f, 0x79, 0x65,
+	0x61, 0x72, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x70, 0x65, 0x72, 0x69, 0x6f,
+	0x64, 0x59, 0x65, 0x61, 0x72, 0x73, 0x22, 0x84, 0x01, 0x0a, 0x0e, 0x52, 0x65, 0x73, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75,
+	0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x75,
+	0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x1d, 0x0a,
+	0x0a, 0x67, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x67, 0x72, 0x61, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1f, 0x0a, 0x0b,
+	0x67, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x67, 0x72, 0x61, 0x64, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xe8, 0x01,
+	0x0a, 0x1d, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x40, 0x0a, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x26, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x67, 0x72, 0x2e,
+	0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x73, 0x12, 0x0c, 0x0a, 0x01, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x6b, 0x12,
+	0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x2b, 0x0a, 0x11, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x5f,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x67, 0x65, 0x6e,
+	0x65, 0x72, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x29, 0x0a,
+	0x10, 0x73, 0x75, 0x70, 0x70, 0x72, 0x65, 0x73, 0x73, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x73, 0x75, 0x70, 0x70, 0x72, 0x65, 0x73,
+	0x73, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x50, 0x0a, 0x1a, 0x45, 0x78, 0x70, 0x6f,
+	0x72, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x50, 0x44, 0x46, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1c, 0x0a, 0x09,
+	0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x22, 0x4b, 0x0a, 0x1b, 0x45, 0x78,
+	0x70, 0x6f, 0x72, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x50, 0x44,
+	0x46, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x70, 0x64, 0x66,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x70, 0x64, 0x66, 0x12, 0x1a, 0x0a, 0x08, 0x66,
+	0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66,
+	0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x55, 0x0a, 0x16, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x41, 0x64, 0x64, 0x47, 0x72, 0x61, 0x64, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x3b, 0x0a, 0x06, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x23, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x67, 0x72,
+	0x2e, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x6e, 0x67, 0x6c,
+	0x65, 0x47, 0x72, 0x61, 0x64, 0x65, 0x52, 0x06, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x22, 0xb4,
+	0x01, 0x0a, 0x1e, 0x4e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x41, 0x63, 0x72, 0x6f,
+	0x73, 0x73, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73,
+	0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73,
+	0x65, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x72, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x72, 0x12,
+	0x16, 0x0a, 0x06, 0x69, 0x74, 0x65, 0x6d, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x69, 0x74, 0x65, 0x6d, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f,
+	0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12,
+	0x14, 0x0a, 0x05, 0x61, 0x70, 0x70, 0x6c, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05,
+	0x61, 0x70, 0x70, 0x6c, 0x79, 0x22, 0xd7, 0x01, 0x0a, 0x0c, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x65,
+	0x61, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x6d, 0x65, 0x61, 0x6e, 0x12, 0x17,
+	0x0a, 0x07, 0x73, 0x74, 0x64, 0x5f, 0x64, 0x65, 0x76, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x06, 0x73, 0x74, 0x64, 0x44, 0x65, 0x76, 0x12, 0x27, 0x0a, 0x0f, 0x6e, 0x6f, 0x72, 0x6d, 0x61,
+	0x6c, 0x69, 0x7a, 0x65, 0x64, 0x5f, 0x6d, 0x65, 0x61, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x0e, 0x6e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x4d, 0x65, 0x61, 0x6e,
+	0x12, 0x3d, 0x0a, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x27, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x67, 0x72, 0x2e, 0x67,
+	0x72, 0x61, 0x64, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x65, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x22,
+	0x95, 0x01, 0x0a, 0x0f, 0x4e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x47, 0x72,
+	0x61, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x72, 0x61, 0x64, 0x65, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x72, 0x61, 0x64, 0x65, 0x49, 0x44, 0x12, 0x1c, 0x0a,
+	0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x73,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x65, 0x66,
+	0x6f, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x22, 0xaa, 0x02, 0x0a, 0x1f, 0x4e, 0x6f, 0x72, 0x6d,
+	0x61, 0x6c, 0x69, 0x7a, 0x65, 0x41, 0x63, 0x72, 0x6f, 0x73, 0x73, 0x53, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x08, 0x73,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x67, 0x72, 0x2e, 0x67, 0x72, 0x61,
+	0x64, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x52, 0x08, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x3f, 0x0a,
+	0x06, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x67, 0x72, 0x2e, 0x67, 0x72, 0x61,
+	0x64, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x69, 0x7a, 0x65,
+	0x64, 0x47, 0x72, 0x61, 0x64, 0x65, 0x52, 0x06, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x12, 0x1f,
+	0x0a, 0x0b, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x5f, 0x6d, 0x65, 0x61, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x0a, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x4d, 0x65, 0x61, 0x6e, 0x12,
+	0x24, 0x0a, 0x0e, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x5f, 0x73, 0x74, 0x64, 0x5f, 0x64, 0x65,
+	0x76, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x53,
+	0x74, 0x64, 0x44, 0x65, 0x76, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x12,
+	0x23, 0x0a, 0x0d, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x22, 0x6e, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x53, 0x74, 0x75, 0x64, 0x65,
+	0x6e, 0x74, 0x53, 0x65, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x72, 0x47, 0x50, 0x41, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74,
+	0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73,
+	0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x6d, 0x65,
+	0x73, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x6d, 0x65,
+	0x73, 0x74, 0x65, 0x72, 0x22, 0x7f, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x53, 0x74, 0x75, 0x64, 0x65,
+	0x6e, 0x74, 0x53, 0x65, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x72, 0x47, 0x50, 0x41, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x67, 0x70, 0x61, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x03, 0x67, 0x70, 0x61, 0x12, 0x25, 0x0a, 0x0e, 0x69, 0x6e, 0x63, 0x6c, 0x75,
+	0x64, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0d, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x25,
+	0x0a, 0x0e, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x96, 0x01, 0x0a, 0x1f, 0x47, 0x65, 0x74, 0x57, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x61, 0x6e, 0x6b, 0x69,
+	0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
+	0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x73,
+	0x65, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73,
+	0x65, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x72, 0x12, 0x25, 0x0a, 0x0e, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6e, 0x67, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x22, 0xbe,
+	0x01, 0x0a, 0x14, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x65, 0x64, 0x52, 0x61, 0x6e, 0x6b, 0x69,
+	0x6e, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x12, 0x1c, 0x0a, 0x09, 0x73,
+	0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x25, 0x0a, 0x0e, 0x77, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x65, 0x64, 0x5f, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x0d, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x65, 0x64, 0x46, 0x69, 0x6e, 0x61, 0x6c,
+	0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x6c, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x6c, 0x65,
+	0x12, 0x2d, 0x0a, 0x12, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x6f, 0x6d, 0x70,
+	0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x11, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x73, 0x22,
+	0xbe, 0x01, 0x0a, 0x20, 0x47, 0x65, 0x74, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x65, 0x64, 0x43,
+	0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x65, 0x74, 0x74,
+	0x65, 0x72, 0x67, 0x72, 0x2e, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x57,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x65, 0x64, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x2b, 0x0a, 0x11,
+	0x75, 0x6e, 0x72, 0x61, 0x6e, 0x6b, 0x65, 0x64, 0x5f, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x75, 0x6e, 0x72, 0x61, 0x6e, 0x6b, 0x65,
+	0x64, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x22, 0x8e, 0x01, 0x0a, 0x1f, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x47, 0x72,
+	0x61, 0x64, 0x65, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x52, 0x65, 0x71,
 	0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20,
 	0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f,
 	0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f,
 	0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x6d, 0x65, 0x73, 0x74,
 	0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x6d, 0x65, 0x73, 0x74,
-	0x65, 0x72, 0x22, 0x46, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x47,
-	0x72, 0x61, 0x64, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a,
-	0x06, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e,
-	0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x2e, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x47, 0x72, 0x61,
-	0x64, 0x65, 0x52, 0x06, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x22, 0x71, 0x0a, 0x1f, 0x47, 0x65,
-	0x74, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x72,
+	0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x67, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x67, 0x72, 0x61, 0x64, 0x65, 0x54, 0x79, 0x70,
+	0x65, 0x22, 0xdc, 0x01, 0x0a, 0x20, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x47,
+	0x72, 0x61, 0x64, 0x65, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x65, 0x61, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x6d, 0x65, 0x61, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65,
+	0x64, 0x69, 0x61, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x6d, 0x65, 0x64, 0x69,
+	0x61, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x03, 0x6d, 0x69, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x61, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x03, 0x6d, 0x61, 0x78, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x74, 0x64, 0x5f, 0x64, 0x65,
+	0x76, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x73, 0x74, 0x64, 0x44, 0x65, 0x76, 0x12,
+	0x23, 0x0a, 0x0d, 0x6e, 0x75, 0x6d, 0x65, 0x72, 0x69, 0x63, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x6e, 0x75, 0x6d, 0x65, 0x72, 0x69, 0x63, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x6e, 0x6f, 0x6e, 0x5f, 0x6e, 0x75, 0x6d, 0x65,
+	0x72, 0x69, 0x63, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0f, 0x6e, 0x6f, 0x6e, 0x4e, 0x75, 0x6d, 0x65, 0x72, 0x69, 0x63, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x22, 0x7f, 0x0a, 0x19, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x54, 0x65, 0x73, 0x74,
 	0x47, 0x72, 0x61, 0x64, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a,
 	0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f,
-	0x6b, 0x65, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x72, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x72, 0x12,
-	0x1c, 0x0a, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x22, 0x4f, 0x0a,
-	0x20, 0x47, 0x65, 0x74, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x6d, 0x65, 0x73,
-	0x74, 0x65, 0x72, 0x47, 0x72, 0x61, 0x64, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x2b, 0x0a, 0x06, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x13, 0x2e, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x2e, 0x53, 0x69, 0x6e, 0x67, 0x6c,
-	0x65, 0x47, 0x72, 0x61, 0x64, 0x65, 0x52, 0x06, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x22, 0x8b,
-	0x02, 0x0a, 0x0b, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x47, 0x72, 0x61, 0x64, 0x65, 0x12, 0x1a,
-	0x0a, 0x08, 0x73, 0x65, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x73, 0x65, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x72,
-	0x61, 0x64, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x72, 0x61,
-	0x64, 0x65, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49,
-	0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74,
-	0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x1c,
-	0x0a, 0x09, 0x67, 0x72, 0x61, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x67, 0x72, 0x61, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06,
-	0x69, 0x74, 0x65, 0x6d, 0x49, 0x44, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x69, 0x74,
-	0x65, 0x6d, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x67, 0x72, 0x61, 0x64, 0x65, 0x56, 0x61, 0x6c,
-	0x75, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x67, 0x72, 0x61, 0x64, 0x65, 0x56,
-	0x61, 0x6c, 0x75, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x67, 0x72, 0x61, 0x64, 0x65, 0x64, 0x42, 0x79,
-	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x67, 0x72, 0x61, 0x64, 0x65, 0x64, 0x42, 0x79,
-	0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x09, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x32, 0xc0, 0x04, 0x0a,
-	0x0d, 0x47, 0x72, 0x61, 0x64, 0x65, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x52,
-	0x0a, 0x0f, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x47, 0x72, 0x61, 0x64, 0x65,
-	0x73, 0x12, 0x1e, 0x2e, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f,
-	0x75, 0x72, 0x73, 0x65, 0x47, 0x72, 0x61, 0x64, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1f, 0x2e, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f,
-	0x75, 0x72, 0x73, 0x65, 0x47, 0x72, 0x61, 0x64, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x67, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74,
-	0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x47, 0x72, 0x61, 0x64, 0x65, 0x73, 0x12, 0x25, 0x2e, 0x67,
-	0x72, 0x61, 0x64, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74,
-	0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x47, 0x72, 0x61, 0x64, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74,
-	0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x47, 0x72, 0x61,
-	0x64, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4f, 0x0a, 0x0e, 0x41,
-	0x64, 0x64, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x47, 0x72, 0x61, 0x64, 0x65, 0x12, 0x1d, 0x2e,
-	0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65,
-	0x47, 0x72, 0x61, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x67,
-	0x72, 0x61, 0x64, 0x65, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x47,
-	0x72, 0x61, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x58, 0x0a, 0x11,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x47, 0x72, 0x61, 0x64,
-	0x65, 0x12, 0x20, 0x2e, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x47, 0x72, 0x61, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x2e, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x47, 0x72, 0x61, 0x64, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x58, 0x0a, 0x11, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65,
-	0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x47, 0x72, 0x61, 0x64, 0x65, 0x12, 0x20, 0x2e, 0x67, 0x72,
-	0x61, 0x64, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x69, 0x6e, 0x67, 0x6c,
-	0x65, 0x47, 0x72, 0x61, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e,
-	0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x69, 0x6e,
-	0x67, 0x6c, 0x65, 0x47, 0x72, 0x61, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x6d, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x53, 0x65,
-	0x6d, 0x65, 0x73, 0x74, 0x65, 0x72, 0x47, 0x72, 0x61, 0x64, 0x65, 0x73, 0x12, 0x27, 0x2e, 0x67,
-	0x72, 0x61, 0x64, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74,
-	0x53, 0x65, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x72, 0x47, 0x72, 0x61, 0x64, 0x65, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x2e, 0x47,
-	0x65, 0x74, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x6d, 0x65, 0x73, 0x74, 0x65,
-	0x72, 0x47, 0x72, 0x61, 0x64, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42,
-	0x30, 0x5a, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x42, 0x65,
-	0x74, 0x74, 0x65, 0x72, 0x47, 0x52, 0x2f, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x2d, 0x6d, 0x69,
-	0x63, 0x72, 0x6f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12,
+	0x1a, 0x0a, 0x08, 0x73, 0x65, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x73, 0x65, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x22, 0x53, 0x0a, 0x1a, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x54, 0x65, 0x73,
+	0x74, 0x47, 0x72, 0x61, 0x64, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x23, 0x0a, 0x0d, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x75, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x72, 0x75, 0x6e, 0x32, 0x8f, 0x3f, 0x0a, 0x0d, 0x47, 0x72, 0x61, 0x64, 0x65,
+	0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x72, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x43,
+	0x6f, 0x75, 0x72, 0x73, 0x65, 0x47, 0x72, 0x61, 0x64, 0x65, 0x73, 0x12, 0x2e, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x67, 0x72, 0x2e, 0x67, 0x72, 0x61, 0x64, 0x65,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x47, 0x72,
+	0x61, 0x64, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x67, 0x72, 0x2e, 0x67, 0x72, 0x61, 0x64, 0x65,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x47, 0x72,
+	0x61, 0x64, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x87, 0x01, 0x0a,
+	0x16, 0x47, 0x65, 0x74, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73,
+	0x65, 0x47, 0x72, 0x61, 0x64, 0x65, 0x73, 0x12, 0x35, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x65,
+	0x74, 0x74, 0x65, 0x72, 0x67, 0x72, 0x2e, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73,
+	0x65, 0x47, 0x72, 0x61, 0x64, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x36,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x67, 0x72, 0x2e, 0x67, 0x72,
+	0x61, 0x64, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x75, 0x64, 0x65,
+	0x6e, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x47, 0x72, 0x61, 0x64, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6f, 0x0a, 0x0e, 0x41, 0x64, 0x64, 0x53, 0x69, 0x6e,
+	0x67, 0x6c, 0x65, 0x47, 0x72, 0x61, 0x64, 0x65, 0x12, 0x2d, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x62,
+	0x65, 0x74, 0x74, 0x65, 0x72, 0x67, 0x72, 0x2e, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x41, 0x64, 0x64, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x47, 0x72, 0x61, 0x64, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x65,
+	0x74, 0x74, 0x65, 0x72, 0x67, 0x72, 0x2e, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x41, 0x64, 0x64, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x47, 0x72, 0x61, 0x64, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x78, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x47, 0x72, 0x61, 0x64, 0x65, 0x12, 0x30, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x67, 0x72, 0x2e, 0x67, 0x72, 0x61, 0x64,
+	0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x69, 0x6e, 0x67,
+	0x6c, 0x65, 0x47, 0x72, 0x61, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x67, 0x72, 0x2e, 0x67, 0x72,
+	0x61, 0x64, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x69,
+	0x6e, 0x67, 0x6c, 0x65, 0x47, 0x72, 0x61, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x78, 0x0a, 0x11, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x69, 0x6e, 0x67, 0x6c,
+	0x65, 0x47, 0x72, 0x61, 0x64, 0x65, 0x12, 0x30, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x65, 0x74,
+	0x74, 0x65, 0x72, 0x67, 0x72, 0x2e, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x47, 0x72, 0x61, 0x64,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x62,
+	0x65, 0x74, 0x74, 0x65, 0x72, 0x67, 0x72, 0x2e, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x47, 0x72,
+	0x61, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x8d, 0x01, 0x0a, 0x18,
+	0x47, 0x65, 0x74, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x6d, 0x65, 0x73, 0x74,
+	0x65, 0x72, 0x47, 0x72, 0x61, 0x64, 0x65, 0x73, 0x12, 0x37, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x62,
+	0x65, 0x74, 0x74, 0x65, 0x72, 0x67, 0x72, 0x2e, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x6d, 0x65,
+	0x73, 0x74, 0x65, 0x72, 0x47, 0x72, 0x61, 0x64, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x38, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x67, 0x72,
+	0x2e, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74,
+	0x75, 0x64, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x72, 0x47, 0x72, 0x61,
+	0x64, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x72, 0x0a, 0x0f, 0x41,
+	0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x53, 0x65, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x72, 0x12, 0x2e,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x67, 0x72, 0x2e, 0x67, 0x72,
+	0x61, 0x64, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x53,
+	0x65, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x67, 0x72, 0x2e, 0x67, 0x72,
+	0x61, 0x64, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x53,
+	0x65, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x5d, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x47, 0x72, 0x61, 0x64, 0x65, 0x12, 0x27, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x67, 0x72, 0x2e, 0x67, 0x72, 0x61, 0x64, 0x65,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x72, 0x61, 0x64, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65,
+	0x72, 0x67, 0x72, 0x2e, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x47, 0x72, 0x61, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7e,
+	0x0a, 0x13, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x47, 0x72, 0x61, 0x64, 0x65, 0x43, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x32, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x65, 0x74, 0x74,
+	0x65, 0x72, 0x67, 0x72, 0x2e, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x47, 0x72, 0x61, 0x64, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x63, 0x6f, 0x6d, 0x2e,
+	0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x67, 0x72, 0x2e, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x47, 0x72, 0x61, 0x64, 0x65, 0x43, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x69,
+	0x0a, 0x0c, 0x53, 0x65, 0x74, 0x47, 0x72, 0x61, 0x64, 0x65, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x2b,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x67, 0x72, 0x2e, 0x67, 0x72,
+	0x61, 0x64, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x47, 0x72, 0x61, 0x64, 0x65,
+	0x46, 0x6c, 0x61, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x67, 0x72, 0x2e, 0x67, 0x72, 0x61, 0x64, 0x65,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x47, 0x72, 0x61, 0x64, 0x65, 0x46, 0x6c, 0x61,
+	0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x75, 0x0a, 0x10, 0x47, 0x65, 0x74,
+	0x46, 0x6c, 0x61, 0x67, 0x67, 0x65, 0x64, 0x47, 0x72, 0x61, 0x64, 0x65, 0x73, 0x12, 0x2f, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x67, 0x72, 0x2e, 0x67, 0x72, 0x61,
+	0x64, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x6c, 0x61, 0x67, 0x67, 0x65,
+	0x64, 0x47, 0x72, 0x61, 0x64, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x67, 0x72, 0x2e, 0x67, 0x72,
+	0x61, 0x64, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x6c, 0x61, 0x67, 0x67,
+	0x65, 0x64, 0x47, 0x72, 0x61, 0x64, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x81, 0x01, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x43, 0x6f, 0x75,
+	0x72, 0x73, 0x65, 0x47, 0x72, 0x61, 0x64, 0x65, 0x73, 0x12, 0x33, 0x2e, 0x63, 0x6f, 0x6d, 0x2e,
+	0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x67, 0x72, 0x2e, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x43, 0x6f, 0x75, 0x72, 0x73,
+	0x65, 0x47, 0x72, 0x61, 0x64, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x34,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x67, 0x72, 0x2e, 0x67, 0x72,
+	0x61, 0x64, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x75, 0x6c, 0x74, 0x69,
+	0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x47, 0x72, 0x61, 0x64, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7e, 0x0a, 0x13, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x53, 0x74,
+	0x75, 0x64, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x61, 0x64, 0x65, 0x73, 0x12, 0x32, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x67, 0x72, 0x2e, 0x67, 0x72, 0x61, 0x64, 0x65,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x53, 0x74, 0x75, 0x64, 0x65,
+	0x6e, 0x74, 0x47, 0x72, 0x61, 0x64, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x33, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x67, 0x72, 0x2e, 0x67,
+	0x72, 0x61, 0x64, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x53,
+	0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x61, 0x64, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x78, 0x0a, 0x11, 0x52, 0x65, 0x74, 0x72, 0x79, 0x46, 0x61, 0x69,
+	0x6c, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x30, 0x2e, 0x63, 0x6f, 0x6d, 0x2e,
+	0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x67, 0x72, 0x2e, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x79, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x67, 0x72, 0x2e, 0x67, 0x72, 0x61, 0x64, 0x65,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x79, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7b,
+	0x0a, 0x12, 0x47, 0x65, 0x74, 0x47, 0x72, 0x61, 0x64, 0x65, 0x50, 0x72, 0x6f, 0x76, 0x65, 0x6e,
+	0x61, 0x6e, 0x63, 0x65, 0x12, 0x31, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65,
+	0x72, 0x67, 0x72, 0x2e, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x47, 0x72, 0x61, 0x64, 0x65, 0x50, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x65,
+	0x74, 0x74, 0x65, 0x72, 0x67, 0x72, 0x2e, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x47, 0x72, 0x61, 0x64, 0x65, 0x50, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61,
+	0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x81, 0x01, 0x0a, 0x14,
+	0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x47,
+	0x72, 0x61, 0x64, 0x65, 0x12, 0x33, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65,
+	0x72, 0x67, 0x72, 0x2e, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72,
+	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x47, 0x72, 0x61,
+	0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e, 0x63, 0x6f, 0x6d, 0x2e,
+	0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x67, 0x72, 0x2e, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72,
+	0x65, 0x64, 0x47, 0x72, 0x61, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x77, 0x0a, 0x14, 0x42, 0x61, 0x74, 0x63, 0x68, 0x41, 0x64, 0x64, 0x47, 0x72, 0x61, 0x64, 0x65,
+	0x73, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x2d, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x65,
+	0x74, 0x74, 0x65, 0x72, 0x67, 0x72, 0x2e, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x41, 0x64, 0x64, 0x47, 0x72, 0x61, 0x64, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x65, 0x74,
+	0x74, 0x65, 0x72, 0x67, 0x72, 0x2e, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x41, 0x64, 0x64, 0x47, 0x72, 0x61, 0x64, 0x65, 0x73, 0x50, 0x72,
+	0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x30, 0x01, 0x12, 0x81, 0x01, 0x0a, 0x14, 0x44, 0x65, 0x74,
+	0x65, 0x63, 0x74, 0x47, 0x72, 0x61, 0x64, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74,
+	0x73, 0x12, 0x33, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x67, 0x72,
+	0x2e, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x74, 0x65, 0x63,
+	0x74, 0x47, 0x72, 0x61, 0x64, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x65, 0x74,
+	0x74, 0x65, 0x72, 0x67, 0x72, 0x2e, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x47, 0x72, 0x61, 0x64, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x6c,
+	0x69, 0x63, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x8a, 0x01, 0x0a,
+	0x17, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x54, 0x6f,
+	0x55, 0x6e, 0x67, 0x72, 0x61, 0x64, 0x65, 0x64, 0x12, 0x36, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x62,
+	0x65, 0x74, 0x74, 0x65, 0x72, 0x67, 0x72, 0x2e, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x54,
+	0x6f, 0x55, 0x6e, 0x67, 0x72, 0x61, 0x64, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x37, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x67, 0x72, 0x2e,
+	0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e,
+	0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x54, 0x6f, 0x55, 0x6e, 0x67, 0x72, 0x61, 0x64, 0x65,
+	0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6e, 0x0a, 0x12, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x47, 0x72, 0x61, 0x64, 0x65, 0x73, 0x12,
+	0x31, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x67, 0x72, 0x2e, 0x67,
+	0x72, 0x61, 0x64, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x43,
+	0x6f, 0x75, 0x72, 0x73, 0x65, 0x47, 0x72, 0x61, 0x64, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x23, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x67,
+	0x72, 0x2e, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x6e, 0x67,
+	0x6c, 0x65, 0x47, 0x72, 0x61, 0x64, 0x65, 0x30, 0x01, 0x12, 0x7b, 0x0a, 0x12, 0x47, 0x65, 0x74,
+	0x47, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x56, 0x65, 0x6c, 0x6f, 0x63, 0x69, 0x74, 0x79, 0x12,
+	0x31, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x67, 0x72, 0x2e, 0x67,
+	0x72, 0x61, 0x64, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x72, 0x61, 0x64,
+	0x69, 0x6e, 0x67, 0x56, 0x65, 0x6c, 0x6f, 0x63, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x32, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x67,
+	0x72, 0x2e, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x47,
+	0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x56, 0x65, 0x6c, 0x6f, 0x63, 0x69, 0x74, 0x79, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x72, 0x0a, 0x0f, 0x50, 0x75, 0x72, 0x67, 0x65, 0x54,
+	0x6f, 0x6d, 0x62, 0x73, 0x74, 0x6f, 0x6e, 0x65, 0x73, 0x12, 0x2e, 0x2e, 0x63, 0x6f, 0x6d, 0x2e,
+	0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x67, 0x72, 0x2e, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x50, 0x75, 0x72, 0x67, 0x65, 0x54, 0x6f, 0x6d, 0x62, 0x73, 0x74, 0x6f, 0x6e,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x63, 0x6f, 0x6d, 0x2e,
+	0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x67, 0x72, 0x2e, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x50, 0x75, 0x72, 0x67, 0x65, 0x54, 0x6f, 0x6d, 0x62, 0x73, 0x74, 0x6f, 0x6e,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x87, 0x01, 0x0a, 0x16, 0x43,
+	0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x53, 0x65, 0x6d, 0x65,
+	0x73, 0x74, 0x65, 0x72, 0x73, 0x12, 0x35, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x65, 0x74, 0x74,
+	0x65, 0x72, 0x67, 0x72, 0x2e, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x53, 0x65, 0x6d, 0x65,
+	0x73, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x36, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x67, 0x72, 0x2e, 0x67, 0x72, 0x61, 0x64,
+	0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x43, 0x6f, 0x75,
+	0x72, 0x73, 0x65, 0x53, 0x65, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x96, 0x01, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x53, 0x65, 0x6d, 0x65,
+	0x73, 0x74, 0x65, 0x72, 0x47, 0x72, 0x61, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x53, 0x75, 0x6d,
+	0x6d, 0x61, 0x72, 0x79, 0x12, 0x3a, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65,
+	0x72, 0x67, 0x72, 0x2e, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x53, 0x65, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x72, 0x47, 0x72, 0x61, 0x64, 0x65, 0x54, 0x79,
+	0x70, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x3b, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x67, 0x72, 0x2e,
+	0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x6d,
+	0x65, 0x73, 0x74, 0x65, 0x72, 0x47, 0x72, 0x61, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x53, 0x75,
+	0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x84, 0x01,
+	0x0a, 0x15, 0x53, 0x65, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x4f, 0x70, 0x74, 0x4f, 0x75, 0x74, 0x12, 0x34, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x65,
+	0x74, 0x74, 0x65, 0x72, 0x67, 0x72, 0x2e, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x65, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x4f, 0x70, 0x74, 0x4f, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x35, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x67, 0x72, 0x2e, 0x67, 0x72, 0x61,
+	0x64, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x70, 0x74, 0x4f, 0x75, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x87, 0x01, 0x0a, 0x16, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x43,
+	0x6f, 0x75, 0x72, 0x73, 0x65, 0x47, 0x72, 0x61, 0x64, 0x65, 0x73, 0x58, 0x4c, 0x53, 0x58, 0x12,
+	0x35, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x67, 0x72, 0x2e, 0x67,
+	0x72, 0x61, 0x64, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x43,
+	0x6f, 0x75, 0x72, 0x73, 0x65, 0x47, 0x72, 0x61, 0x64, 0x65, 0x73, 0x58, 0x4c, 0x53, 0x58, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x36, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x65, 0x74,
+	0x74, 0x65, 0x72, 0x67, 0x72, 0x2e, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x47, 0x72, 0x61, 0x64,
+	0x65, 0x73, 0x58, 0x4c, 0x53, 0x58, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x81,
+	0x01, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x59, 0x65, 0x61,
+	0x72, 0x47, 0x72, 0x61, 0x64, 0x65, 0x73, 0x12, 0x33, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x65,
+	0x74, 0x74, 0x65, 0x72, 0x67, 0x72, 0x2e, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x59, 0x65, 0x61, 0x72, 0x47,
+	0x72, 0x61, 0x64, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x67, 0x72, 0x2e, 0x67, 0x72, 0x61, 0x64,
+	0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74,
+	0x59, 0x65, 0x61, 0x72, 0x47, 0x72, 0x61, 0x64, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x6c, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x47, 0x72, 0x61, 0x64, 0x65, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x12, 0x2c, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72,
+	0x67, 0x72, 0x2e, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x47, 0x72, 0x61, 0x64, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x2d, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x67, 0x72,
+	0x2e, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x72,
+	0x61, 0x64, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x72, 0x0a, 0x0f, 0x41, 0x64, 0x64, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x47, 0x72, 0x61,
+	0x64, 0x65, 0x72, 0x12, 0x2e, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72,
+	0x67, 0x72, 0x2e, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64,
+	0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x47, 0x72, 0x61, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72,
+	0x67, 0x72, 0x2e, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64,
+	0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x47, 0x72, 0x61, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7b, 0x0a, 0x12, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x6f,
+	0x75, 0x72, 0x73, 0x65, 0x47, 0x72, 0x61, 0x64, 0x65, 0x72, 0x12, 0x31, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x67, 0x72, 0x2e, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65,
+	0x47, 0x72, 0x61, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x67, 0x72, 0x2e, 0x67, 0x72, 0x61,
+	0x64, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x6f, 0x75,
+	0x72, 0x73, 0x65, 0x47, 0x72, 0x61, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x75, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x47, 0x72,
+	0x61, 0x64, 0x65, 0x72, 0x73, 0x12, 0x2f, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x65, 0x74, 0x74,
+	0x65, 0x72, 0x67, 0x72, 0x2e, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x47, 0x72, 0x61, 0x64, 0x65, 0x72, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x65, 0x74,
+	0x74, 0x65, 0x72, 0x67, 0x72, 0x2e, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x47, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x47, 0x72, 0x61, 0x64, 0x65, 0x72, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6f, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x43,
+	0x6f, 0x75, 0x72, 0x73, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x2d, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x67, 0x72, 0x2e, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x53, 0x74, 0x61,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x63, 0x6f, 0x6d, 0x2e,
+	0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x67, 0x72, 0x2e, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x53, 0x74, 0x61, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7b, 0x0a, 0x12, 0x52, 0x65, 0x66,
+	0x72, 0x65, 0x73, 0x68, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12,
+	0x31, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x67, 0x72, 0x2e, 0x67,
+	0x72, 0x61, 0x64, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68,
+	0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x32, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x67,
+	0x72, 0x2e, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x66, 0x72,
+	0x65, 0x73, 0x68, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x87, 0x01, 0x0a, 0x16, 0x45, 0x78, 0x70, 0x6f, 0x72,
+	0x74, 0x53, 0x65, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x72, 0x54, 0x6f, 0x42, 0x75, 0x63, 0x6b, 0x65,
+	0x74, 0x12, 0x35, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x67, 0x72,
+	0x2e, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72,
+	0x74, 0x53, 0x65, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x72, 0x54, 0x6f, 0x42, 0x75, 0x63, 0x6b, 0x65,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x36, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x62,
+	0x65, 0x74, 0x74, 0x65, 0x72, 0x67, 0x72, 0x2e, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x65, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x72,
+	0x54, 0x6f, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x7e, 0x0a, 0x13, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x47, 0x72, 0x61, 0x64,
+	0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x32, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x65,
+	0x74, 0x74, 0x65, 0x72, 0x67, 0x72, 0x2e, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x47, 0x72, 0x61, 0x64, 0x65, 0x43, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x67, 0x72, 0x2e, 0x67, 0x72, 0x61, 0x64, 0x65,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x47, 0x72, 0x61,
+	0x64, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x81, 0x01, 0x0a, 0x14, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x65,
+	0x6c, 0x65, 0x61, 0x73, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x33, 0x2e, 0x63, 0x6f, 0x6d, 0x2e,
+	0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x67, 0x72, 0x2e, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x65, 0x6c, 0x65,
+	0x61, 0x73, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x34,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x67, 0x72, 0x2e, 0x67, 0x72,
+	0x61, 0x64, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73,
+	0x65, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x87, 0x01, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x53, 0x65, 0x6d, 0x65,
+	0x73, 0x74, 0x65, 0x72, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x12,
+	0x35, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x67, 0x72, 0x2e, 0x67,
+	0x72, 0x61, 0x64, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x6d, 0x65,
+	0x73, 0x74, 0x65, 0x72, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x36, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x65, 0x74,
+	0x74, 0x65, 0x72, 0x67, 0x72, 0x2e, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x47, 0x65, 0x74, 0x53, 0x65, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x72, 0x4c, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x75,
+	0x0a, 0x10, 0x47, 0x65, 0x74, 0x47, 0x72, 0x61, 0x64, 0x65, 0x46, 0x6c, 0x65, 0x78, 0x69, 0x62,
+	0x6c, 0x65, 0x12, 0x2f, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x67,
+	0x72, 0x2e, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x47,
+	0x72, 0x61, 0x64, 0x65, 0x46, 0x6c, 0x65, 0x78, 0x69, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72,
+	0x67, 0x72, 0x2e, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x47, 0x72, 0x61, 0x64, 0x65, 0x46, 0x6c, 0x65, 0x78, 0x69, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x8a, 0x01, 0x0a, 0x17, 0x53, 0x6f, 0x66, 0x74, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x61, 0x64, 0x65,
+	0x73, 0x12, 0x36, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x67, 0x72,
+	0x2e, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6f, 0x66, 0x74, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x61, 0x64,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x37, 0x2e, 0x63, 0x6f, 0x6d, 0x2e,
+	0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x67, 0x72, 0x2e, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x6f, 0x66, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x74, 0x75,
+	0x64, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x61, 0x64, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x69, 0x0a, 0x0c, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x43, 0x75, 0x72,
+	0x76, 0x65, 0x12, 0x2b, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x67,
+	0x72, 0x2e, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x65, 0x76,
+	0x69, 0x65, 0x77, 0x43, 0x75, 0x72, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x2c, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x67, 0x72, 0x2e, 0x67,
+	0x72, 0x61, 0x64, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77,
+	0x43, 0x75, 0x72, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7e, 0x0a,
+	0x13, 0x47, 0x65, 0x74, 0x47, 0x72, 0x61, 0x64, 0x65, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74,
+	0x69, 0x6c, 0x65, 0x73, 0x12, 0x32, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65,
+	0x72, 0x67, 0x72, 0x2e, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x47, 0x72, 0x61, 0x64, 0x65, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x6c, 0x65,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x62,
+	0x65, 0x74, 0x74, 0x65, 0x72, 0x67, 0x72, 0x2e, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x72, 0x61, 0x64, 0x65, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e,
+	0x74, 0x69, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7b, 0x0a,
+	0x12, 0x53, 0x65, 0x74, 0x47, 0x72, 0x61, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x53, 0x63, 0x68,
+	0x65, 0x6d, 0x65, 0x12, 0x31, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72,
+	0x67, 0x72, 0x2e, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74,
+	0x47, 0x72, 0x61, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x65, 0x74,
+	0x74, 0x65, 0x72, 0x67, 0x72, 0x2e, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x65, 0x74, 0x47, 0x72, 0x61, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x53, 0x63, 0x68, 0x65,
+	0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7e, 0x0a, 0x13, 0x47, 0x65,
+	0x74, 0x47, 0x72, 0x61, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x65,
+	0x73, 0x12, 0x32, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x67, 0x72,
+	0x2e, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x72,
+	0x61, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x65, 0x74, 0x74,
+	0x65, 0x72, 0x67, 0x72, 0x2e, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x47, 0x72, 0x61, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x53, 0x63, 0x68, 0x65, 0x6d,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x75, 0x0a, 0x10, 0x53, 0x65,
+	0x74, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x2f,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x67, 0x72, 0x2e, 0x67, 0x72,
+	0x61, 0x64, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x41, 0x74, 0x74, 0x65, 0x6d,
+	0x70, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x30, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x67, 0x72, 0x2e, 0x67,
+	0x72, 0x61, 0x64, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x41, 0x74, 0x74, 0x65,
+	0x6d, 0x70, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x75, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x47, 0x72, 0x61, 0x64, 0x65, 0x54, 0x69, 0x6d,
+	0x65, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x2f, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x65, 0x74, 0x74,
+	0x65, 0x72, 0x67, 0x72, 0x2e, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x47, 0x72, 0x61, 0x64, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x65, 0x74,
+	0x74, 0x65, 0x72, 0x67, 0x72, 0x2e, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x47, 0x65, 0x74, 0x47, 0x72, 0x61, 0x64, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x6c, 0x69, 0x6e, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x8d, 0x01, 0x0a, 0x18, 0x47, 0x65, 0x74,
+	0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x65, 0x64, 0x41, 0x76,
+	0x65, 0x72, 0x61, 0x67, 0x65, 0x12, 0x37, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x65, 0x74, 0x74,
+	0x65, 0x72, 0x67, 0x72, 0x2e, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x65, 0x64,
+	0x41, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x38,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x67, 0x72, 0x2e, 0x67, 0x72,
+	0x61, 0x64, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73,
+	0x65, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x65, 0x64, 0x41, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7b, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x48,
+	0x6f, 0x6e, 0x6f, 0x72, 0x52, 0x6f, 0x6c, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x31,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x67, 0x72, 0x2e, 0x67, 0x72,
+	0x61, 0x64, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x48, 0x6f, 0x6e, 0x6f, 0x72,
+	0x52, 0x6f, 0x6c, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x32, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x67, 0x72,
+	0x2e, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x48, 0x6f,
+	0x6e, 0x6f, 0x72, 0x52, 0x6f, 0x6c, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x8a, 0x01, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x75,
+	0x72, 0x73, 0x65, 0x47, 0x72, 0x61, 0x64, 0x65, 0x73, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75,
+	0x6d, 0x12, 0x36, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x67, 0x72,
+	0x2e, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f,
+	0x75, 0x72, 0x73, 0x65, 0x47, 0x72, 0x61, 0x64, 0x65, 0x73, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x73,
+	0x75, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x37, 0x2e, 0x63, 0x6f, 0x6d, 0x2e,
+	0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x67, 0x72, 0x2e, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x47, 0x72, 0x61, 0x64,
+	0x65, 0x73, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x84, 0x01, 0x0a, 0x15, 0x53, 0x65, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x12, 0x34, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x67, 0x72, 0x2e, 0x67, 0x72, 0x61, 0x64,
+	0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x35, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x67,
+	0x72, 0x2e, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x4e,
+	0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x69, 0x67, 0x65, 0x73,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7b, 0x0a, 0x12, 0x47, 0x65, 0x74,
+	0x50, 0x72, 0x6f, 0x62, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x31, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x67, 0x72, 0x2e, 0x67,
+	0x72, 0x61, 0x64, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x62,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x32, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x67,
+	0x72, 0x2e, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50,
+	0x72, 0x6f, 0x62, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x8d, 0x01, 0x0a, 0x18, 0x45, 0x78, 0x70, 0x6f, 0x72,
+	0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x47, 0x72, 0x61, 0x64, 0x65, 0x73, 0x53, 0x69, 0x67,
+	0x6e, 0x65, 0x64, 0x12, 0x37, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72,
+	0x67, 0x72, 0x2e, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x70,
+	0x6f, 0x72, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x47, 0x72, 0x61, 0x64, 0x65, 0x73, 0x53,
+	0x69, 0x67, 0x6e, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x38, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x67, 0x72, 0x2e, 0x67, 0x72, 0x61, 0x64,
+	0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x75, 0x72,
+	0x73, 0x65, 0x47, 0x72, 0x61, 0x64, 0x65, 0x73, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x72, 0x0a, 0x0f, 0x55, 0x6e, 0x64, 0x6f, 0x47, 0x72,
+	0x61, 0x64, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x2e, 0x2e, 0x63, 0x6f, 0x6d, 0x2e,
+	0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x67, 0x72, 0x2e, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x55, 0x6e, 0x64, 0x6f, 0x47, 0x72, 0x61, 0x64, 0x65, 0x43, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x63, 0x6f, 0x6d, 0x2e,
+	0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x67, 0x72, 0x2e, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x55, 0x6e, 0x64, 0x6f, 0x47, 0x72, 0x61, 0x64, 0x65, 0x43, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x72, 0x0a, 0x0f, 0x49, 0x6d,
+	0x70, 0x6f, 0x72, 0x74, 0x47, 0x72, 0x61, 0x64, 0x65, 0x73, 0x43, 0x53, 0x56, 0x12, 0x2e, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x67, 0x72, 0x2e, 0x67, 0x72, 0x61,
+	0x64, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x47, 0x72, 0x61,
+	0x64, 0x65, 0x73, 0x43, 0x53, 0x56, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x67, 0x72, 0x2e, 0x67, 0x72, 0x61,
+	0x64, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x47, 0x72, 0x61,
+	0x64, 0x65, 0x73, 0x43, 0x53, 0x56, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x63,
+	0x0a, 0x0a, 0x46, 0x69, 0x6c, 0x65, 0x41, 0x70, 0x70, 0x65, 0x61, 0x6c, 0x12, 0x29, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x67, 0x72, 0x2e, 0x67, 0x72, 0x61, 0x64,
+	0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x41, 0x70, 0x70, 0x65, 0x61, 0x6c,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x65,
+	0x74, 0x74, 0x65, 0x72, 0x67, 0x72, 0x2e, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x46, 0x69, 0x6c, 0x65, 0x41, 0x70, 0x70, 0x65, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x6c, 0x0a, 0x0d, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x41, 0x70,
+	0x70, 0x65, 0x61, 0x6c, 0x12, 0x2c, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65,
+	0x72, 0x67, 0x72, 0x2e, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65,
+	0x73, 0x6f, 0x6c, 0x76, 0x65, 0x41, 0x70, 0x70, 0x65, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x67,
+	0x72, 0x2e, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f,
+	0x6c, 0x76, 0x65, 0x41, 0x70, 0x70, 0x65, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x6c, 0x0a, 0x11, 0x57, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65,
+	0x47, 0x72, 0x61, 0x64, 0x65, 0x73, 0x12, 0x30, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x65, 0x74,
+	0x74, 0x65, 0x72, 0x67, 0x72, 0x2e, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x57, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x47, 0x72, 0x61, 0x64, 0x65,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x62,
+	0x65, 0x74, 0x74, 0x65, 0x72, 0x67, 0x72, 0x2e, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x30, 0x01, 0x12,
+	0x84, 0x01, 0x0a, 0x15, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x12, 0x34, 0x2e, 0x63, 0x6f, 0x6d, 0x2e,
+	0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x67, 0x72, 0x2e, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x35, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x67, 0x72, 0x2e, 0x67,
+	0x72, 0x61, 0x64, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52,
+	0x65, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7e, 0x0a, 0x13, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x50, 0x44, 0x46, 0x12, 0x32, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x67, 0x72, 0x2e, 0x67, 0x72, 0x61,
+	0x64, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x50, 0x44, 0x46, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x33, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x67, 0x72,
+	0x2e, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72,
+	0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x50, 0x44, 0x46, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6f, 0x0a, 0x0e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x41,
+	0x64, 0x64, 0x47, 0x72, 0x61, 0x64, 0x65, 0x73, 0x12, 0x2d, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x62,
+	0x65, 0x74, 0x74, 0x65, 0x72, 0x67, 0x72, 0x2e, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x41, 0x64, 0x64, 0x47, 0x72, 0x61, 0x64, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x65,
+	0x74, 0x74, 0x65, 0x72, 0x67, 0x72, 0x2e, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x41, 0x64, 0x64, 0x47, 0x72, 0x61, 0x64, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x8a, 0x01, 0x0a, 0x17, 0x4e, 0x6f, 0x72, 0x6d,
+	0x61, 0x6c, 0x69, 0x7a, 0x65, 0x41, 0x63, 0x72, 0x6f, 0x73, 0x73, 0x53, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x36, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72,
+	0x67, 0x72, 0x2e, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x72,
+	0x6d, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x41, 0x63, 0x72, 0x6f, 0x73, 0x73, 0x53, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x37, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x67, 0x72, 0x2e, 0x67, 0x72, 0x61, 0x64, 0x65,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x41, 0x63,
+	0x72, 0x6f, 0x73, 0x73, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x84, 0x01, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x53, 0x74, 0x75, 0x64,
+	0x65, 0x6e, 0x74, 0x53, 0x65, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x72, 0x47, 0x50, 0x41, 0x12, 0x34,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x67, 0x72, 0x2e, 0x67, 0x72,
+	0x61, 0x64, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x75, 0x64, 0x65,
+	0x6e, 0x74, 0x53, 0x65, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x72, 0x47, 0x50, 0x41, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x35, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65,
+	0x72, 0x67, 0x72, 0x2e, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x72,
+	0x47, 0x50, 0x41, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x8d, 0x01, 0x0a, 0x18,
+	0x47, 0x65, 0x74, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x72, 0x73,
+	0x65, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x12, 0x37, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x62,
+	0x65, 0x74, 0x74, 0x65, 0x72, 0x67, 0x72, 0x2e, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x75,
+	0x72, 0x73, 0x65, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x38, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x67, 0x72,
+	0x2e, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x57, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x61, 0x6e, 0x6b,
+	0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x8d, 0x01, 0x0a, 0x18,
+	0x47, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x47, 0x72, 0x61, 0x64, 0x65, 0x53, 0x74,
+	0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x12, 0x37, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x62,
+	0x65, 0x74, 0x74, 0x65, 0x72, 0x67, 0x72, 0x2e, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x47, 0x72, 0x61, 0x64, 0x65,
+	0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x38, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x67, 0x72,
+	0x2e, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f,
+	0x75, 0x72, 0x73, 0x65, 0x47, 0x72, 0x61, 0x64, 0x65, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74,
+	0x69, 0x63, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7b, 0x0a, 0x12, 0x47,
+	0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x54, 0x65, 0x73, 0x74, 0x47, 0x72, 0x61, 0x64, 0x65,
+	0x73, 0x12, 0x31, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x67, 0x72,
+	0x2e, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72,
+	0x61, 0x74, 0x65, 0x54, 0x65, 0x73, 0x74, 0x47, 0x72, 0x61, 0x64, 0x65, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65,
+	0x72, 0x67, 0x72, 0x2e, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x54, 0x65, 0x73, 0x74, 0x47, 0x72, 0x61, 0x64, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x37, 0x5a, 0x35, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x42, 0x65, 0x74, 0x74, 0x65, 0x72, 0x47, 0x52, 0x2f,
+	0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x2d, 0x6d, 0x69, 0x63, 0x72, 0x6f, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x3b, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
@@ -944,47 +12066,360 @@ func file_grades_microservice_proto_rawDescGZIP() []byte {
 	return file_grades_microservice_proto_rawDescData
 }
 
-var file_grades_microservice_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_grades_microservice_proto_msgTypes = make([]protoimpl.MessageInfo, 158)
 var file_grades_microservice_proto_goTypes = []any{
-	(*AddSingleGradeRequest)(nil),            // 0: grades.AddSingleGradeRequest
-	(*AddSingleGradeResponse)(nil),           // 1: grades.AddSingleGradeResponse
-	(*GetStudentCourseGradesRequest)(nil),    // 2: grades.GetStudentCourseGradesRequest
-	(*GetStudentCourseGradesResponse)(nil),   // 3: grades.GetStudentCourseGradesResponse
-	(*UpdateSingleGradeRequest)(nil),         // 4: grades.UpdateSingleGradeRequest
-	(*UpdateSingleGradeResponse)(nil),        // 5: grades.UpdateSingleGradeResponse
-	(*RemoveSingleGradeRequest)(nil),         // 6: grades.RemoveSingleGradeRequest
-	(*RemoveSingleGradeResponse)(nil),        // 7: grades.RemoveSingleGradeResponse
-	(*GetCourseGradesRequest)(nil),           // 8: grades.GetCourseGradesRequest
-	(*GetCourseGradesResponse)(nil),          // 9: grades.GetCourseGradesResponse
-	(*GetStudentSemesterGradesRequest)(nil),  // 10: grades.GetStudentSemesterGradesRequest
-	(*GetStudentSemesterGradesResponse)(nil), // 11: grades.GetStudentSemesterGradesResponse
-	(*SingleGrade)(nil),                      // 12: grades.SingleGrade
+	(*SingleGrade)(nil),                         // 0: com.bettergr.grades.v1.SingleGrade
+	(*AddSingleGradeRequest)(nil),               // 1: com.bettergr.grades.v1.AddSingleGradeRequest
+	(*AddSingleGradeResponse)(nil),              // 2: com.bettergr.grades.v1.AddSingleGradeResponse
+	(*GetStudentCourseGradesRequest)(nil),       // 3: com.bettergr.grades.v1.GetStudentCourseGradesRequest
+	(*GetStudentCourseGradesResponse)(nil),      // 4: com.bettergr.grades.v1.GetStudentCourseGradesResponse
+	(*UpdateSingleGradeRequest)(nil),            // 5: com.bettergr.grades.v1.UpdateSingleGradeRequest
+	(*UpdateSingleGradeResponse)(nil),           // 6: com.bettergr.grades.v1.UpdateSingleGradeResponse
+	(*RemoveSingleGradeRequest)(nil),            // 7: com.bettergr.grades.v1.RemoveSingleGradeRequest
+	(*RemoveSingleGradeResponse)(nil),           // 8: com.bettergr.grades.v1.RemoveSingleGradeResponse
+	(*GetCourseGradesRequest)(nil),              // 9: com.bettergr.grades.v1.GetCourseGradesRequest
+	(*GetCourseGradesResponse)(nil),             // 10: com.bettergr.grades.v1.GetCourseGradesResponse
+	(*GetStudentSemesterGradesRequest)(nil),     // 11: com.bettergr.grades.v1.GetStudentSemesterGradesRequest
+	(*GetStudentSemesterGradesResponse)(nil),    // 12: com.bettergr.grades.v1.GetStudentSemesterGradesResponse
+	(*CourseInfo)(nil),                          // 13: com.bettergr.grades.v1.CourseInfo
+	(*ArchiveSemesterRequest)(nil),              // 14: com.bettergr.grades.v1.ArchiveSemesterRequest
+	(*ArchiveSemesterResponse)(nil),             // 15: com.bettergr.grades.v1.ArchiveSemesterResponse
+	(*GetGradeRequest)(nil),                     // 16: com.bettergr.grades.v1.GetGradeRequest
+	(*GetGradeResponse)(nil),                    // 17: com.bettergr.grades.v1.GetGradeResponse
+	(*SearchGradeCommentsRequest)(nil),          // 18: com.bettergr.grades.v1.SearchGradeCommentsRequest
+	(*SearchGradeCommentsResponse)(nil),         // 19: com.bettergr.grades.v1.SearchGradeCommentsResponse
+	(*SetGradeFlagRequest)(nil),                 // 20: com.bettergr.grades.v1.SetGradeFlagRequest
+	(*SetGradeFlagResponse)(nil),                // 21: com.bettergr.grades.v1.SetGradeFlagResponse
+	(*GetFlaggedGradesRequest)(nil),             // 22: com.bettergr.grades.v1.GetFlaggedGradesRequest
+	(*GetFlaggedGradesResponse)(nil),            // 23: com.bettergr.grades.v1.GetFlaggedGradesResponse
+	(*GetMultiCourseGradesRequest)(nil),         // 24: com.bettergr.grades.v1.GetMultiCourseGradesRequest
+	(*CourseGrades)(nil),                        // 25: com.bettergr.grades.v1.CourseGrades
+	(*GetMultiCourseGradesResponse)(nil),        // 26: com.bettergr.grades.v1.GetMultiCourseGradesResponse
+	(*SearchStudentGradesRequest)(nil),          // 27: com.bettergr.grades.v1.SearchStudentGradesRequest
+	(*SearchStudentGradesResponse)(nil),         // 28: com.bettergr.grades.v1.SearchStudentGradesResponse
+	(*RetryFailedEventsRequest)(nil),            // 29: com.bettergr.grades.v1.RetryFailedEventsRequest
+	(*RetryFailedEventsResponse)(nil),           // 30: com.bettergr.grades.v1.RetryFailedEventsResponse
+	(*SemesterGrades)(nil),                      // 31: com.bettergr.grades.v1.SemesterGrades
+	(*GetGradeProvenanceRequest)(nil),           // 32: com.bettergr.grades.v1.GetGradeProvenanceRequest
+	(*ProvenanceEvent)(nil),                     // 33: com.bettergr.grades.v1.ProvenanceEvent
+	(*GetGradeProvenanceResponse)(nil),          // 34: com.bettergr.grades.v1.GetGradeProvenanceResponse
+	(*ProjectRequiredGradeRequest)(nil),         // 35: com.bettergr.grades.v1.ProjectRequiredGradeRequest
+	(*ProjectRequiredGradeResponse)(nil),        // 36: com.bettergr.grades.v1.ProjectRequiredGradeResponse
+	(*BatchAddGradesRequest)(nil),               // 37: com.bettergr.grades.v1.BatchAddGradesRequest
+	(*BatchGradeError)(nil),                     // 38: com.bettergr.grades.v1.BatchGradeError
+	(*BatchAddGradesProgress)(nil),              // 39: com.bettergr.grades.v1.BatchAddGradesProgress
+	(*DetectGradeConflictsRequest)(nil),         // 40: com.bettergr.grades.v1.DetectGradeConflictsRequest
+	(*GradeConflict)(nil),                       // 41: com.bettergr.grades.v1.GradeConflict
+	(*DetectGradeConflictsResponse)(nil),        // 42: com.bettergr.grades.v1.DetectGradeConflictsResponse
+	(*AssignDefaultToUngradedRequest)(nil),      // 43: com.bettergr.grades.v1.AssignDefaultToUngradedRequest
+	(*AssignDefaultToUngradedResponse)(nil),     // 44: com.bettergr.grades.v1.AssignDefaultToUngradedResponse
+	(*StreamCourseGradesRequest)(nil),           // 45: com.bettergr.grades.v1.StreamCourseGradesRequest
+	(*GetGradingVelocityRequest)(nil),           // 46: com.bettergr.grades.v1.GetGradingVelocityRequest
+	(*DailyGradingCount)(nil),                   // 47: com.bettergr.grades.v1.DailyGradingCount
+	(*GetGradingVelocityResponse)(nil),          // 48: com.bettergr.grades.v1.GetGradingVelocityResponse
+	(*PurgeTombstonesRequest)(nil),              // 49: com.bettergr.grades.v1.PurgeTombstonesRequest
+	(*PurgeTombstonesResponse)(nil),             // 50: com.bettergr.grades.v1.PurgeTombstonesResponse
+	(*RubricScore)(nil),                         // 51: com.bettergr.grades.v1.RubricScore
+	(*CompareCourseSemestersRequest)(nil),       // 52: com.bettergr.grades.v1.CompareCourseSemestersRequest
+	(*StudentSemesterDelta)(nil),                // 53: com.bettergr.grades.v1.StudentSemesterDelta
+	(*CompareCourseSemestersResponse)(nil),      // 54: com.bettergr.grades.v1.CompareCourseSemestersResponse
+	(*GetSemesterGradeTypeSummaryRequest)(nil),  // 55: com.bettergr.grades.v1.GetSemesterGradeTypeSummaryRequest
+	(*GradeTypeSummary)(nil),                    // 56: com.bettergr.grades.v1.GradeTypeSummary
+	(*GetSemesterGradeTypeSummaryResponse)(nil), // 57: com.bettergr.grades.v1.GetSemesterGradeTypeSummaryResponse
+	(*SetNotificationOptOutRequest)(nil),        // 58: com.bettergr.grades.v1.SetNotificationOptOutRequest
+	(*SetNotificationOptOutResponse)(nil),       // 59: com.bettergr.grades.v1.SetNotificationOptOutResponse
+	(*ExportCourseGradesXLSXRequest)(nil),       // 60: com.bettergr.grades.v1.ExportCourseGradesXLSXRequest
+	(*ExportCourseGradesXLSXResponse)(nil),      // 61: com.bettergr.grades.v1.ExportCourseGradesXLSXResponse
+	(*GetStudentYearGradesRequest)(nil),         // 62: com.bettergr.grades.v1.GetStudentYearGradesRequest
+	(*GetStudentYearGradesResponse)(nil),        // 63: com.bettergr.grades.v1.GetStudentYearGradesResponse
+	(*GetGradeValueRequest)(nil),                // 64: com.bettergr.grades.v1.GetGradeValueRequest
+	(*GetGradeValueResponse)(nil),               // 65: com.bettergr.grades.v1.GetGradeValueResponse
+	(*AddCourseGraderRequest)(nil),              // 66: com.bettergr.grades.v1.AddCourseGraderRequest
+	(*AddCourseGraderResponse)(nil),             // 67: com.bettergr.grades.v1.AddCourseGraderResponse
+	(*RemoveCourseGraderRequest)(nil),           // 68: com.bettergr.grades.v1.RemoveCourseGraderRequest
+	(*RemoveCourseGraderResponse)(nil),          // 69: com.bettergr.grades.v1.RemoveCourseGraderResponse
+	(*GetCourseGradersRequest)(nil),             // 70: com.bettergr.grades.v1.GetCourseGradersRequest
+	(*GetCourseGradersResponse)(nil),            // 71: com.bettergr.grades.v1.GetCourseGradersResponse
+	(*GetCourseStatsRequest)(nil),               // 72: com.bettergr.grades.v1.GetCourseStatsRequest
+	(*GetCourseStatsResponse)(nil),              // 73: com.bettergr.grades.v1.GetCourseStatsResponse
+	(*RefreshCourseStatsRequest)(nil),           // 74: com.bettergr.grades.v1.RefreshCourseStatsRequest
+	(*RefreshCourseStatsResponse)(nil),          // 75: com.bettergr.grades.v1.RefreshCourseStatsResponse
+	(*ExportSemesterToBucketRequest)(nil),       // 76: com.bettergr.grades.v1.ExportSemesterToBucketRequest
+	(*ExportSemesterToBucketResponse)(nil),      // 77: com.bettergr.grades.v1.ExportSemesterToBucketResponse
+	(*GradeOverride)(nil),                       // 78: com.bettergr.grades.v1.GradeOverride
+	(*SimulateGradeChangeRequest)(nil),          // 79: com.bettergr.grades.v1.SimulateGradeChangeRequest
+	(*SimulateGradeChangeResponse)(nil),         // 80: com.bettergr.grades.v1.SimulateGradeChangeResponse
+	(*SetCourseReleaseTimeRequest)(nil),         // 81: com.bettergr.grades.v1.SetCourseReleaseTimeRequest
+	(*SetCourseReleaseTimeResponse)(nil),        // 82: com.bettergr.grades.v1.SetCourseReleaseTimeResponse
+	(*GetSemesterLeaderboardRequest)(nil),       // 83: com.bettergr.grades.v1.GetSemesterLeaderboardRequest
+	(*LeaderboardEntry)(nil),                    // 84: com.bettergr.grades.v1.LeaderboardEntry
+	(*GetSemesterLeaderboardResponse)(nil),      // 85: com.bettergr.grades.v1.GetSemesterLeaderboardResponse
+	(*GetGradeFlexibleRequest)(nil),             // 86: com.bettergr.grades.v1.GetGradeFlexibleRequest
+	(*GetGradeFlexibleResponse)(nil),            // 87: com.bettergr.grades.v1.GetGradeFlexibleResponse
+	(*SoftDeleteStudentGradesRequest)(nil),      // 88: com.bettergr.grades.v1.SoftDeleteStudentGradesRequest
+	(*SoftDeleteStudentGradesResponse)(nil),     // 89: com.bettergr.grades.v1.SoftDeleteStudentGradesResponse
+	(*CurveOperation)(nil),                      // 90: com.bettergr.grades.v1.CurveOperation
+	(*PreviewCurveRequest)(nil),                 // 91: com.bettergr.grades.v1.PreviewCurveRequest
+	(*GradeValueCount)(nil),                     // 92: com.bettergr.grades.v1.GradeValueCount
+	(*CurvedGrade)(nil),                         // 93: com.bettergr.grades.v1.CurvedGrade
+	(*PreviewCurveResponse)(nil),                // 94: com.bettergr.grades.v1.PreviewCurveResponse
+	(*GetGradePercentilesRequest)(nil),          // 95: com.bettergr.grades.v1.GetGradePercentilesRequest
+	(*GetGradePercentilesResponse)(nil),         // 96: com.bettergr.grades.v1.GetGradePercentilesResponse
+	(*SetGradeTypeSchemeRequest)(nil),           // 97: com.bettergr.grades.v1.SetGradeTypeSchemeRequest
+	(*SetGradeTypeSchemeResponse)(nil),          // 98: com.bettergr.grades.v1.SetGradeTypeSchemeResponse
+	(*GetGradeTypeSchemesRequest)(nil),          // 99: com.bettergr.grades.v1.GetGradeTypeSchemesRequest
+	(*GradeTypeScheme)(nil),                     // 100: com.bettergr.grades.v1.GradeTypeScheme
+	(*GetGradeTypeSchemesResponse)(nil),         // 101: com.bettergr.grades.v1.GetGradeTypeSchemesResponse
+	(*SetAttemptPolicyRequest)(nil),             // 102: com.bettergr.grades.v1.SetAttemptPolicyRequest
+	(*SetAttemptPolicyResponse)(nil),            // 103: com.bettergr.grades.v1.SetAttemptPolicyResponse
+	(*GetGradeTimelineRequest)(nil),             // 104: com.bettergr.grades.v1.GetGradeTimelineRequest
+	(*GradeTransition)(nil),                     // 105: com.bettergr.grades.v1.GradeTransition
+	(*GetGradeTimelineResponse)(nil),            // 106: com.bettergr.grades.v1.GetGradeTimelineResponse
+	(*GetCourseWeightedAverageRequest)(nil),     // 107: com.bettergr.grades.v1.GetCourseWeightedAverageRequest
+	(*ComponentAverage)(nil),                    // 108: com.bettergr.grades.v1.ComponentAverage
+	(*GetCourseWeightedAverageResponse)(nil),    // 109: com.bettergr.grades.v1.GetCourseWeightedAverageResponse
+	(*GetHonorRollStatusRequest)(nil),           // 110: com.bettergr.grades.v1.GetHonorRollStatusRequest
+	(*GetHonorRollStatusResponse)(nil),          // 111: com.bettergr.grades.v1.GetHonorRollStatusResponse
+	(*GetCourseGradesChecksumRequest)(nil),      // 112: com.bettergr.grades.v1.GetCourseGradesChecksumRequest
+	(*GetCourseGradesChecksumResponse)(nil),     // 113: com.bettergr.grades.v1.GetCourseGradesChecksumResponse
+	(*SetNotificationDigestRequest)(nil),        // 114: com.bettergr.grades.v1.SetNotificationDigestRequest
+	(*SetNotificationDigestResponse)(nil),       // 115: com.bettergr.grades.v1.SetNotificationDigestResponse
+	(*GetProbationStatusRequest)(nil),           // 116: com.bettergr.grades.v1.GetProbationStatusRequest
+	(*GetProbationStatusResponse)(nil),          // 117: com.bettergr.grades.v1.GetProbationStatusResponse
+	(*ExportCourseGradesSignedRequest)(nil),     // 118: com.bettergr.grades.v1.ExportCourseGradesSignedRequest
+	(*ExportCourseGradesSignedResponse)(nil),    // 119: com.bettergr.grades.v1.ExportCourseGradesSignedResponse
+	(*UndoGradeChangeRequest)(nil),              // 120: com.bettergr.grades.v1.UndoGradeChangeRequest
+	(*UndoGradeChangeResponse)(nil),             // 121: com.bettergr.grades.v1.UndoGradeChangeResponse
+	(*ImportColumnMapping)(nil),                 // 122: com.bettergr.grades.v1.ImportColumnMapping
+	(*ImportGradesCSVRequest)(nil),              // 123: com.bettergr.grades.v1.ImportGradesCSVRequest
+	(*ImportRowError)(nil),                      // 124: com.bettergr.grades.v1.ImportRowError
+	(*ImportGradesCSVResponse)(nil),             // 125: com.bettergr.grades.v1.ImportGradesCSVResponse
+	(*FileAppealRequest)(nil),                   // 126: com.bettergr.grades.v1.FileAppealRequest
+	(*FileAppealResponse)(nil),                  // 127: com.bettergr.grades.v1.FileAppealResponse
+	(*ResolveAppealRequest)(nil),                // 128: com.bettergr.grades.v1.ResolveAppealRequest
+	(*ResolveAppealResponse)(nil),               // 129: com.bettergr.grades.v1.ResolveAppealResponse
+	(*WatchCourseGradesRequest)(nil),            // 130: com.bettergr.grades.v1.WatchCourseGradesRequest
+	(*GradeChange)(nil),                         // 131: com.bettergr.grades.v1.GradeChange
+	(*ExportResearchDatasetRequest)(nil),        // 132: com.bettergr.grades.v1.ExportResearchDatasetRequest
+	(*ResearchRecord)(nil),                      // 133: com.bettergr.grades.v1.ResearchRecord
+	(*ExportResearchDatasetResponse)(nil),       // 134: com.bettergr.grades.v1.ExportResearchDatasetResponse
+	(*ExportTranscriptPDFRequest)(nil),          // 135: com.bettergr.grades.v1.ExportTranscriptPDFRequest
+	(*ExportTranscriptPDFResponse)(nil),         // 136: com.bettergr.grades.v1.ExportTranscriptPDFResponse
+	(*BatchAddGradesResponse)(nil),              // 137: com.bettergr.grades.v1.BatchAddGradesResponse
+	(*NormalizeAcrossSectionsRequest)(nil),      // 138: com.bettergr.grades.v1.NormalizeAcrossSectionsRequest
+	(*SectionStats)(nil),                        // 139: com.bettergr.grades.v1.SectionStats
+	(*NormalizedGrade)(nil),                     // 140: com.bettergr.grades.v1.NormalizedGrade
+	(*NormalizeAcrossSectionsResponse)(nil),     // 141: com.bettergr.grades.v1.NormalizeAcrossSectionsResponse
+	(*GetStudentSemesterGPARequest)(nil),        // 142: com.bettergr.grades.v1.GetStudentSemesterGPARequest
+	(*GetStudentSemesterGPAResponse)(nil),       // 143: com.bettergr.grades.v1.GetStudentSemesterGPAResponse
+	(*GetWeightedCourseRankingRequest)(nil),     // 144: com.bettergr.grades.v1.GetWeightedCourseRankingRequest
+	(*WeightedRankingEntry)(nil),                // 145: com.bettergr.grades.v1.WeightedRankingEntry
+	(*GetWeightedCourseRankingResponse)(nil),    // 146: com.bettergr.grades.v1.GetWeightedCourseRankingResponse
+	(*GetCourseGradeStatisticsRequest)(nil),     // 147: com.bettergr.grades.v1.GetCourseGradeStatisticsRequest
+	(*GetCourseGradeStatisticsResponse)(nil),    // 148: com.bettergr.grades.v1.GetCourseGradeStatisticsResponse
+	(*GenerateTestGradesRequest)(nil),           // 149: com.bettergr.grades.v1.GenerateTestGradesRequest
+	(*GenerateTestGradesResponse)(nil),          // 150: com.bettergr.grades.v1.GenerateTestGradesResponse
+	nil,                                         // 151: com.bettergr.grades.v1.GetStudentSemesterGradesResponse.CoursesEntry
+	nil,                                         // 152: com.bettergr.grades.v1.ProjectRequiredGradeRequest.WeightsEntry
+	nil,                                         // 153: com.bettergr.grades.v1.GetGradePercentilesResponse.PercentilesEntry
+	nil,                                         // 154: com.bettergr.grades.v1.GetCourseWeightedAverageRequest.WeightsEntry
+	nil,                                         // 155: com.bettergr.grades.v1.GetCourseGradesChecksumResponse.StudentChecksumsEntry
+	nil,                                         // 156: com.bettergr.grades.v1.ImportColumnMapping.ColumnsEntry
+	nil,                                         // 157: com.bettergr.grades.v1.ImportColumnMapping.DefaultsEntry
+	(*timestamppb.Timestamp)(nil),               // 158: google.protobuf.Timestamp
 }
 var file_grades_microservice_proto_depIdxs = []int32{
-	12, // 0: grades.AddSingleGradeRequest.grade:type_name -> grades.SingleGrade
-	12, // 1: grades.AddSingleGradeResponse.grade:type_name -> grades.SingleGrade
-	12, // 2: grades.GetStudentCourseGradesResponse.grades:type_name -> grades.SingleGrade
-	12, // 3: grades.UpdateSingleGradeRequest.grade:type_name -> grades.SingleGrade
-	12, // 4: grades.UpdateSingleGradeResponse.grade:type_name -> grades.SingleGrade
-	12, // 5: grades.GetCourseGradesResponse.grades:type_name -> grades.SingleGrade
-	12, // 6: grades.GetStudentSemesterGradesResponse.grades:type_name -> grades.SingleGrade
-	8,  // 7: grades.GradesService.GetCourseGrades:input_type -> grades.GetCourseGradesRequest
-	2,  // 8: grades.GradesService.GetStudentCourseGrades:input_type -> grades.GetStudentCourseGradesRequest
-	0,  // 9: grades.GradesService.AddSingleGrade:input_type -> grades.AddSingleGradeRequest
-	4,  // 10: grades.GradesService.UpdateSingleGrade:input_type -> grades.UpdateSingleGradeRequest
-	6,  // 11: grades.GradesService.RemoveSingleGrade:input_type -> grades.RemoveSingleGradeRequest
-	10, // 12: grades.GradesService.GetStudentSemesterGrades:input_type -> grades.GetStudentSemesterGradesRequest
-	9,  // 13: grades.GradesService.GetCourseGrades:output_type -> grades.GetCourseGradesResponse
-	3,  // 14: grades.GradesService.GetStudentCourseGrades:output_type -> grades.GetStudentCourseGradesResponse
-	1,  // 15: grades.GradesService.AddSingleGrade:output_type -> grades.AddSingleGradeResponse
-	5,  // 16: grades.GradesService.UpdateSingleGrade:output_type -> grades.UpdateSingleGradeResponse
-	7,  // 17: grades.GradesService.RemoveSingleGrade:output_type -> grades.RemoveSingleGradeResponse
-	11, // 18: grades.GradesService.GetStudentSemesterGrades:output_type -> grades.GetStudentSemesterGradesResponse
-	13, // [13:19] is the sub-list for method output_type
-	7,  // [7:13] is the sub-list for method input_type
-	7,  // [7:7] is the sub-list for extension type_name
-	7,  // [7:7] is the sub-list for extension extendee
-	0,  // [0:7] is the sub-list for field type_name
+	51,  // 0: com.bettergr.grades.v1.SingleGrade.rubric_scores:type_name -> com.bettergr.grades.v1.RubricScore
+	158, // 1: com.bettergr.grades.v1.SingleGrade.graded_at:type_name -> google.protobuf.Timestamp
+	158, // 2: com.bettergr.grades.v1.SingleGrade.updated_at:type_name -> google.protobuf.Timestamp
+	0,   // 3: com.bettergr.grades.v1.AddSingleGradeRequest.grade:type_name -> com.bettergr.grades.v1.SingleGrade
+	0,   // 4: com.bettergr.grades.v1.AddSingleGradeResponse.grade:type_name -> com.bettergr.grades.v1.SingleGrade
+	0,   // 5: com.bettergr.grades.v1.GetStudentCourseGradesResponse.grades:type_name -> com.bettergr.grades.v1.SingleGrade
+	0,   // 6: com.bettergr.grades.v1.UpdateSingleGradeRequest.grade:type_name -> com.bettergr.grades.v1.SingleGrade
+	0,   // 7: com.bettergr.grades.v1.UpdateSingleGradeResponse.grade:type_name -> com.bettergr.grades.v1.SingleGrade
+	0,   // 8: com.bettergr.grades.v1.GetCourseGradesResponse.grades:type_name -> com.bettergr.grades.v1.SingleGrade
+	31,  // 9: com.bettergr.grades.v1.GetCourseGradesResponse.semester_grades:type_name -> com.bettergr.grades.v1.SemesterGrades
+	0,   // 10: com.bettergr.grades.v1.GetStudentSemesterGradesResponse.grades:type_name -> com.bettergr.grades.v1.SingleGrade
+	151, // 11: com.bettergr.grades.v1.GetStudentSemesterGradesResponse.courses:type_name -> com.bettergr.grades.v1.GetStudentSemesterGradesResponse.CoursesEntry
+	0,   // 12: com.bettergr.grades.v1.GetGradeResponse.grade:type_name -> com.bettergr.grades.v1.SingleGrade
+	0,   // 13: com.bettergr.grades.v1.SearchGradeCommentsResponse.grades:type_name -> com.bettergr.grades.v1.SingleGrade
+	0,   // 14: com.bettergr.grades.v1.SetGradeFlagResponse.grade:type_name -> com.bettergr.grades.v1.SingleGrade
+	0,   // 15: com.bettergr.grades.v1.GetFlaggedGradesResponse.grades:type_name -> com.bettergr.grades.v1.SingleGrade
+	0,   // 16: com.bettergr.grades.v1.CourseGrades.grades:type_name -> com.bettergr.grades.v1.SingleGrade
+	25,  // 17: com.bettergr.grades.v1.GetMultiCourseGradesResponse.courses:type_name -> com.bettergr.grades.v1.CourseGrades
+	0,   // 18: com.bettergr.grades.v1.SearchStudentGradesResponse.grades:type_name -> com.bettergr.grades.v1.SingleGrade
+	0,   // 19: com.bettergr.grades.v1.SemesterGrades.grades:type_name -> com.bettergr.grades.v1.SingleGrade
+	33,  // 20: com.bettergr.grades.v1.GetGradeProvenanceResponse.events:type_name -> com.bettergr.grades.v1.ProvenanceEvent
+	152, // 21: com.bettergr.grades.v1.ProjectRequiredGradeRequest.weights:type_name -> com.bettergr.grades.v1.ProjectRequiredGradeRequest.WeightsEntry
+	0,   // 22: com.bettergr.grades.v1.BatchAddGradesRequest.grades:type_name -> com.bettergr.grades.v1.SingleGrade
+	38,  // 23: com.bettergr.grades.v1.BatchAddGradesProgress.errors:type_name -> com.bettergr.grades.v1.BatchGradeError
+	0,   // 24: com.bettergr.grades.v1.GradeConflict.grade:type_name -> com.bettergr.grades.v1.SingleGrade
+	41,  // 25: com.bettergr.grades.v1.DetectGradeConflictsResponse.conflicts:type_name -> com.bettergr.grades.v1.GradeConflict
+	47,  // 26: com.bettergr.grades.v1.GetGradingVelocityResponse.daily_counts:type_name -> com.bettergr.grades.v1.DailyGradingCount
+	53,  // 27: com.bettergr.grades.v1.CompareCourseSemestersResponse.students:type_name -> com.bettergr.grades.v1.StudentSemesterDelta
+	56,  // 28: com.bettergr.grades.v1.GetSemesterGradeTypeSummaryResponse.summaries:type_name -> com.bettergr.grades.v1.GradeTypeSummary
+	31,  // 29: com.bettergr.grades.v1.GetStudentYearGradesResponse.semesters:type_name -> com.bettergr.grades.v1.SemesterGrades
+	78,  // 30: com.bettergr.grades.v1.SimulateGradeChangeRequest.overrides:type_name -> com.bettergr.grades.v1.GradeOverride
+	84,  // 31: com.bettergr.grades.v1.GetSemesterLeaderboardResponse.entries:type_name -> com.bettergr.grades.v1.LeaderboardEntry
+	90,  // 32: com.bettergr.grades.v1.PreviewCurveRequest.curve:type_name -> com.bettergr.grades.v1.CurveOperation
+	92,  // 33: com.bettergr.grades.v1.PreviewCurveResponse.before:type_name -> com.bettergr.grades.v1.GradeValueCount
+	92,  // 34: com.bettergr.grades.v1.PreviewCurveResponse.after:type_name -> com.bettergr.grades.v1.GradeValueCount
+	93,  // 35: com.bettergr.grades.v1.PreviewCurveResponse.grades:type_name -> com.bettergr.grades.v1.CurvedGrade
+	153, // 36: com.bettergr.grades.v1.GetGradePercentilesResponse.percentiles:type_name -> com.bettergr.grades.v1.GetGradePercentilesResponse.PercentilesEntry
+	100, // 37: com.bettergr.grades.v1.GetGradeTypeSchemesResponse.schemes:type_name -> com.bettergr.grades.v1.GradeTypeScheme
+	105, // 38: com.bettergr.grades.v1.GetGradeTimelineResponse.transitions:type_name -> com.bettergr.grades.v1.GradeTransition
+	154, // 39: com.bettergr.grades.v1.GetCourseWeightedAverageRequest.weights:type_name -> com.bettergr.grades.v1.GetCourseWeightedAverageRequest.WeightsEntry
+	108, // 40: com.bettergr.grades.v1.GetCourseWeightedAverageResponse.components:type_name -> com.bettergr.grades.v1.ComponentAverage
+	155, // 41: com.bettergr.grades.v1.GetCourseGradesChecksumResponse.student_checksums:type_name -> com.bettergr.grades.v1.GetCourseGradesChecksumResponse.StudentChecksumsEntry
+	0,   // 42: com.bettergr.grades.v1.UndoGradeChangeResponse.grade:type_name -> com.bettergr.grades.v1.SingleGrade
+	156, // 43: com.bettergr.grades.v1.ImportColumnMapping.columns:type_name -> com.bettergr.grades.v1.ImportColumnMapping.ColumnsEntry
+	157, // 44: com.bettergr.grades.v1.ImportColumnMapping.defaults:type_name -> com.bettergr.grades.v1.ImportColumnMapping.DefaultsEntry
+	122, // 45: com.bettergr.grades.v1.ImportGradesCSVRequest.mapping:type_name -> com.bettergr.grades.v1.ImportColumnMapping
+	124, // 46: com.bettergr.grades.v1.ImportGradesCSVResponse.errors:type_name -> com.bettergr.grades.v1.ImportRowError
+	0,   // 47: com.bettergr.grades.v1.ImportGradesCSVResponse.grades:type_name -> com.bettergr.grades.v1.SingleGrade
+	0,   // 48: com.bettergr.grades.v1.FileAppealResponse.grade:type_name -> com.bettergr.grades.v1.SingleGrade
+	0,   // 49: com.bettergr.grades.v1.ResolveAppealResponse.grade:type_name -> com.bettergr.grades.v1.SingleGrade
+	0,   // 50: com.bettergr.grades.v1.GradeChange.grade:type_name -> com.bettergr.grades.v1.SingleGrade
+	133, // 51: com.bettergr.grades.v1.ExportResearchDatasetResponse.records:type_name -> com.bettergr.grades.v1.ResearchRecord
+	0,   // 52: com.bettergr.grades.v1.BatchAddGradesResponse.grades:type_name -> com.bettergr.grades.v1.SingleGrade
+	92,  // 53: com.bettergr.grades.v1.SectionStats.after:type_name -> com.bettergr.grades.v1.GradeValueCount
+	139, // 54: com.bettergr.grades.v1.NormalizeAcrossSectionsResponse.sections:type_name -> com.bettergr.grades.v1.SectionStats
+	140, // 55: com.bettergr.grades.v1.NormalizeAcrossSectionsResponse.grades:type_name -> com.bettergr.grades.v1.NormalizedGrade
+	145, // 56: com.bettergr.grades.v1.GetWeightedCourseRankingResponse.entries:type_name -> com.bettergr.grades.v1.WeightedRankingEntry
+	13,  // 57: com.bettergr.grades.v1.GetStudentSemesterGradesResponse.CoursesEntry.value:type_name -> com.bettergr.grades.v1.CourseInfo
+	9,   // 58: com.bettergr.grades.v1.GradesService.GetCourseGrades:input_type -> com.bettergr.grades.v1.GetCourseGradesRequest
+	3,   // 59: com.bettergr.grades.v1.GradesService.GetStudentCourseGrades:input_type -> com.bettergr.grades.v1.GetStudentCourseGradesRequest
+	1,   // 60: com.bettergr.grades.v1.GradesService.AddSingleGrade:input_type -> com.bettergr.grades.v1.AddSingleGradeRequest
+	5,   // 61: com.bettergr.grades.v1.GradesService.UpdateSingleGrade:input_type -> com.bettergr.grades.v1.UpdateSingleGradeRequest
+	7,   // 62: com.bettergr.grades.v1.GradesService.RemoveSingleGrade:input_type -> com.bettergr.grades.v1.RemoveSingleGradeRequest
+	11,  // 63: com.bettergr.grades.v1.GradesService.GetStudentSemesterGrades:input_type -> com.bettergr.grades.v1.GetStudentSemesterGradesRequest
+	14,  // 64: com.bettergr.grades.v1.GradesService.ArchiveSemester:input_type -> com.bettergr.grades.v1.ArchiveSemesterRequest
+	16,  // 65: com.bettergr.grades.v1.GradesService.GetGrade:input_type -> com.bettergr.grades.v1.GetGradeRequest
+	18,  // 66: com.bettergr.grades.v1.GradesService.SearchGradeComments:input_type -> com.bettergr.grades.v1.SearchGradeCommentsRequest
+	20,  // 67: com.bettergr.grades.v1.GradesService.SetGradeFlag:input_type -> com.bettergr.grades.v1.SetGradeFlagRequest
+	22,  // 68: com.bettergr.grades.v1.GradesService.GetFlaggedGrades:input_type -> com.bettergr.grades.v1.GetFlaggedGradesRequest
+	24,  // 69: com.bettergr.grades.v1.GradesService.GetMultiCourseGrades:input_type -> com.bettergr.grades.v1.GetMultiCourseGradesRequest
+	27,  // 70: com.bettergr.grades.v1.GradesService.SearchStudentGrades:input_type -> com.bettergr.grades.v1.SearchStudentGradesRequest
+	29,  // 71: com.bettergr.grades.v1.GradesService.RetryFailedEvents:input_type -> com.bettergr.grades.v1.RetryFailedEventsRequest
+	32,  // 72: com.bettergr.grades.v1.GradesService.GetGradeProvenance:input_type -> com.bettergr.grades.v1.GetGradeProvenanceRequest
+	35,  // 73: com.bettergr.grades.v1.GradesService.ProjectRequiredGrade:input_type -> com.bettergr.grades.v1.ProjectRequiredGradeRequest
+	37,  // 74: com.bettergr.grades.v1.GradesService.BatchAddGradesStream:input_type -> com.bettergr.grades.v1.BatchAddGradesRequest
+	40,  // 75: com.bettergr.grades.v1.GradesService.DetectGradeConflicts:input_type -> com.bettergr.grades.v1.DetectGradeConflictsRequest
+	43,  // 76: com.bettergr.grades.v1.GradesService.AssignDefaultToUngraded:input_type -> com.bettergr.grades.v1.AssignDefaultToUngradedRequest
+	45,  // 77: com.bettergr.grades.v1.GradesService.StreamCourseGrades:input_type -> com.bettergr.grades.v1.StreamCourseGradesRequest
+	46,  // 78: com.bettergr.grades.v1.GradesService.GetGradingVelocity:input_type -> com.bettergr.grades.v1.GetGradingVelocityRequest
+	49,  // 79: com.bettergr.grades.v1.GradesService.PurgeTombstones:input_type -> com.bettergr.grades.v1.PurgeTombstonesRequest
+	52,  // 80: com.bettergr.grades.v1.GradesService.CompareCourseSemesters:input_type -> com.bettergr.grades.v1.CompareCourseSemestersRequest
+	55,  // 81: com.bettergr.grades.v1.GradesService.GetSemesterGradeTypeSummary:input_type -> com.bettergr.grades.v1.GetSemesterGradeTypeSummaryRequest
+	58,  // 82: com.bettergr.grades.v1.GradesService.SetNotificationOptOut:input_type -> com.bettergr.grades.v1.SetNotificationOptOutRequest
+	60,  // 83: com.bettergr.grades.v1.GradesService.ExportCourseGradesXLSX:input_type -> com.bettergr.grades.v1.ExportCourseGradesXLSXRequest
+	62,  // 84: com.bettergr.grades.v1.GradesService.GetStudentYearGrades:input_type -> com.bettergr.grades.v1.GetStudentYearGradesRequest
+	64,  // 85: com.bettergr.grades.v1.GradesService.GetGradeValue:input_type -> com.bettergr.grades.v1.GetGradeValueRequest
+	66,  // 86: com.bettergr.grades.v1.GradesService.AddCourseGrader:input_type -> com.bettergr.grades.v1.AddCourseGraderRequest
+	68,  // 87: com.bettergr.grades.v1.GradesService.RemoveCourseGrader:input_type -> com.bettergr.grades.v1.RemoveCourseGraderRequest
+	70,  // 88: com.bettergr.grades.v1.GradesService.GetCourseGraders:input_type -> com.bettergr.grades.v1.GetCourseGradersRequest
+	72,  // 89: com.bettergr.grades.v1.GradesService.GetCourseStats:input_type -> com.bettergr.grades.v1.GetCourseStatsRequest
+	74,  // 90: com.bettergr.grades.v1.GradesService.RefreshCourseStats:input_type -> com.bettergr.grades.v1.RefreshCourseStatsRequest
+	76,  // 91: com.bettergr.grades.v1.GradesService.ExportSemesterToBucket:input_type -> com.bettergr.grades.v1.ExportSemesterToBucketRequest
+	79,  // 92: com.bettergr.grades.v1.GradesService.SimulateGradeChange:input_type -> com.bettergr.grades.v1.SimulateGradeChangeRequest
+	81,  // 93: com.bettergr.grades.v1.GradesService.SetCourseReleaseTime:input_type -> com.bettergr.grades.v1.SetCourseReleaseTimeRequest
+	83,  // 94: com.bettergr.grades.v1.GradesService.GetSemesterLeaderboard:input_type -> com.bettergr.grades.v1.GetSemesterLeaderboardRequest
+	86,  // 95: com.bettergr.grades.v1.GradesService.GetGradeFlexible:input_type -> com.bettergr.grades.v1.GetGradeFlexibleRequest
+	88,  // 96: com.bettergr.grades.v1.GradesService.SoftDeleteStudentGrades:input_type -> com.bettergr.grades.v1.SoftDeleteStudentGradesRequest
+	91,  // 97: com.bettergr.grades.v1.GradesService.PreviewCurve:input_type -> com.bettergr.grades.v1.PreviewCurveRequest
+	95,  // 98: com.bettergr.grades.v1.GradesService.GetGradePercentiles:input_type -> com.bettergr.grades.v1.GetGradePercentilesRequest
+	97,  // 99: com.bettergr.grades.v1.GradesService.SetGradeTypeScheme:input_type -> com.bettergr.grades.v1.SetGradeTypeSchemeRequest
+	99,  // 100: com.bettergr.grades.v1.GradesService.GetGradeTypeSchemes:input_type -> com.bettergr.grades.v1.GetGradeTypeSchemesRequest
+	102, // 101: com.bettergr.grades.v1.GradesService.SetAttemptPolicy:input_type -> com.bettergr.grades.v1.SetAttemptPolicyRequest
+	104, // 102: com.bettergr.grades.v1.GradesService.GetGradeTimeline:input_type -> com.bettergr.grades.v1.GetGradeTimelineRequest
+	107, // 103: com.bettergr.grades.v1.GradesService.GetCourseWeightedAverage:input_type -> com.bettergr.grades.v1.GetCourseWeightedAverageRequest
+	110, // 104: com.bettergr.grades.v1.GradesService.GetHonorRollStatus:input_type -> com.bettergr.grades.v1.GetHonorRollStatusRequest
+	112, // 105: com.bettergr.grades.v1.GradesService.GetCourseGradesChecksum:input_type -> com.bettergr.grades.v1.GetCourseGradesChecksumRequest
+	114, // 106: com.bettergr.grades.v1.GradesService.SetNotificationDigest:input_type -> com.bettergr.grades.v1.SetNotificationDigestRequest
+	116, // 107: com.bettergr.grades.v1.GradesService.GetProbationStatus:input_type -> com.bettergr.grades.v1.GetProbationStatusRequest
+	118, // 108: com.bettergr.grades.v1.GradesService.ExportCourseGradesSigned:input_type -> com.bettergr.grades.v1.ExportCourseGradesSignedRequest
+	120, // 109: com.bettergr.grades.v1.GradesService.UndoGradeChange:input_type -> com.bettergr.grades.v1.UndoGradeChangeRequest
+	123, // 110: com.bettergr.grades.v1.GradesService.ImportGradesCSV:input_type -> com.bettergr.grades.v1.ImportGradesCSVRequest
+	126, // 111: com.bettergr.grades.v1.GradesService.FileAppeal:input_type -> com.bettergr.grades.v1.FileAppealRequest
+	128, // 112: com.bettergr.grades.v1.GradesService.ResolveAppeal:input_type -> com.bettergr.grades.v1.ResolveAppealRequest
+	130, // 113: com.bettergr.grades.v1.GradesService.WatchCourseGrades:input_type -> com.bettergr.grades.v1.WatchCourseGradesRequest
+	132, // 114: com.bettergr.grades.v1.GradesService.ExportResearchDataset:input_type -> com.bettergr.grades.v1.ExportResearchDatasetRequest
+	135, // 115: com.bettergr.grades.v1.GradesService.ExportTranscriptPDF:input_type -> com.bettergr.grades.v1.ExportTranscriptPDFRequest
+	37,  // 116: com.bettergr.grades.v1.GradesService.BatchAddGrades:input_type -> com.bettergr.grades.v1.BatchAddGradesRequest
+	138, // 117: com.bettergr.grades.v1.GradesService.NormalizeAcrossSections:input_type -> com.bettergr.grades.v1.NormalizeAcrossSectionsRequest
+	142, // 118: com.bettergr.grades.v1.GradesService.GetStudentSemesterGPA:input_type -> com.bettergr.grades.v1.GetStudentSemesterGPARequest
+	144, // 119: com.bettergr.grades.v1.GradesService.GetWeightedCourseRanking:input_type -> com.bettergr.grades.v1.GetWeightedCourseRankingRequest
+	147, // 120: com.bettergr.grades.v1.GradesService.GetCourseGradeStatistics:input_type -> com.bettergr.grades.v1.GetCourseGradeStatisticsRequest
+	149, // 121: com.bettergr.grades.v1.GradesService.GenerateTestGrades:input_type -> com.bettergr.grades.v1.GenerateTestGradesRequest
+	10,  // 122: com.bettergr.grades.v1.GradesService.GetCourseGrades:output_type -> com.bettergr.grades.v1.GetCourseGradesResponse
+	4,   // 123: com.bettergr.grades.v1.GradesService.GetStudentCourseGrades:output_type -> com.bettergr.grades.v1.GetStudentCourseGradesResponse
+	2,   // 124: com.bettergr.grades.v1.GradesService.AddSingleGrade:output_type -> com.bettergr.grades.v1.AddSingleGradeResponse
+	6,   // 125: com.bettergr.grades.v1.GradesService.UpdateSingleGrade:output_type -> com.bettergr.grades.v1.UpdateSingleGradeResponse
+	8,   // 126: com.bettergr.grades.v1.GradesService.RemoveSingleGrade:output_type -> com.bettergr.grades.v1.RemoveSingleGradeResponse
+	12,  // 127: com.bettergr.grades.v1.GradesService.GetStudentSemesterGrades:output_type -> com.bettergr.grades.v1.GetStudentSemesterGradesResponse
+	15,  // 128: com.bettergr.grades.v1.GradesService.ArchiveSemester:output_type -> com.bettergr.grades.v1.ArchiveSemesterResponse
+	17,  // 129: com.bettergr.grades.v1.GradesService.GetGrade:output_type -> com.bettergr.grades.v1.GetGradeResponse
+	19,  // 130: com.bettergr.grades.v1.GradesService.SearchGradeComments:output_type -> com.bettergr.grades.v1.SearchGradeCommentsResponse
+	21,  // 131: com.bettergr.grades.v1.GradesService.SetGradeFlag:output_type -> com.bettergr.grades.v1.SetGradeFlagResponse
+	23,  // 132: com.bettergr.grades.v1.GradesService.GetFlaggedGrades:output_type -> com.bettergr.grades.v1.GetFlaggedGradesResponse
+	26,  // 133: com.bettergr.grades.v1.GradesService.GetMultiCourseGrades:output_type -> com.bettergr.grades.v1.GetMultiCourseGradesResponse
+	28,  // 134: com.bettergr.grades.v1.GradesService.SearchStudentGrades:output_type -> com.bettergr.grades.v1.SearchStudentGradesResponse
+	30,  // 135: com.bettergr.grades.v1.GradesService.RetryFailedEvents:output_type -> com.bettergr.grades.v1.RetryFailedEventsResponse
+	34,  // 136: com.bettergr.grades.v1.GradesService.GetGradeProvenance:output_type -> com.bettergr.grades.v1.GetGradeProvenanceResponse
+	36,  // 137: com.bettergr.grades.v1.GradesService.ProjectRequiredGrade:output_type -> com.bettergr.grades.v1.ProjectRequiredGradeResponse
+	39,  // 138: com.bettergr.grades.v1.GradesService.BatchAddGradesStream:output_type -> com.bettergr.grades.v1.BatchAddGradesProgress
+	42,  // 139: com.bettergr.grades.v1.GradesService.DetectGradeConflicts:output_type -> com.bettergr.grades.v1.DetectGradeConflictsResponse
+	44,  // 140: com.bettergr.grades.v1.GradesService.AssignDefaultToUngraded:output_type -> com.bettergr.grades.v1.AssignDefaultToUngradedResponse
+	0,   // 141: com.bettergr.grades.v1.GradesService.StreamCourseGrades:output_type -> com.bettergr.grades.v1.SingleGrade
+	48,  // 142: com.bettergr.grades.v1.GradesService.GetGradingVelocity:output_type -> com.bettergr.grades.v1.GetGradingVelocityResponse
+	50,  // 143: com.bettergr.grades.v1.GradesService.PurgeTombstones:output_type -> com.bettergr.grades.v1.PurgeTombstonesResponse
+	54,  // 144: com.bettergr.grades.v1.GradesService.CompareCourseSemesters:output_type -> com.bettergr.grades.v1.CompareCourseSemestersResponse
+	57,  // 145: com.bettergr.grades.v1.GradesService.GetSemesterGradeTypeSummary:output_type -> com.bettergr.grades.v1.GetSemesterGradeTypeSummaryResponse
+	59,  // 146: com.bettergr.grades.v1.GradesService.SetNotificationOptOut:output_type -> com.bettergr.grades.v1.SetNotificationOptOutResponse
+	61,  // 147: com.bettergr.grades.v1.GradesService.ExportCourseGradesXLSX:output_type -> com.bettergr.grades.v1.ExportCourseGradesXLSXResponse
+	63,  // 148: com.bettergr.grades.v1.GradesService.GetStudentYearGrades:output_type -> com.bettergr.grades.v1.GetStudentYearGradesResponse
+	65,  // 149: com.bettergr.grades.v1.GradesService.GetGradeValue:output_type -> com.bettergr.grades.v1.GetGradeValueResponse
+	67,  // 150: com.bettergr.grades.v1.GradesService.AddCourseGrader:output_type -> com.bettergr.grades.v1.AddCourseGraderResponse
+	69,  // 151: com.bettergr.grades.v1.GradesService.RemoveCourseGrader:output_type -> com.bettergr.grades.v1.RemoveCourseGraderResponse
+	71,  // 152: com.bettergr.grades.v1.GradesService.GetCourseGraders:output_type -> com.bettergr.grades.v1.GetCourseGradersResponse
+	73,  // 153: com.bettergr.grades.v1.GradesService.GetCourseStats:output_type -> com.bettergr.grades.v1.GetCourseStatsResponse
+	75,  // 154: com.bettergr.grades.v1.GradesService.RefreshCourseStats:output_type -> com.bettergr.grades.v1.RefreshCourseStatsResponse
+	77,  // 155: com.bettergr.grades.v1.GradesService.ExportSemesterToBucket:output_type -> com.bettergr.grades.v1.ExportSemesterToBucketResponse
+	80,  // 156: com.bettergr.grades.v1.GradesService.SimulateGradeChange:output_type -> com.bettergr.grades.v1.SimulateGradeChangeResponse
+	82,  // 157: com.bettergr.grades.v1.GradesService.SetCourseReleaseTime:output_type -> com.bettergr.grades.v1.SetCourseReleaseTimeResponse
+	85,  // 158: com.bettergr.grades.v1.GradesService.GetSemesterLeaderboard:output_type -> com.bettergr.grades.v1.GetSemesterLeaderboardResponse
+	87,  // 159: com.bettergr.grades.v1.GradesService.GetGradeFlexible:output_type -> com.bettergr.grades.v1.GetGradeFlexibleResponse
+	89,  // 160: com.bettergr.grades.v1.GradesService.SoftDeleteStudentGrades:output_type -> com.bettergr.grades.v1.SoftDeleteStudentGradesResponse
+	94,  // 161: com.bettergr.grades.v1.GradesService.PreviewCurve:output_type -> com.bettergr.grades.v1.PreviewCurveResponse
+	96,  // 162: com.bettergr.grades.v1.GradesService.GetGradePercentiles:output_type -> com.bettergr.grades.v1.GetGradePercentilesResponse
+	98,  // 163: com.bettergr.grades.v1.GradesService.SetGradeTypeScheme:output_type -> com.bettergr.grades.v1.SetGradeTypeSchemeResponse
+	101, // 164: com.bettergr.grades.v1.GradesService.GetGradeTypeSchemes:output_type -> com.bettergr.grades.v1.GetGradeTypeSchemesResponse
+	103, // 165: com.bettergr.grades.v1.GradesService.SetAttemptPolicy:output_type -> com.bettergr.grades.v1.SetAttemptPolicyResponse
+	106, // 166: com.bettergr.grades.v1.GradesService.GetGradeTimeline:output_type -> com.bettergr.grades.v1.GetGradeTimelineResponse
+	109, // 167: com.bettergr.grades.v1.GradesService.GetCourseWeightedAverage:output_type -> com.bettergr.grades.v1.GetCourseWeightedAverageResponse
+	111, // 168: com.bettergr.grades.v1.GradesService.GetHonorRollStatus:output_type -> com.bettergr.grades.v1.GetHonorRollStatusResponse
+	113, // 169: com.bettergr.grades.v1.GradesService.GetCourseGradesChecksum:output_type -> com.bettergr.grades.v1.GetCourseGradesChecksumResponse
+	115, // 170: com.bettergr.grades.v1.GradesService.SetNotificationDigest:output_type -> com.bettergr.grades.v1.SetNotificationDigestResponse
+	117, // 171: com.bettergr.grades.v1.GradesService.GetProbationStatus:output_type -> com.bettergr.grades.v1.GetProbationStatusResponse
+	119, // 172: com.bettergr.grades.v1.GradesService.ExportCourseGradesSigned:output_type -> com.bettergr.grades.v1.ExportCourseGradesSignedResponse
+	121, // 173: com.bettergr.grades.v1.GradesService.UndoGradeChange:output_type -> com.bettergr.grades.v1.UndoGradeChangeResponse
+	125, // 174: com.bettergr.grades.v1.GradesService.ImportGradesCSV:output_type -> com.bettergr.grades.v1.ImportGradesCSVResponse
+	127, // 175: com.bettergr.grades.v1.GradesService.FileAppeal:output_type -> com.bettergr.grades.v1.FileAppealResponse
+	129, // 176: com.bettergr.grades.v1.GradesService.ResolveAppeal:output_type -> com.bettergr.grades.v1.ResolveAppealResponse
+	131, // 177: com.bettergr.grades.v1.GradesService.WatchCourseGrades:output_type -> com.bettergr.grades.v1.GradeChange
+	134, // 178: com.bettergr.grades.v1.GradesService.ExportResearchDataset:output_type -> com.bettergr.grades.v1.ExportResearchDatasetResponse
+	136, // 179: com.bettergr.grades.v1.GradesService.ExportTranscriptPDF:output_type -> com.bettergr.grades.v1.ExportTranscriptPDFResponse
+	137, // 180: com.bettergr.grades.v1.GradesService.BatchAddGrades:output_type -> com.bettergr.grades.v1.BatchAddGradesResponse
+	141, // 181: com.bettergr.grades.v1.GradesService.NormalizeAcrossSections:output_type -> com.bettergr.grades.v1.NormalizeAcrossSectionsResponse
+	143, // 182: com.bettergr.grades.v1.GradesService.GetStudentSemesterGPA:output_type -> com.bettergr.grades.v1.GetStudentSemesterGPAResponse
+	146, // 183: com.bettergr.grades.v1.GradesService.GetWeightedCourseRanking:output_type -> com.bettergr.grades.v1.GetWeightedCourseRankingResponse
+	148, // 184: com.bettergr.grades.v1.GradesService.GetCourseGradeStatistics:output_type -> com.bettergr.grades.v1.GetCourseGradeStatisticsResponse
+	150, // 185: com.bettergr.grades.v1.GradesService.GenerateTestGrades:output_type -> com.bettergr.grades.v1.GenerateTestGradesResponse
+	122, // [122:186] is the sub-list for method output_type
+	58,  // [58:122] is the sub-list for method input_type
+	58,  // [58:58] is the sub-list for extension type_name
+	58,  // [58:58] is the sub-list for extension extendee
+	0,   // [0:58] is the sub-list for field type_name
 }
 
 func init() { file_grades_microservice_proto_init() }
@@ -998,7 +12433,7 @@ func file_grades_microservice_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_grades_microservice_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   13,
+			NumMessages:   158,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -18,6 +18,8 @@
 // - protoc             v5.28.3
 // source: grades-microservice.proto
 
+// Use a versioned, domain-specific package name.
+
 package protos
 
 import (
@@ -33,32 +35,244 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	GradesService_GetCourseGrades_FullMethodName          = "/grades.GradesService/GetCourseGrades"
-	GradesService_GetStudentCourseGrades_FullMethodName   = "/grades.GradesService/GetStudentCourseGrades"
-	GradesService_AddSingleGrade_FullMethodName           = "/grades.GradesService/AddSingleGrade"
-	GradesService_UpdateSingleGrade_FullMethodName        = "/grades.GradesService/UpdateSingleGrade"
-	GradesService_RemoveSingleGrade_FullMethodName        = "/grades.GradesService/RemoveSingleGrade"
-	GradesService_GetStudentSemesterGrades_FullMethodName = "/grades.GradesService/GetStudentSemesterGrades"
+	GradesService_GetCourseGrades_FullMethodName             = "/com.bettergr.grades.v1.GradesService/GetCourseGrades"
+	GradesService_GetStudentCourseGrades_FullMethodName      = "/com.bettergr.grades.v1.GradesService/GetStudentCourseGrades"
+	GradesService_AddSingleGrade_FullMethodName              = "/com.bettergr.grades.v1.GradesService/AddSingleGrade"
+	GradesService_UpdateSingleGrade_FullMethodName           = "/com.bettergr.grades.v1.GradesService/UpdateSingleGrade"
+	GradesService_RemoveSingleGrade_FullMethodName           = "/com.bettergr.grades.v1.GradesService/RemoveSingleGrade"
+	GradesService_GetStudentSemesterGrades_FullMethodName    = "/com.bettergr.grades.v1.GradesService/GetStudentSemesterGrades"
+	GradesService_ArchiveSemester_FullMethodName             = "/com.bettergr.grades.v1.GradesService/ArchiveSemester"
+	GradesService_GetGrade_FullMethodName                    = "/com.bettergr.grades.v1.GradesService/GetGrade"
+	GradesService_SearchGradeComments_FullMethodName         = "/com.bettergr.grades.v1.GradesService/SearchGradeComments"
+	GradesService_SetGradeFlag_FullMethodName                = "/com.bettergr.grades.v1.GradesService/SetGradeFlag"
+	GradesService_GetFlaggedGrades_FullMethodName            = "/com.bettergr.grades.v1.GradesService/GetFlaggedGrades"
+	GradesService_GetMultiCourseGrades_FullMethodName        = "/com.bettergr.grades.v1.GradesService/GetMultiCourseGrades"
+	GradesService_SearchStudentGrades_FullMethodName         = "/com.bettergr.grades.v1.GradesService/SearchStudentGrades"
+	GradesService_RetryFailedEvents_FullMethodName           = "/com.bettergr.grades.v1.GradesService/RetryFailedEvents"
+	GradesService_GetGradeProvenance_FullMethodName          = "/com.bettergr.grades.v1.GradesService/GetGradeProvenance"
+	GradesService_ProjectRequiredGrade_FullMethodName        = "/com.bettergr.grades.v1.GradesService/ProjectRequiredGrade"
+	GradesService_BatchAddGradesStream_FullMethodName        = "/com.bettergr.grades.v1.GradesService/BatchAddGradesStream"
+	GradesService_DetectGradeConflicts_FullMethodName        = "/com.bettergr.grades.v1.GradesService/DetectGradeConflicts"
+	GradesService_AssignDefaultToUngraded_FullMethodName     = "/com.bettergr.grades.v1.GradesService/AssignDefaultToUngraded"
+	GradesService_StreamCourseGrades_FullMethodName          = "/com.bettergr.grades.v1.GradesService/StreamCourseGrades"
+	GradesService_GetGradingVelocity_FullMethodName          = "/com.bettergr.grades.v1.GradesService/GetGradingVelocity"
+	GradesService_PurgeTombstones_FullMethodName             = "/com.bettergr.grades.v1.GradesService/PurgeTombstones"
+	GradesService_CompareCourseSemesters_FullMethodName      = "/com.bettergr.grades.v1.GradesService/CompareCourseSemesters"
+	GradesService_GetSemesterGradeTypeSummary_FullMethodName = "/com.bettergr.grades.v1.GradesService/GetSemesterGradeTypeSummary"
+	GradesService_SetNotificationOptOut_FullMethodName       = "/com.bettergr.grades.v1.GradesService/SetNotificationOptOut"
+	GradesService_ExportCourseGradesXLSX_FullMethodName      = "/com.bettergr.grades.v1.GradesService/ExportCourseGradesXLSX"
+	GradesService_GetStudentYearGrades_FullMethodName        = "/com.bettergr.grades.v1.GradesService/GetStudentYearGrades"
+	GradesService_GetGradeValue_FullMethodName               = "/com.bettergr.grades.v1.GradesService/GetGradeValue"
+	GradesService_AddCourseGrader_FullMethodName             = "/com.bettergr.grades.v1.GradesService/AddCourseGrader"
+	GradesService_RemoveCourseGrader_FullMethodName          = "/com.bettergr.grades.v1.GradesService/RemoveCourseGrader"
+	GradesService_GetCourseGraders_FullMethodName            = "/com.bettergr.grades.v1.GradesService/GetCourseGraders"
+	GradesService_GetCourseStats_FullMethodName              = "/com.bettergr.grades.v1.GradesService/GetCourseStats"
+	GradesService_RefreshCourseStats_FullMethodName          = "/com.bettergr.grades.v1.GradesService/RefreshCourseStats"
+	GradesService_ExportSemesterToBucket_FullMethodName      = "/com.bettergr.grades.v1.GradesService/ExportSemesterToBucket"
+	GradesService_SimulateGradeChange_FullMethodName         = "/com.bettergr.grades.v1.GradesService/SimulateGradeChange"
+	GradesService_SetCourseReleaseTime_FullMethodName        = "/com.bettergr.grades.v1.GradesService/SetCourseReleaseTime"
+	GradesService_GetSemesterLeaderboard_FullMethodName      = "/com.bettergr.grades.v1.GradesService/GetSemesterLeaderboard"
+	GradesService_GetGradeFlexible_FullMethodName            = "/com.bettergr.grades.v1.GradesService/GetGradeFlexible"
+	GradesService_SoftDeleteStudentGrades_FullMethodName     = "/com.bettergr.grades.v1.GradesService/SoftDeleteStudentGrades"
+	GradesService_PreviewCurve_FullMethodName                = "/com.bettergr.grades.v1.GradesService/PreviewCurve"
+	GradesService_GetGradePercentiles_FullMethodName         = "/com.bettergr.grades.v1.GradesService/GetGradePercentiles"
+	GradesService_SetGradeTypeScheme_FullMethodName          = "/com.bettergr.grades.v1.GradesService/SetGradeTypeScheme"
+	GradesService_GetGradeTypeSchemes_FullMethodName         = "/com.bettergr.grades.v1.GradesService/GetGradeTypeSchemes"
+	GradesService_SetAttemptPolicy_FullMethodName            = "/com.bettergr.grades.v1.GradesService/SetAttemptPolicy"
+	GradesService_GetGradeTimeline_FullMethodName            = "/com.bettergr.grades.v1.GradesService/GetGradeTimeline"
+	GradesService_GetCourseWeightedAverage_FullMethodName    = "/com.bettergr.grades.v1.GradesService/GetCourseWeightedAverage"
+	GradesService_GetHonorRollStatus_FullMethodName          = "/com.bettergr.grades.v1.GradesService/GetHonorRollStatus"
+	GradesService_GetCourseGradesChecksum_FullMethodName     = "/com.bettergr.grades.v1.GradesService/GetCourseGradesChecksum"
+	GradesService_SetNotificationDigest_FullMethodName       = "/com.bettergr.grades.v1.GradesService/SetNotificationDigest"
+	GradesService_GetProbationStatus_FullMethodName          = "/com.bettergr.grades.v1.GradesService/GetProbationStatus"
+	GradesService_ExportCourseGradesSigned_FullMethodName    = "/com.bettergr.grades.v1.GradesService/ExportCourseGradesSigned"
+	GradesService_UndoGradeChange_FullMethodName             = "/com.bettergr.grades.v1.GradesService/UndoGradeChange"
+	GradesService_ImportGradesCSV_FullMethodName             = "/com.bettergr.grades.v1.GradesService/ImportGradesCSV"
+	GradesService_FileAppeal_FullMethodName                  = "/com.bettergr.grades.v1.GradesService/FileAppeal"
+	GradesService_ResolveAppeal_FullMethodName               = "/com.bettergr.grades.v1.GradesService/ResolveAppeal"
+	GradesService_WatchCourseGrades_FullMethodName           = "/com.bettergr.grades.v1.GradesService/WatchCourseGrades"
+	GradesService_ExportResearchDataset_FullMethodName       = "/com.bettergr.grades.v1.GradesService/ExportResearchDataset"
+	GradesService_ExportTranscriptPDF_FullMethodName         = "/com.bettergr.grades.v1.GradesService/ExportTranscriptPDF"
+	GradesService_BatchAddGrades_FullMethodName              = "/com.bettergr.grades.v1.GradesService/BatchAddGrades"
+	GradesService_NormalizeAcrossSections_FullMethodName     = "/com.bettergr.grades.v1.GradesService/NormalizeAcrossSections"
+	GradesService_GetStudentSemesterGPA_FullMethodName       = "/com.bettergr.grades.v1.GradesService/GetStudentSemesterGPA"
+	GradesService_GetWeightedCourseRanking_FullMethodName    = "/com.bettergr.grades.v1.GradesService/GetWeightedCourseRanking"
+	GradesService_GetCourseGradeStatistics_FullMethodName    = "/com.bettergr.grades.v1.GradesService/GetCourseGradeStatistics"
+	GradesService_GenerateTestGrades_FullMethodName          = "/com.bettergr.grades.v1.GradesService/GenerateTestGrades"
 )
 
 // GradesServiceClient is the client API for GradesService service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 //
-// GradesService is a microservice responsible for managing grades.
+// GradesService manages student grade information.
 type GradesServiceClient interface {
-	// GetCourseGrades returns all students grades enrolled in a specific course for a specific semester.
+	// GetCourseGrades retrieves a paginated list of grades for all students enrolled
+	// in a specific course during a specific semester.
 	GetCourseGrades(ctx context.Context, in *GetCourseGradesRequest, opts ...grpc.CallOption) (*GetCourseGradesResponse, error)
-	// GetStudentCourseGrades returns a specific student all grades in a specific course for a specific semester.
+	// GetStudentCourseGrades retrieves a paginated list of grades for a specific student
+	// within a specific course and semester.
 	GetStudentCourseGrades(ctx context.Context, in *GetStudentCourseGradesRequest, opts ...grpc.CallOption) (*GetStudentCourseGradesResponse, error)
-	// AddSingleGrade adds a single grade for a student in a course for a specific semester.
+	// AddSingleGrade adds a new grade entry for a student in a course.
 	AddSingleGrade(ctx context.Context, in *AddSingleGradeRequest, opts ...grpc.CallOption) (*AddSingleGradeResponse, error)
-	// UpdateSingleGrade updates a single grade for a student in a course for a specific semester.
+	// UpdateSingleGrade modifies an existing grade entry.
 	UpdateSingleGrade(ctx context.Context, in *UpdateSingleGradeRequest, opts ...grpc.CallOption) (*UpdateSingleGradeResponse, error)
-	// RemoveSingleGrade removes a single grade for a student in a course for a specific semester.
+	// RemoveSingleGrade deletes a specific grade entry. It is kept as a tombstone until purged.
 	RemoveSingleGrade(ctx context.Context, in *RemoveSingleGradeRequest, opts ...grpc.CallOption) (*RemoveSingleGradeResponse, error)
-	// GetStudentSemesterGrades returns all grades for a specific student for a specific semester.
+	// GetStudentSemesterGrades retrieves a paginated list of all grades for a specific student
+	// across all courses during a specific semester.
 	GetStudentSemesterGrades(ctx context.Context, in *GetStudentSemesterGradesRequest, opts ...grpc.CallOption) (*GetStudentSemesterGradesResponse, error)
+	// ArchiveSemester moves all grades of a semester from the live table to cold storage.
+	// Requires the admin role.
+	ArchiveSemester(ctx context.Context, in *ArchiveSemesterRequest, opts ...grpc.CallOption) (*ArchiveSemesterResponse, error)
+	// GetGrade retrieves a single grade, either its latest state or a specific historical version.
+	GetGrade(ctx context.Context, in *GetGradeRequest, opts ...grpc.CallOption) (*GetGradeResponse, error)
+	// SearchGradeComments retrieves the grades of a course whose comments contain a search term.
+	SearchGradeComments(ctx context.Context, in *SearchGradeCommentsRequest, opts ...grpc.CallOption) (*SearchGradeCommentsResponse, error)
+	// SetGradeFlag marks or unmarks a grade for review.
+	SetGradeFlag(ctx context.Context, in *SetGradeFlagRequest, opts ...grpc.CallOption) (*SetGradeFlagResponse, error)
+	// GetFlaggedGrades retrieves all grades marked for review in a course during a semester.
+	GetFlaggedGrades(ctx context.Context, in *GetFlaggedGradesRequest, opts ...grpc.CallOption) (*GetFlaggedGradesResponse, error)
+	// GetMultiCourseGrades retrieves the grades of several courses during a semester, grouped by course.
+	GetMultiCourseGrades(ctx context.Context, in *GetMultiCourseGradesRequest, opts ...grpc.CallOption) (*GetMultiCourseGradesResponse, error)
+	// SearchStudentGrades retrieves the grades of students whose ID starts with a prefix.
+	SearchStudentGrades(ctx context.Context, in *SearchStudentGradesRequest, opts ...grpc.CallOption) (*SearchStudentGradesResponse, error)
+	// RetryFailedEvents re-publishes grade events whose delivery previously failed.
+	RetryFailedEvents(ctx context.Context, in *RetryFailedEventsRequest, opts ...grpc.CallOption) (*RetryFailedEventsResponse, error)
+	// GetGradeProvenance retrieves the chronological history of a grade: its creation, every
+	// change and every recorded read. Requires the staff or auditor role.
+	GetGradeProvenance(ctx context.Context, in *GetGradeProvenanceRequest, opts ...grpc.CallOption) (*GetGradeProvenanceResponse, error)
+	// ProjectRequiredGrade computes the score a student needs on the ungraded components of a course
+	// to reach a target final grade.
+	ProjectRequiredGrade(ctx context.Context, in *ProjectRequiredGradeRequest, opts ...grpc.CallOption) (*ProjectRequiredGradeResponse, error)
+	// BatchAddGradesStream adds a list of grades, streaming progress updates and a final summary.
+	BatchAddGradesStream(ctx context.Context, in *BatchAddGradesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[BatchAddGradesProgress], error)
+	// DetectGradeConflicts retrieves the grades of a course during a semester that were changed by a
+	// different grader than the original one. Requires the staff role.
+	DetectGradeConflicts(ctx context.Context, in *DetectGradeConflictsRequest, opts ...grpc.CallOption) (*DetectGradeConflictsResponse, error)
+	// AssignDefaultToUngraded gives a default grade for an item to every rostered student without
+	// one. Requires the staff role.
+	AssignDefaultToUngraded(ctx context.Context, in *AssignDefaultToUngradedRequest, opts ...grpc.CallOption) (*AssignDefaultToUngradedResponse, error)
+	// StreamCourseGrades streams the grades of a course during a semester, one grade per message,
+	// reading them only as fast as the client consumes them.
+	StreamCourseGrades(ctx context.Context, in *StreamCourseGradesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[SingleGrade], error)
+	// GetGradingVelocity reports how fast an item is being graded and projects its completion date.
+	// Requires the staff role.
+	GetGradingVelocity(ctx context.Context, in *GetGradingVelocityRequest, opts ...grpc.CallOption) (*GetGradingVelocityResponse, error)
+	// PurgeTombstones permanently deletes grades removed longer ago than the retention period.
+	// Requires the admin role.
+	PurgeTombstones(ctx context.Context, in *PurgeTombstonesRequest, opts ...grpc.CallOption) (*PurgeTombstonesResponse, error)
+	// CompareCourseSemesters compares a course between two semesters, per student and for the
+	// class average. Requires the staff role.
+	CompareCourseSemesters(ctx context.Context, in *CompareCourseSemestersRequest, opts ...grpc.CallOption) (*CompareCourseSemestersResponse, error)
+	// GetSemesterGradeTypeSummary counts and averages the grades of each grade type across a semester.
+	// Requires the admin role.
+	GetSemesterGradeTypeSummary(ctx context.Context, in *GetSemesterGradeTypeSummaryRequest, opts ...grpc.CallOption) (*GetSemesterGradeTypeSummaryResponse, error)
+	// SetNotificationOptOut opts a student out of grade change notifications, or back in.
+	// Students may only change their own preference.
+	SetNotificationOptOut(ctx context.Context, in *SetNotificationOptOutRequest, opts ...grpc.CallOption) (*SetNotificationOptOutResponse, error)
+	// ExportCourseGradesXLSX exports the grades of a course in a semester as an Excel workbook,
+	// with a grades sheet and a summary sheet.
+	ExportCourseGradesXLSX(ctx context.Context, in *ExportCourseGradesXLSXRequest, opts ...grpc.CallOption) (*ExportCourseGradesXLSXResponse, error)
+	// GetStudentYearGrades retrieves the grades of a student across the semesters of an academic year,
+	// grouped by semester.
+	GetStudentYearGrades(ctx context.Context, in *GetStudentYearGradesRequest, opts ...grpc.CallOption) (*GetStudentYearGradesResponse, error)
+	// GetGradeValue retrieves only the value of a grade, by grade ID or by student, course, semester and item.
+	GetGradeValue(ctx context.Context, in *GetGradeValueRequest, opts ...grpc.CallOption) (*GetGradeValueResponse, error)
+	// AddCourseGrader allows a grader to grade a course. Once a course lists graders, only they
+	// and administrators may add or update its grades.
+	AddCourseGrader(ctx context.Context, in *AddCourseGraderRequest, opts ...grpc.CallOption) (*AddCourseGraderResponse, error)
+	// RemoveCourseGrader removes a grader from the graders of a course.
+	RemoveCourseGrader(ctx context.Context, in *RemoveCourseGraderRequest, opts ...grpc.CallOption) (*RemoveCourseGraderResponse, error)
+	// GetCourseGraders lists the graders allowed to grade a course, empty when anyone may.
+	GetCourseGraders(ctx context.Context, in *GetCourseGradersRequest, opts ...grpc.CallOption) (*GetCourseGradersResponse, error)
+	// GetCourseStats summarizes the grades of a course in a semester from periodically refreshed
+	// statistics, computing them live when those are stale.
+	GetCourseStats(ctx context.Context, in *GetCourseStatsRequest, opts ...grpc.CallOption) (*GetCourseStatsResponse, error)
+	// RefreshCourseStats recomputes the statistics of every course. Requires the admin role.
+	RefreshCourseStats(ctx context.Context, in *RefreshCourseStatsRequest, opts ...grpc.CallOption) (*RefreshCourseStatsResponse, error)
+	// ExportSemesterToBucket streams every grade of a semester to the export bucket as CSV or JSONL.
+	// Requires the admin role.
+	ExportSemesterToBucket(ctx context.Context, in *ExportSemesterToBucketRequest, opts ...grpc.CallOption) (*ExportSemesterToBucketResponse, error)
+	// SimulateGradeChange recomputes a student's GPA as if hypothetical grade changes applied,
+	// without storing them.
+	SimulateGradeChange(ctx context.Context, in *SimulateGradeChangeRequest, opts ...grpc.CallOption) (*SimulateGradeChangeResponse, error)
+	// SetCourseReleaseTime sets when the grades of a course offering become visible to students.
+	// Requires the staff or admin role.
+	SetCourseReleaseTime(ctx context.Context, in *SetCourseReleaseTimeRequest, opts ...grpc.CallOption) (*SetCourseReleaseTimeResponse, error)
+	// GetSemesterLeaderboard ranks the students of a semester by GPA. Requires the admin role.
+	GetSemesterLeaderboard(ctx context.Context, in *GetSemesterLeaderboardRequest, opts ...grpc.CallOption) (*GetSemesterLeaderboardResponse, error)
+	// GetGradeFlexible returns a grade serialized as protobuf, JSON or compact JSON.
+	GetGradeFlexible(ctx context.Context, in *GetGradeFlexibleRequest, opts ...grpc.CallOption) (*GetGradeFlexibleResponse, error)
+	// SoftDeleteStudentGrades removes every grade of a student, keeping them recoverable as
+	// tombstones. Requires the admin role.
+	SoftDeleteStudentGrades(ctx context.Context, in *SoftDeleteStudentGradesRequest, opts ...grpc.CallOption) (*SoftDeleteStudentGradesResponse, error)
+	// PreviewCurve computes the effect of a curve on a course item without changing any grade.
+	PreviewCurve(ctx context.Context, in *PreviewCurveRequest, opts ...grpc.CallOption) (*PreviewCurveResponse, error)
+	// GetGradePercentiles returns the percentile rank of every numeric grade value of a course.
+	GetGradePercentiles(ctx context.Context, in *GetGradePercentilesRequest, opts ...grpc.CallOption) (*GetGradePercentilesResponse, error)
+	// SetGradeTypeScheme sets the grading scheme of a grade type in a course.
+	SetGradeTypeScheme(ctx context.Context, in *SetGradeTypeSchemeRequest, opts ...grpc.CallOption) (*SetGradeTypeSchemeResponse, error)
+	// GetGradeTypeSchemes returns the grading schemes of a course's grade types.
+	GetGradeTypeSchemes(ctx context.Context, in *GetGradeTypeSchemesRequest, opts ...grpc.CallOption) (*GetGradeTypeSchemesResponse, error)
+	// SetAttemptPolicy sets how a course aggregates several attempts at the same item.
+	SetAttemptPolicy(ctx context.Context, in *SetAttemptPolicyRequest, opts ...grpc.CallOption) (*SetAttemptPolicyResponse, error)
+	// GetGradeTimeline returns the value transitions of a grade, oldest first.
+	GetGradeTimeline(ctx context.Context, in *GetGradeTimelineRequest, opts ...grpc.CallOption) (*GetGradeTimelineResponse, error)
+	// GetCourseWeightedAverage returns the class average of a course weighting each component.
+	GetCourseWeightedAverage(ctx context.Context, in *GetCourseWeightedAverageRequest, opts ...grpc.CallOption) (*GetCourseWeightedAverageResponse, error)
+	// GetHonorRollStatus reports whether a student makes the honor roll of a semester by GPA.
+	// Students may only check their own status.
+	GetHonorRollStatus(ctx context.Context, in *GetHonorRollStatusRequest, opts ...grpc.CallOption) (*GetHonorRollStatusResponse, error)
+	// GetCourseGradesChecksum returns a checksum of a course's grades for reconciling a replica
+	// without transferring them. Requires the staff, auditor or admin role.
+	GetCourseGradesChecksum(ctx context.Context, in *GetCourseGradesChecksumRequest, opts ...grpc.CallOption) (*GetCourseGradesChecksumResponse, error)
+	// SetNotificationDigest makes a student get a periodic digest of grade changes instead of a
+	// notification for each, or back. Students may only change their own preference.
+	SetNotificationDigest(ctx context.Context, in *SetNotificationDigestRequest, opts ...grpc.CallOption) (*SetNotificationDigestResponse, error)
+	// GetProbationStatus reports whether a student is on academic probation by GPA. Students may
+	// only check their own status.
+	GetProbationStatus(ctx context.Context, in *GetProbationStatusRequest, opts ...grpc.CallOption) (*GetProbationStatusResponse, error)
+	// ExportCourseGradesSigned exports the grades of a course with a detached signature by the
+	// institution's key, for official transcripts. Requires the staff or admin role.
+	ExportCourseGradesSigned(ctx context.Context, in *ExportCourseGradesSignedRequest, opts ...grpc.CallOption) (*ExportCourseGradesSignedResponse, error)
+	// UndoGradeChange reverts a grade to its value before the latest change. Graders may only undo
+	// their own recent changes.
+	UndoGradeChange(ctx context.Context, in *UndoGradeChangeRequest, opts ...grpc.CallOption) (*UndoGradeChangeResponse, error)
+	// ImportGradesCSV adds the grades of a CSV file, mapping its columns and values to grades as
+	// the request configures. Requires the staff or admin role.
+	ImportGradesCSV(ctx context.Context, in *ImportGradesCSVRequest, opts ...grpc.CallOption) (*ImportGradesCSVResponse, error)
+	// FileAppeal puts a grade under appeal, locking it from edits until the appeal is resolved.
+	// Students may only appeal their own grades.
+	FileAppeal(ctx context.Context, in *FileAppealRequest, opts ...grpc.CallOption) (*FileAppealResponse, error)
+	// ResolveAppeal accepts an appeal, changing the grade, or rejects it. Requires the staff or
+	// admin role.
+	ResolveAppeal(ctx context.Context, in *ResolveAppealRequest, opts ...grpc.CallOption) (*ResolveAppealResponse, error)
+	// WatchCourseGrades streams the grades of a course during a semester, then every change to them
+	// as it happens, until the client cancels. Requires the staff or admin role.
+	WatchCourseGrades(ctx context.Context, in *WatchCourseGradesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[GradeChange], error)
+	// ExportResearchDataset exports de-identified grades of semesters for research, k-anonymous over
+	// course, period and grade type. Requires the admin role.
+	ExportResearchDataset(ctx context.Context, in *ExportResearchDatasetRequest, opts ...grpc.CallOption) (*ExportResearchDatasetResponse, error)
+	// ExportTranscriptPDF renders the transcript of a student, with the GPA of each semester and the
+	// cumulative GPA, as a PDF document. Students may only export their own.
+	ExportTranscriptPDF(ctx context.Context, in *ExportTranscriptPDFRequest, opts ...grpc.CallOption) (*ExportTranscriptPDFResponse, error)
+	// BatchAddGrades adds a list of grades atomically: either every grade is added or none is.
+	BatchAddGrades(ctx context.Context, in *BatchAddGradesRequest, opts ...grpc.CallOption) (*BatchAddGradesResponse, error)
+	// NormalizeAcrossSections aligns the grade distributions of the sections of a course item,
+	// previewing the normalized grades or applying them.
+	NormalizeAcrossSections(ctx context.Context, in *NormalizeAcrossSectionsRequest, opts ...grpc.CallOption) (*NormalizeAcrossSectionsResponse, error)
+	// GetStudentSemesterGPA returns a student's semester GPA from their letter grades on the grade
+	// point scale.
+	GetStudentSemesterGPA(ctx context.Context, in *GetStudentSemesterGPARequest, opts ...grpc.CallOption) (*GetStudentSemesterGPAResponse, error)
+	// GetWeightedCourseRanking ranks the students of a course by their weighted final grade.
+	GetWeightedCourseRanking(ctx context.Context, in *GetWeightedCourseRankingRequest, opts ...grpc.CallOption) (*GetWeightedCourseRankingResponse, error)
+	// GetCourseGradeStatistics returns the mean, median, minimum, maximum and standard deviation of
+	// the numeric grades of a course.
+	GetCourseGradeStatistics(ctx context.Context, in *GetCourseGradeStatisticsRequest, opts ...grpc.CallOption) (*GetCourseGradeStatisticsResponse, error)
+	// GenerateTestGrades inserts synthetic grades into a course for load testing, when test data is
+	// allowed.
+	GenerateTestGrades(ctx context.Context, in *GenerateTestGradesRequest, opts ...grpc.CallOption) (*GenerateTestGradesResponse, error)
 }
 
 type gradesServiceClient struct {
@@ -129,186 +343,2144 @@ func (c *gradesServiceClient) GetStudentSemesterGrades(ctx context.Context, in *
 	return out, nil
 }
 
-// GradesServiceServer is the server API for GradesService service.
-// All implementations must embed UnimplementedGradesServiceServer
-// for forward compatibility.
-//
-// GradesService is a microservice responsible for managing grades.
-type GradesServiceServer interface {
-	// GetCourseGrades returns all students grades enrolled in a specific course for a specific semester.
-	GetCourseGrades(context.Context, *GetCourseGradesRequest) (*GetCourseGradesResponse, error)
-	// GetStudentCourseGrades returns a specific student all grades in a specific course for a specific semester.
-	GetStudentCourseGrades(context.Context, *GetStudentCourseGradesRequest) (*GetStudentCourseGradesResponse, error)
-	// AddSingleGrade adds a single grade for a student in a course for a specific semester.
-	AddSingleGrade(context.Context, *AddSingleGradeRequest) (*AddSingleGradeResponse, error)
-	// UpdateSingleGrade updates a single grade for a student in a course for a specific semester.
-	UpdateSingleGrade(context.Context, *UpdateSingleGradeRequest) (*UpdateSingleGradeResponse, error)
-	// RemoveSingleGrade removes a single grade for a student in a course for a specific semester.
-	RemoveSingleGrade(context.Context, *RemoveSingleGradeRequest) (*RemoveSingleGradeResponse, error)
-	// GetStudentSemesterGrades returns all grades for a specific student for a specific semester.
-	GetStudentSemesterGrades(context.Context, *GetStudentSemesterGradesRequest) (*GetStudentSemesterGradesResponse, error)
-	mustEmbedUnimplementedGradesServiceServer()
+func (c *gradesServiceClient) ArchiveSemester(ctx context.Context, in *ArchiveSemesterRequest, opts ...grpc.CallOption) (*ArchiveSemesterResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ArchiveSemesterResponse)
+	err := c.cc.Invoke(ctx, GradesService_ArchiveSemester_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-// UnimplementedGradesServiceServer must be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedGradesServiceServer struct{}
-
-func (UnimplementedGradesServiceServer) GetCourseGrades(context.Context, *GetCourseGradesRequest) (*GetCourseGradesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetCourseGrades not implemented")
-}
-func (UnimplementedGradesServiceServer) GetStudentCourseGrades(context.Context, *GetStudentCourseGradesRequest) (*GetStudentCourseGradesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetStudentCourseGrades not implemented")
-}
-func (UnimplementedGradesServiceServer) AddSingleGrade(context.Context, *AddSingleGradeRequest) (*AddSingleGradeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddSingleGrade not implemented")
-}
-func (UnimplementedGradesServiceServer) UpdateSingleGrade(context.Context, *UpdateSingleGradeRequest) (*UpdateSingleGradeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateSingleGrade not implemented")
+func (c *gradesServiceClient) GetGrade(ctx context.Context, in *GetGradeRequest, opts ...grpc.CallOption) (*GetGradeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetGradeResponse)
+	err := c.cc.Invoke(ctx, GradesService_GetGrade_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGradesServiceServer) RemoveSingleGrade(context.Context, *RemoveSingleGradeRequest) (*RemoveSingleGradeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RemoveSingleGrade not implemented")
+
+func (c *gradesServiceClient) SearchGradeComments(ctx context.Context, in *SearchGradeCommentsRequest, opts ...grpc.CallOption) (*SearchGradeCommentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchGradeCommentsResponse)
+	err := c.cc.Invoke(ctx, GradesService_SearchGradeComments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGradesServiceServer) GetStudentSemesterGrades(context.Context, *GetStudentSemesterGradesRequest) (*GetStudentSemesterGradesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetStudentSemesterGrades not implemented")
+
+func (c *gradesServiceClient) SetGradeFlag(ctx context.Context, in *SetGradeFlagRequest, opts ...grpc.CallOption) (*SetGradeFlagResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetGradeFlagResponse)
+	err := c.cc.Invoke(ctx, GradesService_SetGradeFlag_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGradesServiceServer) mustEmbedUnimplementedGradesServiceServer() {}
-func (UnimplementedGradesServiceServer) testEmbeddedByValue()                       {}
 
-// UnsafeGradesServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to GradesServiceServer will
-// result in compilation errors.
-type UnsafeGradesServiceServer interface {
-	mustEmbedUnimplementedGradesServiceServer()
+func (c *gradesServiceClient) GetFlaggedGrades(ctx context.Context, in *GetFlaggedGradesRequest, opts ...grpc.CallOption) (*GetFlaggedGradesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetFlaggedGradesResponse)
+	err := c.cc.Invoke(ctx, GradesService_GetFlaggedGrades_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func RegisterGradesServiceServer(s grpc.ServiceRegistrar, srv GradesServiceServer) {
-	// If the following call pancis, it indicates UnimplementedGradesServiceServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
+func (c *gradesServiceClient) GetMultiCourseGrades(ctx context.Context, in *GetMultiCourseGradesRequest, opts ...grpc.CallOption) (*GetMultiCourseGradesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetMultiCourseGradesResponse)
+	err := c.cc.Invoke(ctx, GradesService_GetMultiCourseGrades_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
 	}
-	s.RegisterService(&GradesService_ServiceDesc, srv)
+	return out, nil
 }
 
-func _GradesService_GetCourseGrades_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetCourseGradesRequest)
-	if err := dec(in); err != nil {
+func (c *gradesServiceClient) SearchStudentGrades(ctx context.Context, in *SearchStudentGradesRequest, opts ...grpc.CallOption) (*SearchStudentGradesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchStudentGradesResponse)
+	err := c.cc.Invoke(ctx, GradesService_SearchStudentGrades_FullMethodName, in, out, cOpts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(GradesServiceServer).GetCourseGrades(ctx, in)
+	return out, nil
+}
+
+func (c *gradesServiceClient) RetryFailedEvents(ctx context.Context, in *RetryFailedEventsRequest, opts ...grpc.CallOption) (*RetryFailedEventsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RetryFailedEventsResponse)
+	err := c.cc.Invoke(ctx, GradesService_RetryFailedEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: GradesService_GetCourseGrades_FullMethodName,
+	return out, nil
+}
+
+func (c *gradesServiceClient) GetGradeProvenance(ctx context.Context, in *GetGradeProvenanceRequest, opts ...grpc.CallOption) (*GetGradeProvenanceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetGradeProvenanceResponse)
+	err := c.cc.Invoke(ctx, GradesService_GetGradeProvenance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GradesServiceServer).GetCourseGrades(ctx, req.(*GetCourseGradesRequest))
+	return out, nil
+}
+
+func (c *gradesServiceClient) ProjectRequiredGrade(ctx context.Context, in *ProjectRequiredGradeRequest, opts ...grpc.CallOption) (*ProjectRequiredGradeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProjectRequiredGradeResponse)
+	err := c.cc.Invoke(ctx, GradesService_ProjectRequiredGrade_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _GradesService_GetStudentCourseGrades_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetStudentCourseGradesRequest)
-	if err := dec(in); err != nil {
+func (c *gradesServiceClient) BatchAddGradesStream(ctx context.Context, in *BatchAddGradesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[BatchAddGradesProgress], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &GradesService_ServiceDesc.Streams[0], GradesService_BatchAddGradesStream_FullMethodName, cOpts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(GradesServiceServer).GetStudentCourseGrades(ctx, in)
+	x := &grpc.GenericClientStream[BatchAddGradesRequest, BatchAddGradesProgress]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: GradesService_GetStudentCourseGrades_FullMethodName,
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GradesServiceServer).GetStudentCourseGrades(ctx, req.(*GetStudentCourseGradesRequest))
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GradesService_BatchAddGradesStreamClient = grpc.ServerStreamingClient[BatchAddGradesProgress]
+
+func (c *gradesServiceClient) DetectGradeConflicts(ctx context.Context, in *DetectGradeConflictsRequest, opts ...grpc.CallOption) (*DetectGradeConflictsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DetectGradeConflictsResponse)
+	err := c.cc.Invoke(ctx, GradesService_DetectGradeConflicts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _GradesService_AddSingleGrade_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddSingleGradeRequest)
-	if err := dec(in); err != nil {
+func (c *gradesServiceClient) AssignDefaultToUngraded(ctx context.Context, in *AssignDefaultToUngradedRequest, opts ...grpc.CallOption) (*AssignDefaultToUngradedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AssignDefaultToUngradedResponse)
+	err := c.cc.Invoke(ctx, GradesService_AssignDefaultToUngraded_FullMethodName, in, out, cOpts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(GradesServiceServer).AddSingleGrade(ctx, in)
+	return out, nil
+}
+
+func (c *gradesServiceClient) StreamCourseGrades(ctx context.Context, in *StreamCourseGradesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[SingleGrade], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &GradesService_ServiceDesc.Streams[1], GradesService_StreamCourseGrades_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: GradesService_AddSingleGrade_FullMethodName,
+	x := &grpc.GenericClientStream[StreamCourseGradesRequest, SingleGrade]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GradesServiceServer).AddSingleGrade(ctx, req.(*AddSingleGradeRequest))
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return x, nil
 }
 
-func _GradesService_UpdateSingleGrade_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateSingleGradeRequest)
-	if err := dec(in); err != nil {
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GradesService_StreamCourseGradesClient = grpc.ServerStreamingClient[SingleGrade]
+
+func (c *gradesServiceClient) GetGradingVelocity(ctx context.Context, in *GetGradingVelocityRequest, opts ...grpc.CallOption) (*GetGradingVelocityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetGradingVelocityResponse)
+	err := c.cc.Invoke(ctx, GradesService_GetGradingVelocity_FullMethodName, in, out, cOpts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(GradesServiceServer).UpdateSingleGrade(ctx, in)
+	return out, nil
+}
+
+func (c *gradesServiceClient) PurgeTombstones(ctx context.Context, in *PurgeTombstonesRequest, opts ...grpc.CallOption) (*PurgeTombstonesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PurgeTombstonesResponse)
+	err := c.cc.Invoke(ctx, GradesService_PurgeTombstones_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: GradesService_UpdateSingleGrade_FullMethodName,
+	return out, nil
+}
+
+func (c *gradesServiceClient) CompareCourseSemesters(ctx context.Context, in *CompareCourseSemestersRequest, opts ...grpc.CallOption) (*CompareCourseSemestersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CompareCourseSemestersResponse)
+	err := c.cc.Invoke(ctx, GradesService_CompareCourseSemesters_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GradesServiceServer).UpdateSingleGrade(ctx, req.(*UpdateSingleGradeRequest))
+	return out, nil
+}
+
+func (c *gradesServiceClient) GetSemesterGradeTypeSummary(ctx context.Context, in *GetSemesterGradeTypeSummaryRequest, opts ...grpc.CallOption) (*GetSemesterGradeTypeSummaryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSemesterGradeTypeSummaryResponse)
+	err := c.cc.Invoke(ctx, GradesService_GetSemesterGradeTypeSummary_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _GradesService_RemoveSingleGrade_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RemoveSingleGradeRequest)
-	if err := dec(in); err != nil {
+func (c *gradesServiceClient) SetNotificationOptOut(ctx context.Context, in *SetNotificationOptOutRequest, opts ...grpc.CallOption) (*SetNotificationOptOutResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetNotificationOptOutResponse)
+	err := c.cc.Invoke(ctx, GradesService_SetNotificationOptOut_FullMethodName, in, out, cOpts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(GradesServiceServer).RemoveSingleGrade(ctx, in)
+	return out, nil
+}
+
+func (c *gradesServiceClient) ExportCourseGradesXLSX(ctx context.Context, in *ExportCourseGradesXLSXRequest, opts ...grpc.CallOption) (*ExportCourseGradesXLSXResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportCourseGradesXLSXResponse)
+	err := c.cc.Invoke(ctx, GradesService_ExportCourseGradesXLSX_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: GradesService_RemoveSingleGrade_FullMethodName,
+	return out, nil
+}
+
+func (c *gradesServiceClient) GetStudentYearGrades(ctx context.Context, in *GetStudentYearGradesRequest, opts ...grpc.CallOption) (*GetStudentYearGradesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetStudentYearGradesResponse)
+	err := c.cc.Invoke(ctx, GradesService_GetStudentYearGrades_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GradesServiceServer).RemoveSingleGrade(ctx, req.(*RemoveSingleGradeRequest))
+	return out, nil
+}
+
+func (c *gradesServiceClient) GetGradeValue(ctx context.Context, in *GetGradeValueRequest, opts ...grpc.CallOption) (*GetGradeValueResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetGradeValueResponse)
+	err := c.cc.Invoke(ctx, GradesService_GetGradeValue_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _GradesService_GetStudentSemesterGrades_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetStudentSemesterGradesRequest)
-	if err := dec(in); err != nil {
+func (c *gradesServiceClient) AddCourseGrader(ctx context.Context, in *AddCourseGraderRequest, opts ...grpc.CallOption) (*AddCourseGraderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddCourseGraderResponse)
+	err := c.cc.Invoke(ctx, GradesService_AddCourseGrader_FullMethodName, in, out, cOpts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(GradesServiceServer).GetStudentSemesterGrades(ctx, in)
+	return out, nil
+}
+
+func (c *gradesServiceClient) RemoveCourseGrader(ctx context.Context, in *RemoveCourseGraderRequest, opts ...grpc.CallOption) (*RemoveCourseGraderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveCourseGraderResponse)
+	err := c.cc.Invoke(ctx, GradesService_RemoveCourseGrader_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: GradesService_GetStudentSemesterGrades_FullMethodName,
+	return out, nil
+}
+
+func (c *gradesServiceClient) GetCourseGraders(ctx context.Context, in *GetCourseGradersRequest, opts ...grpc.CallOption) (*GetCourseGradersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCourseGradersResponse)
+	err := c.cc.Invoke(ctx, GradesService_GetCourseGraders_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GradesServiceServer).GetStudentSemesterGrades(ctx, req.(*GetStudentSemesterGradesRequest))
+	return out, nil
+}
+
+func (c *gradesServiceClient) GetCourseStats(ctx context.Context, in *GetCourseStatsRequest, opts ...grpc.CallOption) (*GetCourseStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCourseStatsResponse)
+	err := c.cc.Invoke(ctx, GradesService_GetCourseStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-// GradesService_ServiceDesc is the grpc.ServiceDesc for GradesService service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
+func (c *gradesServiceClient) RefreshCourseStats(ctx context.Context, in *RefreshCourseStatsRequest, opts ...grpc.CallOption) (*RefreshCourseStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RefreshCourseStatsResponse)
+	err := c.cc.Invoke(ctx, GradesService_RefreshCourseStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gradesServiceClient) ExportSemesterToBucket(ctx context.Context, in *ExportSemesterToBucketRequest, opts ...grpc.CallOption) (*ExportSemesterToBucketResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportSemesterToBucketResponse)
+	err := c.cc.Invoke(ctx, GradesService_ExportSemesterToBucket_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gradesServiceClient) SimulateGradeChange(ctx context.Context, in *SimulateGradeChangeRequest, opts ...grpc.CallOption) (*SimulateGradeChangeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SimulateGradeChangeResponse)
+	err := c.cc.Invoke(ctx, GradesService_SimulateGradeChange_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gradesServiceClient) SetCourseReleaseTime(ctx context.Context, in *SetCourseReleaseTimeRequest, opts ...grpc.CallOption) (*SetCourseReleaseTimeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetCourseReleaseTimeResponse)
+	err := c.cc.Invoke(ctx, GradesService_SetCourseReleaseTime_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gradesServiceClient) GetSemesterLeaderboard(ctx context.Context, in *GetSemesterLeaderboardRequest, opts ...grpc.CallOption) (*GetSemesterLeaderboardResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSemesterLeaderboardResponse)
+	err := c.cc.Invoke(ctx, GradesService_GetSemesterLeaderboard_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gradesServiceClient) GetGradeFlexible(ctx context.Context, in *GetGradeFlexibleRequest, opts ...grpc.CallOption) (*GetGradeFlexibleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetGradeFlexibleResponse)
+	err := c.cc.Invoke(ctx, GradesService_GetGradeFlexible_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gradesServiceClient) SoftDeleteStudentGrades(ctx context.Context, in *SoftDeleteStudentGradesRequest, opts ...grpc.CallOption) (*SoftDeleteStudentGradesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SoftDeleteStudentGradesResponse)
+	err := c.cc.Invoke(ctx, GradesService_SoftDeleteStudentGrades_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gradesServiceClient) PreviewCurve(ctx context.Context, in *PreviewCurveRequest, opts ...grpc.CallOption) (*PreviewCurveResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PreviewCurveResponse)
+	err := c.cc.Invoke(ctx, GradesService_PreviewCurve_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gradesServiceClient) GetGradePercentiles(ctx context.Context, in *GetGradePercentilesRequest, opts ...grpc.CallOption) (*GetGradePercentilesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetGradePercentilesResponse)
+	err := c.cc.Invoke(ctx, GradesService_GetGradePercentiles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gradesServiceClient) SetGradeTypeScheme(ctx context.Context, in *SetGradeTypeSchemeRequest, opts ...grpc.CallOption) (*SetGradeTypeSchemeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetGradeTypeSchemeResponse)
+	err := c.cc.Invoke(ctx, GradesService_SetGradeTypeScheme_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gradesServiceClient) GetGradeTypeSchemes(ctx context.Context, in *GetGradeTypeSchemesRequest, opts ...grpc.CallOption) (*GetGradeTypeSchemesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetGradeTypeSchemesResponse)
+	err := c.cc.Invoke(ctx, GradesService_GetGradeTypeSchemes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gradesServiceClient) SetAttemptPolicy(ctx context.Context, in *SetAttemptPolicyRequest, opts ...grpc.CallOption) (*SetAttemptPolicyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetAttemptPolicyResponse)
+	err := c.cc.Invoke(ctx, GradesService_SetAttemptPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gradesServiceClient) GetGradeTimeline(ctx context.Context, in *GetGradeTimelineRequest, opts ...grpc.CallOption) (*GetGradeTimelineResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetGradeTimelineResponse)
+	err := c.cc.Invoke(ctx, GradesService_GetGradeTimeline_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gradesServiceClient) GetCourseWeightedAverage(ctx context.Context, in *GetCourseWeightedAverageRequest, opts ...grpc.CallOption) (*GetCourseWeightedAverageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCourseWeightedAverageResponse)
+	err := c.cc.Invoke(ctx, GradesService_GetCourseWeightedAverage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gradesServiceClient) GetHonorRollStatus(ctx context.Context, in *GetHonorRollStatusRequest, opts ...grpc.CallOption) (*GetHonorRollStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetHonorRollStatusResponse)
+	err := c.cc.Invoke(ctx, GradesService_GetHonorRollStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gradesServiceClient) GetCourseGradesChecksum(ctx context.Context, in *GetCourseGradesChecksumRequest, opts ...grpc.CallOption) (*GetCourseGradesChecksumResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCourseGradesChecksumResponse)
+	err := c.cc.Invoke(ctx, GradesService_GetCourseGradesChecksum_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gradesServiceClient) SetNotificationDigest(ctx context.Context, in *SetNotificationDigestRequest, opts ...grpc.CallOption) (*SetNotificationDigestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetNotificationDigestResponse)
+	err := c.cc.Invoke(ctx, GradesService_SetNotificationDigest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gradesServiceClient) GetProbationStatus(ctx context.Context, in *GetProbationStatusRequest, opts ...grpc.CallOption) (*GetProbationStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetProbationStatusResponse)
+	err := c.cc.Invoke(ctx, GradesService_GetProbationStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gradesServiceClient) ExportCourseGradesSigned(ctx context.Context, in *ExportCourseGradesSignedRequest, opts ...grpc.CallOption) (*ExportCourseGradesSignedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportCourseGradesSignedResponse)
+	err := c.cc.Invoke(ctx, GradesService_ExportCourseGradesSigned_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gradesServiceClient) UndoGradeChange(ctx context.Context, in *UndoGradeChangeRequest, opts ...grpc.CallOption) (*UndoGradeChangeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UndoGradeChangeResponse)
+	err := c.cc.Invoke(ctx, GradesService_UndoGradeChange_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gradesServiceClient) ImportGradesCSV(ctx context.Context, in *ImportGradesCSVRequest, opts ...grpc.CallOption) (*ImportGradesCSVResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportGradesCSVResponse)
+	err := c.cc.Invoke(ctx, GradesService_ImportGradesCSV_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gradesServiceClient) FileAppeal(ctx context.Context, in *FileAppealRequest, opts ...grpc.CallOption) (*FileAppealResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FileAppealResponse)
+	err := c.cc.Invoke(ctx, GradesService_FileAppeal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gradesServiceClient) ResolveAppeal(ctx context.Context, in *ResolveAppealRequest, opts ...grpc.CallOption) (*ResolveAppealResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResolveAppealResponse)
+	err := c.cc.Invoke(ctx, GradesService_ResolveAppeal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gradesServiceClient) WatchCourseGrades(ctx context.Context, in *WatchCourseGradesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[GradeChange], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &GradesService_ServiceDesc.Streams[2], GradesService_WatchCourseGrades_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchCourseGradesRequest, GradeChange]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GradesService_WatchCourseGradesClient = grpc.ServerStreamingClient[GradeChange]
+
+func (c *gradesServiceClient) ExportResearchDataset(ctx context.Context, in *ExportResearchDatasetRequest, opts ...grpc.CallOption) (*ExportResearchDatasetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportResearchDatasetResponse)
+	err := c.cc.Invoke(ctx, GradesService_ExportResearchDataset_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gradesServiceClient) ExportTranscriptPDF(ctx context.Context, in *ExportTranscriptPDFRequest, opts ...grpc.CallOption) (*ExportTranscriptPDFResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportTranscriptPDFResponse)
+	err := c.cc.Invoke(ctx, GradesService_ExportTranscriptPDF_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gradesServiceClient) BatchAddGrades(ctx context.Context, in *BatchAddGradesRequest, opts ...grpc.CallOption) (*BatchAddGradesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchAddGradesResponse)
+	err := c.cc.Invoke(ctx, GradesService_BatchAddGrades_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gradesServiceClient) NormalizeAcrossSections(ctx context.Context, in *NormalizeAcrossSectionsRequest, opts ...grpc.CallOption) (*NormalizeAcrossSectionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(NormalizeAcrossSectionsResponse)
+	err := c.cc.Invoke(ctx, GradesService_NormalizeAcrossSections_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gradesServiceClient) GetStudentSemesterGPA(ctx context.Context, in *GetStudentSemesterGPARequest, opts ...grpc.CallOption) (*GetStudentSemesterGPAResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetStudentSemesterGPAResponse)
+	err := c.cc.Invoke(ctx, GradesService_GetStudentSemesterGPA_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gradesServiceClient) GetWeightedCourseRanking(ctx context.Context, in *GetWeightedCourseRankingRequest, opts ...grpc.CallOption) (*GetWeightedCourseRankingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetWeightedCourseRankingResponse)
+	err := c.cc.Invoke(ctx, GradesService_GetWeightedCourseRanking_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gradesServiceClient) GetCourseGradeStatistics(ctx context.Context, in *GetCourseGradeStatisticsRequest, opts ...grpc.CallOption) (*GetCourseGradeStatisticsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCourseGradeStatisticsResponse)
+	err := c.cc.Invoke(ctx, GradesService_GetCourseGradeStatistics_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gradesServiceClient) GenerateTestGrades(ctx context.Context, in *GenerateTestGradesRequest, opts ...grpc.CallOption) (*GenerateTestGradesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GenerateTestGradesResponse)
+	err := c.cc.Invoke(ctx, GradesService_GenerateTestGrades_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GradesServiceServer is the server API for GradesService service.
+// All implementations must embed UnimplementedGradesServiceServer
+// for forward compatibility.
+//
+// GradesService manages student grade information.
+type GradesServiceServer interface {
+	// GetCourseGrades retrieves a paginated list of grades for all students enrolled
+	// in a specific course during a specific semester.
+	GetCourseGrades(context.Context, *GetCourseGradesRequest) (*GetCourseGradesResponse, error)
+	// GetStudentCourseGrades retrieves a paginated list of grades for a specific student
+	// within a specific course and semester.
+	GetStudentCourseGrades(context.Context, *GetStudentCourseGradesRequest) (*GetStudentCourseGradesResponse, error)
+	// AddSingleGrade adds a new grade entry for a student in a course.
+	AddSingleGrade(context.Context, *AddSingleGradeRequest) (*AddSingleGradeResponse, error)
+	// UpdateSingleGrade modifies an existing grade entry.
+	UpdateSingleGrade(context.Context, *UpdateSingleGradeRequest) (*UpdateSingleGradeResponse, error)
+	// RemoveSingleGrade deletes a specific grade entry. It is kept as a tombstone until purged.
+	RemoveSingleGrade(context.Context, *RemoveSingleGradeRequest) (*RemoveSingleGradeResponse, error)
+	// GetStudentSemesterGrades retrieves a paginated list of all grades for a specific student
+	// across all courses during a specific semester.
+	GetStudentSemesterGrades(context.Context, *GetStudentSemesterGradesRequest) (*GetStudentSemesterGradesResponse, error)
+	// ArchiveSemester moves all grades of a semester from the live table to cold storage.
+	// Requires the admin role.
+	ArchiveSemester(context.Context, *ArchiveSemesterRequest) (*ArchiveSemesterResponse, error)
+	// GetGrade retrieves a single grade, either its latest state or a specific historical version.
+	GetGrade(context.Context, *GetGradeRequest) (*GetGradeResponse, error)
+	// SearchGradeComments retrieves the grades of a course whose comments contain a search term.
+	SearchGradeComments(context.Context, *SearchGradeCommentsRequest) (*SearchGradeCommentsResponse, error)
+	// SetGradeFlag marks or unmarks a grade for review.
+	SetGradeFlag(context.Context, *SetGradeFlagRequest) (*SetGradeFlagResponse, error)
+	// GetFlaggedGrades retrieves all grades marked for review in a course during a semester.
+	GetFlaggedGrades(context.Context, *GetFlaggedGradesRequest) (*GetFlaggedGradesResponse, error)
+	// GetMultiCourseGrades retrieves the grades of several courses during a semester, grouped by course.
+	GetMultiCourseGrades(context.Context, *GetMultiCourseGradesRequest) (*GetMultiCourseGradesResponse, error)
+	// SearchStudentGrades retrieves the grades of students whose ID starts with a prefix.
+	SearchStudentGrades(context.Context, *SearchStudentGradesRequest) (*SearchStudentGradesResponse, error)
+	// RetryFailedEvents re-publishes grade events whose delivery previously failed.
+	RetryFailedEvents(context.Context, *RetryFailedEventsRequest) (*RetryFailedEventsResponse, error)
+	// GetGradeProvenance retrieves the chronological history of a grade: its creation, every
+	// change and every recorded read. Requires the staff or auditor role.
+	GetGradeProvenance(context.Context, *GetGradeProvenanceRequest) (*GetGradeProvenanceResponse, error)
+	// ProjectRequiredGrade computes the score a student needs on the ungraded components of a course
+	// to reach a target final grade.
+	ProjectRequiredGrade(context.Context, *ProjectRequiredGradeRequest) (*ProjectRequiredGradeResponse, error)
+	// BatchAddGradesStream adds a list of grades, streaming progress updates and a final summary.
+	BatchAddGradesStream(*BatchAddGradesRequest, grpc.ServerStreamingServer[BatchAddGradesProgress]) error
+	// DetectGradeConflicts retrieves the grades of a course during a semester that were changed by a
+	// different grader than the original one. Requires the staff role.
+	DetectGradeConflicts(context.Context, *DetectGradeConflictsRequest) (*DetectGradeConflictsResponse, error)
+	// AssignDefaultToUngraded gives a default grade for an item to every rostered student without
+	// one. Requires the staff role.
+	AssignDefaultToUngraded(context.Context, *AssignDefaultToUngradedRequest) (*AssignDefaultToUngradedResponse, error)
+	// StreamCourseGrades streams the grades of a course during a semester, one grade per message,
+	// reading them only as fast as the client consumes them.
+	StreamCourseGrades(*StreamCourseGradesRequest, grpc.ServerStreamingServer[SingleGrade]) error
+	// GetGradingVelocity reports how fast an item is being graded and projects its completion date.
+	// Requires the staff role.
+	GetGradingVelocity(context.Context, *GetGradingVelocityRequest) (*GetGradingVelocityResponse, error)
+	// PurgeTombstones permanently deletes grades removed longer ago than the retention period.
+	// Requires the admin role.
+	PurgeTombstones(context.Context, *PurgeTombstonesRequest) (*PurgeTombstonesResponse, error)
+	// CompareCourseSemesters compares a course between two semesters, per student and for the
+	// class average. Requires the staff role.
+	CompareCourseSemesters(context.Context, *CompareCourseSemestersRequest) (*CompareCourseSemestersResponse, error)
+	// GetSemesterGradeTypeSummary counts and averages the grades of each grade type across a semester.
+	// Requires the admin role.
+	GetSemesterGradeTypeSummary(context.Context, *GetSemesterGradeTypeSummaryRequest) (*GetSemesterGradeTypeSummaryResponse, error)
+	// SetNotificationOptOut opts a student out of grade change notifications, or back in.
+	// Students may only change their own preference.
+	SetNotificationOptOut(context.Context, *SetNotificationOptOutRequest) (*SetNotificationOptOutResponse, error)
+	// ExportCourseGradesXLSX exports the grades of a course in a semester as an Excel workbook,
+	// with a grades sheet and a summary sheet.
+	ExportCourseGradesXLSX(context.Context, *ExportCourseGradesXLSXRequest) (*ExportCourseGradesXLSXResponse, error)
+	// GetStudentYearGrades retrieves the grades of a student across the semesters of an academic year,
+	// grouped by semester.
+	GetStudentYearGrades(context.Context, *GetStudentYearGradesRequest) (*GetStudentYearGradesResponse, error)
+	// GetGradeValue retrieves only the value of a grade, by grade ID or by student, course, semester and item.
+	GetGradeValue(context.Context, *GetGradeValueRequest) (*GetGradeValueResponse, error)
+	// AddCourseGrader allows a grader to grade a course. Once a course lists graders, only they
+	// and administrators may add or update its grades.
+	AddCourseGrader(context.Context, *AddCourseGraderRequest) (*AddCourseGraderResponse, error)
+	// RemoveCourseGrader removes a grader from the graders of a course.
+	RemoveCourseGrader(context.Context, *RemoveCourseGraderRequest) (*RemoveCourseGraderResponse, error)
+	// GetCourseGraders lists the graders allowed to grade a course, empty when anyone may.
+	GetCourseGraders(context.Context, *GetCourseGradersRequest) (*GetCourseGradersResponse, error)
+	// GetCourseStats summarizes the grades of a course in a semester from periodically refreshed
+	// statistics, computing them live when those are stale.
+	GetCourseStats(context.Context, *GetCourseStatsRequest) (*GetCourseStatsResponse, error)
+	// RefreshCourseStats recomputes the statistics of every course. Requires the admin role.
+	RefreshCourseStats(context.Context, *RefreshCourseStatsRequest) (*RefreshCourseStatsResponse, error)
+	// ExportSemesterToBucket streams every grade of a semester to the export bucket as CSV or JSONL.
+	// Requires the admin role.
+	ExportSemesterToBucket(context.Context, *ExportSemesterToBucketRequest) (*ExportSemesterToBucketResponse, error)
+	// SimulateGradeChange recomputes a student's GPA as if hypothetical grade changes applied,
+	// without storing them.
+	SimulateGradeChange(context.Context, *SimulateGradeChangeRequest) (*SimulateGradeChangeResponse, error)
+	// SetCourseReleaseTime sets when the grades of a course offering become visible to students.
+	// Requires the staff or admin role.
+	SetCourseReleaseTime(context.Context, *SetCourseReleaseTimeRequest) (*SetCourseReleaseTimeResponse, error)
+	// GetSemesterLeaderboard ranks the students of a semester by GPA. Requires the admin role.
+	GetSemesterLeaderboard(context.Context, *GetSemesterLeaderboardRequest) (*GetSemesterLeaderboardResponse, error)
+	// GetGradeFlexible returns a grade serialized as protobuf, JSON or compact JSON.
+	GetGradeFlexible(context.Context, *GetGradeFlexibleRequest) (*GetGradeFlexibleResponse, error)
+	// SoftDeleteStudentGrades removes every grade of a student, keeping them recoverable as
+	// tombstones. Requires the admin role.
+	SoftDeleteStudentGrades(context.Context, *SoftDeleteStudentGradesRequest) (*SoftDeleteStudentGradesResponse, error)
+	// PreviewCurve computes the effect of a curve on a course item without changing any grade.
+	PreviewCurve(context.Context, *PreviewCurveRequest) (*PreviewCurveResponse, error)
+	// GetGradePercentiles returns the percentile rank of every numeric grade value of a course.
+	GetGradePercentiles(context.Context, *GetGradePercentilesRequest) (*GetGradePercentilesResponse, error)
+	// SetGradeTypeScheme sets the grading scheme of a grade type in a course.
+	SetGradeTypeScheme(context.Context, *SetGradeTypeSchemeRequest) (*SetGradeTypeSchemeResponse, error)
+	// GetGradeTypeSchemes returns the grading schemes of a course's grade types.
+	GetGradeTypeSchemes(context.Context, *GetGradeTypeSchemesRequest) (*GetGradeTypeSchemesResponse, error)
+	// SetAttemptPolicy sets how a course aggregates several attempts at the same item.
+	SetAttemptPolicy(context.Context, *SetAttemptPolicyRequest) (*SetAttemptPolicyResponse, error)
+	// GetGradeTimeline returns the value transitions of a grade, oldest first.
+	GetGradeTimeline(context.Context, *GetGradeTimelineRequest) (*GetGradeTimelineResponse, error)
+	// GetCourseWeightedAverage returns the class average of a course weighting each component.
+	GetCourseWeightedAverage(context.Context, *GetCourseWeightedAverageRequest) (*GetCourseWeightedAverageResponse, error)
+	// GetHonorRollStatus reports whether a student makes the honor roll of a semester by GPA.
+	// Students may only check their own status.
+	GetHonorRollStatus(context.Context, *GetHonorRollStatusRequest) (*GetHonorRollStatusResponse, error)
+	// GetCourseGradesChecksum returns a checksum of a course's grades for reconciling a replica
+	// without transferring them. Requires the staff, auditor or admin role.
+	GetCourseGradesChecksum(context.Context, *GetCourseGradesChecksumRequest) (*GetCourseGradesChecksumResponse, error)
+	// SetNotificationDigest makes a student get a periodic digest of grade changes instead of a
+	// notification for each, or back. Students may only change their own preference.
+	SetNotificationDigest(context.Context, *SetNotificationDigestRequest) (*SetNotificationDigestResponse, error)
+	// GetProbationStatus reports whether a student is on academic probation by GPA. Students may
+	// only check their own status.
+	GetProbationStatus(context.Context, *GetProbationStatusRequest) (*GetProbationStatusResponse, error)
+	// ExportCourseGradesSigned exports the grades of a course with a detached signature by the
+	// institution's key, for official transcripts. Requires the staff or admin role.
+	ExportCourseGradesSigned(context.Context, *ExportCourseGradesSignedRequest) (*ExportCourseGradesSignedResponse, error)
+	// UndoGradeChange reverts a grade to its value before the latest change. Graders may only undo
+	// their own recent changes.
+	UndoGradeChange(context.Context, *UndoGradeChangeRequest) (*UndoGradeChangeResponse, error)
+	// ImportGradesCSV adds the grades of a CSV file, mapping its columns and values to grades as
+	// the request configures. Requires the staff or admin role.
+	ImportGradesCSV(context.Context, *ImportGradesCSVRequest) (*ImportGradesCSVResponse, error)
+	// FileAppeal puts a grade under appeal, locking it from edits until the appeal is resolved.
+	// Students may only appeal their own grades.
+	FileAppeal(context.Context, *FileAppealRequest) (*FileAppealResponse, error)
+	// ResolveAppeal accepts an appeal, changing the grade, or rejects it. Requires the staff or
+	// admin role.
+	ResolveAppeal(context.Context, *ResolveAppealRequest) (*ResolveAppealResponse, error)
+	// WatchCourseGrades streams the grades of a course during a semester, then every change to them
+	// as it happens, until the client cancels. Requires the staff or admin role.
+	WatchCourseGrades(*WatchCourseGradesRequest, grpc.ServerStreamingServer[GradeChange]) error
+	// ExportResearchDataset exports de-identified grades of semesters for research, k-anonymous over
+	// course, period and grade type. Requires the admin role.
+	ExportResearchDataset(context.Context, *ExportResearchDatasetRequest) (*ExportResearchDatasetResponse, error)
+	// ExportTranscriptPDF renders the transcript of a student, with the GPA of each semester and the
+	// cumulative GPA, as a PDF document. Students may only export their own.
+	ExportTranscriptPDF(context.Context, *ExportTranscriptPDFRequest) (*ExportTranscriptPDFResponse, error)
+	// BatchAddGrades adds a list of grades atomically: either every grade is added or none is.
+	BatchAddGrades(context.Context, *BatchAddGradesRequest) (*BatchAddGradesResponse, error)
+	// NormalizeAcrossSections aligns the grade distributions of the sections of a course item,
+	// previewing the normalized grades or applying them.
+	NormalizeAcrossSections(context.Context, *NormalizeAcrossSectionsRequest) (*NormalizeAcrossSectionsResponse, error)
+	// GetStudentSemesterGPA returns a student's semester GPA from their letter grades on the grade
+	// point scale.
+	GetStudentSemesterGPA(context.Context, *GetStudentSemesterGPARequest) (*GetStudentSemesterGPAResponse, error)
+	// GetWeightedCourseRanking ranks the students of a course by their weighted final grade.
+	GetWeightedCourseRanking(context.Context, *GetWeightedCourseRankingRequest) (*GetWeightedCourseRankingResponse, error)
+	// GetCourseGradeStatistics returns the mean, median, minimum, maximum and standard deviation of
+	// the numeric grades of a course.
+	GetCourseGradeStatistics(context.Context, *GetCourseGradeStatisticsRequest) (*GetCourseGradeStatisticsResponse, error)
+	// GenerateTestGrades inserts synthetic grades into a course for load testing, when test data is
+	// allowed.
+	GenerateTestGrades(context.Context, *GenerateTestGradesRequest) (*GenerateTestGradesResponse, error)
+	mustEmbedUnimplementedGradesServiceServer()
+}
+
+// UnimplementedGradesServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedGradesServiceServer struct{}
+
+func (UnimplementedGradesServiceServer) GetCourseGrades(context.Context, *GetCourseGradesRequest) (*GetCourseGradesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCourseGrades not implemented")
+}
+func (UnimplementedGradesServiceServer) GetStudentCourseGrades(context.Context, *GetStudentCourseGradesRequest) (*GetStudentCourseGradesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStudentCourseGrades not implemented")
+}
+func (UnimplementedGradesServiceServer) AddSingleGrade(context.Context, *AddSingleGradeRequest) (*AddSingleGradeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddSingleGrade not implemented")
+}
+func (UnimplementedGradesServiceServer) UpdateSingleGrade(context.Context, *UpdateSingleGradeRequest) (*UpdateSingleGradeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSingleGrade not implemented")
+}
+func (UnimplementedGradesServiceServer) RemoveSingleGrade(context.Context, *RemoveSingleGradeRequest) (*RemoveSingleGradeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveSingleGrade not implemented")
+}
+func (UnimplementedGradesServiceServer) GetStudentSemesterGrades(context.Context, *GetStudentSemesterGradesRequest) (*GetStudentSemesterGradesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStudentSemesterGrades not implemented")
+}
+func (UnimplementedGradesServiceServer) ArchiveSemester(context.Context, *ArchiveSemesterRequest) (*ArchiveSemesterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ArchiveSemester not implemented")
+}
+func (UnimplementedGradesServiceServer) GetGrade(context.Context, *GetGradeRequest) (*GetGradeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGrade not implemented")
+}
+func (UnimplementedGradesServiceServer) SearchGradeComments(context.Context, *SearchGradeCommentsRequest) (*SearchGradeCommentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchGradeComments not implemented")
+}
+func (UnimplementedGradesServiceServer) SetGradeFlag(context.Context, *SetGradeFlagRequest) (*SetGradeFlagResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetGradeFlag not implemented")
+}
+func (UnimplementedGradesServiceServer) GetFlaggedGrades(context.Context, *GetFlaggedGradesRequest) (*GetFlaggedGradesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFlaggedGrades not implemented")
+}
+func (UnimplementedGradesServiceServer) GetMultiCourseGrades(context.Context, *GetMultiCourseGradesRequest) (*GetMultiCourseGradesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMultiCourseGrades not implemented")
+}
+func (UnimplementedGradesServiceServer) SearchStudentGrades(context.Context, *SearchStudentGradesRequest) (*SearchStudentGradesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchStudentGrades not implemented")
+}
+func (UnimplementedGradesServiceServer) RetryFailedEvents(context.Context, *RetryFailedEventsRequest) (*RetryFailedEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetryFailedEvents not implemented")
+}
+func (UnimplementedGradesServiceServer) GetGradeProvenance(context.Context, *GetGradeProvenanceRequest) (*GetGradeProvenanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGradeProvenance not implemented")
+}
+func (UnimplementedGradesServiceServer) ProjectRequiredGrade(context.Context, *ProjectRequiredGradeRequest) (*ProjectRequiredGradeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProjectRequiredGrade not implemented")
+}
+func (UnimplementedGradesServiceServer) BatchAddGradesStream(*BatchAddGradesRequest, grpc.ServerStreamingServer[BatchAddGradesProgress]) error {
+	return status.Errorf(codes.Unimplemented, "method BatchAddGradesStream not implemented")
+}
+func (UnimplementedGradesServiceServer) DetectGradeConflicts(context.Context, *DetectGradeConflictsRequest) (*DetectGradeConflictsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DetectGradeConflicts not implemented")
+}
+func (UnimplementedGradesServiceServer) AssignDefaultToUngraded(context.Context, *AssignDefaultToUngradedRequest) (*AssignDefaultToUngradedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AssignDefaultToUngraded not implemented")
+}
+func (UnimplementedGradesServiceServer) StreamCourseGrades(*StreamCourseGradesRequest, grpc.ServerStreamingServer[SingleGrade]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamCourseGrades not implemented")
+}
+func (UnimplementedGradesServiceServer) GetGradingVelocity(context.Context, *GetGradingVelocityRequest) (*GetGradingVelocityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGradingVelocity not implemented")
+}
+func (UnimplementedGradesServiceServer) PurgeTombstones(context.Context, *PurgeTombstonesRequest) (*PurgeTombstonesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeTombstones not implemented")
+}
+func (UnimplementedGradesServiceServer) CompareCourseSemesters(context.Context, *CompareCourseSemestersRequest) (*CompareCourseSemestersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompareCourseSemesters not implemented")
+}
+func (UnimplementedGradesServiceServer) GetSemesterGradeTypeSummary(context.Context, *GetSemesterGradeTypeSummaryRequest) (*GetSemesterGradeTypeSummaryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSemesterGradeTypeSummary not implemented")
+}
+func (UnimplementedGradesServiceServer) SetNotificationOptOut(context.Context, *SetNotificationOptOutRequest) (*SetNotificationOptOutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetNotificationOptOut not implemented")
+}
+func (UnimplementedGradesServiceServer) ExportCourseGradesXLSX(context.Context, *ExportCourseGradesXLSXRequest) (*ExportCourseGradesXLSXResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportCourseGradesXLSX not implemented")
+}
+func (UnimplementedGradesServiceServer) GetStudentYearGrades(context.Context, *GetStudentYearGradesRequest) (*GetStudentYearGradesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStudentYearGrades not implemented")
+}
+func (UnimplementedGradesServiceServer) GetGradeValue(context.Context, *GetGradeValueRequest) (*GetGradeValueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGradeValue not implemented")
+}
+func (UnimplementedGradesServiceServer) AddCourseGrader(context.Context, *AddCourseGraderRequest) (*AddCourseGraderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddCourseGrader not implemented")
+}
+func (UnimplementedGradesServiceServer) RemoveCourseGrader(context.Context, *RemoveCourseGraderRequest) (*RemoveCourseGraderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveCourseGrader not implemented")
+}
+func (UnimplementedGradesServiceServer) GetCourseGraders(context.Context, *GetCourseGradersRequest) (*GetCourseGradersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCourseGraders not implemented")
+}
+func (UnimplementedGradesServiceServer) GetCourseStats(context.Context, *GetCourseStatsRequest) (*GetCourseStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCourseStats not implemented")
+}
+func (UnimplementedGradesServiceServer) RefreshCourseStats(context.Context, *RefreshCourseStatsRequest) (*RefreshCourseStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefreshCourseStats not implemented")
+}
+func (UnimplementedGradesServiceServer) ExportSemesterToBucket(context.Context, *ExportSemesterToBucketRequest) (*ExportSemesterToBucketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportSemesterToBucket not implemented")
+}
+func (UnimplementedGradesServiceServer) SimulateGradeChange(context.Context, *SimulateGradeChangeRequest) (*SimulateGradeChangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateGradeChange not implemented")
+}
+func (UnimplementedGradesServiceServer) SetCourseReleaseTime(context.Context, *SetCourseReleaseTimeRequest) (*SetCourseReleaseTimeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCourseReleaseTime not implemented")
+}
+func (UnimplementedGradesServiceServer) GetSemesterLeaderboard(context.Context, *GetSemesterLeaderboardRequest) (*GetSemesterLeaderboardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSemesterLeaderboard not implemented")
+}
+func (UnimplementedGradesServiceServer) GetGradeFlexible(context.Context, *GetGradeFlexibleRequest) (*GetGradeFlexibleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGradeFlexible not implemented")
+}
+func (UnimplementedGradesServiceServer) SoftDeleteStudentGrades(context.Context, *SoftDeleteStudentGradesRequest) (*SoftDeleteStudentGradesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SoftDeleteStudentGrades not implemented")
+}
+func (UnimplementedGradesServiceServer) PreviewCurve(context.Context, *PreviewCurveRequest) (*PreviewCurveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PreviewCurve not implemented")
+}
+func (UnimplementedGradesServiceServer) GetGradePercentiles(context.Context, *GetGradePercentilesRequest) (*GetGradePercentilesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGradePercentiles not implemented")
+}
+func (UnimplementedGradesServiceServer) SetGradeTypeScheme(context.Context, *SetGradeTypeSchemeRequest) (*SetGradeTypeSchemeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetGradeTypeScheme not implemented")
+}
+func (UnimplementedGradesServiceServer) GetGradeTypeSchemes(context.Context, *GetGradeTypeSchemesRequest) (*GetGradeTypeSchemesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGradeTypeSchemes not implemented")
+}
+func (UnimplementedGradesServiceServer) SetAttemptPolicy(context.Context, *SetAttemptPolicyRequest) (*SetAttemptPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAttemptPolicy not implemented")
+}
+func (UnimplementedGradesServiceServer) GetGradeTimeline(context.Context, *GetGradeTimelineRequest) (*GetGradeTimelineResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGradeTimeline not implemented")
+}
+func (UnimplementedGradesServiceServer) GetCourseWeightedAverage(context.Context, *GetCourseWeightedAverageRequest) (*GetCourseWeightedAverageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCourseWeightedAverage not implemented")
+}
+func (UnimplementedGradesServiceServer) GetHonorRollStatus(context.Context, *GetHonorRollStatusRequest) (*GetHonorRollStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHonorRollStatus not implemented")
+}
+func (UnimplementedGradesServiceServer) GetCourseGradesChecksum(context.Context, *GetCourseGradesChecksumRequest) (*GetCourseGradesChecksumResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCourseGradesChecksum not implemented")
+}
+func (UnimplementedGradesServiceServer) SetNotificationDigest(context.Context, *SetNotificationDigestRequest) (*SetNotificationDigestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetNotificationDigest not implemented")
+}
+func (UnimplementedGradesServiceServer) GetProbationStatus(context.Context, *GetProbationStatusRequest) (*GetProbationStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProbationStatus not implemented")
+}
+func (UnimplementedGradesServiceServer) ExportCourseGradesSigned(context.Context, *ExportCourseGradesSignedRequest) (*ExportCourseGradesSignedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportCourseGradesSigned not implemented")
+}
+func (UnimplementedGradesServiceServer) UndoGradeChange(context.Context, *UndoGradeChangeRequest) (*UndoGradeChangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UndoGradeChange not implemented")
+}
+func (UnimplementedGradesServiceServer) ImportGradesCSV(context.Context, *ImportGradesCSVRequest) (*ImportGradesCSVResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportGradesCSV not implemented")
+}
+func (UnimplementedGradesServiceServer) FileAppeal(context.Context, *FileAppealRequest) (*FileAppealResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FileAppeal not implemented")
+}
+func (UnimplementedGradesServiceServer) ResolveAppeal(context.Context, *ResolveAppealRequest) (*ResolveAppealResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveAppeal not implemented")
+}
+func (UnimplementedGradesServiceServer) WatchCourseGrades(*WatchCourseGradesRequest, grpc.ServerStreamingServer[GradeChange]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchCourseGrades not implemented")
+}
+func (UnimplementedGradesServiceServer) ExportResearchDataset(context.Context, *ExportResearchDatasetRequest) (*ExportResearchDatasetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportResearchDataset not implemented")
+}
+func (UnimplementedGradesServiceServer) ExportTranscriptPDF(context.Context, *ExportTranscriptPDFRequest) (*ExportTranscriptPDFResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportTranscriptPDF not implemented")
+}
+func (UnimplementedGradesServiceServer) BatchAddGrades(context.Context, *BatchAddGradesRequest) (*BatchAddGradesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchAddGrades not implemented")
+}
+func (UnimplementedGradesServiceServer) NormalizeAcrossSections(context.Context, *NormalizeAcrossSectionsRequest) (*NormalizeAcrossSectionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NormalizeAcrossSections not implemented")
+}
+func (UnimplementedGradesServiceServer) GetStudentSemesterGPA(context.Context, *GetStudentSemesterGPARequest) (*GetStudentSemesterGPAResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStudentSemesterGPA not implemented")
+}
+func (UnimplementedGradesServiceServer) GetWeightedCourseRanking(context.Context, *GetWeightedCourseRankingRequest) (*GetWeightedCourseRankingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWeightedCourseRanking not implemented")
+}
+func (UnimplementedGradesServiceServer) GetCourseGradeStatistics(context.Context, *GetCourseGradeStatisticsRequest) (*GetCourseGradeStatisticsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCourseGradeStatistics not implemented")
+}
+func (UnimplementedGradesServiceServer) GenerateTestGrades(context.Context, *GenerateTestGradesRequest) (*GenerateTestGradesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GenerateTestGrades not implemented")
+}
+func (UnimplementedGradesServiceServer) mustEmbedUnimplementedGradesServiceServer() {}
+func (UnimplementedGradesServiceServer) testEmbeddedByValue()                       {}
+
+// UnsafeGradesServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to GradesServiceServer will
+// result in compilation errors.
+type UnsafeGradesServiceServer interface {
+	mustEmbedUnimplementedGradesServiceServer()
+}
+
+func RegisterGradesServiceServer(s grpc.ServiceRegistrar, srv GradesServiceServer) {
+	// If the following call pancis, it indicates UnimplementedGradesServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&GradesService_ServiceDesc, srv)
+}
+
+func _GradesService_GetCourseGrades_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCourseGradesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GradesServiceServer).GetCourseGrades(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GradesService_GetCourseGrades_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GradesServiceServer).GetCourseGrades(ctx, req.(*GetCourseGradesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GradesService_GetStudentCourseGrades_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStudentCourseGradesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GradesServiceServer).GetStudentCourseGrades(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GradesService_GetStudentCourseGrades_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GradesServiceServer).GetStudentCourseGrades(ctx, req.(*GetStudentCourseGradesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GradesService_AddSingleGrade_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddSingleGradeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GradesServiceServer).AddSingleGrade(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GradesService_AddSingleGrade_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GradesServiceServer).AddSingleGrade(ctx, req.(*AddSingleGradeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GradesService_UpdateSingleGrade_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSingleGradeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GradesServiceServer).UpdateSingleGrade(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GradesService_UpdateSingleGrade_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GradesServiceServer).UpdateSingleGrade(ctx, req.(*UpdateSingleGradeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GradesService_RemoveSingleGrade_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveSingleGradeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GradesServiceServer).RemoveSingleGrade(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GradesService_RemoveSingleGrade_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GradesServiceServer).RemoveSingleGrade(ctx, req.(*RemoveSingleGradeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GradesService_GetStudentSemesterGrades_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStudentSemesterGradesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GradesServiceServer).GetStudentSemesterGrades(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GradesService_GetStudentSemesterGrades_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GradesServiceServer).GetStudentSemesterGrades(ctx, req.(*GetStudentSemesterGradesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GradesService_ArchiveSemester_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArchiveSemesterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GradesServiceServer).ArchiveSemester(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GradesService_ArchiveSemester_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GradesServiceServer).ArchiveSemester(ctx, req.(*ArchiveSemesterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GradesService_GetGrade_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGradeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GradesServiceServer).GetGrade(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GradesService_GetGrade_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GradesServiceServer).GetGrade(ctx, req.(*GetGradeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GradesService_SearchGradeComments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchGradeCommentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GradesServiceServer).SearchGradeComments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GradesService_SearchGradeComments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GradesServiceServer).SearchGradeComments(ctx, req.(*SearchGradeCommentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GradesService_SetGradeFlag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetGradeFlagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GradesServiceServer).SetGradeFlag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GradesService_SetGradeFlag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GradesServiceServer).SetGradeFlag(ctx, req.(*SetGradeFlagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GradesService_GetFlaggedGrades_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFlaggedGradesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GradesServiceServer).GetFlaggedGrades(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GradesService_GetFlaggedGrades_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GradesServiceServer).GetFlaggedGrades(ctx, req.(*GetFlaggedGradesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GradesService_GetMultiCourseGrades_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMultiCourseGradesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GradesServiceServer).GetMultiCourseGrades(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GradesService_GetMultiCourseGrades_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GradesServiceServer).GetMultiCourseGrades(ctx, req.(*GetMultiCourseGradesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GradesService_SearchStudentGrades_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchStudentGradesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GradesServiceServer).SearchStudentGrades(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GradesService_SearchStudentGrades_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GradesServiceServer).SearchStudentGrades(ctx, req.(*SearchStudentGradesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GradesService_RetryFailedEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RetryFailedEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GradesServiceServer).RetryFailedEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GradesService_RetryFailedEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GradesServiceServer).RetryFailedEvents(ctx, req.(*RetryFailedEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GradesService_GetGradeProvenance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGradeProvenanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GradesServiceServer).GetGradeProvenance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GradesService_GetGradeProvenance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GradesServiceServer).GetGradeProvenance(ctx, req.(*GetGradeProvenanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GradesService_ProjectRequiredGrade_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProjectRequiredGradeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GradesServiceServer).ProjectRequiredGrade(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GradesService_ProjectRequiredGrade_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GradesServiceServer).ProjectRequiredGrade(ctx, req.(*ProjectRequiredGradeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GradesService_BatchAddGradesStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(BatchAddGradesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GradesServiceServer).BatchAddGradesStream(m, &grpc.GenericServerStream[BatchAddGradesRequest, BatchAddGradesProgress]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GradesService_BatchAddGradesStreamServer = grpc.ServerStreamingServer[BatchAddGradesProgress]
+
+func _GradesService_DetectGradeConflicts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DetectGradeConflictsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GradesServiceServer).DetectGradeConflicts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GradesService_DetectGradeConflicts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GradesServiceServer).DetectGradeConflicts(ctx, req.(*DetectGradeConflictsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GradesService_AssignDefaultToUngraded_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssignDefaultToUngradedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GradesServiceServer).AssignDefaultToUngraded(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GradesService_AssignDefaultToUngraded_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GradesServiceServer).AssignDefaultToUngraded(ctx, req.(*AssignDefaultToUngradedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GradesService_StreamCourseGrades_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamCourseGradesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GradesServiceServer).StreamCourseGrades(m, &grpc.GenericServerStream[StreamCourseGradesRequest, SingleGrade]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GradesService_StreamCourseGradesServer = grpc.ServerStreamingServer[SingleGrade]
+
+func _GradesService_GetGradingVelocity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGradingVelocityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GradesServiceServer).GetGradingVelocity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GradesService_GetGradingVelocity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GradesServiceServer).GetGradingVelocity(ctx, req.(*GetGradingVelocityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GradesService_PurgeTombstones_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeTombstonesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GradesServiceServer).PurgeTombstones(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GradesService_PurgeTombstones_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GradesServiceServer).PurgeTombstones(ctx, req.(*PurgeTombstonesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GradesService_CompareCourseSemesters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompareCourseSemestersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GradesServiceServer).CompareCourseSemesters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GradesService_CompareCourseSemesters_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GradesServiceServer).CompareCourseSemesters(ctx, req.(*CompareCourseSemestersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GradesService_GetSemesterGradeTypeSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSemesterGradeTypeSummaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GradesServiceServer).GetSemesterGradeTypeSummary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GradesService_GetSemesterGradeTypeSummary_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GradesServiceServer).GetSemesterGradeTypeSummary(ctx, req.(*GetSemesterGradeTypeSummaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GradesService_SetNotificationOptOut_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetNotificationOptOutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GradesServiceServer).SetNotificationOptOut(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GradesService_SetNotificationOptOut_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GradesServiceServer).SetNotificationOptOut(ctx, req.(*SetNotificationOptOutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GradesService_ExportCourseGradesXLSX_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportCourseGradesXLSXRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GradesServiceServer).ExportCourseGradesXLSX(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GradesService_ExportCourseGradesXLSX_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GradesServiceServer).ExportCourseGradesXLSX(ctx, req.(*ExportCourseGradesXLSXRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GradesService_GetStudentYearGrades_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStudentYearGradesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GradesServiceServer).GetStudentYearGrades(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GradesService_GetStudentYearGrades_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GradesServiceServer).GetStudentYearGrades(ctx, req.(*GetStudentYearGradesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GradesService_GetGradeValue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGradeValueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GradesServiceServer).GetGradeValue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GradesService_GetGradeValue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GradesServiceServer).GetGradeValue(ctx, req.(*GetGradeValueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GradesService_AddCourseGrader_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddCourseGraderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GradesServiceServer).AddCourseGrader(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GradesService_AddCourseGrader_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GradesServiceServer).AddCourseGrader(ctx, req.(*AddCourseGraderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GradesService_RemoveCourseGrader_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveCourseGraderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GradesServiceServer).RemoveCourseGrader(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GradesService_RemoveCourseGrader_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GradesServiceServer).RemoveCourseGrader(ctx, req.(*RemoveCourseGraderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GradesService_GetCourseGraders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCourseGradersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GradesServiceServer).GetCourseGraders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GradesService_GetCourseGraders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GradesServiceServer).GetCourseGraders(ctx, req.(*GetCourseGradersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GradesService_GetCourseStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCourseStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GradesServiceServer).GetCourseStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GradesService_GetCourseStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GradesServiceServer).GetCourseStats(ctx, req.(*GetCourseStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GradesService_RefreshCourseStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshCourseStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GradesServiceServer).RefreshCourseStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GradesService_RefreshCourseStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GradesServiceServer).RefreshCourseStats(ctx, req.(*RefreshCourseStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GradesService_ExportSemesterToBucket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportSemesterToBucketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GradesServiceServer).ExportSemesterToBucket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GradesService_ExportSemesterToBucket_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GradesServiceServer).ExportSemesterToBucket(ctx, req.(*ExportSemesterToBucketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GradesService_SimulateGradeChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SimulateGradeChangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GradesServiceServer).SimulateGradeChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GradesService_SimulateGradeChange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GradesServiceServer).SimulateGradeChange(ctx, req.(*SimulateGradeChangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GradesService_SetCourseReleaseTime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetCourseReleaseTimeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GradesServiceServer).SetCourseReleaseTime(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GradesService_SetCourseReleaseTime_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GradesServiceServer).SetCourseReleaseTime(ctx, req.(*SetCourseReleaseTimeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GradesService_GetSemesterLeaderboard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSemesterLeaderboardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GradesServiceServer).GetSemesterLeaderboard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GradesService_GetSemesterLeaderboard_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GradesServiceServer).GetSemesterLeaderboard(ctx, req.(*GetSemesterLeaderboardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GradesService_GetGradeFlexible_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGradeFlexibleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GradesServiceServer).GetGradeFlexible(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GradesService_GetGradeFlexible_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GradesServiceServer).GetGradeFlexible(ctx, req.(*GetGradeFlexibleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GradesService_SoftDeleteStudentGrades_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SoftDeleteStudentGradesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GradesServiceServer).SoftDeleteStudentGrades(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GradesService_SoftDeleteStudentGrades_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GradesServiceServer).SoftDeleteStudentGrades(ctx, req.(*SoftDeleteStudentGradesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GradesService_PreviewCurve_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PreviewCurveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GradesServiceServer).PreviewCurve(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GradesService_PreviewCurve_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GradesServiceServer).PreviewCurve(ctx, req.(*PreviewCurveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GradesService_GetGradePercentiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGradePercentilesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GradesServiceServer).GetGradePercentiles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GradesService_GetGradePercentiles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GradesServiceServer).GetGradePercentiles(ctx, req.(*GetGradePercentilesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GradesService_SetGradeTypeScheme_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetGradeTypeSchemeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GradesServiceServer).SetGradeTypeScheme(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GradesService_SetGradeTypeScheme_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GradesServiceServer).SetGradeTypeScheme(ctx, req.(*SetGradeTypeSchemeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GradesService_GetGradeTypeSchemes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGradeTypeSchemesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GradesServiceServer).GetGradeTypeSchemes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GradesService_GetGradeTypeSchemes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GradesServiceServer).GetGradeTypeSchemes(ctx, req.(*GetGradeTypeSchemesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GradesService_SetAttemptPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetAttemptPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GradesServiceServer).SetAttemptPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GradesService_SetAttemptPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GradesServiceServer).SetAttemptPolicy(ctx, req.(*SetAttemptPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GradesService_GetGradeTimeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGradeTimelineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GradesServiceServer).GetGradeTimeline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GradesService_GetGradeTimeline_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GradesServiceServer).GetGradeTimeline(ctx, req.(*GetGradeTimelineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GradesService_GetCourseWeightedAverage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCourseWeightedAverageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GradesServiceServer).GetCourseWeightedAverage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GradesService_GetCourseWeightedAverage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GradesServiceServer).GetCourseWeightedAverage(ctx, req.(*GetCourseWeightedAverageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GradesService_GetHonorRollStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHonorRollStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GradesServiceServer).GetHonorRollStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GradesService_GetHonorRollStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GradesServiceServer).GetHonorRollStatus(ctx, req.(*GetHonorRollStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GradesService_GetCourseGradesChecksum_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCourseGradesChecksumRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GradesServiceServer).GetCourseGradesChecksum(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GradesService_GetCourseGradesChecksum_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GradesServiceServer).GetCourseGradesChecksum(ctx, req.(*GetCourseGradesChecksumRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GradesService_SetNotificationDigest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetNotificationDigestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GradesServiceServer).SetNotificationDigest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GradesService_SetNotificationDigest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GradesServiceServer).SetNotificationDigest(ctx, req.(*SetNotificationDigestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GradesService_GetProbationStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProbationStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GradesServiceServer).GetProbationStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GradesService_GetProbationStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GradesServiceServer).GetProbationStatus(ctx, req.(*GetProbationStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GradesService_ExportCourseGradesSigned_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportCourseGradesSignedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GradesServiceServer).ExportCourseGradesSigned(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GradesService_ExportCourseGradesSigned_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GradesServiceServer).ExportCourseGradesSigned(ctx, req.(*ExportCourseGradesSignedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GradesService_UndoGradeChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UndoGradeChangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GradesServiceServer).UndoGradeChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GradesService_UndoGradeChange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GradesServiceServer).UndoGradeChange(ctx, req.(*UndoGradeChangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GradesService_ImportGradesCSV_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportGradesCSVRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GradesServiceServer).ImportGradesCSV(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GradesService_ImportGradesCSV_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GradesServiceServer).ImportGradesCSV(ctx, req.(*ImportGradesCSVRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GradesService_FileAppeal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FileAppealRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GradesServiceServer).FileAppeal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GradesService_FileAppeal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GradesServiceServer).FileAppeal(ctx, req.(*FileAppealRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GradesService_ResolveAppeal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveAppealRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GradesServiceServer).ResolveAppeal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GradesService_ResolveAppeal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GradesServiceServer).ResolveAppeal(ctx, req.(*ResolveAppealRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GradesService_WatchCourseGrades_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchCourseGradesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GradesServiceServer).WatchCourseGrades(m, &grpc.GenericServerStream[WatchCourseGradesRequest, GradeChange]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GradesService_WatchCourseGradesServer = grpc.ServerStreamingServer[GradeChange]
+
+func _GradesService_ExportResearchDataset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportResearchDatasetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GradesServiceServer).ExportResearchDataset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GradesService_ExportResearchDataset_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GradesServiceServer).ExportResearchDataset(ctx, req.(*ExportResearchDatasetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GradesService_ExportTranscriptPDF_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportTranscriptPDFRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GradesServiceServer).ExportTranscriptPDF(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GradesService_ExportTranscriptPDF_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GradesServiceServer).ExportTranscriptPDF(ctx, req.(*ExportTranscriptPDFRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GradesService_BatchAddGrades_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchAddGradesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GradesServiceServer).BatchAddGrades(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GradesService_BatchAddGrades_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GradesServiceServer).BatchAddGrades(ctx, req.(*BatchAddGradesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GradesService_NormalizeAcrossSections_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NormalizeAcrossSectionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GradesServiceServer).NormalizeAcrossSections(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GradesService_NormalizeAcrossSections_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GradesServiceServer).NormalizeAcrossSections(ctx, req.(*NormalizeAcrossSectionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GradesService_GetStudentSemesterGPA_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStudentSemesterGPARequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GradesServiceServer).GetStudentSemesterGPA(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GradesService_GetStudentSemesterGPA_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GradesServiceServer).GetStudentSemesterGPA(ctx, req.(*GetStudentSemesterGPARequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GradesService_GetWeightedCourseRanking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWeightedCourseRankingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GradesServiceServer).GetWeightedCourseRanking(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GradesService_GetWeightedCourseRanking_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GradesServiceServer).GetWeightedCourseRanking(ctx, req.(*GetWeightedCourseRankingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GradesService_GetCourseGradeStatistics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCourseGradeStatisticsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GradesServiceServer).GetCourseGradeStatistics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GradesService_GetCourseGradeStatistics_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GradesServiceServer).GetCourseGradeStatistics(ctx, req.(*GetCourseGradeStatisticsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GradesService_GenerateTestGrades_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenerateTestGradesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GradesServiceServer).GenerateTestGrades(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GradesService_GenerateTestGrades_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GradesServiceServer).GenerateTestGrades(ctx, req.(*GenerateTestGradesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// GradesService_ServiceDesc is the grpc.ServiceDesc for GradesService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
 var GradesService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "grades.GradesService",
+	ServiceName: "com.bettergr.grades.v1.GradesService",
 	HandlerType: (*GradesServiceServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
@@ -335,7 +2507,243 @@ var GradesService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetStudentSemesterGrades",
 			Handler:    _GradesService_GetStudentSemesterGrades_Handler,
 		},
+		{
+			MethodName: "ArchiveSemester",
+			Handler:    _GradesService_ArchiveSemester_Handler,
+		},
+		{
+			MethodName: "GetGrade",
+			Handler:    _GradesService_GetGrade_Handler,
+		},
+		{
+			MethodName: "SearchGradeComments",
+			Handler:    _GradesService_SearchGradeComments_Handler,
+		},
+		{
+			MethodName: "SetGradeFlag",
+			Handler:    _GradesService_SetGradeFlag_Handler,
+		},
+		{
+			MethodName: "GetFlaggedGrades",
+			Handler:    _GradesService_GetFlaggedGrades_Handler,
+		},
+		{
+			MethodName: "GetMultiCourseGrades",
+			Handler:    _GradesService_GetMultiCourseGrades_Handler,
+		},
+		{
+			MethodName: "SearchStudentGrades",
+			Handler:    _GradesService_SearchStudentGrades_Handler,
+		},
+		{
+			MethodName: "RetryFailedEvents",
+			Handler:    _GradesService_RetryFailedEvents_Handler,
+		},
+		{
+			MethodName: "GetGradeProvenance",
+			Handler:    _GradesService_GetGradeProvenance_Handler,
+		},
+		{
+			MethodName: "ProjectRequiredGrade",
+			Handler:    _GradesService_ProjectRequiredGrade_Handler,
+		},
+		{
+			MethodName: "DetectGradeConflicts",
+			Handler:    _GradesService_DetectGradeConflicts_Handler,
+		},
+		{
+			MethodName: "AssignDefaultToUngraded",
+			Handler:    _GradesService_AssignDefaultToUngraded_Handler,
+		},
+		{
+			MethodName: "GetGradingVelocity",
+			Handler:    _GradesService_GetGradingVelocity_Handler,
+		},
+		{
+			MethodName: "PurgeTombstones",
+			Handler:    _GradesService_PurgeTombstones_Handler,
+		},
+		{
+			MethodName: "CompareCourseSemesters",
+			Handler:    _GradesService_CompareCourseSemesters_Handler,
+		},
+		{
+			MethodName: "GetSemesterGradeTypeSummary",
+			Handler:    _GradesService_GetSemesterGradeTypeSummary_Handler,
+		},
+		{
+			MethodName: "SetNotificationOptOut",
+			Handler:    _GradesService_SetNotificationOptOut_Handler,
+		},
+		{
+			MethodName: "ExportCourseGradesXLSX",
+			Handler:    _GradesService_ExportCourseGradesXLSX_Handler,
+		},
+		{
+			MethodName: "GetStudentYearGrades",
+			Handler:    _GradesService_GetStudentYearGrades_Handler,
+		},
+		{
+			MethodName: "GetGradeValue",
+			Handler:    _GradesService_GetGradeValue_Handler,
+		},
+		{
+			MethodName: "AddCourseGrader",
+			Handler:    _GradesService_AddCourseGrader_Handler,
+		},
+		{
+			MethodName: "RemoveCourseGrader",
+			Handler:    _GradesService_RemoveCourseGrader_Handler,
+		},
+		{
+			MethodName: "GetCourseGraders",
+			Handler:    _GradesService_GetCourseGraders_Handler,
+		},
+		{
+			MethodName: "GetCourseStats",
+			Handler:    _GradesService_GetCourseStats_Handler,
+		},
+		{
+			MethodName: "RefreshCourseStats",
+			Handler:    _GradesService_RefreshCourseStats_Handler,
+		},
+		{
+			MethodName: "ExportSemesterToBucket",
+			Handler:    _GradesService_ExportSemesterToBucket_Handler,
+		},
+		{
+			MethodName: "SimulateGradeChange",
+			Handler:    _GradesService_SimulateGradeChange_Handler,
+		},
+		{
+			MethodName: "SetCourseReleaseTime",
+			Handler:    _GradesService_SetCourseReleaseTime_Handler,
+		},
+		{
+			MethodName: "GetSemesterLeaderboard",
+			Handler:    _GradesService_GetSemesterLeaderboard_Handler,
+		},
+		{
+			MethodName: "GetGradeFlexible",
+			Handler:    _GradesService_GetGradeFlexible_Handler,
+		},
+		{
+			MethodName: "SoftDeleteStudentGrades",
+			Handler:    _GradesService_SoftDeleteStudentGrades_Handler,
+		},
+		{
+			MethodName: "PreviewCurve",
+			Handler:    _GradesService_PreviewCurve_Handler,
+		},
+		{
+			MethodName: "GetGradePercentiles",
+			Handler:    _GradesService_GetGradePercentiles_Handler,
+		},
+		{
+			MethodName: "SetGradeTypeScheme",
+			Handler:    _GradesService_SetGradeTypeScheme_Handler,
+		},
+		{
+			MethodName: "GetGradeTypeSchemes",
+			Handler:    _GradesService_GetGradeTypeSchemes_Handler,
+		},
+		{
+			MethodName: "SetAttemptPolicy",
+			Handler:    _GradesService_SetAttemptPolicy_Handler,
+		},
+		{
+			MethodName: "GetGradeTimeline",
+			Handler:    _GradesService_GetGradeTimeline_Handler,
+		},
+		{
+			MethodName: "GetCourseWeightedAverage",
+			Handler:    _GradesService_GetCourseWeightedAverage_Handler,
+		},
+		{
+			MethodName: "GetHonorRollStatus",
+			Handler:    _GradesService_GetHonorRollStatus_Handler,
+		},
+		{
+			MethodName: "GetCourseGradesChecksum",
+			Handler:    _GradesService_GetCourseGradesChecksum_Handler,
+		},
+		{
+			MethodName: "SetNotificationDigest",
+			Handler:    _GradesService_SetNotificationDigest_Handler,
+		},
+		{
+			MethodName: "GetProbationStatus",
+			Handler:    _GradesService_GetProbationStatus_Handler,
+		},
+		{
+			MethodName: "ExportCourseGradesSigned",
+			Handler:    _GradesService_ExportCourseGradesSigned_Handler,
+		},
+		{
+			MethodName: "UndoGradeChange",
+			Handler:    _GradesService_UndoGradeChange_Handler,
+		},
+		{
+			MethodName: "ImportGradesCSV",
+			Handler:    _GradesService_ImportGradesCSV_Handler,
+		},
+		{
+			MethodName: "FileAppeal",
+			Handler:    _GradesService_FileAppeal_Handler,
+		},
+		{
+			MethodName: "ResolveAppeal",
+			Handler:    _GradesService_ResolveAppeal_Handler,
+		},
+		{
+			MethodName: "ExportResearchDataset",
+			Handler:    _GradesService_ExportResearchDataset_Handler,
+		},
+		{
+			MethodName: "ExportTranscriptPDF",
+			Handler:    _GradesService_ExportTranscriptPDF_Handler,
+		},
+		{
+			MethodName: "BatchAddGrades",
+			Handler:    _GradesService_BatchAddGrades_Handler,
+		},
+		{
+			MethodName: "NormalizeAcrossSections",
+			Handler:    _GradesService_NormalizeAcrossSections_Handler,
+		},
+		{
+			MethodName: "GetStudentSemesterGPA",
+			Handler:    _GradesService_GetStudentSemesterGPA_Handler,
+		},
+		{
+			MethodName: "GetWeightedCourseRanking",
+			Handler:    _GradesService_GetWeightedCourseRanking_Handler,
+		},
+		{
+			MethodName: "GetCourseGradeStatistics",
+			Handler:    _GradesService_GetCourseGradeStatistics_Handler,
+		},
+		{
+			MethodName: "GenerateTestGrades",
+			Handler:    _GradesService_GenerateTestGrades_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "BatchAddGradesStream",
+			Handler:       _GradesService_BatchAddGradesStream_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamCourseGrades",
+			Handler:       _GradesService_StreamCourseGrades_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchCourseGrades",
+			Handler:       _GradesService_WatchCourseGrades_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "grades-microservice.proto",
 }
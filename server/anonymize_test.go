@@ -0,0 +1,43 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	gpb "github.com/BetterGR/grades-microservice/protos"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStudentAnonymizerTokens(t *testing.T) {
+	anonymizer, err := newStudentAnonymizer()
+	require.NoError(t, err)
+
+	token := anonymizer.Token("student-1")
+	assert.True(t, strings.HasPrefix(token, anonymizedIDPrefix))
+	assert.NotContains(t, token, "student-1")
+	assert.Equal(t, token, anonymizer.Token("student-1"))
+	assert.NotEqual(t, token, anonymizer.Token("student-2"))
+
+	// A new export draws a new salt, so tokens cannot be linked across exports.
+	other, err := newStudentAnonymizer()
+	require.NoError(t, err)
+	assert.NotEqual(t, token, other.Token("student-1"))
+}
+
+func TestStudentAnonymizerKeepsGradeData(t *testing.T) {
+	anonymizer, err := newStudentAnonymizer()
+	require.NoError(t, err)
+
+	grades := []*gpb.SingleGrade{
+		{GradeID: "g1", StudentID: "student-1", ItemID: "hw1", GradeValue: "90"},
+		{GradeID: "g2", StudentID: "student-1", ItemID: "hw2", GradeValue: "75"},
+	}
+	anonymizer.Apply(grades)
+
+	assert.Equal(t, anonymizer.Token("student-1"), grades[0].GetStudentID())
+	assert.Equal(t, grades[0].GetStudentID(), grades[1].GetStudentID())
+	assert.Equal(t, "hw1", grades[0].GetItemID())
+	assert.Equal(t, "90", grades[0].GetGradeValue())
+	assert.Equal(t, "g2", grades[1].GetGradeID())
+}
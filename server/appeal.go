@@ -0,0 +1,177 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	gpb "github.com/BetterGR/grades-microservice/protos"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/klog/v2"
+)
+
+// Appeal statuses of a grade. A grade that was never appealed has an empty status; filing puts it
+// under appeal, and resolving accepts or rejects the appeal. A grade is appealed at most once.
+const (
+	appealUnderAppeal = "under_appeal"
+	appealAccepted    = "accepted"
+	appealRejected    = "rejected"
+)
+
+var (
+	ErrGradeUnderAppeal      = errors.New("grade is under appeal and locked until the appeal is resolved")
+	ErrAppealTransition      = errors.New("invalid appeal transition")
+	ErrAppealOutcomeInvalid  = errors.New("appeal outcome must be accepted or rejected")
+	ErrAppealValueRequired   = errors.New("accepting an appeal requires the new grade value")
+	ErrAppealValueUnexpected = errors.New("rejecting an appeal keeps the grade value")
+)
+
+// appealStatusName names an appeal status for error messages.
+func appealStatusName(appealStatus string) string {
+	if appealStatus == "" {
+		return "not appealed"
+	}
+
+	return appealStatus
+}
+
+// appealError maps the errors of an appeal transition to gRPC statuses.
+func appealError(action string, err error) error {
+	switch {
+	case errors.Is(err, ErrGradeNotFound):
+		return fmt.Errorf("failed to %s: %w", action, status.Error(codes.NotFound, err.Error()))
+	case errors.Is(err, ErrGradeIDEmpty):
+		return fmt.Errorf("failed to %s: %w", action, status.Error(codes.InvalidArgument, err.Error()))
+	case errors.Is(err, ErrAppealTransition):
+		return fmt.Errorf("failed to %s: %w", action, status.Error(codes.FailedPrecondition, err.Error()))
+	default:
+		return fmt.Errorf("failed to %s: %w", action, err)
+	}
+}
+
+// FileAppeal puts a grade under appeal. The grade is locked from edits, undos and replacements
+// until the appeal is resolved, and its status shows in every read. Students may only appeal their
+// own grades; staff and admins may file on a student's behalf.
+func (s *GradesServer) FileAppeal(ctx context.Context, req *gpb.FileAppealRequest) (*gpb.FileAppealResponse, error) {
+	claims, err := s.getClaims(ctx, req.GetToken())
+	if err != nil {
+		return nil, fmt.Errorf("authentication failed: %w",
+			status.Error(codes.Unauthenticated, err.Error()))
+	}
+
+	logger := klog.FromContext(ctx)
+	logger.V(logLevelDebug).Info("Received request to file appeal", "grade_id", req.GetGradeID())
+
+	view, err := s.gradeView(ctx, claims)
+	if err != nil {
+		return nil, err
+	}
+
+	grade, err := s.db.GetGradeByID(withPrimaryReads(ctx), req.GetGradeID())
+	staff := claims.HasRole(roleStaff) || claims.HasRole(roleAdmin)
+
+	// Students are denied alike for unknown grades and grades of others, so they cannot probe for
+	// the IDs of other students' grades.
+	if !staff && (errors.Is(err, ErrGradeNotFound) || err == nil && callerIdentity(claims) != grade.StudentID) {
+		return nil, fmt.Errorf("authorization failed: %w",
+			status.Error(codes.PermissionDenied, "students may only appeal their own grades"))
+	}
+
+	if err != nil {
+		return nil, appealError("file appeal", err)
+	}
+
+	// A grade still hidden from the student cannot be appealed yet.
+	if len(view.embargo.released([]*Grade{grade})) == 0 {
+		return nil, fmt.Errorf("failed to file appeal: %w", status.Error(codes.FailedPrecondition,
+			"grade is not released yet"))
+	}
+
+	appealed, err := s.db.TransitionAppeal(ctx, grade.GradeID, "", appealUnderAppeal, "")
+	if err != nil {
+		return nil, appealError("file appeal", err)
+	}
+
+	return &gpb.FileAppealResponse{Grade: s.gradeResponse(appealed, view)}, nil
+}
+
+// ResolveAppeal resolves the appeal of a grade under appeal, unlocking it. Accepting the appeal
+// replaces the grade value, recorded in the history like an update; rejecting it keeps the value.
+// Requires the staff role and the right to grade the course, or the admin role.
+func (s *GradesServer) ResolveAppeal(ctx context.Context,
+	req *gpb.ResolveAppealRequest,
+) (*gpb.ResolveAppealResponse, error) {
+	claims, err := s.getClaims(ctx, req.GetToken())
+	if err != nil {
+		return nil, fmt.Errorf("authentication failed: %w",
+			status.Error(codes.Unauthenticated, err.Error()))
+	}
+
+	ctx = withActor(ctx, callerIdentity(claims))
+
+	if !claims.HasRole(roleStaff) && !claims.HasRole(roleAdmin) {
+		return nil, fmt.Errorf("authorization failed: %w",
+			status.Error(codes.PermissionDenied, "caller lacks the required role"))
+	}
+
+	logger := klog.FromContext(ctx)
+	logger.V(logLevelDebug).Info("Received request to resolve appeal", "grade_id", req.GetGradeID(),
+		"outcome", req.GetOutcome())
+
+	switch {
+	case req.GetOutcome() != appealAccepted && req.GetOutcome() != appealRejected:
+		err = ErrAppealOutcomeInvalid
+	case req.GetOutcome() == appealAccepted && req.GetGradeValue() == "":
+		err = ErrAppealValueRequired
+	case req.GetOutcome() == appealRejected && req.GetGradeValue() != "":
+		err = ErrAppealValueUnexpected
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve appeal: %w", status.Error(codes.InvalidArgument, err.Error()))
+	}
+
+	view, err := s.gradeView(ctx, claims)
+	if err != nil {
+		return nil, err
+	}
+
+	grade, err := s.db.GetGradeByID(withPrimaryReads(ctx), req.GetGradeID())
+	if err != nil {
+		return nil, appealError("resolve appeal", err)
+	}
+
+	if err := s.authorizeGrader(ctx, claims, grade.CourseID); err != nil {
+		return nil, err
+	}
+
+	value := req.GetGradeValue()
+	if value != "" {
+		scheme, err := s.newGradeTypeSchemes().lookup(ctx, grade.CourseID, grade.GradeType)
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve appeal: %w", err)
+		}
+
+		if err := s.validator.ValidateGradeValueAs(scheme, value); err != nil {
+			return nil, fmt.Errorf("failed to resolve appeal: %w", status.Error(codes.InvalidArgument, err.Error()))
+		}
+
+		if s.roundOnStore {
+			value = s.rounder.Round(value)
+		}
+	}
+
+	resolved, err := s.db.TransitionAppeal(ctx, grade.GradeID, appealUnderAppeal, req.GetOutcome(), value)
+	if err != nil {
+		return nil, appealError("resolve appeal", err)
+	}
+
+	if value != "" {
+		s.publishEvent(ctx, newGradeEvent(eventGradeUpdated, resolved))
+	}
+
+	s.notifyStudent(ctx, resolved)
+
+	return &gpb.ResolveAppealResponse{Grade: s.gradeResponse(resolved, view)}, nil
+}
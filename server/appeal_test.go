@@ -0,0 +1,158 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	gpb "github.com/BetterGR/grades-microservice/protos"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// appealClients returns a client for the student owning grade and one for a grader, sharing db.
+func appealClients(t *testing.T, db *MockDatabase) (gpb.GradesServiceClient, gpb.GradesServiceClient) {
+	t.Helper()
+
+	student := setupClient(t, func(s *GradesServer) {
+		s.db = db
+		verifiedCaller(roleStudent)(s)
+	})
+	grader := setupClient(t, func(s *GradesServer) {
+		s.db = db
+		verifiedCaller(roleStaff)(s)
+	})
+
+	return student, grader
+}
+
+func TestFileAppeal(t *testing.T) {
+	db := NewMockDatabase()
+	student, grader := appealClients(t, db)
+
+	grade := createTestGrade()
+	grade.StudentID, grade.GradeValue = "student-1", "70"
+	_, err := db.AddGrade(context.Background(), grade)
+	require.NoError(t, err)
+
+	filed, err := student.FileAppeal(context.Background(), &gpb.FileAppealRequest{
+		Token: studentToken, GradeID: grade.GetGradeID(),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, appealUnderAppeal, filed.GetGrade().GetAppealStatus())
+
+	grades, err := student.GetStudentCourseGrades(context.Background(), &gpb.GetStudentCourseGradesRequest{
+		Token: studentToken, StudentID: "student-1", CourseID: grade.GetCourseID(), Semester: grade.GetSemester(),
+	})
+	require.NoError(t, err)
+	require.Len(t, grades.GetGrades(), 1)
+	assert.Equal(t, appealUnderAppeal, grades.GetGrades()[0].GetAppealStatus(), "students see their appeal")
+
+	_, err = student.FileAppeal(context.Background(), &gpb.FileAppealRequest{
+		Token: studentToken, GradeID: grade.GetGradeID(),
+	})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err), "a grade is appealed once")
+
+	// The grade is locked while under appeal.
+	_, err = grader.UpdateSingleGrade(context.Background(), &gpb.UpdateSingleGradeRequest{
+		Token: graderToken, Grade: &gpb.SingleGrade{GradeID: grade.GetGradeID(), GradeValue: "75"},
+	})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	_, err = grader.RemoveSingleGrade(context.Background(), &gpb.RemoveSingleGradeRequest{
+		Token: graderToken, GradeID: grade.GetGradeID(),
+	})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err), "a grade under appeal cannot be removed")
+
+	stored, err := db.GetGradeByID(context.Background(), grade.GetGradeID())
+	require.NoError(t, err)
+	assert.Equal(t, "70", stored.GradeValue)
+
+	other := createTestGrade()
+	_, err = db.AddGrade(context.Background(), other)
+	require.NoError(t, err)
+
+	_, err = student.FileAppeal(context.Background(), &gpb.FileAppealRequest{
+		Token: studentToken, GradeID: other.GetGradeID(),
+	})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err), "students may only appeal their own grades")
+
+	denied := err.Error()
+
+	_, err = student.FileAppeal(context.Background(), &gpb.FileAppealRequest{Token: studentToken, GradeID: "missing"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	assert.Equal(t, denied, err.Error(), "students cannot tell unknown grades from grades of others")
+
+	_, err = grader.FileAppeal(context.Background(), &gpb.FileAppealRequest{Token: graderToken, GradeID: "missing"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestResolveAppeal(t *testing.T) {
+	db := NewMockDatabase()
+	student, grader := appealClients(t, db)
+
+	accepted, rejected := createTestGrade(), createTestGrade()
+	for _, grade := range []*gpb.SingleGrade{accepted, rejected} {
+		grade.StudentID, grade.GradeValue = "student-1", "70"
+		_, err := db.AddGrade(context.Background(), grade)
+		require.NoError(t, err)
+	}
+
+	_, err := grader.ResolveAppeal(context.Background(), &gpb.ResolveAppealRequest{
+		Token: graderToken, GradeID: accepted.GetGradeID(), Outcome: appealAccepted, GradeValue: "85",
+	})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err), "only grades under appeal are resolved")
+
+	for _, grade := range []*gpb.SingleGrade{accepted, rejected} {
+		_, err := student.FileAppeal(context.Background(), &gpb.FileAppealRequest{
+			Token: studentToken, GradeID: grade.GetGradeID(),
+		})
+		require.NoError(t, err)
+	}
+
+	for name, req := range map[string]*gpb.ResolveAppealRequest{
+		"unknown outcome":         {GradeID: accepted.GetGradeID(), Outcome: "withdrawn"},
+		"accepted without value":  {GradeID: accepted.GetGradeID(), Outcome: appealAccepted},
+		"rejected with new value": {GradeID: rejected.GetGradeID(), Outcome: appealRejected, GradeValue: "85"},
+	} {
+		req.Token = graderToken
+		_, err := grader.ResolveAppeal(context.Background(), req)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err), name)
+	}
+
+	_, err = student.ResolveAppeal(context.Background(), &gpb.ResolveAppealRequest{
+		Token: studentToken, GradeID: accepted.GetGradeID(), Outcome: appealAccepted, GradeValue: "100",
+	})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err), "students cannot resolve their appeals")
+
+	resp, err := grader.ResolveAppeal(context.Background(), &gpb.ResolveAppealRequest{
+		Token: graderToken, GradeID: accepted.GetGradeID(), Outcome: appealAccepted, GradeValue: "85",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, appealAccepted, resp.GetGrade().GetAppealStatus())
+	assert.Equal(t, "85", resp.GetGrade().GetGradeValue())
+
+	versions, err := db.GetGradeVersions(context.Background(), accepted.GetGradeID())
+	require.NoError(t, err)
+	require.Len(t, versions, 2)
+	assert.Equal(t, "70", versions[0].GradeValue, "the appealed value is kept in the history")
+
+	resp, err = grader.ResolveAppeal(context.Background(), &gpb.ResolveAppealRequest{
+		Token: graderToken, GradeID: rejected.GetGradeID(), Outcome: appealRejected,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, appealRejected, resp.GetGrade().GetAppealStatus())
+	assert.Equal(t, "70", resp.GetGrade().GetGradeValue())
+
+	// Resolved grades can be edited again, but not resolved twice.
+	_, err = grader.UpdateSingleGrade(context.Background(), &gpb.UpdateSingleGradeRequest{
+		Token: graderToken, Grade: &gpb.SingleGrade{GradeID: rejected.GetGradeID(), GradeValue: "72"},
+	})
+	require.NoError(t, err)
+
+	_, err = grader.ResolveAppeal(context.Background(), &gpb.ResolveAppealRequest{
+		Token: graderToken, GradeID: rejected.GetGradeID(), Outcome: appealAccepted, GradeValue: "90",
+	})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
@@ -0,0 +1,162 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	gpb "github.com/BetterGR/grades-microservice/protos"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/klog/v2"
+)
+
+// AttemptPolicy decides how several grades of a student for the same item, one per attempt, become
+// the single grade of the item. The zero value keeps every attempt.
+type AttemptPolicy string
+
+const (
+	// attemptBest keeps the attempt with the highest numeric value.
+	attemptBest AttemptPolicy = "best"
+	// attemptLatest keeps the attempt graded last.
+	attemptLatest AttemptPolicy = "latest"
+	// attemptAverage averages the numeric attempts.
+	attemptAverage AttemptPolicy = "average"
+)
+
+var ErrAttemptPolicyInvalid = errors.New("invalid attempt policy")
+
+// parseAttemptPolicy parses a policy name, ignoring case. An empty name keeps every attempt.
+func parseAttemptPolicy(name string) (AttemptPolicy, error) {
+	switch policy := AttemptPolicy(strings.ToLower(strings.TrimSpace(name))); policy {
+	case "", attemptBest, attemptLatest, attemptAverage:
+		return policy, nil
+	default:
+		return "", fmt.Errorf("%w: %q", ErrAttemptPolicyInvalid, name)
+	}
+}
+
+// aggregate returns one grade per item of a student's grades, in the order each item first appears.
+// Grades without an item are never aggregated. Items without numeric attempts keep their latest
+// attempt under the best and average policies, and an average is reported on the latest attempt
+// with its value replaced; the stored grades are never modified.
+func (p AttemptPolicy) aggregate(grades []*Grade) []*Grade {
+	if p == "" {
+		return grades
+	}
+
+	attempts := make(map[string][]*Grade)
+	aggregated := make([]*Grade, 0, len(grades))
+
+	for _, grade := range grades {
+		if grade.ItemID == "" {
+			continue
+		}
+
+		attempts[grade.ItemID] = append(attempts[grade.ItemID], grade)
+	}
+
+	for _, grade := range grades {
+		if grade.ItemID == "" {
+			aggregated = append(aggregated, grade)
+
+			continue
+		}
+
+		itemAttempts, ok := attempts[grade.ItemID]
+		if !ok {
+			continue
+		}
+
+		delete(attempts, grade.ItemID)
+		aggregated = append(aggregated, p.combine(itemAttempts))
+	}
+
+	return aggregated
+}
+
+// combine returns the single grade of an item from its attempts.
+func (p AttemptPolicy) combine(attempts []*Grade) *Grade {
+	latest := attempts[0]
+
+	for _, attempt := range attempts[1:] {
+		if !attempt.GradedAt.Before(latest.GradedAt) {
+			latest = attempt
+		}
+	}
+
+	var (
+		best      *Grade
+		bestValue float64
+		sum       float64
+		numeric   int
+	)
+
+	for _, attempt := range attempts {
+		value, ok := parseNumericGrade(attempt.GradeValue)
+		if !ok {
+			continue
+		}
+
+		if best == nil || value > bestValue {
+			best, bestValue = attempt, value
+		}
+
+		sum += value
+		numeric++
+	}
+
+	switch {
+	case p == attemptBest && best != nil:
+		return best
+	case p == attemptAverage && numeric > 0:
+		average := *latest
+		average.GradeValue = strconv.FormatFloat(sum/float64(numeric), 'f', -1, 64)
+
+		return &average
+	default:
+		return latest
+	}
+}
+
+// attemptPolicyStatus reports invalid attempt policy requests as InvalidArgument.
+func attemptPolicyStatus(err error) error {
+	if errors.Is(err, ErrCourseIDEmpty) || errors.Is(err, ErrAttemptPolicyInvalid) {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return err
+}
+
+// SetAttemptPolicy makes the reads of a course's student grades aggregate several attempts at the
+// same item into one grade; an empty policy removes it, so every attempt is returned. Requires the
+// staff role and, for non-admins, permission to grade the course.
+func (s *GradesServer) SetAttemptPolicy(ctx context.Context,
+	req *gpb.SetAttemptPolicyRequest,
+) (*gpb.SetAttemptPolicyResponse, error) {
+	claims, err := s.authorizeRoles(ctx, req.GetToken(), roleStaff, roleAdmin)
+	if err != nil {
+		return nil, err
+	}
+
+	logger := klog.FromContext(ctx)
+	logger.V(logLevelDebug).Info("Received request to set attempt policy", "course_id", req.GetCourseID(),
+		"policy", req.GetPolicy())
+
+	policy, err := parseAttemptPolicy(req.GetPolicy())
+	if err != nil {
+		return nil, fmt.Errorf("failed to set attempt policy: %w", attemptPolicyStatus(err))
+	}
+
+	if err := s.authorizeGrader(ctx, claims, req.GetCourseID()); err != nil {
+		return nil, err
+	}
+
+	if err := s.db.SetAttemptPolicy(ctx, req.GetCourseID(), policy); err != nil {
+		return nil, fmt.Errorf("failed to set attempt policy: %w", attemptPolicyStatus(err))
+	}
+
+	return &gpb.SetAttemptPolicyResponse{}, nil
+}
@@ -0,0 +1,123 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	gpb "github.com/BetterGR/grades-microservice/protos"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestParseAttemptPolicy(t *testing.T) {
+	for name, want := range map[string]AttemptPolicy{
+		"": "", "best": attemptBest, " Latest ": attemptLatest, "AVERAGE": attemptAverage,
+	} {
+		policy, err := parseAttemptPolicy(name)
+		require.NoError(t, err, name)
+		assert.Equal(t, want, policy, name)
+	}
+
+	_, err := parseAttemptPolicy("worst")
+	require.ErrorIs(t, err, ErrAttemptPolicyInvalid)
+}
+
+func TestAttemptPolicyAggregate(t *testing.T) {
+	start := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	grades := []*Grade{
+		{GradeID: "hw1-a", ItemID: "hw1", GradeValue: "70", GradedAt: start},
+		{GradeID: "quiz", ItemID: "", GradeValue: "50", GradedAt: start},
+		{GradeID: "hw1-b", ItemID: "hw1", GradeValue: "90", GradedAt: start.Add(time.Hour)},
+		{GradeID: "hw1-c", ItemID: "hw1", GradeValue: "80", GradedAt: start.Add(2 * time.Hour)},
+		{GradeID: "lab-a", ItemID: "lab", GradeValue: "B", GradedAt: start.Add(time.Hour)},
+		{GradeID: "lab-b", ItemID: "lab", GradeValue: "A", GradedAt: start},
+	}
+
+	tests := []struct {
+		policy AttemptPolicy
+		ids    []string
+		values []string
+	}{
+		{policy: "", ids: []string{"hw1-a", "quiz", "hw1-b", "hw1-c", "lab-a", "lab-b"},
+			values: []string{"70", "50", "90", "80", "B", "A"}},
+		{policy: attemptBest, ids: []string{"hw1-b", "quiz", "lab-a"}, values: []string{"90", "50", "B"}},
+		{policy: attemptLatest, ids: []string{"hw1-c", "quiz", "lab-a"}, values: []string{"80", "50", "B"}},
+		{policy: attemptAverage, ids: []string{"hw1-c", "quiz", "lab-a"}, values: []string{"80", "50", "B"}},
+	}
+
+	for _, tt := range tests {
+		aggregated := tt.policy.aggregate(grades)
+
+		ids := make([]string, 0, len(aggregated))
+		values := make([]string, 0, len(aggregated))
+
+		for _, grade := range aggregated {
+			ids = append(ids, grade.GradeID)
+			values = append(values, grade.GradeValue)
+		}
+
+		assert.Equal(t, tt.ids, ids, tt.policy)
+		assert.Equal(t, tt.values, values, tt.policy)
+	}
+
+	assert.Equal(t, "80", grades[3].GradeValue, "averaging leaves the stored grades alone")
+
+	average := attemptAverage.aggregate([]*Grade{
+		{GradeID: "a", ItemID: "hw", GradeValue: "70"},
+		{GradeID: "b", ItemID: "hw", GradeValue: "75"},
+	})
+	require.Len(t, average, 1)
+	assert.Equal(t, "72.5", average[0].GradeValue)
+}
+
+func TestGetStudentCourseGradesAttemptPolicy(t *testing.T) {
+	db := NewMockDatabase()
+	client := setupClient(t, func(s *GradesServer) { s.db = db })
+	ctx := context.Background()
+	start := time.Now().Add(-time.Hour)
+
+	for i, value := range []string{"60", "95", "85"} {
+		grade := createTestGrade()
+		grade.StudentID, grade.CourseID, grade.ItemID, grade.GradeValue = "s1", "c1", "hw1", value
+
+		added, err := db.AddGrade(ctx, grade)
+		require.NoError(t, err)
+
+		added.GradedAt = start.Add(time.Duration(i) * time.Minute)
+	}
+
+	read := func(raw bool) *gpb.GetStudentCourseGradesResponse {
+		resp, err := client.GetStudentCourseGrades(ctx, &gpb.GetStudentCourseGradesRequest{
+			Token: "test-token", CourseID: "c1", Semester: "Winter_2023", StudentID: "s1", RawAttempts: raw,
+		})
+		require.NoError(t, err)
+
+		return resp
+	}
+
+	resp := read(false)
+	assert.Len(t, resp.GetGrades(), 3, "every attempt is returned without a policy")
+	assert.Empty(t, resp.GetAttemptPolicy())
+
+	for policy, want := range map[string]string{"best": "95", "latest": "85", "average": "80"} {
+		_, err := client.SetAttemptPolicy(ctx, &gpb.SetAttemptPolicyRequest{
+			Token: "test-token", CourseID: "c1", Policy: policy,
+		})
+		require.NoError(t, err, policy)
+
+		resp := read(false)
+		require.Len(t, resp.GetGrades(), 1, policy)
+		assert.Equal(t, want, resp.GetGrades()[0].GetGradeValue(), policy)
+		assert.Equal(t, policy, resp.GetAttemptPolicy())
+
+		assert.Len(t, read(true).GetGrades(), 3, "raw attempts stay available")
+	}
+
+	_, err := client.SetAttemptPolicy(ctx, &gpb.SetAttemptPolicyRequest{
+		Token: "test-token", CourseID: "c1", Policy: "worst",
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
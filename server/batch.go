@@ -0,0 +1,366 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	gpb "github.com/BetterGR/grades-microservice/protos"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/klog/v2"
+)
+
+const (
+	// batchAddChunkSize is the number of grades written per transaction and per progress update.
+	batchAddChunkSize = 100
+	// maxBatchGrades is the maximum number of grades accepted by a single batch add.
+	maxBatchGrades = 10000
+)
+
+var ErrTooManyGrades = errors.New("too many grades in batch")
+
+// validateNewGrade checks a grade of a batch before it is written, against the scheme of its grade
+// type when its course maps one, and checks its student is enrolled in the course.
+func (s *GradesServer) validateNewGrade(ctx context.Context, schemes *gradeTypeSchemes, grade *gpb.SingleGrade) error {
+	switch {
+	case grade == nil:
+		return fmt.Errorf("%w", ErrGradeNil)
+	case grade.GetStudentID() == "":
+		return fmt.Errorf("%w", ErrStudentIDEmpty)
+	case grade.GetCourseID() == "":
+		return fmt.Errorf("%w", ErrCourseIDEmpty)
+	}
+
+	scheme, err := schemes.lookup(ctx, grade.GetCourseID(), grade.GetGradeType())
+	if err != nil {
+		return err
+	}
+
+	if err := s.validator.ValidateGradeValueAs(scheme, grade.GetGradeValue()); err != nil {
+		return err
+	}
+
+	if err := s.validator.ValidateSemester(grade.GetSemester()); err != nil {
+		return err
+	}
+
+	if err := rubricFromProto(grade.GetRubricScores()).validate(); err != nil {
+		return err
+	}
+
+	if err := validateGradeTimes(grade); err != nil {
+		return err
+	}
+
+	return s.checkEnrollment(ctx, grade)
+}
+
+// addGradeChunk validates and adds a chunk of a batch in one transaction. offset is the index of
+// the chunk's first grade in the batch, used to report errors. Invalid grades, and grades of
+// courses the caller may not grade, are reported and skipped; when the transaction fails every
+// remaining grade of the chunk is reported as failed. An error is returned only when the context
+// was cancelled, aborting the batch.
+func (s *GradesServer) addGradeChunk(ctx context.Context, schemes *gradeTypeSchemes, graders *courseGraderChecks,
+	chunk []*gpb.SingleGrade, offset int,
+) (int, []*gpb.BatchGradeError, error) {
+	var (
+		valid   []*gpb.SingleGrade
+		indexes []int
+		errs    []*gpb.BatchGradeError
+	)
+
+	for i, grade := range chunk {
+		if err := s.validateNewGrade(ctx, schemes, grade); err != nil {
+			errs = append(errs, &gpb.BatchGradeError{Index: int32(offset + i), Message: err.Error()})
+
+			continue
+		}
+
+		if err := graders.authorize(ctx, grade.GetCourseID()); err != nil {
+			errs = append(errs, &gpb.BatchGradeError{Index: int32(offset + i), Message: err.Error()})
+
+			continue
+		}
+
+		if s.roundOnStore {
+			grade.GradeValue = s.rounder.Round(grade.GetGradeValue())
+		}
+
+		grade.Comments = sanitizeComment(grade.GetComments(), s.maxCommentNewlines)
+
+		valid = append(valid, grade)
+		indexes = append(indexes, offset+i)
+	}
+
+	if len(valid) == 0 {
+		return 0, errs, nil
+	}
+
+	added, err := s.db.AddGrades(ctx, valid)
+	if err != nil {
+		if ctx.Err() != nil {
+			return 0, errs, status.FromContextError(ctx.Err()).Err()
+		}
+
+		klog.FromContext(ctx).Error(err, "Failed to add batch chunk", "offset", offset, "size", len(chunk))
+
+		for _, index := range indexes {
+			errs = append(errs, &gpb.BatchGradeError{Index: int32(index), Message: err.Error()})
+		}
+
+		return 0, errs, nil
+	}
+
+	for _, grade := range added {
+		s.publishEvent(ctx, newGradeEvent(addedEventType(grade), grade))
+	}
+
+	return len(added), errs, nil
+}
+
+// BatchAddGradesStream adds a list of grades, streaming a progress update after every chunk and
+// ending with a summary marked done. Each chunk is written in its own transaction, so when the
+// client cancels, chunks already written are kept, the chunk in flight is rolled back and the
+// remaining grades are skipped. Grades of courses the caller may not grade are reported as failed.
+// Only staff and administrators may add grades in bulk.
+func (s *GradesServer) BatchAddGradesStream(req *gpb.BatchAddGradesRequest,
+	stream gpb.GradesService_BatchAddGradesStreamServer,
+) error {
+	ctx := stream.Context()
+
+	claims, err := s.authorizeRoles(ctx, req.GetToken(), roleStaff, roleAdmin)
+	if err != nil {
+		return err
+	}
+
+	ctx = withActor(ctx, callerIdentity(claims))
+
+	logger := klog.FromContext(ctx)
+	logger.V(logLevelDebug).Info("Received request for batch add grades", "count", len(req.GetGrades()))
+
+	grades := req.GetGrades()
+	if len(grades) > maxBatchGrades {
+		return fmt.Errorf("failed to batch add grades: %w", status.Error(codes.InvalidArgument,
+			fmt.Sprintf("%v: %d, maximum is %d", ErrTooManyGrades, len(grades), maxBatchGrades)))
+	}
+
+	var added, failed int
+
+	schemes := s.newGradeTypeSchemes()
+	graders := s.newCourseGraderChecks(claims)
+
+	for start := 0; start < len(grades); start += batchAddChunkSize {
+		if err := ctx.Err(); err != nil {
+			return fmt.Errorf("batch add aborted after %d grades: %w", start, status.FromContextError(err).Err())
+		}
+
+		end := min(start+batchAddChunkSize, len(grades))
+
+		chunkAdded, errs, err := s.addGradeChunk(ctx, schemes, graders, grades[start:end], start)
+		if err != nil {
+			return fmt.Errorf("batch add aborted after %d grades: %w", start, err)
+		}
+
+		added += chunkAdded
+		failed += len(errs)
+
+		if err := stream.Send(&gpb.BatchAddGradesProgress{
+			ProcessedCount: int32(end),
+			AddedCount:     int32(added),
+			ErrorCount:     int32(failed),
+			Errors:         errs,
+		}); err != nil {
+			return fmt.Errorf("failed to send batch progress: %w", err)
+		}
+	}
+
+	if err := stream.Send(&gpb.BatchAddGradesProgress{
+		ProcessedCount: int32(len(grades)),
+		AddedCount:     int32(added),
+		ErrorCount:     int32(failed),
+		Done:           true,
+	}); err != nil {
+		return fmt.Errorf("failed to send batch summary: %w", err)
+	}
+
+	return nil
+}
+
+// BatchAddGrades adds a list of grades atomically, for imports that must not be left half done.
+// Every grade is validated before anything is written, and the batch is rejected naming the first
+// invalid grade; valid batches are written in a single transaction, so a row failing in the
+// database rolls the whole batch back. The added grades are returned in request order. Only staff
+// and administrators may add grades in bulk.
+func (s *GradesServer) BatchAddGrades(ctx context.Context,
+	req *gpb.BatchAddGradesRequest,
+) (*gpb.BatchAddGradesResponse, error) {
+	claims, err := s.authorizeRoles(ctx, req.GetToken(), roleStaff, roleAdmin)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx = withActor(ctx, callerIdentity(claims))
+
+	logger := klog.FromContext(ctx)
+	logger.V(logLevelDebug).Info("Received request for atomic batch add grades", "count", len(req.GetGrades()))
+
+	grades := req.GetGrades()
+	if len(grades) > maxBatchGrades {
+		return nil, fmt.Errorf("failed to batch add grades: %w", status.Error(codes.InvalidArgument,
+			fmt.Sprintf("%v: %d, maximum is %d", ErrTooManyGrades, len(grades), maxBatchGrades)))
+	}
+
+	view, err := s.gradeView(ctx, claims)
+	if err != nil {
+		return nil, err
+	}
+
+	schemes := s.newGradeTypeSchemes()
+	authorized := make(map[string]bool)
+
+	for i, grade := range grades {
+		err := s.validateNewGrade(ctx, schemes, grade)
+
+		switch {
+		case errors.Is(err, ErrStudentNotEnrolled), errors.Is(err, ErrEnrollmentCheck):
+			return nil, fmt.Errorf("failed to batch add grade %d: %w", i, enrollmentStatus(err))
+		case err != nil:
+			return nil, fmt.Errorf("failed to batch add grade %d: %w", i,
+				status.Error(codes.InvalidArgument, err.Error()))
+		}
+
+		if !authorized[grade.GetCourseID()] {
+			if err := s.authorizeGrader(ctx, claims, grade.GetCourseID()); err != nil {
+				return nil, err
+			}
+
+			authorized[grade.GetCourseID()] = true
+		}
+
+		if s.roundOnStore {
+			grade.GradeValue = s.rounder.Round(grade.GetGradeValue())
+		}
+
+		grade.Comments = sanitizeComment(grade.GetComments(), s.maxCommentNewlines)
+	}
+
+	added, err := s.db.AddGrades(ctx, grades)
+	if errors.Is(err, ErrGradeAlreadyExists) {
+		return nil, fmt.Errorf("failed to batch add grades: %w", status.Error(codes.AlreadyExists, err.Error()))
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to batch add grades: %w", err)
+	}
+
+	resp := &gpb.BatchAddGradesResponse{Grades: make([]*gpb.SingleGrade, 0, len(added))}
+
+	for _, grade := range added {
+		s.publishEvent(ctx, newGradeEvent(addedEventType(grade), grade))
+		resp.Grades = append(resp.Grades, s.gradeResponse(grade, view))
+	}
+
+	return resp, nil
+}
+
+// ungradedStudents returns the students of the roster, in roster order and without duplicates or
+// empty IDs, who have no grade for the item.
+func ungradedStudents(roster []string, grades []*Grade, itemID string) []string {
+	graded := make(map[string]bool, len(grades))
+
+	for _, grade := range grades {
+		if grade.ItemID == itemID {
+			graded[grade.StudentID] = true
+		}
+	}
+
+	var ungraded []string
+
+	for _, studentID := range uniqueInOrder(roster) {
+		if studentID != "" && !graded[studentID] {
+			ungraded = append(ungraded, studentID)
+		}
+	}
+
+	return ungraded
+}
+
+// AssignDefaultToUngraded gives every student of the roster without a grade for an item the
+// default grade, adding all of them in one transaction. Requires the staff role and, for non-admins,
+// permission to grade the course.
+func (s *GradesServer) AssignDefaultToUngraded(ctx context.Context,
+	req *gpb.AssignDefaultToUngradedRequest,
+) (*gpb.AssignDefaultToUngradedResponse, error) {
+	claims, err := s.authorizeRoles(ctx, req.GetToken(), roleStaff, roleAdmin)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx = withActor(ctx, callerIdentity(claims))
+
+	logger := klog.FromContext(ctx)
+	logger.V(logLevelDebug).Info("Received request to assign default to ungraded", "course_id", req.GetCourseID(),
+		"semester", req.GetSemester(), "item_id", req.GetItemID(), "roster_size", len(req.GetStudentIDs()))
+
+	switch {
+	case req.GetCourseID() == "":
+		return nil, fmt.Errorf("failed to assign default grades: %w",
+			status.Error(codes.InvalidArgument, ErrCourseIDEmpty.Error()))
+	case strings.TrimSpace(req.GetItemID()) == "":
+		return nil, fmt.Errorf("failed to assign default grades: %w",
+			status.Error(codes.InvalidArgument, ErrItemIDEmpty.Error()))
+	case len(req.GetStudentIDs()) > maxBatchGrades:
+		return nil, fmt.Errorf("failed to assign default grades: %w", status.Error(codes.InvalidArgument,
+			fmt.Sprintf("%v: %d, maximum is %d", ErrTooManyGrades, len(req.GetStudentIDs()), maxBatchGrades)))
+	}
+
+	if err := s.authorizeGrader(ctx, claims, req.GetCourseID()); err != nil {
+		return nil, err
+	}
+
+	scheme, err := s.newGradeTypeSchemes().lookup(ctx, req.GetCourseID(), req.GetGradeType())
+	if err != nil {
+		return nil, fmt.Errorf("failed to assign default grades: %w", err)
+	}
+
+	if err := s.validator.ValidateGradeValueAs(scheme, req.GetDefaultValue()); err != nil {
+		return nil, fmt.Errorf("failed to assign default grades: %w",
+			status.Error(codes.InvalidArgument, err.Error()))
+	}
+
+	existing, err := s.db.GetCourseGrades(ctx, req.GetCourseID(), req.GetSemester())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get course grades: %w", err)
+	}
+
+	value := req.GetDefaultValue()
+	if s.roundOnStore {
+		value = s.rounder.Round(value)
+	}
+
+	students := ungradedStudents(req.GetStudentIDs(), existing, req.GetItemID())
+	defaults := make([]*gpb.SingleGrade, 0, len(students))
+
+	for _, studentID := range students {
+		defaults = append(defaults, &gpb.SingleGrade{
+			StudentID:  studentID,
+			CourseID:   req.GetCourseID(),
+			Semester:   req.GetSemester(),
+			GradeType:  req.GetGradeType(),
+			ItemID:     req.GetItemID(),
+			GradeValue: value,
+			GradedBy:   req.GetGradedBy(),
+		})
+	}
+
+	added, err := s.db.AddGrades(ctx, defaults)
+	if err != nil {
+		return nil, fmt.Errorf("failed to assign default grades: %w", err)
+	}
+
+	for _, grade := range added {
+		s.publishEvent(ctx, newGradeEvent(addedEventType(grade), grade))
+	}
+
+	return &gpb.AssignDefaultToUngradedResponse{InsertedCount: int32(len(added))}, nil
+}
@@ -0,0 +1,293 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+
+	gpb "github.com/BetterGR/grades-microservice/protos"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestBatchAddGradesStreamReportsProgress(t *testing.T) {
+	mockDB := NewMockDatabase()
+	client := setupClient(t, func(s *GradesServer) {
+		s.db = mockDB
+		s.validator = &Validator{scheme: schemeNumeric}
+	})
+
+	grades := make([]*gpb.SingleGrade, 250)
+	for i := range grades {
+		grades[i] = createTestGrade()
+		grades[i].GradeValue = "80"
+	}
+
+	grades[5].StudentID = ""
+	grades[120].GradeValue = "A"
+
+	stream, err := client.BatchAddGradesStream(context.Background(),
+		&gpb.BatchAddGradesRequest{Token: "test-token", Grades: grades})
+	require.NoError(t, err)
+
+	var updates []*gpb.BatchAddGradesProgress
+
+	for {
+		progress, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		require.NoError(t, err)
+
+		updates = append(updates, progress)
+	}
+
+	// One update per chunk of 100, then the summary.
+	require.Len(t, updates, 4)
+
+	assert.Equal(t, int32(100), updates[0].GetProcessedCount())
+	assert.Equal(t, int32(99), updates[0].GetAddedCount())
+	require.Len(t, updates[0].GetErrors(), 1)
+	assert.Equal(t, int32(5), updates[0].GetErrors()[0].GetIndex())
+
+	assert.Equal(t, int32(200), updates[1].GetProcessedCount())
+	assert.Equal(t, int32(2), updates[1].GetErrorCount())
+	require.Len(t, updates[1].GetErrors(), 1)
+	assert.Equal(t, int32(120), updates[1].GetErrors()[0].GetIndex())
+
+	assert.Equal(t, int32(250), updates[2].GetProcessedCount())
+	assert.Empty(t, updates[2].GetErrors())
+
+	for _, progress := range updates[:3] {
+		assert.False(t, progress.GetDone())
+	}
+
+	summary := updates[3]
+	assert.True(t, summary.GetDone())
+	assert.Equal(t, int32(250), summary.GetProcessedCount())
+	assert.Equal(t, int32(248), summary.GetAddedCount())
+	assert.Equal(t, int32(2), summary.GetErrorCount())
+	assert.Len(t, mockDB.grades, 248)
+}
+
+// cancellingStream cancels its context after the first progress update.
+type cancellingStream struct {
+	grpc.ServerStream
+	ctx     context.Context
+	cancel  context.CancelFunc
+	updates []*gpb.BatchAddGradesProgress
+}
+
+func (c *cancellingStream) Context() context.Context {
+	return c.ctx
+}
+
+func (c *cancellingStream) Send(progress *gpb.BatchAddGradesProgress) error {
+	c.updates = append(c.updates, progress)
+	c.cancel()
+
+	return nil
+}
+
+func TestBatchAddGradesStreamStopsWhenCancelled(t *testing.T) {
+	mockDB := NewMockDatabase()
+	server := &GradesServer{db: mockDB, Claims: MockClaims{}}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	stream := &cancellingStream{ctx: ctx, cancel: cancel}
+
+	grades := make([]*gpb.SingleGrade, 3*batchAddChunkSize)
+	for i := range grades {
+		grades[i] = createTestGrade()
+	}
+
+	err := server.BatchAddGradesStream(&gpb.BatchAddGradesRequest{Grades: grades}, stream)
+	assert.Equal(t, codes.Canceled, status.Code(err))
+
+	// The first chunk was committed before the cancellation; nothing after it was written.
+	require.Len(t, stream.updates, 1)
+	assert.Equal(t, int32(batchAddChunkSize), stream.updates[0].GetAddedCount())
+	assert.Len(t, mockDB.grades, batchAddChunkSize)
+}
+
+func TestBatchAddGradesStreamRejectsOversizedBatch(t *testing.T) {
+	client := setupClient(t)
+
+	stream, err := client.BatchAddGradesStream(context.Background(), &gpb.BatchAddGradesRequest{
+		Token: "test-token", Grades: make([]*gpb.SingleGrade, maxBatchGrades+1),
+	})
+	require.NoError(t, err)
+
+	_, err = stream.Recv()
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestBatchAddGradesStreamRequiresStaff(t *testing.T) {
+	db := NewMockDatabase()
+	client := setupClient(t, func(s *GradesServer) {
+		s.db = db
+		s.Claims = RoleClaims{role: "student"}
+	})
+
+	grade := createTestGrade()
+
+	stream, err := client.BatchAddGradesStream(context.Background(), &gpb.BatchAddGradesRequest{
+		Token: "test-token", Grades: []*gpb.SingleGrade{grade},
+	})
+	require.NoError(t, err)
+
+	_, err = stream.Recv()
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = db.GetGradeByID(context.Background(), grade.GetGradeID())
+	assert.ErrorIs(t, err, ErrGradeNotFound)
+}
+
+func TestBatchAddGrades(t *testing.T) {
+	db := NewMockDatabase()
+	client := setupClient(t, func(s *GradesServer) {
+		s.db = db
+	})
+
+	grades := []*gpb.SingleGrade{createTestGrade(), createTestGrade(), createTestGrade()}
+	for _, grade := range grades {
+		grade.GradeID = ""
+	}
+
+	resp, err := client.BatchAddGrades(context.Background(), &gpb.BatchAddGradesRequest{
+		Token: "test-token", Grades: grades,
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.GetGrades(), 3)
+
+	ids := make(map[string]bool)
+	for i, grade := range resp.GetGrades() {
+		assert.NotEmpty(t, grade.GetGradeID())
+		assert.Equal(t, grades[i].GetStudentID(), grade.GetStudentID(), "grades are returned in request order")
+		ids[grade.GetGradeID()] = true
+	}
+
+	assert.Len(t, ids, 3, "every grade gets its own generated ID")
+}
+
+func TestBatchAddGradesIsAtomic(t *testing.T) {
+	db := NewMockDatabase()
+	client := setupClient(t, func(s *GradesServer) {
+		s.db = db
+	})
+
+	valid, invalid := createTestGrade(), createTestGrade()
+	invalid.StudentID = ""
+
+	_, err := client.BatchAddGrades(context.Background(), &gpb.BatchAddGradesRequest{
+		Token: "test-token", Grades: []*gpb.SingleGrade{valid, invalid},
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.Contains(t, err.Error(), "grade 1")
+
+	_, err = db.GetGradeByID(context.Background(), valid.GetGradeID())
+	assert.ErrorIs(t, err, ErrGradeNotFound, "nothing is written when any grade is invalid")
+}
+
+func TestBatchAddGradesRequiresStaff(t *testing.T) {
+	db := NewMockDatabase()
+	client := setupClient(t, func(s *GradesServer) {
+		s.db = db
+		s.Claims = RoleClaims{role: roleStudent}
+	})
+
+	grade := createTestGrade()
+
+	_, err := client.BatchAddGrades(context.Background(), &gpb.BatchAddGradesRequest{
+		Token: "test-token", Grades: []*gpb.SingleGrade{grade},
+	})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = db.GetGradeByID(context.Background(), grade.GetGradeID())
+	assert.ErrorIs(t, err, ErrGradeNotFound)
+}
+
+func TestUngradedStudents(t *testing.T) {
+	grades := []*Grade{
+		{StudentID: "s1", ItemID: "hw1"},
+		{StudentID: "s2", ItemID: "hw2"},
+	}
+
+	assert.Equal(t, []string{"s2", "s3"}, ungradedStudents([]string{"s1", "s2", "", "s3", "s2"}, grades, "hw1"))
+	assert.Empty(t, ungradedStudents([]string{"s1"}, grades, "hw1"))
+}
+
+func TestAssignDefaultToUngraded(t *testing.T) {
+	mockDB := NewMockDatabase()
+	client := setupClient(t, func(s *GradesServer) {
+		s.db = mockDB
+		s.Claims = RoleClaims{role: roleStaff}
+	})
+
+	graded := createTestGrade()
+	graded.ItemID = "hw1"
+	graded.GradeValue = "95"
+	_, err := client.AddSingleGrade(context.Background(), &gpb.AddSingleGradeRequest{Token: "test-token", Grade: graded})
+	require.NoError(t, err)
+
+	// Graded for another item only, so it still gets the default for hw1.
+	otherItem := createTestGrade()
+	otherItem.CourseID = graded.GetCourseID()
+	otherItem.ItemID = "hw2"
+	_, err = client.AddSingleGrade(context.Background(), &gpb.AddSingleGradeRequest{
+		Token: "test-token", Grade: otherItem,
+	})
+	require.NoError(t, err)
+
+	req := &gpb.AssignDefaultToUngradedRequest{
+		Token:        "test-token",
+		CourseID:     graded.GetCourseID(),
+		Semester:     graded.GetSemester(),
+		ItemID:       "hw1",
+		GradeType:    "Homework",
+		StudentIDs:   []string{graded.GetStudentID(), otherItem.GetStudentID(), "s-missing"},
+		DefaultValue: "0",
+		GradedBy:     "Professor X",
+	}
+
+	resp, err := client.AssignDefaultToUngraded(context.Background(), req)
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), resp.GetInsertedCount())
+
+	byStudent := map[string]string{}
+
+	for _, grade := range mockDB.grades {
+		if grade.ItemID == "hw1" {
+			byStudent[grade.StudentID] = grade.GradeValue
+		}
+	}
+
+	assert.Equal(t, map[string]string{
+		graded.GetStudentID():    "95",
+		otherItem.GetStudentID(): "0",
+		"s-missing":              "0",
+	}, byStudent)
+
+	// Running it again finds nobody left to grade.
+	resp, err = client.AssignDefaultToUngraded(context.Background(), req)
+	require.NoError(t, err)
+	assert.Equal(t, int32(0), resp.GetInsertedCount())
+}
+
+func TestAssignDefaultToUngradedRequiresItem(t *testing.T) {
+	client := setupClient(t, func(s *GradesServer) {
+		s.Claims = RoleClaims{role: roleStaff}
+	})
+
+	_, err := client.AssignDefaultToUngraded(context.Background(), &gpb.AssignDefaultToUngradedRequest{
+		Token: "test-token", CourseID: "c1", StudentIDs: []string{"s1"}, DefaultValue: "0",
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
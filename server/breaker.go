@@ -0,0 +1,597 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	gpb "github.com/BetterGR/grades-microservice/protos"
+	"github.com/uptrace/bun/driver/pgdriver"
+)
+
+const (
+	// defaultBreakerWindow is the number of recent operations judged when DB_BREAKER_WINDOW is unset.
+	defaultBreakerWindow = 20
+	// defaultBreakerCooldown is how long an open circuit rejects operations when DB_BREAKER_COOLDOWN is unset.
+	defaultBreakerCooldown = 30 * time.Second
+)
+
+// ErrCircuitOpen is returned without touching the database while the circuit breaker is open.
+var ErrCircuitOpen = errors.New("database unavailable")
+
+// breakerState is the position of a circuitBreaker.
+type breakerState int
+
+const (
+	// breakerClosed lets every operation through and records its outcome.
+	breakerClosed breakerState = iota
+	// breakerOpen rejects every operation until the cooldown passes.
+	breakerOpen
+	// breakerHalfOpen lets a single probe through to decide whether to close again.
+	breakerHalfOpen
+)
+
+// circuitBreaker tracks the outcomes of the last window operations and opens once at least
+// failureRate of them failed. While open it rejects operations for cooldown, then admits a single
+// probe: a successful probe closes the circuit and a failed one opens it for another cooldown.
+type circuitBreaker struct {
+	failureRate float64
+	cooldown    time.Duration
+	now         func() time.Time
+
+	mu       sync.Mutex
+	state    breakerState
+	openedAt time.Time
+	probing  bool
+	outcomes []bool
+	next     int
+	recorded int
+	failures int
+}
+
+// newCircuitBreaker opens after failureRate of the last window operations failed and stays open for cooldown.
+func newCircuitBreaker(failureRate float64, window int, cooldown time.Duration) *circuitBreaker {
+	return &circuitBreaker{
+		failureRate: failureRate,
+		cooldown:    cooldown,
+		now:         time.Now,
+		outcomes:    make([]bool, max(window, 1)),
+	}
+}
+
+// allow reports whether an operation may run, moving an open circuit to half-open once the cooldown
+// has passed. It fails with ErrCircuitOpen while the circuit is open or a probe is in flight.
+func (b *circuitBreaker) allow() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch b.state {
+	case breakerClosed:
+		return nil
+	case breakerOpen:
+		if wait := b.openedAt.Add(b.cooldown).Sub(b.now()); wait > 0 {
+			return fmt.Errorf("%w: circuit open, retry in %s", ErrCircuitOpen, wait.Round(time.Second))
+		}
+
+		b.state = breakerHalfOpen
+	case breakerHalfOpen:
+		if b.probing {
+			return fmt.Errorf("%w: circuit half-open, probe in flight", ErrCircuitOpen)
+		}
+	}
+
+	b.probing = true
+
+	return nil
+}
+
+// record judges the outcome of an operation admitted by allow.
+func (b *circuitBreaker) record(err error) {
+	failed := isDatabaseFailure(err)
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.state == breakerHalfOpen {
+		b.probing = false
+
+		if failed {
+			b.trip()
+		} else {
+			b.reset()
+		}
+
+		return
+	}
+
+	if b.state != breakerClosed {
+		return
+	}
+
+	if b.recorded == len(b.outcomes) {
+		if b.outcomes[b.next] {
+			b.failures--
+		}
+	} else {
+		b.recorded++
+	}
+
+	b.outcomes[b.next] = failed
+	b.next = (b.next + 1) % len(b.outcomes)
+
+	if failed {
+		b.failures++
+	}
+
+	if b.recorded == len(b.outcomes) && float64(b.failures) >= b.failureRate*float64(b.recorded) {
+		b.trip()
+	}
+}
+
+// trip opens the circuit for a cooldown.
+func (b *circuitBreaker) trip() {
+	b.state = breakerOpen
+	b.openedAt = b.now()
+}
+
+// reset closes the circuit and forgets the outcomes recorded before it opened.
+func (b *circuitBreaker) reset() {
+	b.state = breakerClosed
+	b.next, b.recorded, b.failures = 0, 0, 0
+}
+
+// isDatabaseFailure reports whether err means the database itself is unreachable or unhealthy,
+// rather than rejecting a request, missing a row or losing a canceled caller.
+func isDatabaseFailure(err error) bool {
+	if err == nil || errors.Is(err, context.Canceled) || errors.Is(err, ErrDatabaseBusy) {
+		return false
+	}
+
+	var netErr net.Error
+	if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, driver.ErrBadConn) ||
+		errors.Is(err, sql.ErrConnDone) || errors.Is(err, io.ErrUnexpectedEOF) || errors.As(err, &netErr) {
+		return true
+	}
+
+	// Connection exceptions, insufficient resources and operator intervention such as a shutdown.
+	var pgErr pgdriver.Error
+	if errors.As(err, &pgErr) {
+		code := pgErr.Field('C')
+
+		return strings.HasPrefix(code, "08") || strings.HasPrefix(code, "53") || strings.HasPrefix(code, "57P")
+	}
+
+	return false
+}
+
+// breakerDatabase guards db with a circuit breaker, so an unhealthy database fails requests fast with
+// ErrCircuitOpen instead of letting each of them wait out its own timeout.
+type breakerDatabase struct {
+	db      DBInterface
+	breaker *circuitBreaker
+}
+
+// Verify that breakerDatabase implements DBInterface at compile time.
+var _ DBInterface = (*breakerDatabase)(nil)
+
+// newBreakerDatabase guards db with breaker.
+func newBreakerDatabase(db DBInterface, breaker *circuitBreaker) *breakerDatabase {
+	return &breakerDatabase{db: db, breaker: breaker}
+}
+
+// run calls fn unless the circuit is open and records its outcome.
+func (b *breakerDatabase) run(fn func() error) error {
+	if err := b.breaker.allow(); err != nil {
+		return err
+	}
+
+	err := fn()
+	b.breaker.record(err)
+
+	return err
+}
+
+// withBreaker calls fn unless the circuit of b is open, records its outcome and returns its result.
+func withBreaker[T any](b *breakerDatabase, fn func() (T, error)) (T, error) {
+	if err := b.breaker.allow(); err != nil {
+		var zero T
+
+		return zero, err
+	}
+
+	result, err := fn()
+	b.breaker.record(err)
+
+	return result, err
+}
+
+// AddGrade fails fast while the circuit is open.
+func (b *breakerDatabase) AddGrade(ctx context.Context, grade *gpb.SingleGrade) (*Grade, error) {
+	return withBreaker(b, func() (*Grade, error) {
+		return b.db.AddGrade(ctx, grade)
+	})
+}
+
+// AddGrades fails fast while the circuit is open.
+func (b *breakerDatabase) AddGrades(ctx context.Context, grades []*gpb.SingleGrade) ([]*Grade, error) {
+	return withBreaker(b, func() ([]*Grade, error) {
+		return b.db.AddGrades(ctx, grades)
+	})
+}
+
+// GetCourseGrades fails fast while the circuit is open.
+func (b *breakerDatabase) GetCourseGrades(ctx context.Context, courseID, semester string) ([]*Grade, error) {
+	return withBreaker(b, func() ([]*Grade, error) {
+		return b.db.GetCourseGrades(ctx, courseID, semester)
+	})
+}
+
+// StreamCourseGrades fails fast while the circuit is open.
+func (b *breakerDatabase) StreamCourseGrades(ctx context.Context,
+	courseID, semester string, fn func(*Grade) error,
+) error {
+	return b.run(func() error {
+		return b.db.StreamCourseGrades(ctx, courseID, semester, fn)
+	})
+}
+
+// StreamSemesterGrades fails fast while the circuit is open.
+func (b *breakerDatabase) StreamSemesterGrades(ctx context.Context, semester string, fn func(*Grade) error) error {
+	return b.run(func() error {
+		return b.db.StreamSemesterGrades(ctx, semester, fn)
+	})
+}
+
+// GetStudentCourseGrades fails fast while the circuit is open.
+func (b *breakerDatabase) GetStudentCourseGrades(ctx context.Context,
+	courseID, semester, studentID string,
+) ([]*Grade, error) {
+	return withBreaker(b, func() ([]*Grade, error) {
+		return b.db.GetStudentCourseGrades(ctx, courseID, semester, studentID)
+	})
+}
+
+// UpdateGrade fails fast while the circuit is open.
+func (b *breakerDatabase) UpdateGrade(ctx context.Context, grade *gpb.SingleGrade) (*Grade, error) {
+	return withBreaker(b, func() (*Grade, error) {
+		return b.db.UpdateGrade(ctx, grade)
+	})
+}
+
+// UndoGradeChange fails fast while the circuit is open.
+func (b *breakerDatabase) UndoGradeChange(ctx context.Context, gradeID string, version int64) (*Grade, error) {
+	return withBreaker(b, func() (*Grade, error) {
+		return b.db.UndoGradeChange(ctx, gradeID, version)
+	})
+}
+
+// TransitionAppeal fails fast while the circuit is open.
+func (b *breakerDatabase) TransitionAppeal(ctx context.Context, gradeID, from, to, value string) (*Grade, error) {
+	return withBreaker(b, func() (*Grade, error) {
+		return b.db.TransitionAppeal(ctx, gradeID, from, to, value)
+	})
+}
+
+// RemoveGrade fails fast while the circuit is open.
+func (b *breakerDatabase) RemoveGrade(ctx context.Context, gradeID string) error {
+	return b.run(func() error {
+		return b.db.RemoveGrade(ctx, gradeID)
+	})
+}
+
+// PurgeTombstones fails fast while the circuit is open.
+func (b *breakerDatabase) PurgeTombstones(ctx context.Context, cutoff time.Time) (int64, error) {
+	return withBreaker(b, func() (int64, error) {
+		return b.db.PurgeTombstones(ctx, cutoff)
+	})
+}
+
+// SoftDeleteStudentGrades fails fast while the circuit is open.
+func (b *breakerDatabase) SoftDeleteStudentGrades(ctx context.Context, studentID, actor string) ([]string, error) {
+	return withBreaker(b, func() ([]string, error) {
+		return b.db.SoftDeleteStudentGrades(ctx, studentID, actor)
+	})
+}
+
+// GetStudentSemesterGrades fails fast while the circuit is open.
+func (b *breakerDatabase) GetStudentSemesterGrades(ctx context.Context, studentID, semester string) ([]*Grade, error) {
+	return withBreaker(b, func() ([]*Grade, error) {
+		return b.db.GetStudentSemesterGrades(ctx, studentID, semester)
+	})
+}
+
+// GetStudentGrades fails fast while the circuit is open.
+func (b *breakerDatabase) GetStudentGrades(ctx context.Context, studentID string) ([]*Grade, error) {
+	return withBreaker(b, func() ([]*Grade, error) {
+		return b.db.GetStudentGrades(ctx, studentID)
+	})
+}
+
+// GetStudentGradesGrouped fails fast while the circuit is open.
+func (b *breakerDatabase) GetStudentGradesGrouped(ctx context.Context,
+	studentID string,
+) (map[string]map[string][]*Grade, error) {
+	return withBreaker(b, func() (map[string]map[string][]*Grade, error) {
+		return b.db.GetStudentGradesGrouped(ctx, studentID)
+	})
+}
+
+// ArchiveSemester fails fast while the circuit is open.
+func (b *breakerDatabase) ArchiveSemester(ctx context.Context, semester string) (int64, error) {
+	return withBreaker(b, func() (int64, error) {
+		return b.db.ArchiveSemester(ctx, semester)
+	})
+}
+
+// GetArchivedCourseGrades fails fast while the circuit is open.
+func (b *breakerDatabase) GetArchivedCourseGrades(ctx context.Context, courseID, semester string) ([]*Grade, error) {
+	return withBreaker(b, func() ([]*Grade, error) {
+		return b.db.GetArchivedCourseGrades(ctx, courseID, semester)
+	})
+}
+
+// GetGradeByID fails fast while the circuit is open.
+func (b *breakerDatabase) GetGradeByID(ctx context.Context, gradeID string) (*Grade, error) {
+	return withBreaker(b, func() (*Grade, error) {
+		return b.db.GetGradeByID(ctx, gradeID)
+	})
+}
+
+// AddCourseGrader fails fast while the circuit is open.
+func (b *breakerDatabase) AddCourseGrader(ctx context.Context, courseID, graderID string) error {
+	return b.run(func() error {
+		return b.db.AddCourseGrader(ctx, courseID, graderID)
+	})
+}
+
+// RemoveCourseGrader fails fast while the circuit is open.
+func (b *breakerDatabase) RemoveCourseGrader(ctx context.Context, courseID, graderID string) error {
+	return b.run(func() error {
+		return b.db.RemoveCourseGrader(ctx, courseID, graderID)
+	})
+}
+
+// GetCourseGraders fails fast while the circuit is open.
+func (b *breakerDatabase) GetCourseGraders(ctx context.Context, courseID string) ([]string, error) {
+	return withBreaker(b, func() ([]string, error) {
+		return b.db.GetCourseGraders(ctx, courseID)
+	})
+}
+
+// SetCourseRelease fails fast while the circuit is open.
+func (b *breakerDatabase) SetCourseRelease(ctx context.Context, courseID, semester string, releaseAt time.Time) error {
+	return b.run(func() error {
+		return b.db.SetCourseRelease(ctx, courseID, semester, releaseAt)
+	})
+}
+
+// GetPendingReleases fails fast while the circuit is open.
+func (b *breakerDatabase) GetPendingReleases(ctx context.Context, now time.Time) ([]*CourseRelease, error) {
+	return withBreaker(b, func() ([]*CourseRelease, error) {
+		return b.db.GetPendingReleases(ctx, now)
+	})
+}
+
+// SetGradeTypeScheme fails fast while the circuit is open.
+func (b *breakerDatabase) SetGradeTypeScheme(ctx context.Context, courseID, gradeType string,
+	scheme GradeScheme,
+) error {
+	return b.run(func() error {
+		return b.db.SetGradeTypeScheme(ctx, courseID, gradeType, scheme)
+	})
+}
+
+// GetGradeTypeSchemes fails fast while the circuit is open.
+func (b *breakerDatabase) GetGradeTypeSchemes(ctx context.Context, courseID string) (map[string]GradeScheme, error) {
+	return withBreaker(b, func() (map[string]GradeScheme, error) {
+		return b.db.GetGradeTypeSchemes(ctx, courseID)
+	})
+}
+
+// SetAttemptPolicy fails fast while the circuit is open.
+func (b *breakerDatabase) SetAttemptPolicy(ctx context.Context, courseID string, policy AttemptPolicy) error {
+	return b.run(func() error {
+		return b.db.SetAttemptPolicy(ctx, courseID, policy)
+	})
+}
+
+// GetAttemptPolicy fails fast while the circuit is open.
+func (b *breakerDatabase) GetAttemptPolicy(ctx context.Context, courseID string) (AttemptPolicy, error) {
+	return withBreaker(b, func() (AttemptPolicy, error) {
+		return b.db.GetAttemptPolicy(ctx, courseID)
+	})
+}
+
+// RefreshCourseStats fails fast while the circuit is open.
+func (b *breakerDatabase) RefreshCourseStats(ctx context.Context) error {
+	return b.run(func() error {
+		return b.db.RefreshCourseStats(ctx)
+	})
+}
+
+// GetCourseStats fails fast while the circuit is open.
+func (b *breakerDatabase) GetCourseStats(ctx context.Context,
+	courseID, semester string, maxStaleness time.Duration,
+) (*CourseStats, error) {
+	return withBreaker(b, func() (*CourseStats, error) {
+		return b.db.GetCourseStats(ctx, courseID, semester, maxStaleness)
+	})
+}
+
+// GetGradeValue fails fast while the circuit is open.
+func (b *breakerDatabase) GetGradeValue(ctx context.Context, key GradeKey) (string, error) {
+	return withBreaker(b, func() (string, error) {
+		return b.db.GetGradeValue(ctx, key)
+	})
+}
+
+// GetGradeVersion fails fast while the circuit is open.
+func (b *breakerDatabase) GetGradeVersion(ctx context.Context, gradeID string, version int64) (*Grade, error) {
+	return withBreaker(b, func() (*Grade, error) {
+		return b.db.GetGradeVersion(ctx, gradeID, version)
+	})
+}
+
+// SearchGradeComments fails fast while the circuit is open.
+func (b *breakerDatabase) SearchGradeComments(ctx context.Context, courseID, semester, term string) ([]*Grade, error) {
+	return withBreaker(b, func() ([]*Grade, error) {
+		return b.db.SearchGradeComments(ctx, courseID, semester, term)
+	})
+}
+
+// GetCourseGradesETag fails fast while the circuit is open.
+func (b *breakerDatabase) GetCourseGradesETag(ctx context.Context, courseID, semester string) (string, error) {
+	return withBreaker(b, func() (string, error) {
+		return b.db.GetCourseGradesETag(ctx, courseID, semester)
+	})
+}
+
+// SetGradeFlag fails fast while the circuit is open.
+func (b *breakerDatabase) SetGradeFlag(ctx context.Context, gradeID string, flagged bool) (*Grade, error) {
+	return withBreaker(b, func() (*Grade, error) {
+		return b.db.SetGradeFlag(ctx, gradeID, flagged)
+	})
+}
+
+// GetFlaggedGrades fails fast while the circuit is open.
+func (b *breakerDatabase) GetFlaggedGrades(ctx context.Context, courseID, semester string) ([]*Grade, error) {
+	return withBreaker(b, func() ([]*Grade, error) {
+		return b.db.GetFlaggedGrades(ctx, courseID, semester)
+	})
+}
+
+// GetMultiCourseGrades fails fast while the circuit is open.
+func (b *breakerDatabase) GetMultiCourseGrades(ctx context.Context,
+	courseIDs []string, semester string,
+) (map[string][]*Grade, error) {
+	return withBreaker(b, func() (map[string][]*Grade, error) {
+		return b.db.GetMultiCourseGrades(ctx, courseIDs, semester)
+	})
+}
+
+// GetCourseGradesBySemester fails fast while the circuit is open.
+func (b *breakerDatabase) GetCourseGradesBySemester(ctx context.Context,
+	courseID string, semesters []string,
+) (map[string][]*Grade, error) {
+	return withBreaker(b, func() (map[string][]*Grade, error) {
+		return b.db.GetCourseGradesBySemester(ctx, courseID, semesters)
+	})
+}
+
+// GetStudentGradesBySemester fails fast while the circuit is open.
+func (b *breakerDatabase) GetStudentGradesBySemester(ctx context.Context,
+	studentID string, semesters []string,
+) (map[string][]*Grade, error) {
+	return withBreaker(b, func() (map[string][]*Grade, error) {
+		return b.db.GetStudentGradesBySemester(ctx, studentID, semesters)
+	})
+}
+
+// SearchStudentGrades fails fast while the circuit is open.
+func (b *breakerDatabase) SearchStudentGrades(ctx context.Context, prefix string, limit int) ([]*Grade, error) {
+	return withBreaker(b, func() ([]*Grade, error) {
+		return b.db.SearchStudentGrades(ctx, prefix, limit)
+	})
+}
+
+// RecordFailedEvent fails fast while the circuit is open.
+func (b *breakerDatabase) RecordFailedEvent(ctx context.Context, event *FailedEvent) error {
+	return b.run(func() error {
+		return b.db.RecordFailedEvent(ctx, event)
+	})
+}
+
+// GetFailedEvents fails fast while the circuit is open.
+func (b *breakerDatabase) GetFailedEvents(ctx context.Context, limit int) ([]*FailedEvent, error) {
+	return withBreaker(b, func() ([]*FailedEvent, error) {
+		return b.db.GetFailedEvents(ctx, limit)
+	})
+}
+
+// RecordFailedEventAttempt fails fast while the circuit is open.
+func (b *breakerDatabase) RecordFailedEventAttempt(ctx context.Context, eventID int64, lastError string) error {
+	return b.run(func() error {
+		return b.db.RecordFailedEventAttempt(ctx, eventID, lastError)
+	})
+}
+
+// RemoveFailedEvent fails fast while the circuit is open.
+func (b *breakerDatabase) RemoveFailedEvent(ctx context.Context, eventID int64) error {
+	return b.run(func() error {
+		return b.db.RemoveFailedEvent(ctx, eventID)
+	})
+}
+
+// GetGradeVersions fails fast while the circuit is open.
+func (b *breakerDatabase) GetGradeVersions(ctx context.Context, gradeID string) ([]*Grade, error) {
+	return withBreaker(b, func() ([]*Grade, error) {
+		return b.db.GetGradeVersions(ctx, gradeID)
+	})
+}
+
+// GetCourseGradeVersions fails fast while the circuit is open.
+func (b *breakerDatabase) GetCourseGradeVersions(ctx context.Context,
+	courseID, semester string,
+) (map[string][]*Grade, error) {
+	return withBreaker(b, func() (map[string][]*Grade, error) {
+		return b.db.GetCourseGradeVersions(ctx, courseID, semester)
+	})
+}
+
+// RecordAudit fails fast while the circuit is open.
+func (b *breakerDatabase) RecordAudit(ctx context.Context, entry *AuditEntry) error {
+	return b.run(func() error {
+		return b.db.RecordAudit(ctx, entry)
+	})
+}
+
+// GetGradeAudit fails fast while the circuit is open.
+func (b *breakerDatabase) GetGradeAudit(ctx context.Context, gradeID string) ([]*AuditEntry, error) {
+	return withBreaker(b, func() ([]*AuditEntry, error) {
+		return b.db.GetGradeAudit(ctx, gradeID)
+	})
+}
+
+// GetSemesterGradeTypeSummary fails fast while the circuit is open.
+func (b *breakerDatabase) GetSemesterGradeTypeSummary(ctx context.Context,
+	semester string,
+) ([]*GradeTypeSummary, error) {
+	return withBreaker(b, func() ([]*GradeTypeSummary, error) {
+		return b.db.GetSemesterGradeTypeSummary(ctx, semester)
+	})
+}
+
+// SetNotificationOptOut fails fast while the circuit is open.
+func (b *breakerDatabase) SetNotificationOptOut(ctx context.Context, studentID string, optOut bool) error {
+	return b.run(func() error {
+		return b.db.SetNotificationOptOut(ctx, studentID, optOut)
+	})
+}
+
+// IsNotificationOptedOut fails fast while the circuit is open.
+func (b *breakerDatabase) IsNotificationOptedOut(ctx context.Context, studentID string) (bool, error) {
+	return withBreaker(b, func() (bool, error) {
+		return b.db.IsNotificationOptedOut(ctx, studentID)
+	})
+}
+
+// SetNotificationDigest fails fast while the circuit is open.
+func (b *breakerDatabase) SetNotificationDigest(ctx context.Context, studentID string, digest bool) error {
+	return b.run(func() error {
+		return b.db.SetNotificationDigest(ctx, studentID, digest)
+	})
+}
+
+// IsNotificationDigestEnabled fails fast while the circuit is open.
+func (b *breakerDatabase) IsNotificationDigestEnabled(ctx context.Context, studentID string) (bool, error) {
+	return withBreaker(b, func() (bool, error) {
+		return b.db.IsNotificationDigestEnabled(ctx, studentID)
+	})
+}
@@ -0,0 +1,133 @@
+package main
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"testing"
+	"time"
+
+	gpb "github.com/BetterGR/grades-microservice/protos"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// flakyDatabase fails GetCourseGrades with err while it is set and counts the calls that reach it.
+type flakyDatabase struct {
+	*MockDatabase
+	err   error
+	calls int
+}
+
+func (f *flakyDatabase) GetCourseGrades(ctx context.Context, courseID, semester string) ([]*Grade, error) {
+	f.calls++
+
+	if f.err != nil {
+		return nil, f.err
+	}
+
+	return f.MockDatabase.GetCourseGrades(ctx, courseID, semester)
+}
+
+// newTestBreaker guards a flakyDatabase with a breaker over a window of 4 operations and a fake clock.
+func newTestBreaker() (*breakerDatabase, *flakyDatabase, *time.Time) {
+	flaky := &flakyDatabase{MockDatabase: NewMockDatabase()}
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	breaker := newCircuitBreaker(0.5, 4, time.Minute)
+	breaker.now = func() time.Time { return now }
+
+	return newBreakerDatabase(flaky, breaker), flaky, &now
+}
+
+func TestCircuitBreakerTransitions(t *testing.T) {
+	guarded, flaky, now := newTestBreaker()
+	ctx := context.Background()
+
+	get := func() error {
+		_, err := guarded.GetCourseGrades(ctx, "course", "Winter_2023")
+
+		return err
+	}
+
+	// Closed: one failure in a full window of four stays under the rate.
+	for range 3 {
+		require.NoError(t, get())
+	}
+
+	flaky.err = driver.ErrBadConn
+	require.ErrorIs(t, get(), driver.ErrBadConn)
+	assert.Equal(t, breakerClosed, guarded.breaker.state)
+
+	// A second failure makes half of the last four operations fail, which opens the circuit.
+	require.ErrorIs(t, get(), driver.ErrBadConn)
+	assert.Equal(t, breakerOpen, guarded.breaker.state)
+
+	calls := flaky.calls
+	require.ErrorIs(t, get(), ErrCircuitOpen)
+	assert.Equal(t, calls, flaky.calls, "an open circuit does not reach the database")
+
+	// Half-open: after the cooldown a failed probe opens the circuit for another cooldown.
+	*now = now.Add(time.Minute)
+	require.ErrorIs(t, get(), driver.ErrBadConn)
+	assert.Equal(t, breakerOpen, guarded.breaker.state)
+	require.ErrorIs(t, get(), ErrCircuitOpen)
+
+	// A successful probe closes it again with a fresh window.
+	*now = now.Add(time.Minute)
+	flaky.err = nil
+	require.NoError(t, get())
+	assert.Equal(t, breakerClosed, guarded.breaker.state)
+
+	flaky.err = driver.ErrBadConn
+	require.ErrorIs(t, get(), driver.ErrBadConn)
+	assert.Equal(t, breakerClosed, guarded.breaker.state, "earlier failures are forgotten once closed")
+}
+
+func TestCircuitBreakerSingleProbe(t *testing.T) {
+	breaker := newCircuitBreaker(0.5, 1, time.Minute)
+	now := time.Now()
+	breaker.now = func() time.Time { return now }
+
+	require.NoError(t, breaker.allow())
+	breaker.record(driver.ErrBadConn)
+	require.ErrorIs(t, breaker.allow(), ErrCircuitOpen)
+
+	now = now.Add(time.Minute)
+	require.NoError(t, breaker.allow())
+	assert.Equal(t, breakerHalfOpen, breaker.state)
+	require.ErrorIs(t, breaker.allow(), ErrCircuitOpen, "only one probe runs at a time")
+
+	breaker.record(nil)
+	require.NoError(t, breaker.allow())
+}
+
+func TestCircuitBreakerIgnoresRequestErrors(t *testing.T) {
+	guarded, flaky, _ := newTestBreaker()
+
+	for _, err := range []error{ErrGradeNotFound, context.Canceled, ErrDatabaseBusy, errors.New("bad input")} {
+		flaky.err = err
+		for range 4 {
+			_, got := guarded.GetCourseGrades(context.Background(), "course", "Winter_2023")
+			require.ErrorIs(t, got, err)
+		}
+	}
+
+	assert.Equal(t, breakerClosed, guarded.breaker.state)
+}
+
+func TestCircuitOpenStatus(t *testing.T) {
+	guarded, _, _ := newTestBreaker()
+	guarded.breaker.trip()
+
+	client := setupClient(t, func(s *GradesServer) {
+		s.db = guarded
+	})
+
+	_, err := client.GetCourseGrades(context.Background(), &gpb.GetCourseGradesRequest{
+		Token: "test-token", CourseID: "course", Semester: "Winter_2023",
+	})
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+	assert.Contains(t, status.Convert(err).Message(), "database unavailable")
+}
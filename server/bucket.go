@@ -0,0 +1,258 @@
+package main
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	gpb "github.com/BetterGR/grades-microservice/protos"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/klog/v2"
+)
+
+// Semester export formats.
+const (
+	exportFormatCSV   = "csv"
+	exportFormatJSONL = "jsonl"
+)
+
+var (
+	ErrExportFormatInvalid      = errors.New("export format must be csv or jsonl")
+	ErrObjectStoreNotConfigured = errors.New("no export bucket is configured")
+	ErrObjectStoreRejected      = errors.New("object store rejected the upload")
+)
+
+// ObjectStore uploads objects to a bucket of a cloud object store such as S3 or GCS.
+type ObjectStore interface {
+	// Upload stores everything read from body as the object key of bucket. The body is read as it
+	// is produced, so objects larger than memory can be uploaded.
+	Upload(ctx context.Context, bucket, key, contentType string, body io.Reader) error
+}
+
+// httpObjectStore uploads objects with a streamed HTTP PUT to <endpoint>/<bucket>/<key>, the path
+// style of S3-compatible stores and of GCS's XML API.
+type httpObjectStore struct {
+	endpoint string
+	client   *http.Client
+}
+
+// newHTTPObjectStore creates a store uploading to the endpoint.
+func newHTTPObjectStore(endpoint string) *httpObjectStore {
+	return &httpObjectStore{endpoint: strings.TrimRight(endpoint, "/"), client: &http.Client{}}
+}
+
+// Upload streams the body to the store and fails unless it answers with a 2xx status.
+func (s *httpObjectStore) Upload(ctx context.Context, bucket, key, contentType string, body io.Reader) error {
+	target := s.endpoint + "/" + url.PathEscape(bucket) + "/" + (&url.URL{Path: key}).EscapedPath()
+
+	// Hide the body's concrete type so the request is sent chunked instead of buffered for its length.
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, target, io.NopCloser(body))
+	if err != nil {
+		return fmt.Errorf("failed to create upload request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", contentType)
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to upload object: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("%w: status %d", ErrObjectStoreRejected, resp.StatusCode)
+	}
+
+	return nil
+}
+
+// parseExportFormat validates an export format, defaulting to def when empty.
+func parseExportFormat(format, def string) (string, error) {
+	switch format = strings.ToLower(strings.TrimSpace(format)); format {
+	case "":
+		return def, nil
+	case exportFormatCSV, exportFormatJSONL:
+		return format, nil
+	default:
+		return "", fmt.Errorf("%w: %q", ErrExportFormatInvalid, format)
+	}
+}
+
+// exportContentType returns the content type of objects in the format.
+func exportContentType(format string) string {
+	if format == exportFormatJSONL {
+		return "application/x-ndjson"
+	}
+
+	return "text/csv"
+}
+
+// semesterExportKey names the export of a semester taken at the given time, so nightly dumps
+// never overwrite each other.
+func semesterExportKey(semester, format string, at time.Time) string {
+	return "grades/" + semester + "/" + semester + "-" + at.UTC().Format("20060102T150405Z") + "." + format
+}
+
+// exportedGrade is a grade as written to an export.
+type exportedGrade struct {
+	GradeID    string `json:"grade_id"`
+	StudentID  string `json:"student_id"`
+	CourseID   string `json:"course_id"`
+	Semester   string `json:"semester"`
+	GradeType  string `json:"grade_type"`
+	ItemID     string `json:"item_id"`
+	GradeValue string `json:"grade_value"`
+	GradedBy   string `json:"graded_by"`
+	GradedAt   string `json:"graded_at"`
+	Comments   string `json:"comments"`
+}
+
+// exportColumns is the CSV header, in the order of exportedGrade.row.
+var exportColumns = []string{
+	"grade_id", "student_id", "course_id", "semester", "grade_type", "item_id", "grade_value",
+	"graded_by", "graded_at", "comments",
+}
+
+// newExportedGrade converts a grade for an export.
+func newExportedGrade(grade *Grade) exportedGrade {
+	return exportedGrade{
+		GradeID:    grade.GradeID,
+		StudentID:  grade.StudentID,
+		CourseID:   grade.CourseID,
+		Semester:   grade.Semester,
+		GradeType:  grade.GradeType,
+		ItemID:     grade.ItemID,
+		GradeValue: grade.GradeValue,
+		GradedBy:   grade.GradedBy,
+		GradedAt:   gradeTimeToProto(grade.GradedAt),
+		Comments:   grade.Comments,
+	}
+}
+
+// row returns the CSV record of the grade.
+func (g exportedGrade) row() []string {
+	return []string{
+		g.GradeID, g.StudentID, g.CourseID, g.Semester, g.GradeType, g.ItemID, g.GradeValue,
+		g.GradedBy, g.GradedAt, g.Comments,
+	}
+}
+
+// writeSemesterExport writes every grade of a semester to w in the format as it is read from the
+// database, returning the number of grades written.
+func (s *GradesServer) writeSemesterExport(ctx context.Context, w io.Writer, semester, format string) (int, error) {
+	var (
+		count int
+		write func(exportedGrade) error
+		flush func() error
+	)
+
+	if format == exportFormatJSONL {
+		encoder := json.NewEncoder(w)
+		write = func(grade exportedGrade) error { return encoder.Encode(grade) }
+		flush = func() error { return nil }
+	} else {
+		writer := csv.NewWriter(w)
+		if err := writer.Write(exportColumns); err != nil {
+			return 0, fmt.Errorf("failed to write export header: %w", err)
+		}
+
+		write = func(grade exportedGrade) error { return writer.Write(grade.row()) }
+		flush = func() error {
+			writer.Flush()
+
+			return writer.Error()
+		}
+	}
+
+	err := s.db.StreamSemesterGrades(ctx, semester, func(grade *Grade) error {
+		count++
+
+		return write(newExportedGrade(grade))
+	})
+	if err != nil {
+		return count, err
+	}
+
+	if err := flush(); err != nil {
+		return count, fmt.Errorf("failed to write export: %w", err)
+	}
+
+	return count, nil
+}
+
+// ExportSemesterToBucket uploads every grade of a semester to the export bucket as CSV or JSONL,
+// returning the key of the object. Grades are streamed from the database to the store as they are
+// read, so the semester is never held in memory. Requires the admin role.
+func (s *GradesServer) ExportSemesterToBucket(ctx context.Context,
+	req *gpb.ExportSemesterToBucketRequest,
+) (*gpb.ExportSemesterToBucketResponse, error) {
+	if err := s.requireRole(ctx, req.GetToken(), roleAdmin); err != nil {
+		return nil, err
+	}
+
+	logger := klog.FromContext(ctx)
+	logger.V(logLevelDebug).Info("Received request to export semester to bucket", "semester", req.GetSemester(),
+		"format", req.GetFormat())
+
+	if req.GetSemester() == "" {
+		return nil, fmt.Errorf("failed to export semester: %w",
+			status.Error(codes.InvalidArgument, ErrSemesterEmpty.Error()))
+	}
+
+	format, err := parseExportFormat(req.GetFormat(), s.exportFormat)
+	if err != nil {
+		return nil, fmt.Errorf("failed to export semester: %w", status.Error(codes.InvalidArgument, err.Error()))
+	}
+
+	if s.objectStore == nil || s.exportBucket == "" {
+		return nil, fmt.Errorf("failed to export semester: %w",
+			status.Error(codes.FailedPrecondition, ErrObjectStoreNotConfigured.Error()))
+	}
+
+	key := semesterExportKey(req.GetSemester(), format, time.Now())
+	reader, writer := io.Pipe()
+
+	type exportResult struct {
+		count int
+		err   error
+	}
+
+	written := make(chan exportResult, 1)
+
+	go func() {
+		count, err := s.writeSemesterExport(ctx, writer, req.GetSemester(), format)
+		// A write error fails the upload's next read, so a partial export is never stored as complete.
+		writer.CloseWithError(err)
+		written <- exportResult{count: count, err: err}
+	}()
+
+	uploadErr := s.objectStore.Upload(ctx, s.exportBucket, key, exportContentType(format), reader)
+	// Unblock the writer when the upload stopped reading early.
+	reader.CloseWithError(io.ErrClosedPipe)
+
+	result := <-written
+	if result.err != nil && !errors.Is(result.err, io.ErrClosedPipe) {
+		return nil, fmt.Errorf("failed to export semester: %w", result.err)
+	}
+
+	if uploadErr != nil {
+		return nil, fmt.Errorf("failed to upload semester export: %w", uploadErr)
+	}
+
+	logger.V(logLevelDebug).Info("Exported semester to bucket", "bucket", s.exportBucket, "key", key,
+		"count", result.count)
+
+	return &gpb.ExportSemesterToBucketResponse{
+		Bucket:     s.exportBucket,
+		ObjectKey:  key,
+		GradeCount: int64(result.count),
+	}, nil
+}
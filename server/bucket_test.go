@@ -0,0 +1,240 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	gpb "github.com/BetterGR/grades-microservice/protos"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var errStoreDown = errors.New("store down")
+
+// MockObjectStore keeps uploaded objects in memory. When failAfter is positive, uploads fail once
+// that many bytes were read.
+type MockObjectStore struct {
+	mutex       sync.Mutex
+	objects     map[string][]byte
+	contentType map[string]string
+	failAfter   int64
+}
+
+// NewMockObjectStore creates an empty store.
+func NewMockObjectStore() *MockObjectStore {
+	return &MockObjectStore{objects: make(map[string][]byte), contentType: make(map[string]string)}
+}
+
+// Upload reads the body and stores it under bucket/key.
+func (m *MockObjectStore) Upload(_ context.Context, bucket, key, contentType string, body io.Reader) error {
+	if m.failAfter > 0 {
+		if _, err := io.CopyN(io.Discard, body, m.failAfter); err != nil {
+			return err
+		}
+
+		return errStoreDown
+	}
+
+	data, err := io.ReadAll(body)
+	if err != nil {
+		return err
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	m.objects[bucket+"/"+key] = data
+	m.contentType[bucket+"/"+key] = contentType
+
+	return nil
+}
+
+// setupExportClient returns an admin client exporting to the store, backed by db.
+func setupExportClient(t *testing.T, db *MockDatabase, store *MockObjectStore) gpb.GradesServiceClient {
+	t.Helper()
+
+	return setupClient(t, func(s *GradesServer) {
+		s.db = db
+		s.objectStore = store
+		s.exportBucket = "grades-dumps"
+		s.exportFormat = exportFormatCSV
+	})
+}
+
+// addSemesterGrades adds count grades of the semester, spread over two courses.
+func addSemesterGrades(t *testing.T, db *MockDatabase, semester string, count int) {
+	t.Helper()
+
+	for i := range count {
+		grade := createTestGrade()
+		grade.Semester = semester
+		grade.CourseID = []string{"234218", "234247"}[i%2]
+
+		_, err := db.AddGrade(context.Background(), grade)
+		require.NoError(t, err)
+	}
+}
+
+func TestExportSemesterToBucketCSV(t *testing.T) {
+	db := NewMockDatabase()
+	store := NewMockObjectStore()
+	client := setupExportClient(t, db, store)
+
+	addSemesterGrades(t, db, "Winter_2024", 3)
+	addSemesterGrades(t, db, "Spring_2024", 1)
+
+	resp, err := client.ExportSemesterToBucket(context.Background(),
+		&gpb.ExportSemesterToBucketRequest{Token: "test-token", Semester: "Winter_2024"})
+	require.NoError(t, err)
+	assert.Equal(t, "grades-dumps", resp.GetBucket())
+	assert.True(t, strings.HasPrefix(resp.GetObjectKey(), "grades/Winter_2024/Winter_2024-"), resp.GetObjectKey())
+	assert.True(t, strings.HasSuffix(resp.GetObjectKey(), ".csv"), resp.GetObjectKey())
+	assert.Equal(t, int64(3), resp.GetGradeCount())
+
+	object := "grades-dumps/" + resp.GetObjectKey()
+	assert.Equal(t, "text/csv", store.contentType[object])
+
+	records, err := csv.NewReader(bytes.NewReader(store.objects[object])).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, records, 4)
+	assert.Equal(t, exportColumns, records[0])
+
+	for _, record := range records[1:] {
+		assert.Equal(t, "Winter_2024", record[3])
+		assert.Equal(t, "Excellent work!", record[9])
+	}
+
+	// Grades are exported course by course.
+	assert.Equal(t, "234218", records[1][2])
+	assert.Equal(t, "234247", records[3][2])
+}
+
+func TestExportSemesterToBucketJSONL(t *testing.T) {
+	db := NewMockDatabase()
+	store := NewMockObjectStore()
+	client := setupExportClient(t, db, store)
+
+	addSemesterGrades(t, db, "Winter_2024", 2)
+
+	resp, err := client.ExportSemesterToBucket(context.Background(),
+		&gpb.ExportSemesterToBucketRequest{Token: "test-token", Semester: "Winter_2024", Format: "JSONL"})
+	require.NoError(t, err)
+	assert.True(t, strings.HasSuffix(resp.GetObjectKey(), ".jsonl"), resp.GetObjectKey())
+
+	object := "grades-dumps/" + resp.GetObjectKey()
+	assert.Equal(t, "application/x-ndjson", store.contentType[object])
+
+	scanner := bufio.NewScanner(bytes.NewReader(store.objects[object]))
+	lines := 0
+
+	for scanner.Scan() {
+		var grade exportedGrade
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &grade))
+		assert.Equal(t, "Winter_2024", grade.Semester)
+		assert.NotEmpty(t, grade.GradeID)
+
+		lines++
+	}
+
+	assert.Equal(t, 2, lines)
+}
+
+func TestExportSemesterToBucketStoreFailure(t *testing.T) {
+	db := NewMockDatabase()
+	store := NewMockObjectStore()
+	// The store stops reading well before the export is complete, which must not leave the
+	// export blocked on the pipe.
+	store.failAfter = 512
+	client := setupExportClient(t, db, store)
+
+	addSemesterGrades(t, db, "Winter_2024", 200)
+
+	_, err := client.ExportSemesterToBucket(context.Background(),
+		&gpb.ExportSemesterToBucketRequest{Token: "test-token", Semester: "Winter_2024"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), errStoreDown.Error())
+}
+
+func TestExportSemesterToBucketRejects(t *testing.T) {
+	db := NewMockDatabase()
+
+	unconfigured := setupClient(t, func(s *GradesServer) { s.db = db })
+	_, err := unconfigured.ExportSemesterToBucket(context.Background(),
+		&gpb.ExportSemesterToBucketRequest{Token: "test-token", Semester: "Winter_2024"})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	client := setupExportClient(t, db, NewMockObjectStore())
+
+	_, err = client.ExportSemesterToBucket(context.Background(),
+		&gpb.ExportSemesterToBucketRequest{Token: "test-token"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = client.ExportSemesterToBucket(context.Background(),
+		&gpb.ExportSemesterToBucketRequest{Token: "test-token", Semester: "Winter_2024", Format: "xml"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	staff := setupClient(t, func(s *GradesServer) {
+		s.db = db
+		s.objectStore = NewMockObjectStore()
+		s.exportBucket = "grades-dumps"
+		s.Claims = RoleClaims{role: roleStaff}
+	})
+	_, err = staff.ExportSemesterToBucket(context.Background(),
+		&gpb.ExportSemesterToBucketRequest{Token: "test-token", Semester: "Winter_2024"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestHTTPObjectStoreUpload(t *testing.T) {
+	var (
+		method, path, contentType string
+		body                      []byte
+	)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		method, path, contentType = r.Method, r.URL.Path, r.Header.Get("Content-Type")
+		body, _ = io.ReadAll(r.Body)
+
+		if strings.HasSuffix(r.URL.Path, "rejected.csv") {
+			w.WriteHeader(http.StatusForbidden)
+		}
+	}))
+	defer server.Close()
+
+	store := newHTTPObjectStore(server.URL + "/")
+
+	err := store.Upload(context.Background(), "grades-dumps", "grades/Winter_2024/dump.csv", "text/csv",
+		strings.NewReader("grade_id\ng1\n"))
+	require.NoError(t, err)
+	assert.Equal(t, http.MethodPut, method)
+	assert.Equal(t, "/grades-dumps/grades/Winter_2024/dump.csv", path)
+	assert.Equal(t, "text/csv", contentType)
+	assert.Equal(t, "grade_id\ng1\n", string(body))
+
+	err = store.Upload(context.Background(), "grades-dumps", "rejected.csv", "text/csv", strings.NewReader(""))
+	require.ErrorIs(t, err, ErrObjectStoreRejected)
+}
+
+func TestParseExportFormat(t *testing.T) {
+	format, err := parseExportFormat("", exportFormatJSONL)
+	require.NoError(t, err)
+	assert.Equal(t, exportFormatJSONL, format)
+
+	format, err = parseExportFormat(" CSV ", exportFormatJSONL)
+	require.NoError(t, err)
+	assert.Equal(t, exportFormatCSV, format)
+
+	_, err = parseExportFormat("parquet", exportFormatCSV)
+	require.ErrorIs(t, err, ErrExportFormatInvalid)
+}
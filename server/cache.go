@@ -0,0 +1,87 @@
+package main
+
+import (
+	"container/list"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"net"
+	"sync"
+)
+
+// defaultReadCacheSize is the number of reads remembered for stale serving.
+const defaultReadCacheSize = 1000
+
+// gradesCache is a fixed-size LRU cache of grade list reads.
+type gradesCache struct {
+	mutex    sync.Mutex
+	capacity int
+	entries  map[string]*list.Element
+	order    *list.List
+}
+
+type gradesCacheEntry struct {
+	key    string
+	grades []*Grade
+}
+
+// newGradesCache creates a cache holding at most capacity reads.
+func newGradesCache(capacity int) *gradesCache {
+	if capacity <= 0 {
+		capacity = defaultReadCacheSize
+	}
+
+	return &gradesCache{
+		capacity: capacity,
+		entries:  make(map[string]*list.Element),
+		order:    list.New(),
+	}
+}
+
+// Get returns the cached grades for key, marking it as recently used.
+func (c *gradesCache) Get(key string) ([]*Grade, bool) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	element, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+
+	c.order.MoveToFront(element)
+
+	entry, _ := element.Value.(*gradesCacheEntry)
+
+	return entry.grades, true
+}
+
+// Put stores the grades for key, evicting the least recently used entry when full.
+func (c *gradesCache) Put(key string, grades []*Grade) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if element, ok := c.entries[key]; ok {
+		entry, _ := element.Value.(*gradesCacheEntry)
+		entry.grades = grades
+		c.order.MoveToFront(element)
+
+		return
+	}
+
+	c.entries[key] = c.order.PushFront(&gradesCacheEntry{key: key, grades: grades})
+
+	if c.order.Len() > c.capacity {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+
+		entry, _ := oldest.Value.(*gradesCacheEntry)
+		delete(c.entries, entry.key)
+	}
+}
+
+// isConnectionError reports whether err means the database could not be reached.
+func isConnectionError(err error) bool {
+	var netErr net.Error
+
+	return errors.As(err, &netErr) || errors.Is(err, driver.ErrBadConn) || errors.Is(err, io.ErrUnexpectedEOF)
+}
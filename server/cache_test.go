@@ -0,0 +1,44 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"syscall"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGradesCacheEvictsLeastRecentlyUsed(t *testing.T) {
+	cache := newGradesCache(2)
+	cache.Put("a", []*Grade{{GradeID: "1"}})
+	cache.Put("b", []*Grade{{GradeID: "2"}})
+
+	// Touch "a" so "b" becomes the eviction candidate.
+	_, ok := cache.Get("a")
+	assert.True(t, ok)
+
+	cache.Put("c", []*Grade{{GradeID: "3"}})
+
+	_, ok = cache.Get("b")
+	assert.False(t, ok)
+
+	grades, ok := cache.Get("a")
+	assert.True(t, ok)
+	assert.Equal(t, "1", grades[0].GradeID)
+
+	_, ok = cache.Get("c")
+	assert.True(t, ok)
+}
+
+func TestIsConnectionError(t *testing.T) {
+	dialErr := &net.OpError{Op: "dial", Net: connectionProtocol, Err: syscall.ECONNREFUSED}
+
+	assert.True(t, isConnectionError(dialErr))
+	assert.True(t, isConnectionError(fmt.Errorf("failed to get course grades: %w", dialErr)))
+	assert.False(t, isConnectionError(ErrGradeNotFound))
+	assert.False(t, isConnectionError(errors.New("syntax error")))
+	assert.False(t, isConnectionError(context.Canceled))
+}
@@ -0,0 +1,127 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	gpb "github.com/BetterGR/grades-microservice/protos"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/klog/v2"
+)
+
+// defaultAcademicTerms is the calendar used when ACADEMIC_YEAR_TERMS is unset: a Fall semester in
+// the year the academic year starts, followed by a Spring semester in the next one.
+const defaultAcademicTerms = "Fall:0,Spring:1"
+
+var (
+	ErrAcademicCalendarInvalid = errors.New("invalid academic calendar")
+	ErrAcademicYearInvalid     = errors.New("academic year must be positive")
+)
+
+// academicTerm is a semester of the academic year.
+type academicTerm struct {
+	name string
+	// yearOffset is the calendar year of the term relative to the year the academic year starts in.
+	yearOffset int
+}
+
+// AcademicCalendar lists the semesters of an academic year in order. Semesters are named
+// "<term>_<calendar year>", such as "Fall_2024". The zero value is the default calendar.
+type AcademicCalendar []academicTerm
+
+// parseAcademicCalendar parses a "term:offset[,term:offset]" spec such as "Winter:0,Spring:1,Summer:1",
+// where offset is the calendar year of the term relative to the start of the academic year. An empty
+// spec yields the default calendar.
+func parseAcademicCalendar(spec string) (AcademicCalendar, error) {
+	if strings.TrimSpace(spec) == "" {
+		spec = defaultAcademicTerms
+	}
+
+	var calendar AcademicCalendar
+
+	for _, entry := range strings.Split(spec, ",") {
+		name, rawOffset, ok := strings.Cut(entry, ":")
+		name = strings.TrimSpace(name)
+
+		if !ok || name == "" {
+			return nil, fmt.Errorf("%w: entry %q is not term:offset", ErrAcademicCalendarInvalid, entry)
+		}
+
+		offset, err := strconv.Atoi(strings.TrimSpace(rawOffset))
+		if err != nil || offset < 0 {
+			return nil, fmt.Errorf("%w: offset of %q must be a non-negative integer", ErrAcademicCalendarInvalid, name)
+		}
+
+		calendar = append(calendar, academicTerm{name: name, yearOffset: offset})
+	}
+
+	return calendar, nil
+}
+
+// Semesters returns the semesters of the academic year starting in year, in calendar order.
+func (c AcademicCalendar) Semesters(year int) []string {
+	terms := c
+	if len(terms) == 0 {
+		terms, _ = parseAcademicCalendar(defaultAcademicTerms)
+	}
+
+	semesters := make([]string, 0, len(terms))
+	for _, term := range terms {
+		semesters = append(semesters, term.name+"_"+strconv.Itoa(year+term.yearOffset))
+	}
+
+	return semesters
+}
+
+// GetStudentYearGrades returns the grades of a student across the semesters of an academic year,
+// grouped by semester. Every semester of the year has an entry, empty when it has no grades.
+func (s *GradesServer) GetStudentYearGrades(ctx context.Context,
+	req *gpb.GetStudentYearGradesRequest,
+) (*gpb.GetStudentYearGradesResponse, error) {
+	claims, err := s.getClaims(ctx, req.GetToken())
+	if err != nil {
+		return nil, fmt.Errorf("authentication failed: %w",
+			status.Error(codes.Unauthenticated, err.Error()))
+	}
+
+	logger := klog.FromContext(ctx)
+	logger.V(logLevelDebug).Info("Received request for student year grades", "student_id", req.GetStudentID(),
+		"academic_year", req.GetAcademicYear())
+
+	view, err := s.gradeView(ctx, claims)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.GetAcademicYear() <= 0 {
+		return nil, fmt.Errorf("failed to get student year grades: %w",
+			status.Error(codes.InvalidArgument, ErrAcademicYearInvalid.Error()))
+	}
+
+	semesters := s.calendar.Semesters(int(req.GetAcademicYear()))
+
+	groups, err := s.db.GetStudentGradesBySemester(ctx, req.GetStudentID(), semesters)
+	if errors.Is(err, ErrStudentIDEmpty) {
+		return nil, fmt.Errorf("failed to get student year grades: %w",
+			status.Error(codes.InvalidArgument, err.Error()))
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to get student year grades: %w", err)
+	}
+
+	resp := &gpb.GetStudentYearGradesResponse{Semesters: make([]*gpb.SemesterGrades, 0, len(semesters))}
+
+	for _, semester := range semesters {
+		gradesResponse, truncated := s.createGradesResponse(groups[semester], view)
+		resp.Semesters = append(resp.Semesters, &gpb.SemesterGrades{
+			Semester:  semester,
+			Grades:    gradesResponse,
+			Truncated: truncated,
+		})
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,78 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	gpb "github.com/BetterGR/grades-microservice/protos"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestAcademicCalendarSemesters(t *testing.T) {
+	assert.Equal(t, []string{"Fall_2024", "Spring_2025"}, AcademicCalendar(nil).Semesters(2024))
+
+	calendar, err := parseAcademicCalendar("Winter:0, Spring:1, Summer:1")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Winter_2023", "Spring_2024", "Summer_2024"}, calendar.Semesters(2023))
+
+	calendar, err = parseAcademicCalendar("")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Fall_2024", "Spring_2025"}, calendar.Semesters(2024))
+}
+
+func TestParseAcademicCalendarInvalid(t *testing.T) {
+	for _, spec := range []string{"Fall", "Fall:x", ":1", "Fall:-1", "Fall:0,"} {
+		_, err := parseAcademicCalendar(spec)
+		require.ErrorIs(t, err, ErrAcademicCalendarInvalid, spec)
+	}
+}
+
+func TestGetStudentYearGrades(t *testing.T) {
+	client := setupClient(t)
+
+	fall := createTestGrade()
+	fall.Semester = "Fall_2024"
+
+	spring := createTestGrade()
+	spring.StudentID = fall.GetStudentID()
+	spring.Semester = "Spring_2025"
+
+	otherYear := createTestGrade()
+	otherYear.StudentID = fall.GetStudentID()
+	otherYear.Semester = "Spring_2024"
+
+	for _, grade := range []*gpb.SingleGrade{fall, spring, otherYear} {
+		_, err := client.AddSingleGrade(context.Background(), &gpb.AddSingleGradeRequest{Token: "test-token", Grade: grade})
+		require.NoError(t, err)
+	}
+
+	resp, err := client.GetStudentYearGrades(context.Background(), &gpb.GetStudentYearGradesRequest{
+		Token: "test-token", StudentID: fall.GetStudentID(), AcademicYear: 2024,
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.GetSemesters(), 2)
+	assert.Equal(t, "Fall_2024", resp.GetSemesters()[0].GetSemester())
+	require.Len(t, resp.GetSemesters()[0].GetGrades(), 1)
+	assert.Equal(t, fall.GetGradeID(), resp.GetSemesters()[0].GetGrades()[0].GetGradeID())
+	assert.Equal(t, "Spring_2025", resp.GetSemesters()[1].GetSemester())
+	require.Len(t, resp.GetSemesters()[1].GetGrades(), 1)
+	assert.Equal(t, spring.GetGradeID(), resp.GetSemesters()[1].GetGrades()[0].GetGradeID())
+
+	empty, err := client.GetStudentYearGrades(context.Background(), &gpb.GetStudentYearGradesRequest{
+		Token: "test-token", StudentID: fall.GetStudentID(), AcademicYear: 2030,
+	})
+	require.NoError(t, err)
+	require.Len(t, empty.GetSemesters(), 2)
+
+	for _, semester := range empty.GetSemesters() {
+		assert.Empty(t, semester.GetGrades())
+	}
+
+	_, err = client.GetStudentYearGrades(context.Background(), &gpb.GetStudentYearGradesRequest{
+		Token: "test-token", StudentID: fall.GetStudentID(),
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
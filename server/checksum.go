@@ -0,0 +1,128 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+
+	gpb "github.com/BetterGR/grades-microservice/protos"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/klog/v2"
+)
+
+// checksumAlgorithm names the checksum scheme of gradesChecksum. It must change with the hashed
+// fields or their encoding, so replicas never compare checksums of different schemes.
+const checksumAlgorithm = "sha256-sorted-rows-v1"
+
+// checksumFieldSeparator ends every field of a hashed row, so no two rows hash the same fields.
+const checksumFieldSeparator = 0x1f
+
+// gradeRowHash hashes the fields of a grade a replica stores. Store-assigned timestamps are left
+// out, since replicas keep them at different precisions; the version changes with every update.
+func gradeRowHash(grade *Grade) [sha256.Size]byte {
+	var row bytes.Buffer
+
+	for _, field := range []string{
+		grade.GradeID, grade.StudentID, grade.CourseID, grade.Semester, grade.GradeType, grade.ItemID,
+		grade.GradeValue, grade.GradedBy, strconv.FormatInt(grade.Version, 10), strconv.FormatBool(grade.Flagged),
+	} {
+		row.WriteString(field)
+		row.WriteByte(checksumFieldSeparator)
+	}
+
+	return sha256.Sum256(row.Bytes())
+}
+
+// gradesChecksum combines the row hashes of grades into a checksum that does not depend on the
+// order they were added in. Only the hashes are kept, so large courses can be streamed through it.
+type gradesChecksum struct {
+	rows [][sha256.Size]byte
+}
+
+// add hashes grade into the checksum.
+func (c *gradesChecksum) add(grade *Grade) {
+	c.rows = append(c.rows, gradeRowHash(grade))
+}
+
+// sum returns the hex checksum of the grades added so far.
+func (c *gradesChecksum) sum() string {
+	sort.Slice(c.rows, func(i, j int) bool {
+		return bytes.Compare(c.rows[i][:], c.rows[j][:]) < 0
+	})
+
+	hash := sha256.New()
+	for _, row := range c.rows {
+		hash.Write(row[:])
+	}
+
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+// GetCourseGradesChecksum returns a checksum of a course's grades in a semester, so a replica such
+// as a reporting warehouse can verify it holds the same grades without transferring them. The
+// grades are streamed, keeping only their hashes in memory. Requires the staff, auditor or admin role.
+func (s *GradesServer) GetCourseGradesChecksum(ctx context.Context,
+	req *gpb.GetCourseGradesChecksumRequest,
+) (*gpb.GetCourseGradesChecksumResponse, error) {
+	if err := s.requireRole(ctx, req.GetToken(), roleStaff, roleAuditor, roleAdmin); err != nil {
+		return nil, err
+	}
+
+	logger := klog.FromContext(ctx)
+	logger.V(logLevelDebug).Info("Received request for course grades checksum", "course_id", req.GetCourseID(),
+		"semester", req.GetSemester(), "by_student", req.GetByStudent())
+
+	var (
+		course   gradesChecksum
+		students map[string]*gradesChecksum
+	)
+
+	if req.GetByStudent() {
+		students = make(map[string]*gradesChecksum)
+	}
+
+	err := s.db.StreamCourseGrades(ctx, req.GetCourseID(), req.GetSemester(), func(grade *Grade) error {
+		course.add(grade)
+
+		if students != nil {
+			student, ok := students[grade.StudentID]
+			if !ok {
+				student = &gradesChecksum{}
+				students[grade.StudentID] = student
+			}
+
+			student.add(grade)
+		}
+
+		return nil
+	})
+
+	switch {
+	case errors.Is(err, ErrCourseIDEmpty):
+		return nil, fmt.Errorf("failed to get course grades checksum: %w",
+			status.Error(codes.InvalidArgument, err.Error()))
+	case err != nil:
+		return nil, fmt.Errorf("failed to get course grades checksum: %w", err)
+	}
+
+	resp := &gpb.GetCourseGradesChecksumResponse{
+		Checksum:  course.sum(),
+		Algorithm: checksumAlgorithm,
+		Count:     int32(len(course.rows)),
+	}
+
+	if students != nil {
+		resp.StudentChecksums = make(map[string]string, len(students))
+		for studentID, student := range students {
+			resp.StudentChecksums[studentID] = student.sum()
+		}
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,96 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	gpb "github.com/BetterGR/grades-microservice/protos"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestGradesChecksum(t *testing.T) {
+	grades := []*Grade{
+		{GradeID: "g1", StudentID: "s1", CourseID: "c1", Semester: "Winter_2024", GradeValue: "90", Version: 1},
+		{GradeID: "g2", StudentID: "s2", CourseID: "c1", Semester: "Winter_2024", GradeValue: "80", Version: 1},
+		{GradeID: "g3", StudentID: "s2", CourseID: "c1", Semester: "Winter_2024", GradeValue: "70", Version: 2},
+	}
+
+	checksum := func(grades ...*Grade) string {
+		var c gradesChecksum
+		for _, grade := range grades {
+			c.add(grade)
+		}
+
+		return c.sum()
+	}
+
+	want := checksum(grades...)
+	assert.Len(t, want, 64)
+	assert.Equal(t, want, checksum(grades[2], grades[0], grades[1]), "the order of rows does not matter")
+
+	changed := *grades[1]
+	changed.GradeValue = "81"
+	assert.NotEqual(t, want, checksum(grades[0], &changed, grades[2]))
+
+	flagged := *grades[1]
+	flagged.Flagged = true
+	assert.NotEqual(t, want, checksum(grades[0], &flagged, grades[2]))
+
+	assert.NotEqual(t, want, checksum(grades[0], grades[1]), "a missing row changes the checksum")
+
+	// Fields cannot bleed into each other.
+	assert.NotEqual(t, checksum(&Grade{GradeType: "ab", ItemID: "c"}), checksum(&Grade{GradeType: "a", ItemID: "bc"}))
+}
+
+func TestGetCourseGradesChecksum(t *testing.T) {
+	db := NewMockDatabase()
+	client := setupClient(t, func(s *GradesServer) {
+		s.db = db
+		s.Claims = RoleClaims{role: roleAuditor}
+	})
+
+	var added []*Grade
+
+	for _, studentID := range []string{"s1", "s2", "s2"} {
+		grade := createTestGrade()
+		grade.CourseID, grade.StudentID = "c1", studentID
+		dbGrade, err := db.AddGrade(context.Background(), grade)
+		require.NoError(t, err)
+
+		added = append(added, dbGrade)
+	}
+
+	request := &gpb.GetCourseGradesChecksumRequest{
+		Token: "test-token", CourseID: "c1", Semester: "Winter_2023", ByStudent: true,
+	}
+
+	first, err := client.GetCourseGradesChecksum(context.Background(), request)
+	require.NoError(t, err)
+	assert.Equal(t, checksumAlgorithm, first.GetAlgorithm())
+	assert.Equal(t, int32(3), first.GetCount())
+	assert.Len(t, first.GetStudentChecksums(), 2)
+
+	second, err := client.GetCourseGradesChecksum(context.Background(), request)
+	require.NoError(t, err)
+	assert.Equal(t, first.GetChecksum(), second.GetChecksum(), "identical grades have identical checksums")
+
+	_, err = db.UpdateGrade(context.Background(), &gpb.SingleGrade{GradeID: added[0].GradeID, GradeValue: "42"})
+	require.NoError(t, err)
+
+	changed, err := client.GetCourseGradesChecksum(context.Background(), request)
+	require.NoError(t, err)
+	assert.NotEqual(t, first.GetChecksum(), changed.GetChecksum(), "a single change alters the checksum")
+	assert.NotEqual(t, first.GetStudentChecksums()["s1"], changed.GetStudentChecksums()["s1"])
+	assert.Equal(t, first.GetStudentChecksums()["s2"], changed.GetStudentChecksums()["s2"],
+		"the checksums of other students are unchanged")
+
+	_, err = client.GetCourseGradesChecksum(context.Background(), &gpb.GetCourseGradesChecksumRequest{Token: "test-token"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	student := setupClient(t, func(s *GradesServer) { s.Claims = RoleClaims{role: roleStudent} })
+	_, err = student.GetCourseGradesChecksum(context.Background(), request)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
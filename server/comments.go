@@ -0,0 +1,62 @@
+package main
+
+import (
+	"strings"
+	"unicode"
+)
+
+// defaultMaxCommentNewlines keeps at most one blank line between paragraphs of a comment when
+// COMMENT_MAX_NEWLINES is unset.
+const defaultMaxCommentNewlines = 2
+
+// sanitizeComment cleans a comment before it is written, so it cannot break exports or UIs. Control
+// characters are stripped, runs of spaces and tabs collapse to a single space, lines are trimmed,
+// and runs of line breaks collapse to at most maxNewlines; zero keeps every line break.
+func sanitizeComment(comment string, maxNewlines int) string {
+	comment = strings.ReplaceAll(comment, "\r\n", "\n")
+	comment = strings.ReplaceAll(comment, "\r", "\n")
+
+	var (
+		sanitized strings.Builder
+		newlines  int
+	)
+
+	for _, line := range strings.Split(comment, "\n") {
+		line = strings.Join(strings.Fields(strings.Map(cleanCommentRune, line)), " ")
+		if line == "" {
+			// Blank lines only count between text.
+			if sanitized.Len() > 0 {
+				newlines++
+			}
+
+			continue
+		}
+
+		if sanitized.Len() > 0 {
+			newlines++
+			if maxNewlines > 0 {
+				newlines = min(newlines, maxNewlines)
+			}
+
+			sanitized.WriteString(strings.Repeat("\n", newlines))
+		}
+
+		sanitized.WriteString(line)
+
+		newlines = 0
+	}
+
+	return sanitized.String()
+}
+
+// cleanCommentRune maps whitespace within a line to a space and drops control characters.
+func cleanCommentRune(r rune) rune {
+	switch {
+	case unicode.IsSpace(r):
+		return ' '
+	case unicode.IsControl(r):
+		return -1
+	default:
+		return r
+	}
+}
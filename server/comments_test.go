@@ -0,0 +1,58 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	gpb "github.com/BetterGR/grades-microservice/protos"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSanitizeComment(t *testing.T) {
+	tests := []struct {
+		name        string
+		comment     string
+		maxNewlines int
+		want        string
+	}{
+		{"plain comments are kept", "Excellent work!", 2, "Excellent work!"},
+		{"control characters are stripped", "Good\x00 job\x07\x1b!", 2, "Good job!"},
+		{"whitespace runs collapse", "  Good \t\t  job  ", 2, "Good job"},
+		{"line breaks within the limit are kept", "First.\n\nSecond.", 2, "First.\n\nSecond."},
+		{"excessive line breaks collapse", "First.\n\n\n\n\n\nSecond.", 2, "First.\n\nSecond."},
+		{"whitespace-only lines count as blank", "First.\n  \n\t\n \nSecond.", 2, "First.\n\nSecond."},
+		{"carriage returns become line breaks", "First.\r\nSecond.\rThird.", 2, "First.\nSecond.\nThird."},
+		{"a limit of one joins paragraphs", "First.\n\n\nSecond.", 1, "First.\nSecond."},
+		{"zero keeps every line break", "First.\n\n\n\nSecond.", 0, "First.\n\n\n\nSecond."},
+		{"surrounding blank lines are dropped", "\n\n First. \n\n", 2, "First."},
+		{"empty comments stay empty", "", 2, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, sanitizeComment(tt.comment, tt.maxNewlines))
+		})
+	}
+}
+
+func TestAddGradeSanitizesComment(t *testing.T) {
+	client := setupClient(t, func(s *GradesServer) {
+		s.maxCommentNewlines = 2
+	})
+
+	grade := createTestGrade()
+	grade.Comments = "Great\x00 work.\n\n\n\n\nSee me\tafter class."
+
+	resp, err := client.AddSingleGrade(context.Background(), &gpb.AddSingleGradeRequest{Token: "test-token", Grade: grade})
+	require.NoError(t, err)
+	assert.Equal(t, "Great work.\n\nSee me after class.", resp.GetGrade().GetComments())
+
+	grade.Comments = "Regraded.\x1b\n\n\n\nNo change."
+
+	updated, err := client.UpdateSingleGrade(context.Background(), &gpb.UpdateSingleGradeRequest{
+		Token: "test-token", Grade: grade,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "Regraded.\n\nNo change.", updated.GetGrade().GetComments())
+}
@@ -0,0 +1,77 @@
+package main
+
+import (
+	"os"
+	"strconv"
+	"time"
+
+	"k8s.io/klog/v2"
+)
+
+// envBool reads a boolean environment variable, falling back to def when unset or invalid.
+func envBool(name string, def bool) bool {
+	raw := os.Getenv(name)
+	if raw == "" {
+		return def
+	}
+
+	value, err := strconv.ParseBool(raw)
+	if err != nil {
+		klog.Warningf("Invalid value %q for %s, using default %t", raw, name, def)
+
+		return def
+	}
+
+	return value
+}
+
+// envInt reads an integer environment variable, falling back to def when unset or invalid.
+func envInt(name string, def int) int {
+	raw := os.Getenv(name)
+	if raw == "" {
+		return def
+	}
+
+	value, err := strconv.Atoi(raw)
+	if err != nil {
+		klog.Warningf("Invalid value %q for %s, using default %d", raw, name, def)
+
+		return def
+	}
+
+	return value
+}
+
+// envDuration reads a duration environment variable such as "5s", falling back to def when unset or invalid.
+func envDuration(name string, def time.Duration) time.Duration {
+	raw := os.Getenv(name)
+	if raw == "" {
+		return def
+	}
+
+	value, err := time.ParseDuration(raw)
+	if err != nil || value <= 0 {
+		klog.Warningf("Invalid value %q for %s, using default %s", raw, name, def)
+
+		return def
+	}
+
+	return value
+}
+
+// envFloat reads a floating point environment variable, falling back to def when unset or invalid.
+func envFloat(name string, def float64) float64 {
+	raw := os.Getenv(name)
+	if raw == "" {
+		return def
+	}
+
+	value, err := strconv.ParseFloat(raw, 64)
+	if err != nil {
+		klog.Warningf("Invalid value %q for %s, using default %g", raw, name, def)
+
+		return def
+	}
+
+	return value
+}
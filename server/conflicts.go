@@ -0,0 +1,103 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	gpb "github.com/BetterGR/grades-microservice/protos"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/klog/v2"
+)
+
+// graderConflict is a grade that a grader other than its original grader changed.
+type graderConflict struct {
+	// grade is the current state of the grade.
+	grade *Grade
+	// originalGrader graded the first version.
+	originalGrader string
+	// updatedBy is the most recent other grader to change the grade.
+	updatedBy string
+	// updatedVersion is the version written by updatedBy.
+	updatedVersion int64
+}
+
+// detectGraderConflicts finds the grades whose versions, oldest first, were written by a different
+// grader than the first one. Versions without a grader are ignored. Conflicts are sorted by grade ID.
+func detectGraderConflicts(versionsByGrade map[string][]*Grade) []graderConflict {
+	var conflicts []graderConflict
+
+	for _, versions := range versionsByGrade {
+		if len(versions) < 2 {
+			continue
+		}
+
+		original := versions[0].GradedBy
+		if original == "" {
+			continue
+		}
+
+		for i := len(versions) - 1; i > 0; i-- {
+			if grader := versions[i].GradedBy; grader != "" && grader != original {
+				conflicts = append(conflicts, graderConflict{
+					grade:          versions[len(versions)-1],
+					originalGrader: original,
+					updatedBy:      grader,
+					updatedVersion: versions[i].Version,
+				})
+
+				break
+			}
+		}
+	}
+
+	sort.Slice(conflicts, func(i, j int) bool {
+		return conflicts[i].grade.GradeID < conflicts[j].grade.GradeID
+	})
+
+	return conflicts
+}
+
+// DetectGradeConflicts returns the grades of a course during a semester that were changed by a
+// different grader than the one who originally graded them. Requires the staff role.
+func (s *GradesServer) DetectGradeConflicts(ctx context.Context,
+	req *gpb.DetectGradeConflictsRequest,
+) (*gpb.DetectGradeConflictsResponse, error) {
+	claims, err := s.authorizeRoles(ctx, req.GetToken(), roleStaff, roleAdmin)
+	if err != nil {
+		return nil, err
+	}
+
+	logger := klog.FromContext(ctx)
+	logger.V(logLevelDebug).Info("Received request to detect grade conflicts", "course_id", req.GetCourseID(),
+		"semester", req.GetSemester())
+
+	view, err := s.gradeView(ctx, claims)
+	if err != nil {
+		return nil, err
+	}
+
+	versions, err := s.db.GetCourseGradeVersions(ctx, req.GetCourseID(), req.GetSemester())
+	if errors.Is(err, ErrCourseIDEmpty) {
+		return nil, fmt.Errorf("failed to detect grade conflicts: %w",
+			status.Error(codes.InvalidArgument, err.Error()))
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to detect grade conflicts: %w", err)
+	}
+
+	conflicts := detectGraderConflicts(versions)
+
+	resp := &gpb.DetectGradeConflictsResponse{Conflicts: make([]*gpb.GradeConflict, 0, len(conflicts))}
+	for _, conflict := range conflicts {
+		resp.Conflicts = append(resp.Conflicts, &gpb.GradeConflict{
+			Grade:          s.gradeResponse(conflict.grade, view),
+			OriginalGrader: conflict.originalGrader,
+			UpdatedBy:      conflict.updatedBy,
+			UpdatedVersion: conflict.updatedVersion,
+		})
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,96 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	gpb "github.com/BetterGR/grades-microservice/protos"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestDetectGraderConflicts(t *testing.T) {
+	versions := map[string][]*Grade{
+		// Only one grader ever touched it.
+		"g1": {
+			{GradeID: "g1", GradedBy: "alice", Version: 1},
+			{GradeID: "g1", GradedBy: "alice", Version: 2},
+		},
+		// Bob and then Carol overwrote Alice's grade; the latest other grader is reported.
+		"g2": {
+			{GradeID: "g2", GradedBy: "alice", Version: 1},
+			{GradeID: "g2", GradedBy: "bob", Version: 2},
+			{GradeID: "g2", GradedBy: "carol", Version: 3},
+			{GradeID: "g2", GradedBy: "alice", Version: 4, GradeValue: "90"},
+		},
+		// Never updated.
+		"g3": {{GradeID: "g3", GradedBy: "bob", Version: 1}},
+		// An update without a grader is not a conflict.
+		"g4": {
+			{GradeID: "g4", GradedBy: "bob", Version: 1},
+			{GradeID: "g4", Version: 2},
+		},
+	}
+
+	conflicts := detectGraderConflicts(versions)
+	require.Len(t, conflicts, 1)
+	assert.Equal(t, "g2", conflicts[0].grade.GradeID)
+	assert.Equal(t, "90", conflicts[0].grade.GradeValue)
+	assert.Equal(t, "alice", conflicts[0].originalGrader)
+	assert.Equal(t, "carol", conflicts[0].updatedBy)
+	assert.Equal(t, int64(3), conflicts[0].updatedVersion)
+}
+
+func TestDetectGradeConflictsRPC(t *testing.T) {
+	mockDB := NewMockDatabase()
+	client := setupClient(t, func(s *GradesServer) {
+		s.db = mockDB
+		s.Claims = RoleClaims{role: roleStaff}
+	})
+
+	grade := createTestGrade()
+	grade.GradedBy = "alice"
+	_, err := client.AddSingleGrade(context.Background(), &gpb.AddSingleGradeRequest{Token: "test-token", Grade: grade})
+	require.NoError(t, err)
+
+	untouched := createTestGrade()
+	untouched.CourseID = grade.GetCourseID()
+	_, err = client.AddSingleGrade(context.Background(), &gpb.AddSingleGradeRequest{
+		Token: "test-token", Grade: untouched,
+	})
+	require.NoError(t, err)
+
+	// Seed the history: Bob overwrote Alice's original grade.
+	current := mockDB.grades[grade.GetGradeID()]
+	original := *current
+	current.GradedBy = "bob"
+	current.GradeValue = "B"
+	current.Version = 2
+	mockDB.history[grade.GetGradeID()] = []*Grade{&original}
+
+	resp, err := client.DetectGradeConflicts(context.Background(), &gpb.DetectGradeConflictsRequest{
+		Token: "test-token", CourseID: grade.GetCourseID(), Semester: grade.GetSemester(),
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.GetConflicts(), 1)
+
+	conflict := resp.GetConflicts()[0]
+	assert.Equal(t, grade.GetGradeID(), conflict.GetGrade().GetGradeID())
+	assert.Equal(t, "B", conflict.GetGrade().GetGradeValue())
+	assert.Equal(t, "alice", conflict.GetOriginalGrader())
+	assert.Equal(t, "bob", conflict.GetUpdatedBy())
+	assert.Equal(t, int64(2), conflict.GetUpdatedVersion())
+}
+
+func TestDetectGradeConflictsRequiresStaff(t *testing.T) {
+	client := setupClient(t, func(s *GradesServer) {
+		s.Claims = RoleClaims{role: "student"}
+	})
+
+	_, err := client.DetectGradeConflicts(context.Background(), &gpb.DetectGradeConflictsRequest{
+		Token: "test-token", CourseID: "c1", Semester: "S24",
+	})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
@@ -0,0 +1,57 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	gpb "github.com/BetterGR/grades-microservice/protos"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteCopyRow(t *testing.T) {
+	score := 87.5
+	graded := time.Date(2024, 1, 2, 3, 4, 5, 6000, time.UTC)
+
+	var buf bytes.Buffer
+	require.NoError(t, writeCopyRow(&buf, &Grade{
+		GradeID:      "g1",
+		StudentID:    "s1",
+		CourseID:     "c1",
+		Semester:     "Winter_2024",
+		GradeType:    "Exam",
+		ItemID:       "final",
+		GradeValue:   "87.5",
+		GradedBy:     "staff",
+		GradedAt:     graded,
+		UpdatedAt:    graded,
+		Comments:     "tab\there\nnew line \\ slash",
+		Version:      1,
+		NumericValue: &score,
+		RubricScores: RubricScores{{Criterion: "style", Points: 4, Max: 5}},
+		SectionID:    "sec-2",
+		ChangedBy:    "ta-1",
+	}))
+	require.NoError(t, writeCopyRow(&buf, &Grade{GradeID: "g2", GradeValue: "A", Version: 1}))
+
+	assert.Equal(t,
+		"g1\ts1\tc1\tWinter_2024\tExam\tfinal\t87.5\tstaff\t2024-01-02T03:04:05.000006Z\t2024-01-02T03:04:05.000006Z\t"+
+			`tab\there\nnew line \\ slash`+"\t1\tfalse\t87.5\t"+`[{"criterion":"style","points":4,"max":5}]`+
+			"\t\t\\N\t\\N\tsec-2\tta-1\n"+
+			"g2\t\t\t\t\t\tA\t\t\\N\t\\N\t\t1\tfalse\t\\N\t\\N\t\t\\N\t\\N\t\t\n",
+		buf.String())
+}
+
+func TestCopyable(t *testing.T) {
+	grades := []*gpb.SingleGrade{{ItemID: "hw1"}, {ItemID: "hw2"}}
+
+	assert.False(t, (&Database{}).copyable(grades), "COPY is off without a threshold")
+	assert.True(t, (&Database{copyThreshold: 2}).copyable(grades))
+	assert.False(t, (&Database{copyThreshold: 3}).copyable(grades), "small batches are inserted")
+	assert.False(t, (&Database{copyThreshold: 2, onDuplicate: duplicateReject}).copyable(grades),
+		"duplicate checks need the existing grades")
+	assert.True(t, (&Database{copyThreshold: 2, onDuplicate: duplicateReject}).copyable(
+		[]*gpb.SingleGrade{{}, {}}), "grades without an item are never duplicates")
+	assert.False(t, (&Database{copyThreshold: 1}).copyable([]*gpb.SingleGrade{nil}))
+}
@@ -0,0 +1,78 @@
+package main
+
+import (
+	"context"
+	"sort"
+
+	gpb "github.com/BetterGR/grades-microservice/protos"
+	"k8s.io/klog/v2"
+)
+
+// CourseInfo holds the details of a course as known to the course service.
+type CourseInfo struct {
+	CourseID string
+	Name     string
+	Credits  float64
+}
+
+// CourseResolver looks up course details for a batch of course IDs.
+// Courses missing from the returned map are treated as unresolved.
+type CourseResolver interface {
+	GetCourses(ctx context.Context, courseIDs []string) (map[string]*CourseInfo, error)
+}
+
+// enrichCourses resolves the courses referenced by the grades with a single batched lookup.
+// Lookup failures never fail the read; the affected courses are reported as unresolved instead.
+func enrichCourses(ctx context.Context, resolver CourseResolver,
+	grades []*Grade,
+) (map[string]*gpb.CourseInfo, []string) {
+	courseIDs := uniqueCourseIDs(grades)
+	if len(courseIDs) == 0 {
+		return nil, nil
+	}
+
+	resolved, err := resolver.GetCourses(ctx, courseIDs)
+	if err != nil {
+		klog.FromContext(ctx).Error(err, "Failed to resolve courses", "course_ids", courseIDs)
+	}
+
+	courses := make(map[string]*gpb.CourseInfo, len(resolved))
+
+	var unresolved []string
+
+	for _, courseID := range courseIDs {
+		course, ok := resolved[courseID]
+		if !ok || course == nil {
+			unresolved = append(unresolved, courseID)
+
+			continue
+		}
+
+		courses[courseID] = &gpb.CourseInfo{
+			CourseID: courseID,
+			Name:     course.Name,
+			Credits:  course.Credits,
+		}
+	}
+
+	return courses, unresolved
+}
+
+// uniqueCourseIDs returns the distinct course IDs referenced by the grades, sorted.
+func uniqueCourseIDs(grades []*Grade) []string {
+	seen := make(map[string]struct{}, len(grades))
+	courseIDs := make([]string, 0, len(grades))
+
+	for _, grade := range grades {
+		if _, ok := seen[grade.CourseID]; ok {
+			continue
+		}
+
+		seen[grade.CourseID] = struct{}{}
+		courseIDs = append(courseIDs, grade.CourseID)
+	}
+
+	sort.Strings(courseIDs)
+
+	return courseIDs
+}
@@ -0,0 +1,265 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	gpb "github.com/BetterGR/grades-microservice/protos"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/klog/v2"
+)
+
+// defaultCourseStatsMaxStaleness is how old course statistics may be when COURSE_STATS_MAX_STALENESS
+// is unset.
+const defaultCourseStatsMaxStaleness = 15 * time.Minute
+
+// runCourseStatsRefreshes refreshes the course statistics every interval until the context is done.
+func (s *GradesServer) runCourseStatsRefreshes(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := s.db.RefreshCourseStats(ctx); err != nil {
+				klog.ErrorS(err, "Failed to refresh course stats")
+			}
+		}
+	}
+}
+
+// RefreshCourseStats recomputes the statistics of every course. Requires the admin role.
+func (s *GradesServer) RefreshCourseStats(ctx context.Context,
+	req *gpb.RefreshCourseStatsRequest,
+) (*gpb.RefreshCourseStatsResponse, error) {
+	if err := s.requireRole(ctx, req.GetToken(), roleAdmin); err != nil {
+		return nil, err
+	}
+
+	logger := klog.FromContext(ctx)
+	logger.V(logLevelDebug).Info("Received request to refresh course stats")
+
+	if err := s.db.RefreshCourseStats(ctx); err != nil {
+		return nil, fmt.Errorf("failed to refresh course stats: %w", err)
+	}
+
+	return &gpb.RefreshCourseStatsResponse{}, nil
+}
+
+// GetCourseStats returns the grade count, average, minimum and maximum of a course in a semester.
+// They are read from the periodically refreshed statistics, or computed live when those are older
+// than COURSE_STATS_MAX_STALENESS. Requires the staff or admin role.
+func (s *GradesServer) GetCourseStats(ctx context.Context,
+	req *gpb.GetCourseStatsRequest,
+) (*gpb.GetCourseStatsResponse, error) {
+	if err := s.requireRole(ctx, req.GetToken(), roleStaff, roleAdmin); err != nil {
+		return nil, err
+	}
+
+	logger := klog.FromContext(ctx)
+	logger.V(logLevelDebug).Info("Received request for course stats", "course_id", req.GetCourseID(),
+		"semester", req.GetSemester())
+
+	stats, err := s.db.GetCourseStats(ctx, req.GetCourseID(), req.GetSemester(), s.courseStatsMaxStaleness)
+	if errors.Is(err, ErrCourseIDEmpty) {
+		return nil, fmt.Errorf("failed to get course stats: %w", status.Error(codes.InvalidArgument, err.Error()))
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to get course stats: %w", err)
+	}
+
+	resp := &gpb.GetCourseStatsResponse{
+		Count:        stats.Count,
+		NumericCount: stats.NumericCount,
+		RefreshedAt:  stats.RefreshedAt.UTC().Format(time.RFC3339),
+		Live:         stats.Live,
+	}
+
+	if stats.Average != nil {
+		resp.Average = s.rounder.RoundNumber(*stats.Average)
+	}
+
+	if stats.Minimum != nil {
+		resp.Minimum = s.rounder.RoundNumber(*stats.Minimum)
+	}
+
+	if stats.Maximum != nil {
+		resp.Maximum = s.rounder.RoundNumber(*stats.Maximum)
+	}
+
+	return resp, nil
+}
+
+// GetGradePercentiles returns the percentile rank of every numeric grade value of a course in a
+// semester, the percentage of numeric grades at or below it, and the quartile boundaries. Students
+// only get the percentiles of released courses.
+func (s *GradesServer) GetGradePercentiles(ctx context.Context,
+	req *gpb.GetGradePercentilesRequest,
+) (*gpb.GetGradePercentilesResponse, error) {
+	claims, err := s.getClaims(ctx, req.GetToken())
+	if err != nil {
+		return nil, fmt.Errorf("authentication failed: %w",
+			status.Error(codes.Unauthenticated, err.Error()))
+	}
+
+	logger := klog.FromContext(ctx)
+	logger.V(logLevelDebug).Info("Received request for grade percentiles", "course_id", req.GetCourseID(),
+		"semester", req.GetSemester())
+
+	if req.GetCourseID() == "" {
+		return nil, fmt.Errorf("failed to get grade percentiles: %w",
+			status.Error(codes.InvalidArgument, ErrCourseIDEmpty.Error()))
+	}
+
+	view, err := s.gradeView(ctx, claims)
+	if err != nil {
+		return nil, err
+	}
+
+	grades, err := s.db.GetCourseGrades(ctx, req.GetCourseID(), req.GetSemester())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get grade percentiles: %w", err)
+	}
+
+	percentiles := computePercentiles(view.embargo.released(grades), s.rounder)
+
+	resp := &gpb.GetGradePercentilesResponse{
+		Percentiles: make(map[string]float64, len(percentiles.ranks)),
+		P25:         percentiles.p25,
+		P50:         percentiles.p50,
+		P75:         percentiles.p75,
+		Count:       int32(percentiles.count),
+	}
+
+	for value, rank := range percentiles.ranks {
+		resp.Percentiles[strconv.FormatFloat(value, 'f', -1, 64)] = rank
+	}
+
+	return resp, nil
+}
+
+// GetCourseWeightedAverage returns the class average of a course in a semester, weighting the
+// average of each component by the weights from the request or the configured defaults, along with
+// the plain average for comparison. Students only get the averages of released courses.
+func (s *GradesServer) GetCourseWeightedAverage(ctx context.Context,
+	req *gpb.GetCourseWeightedAverageRequest,
+) (*gpb.GetCourseWeightedAverageResponse, error) {
+	claims, err := s.getClaims(ctx, req.GetToken())
+	if err != nil {
+		return nil, fmt.Errorf("authentication failed: %w",
+			status.Error(codes.Unauthenticated, err.Error()))
+	}
+
+	logger := klog.FromContext(ctx)
+	logger.V(logLevelDebug).Info("Received request for course weighted average", "course_id", req.GetCourseID(),
+		"semester", req.GetSemester(), "equal_weight_unweighted", req.GetEqualWeightUnweighted())
+
+	if req.GetCourseID() == "" {
+		return nil, fmt.Errorf("failed to get course weighted average: %w",
+			status.Error(codes.InvalidArgument, ErrCourseIDEmpty.Error()))
+	}
+
+	weights := s.weights
+	if len(req.GetWeights()) > 0 {
+		if weights, err = GradeWeights(req.GetWeights()).normalize(); err != nil {
+			return nil, fmt.Errorf("failed to get course weighted average: %w",
+				status.Error(codes.InvalidArgument, err.Error()))
+		}
+	}
+
+	view, err := s.gradeView(ctx, claims)
+	if err != nil {
+		return nil, err
+	}
+
+	grades, err := s.db.GetCourseGrades(ctx, req.GetCourseID(), req.GetSemester())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get course weighted average: %w", err)
+	}
+
+	average := computeClassAverage(view.embargo.released(grades), weights, req.GetEqualWeightUnweighted())
+
+	resp := &gpb.GetCourseWeightedAverageResponse{
+		WeightedAverage:    average.weighted,
+		UnweightedAverage:  average.unweighted,
+		Components:         make([]*gpb.ComponentAverage, 0, len(average.components)),
+		ExcludedGradeTypes: average.excluded,
+		Count:              int32(average.count),
+	}
+
+	for _, component := range average.components {
+		resp.Components = append(resp.Components, &gpb.ComponentAverage{
+			GradeType: component.component,
+			Average:   component.average,
+			Weight:    component.weight,
+			Count:     int32(component.count),
+		})
+	}
+
+	return resp, nil
+}
+
+// GetCourseGradeStatistics returns the mean, median, minimum, maximum and standard deviation of the
+// grades of a course in a semester whose values are numbers, optionally only those of a grade type,
+// matched case-insensitively, rounded by the grade rounding policy. The number of grades skipped
+// for not being numbers is returned too. Students only get the statistics of released courses.
+func (s *GradesServer) GetCourseGradeStatistics(ctx context.Context,
+	req *gpb.GetCourseGradeStatisticsRequest,
+) (*gpb.GetCourseGradeStatisticsResponse, error) {
+	claims, err := s.getClaims(ctx, req.GetToken())
+	if err != nil {
+		return nil, fmt.Errorf("authentication failed: %w",
+			status.Error(codes.Unauthenticated, err.Error()))
+	}
+
+	logger := klog.FromContext(ctx)
+	logger.V(logLevelDebug).Info("Received request for course grade statistics", "course_id", req.GetCourseID(),
+		"semester", req.GetSemester(), "grade_type", req.GetGradeType())
+
+	if req.GetCourseID() == "" {
+		return nil, fmt.Errorf("failed to get course grade statistics: %w",
+			status.Error(codes.InvalidArgument, ErrCourseIDEmpty.Error()))
+	}
+
+	view, err := s.gradeView(ctx, claims)
+	if err != nil {
+		return nil, err
+	}
+
+	grades, err := s.db.GetCourseGrades(ctx, req.GetCourseID(), req.GetSemester())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get course grade statistics: %w", err)
+	}
+
+	grades = view.embargo.released(grades)
+
+	if gradeType := strings.TrimSpace(req.GetGradeType()); gradeType != "" {
+		var ofType []*Grade
+
+		for _, grade := range grades {
+			if strings.EqualFold(strings.TrimSpace(grade.GradeType), gradeType) {
+				ofType = append(ofType, grade)
+			}
+		}
+
+		grades = ofType
+	}
+
+	stats := computeGradeStatistics(grades)
+
+	return &gpb.GetCourseGradeStatisticsResponse{
+		Mean:            s.rounder.RoundNumber(stats.mean),
+		Median:          s.rounder.RoundNumber(stats.median),
+		Min:             s.rounder.RoundNumber(stats.min),
+		Max:             s.rounder.RoundNumber(stats.max),
+		StdDev:          s.rounder.RoundNumber(stats.stdDev),
+		NumericCount:    int32(stats.count),
+		NonNumericCount: int32(stats.skipped),
+	}, nil
+}
@@ -0,0 +1,238 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	gpb "github.com/BetterGR/grades-microservice/protos"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestGetCourseStats(t *testing.T) {
+	client := setupClient(t)
+
+	grade := createTestGrade()
+
+	for _, value := range []string{"70", "90", "A"} {
+		grade.GradeID = ""
+		grade.ItemID = value
+		grade.GradeValue = value
+
+		_, err := client.AddSingleGrade(context.Background(), &gpb.AddSingleGradeRequest{Token: "test-token", Grade: grade})
+		require.NoError(t, err)
+	}
+
+	stats, err := client.GetCourseStats(context.Background(), &gpb.GetCourseStatsRequest{
+		Token: "test-token", CourseID: grade.GetCourseID(), Semester: grade.GetSemester(),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), stats.GetCount())
+	assert.Equal(t, int64(2), stats.GetNumericCount())
+	assert.InDelta(t, 80, stats.GetAverage(), 1e-9)
+	assert.InDelta(t, 70, stats.GetMinimum(), 1e-9)
+	assert.InDelta(t, 90, stats.GetMaximum(), 1e-9)
+	assert.NotEmpty(t, stats.GetRefreshedAt())
+
+	_, err = client.GetCourseStats(context.Background(), &gpb.GetCourseStatsRequest{Token: "test-token"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestRefreshCourseStatsRequiresAdmin(t *testing.T) {
+	db := NewMockDatabase()
+	staff := setupClient(t, func(s *GradesServer) {
+		s.db = db
+		s.Claims = RoleClaims{role: roleStaff}
+	})
+
+	_, err := staff.RefreshCourseStats(context.Background(), &gpb.RefreshCourseStatsRequest{Token: "test-token"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	admin := setupClient(t, func(s *GradesServer) {
+		s.db = db
+		s.Claims = RoleClaims{role: roleAdmin}
+	})
+
+	_, err = admin.RefreshCourseStats(context.Background(), &gpb.RefreshCourseStatsRequest{Token: "test-token"})
+	require.NoError(t, err)
+	assert.Equal(t, 1, db.statsRefresh)
+}
+
+func TestGetGradePercentiles(t *testing.T) {
+	db := NewMockDatabase()
+	client := setupClient(t, func(s *GradesServer) {
+		s.db = db
+		s.Claims = RoleClaims{role: roleStudent}
+	})
+
+	for _, value := range []string{"60", "70", "80", "80", "B"} {
+		grade := createTestGrade()
+		grade.CourseID, grade.GradeValue = "c1", value
+		_, err := db.AddGrade(context.Background(), grade)
+		require.NoError(t, err)
+	}
+
+	resp, err := client.GetGradePercentiles(context.Background(), &gpb.GetGradePercentilesRequest{
+		Token: "test-token", CourseID: "c1", Semester: "Winter_2023",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]float64{"60": 25, "70": 50, "80": 100}, resp.GetPercentiles())
+	assert.InDelta(t, 67.5, resp.GetP25(), 1e-9)
+	assert.InDelta(t, 75, resp.GetP50(), 1e-9)
+	assert.InDelta(t, 80, resp.GetP75(), 1e-9)
+	assert.Equal(t, int32(4), resp.GetCount())
+
+	resp, err = client.GetGradePercentiles(context.Background(), &gpb.GetGradePercentilesRequest{
+		Token: "test-token", CourseID: "empty", Semester: "Winter_2023",
+	})
+	require.NoError(t, err)
+	assert.Empty(t, resp.GetPercentiles())
+	assert.Zero(t, resp.GetCount())
+
+	_, err = client.GetGradePercentiles(context.Background(), &gpb.GetGradePercentilesRequest{Token: "test-token"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestGetCourseWeightedAverage(t *testing.T) {
+	db := NewMockDatabase()
+	client := setupClient(t, func(s *GradesServer) {
+		s.db = db
+		s.weights = GradeWeights{"homework": 20, "final": 80}
+	})
+
+	for _, grade := range []struct{ gradeType, value string }{
+		{"homework", "100"}, {"homework", "100"}, {"homework", "100"}, {"final", "50"}, {"quiz", "80"},
+	} {
+		newGrade := createTestGrade()
+		newGrade.CourseID, newGrade.GradeType, newGrade.GradeValue = "c1", grade.gradeType, grade.value
+		_, err := db.AddGrade(context.Background(), newGrade)
+		require.NoError(t, err)
+	}
+
+	resp, err := client.GetCourseWeightedAverage(context.Background(), &gpb.GetCourseWeightedAverageRequest{
+		Token: "test-token", CourseID: "c1", Semester: "Winter_2023",
+	})
+	require.NoError(t, err)
+	assert.InDelta(t, 86, resp.GetUnweightedAverage(), 1e-9)
+	assert.InDelta(t, 0.2*100+0.8*50, resp.GetWeightedAverage(), 1e-9)
+	assert.Equal(t, []string{"quiz"}, resp.GetExcludedGradeTypes())
+	assert.Equal(t, int32(5), resp.GetCount())
+	require.Len(t, resp.GetComponents(), 2)
+	assert.Equal(t, "final", resp.GetComponents()[0].GetGradeType())
+	assert.Equal(t, int32(3), resp.GetComponents()[1].GetCount())
+
+	resp, err = client.GetCourseWeightedAverage(context.Background(), &gpb.GetCourseWeightedAverageRequest{
+		Token: "test-token", CourseID: "c1", Semester: "Winter_2023",
+		Weights: map[string]float64{"Final": 1, "Quiz": 1}, EqualWeightUnweighted: true,
+	})
+	require.NoError(t, err)
+	assert.InDelta(t, (100+50+80)/3.0, resp.GetWeightedAverage(), 1e-9)
+	assert.Empty(t, resp.GetExcludedGradeTypes())
+
+	_, err = client.GetCourseWeightedAverage(context.Background(), &gpb.GetCourseWeightedAverageRequest{
+		Token: "test-token", CourseID: "c1", Weights: map[string]float64{"final": -1},
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = client.GetCourseWeightedAverage(context.Background(),
+		&gpb.GetCourseWeightedAverageRequest{Token: "test-token"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestGetGradePercentilesHidesUnreleasedCourses(t *testing.T) {
+	db := NewMockDatabase()
+	client := setupClient(t, func(s *GradesServer) {
+		s.db = db
+		s.Claims = RoleClaims{role: roleStudent}
+	})
+
+	grade := createTestGrade()
+	grade.CourseID, grade.GradeValue = "c1", "90"
+	_, err := db.AddGrade(context.Background(), grade)
+	require.NoError(t, err)
+	require.NoError(t, db.SetCourseRelease(context.Background(), "c1", "Winter_2023", time.Now().Add(time.Hour)))
+
+	resp, err := client.GetGradePercentiles(context.Background(), &gpb.GetGradePercentilesRequest{
+		Token: "test-token", CourseID: "c1", Semester: "Winter_2023",
+	})
+	require.NoError(t, err)
+	assert.Zero(t, resp.GetCount())
+}
+
+func TestGetCourseGradeStatistics(t *testing.T) {
+	db := NewMockDatabase()
+	client := setupClient(t, func(s *GradesServer) {
+		s.db = db
+		s.Claims = RoleClaims{role: roleStaff}
+	})
+
+	for _, grade := range []struct{ gradeType, value string }{
+		{"Exam", "60"}, {"exam", "90"}, {"Exam", "75"}, {"Exam", "absent"},
+		{"Homework", "100"}, {"Homework", "Pass"},
+	} {
+		added := createTestGrade()
+		added.CourseID, added.GradeType, added.GradeValue = "c1", grade.gradeType, grade.value
+		_, err := db.AddGrade(context.Background(), added)
+		require.NoError(t, err)
+	}
+
+	resp, err := client.GetCourseGradeStatistics(context.Background(), &gpb.GetCourseGradeStatisticsRequest{
+		Token: "test-token", CourseID: "c1", Semester: "Winter_2023", GradeType: "EXAM",
+	})
+	require.NoError(t, err)
+	assert.InDelta(t, 75, resp.GetMean(), 1e-9)
+	assert.InDelta(t, 75, resp.GetMedian(), 1e-9)
+	assert.InDelta(t, 60, resp.GetMin(), 1e-9)
+	assert.InDelta(t, 90, resp.GetMax(), 1e-9)
+	assert.InDelta(t, 12.247, resp.GetStdDev(), 0.001)
+	assert.Equal(t, int32(3), resp.GetNumericCount())
+	assert.Equal(t, int32(1), resp.GetNonNumericCount())
+
+	resp, err = client.GetCourseGradeStatistics(context.Background(), &gpb.GetCourseGradeStatisticsRequest{
+		Token: "test-token", CourseID: "c1", Semester: "Winter_2023",
+	})
+	require.NoError(t, err)
+	assert.InDelta(t, 81.25, resp.GetMean(), 1e-9)
+	assert.InDelta(t, 82.5, resp.GetMedian(), 1e-9)
+	assert.Equal(t, int32(4), resp.GetNumericCount())
+	assert.Equal(t, int32(2), resp.GetNonNumericCount())
+
+	resp, err = client.GetCourseGradeStatistics(context.Background(), &gpb.GetCourseGradeStatisticsRequest{
+		Token: "test-token", CourseID: "empty", Semester: "Winter_2023",
+	})
+	require.NoError(t, err)
+	assert.Zero(t, resp.GetMean())
+	assert.Zero(t, resp.GetNumericCount())
+
+	_, err = client.GetCourseGradeStatistics(context.Background(),
+		&gpb.GetCourseGradeStatisticsRequest{Token: "test-token"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestGetCourseGradeStatisticsRounded(t *testing.T) {
+	db := NewMockDatabase()
+	client := setupClient(t, func(s *GradesServer) {
+		s.db = db
+		s.rounder = roundingHalfUp
+	})
+
+	for _, value := range []string{"60", "90", "75", "100"} {
+		added := createTestGrade()
+		added.CourseID, added.GradeValue = "c1", value
+		_, err := db.AddGrade(context.Background(), added)
+		require.NoError(t, err)
+	}
+
+	resp, err := client.GetCourseGradeStatistics(context.Background(), &gpb.GetCourseGradeStatisticsRequest{
+		Token: "test-token", CourseID: "c1", Semester: "Winter_2023",
+	})
+	require.NoError(t, err)
+	assert.InDelta(t, 81.3, resp.GetMean(), 1e-9, "81.25 rounds half up")
+	assert.InDelta(t, 82.5, resp.GetMedian(), 1e-9)
+	assert.InDelta(t, 60, resp.GetMin(), 1e-9)
+	assert.InDelta(t, 100, resp.GetMax(), 1e-9)
+	assert.InDelta(t, 15.2, resp.GetStdDev(), 1e-9)
+}
@@ -0,0 +1,123 @@
+package main
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// encryptedCommentsPrefix marks a stored comment as ciphertext: "enc:<key id>:<base64 nonce+sealed>".
+const encryptedCommentsPrefix = "enc:"
+
+var (
+	ErrEncryptionKeyInvalid = errors.New("invalid comments encryption key")
+	ErrEncryptionKeyUnknown = errors.New("unknown comments encryption key id")
+	ErrCiphertextInvalid    = errors.New("invalid encrypted comments")
+)
+
+// commentsCipher encrypts grade comments at rest with AES-GCM.
+// Values are tagged with the id of the key that sealed them, so old keys can keep
+// decrypting existing rows while new writes use the active key.
+type commentsCipher struct {
+	activeKeyID string
+	keys        map[string]cipher.AEAD
+}
+
+// newCommentsCipher parses a key spec of the form "id:base64key[,id:base64key...]".
+// The first key is used for new writes, the rest are only used for decryption.
+func newCommentsCipher(spec string) (*commentsCipher, error) {
+	c := &commentsCipher{keys: make(map[string]cipher.AEAD)}
+
+	for _, entry := range strings.Split(spec, ",") {
+		keyID, encodedKey, found := strings.Cut(strings.TrimSpace(entry), ":")
+		if !found || keyID == "" {
+			return nil, fmt.Errorf("%w: expected id:base64key", ErrEncryptionKeyInvalid)
+		}
+
+		if _, exists := c.keys[keyID]; exists {
+			return nil, fmt.Errorf("%w: duplicate key id %q", ErrEncryptionKeyInvalid, keyID)
+		}
+
+		key, err := base64.StdEncoding.DecodeString(encodedKey)
+		if err != nil {
+			return nil, fmt.Errorf("%w: key %q is not valid base64", ErrEncryptionKeyInvalid, keyID)
+		}
+
+		block, err := aes.NewCipher(key)
+		if err != nil {
+			return nil, fmt.Errorf("%w: key %q: %w", ErrEncryptionKeyInvalid, keyID, err)
+		}
+
+		aead, err := cipher.NewGCM(block)
+		if err != nil {
+			return nil, fmt.Errorf("%w: key %q: %w", ErrEncryptionKeyInvalid, keyID, err)
+		}
+
+		if c.activeKeyID == "" {
+			c.activeKeyID = keyID
+		}
+
+		c.keys[keyID] = aead
+	}
+
+	return c, nil
+}
+
+// Encrypt seals the comments with the active key. A nil cipher and empty comments pass through unchanged.
+func (c *commentsCipher) Encrypt(comments string) (string, error) {
+	if c == nil || comments == "" {
+		return comments, nil
+	}
+
+	aead := c.keys[c.activeKeyID]
+
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", fmt.Errorf("failed to generate nonce: %w", err)
+	}
+
+	sealed := aead.Seal(nonce, nonce, []byte(comments), []byte(c.activeKeyID))
+
+	return encryptedCommentsPrefix + c.activeKeyID + ":" + base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+// Decrypt opens comments sealed by Encrypt. Values without the encryption prefix were written
+// before encryption was enabled and are returned as is.
+func (c *commentsCipher) Decrypt(stored string) (string, error) {
+	payload, encrypted := strings.CutPrefix(stored, encryptedCommentsPrefix)
+	if !encrypted {
+		return stored, nil
+	}
+
+	if c == nil {
+		return "", fmt.Errorf("%w: comments are encrypted but no key is configured", ErrEncryptionKeyUnknown)
+	}
+
+	keyID, encoded, found := strings.Cut(payload, ":")
+	if !found {
+		return "", fmt.Errorf("%w", ErrCiphertextInvalid)
+	}
+
+	aead, exists := c.keys[keyID]
+	if !exists {
+		return "", fmt.Errorf("%w: %q", ErrEncryptionKeyUnknown, keyID)
+	}
+
+	sealed, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil || len(sealed) < aead.NonceSize() {
+		return "", fmt.Errorf("%w", ErrCiphertextInvalid)
+	}
+
+	nonce, ciphertext := sealed[:aead.NonceSize()], sealed[aead.NonceSize():]
+
+	plaintext, err := aead.Open(nil, nonce, ciphertext, []byte(keyID))
+	if err != nil {
+		return "", fmt.Errorf("%w: %w", ErrCiphertextInvalid, err)
+	}
+
+	return string(plaintext), nil
+}
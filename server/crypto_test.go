@@ -0,0 +1,120 @@
+package main
+
+import (
+	"bytes"
+	"encoding/base64"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testKeySpec(keyID string, fill byte) string {
+	return keyID + ":" + base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{fill}, 32))
+}
+
+func TestCommentsCipherRoundTrip(t *testing.T) {
+	c, err := newCommentsCipher(testKeySpec("k1", 1))
+	require.NoError(t, err)
+
+	sealed, err := c.Encrypt("needs a second look")
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(sealed, "enc:k1:"))
+	assert.NotContains(t, sealed, "second look")
+
+	opened, err := c.Decrypt(sealed)
+	require.NoError(t, err)
+	assert.Equal(t, "needs a second look", opened)
+
+	// Fresh nonces make repeated encryptions differ.
+	again, err := c.Encrypt("needs a second look")
+	require.NoError(t, err)
+	assert.NotEqual(t, sealed, again)
+}
+
+func TestCommentsCipherKeyRotation(t *testing.T) {
+	old, err := newCommentsCipher(testKeySpec("k1", 1))
+	require.NoError(t, err)
+
+	sealed, err := old.Encrypt("written before rotation")
+	require.NoError(t, err)
+
+	rotated, err := newCommentsCipher(testKeySpec("k2", 2) + "," + testKeySpec("k1", 1))
+	require.NoError(t, err)
+
+	opened, err := rotated.Decrypt(sealed)
+	require.NoError(t, err)
+	assert.Equal(t, "written before rotation", opened)
+
+	resealed, err := rotated.Encrypt("written after rotation")
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(resealed, "enc:k2:"))
+
+	_, err = old.Decrypt(resealed)
+	require.ErrorIs(t, err, ErrEncryptionKeyUnknown)
+}
+
+func TestCommentsCipherRejectsTamperedValue(t *testing.T) {
+	c, err := newCommentsCipher(testKeySpec("k1", 1))
+	require.NoError(t, err)
+
+	sealed, err := c.Encrypt("original")
+	require.NoError(t, err)
+
+	// Claiming a different key id breaks the authenticated data even when that key exists.
+	other, err := newCommentsCipher(testKeySpec("k1", 1) + "," + testKeySpec("k2", 1))
+	require.NoError(t, err)
+
+	_, err = other.Decrypt(strings.Replace(sealed, "enc:k1:", "enc:k2:", 1))
+	require.ErrorIs(t, err, ErrCiphertextInvalid)
+}
+
+func TestCommentsCipherInvalidSpec(t *testing.T) {
+	for _, spec := range []string{
+		"no-separator",
+		":" + base64.StdEncoding.EncodeToString(make([]byte, 32)),
+		"k1:not-base64!",
+		"k1:" + base64.StdEncoding.EncodeToString(make([]byte, 10)),
+		testKeySpec("k1", 1) + "," + testKeySpec("k1", 2),
+	} {
+		_, err := newCommentsCipher(spec)
+		require.ErrorIs(t, err, ErrEncryptionKeyInvalid, spec)
+	}
+}
+
+func TestCommentsWithoutKeyStayPlaintext(t *testing.T) {
+	database := &Database{}
+	grade := &Grade{GradeID: "g1", Comments: "plain comment"}
+
+	require.NoError(t, database.sealComments(grade))
+	assert.Equal(t, "plain comment", grade.Comments)
+
+	require.NoError(t, database.openComments(grade))
+	assert.Equal(t, "plain comment", grade.Comments)
+
+	// Encrypted values cannot be read back once the key is removed.
+	_, err := database.comments.Decrypt("enc:k1:AAAA")
+	require.ErrorIs(t, err, ErrEncryptionKeyUnknown)
+}
+
+func TestDatabaseCommentsEncryption(t *testing.T) {
+	c, err := newCommentsCipher(testKeySpec("k1", 1))
+	require.NoError(t, err)
+
+	database := &Database{comments: c}
+	grade := &Grade{GradeID: "g1", Comments: "late submission"}
+
+	require.NoError(t, database.sealComments(grade))
+	assert.True(t, strings.HasPrefix(grade.Comments, encryptedCommentsPrefix))
+
+	// Rows written before encryption was enabled are still readable.
+	legacy := &Grade{GradeID: "g2", Comments: "legacy comment"}
+	require.NoError(t, database.openComments(grade, legacy))
+	assert.Equal(t, "late submission", grade.Comments)
+	assert.Equal(t, "legacy comment", legacy.Comments)
+
+	matches := filterCommentsContaining([]*Grade{grade, legacy}, "LATE")
+	require.Len(t, matches, 1)
+	assert.Equal(t, "g1", matches[0].GradeID)
+}
@@ -0,0 +1,170 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	gpb "github.com/BetterGR/grades-microservice/protos"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/klog/v2"
+)
+
+// Curve kinds.
+const (
+	// curveAdd adds the amount to every numeric grade.
+	curveAdd = "add"
+	// curveScale multiplies every numeric grade by the amount.
+	curveScale = "scale"
+	// curveSqrt replaces every numeric grade x by 10 * sqrt(x), raising low grades the most.
+	curveSqrt = "sqrt"
+)
+
+var (
+	ErrCurveKindInvalid   = errors.New("curve must be add, scale or sqrt")
+	ErrCurveAmountInvalid = errors.New("invalid curve amount")
+)
+
+// gradeCurve is a validated curve operation.
+type gradeCurve struct {
+	kind   string
+	amount float64
+}
+
+// parseGradeCurve validates a curve operation. Scale amounts must be positive, and sqrt takes no amount.
+func parseGradeCurve(op *gpb.CurveOperation) (gradeCurve, error) {
+	curve := gradeCurve{kind: strings.ToLower(strings.TrimSpace(op.GetKind())), amount: op.GetAmount()}
+
+	if math.IsNaN(curve.amount) || math.IsInf(curve.amount, 0) {
+		return gradeCurve{}, fmt.Errorf("%w: %v", ErrCurveAmountInvalid, curve.amount)
+	}
+
+	switch curve.kind {
+	case curveAdd:
+	case curveScale:
+		if curve.amount <= 0 {
+			return gradeCurve{}, fmt.Errorf("%w: scale must be positive, got %v", ErrCurveAmountInvalid, curve.amount)
+		}
+	case curveSqrt:
+		if curve.amount != 0 {
+			return gradeCurve{}, fmt.Errorf("%w: sqrt takes no amount, got %v", ErrCurveAmountInvalid, curve.amount)
+		}
+	default:
+		return gradeCurve{}, fmt.Errorf("%w: %q", ErrCurveKindInvalid, op.GetKind())
+	}
+
+	return curve, nil
+}
+
+// apply curves a grade value, keeping the result between minNumericGrade and maxNumericGrade.
+// Values that are not numeric, such as letter grades, are returned unchanged.
+func (c gradeCurve) apply(value string) string {
+	number, ok := parseNumericGrade(value)
+	if !ok {
+		return value
+	}
+
+	switch c.kind {
+	case curveAdd:
+		number += c.amount
+	case curveScale:
+		number *= c.amount
+	case curveSqrt:
+		number = 10 * math.Sqrt(max(number, 0))
+	}
+
+	number = min(max(number, minNumericGrade), maxNumericGrade)
+
+	return strconv.FormatFloat(number, 'f', -1, 64)
+}
+
+// curveGrades returns copies of the grades with curved values, leaving the grades untouched.
+func curveGrades(grades []*Grade, curve gradeCurve) []*Grade {
+	curved := make([]*Grade, 0, len(grades))
+
+	for _, grade := range grades {
+		copied := *grade
+		copied.GradeValue = curve.apply(grade.GradeValue)
+		curved = append(curved, &copied)
+	}
+
+	return curved
+}
+
+// distributionToProto converts a grade distribution for a response.
+func distributionToProto(buckets []gradeBucket) []*gpb.GradeValueCount {
+	counts := make([]*gpb.GradeValueCount, 0, len(buckets))
+	for _, bucket := range buckets {
+		counts = append(counts, &gpb.GradeValueCount{GradeValue: bucket.value, Count: int32(bucket.count)})
+	}
+
+	return counts
+}
+
+// PreviewCurve computes the effect of a curve on the grades of a course item without writing them:
+// the distribution before and after, and every student's value before and after. Requires the
+// staff role and, for non-admins, permission to grade the course.
+func (s *GradesServer) PreviewCurve(ctx context.Context,
+	req *gpb.PreviewCurveRequest,
+) (*gpb.PreviewCurveResponse, error) {
+	claims, err := s.authorizeRoles(ctx, req.GetToken(), roleStaff, roleAdmin)
+	if err != nil {
+		return nil, err
+	}
+
+	logger := klog.FromContext(ctx)
+	logger.V(logLevelDebug).Info("Received request to preview curve", "course_id", req.GetCourseID(),
+		"semester", req.GetSemester(), "item_id", req.GetItemID(), "curve", req.GetCurve().GetKind())
+
+	switch {
+	case req.GetCourseID() == "":
+		return nil, fmt.Errorf("failed to preview curve: %w", status.Error(codes.InvalidArgument, ErrCourseIDEmpty.Error()))
+	case strings.TrimSpace(req.GetItemID()) == "":
+		return nil, fmt.Errorf("failed to preview curve: %w", status.Error(codes.InvalidArgument, ErrItemIDEmpty.Error()))
+	}
+
+	curve, err := parseGradeCurve(req.GetCurve())
+	if err != nil {
+		return nil, fmt.Errorf("failed to preview curve: %w", status.Error(codes.InvalidArgument, err.Error()))
+	}
+
+	if err := s.authorizeGrader(ctx, claims, req.GetCourseID()); err != nil {
+		return nil, err
+	}
+
+	courseGrades, err := s.db.GetCourseGrades(ctx, req.GetCourseID(), req.GetSemester())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get course grades: %w", err)
+	}
+
+	var grades []*Grade
+
+	for _, grade := range courseGrades {
+		if grade.ItemID == req.GetItemID() {
+			grades = append(grades, grade)
+		}
+	}
+
+	curved := curveGrades(grades, curve)
+
+	resp := &gpb.PreviewCurveResponse{
+		Before: distributionToProto(gradeDistribution(grades, s.rounder)),
+		After:  distributionToProto(gradeDistribution(curved, s.rounder)),
+		Grades: make([]*gpb.CurvedGrade, 0, len(grades)),
+	}
+
+	for i, grade := range grades {
+		resp.Grades = append(resp.Grades, &gpb.CurvedGrade{
+			GradeID:   grade.GradeID,
+			StudentID: grade.StudentID,
+			Before:    s.rounder.Round(grade.GradeValue),
+			After:     s.rounder.Round(curved[i].GradeValue),
+		})
+	}
+
+	return resp, nil
+}
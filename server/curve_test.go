@@ -0,0 +1,121 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	gpb "github.com/BetterGR/grades-microservice/protos"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestParseGradeCurve(t *testing.T) {
+	curve, err := parseGradeCurve(&gpb.CurveOperation{Kind: " Add ", Amount: 5})
+	require.NoError(t, err)
+	assert.Equal(t, gradeCurve{kind: curveAdd, amount: 5}, curve)
+
+	for _, op := range []*gpb.CurveOperation{
+		{Kind: "bell"},
+		{},
+		{Kind: curveScale, Amount: 0},
+		{Kind: curveScale, Amount: -1.1},
+		{Kind: curveSqrt, Amount: 2},
+	} {
+		_, err := parseGradeCurve(op)
+		require.Error(t, err, op.String())
+	}
+}
+
+func TestGradeCurveApply(t *testing.T) {
+	tests := []struct {
+		curve gradeCurve
+		value string
+		want  string
+	}{
+		{gradeCurve{kind: curveAdd, amount: 5}, "80", "85"},
+		{gradeCurve{kind: curveAdd, amount: 5}, "98", "100"},
+		{gradeCurve{kind: curveAdd, amount: -10}, "4", "0"},
+		{gradeCurve{kind: curveScale, amount: 1.1}, "50", "55.00000000000001"},
+		{gradeCurve{kind: curveScale, amount: 1.5}, "90", "100"},
+		{gradeCurve{kind: curveSqrt}, "64", "80"},
+		{gradeCurve{kind: curveSqrt}, "100", "100"},
+		{gradeCurve{kind: curveAdd, amount: 5}, "B+", "B+"},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, tt.curve.apply(tt.value), "%s %v (%s)", tt.curve.kind, tt.curve.amount, tt.value)
+	}
+}
+
+func TestPreviewCurve(t *testing.T) {
+	db := NewMockDatabase()
+	client := setupClient(t, func(s *GradesServer) {
+		s.db = db
+		s.Claims = RoleClaims{role: roleStaff}
+	})
+
+	values := map[string]string{"s1": "64", "s2": "81", "s3": "81", "s4": "Pass"}
+	for studentID, value := range values {
+		grade := createTestGrade()
+		grade.StudentID, grade.CourseID, grade.ItemID, grade.GradeValue = studentID, "c1", "hw1", value
+		_, err := db.AddGrade(context.Background(), grade)
+		require.NoError(t, err)
+	}
+
+	other := createTestGrade()
+	other.CourseID, other.ItemID, other.GradeValue = "c1", "hw2", "10"
+	_, err := db.AddGrade(context.Background(), other)
+	require.NoError(t, err)
+
+	resp, err := client.PreviewCurve(context.Background(), &gpb.PreviewCurveRequest{
+		Token: "test-token", CourseID: other.GetCourseID(), Semester: other.GetSemester(), ItemID: "hw1",
+		Curve: &gpb.CurveOperation{Kind: curveSqrt},
+	})
+	require.NoError(t, err)
+
+	counts := func(buckets []*gpb.GradeValueCount) map[string]int32 {
+		result := make(map[string]int32)
+		for _, bucket := range buckets {
+			result[bucket.GetGradeValue()] = bucket.GetCount()
+		}
+
+		return result
+	}
+
+	assert.Equal(t, map[string]int32{"64": 1, "81": 2, "Pass": 1}, counts(resp.GetBefore()))
+	assert.Equal(t, map[string]int32{"80": 1, "90": 2, "Pass": 1}, counts(resp.GetAfter()))
+
+	require.Len(t, resp.GetGrades(), len(values), "only the item's grades are curved")
+
+	curve := gradeCurve{kind: curveSqrt}
+
+	for _, grade := range resp.GetGrades() {
+		assert.Equal(t, values[grade.GetStudentID()], grade.GetBefore())
+		assert.Equal(t, curve.apply(values[grade.GetStudentID()]), grade.GetAfter(), "preview matches the curve math")
+
+		stored, err := db.GetGradeByID(context.Background(), grade.GetGradeID())
+		require.NoError(t, err)
+		assert.Equal(t, values[grade.GetStudentID()], stored.GradeValue, "the preview writes nothing")
+	}
+}
+
+func TestPreviewCurveErrors(t *testing.T) {
+	client := setupClient(t, func(s *GradesServer) { s.Claims = RoleClaims{role: roleStaff} })
+
+	for _, req := range []*gpb.PreviewCurveRequest{
+		{Token: "test-token", ItemID: "hw1", Curve: &gpb.CurveOperation{Kind: curveSqrt}},
+		{Token: "test-token", CourseID: "c1", Curve: &gpb.CurveOperation{Kind: curveSqrt}},
+		{Token: "test-token", CourseID: "c1", ItemID: "hw1", Curve: &gpb.CurveOperation{Kind: "bell"}},
+	} {
+		_, err := client.PreviewCurve(context.Background(), req)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err), req.String())
+	}
+
+	student := setupClient(t, func(s *GradesServer) { s.Claims = RoleClaims{role: roleStudent} })
+	_, err := student.PreviewCurve(context.Background(), &gpb.PreviewCurveRequest{
+		Token: "test-token", CourseID: "c1", ItemID: "hw1", Curve: &gpb.CurveOperation{Kind: curveSqrt},
+	})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
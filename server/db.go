@@ -2,10 +2,15 @@ package main
 
 import (
 	"context"
+	"crypto/sha256"
 	"database/sql"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"os"
+	"reflect"
+	"slices"
+	"strings"
 	"time"
 
 	gpb "github.com/BetterGR/grades-microservice/protos"
@@ -18,31 +23,158 @@ import (
 // Database represents the database connection.
 type Database struct {
 	db *bun.DB
+	// replica serves the plain reads when set; writes and reads that must see them use db.
+	replica *bun.DB
+	// comments encrypts grade comments at rest; comments are stored as plaintext when nil.
+	comments *commentsCipher
+	// onDuplicate decides what adding a second grade for the same student and item does.
+	onDuplicate DuplicatePolicy
+	// newID generates the IDs of added grades; the database generates them when nil.
+	newID func() string
+	// inListChunkSize bounds the values of a single IN list; defaultInListChunkSize when 0.
+	inListChunkSize int
+	// copyThreshold is the smallest batch AddGrades writes with a single COPY instead of one INSERT
+	// per grade; batches are always inserted when 0.
+	copyThreshold int
+}
+
+// databaseOptions configures a database, real or mock.
+type databaseOptions struct {
+	newID           func() string
+	inListChunkSize int
+	dsn             string
+}
+
+// DatabaseOption configures a database, real or mock.
+type DatabaseOption func(*databaseOptions)
+
+// WithIDGenerator makes the database use newID to generate the IDs of added grades, such as a
+// deterministic sequence in tests. By default the IDs are random UUIDs.
+func WithIDGenerator(newID func() string) DatabaseOption {
+	return func(options *databaseOptions) {
+		options.newID = newID
+	}
+}
+
+// WithDSN makes the database connect to dsn instead of DSN, without the read replica of REPLICA_DSN,
+// which belongs to the database of DSN.
+func WithDSN(dsn string) DatabaseOption {
+	return func(options *databaseOptions) {
+		options.dsn = dsn
+	}
+}
+
+// WithInListChunkSize makes the database split IN lists longer than size into several queries.
+// By default lists are split at DB_IN_LIST_CHUNK_SIZE, or defaultInListChunkSize when unset.
+func WithInListChunkSize(size int) DatabaseOption {
+	return func(options *databaseOptions) {
+		options.inListChunkSize = size
+	}
+}
+
+// newDatabaseOptions applies opts over the defaults.
+func newDatabaseOptions(opts ...DatabaseOption) databaseOptions {
+	var options databaseOptions
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	return options
 }
 
 // Verify that Database implements DBInterface at compile time.
 var _ DBInterface = (*Database)(nil)
 
+const (
+	// archivedGradesTable holds grades moved out of the live table, using the same schema.
+	archivedGradesTable = "archived_grades"
+	// maxSearchTermLength bounds search terms so pattern scans stay cheap.
+	maxSearchTermLength = 100
+	// maxMultiCourseIDs bounds the number of courses read in a single multi-course query.
+	maxMultiCourseIDs = 50
+	// maxMultiSemesters bounds the number of semesters read in a single multi-semester query.
+	maxMultiSemesters = 20
+	// minStudentPrefixLength keeps prefix searches from matching most of the table.
+	minStudentPrefixLength = 3
+	// maxStudentPrefixResults bounds the grades returned by a single prefix search.
+	maxStudentPrefixResults = 200
+	// defaultInListChunkSize bounds the values of a single IN list. Postgres accepts at most 65535
+	// parameters per statement, and a chunk leaves room for the statement's other parameters.
+	defaultInListChunkSize = 10000
+	// defaultCopyThreshold is the smallest batch AddGrades writes with COPY when DB_COPY_THRESHOLD is unset.
+	defaultCopyThreshold = 50
+)
+
 var (
 	ErrGradeNil       = errors.New("grade is nil")
 	ErrStudentIDEmpty = errors.New("student ID is empty")
 	ErrCourseIDEmpty  = errors.New("course ID is empty")
 	ErrGradeIDEmpty   = errors.New("grade ID is empty")
+	ErrSemesterEmpty  = errors.New("semester is empty")
+	ErrItemIDEmpty    = errors.New("item ID is empty")
+
+	ErrGradeNotFound        = errors.New("grade not found")
+	ErrGradeVersionNotFound = errors.New("grade version not found")
+	ErrGradeVersionConflict = errors.New("grade was modified concurrently")
+	ErrNoPriorGradeValue    = errors.New("grade has no prior value to restore")
+
+	ErrSearchTermEmpty   = errors.New("search term is empty")
+	ErrSearchTermTooLong = errors.New("search term is too long")
+
+	ErrTooManyCourses   = errors.New("too many courses requested")
+	ErrTooManySemesters = errors.New("too many semesters requested")
+
+	ErrStudentPrefixTooShort = errors.New("student ID prefix is too short")
+
+	ErrGraderIDEmpty = errors.New("grader ID is empty")
+
+	ErrGradeTypeEmpty = errors.New("grade type is empty")
 )
 
+// gradeColumnMigrations adds columns introduced after the initial schema to existing grade tables.
+// New columns must be appended to the end of Grade in the same order, so the live and archive
+// tables keep identical column layouts.
+var gradeColumnMigrations = []string{
+	"version BIGINT NOT NULL DEFAULT 1",
+	"flagged BOOLEAN NOT NULL DEFAULT FALSE",
+	"numeric_value DOUBLE PRECISION",
+	"deleted_at TIMESTAMPTZ",
+	"rubric_scores JSONB",
+	"institution_id VARCHAR NOT NULL DEFAULT ''",
+	"submitted_at TIMESTAMPTZ",
+	"due_at TIMESTAMPTZ",
+	"changed_by VARCHAR NOT NULL DEFAULT ''",
+	"appeal_status VARCHAR NOT NULL DEFAULT ''",
+	"section_id VARCHAR NOT NULL DEFAULT ''",
+}
+
 // InitializeDatabase ensures that the database exists and initializes the schema.
-func InitializeDatabase() (*Database, error) {
+func InitializeDatabase(opts ...DatabaseOption) (*Database, error) {
 	createDatabaseIfNotExists()
 
-	database, err := ConnectDB()
+	database, err := ConnectDB(opts...)
 	if err != nil {
 		return nil, err
 	}
 
+	// Prime the pool so the first requests after a deploy do not wait for new connections.
+	if envBool("DB_WARMUP", false) {
+		warmed, err := database.warmup(context.Background(), max(envInt("DB_MIN_IDLE_CONNS", defaultMinIdleConns), 1))
+		if err != nil {
+			return nil, fmt.Errorf("failed to warm up database connections: %w", err)
+		}
+
+		klog.V(logLevelDebug).Infof("Warmed up %d database connections.", warmed)
+	}
+
 	if err := database.createSchemaIfNotExists(context.Background()); err != nil {
 		klog.Fatalf("Failed to create schema: %v", err)
 	}
 
+	if err := database.validateSchema(context.Background(), schemaValidationMode()); err != nil {
+		klog.Fatalf("Schema validation failed: %v", err)
+	}
+
 	return database, nil
 }
 
@@ -77,10 +209,17 @@ func createDatabaseIfNotExists() {
 }
 
 // ConnectDB connects to the database.
-func ConnectDB() (*Database, error) {
-	dsn := os.Getenv("DSN")
+func ConnectDB(opts ...DatabaseOption) (*Database, error) {
+	options := newDatabaseOptions(opts...)
+
+	dsn := options.dsn
+	if dsn == "" {
+		dsn = os.Getenv("DSN")
+	}
+
 	connector := pgdriver.NewConnector(pgdriver.WithDSN(dsn))
 	sqldb := sql.OpenDB(connector)
+	sqldb.SetMaxOpenConns(max(envInt("DB_MAX_OPEN_CONNS", 0), 0))
 	database := bun.NewDB(sqldb, pgdialect.New())
 
 	// Test the connection.
@@ -90,19 +229,246 @@ func ConnectDB() (*Database, error) {
 
 	klog.V(logLevelDebug).Info("Connected to PostgreSQL database.")
 
-	return &Database{db: database}, nil
+	var comments *commentsCipher
+
+	if spec := os.Getenv("COMMENTS_ENCRYPTION_KEY"); spec != "" {
+		var err error
+		if comments, err = newCommentsCipher(spec); err != nil {
+			return nil, fmt.Errorf("failed to load comments encryption key: %w", err)
+		}
+
+		klog.V(logLevelDebug).Info("Comments encryption at rest enabled.")
+	}
+
+	onDuplicate, err := parseDuplicatePolicy(os.Getenv("ON_DUPLICATE"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to configure duplicate grades: %w", err)
+	}
+
+	var replica *bun.DB
+
+	if dsn := os.Getenv("REPLICA_DSN"); dsn != "" && options.dsn == "" {
+		if replica, err = connectReplica(dsn); err != nil {
+			return nil, err
+		}
+
+		klog.V(logLevelDebug).Info("Connected to PostgreSQL read replica.")
+	}
+
+	if options.inListChunkSize <= 0 {
+		options.inListChunkSize = envInt("DB_IN_LIST_CHUNK_SIZE", defaultInListChunkSize)
+	}
+
+	return &Database{
+		db:              database,
+		replica:         replica,
+		comments:        comments,
+		onDuplicate:     onDuplicate,
+		newID:           options.newID,
+		inListChunkSize: options.inListChunkSize,
+		copyThreshold:   max(envInt("DB_COPY_THRESHOLD", defaultCopyThreshold), 0),
+	}, nil
+}
+
+// inChunks calls fn with consecutive chunks of values holding at most size values each, so IN
+// lists built from them stay under the database's parameter limit. It stops at the first error.
+func inChunks[T any](values []T, size int, fn func(chunk []T) error) error {
+	if size <= 0 {
+		size = defaultInListChunkSize
+	}
+
+	for start := 0; start < len(values); start += size {
+		if err := fn(values[start:min(start+size, len(values))]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// sealComments encrypts the comments of a grade before it is written.
+func (d *Database) sealComments(grade *Grade) error {
+	comments, err := d.comments.Encrypt(grade.Comments)
+	if err != nil {
+		return fmt.Errorf("failed to encrypt comments: %w", err)
+	}
+
+	grade.Comments = comments
+
+	return nil
+}
+
+// openComments decrypts the comments of grades read from the database.
+func (d *Database) openComments(grades ...*Grade) error {
+	for _, grade := range grades {
+		comments, err := d.comments.Decrypt(grade.Comments)
+		if err != nil {
+			return fmt.Errorf("failed to decrypt comments of grade %s: %w", grade.GradeID, err)
+		}
+
+		grade.Comments = comments
+	}
+
+	return nil
+}
+
+// schemaIndex is a secondary index of a table.
+type schemaIndex struct {
+	name    string
+	columns []string
+}
+
+// schemaTable is a table created at startup from its model, along with its secondary indexes.
+// Tables scoped to an institution name their primary key, which leads with institution_id, so
+// the tables of older deployments can be re-keyed. Columns added to a model after its table was
+// first created are listed in migrations, so they are added to the tables of older deployments.
+type schemaTable struct {
+	name       string
+	model      interface{}
+	indexes    []schemaIndex
+	tenantKey  []string
+	migrations []string
+}
+
+// schemaTables lists the tables of the schema in creation order. The grades table comes first since
+// the history and audit tables refer to its grade IDs.
+var schemaTables = []schemaTable{
+	{name: "grades", model: (*Grade)(nil)},
+	{name: "grade_history", model: (*GradeHistory)(nil), indexes: []schemaIndex{
+		{name: "grade_history_grade_idx", columns: []string{"grade_id"}},
+		{name: "grade_history_changed_at_idx", columns: []string{"changed_at"}},
+	}, migrations: []string{
+		"rubric_scores JSONB",
+		"institution_id VARCHAR NOT NULL DEFAULT ''",
+		"submitted_at TIMESTAMPTZ",
+		"due_at TIMESTAMPTZ",
+		"changed_by VARCHAR NOT NULL DEFAULT ''",
+		"appeal_status VARCHAR NOT NULL DEFAULT ''",
+		"section_id VARCHAR NOT NULL DEFAULT ''",
+	}},
+	{name: "audit_log", model: (*AuditEntry)(nil), indexes: []schemaIndex{
+		{name: "audit_log_grade_idx", columns: []string{"grade_id"}},
+		{name: "audit_log_created_at_idx", columns: []string{"created_at"}},
+	}},
+	{name: "failed_events", model: (*FailedEvent)(nil)},
+	{name: "notification_opt_outs", model: (*NotificationOptOut)(nil),
+		tenantKey: []string{"institution_id", "student_id"}},
+	{name: "notification_digests", model: (*NotificationDigest)(nil),
+		tenantKey: []string{"institution_id", "student_id"}},
+	{name: "course_graders", model: (*CourseGrader)(nil),
+		tenantKey: []string{"institution_id", "course_id", "grader_id"}},
+	{name: "course_releases", model: (*CourseRelease)(nil),
+		tenantKey: []string{"institution_id", "course_id", "semester"}},
+	{name: "grade_type_schemes", model: (*GradeTypeScheme)(nil),
+		tenantKey: []string{"institution_id", "course_id", "grade_type"}},
+	{name: "attempt_policies", model: (*CourseAttemptPolicy)(nil),
+		tenantKey: []string{"institution_id", "course_id"}},
+}
+
+// migrateTenantKey adds the institution to a table created before it was scoped to one, and makes
+// it lead the primary key so each institution has its own rows. It is idempotent.
+func (d *Database) migrateTenantKey(ctx context.Context, table schemaTable) error {
+	if _, err := d.db.ExecContext(ctx,
+		"ALTER TABLE ? ADD COLUMN IF NOT EXISTS institution_id VARCHAR NOT NULL DEFAULT ''",
+		bun.Ident(table.name)); err != nil {
+		return fmt.Errorf("failed to migrate table %s: %w", table.name, err)
+	}
+
+	var keyed bool
+	if err := d.db.NewSelect().TableExpr("pg_index AS i").
+		Join("JOIN pg_attribute AS a ON a.attrelid = i.indrelid AND a.attnum = ANY(i.indkey)").
+		ColumnExpr("COUNT(*) > 0").
+		Where("i.indrelid = ?::regclass AND i.indisprimary AND a.attname = 'institution_id'", table.name).
+		Scan(ctx, &keyed); err != nil {
+		return fmt.Errorf("failed to inspect primary key of table %s: %w", table.name, err)
+	}
+
+	if keyed {
+		return nil
+	}
+
+	if _, err := d.db.ExecContext(ctx, "ALTER TABLE ? DROP CONSTRAINT IF EXISTS ?, ADD PRIMARY KEY (?)",
+		bun.Ident(table.name), bun.Ident(table.name+"_pkey"), bun.In(identifiers(table.tenantKey))); err != nil {
+		return fmt.Errorf("failed to re-key table %s: %w", table.name, err)
+	}
+
+	return nil
+}
+
+// columnNames returns the columns of a model in the order its fields are declared.
+func columnNames(db *bun.DB, model interface{}) []string {
+	fields := db.Table(reflect.TypeOf(model).Elem()).Fields
+
+	columns := make([]string, len(fields))
+	for i, field := range fields {
+		columns[i] = field.Name
+	}
+
+	return columns
+}
+
+// identifiers quotes column names for a query.
+func identifiers(columns []string) []bun.Ident {
+	idents := make([]bun.Ident, len(columns))
+	for i, column := range columns {
+		idents[i] = bun.Ident(column)
+	}
+
+	return idents
 }
 
-// createSchemaIfNotExists creates the database schema if it doesn't exist.
+// createSchemaIfNotExists creates the database schema if it doesn't exist. It is idempotent, so it
+// also brings the schema of an older deployment up to date.
 func (d *Database) createSchemaIfNotExists(ctx context.Context) error {
-	models := []interface{}{
-		(*Grade)(nil),
+	for _, table := range schemaTables {
+		if _, err := d.db.NewCreateTable().IfNotExists().Model(table.model).Exec(ctx); err != nil {
+			return fmt.Errorf("failed to create table %s: %w", table.name, err)
+		}
+
+		for _, column := range table.migrations {
+			if _, err := d.db.ExecContext(ctx, "ALTER TABLE ? ADD COLUMN IF NOT EXISTS "+column,
+				bun.Ident(table.name)); err != nil {
+				return fmt.Errorf("failed to migrate table %s: %w", table.name, err)
+			}
+		}
+
+		for _, index := range table.indexes {
+			if _, err := d.db.NewCreateIndex().IfNotExists().Model(table.model).
+				Index(index.name).Column(index.columns...).Exec(ctx); err != nil {
+				return fmt.Errorf("failed to index table %s: %w", table.name, err)
+			}
+		}
+
+		if len(table.tenantKey) > 0 {
+			if err := d.migrateTenantKey(ctx, table); err != nil {
+				return err
+			}
+		}
+	}
+
+	// The archive table mirrors the grades table.
+	if _, err := d.db.NewCreateTable().IfNotExists().Model((*Grade)(nil)).
+		ModelTableExpr(archivedGradesTable).Exec(ctx); err != nil {
+		return fmt.Errorf("failed to create archive table: %w", err)
 	}
 
-	for _, model := range models {
-		if _, err := d.db.NewCreateTable().IfNotExists().Model(model).Exec(ctx); err != nil {
-			return fmt.Errorf("failed to create table: %w", err)
+	for _, table := range []string{"grades", archivedGradesTable} {
+		for _, column := range gradeColumnMigrations {
+			if _, err := d.db.ExecContext(ctx, "ALTER TABLE ? ADD COLUMN IF NOT EXISTS "+column,
+				bun.Ident(table)); err != nil {
+				return fmt.Errorf("failed to migrate table %s: %w", table, err)
+			}
 		}
+
+		// Every tenant-scoped read filters on the institution first.
+		if _, err := d.db.ExecContext(ctx, "CREATE INDEX IF NOT EXISTS ? ON ? (institution_id, course_id, semester)",
+			bun.Ident(table+"_institution_course_idx"), bun.Ident(table)); err != nil {
+			return fmt.Errorf("failed to index table %s: %w", table, err)
+		}
+	}
+
+	if err := d.createCourseStatsView(ctx); err != nil {
+		return err
 	}
 
 	klog.V(logLevelDebug).Info("Database schema initialized.")
@@ -123,32 +489,391 @@ type Grade struct {
 	GradedAt   time.Time `bun:"graded_at,default:current_timestamp"`
 	UpdatedAt  time.Time `bun:"updated_at,default:current_timestamp"`
 	Comments   string    `bun:"comments"`
+	Version    int64     `bun:"version,notnull,default:1"`
+	Flagged    bool      `bun:"flagged,notnull,default:false"`
+	// NumericValue mirrors GradeValue for numeric grades so SQL aggregates need no casts; it is
+	// NULL for letter grades. GradeValue stays the display form.
+	NumericValue *float64 `bun:"numeric_value,type:double precision"`
+	// DeletedAt marks a removed grade. Removed grades are kept as tombstones, hidden from every
+	// query, until PurgeTombstones deletes them for good.
+	DeletedAt time.Time `bun:"deleted_at,soft_delete,nullzero"`
+	// RubricScores is the per-criterion breakdown of the grade, NULL when it has no rubric.
+	RubricScores RubricScores `bun:"rubric_scores,type:jsonb,nullzero"`
+	// InstitutionID is the tenant owning the grade; empty in single-tenant deployments.
+	InstitutionID string `bun:"institution_id,notnull,default:''"`
+	// SubmittedAt and DueAt time the submission of the graded item, for late penalties; NULL when unknown.
+	SubmittedAt time.Time `bun:"submitted_at,nullzero"`
+	DueAt       time.Time `bun:"due_at,nullzero"`
+	// ChangedBy is the authenticated caller who made the latest change to the grade, recorded by the
+	// server; unlike GradedBy it is never taken from the request. Empty for changes made before it
+	// was recorded.
+	ChangedBy string `bun:"changed_by,notnull,default:''"`
+	// AppealStatus tracks the student's appeal of the grade; empty when it was never appealed.
+	AppealStatus string `bun:"appeal_status,notnull,default:''"`
+	// SectionID is the section of the course the student attends; empty when the course has none.
+	SectionID string `bun:"section_id,notnull,default:''"`
 }
 
-// AddGrade adds a grade to the database.
-func (d *Database) AddGrade(ctx context.Context, grade *gpb.SingleGrade) (*Grade, error) {
+// GradeHistory records the state of a grade before each update.
+type GradeHistory struct {
+	bun.BaseModel `bun:"table:grade_history"`
+
+	HistoryID  int64     `bun:"history_id,pk,autoincrement"`
+	GradeID    string    `bun:"grade_id,notnull"`
+	Version    int64     `bun:"version,notnull"`
+	StudentID  string    `bun:"student_id,notnull"`
+	CourseID   string    `bun:"course_id,notnull"`
+	Semester   string    `bun:"semester,notnull"`
+	GradeType  string    `bun:"grade_type,notnull"`
+	ItemID     string    `bun:"item_id"`
+	GradeValue string    `bun:"grade_value,notnull"`
+	GradedBy   string    `bun:"graded_by"`
+	GradedAt   time.Time `bun:"graded_at"`
+	UpdatedAt  time.Time `bun:"updated_at"`
+	Comments   string    `bun:"comments"`
+	ChangedAt  time.Time `bun:"changed_at,notnull,default:current_timestamp"`
+	// The remaining fields mirror the columns of Grade added after the history table.
+	RubricScores  RubricScores `bun:"rubric_scores,type:jsonb,nullzero"`
+	InstitutionID string       `bun:"institution_id,notnull,default:''"`
+	SubmittedAt   time.Time    `bun:"submitted_at,nullzero"`
+	DueAt         time.Time    `bun:"due_at,nullzero"`
+	ChangedBy     string       `bun:"changed_by,notnull,default:''"`
+	AppealStatus  string       `bun:"appeal_status,notnull,default:''"`
+	SectionID     string       `bun:"section_id,notnull,default:''"`
+}
+
+// FailedEvent is a dead-lettered grade event whose delivery failed.
+type FailedEvent struct {
+	bun.BaseModel `bun:"table:failed_events"`
+
+	EventID       int64     `bun:"event_id,pk,autoincrement"`
+	EventType     string    `bun:"event_type,notnull"`
+	Payload       string    `bun:"payload,notnull"`
+	LastError     string    `bun:"last_error"`
+	Attempts      int       `bun:"attempts,notnull,default:1"`
+	CreatedAt     time.Time `bun:"created_at,notnull,default:current_timestamp"`
+	LastAttemptAt time.Time `bun:"last_attempt_at,notnull,default:current_timestamp"`
+}
+
+// AuditEntry records an access to a grade.
+type AuditEntry struct {
+	bun.BaseModel `bun:"table:audit_log"`
+
+	AuditID   int64     `bun:"audit_id,pk,autoincrement"`
+	GradeID   string    `bun:"grade_id,notnull"`
+	Action    string    `bun:"action,notnull"`
+	Actor     string    `bun:"actor,notnull"`
+	CreatedAt time.Time `bun:"created_at,notnull,default:current_timestamp"`
+}
+
+// NotificationOptOut records a student who asked not to be notified of grade changes.
+type NotificationOptOut struct {
+	bun.BaseModel `bun:"table:notification_opt_outs"`
+
+	InstitutionID string    `bun:"institution_id,pk,default:''"`
+	StudentID     string    `bun:"student_id,pk"`
+	CreatedAt     time.Time `bun:"created_at,notnull,default:current_timestamp"`
+}
+
+// NotificationDigest records a student who asked for a periodic digest of their grade changes
+// instead of a notification for each.
+type NotificationDigest struct {
+	bun.BaseModel `bun:"table:notification_digests"`
+
+	InstitutionID string    `bun:"institution_id,pk,default:''"`
+	StudentID     string    `bun:"student_id,pk"`
+	CreatedAt     time.Time `bun:"created_at,notnull,default:current_timestamp"`
+}
+
+// CourseGrader allows a grader to grade a course. A course without graders may be graded by anyone.
+type CourseGrader struct {
+	bun.BaseModel `bun:"table:course_graders"`
+
+	InstitutionID string    `bun:"institution_id,pk,default:''"`
+	CourseID      string    `bun:"course_id,pk"`
+	GraderID      string    `bun:"grader_id,pk"`
+	CreatedAt     time.Time `bun:"created_at,notnull,default:current_timestamp"`
+}
+
+// GradeTypeScheme makes the grades of a type in a course validate against their own grading scheme
+// instead of the global one, such as pass/fail labs in a course with numeric exams.
+type GradeTypeScheme struct {
+	bun.BaseModel `bun:"table:grade_type_schemes"`
+
+	InstitutionID string `bun:"institution_id,pk,default:''"`
+	CourseID      string `bun:"course_id,pk"`
+	GradeType     string `bun:"grade_type,pk"`
+	Scheme        string `bun:"scheme,notnull"`
+}
+
+// CourseAttemptPolicy makes the reads of a course aggregate several attempts at the same item into
+// one grade.
+type CourseAttemptPolicy struct {
+	bun.BaseModel `bun:"table:attempt_policies"`
+
+	InstitutionID string `bun:"institution_id,pk,default:''"`
+	CourseID      string `bun:"course_id,pk"`
+	Policy        string `bun:"policy,notnull"`
+}
+
+// CourseRelease holds back the grades of a course offering from students until ReleaseAt.
+type CourseRelease struct {
+	bun.BaseModel `bun:"table:course_releases"`
+
+	InstitutionID string    `bun:"institution_id,pk,default:''"`
+	CourseID      string    `bun:"course_id,pk"`
+	Semester      string    `bun:"semester,pk"`
+	ReleaseAt     time.Time `bun:"release_at,notnull"`
+}
+
+// newGradeHistory snapshots a grade into a history entry.
+func newGradeHistory(grade *Grade) *GradeHistory {
+	return &GradeHistory{
+		GradeID:       grade.GradeID,
+		Version:       grade.Version,
+		StudentID:     grade.StudentID,
+		CourseID:      grade.CourseID,
+		Semester:      grade.Semester,
+		GradeType:     grade.GradeType,
+		ItemID:        grade.ItemID,
+		GradeValue:    grade.GradeValue,
+		GradedBy:      grade.GradedBy,
+		GradedAt:      grade.GradedAt,
+		UpdatedAt:     grade.UpdatedAt,
+		Comments:      grade.Comments,
+		RubricScores:  grade.RubricScores,
+		InstitutionID: grade.InstitutionID,
+		SubmittedAt:   grade.SubmittedAt,
+		DueAt:         grade.DueAt,
+		ChangedBy:     grade.ChangedBy,
+		AppealStatus:  grade.AppealStatus,
+		SectionID:     grade.SectionID,
+	}
+}
+
+// toGrade restores the grade as it was at this history entry.
+func (h *GradeHistory) toGrade() *Grade {
+	return &Grade{
+		GradeID:       h.GradeID,
+		StudentID:     h.StudentID,
+		CourseID:      h.CourseID,
+		Semester:      h.Semester,
+		GradeType:     h.GradeType,
+		ItemID:        h.ItemID,
+		GradeValue:    h.GradeValue,
+		GradedBy:      h.GradedBy,
+		GradedAt:      h.GradedAt,
+		UpdatedAt:     h.UpdatedAt,
+		Comments:      h.Comments,
+		Version:       h.Version,
+		NumericValue:  numericGradeValue(h.GradeValue),
+		RubricScores:  h.RubricScores,
+		InstitutionID: h.InstitutionID,
+		SubmittedAt:   h.SubmittedAt,
+		DueAt:         h.DueAt,
+		ChangedBy:     h.ChangedBy,
+		AppealStatus:  h.AppealStatus,
+		SectionID:     h.SectionID,
+	}
+}
+
+// numericGradeValue returns the value stored in numeric_value for a grade value, or nil for
+// non-numeric grades.
+func numericGradeValue(value string) *float64 {
+	number, ok := parseNumericGrade(value)
+	if !ok {
+		return nil
+	}
+
+	return &number
+}
+
+// newGradeFromProto builds the row for a new grade.
+func newGradeFromProto(grade *gpb.SingleGrade) *Grade {
+	return &Grade{
+		StudentID:     grade.GetStudentID(),
+		CourseID:      grade.GetCourseID(),
+		Semester:      grade.GetSemester(),
+		GradeType:     grade.GetGradeType(),
+		ItemID:        grade.GetItemID(),
+		GradeValue:    grade.GetGradeValue(),
+		GradedBy:      grade.GetGradedBy(),
+		Comments:      grade.GetComments(),
+		NumericValue:  numericGradeValue(grade.GetGradeValue()),
+		RubricScores:  rubricFromProto(grade.GetRubricScores()),
+		InstitutionID: grade.GetInstitutionID(),
+		SubmittedAt:   gradeTimeFromProto(grade.GetSubmittedAt()),
+		DueAt:         gradeTimeFromProto(grade.GetDueAt()),
+		SectionID:     grade.GetSectionID(),
+	}
+}
+
+// addGradeTx adds a grade within a transaction, applying the duplicate policy on the natural key
+// student, course, semester and item. Under the update policy an existing grade is updated instead,
+// its previous state recorded in the history, and the returned grade has a version above 1.
+func (d *Database) addGradeTx(ctx context.Context, tx bun.Tx, grade *gpb.SingleGrade) (*Grade, error) {
 	if grade == nil {
 		return nil, fmt.Errorf("%w", ErrGradeNil)
 	}
 
-	newGrade := &Grade{
-		StudentID:  grade.GetStudentID(),
-		CourseID:   grade.GetCourseID(),
-		Semester:   grade.GetSemester(),
-		GradeType:  grade.GetGradeType(),
-		ItemID:     grade.GetItemID(),
-		GradeValue: grade.GetGradeValue(),
-		GradedBy:   grade.GetGradedBy(),
-		Comments:   grade.GetComments(),
+	newGrade := newGradeFromProto(grade)
+	newGrade.ChangedBy = actorFromContext(ctx)
+
+	if institution := institutionFromContext(ctx); institution != "" {
+		newGrade.InstitutionID = institution
+	}
+
+	if d.newID != nil {
+		newGrade.GradeID = d.newID()
+	}
+
+	if keptGradeIDsFromContext(ctx) && grade.GetGradeID() != "" {
+		newGrade.GradeID = grade.GetGradeID()
+	}
+
+	if err := d.sealComments(newGrade); err != nil {
+		return nil, err
+	}
+
+	if d.onDuplicate.enforced(grade.GetItemID()) {
+		existing, found, err := d.lockExistingGrade(ctx, tx, newGrade)
+		if err != nil {
+			return nil, err
+		}
+
+		if found {
+			if d.onDuplicate == duplicateReject {
+				return nil, fmt.Errorf("%w", ErrGradeAlreadyExists)
+			}
+
+			return d.replaceGradeTx(ctx, tx, existing, newGrade, grade.GetComments())
+		}
 	}
 
-	if _, err := d.db.NewInsert().Model(newGrade).Exec(ctx); err != nil {
+	if _, err := tx.NewInsert().Model(newGrade).Exec(ctx); err != nil {
 		return nil, fmt.Errorf("failed to add grade: %w", err)
 	}
 
+	newGrade.Comments = grade.GetComments()
+
 	return newGrade, nil
 }
 
+// lockExistingGrade returns the latest grade sharing the natural key of grade, and false when there
+// is none. The key stays locked until the transaction ends, so concurrent adds of the same key are
+// serialized; a unique index cannot be used because existing tables may already hold duplicates.
+func (d *Database) lockExistingGrade(ctx context.Context, tx bun.Tx, grade *Grade) (*Grade, bool, error) {
+	key := strings.Join([]string{grade.InstitutionID, grade.StudentID, grade.CourseID, grade.Semester, grade.ItemID},
+		"\x00")
+	if _, err := tx.ExecContext(ctx, "SELECT pg_advisory_xact_lock(hashtext(?))", key); err != nil {
+		return nil, false, fmt.Errorf("failed to lock grade key: %w", err)
+	}
+
+	existing := &Grade{}
+	if err := tx.NewSelect().Model(existing).
+		Where("student_id = ? AND course_id = ? AND semester = ? AND item_id = ?",
+			grade.StudentID, grade.CourseID, grade.Semester, grade.ItemID).
+		Order("updated_at DESC").Limit(1).Scan(ctx); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, false, nil
+		}
+
+		return nil, false, fmt.Errorf("failed to get existing grade: %w", err)
+	}
+
+	return existing, true, nil
+}
+
+// replaceGradeTx overwrites an existing grade with the non-empty fields of a new one, recording its
+// previous state in the history. comments are the plaintext comments of the new grade.
+func (d *Database) replaceGradeTx(ctx context.Context, tx bun.Tx, existing, newGrade *Grade,
+	comments string,
+) (*Grade, error) {
+	if existing.AppealStatus == appealUnderAppeal {
+		return nil, fmt.Errorf("%w", ErrGradeUnderAppeal)
+	}
+
+	if _, err := tx.NewInsert().Model(newGradeHistory(existing)).Exec(ctx); err != nil {
+		return nil, fmt.Errorf("failed to record grade history: %w", err)
+	}
+
+	if newGrade.GradeType != "" {
+		existing.GradeType = newGrade.GradeType
+	}
+
+	if newGrade.GradedBy != "" {
+		existing.GradedBy = newGrade.GradedBy
+	}
+
+	if comments != "" {
+		existing.Comments = newGrade.Comments
+	}
+
+	if len(newGrade.RubricScores) > 0 {
+		existing.RubricScores = newGrade.RubricScores
+	}
+
+	existing.GradeValue = newGrade.GradeValue
+	existing.NumericValue = newGrade.NumericValue
+	existing.ChangedBy = newGrade.ChangedBy
+	existing.Version++
+	existing.UpdatedAt = time.Now()
+
+	if _, err := tx.NewUpdate().Model(existing).WherePK().Exec(ctx); err != nil {
+		return nil, fmt.Errorf("failed to update grade: %w", err)
+	}
+
+	if err := d.openComments(existing); err != nil {
+		return nil, err
+	}
+
+	return existing, nil
+}
+
+// AddGrade adds a grade to the database, applying the duplicate policy.
+func (d *Database) AddGrade(ctx context.Context, grade *gpb.SingleGrade) (*Grade, error) {
+	var added *Grade
+
+	if err := d.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		var err error
+		added, err = d.addGradeTx(ctx, tx, grade)
+
+		return err
+	}); err != nil {
+		return nil, err
+	}
+
+	return added, nil
+}
+
+// AddGrades adds several grades in a single transaction, applying the duplicate policy to each;
+// either all of them are added or none. Batches of at least copyThreshold grades that the policy
+// leaves alone are written with a single COPY.
+func (d *Database) AddGrades(ctx context.Context, grades []*gpb.SingleGrade) ([]*Grade, error) {
+	if d.copyable(grades) {
+		return d.copyGrades(ctx, grades)
+	}
+
+	added := make([]*Grade, 0, len(grades))
+
+	if err := d.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		for _, grade := range grades {
+			newGrade, err := d.addGradeTx(ctx, tx, grade)
+			if err != nil {
+				return err
+			}
+
+			added = append(added, newGrade)
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return added, nil
+}
+
 // GetCourseGrades retrieves all grades for a course.
 func (d *Database) GetCourseGrades(ctx context.Context, courseID, semester string) ([]*Grade, error) {
 	if courseID == "" {
@@ -156,14 +881,78 @@ func (d *Database) GetCourseGrades(ctx context.Context, courseID, semester strin
 	}
 
 	var grades []*Grade
-	if err := d.db.NewSelect().Model(&grades).Where("course_id = ? AND semester = ?",
+	if err := d.reader(ctx).NewSelect().Model(&grades).Where("course_id = ? AND semester = ?",
 		courseID, semester).Scan(ctx); err != nil {
 		return nil, fmt.Errorf("failed to get course grades: %w", err)
 	}
 
+	if err := d.openComments(grades...); err != nil {
+		return nil, err
+	}
+
 	return grades, nil
 }
 
+// StreamCourseGrades calls fn with each grade of a course, scanning the rows one at a time so
+// they are never all held in memory. The next row is only read once fn returns, so a slow fn
+// pauses the iteration. An error from fn stops the iteration and is returned.
+func (d *Database) StreamCourseGrades(ctx context.Context, courseID, semester string,
+	fn func(*Grade) error,
+) error {
+	if courseID == "" {
+		return fmt.Errorf("%w", ErrCourseIDEmpty)
+	}
+
+	query := d.db.NewSelect().Model((*Grade)(nil)).Where("course_id = ? AND semester = ?",
+		courseID, semester).Order("grade_id")
+
+	return d.streamGrades(ctx, query, fn)
+}
+
+// StreamSemesterGrades calls fn with each grade of a semester, ordered by course and grade ID,
+// reading rows as fn consumes them.
+func (d *Database) StreamSemesterGrades(ctx context.Context, semester string, fn func(*Grade) error) error {
+	if semester == "" {
+		return fmt.Errorf("%w", ErrSemesterEmpty)
+	}
+
+	query := d.db.NewSelect().Model((*Grade)(nil)).Where("semester = ?", semester).
+		Order("course_id", "grade_id")
+
+	return d.streamGrades(ctx, query, fn)
+}
+
+// streamGrades calls fn with each grade the query selects, one row at a time.
+func (d *Database) streamGrades(ctx context.Context, query *bun.SelectQuery, fn func(*Grade) error) error {
+	// Rows skips the model hooks, so the institution is applied explicitly.
+	rows, err := query.Apply(scopeInstitution(ctx)).Rows(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get grades: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		grade := &Grade{}
+		if err := d.db.ScanRow(ctx, rows, grade); err != nil {
+			return fmt.Errorf("failed to scan grade: %w", err)
+		}
+
+		if err := d.openComments(grade); err != nil {
+			return err
+		}
+
+		if err := fn(grade); err != nil {
+			return err
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("failed to read grades: %w", err)
+	}
+
+	return nil
+}
+
 // GetStudentCourseGrades retrieves all grades for a student in a course.
 func (d *Database) GetStudentCourseGrades(ctx context.Context,
 	courseID, semester, studentID string,
@@ -173,15 +962,19 @@ func (d *Database) GetStudentCourseGrades(ctx context.Context,
 	}
 
 	var grades []*Grade
-	if err := d.db.NewSelect().Model(&grades).Where("course_id = ? AND semester = ? AND student_id = ?",
+	if err := d.reader(ctx).NewSelect().Model(&grades).Where("course_id = ? AND semester = ? AND student_id = ?",
 		courseID, semester, studentID).Scan(ctx); err != nil {
 		return nil, fmt.Errorf("failed to get student course grades: %w", err)
 	}
 
+	if err := d.openComments(grades...); err != nil {
+		return nil, err
+	}
+
 	return grades, nil
 }
 
-// UpdateGrade updates a grade in the database.
+// UpdateGrade updates a grade in the database, recording its previous state in the history table.
 func (d *Database) UpdateGrade(ctx context.Context, grade *gpb.SingleGrade) (*Grade, error) {
 	if grade == nil {
 		return nil, fmt.Errorf("%w", ErrGradeNil)
@@ -191,47 +984,319 @@ func (d *Database) UpdateGrade(ctx context.Context, grade *gpb.SingleGrade) (*Gr
 		return nil, fmt.Errorf("%w", ErrGradeIDEmpty)
 	}
 
-	// Get the grade from the database.
 	existingGrade := &Grade{GradeID: grade.GetGradeID()}
-	if err := d.db.NewSelect().Model(existingGrade).WherePK().Scan(ctx); err != nil {
-		return nil, fmt.Errorf("failed to get grade: %w", err)
+
+	err := d.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		// Get the grade from the database.
+		if err := tx.NewSelect().Model(existingGrade).WherePK().Scan(ctx); err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return fmt.Errorf("%w", ErrGradeNotFound)
+			}
+
+			return fmt.Errorf("failed to get grade: %w", err)
+		}
+
+		if existingGrade.AppealStatus == appealUnderAppeal {
+			return fmt.Errorf("%w", ErrGradeUnderAppeal)
+		}
+
+		// The history keeps the comments exactly as stored.
+		if _, err := tx.NewInsert().Model(newGradeHistory(existingGrade)).Exec(ctx); err != nil {
+			return fmt.Errorf("failed to record grade history: %w", err)
+		}
+
+		if err := d.openComments(existingGrade); err != nil {
+			return err
+		}
+
+		// Update the fields.
+		updateField := func(field *string, newValue string) {
+			if newValue != "" {
+				*field = newValue
+			}
+		}
+
+		updateField(&existingGrade.StudentID, grade.GetStudentID())
+		updateField(&existingGrade.CourseID, grade.GetCourseID())
+		updateField(&existingGrade.Semester, grade.GetSemester())
+		updateField(&existingGrade.GradeType, grade.GetGradeType())
+		updateField(&existingGrade.ItemID, grade.GetItemID())
+		updateField(&existingGrade.GradeValue, grade.GetGradeValue())
+		updateField(&existingGrade.GradedBy, grade.GetGradedBy())
+		updateField(&existingGrade.Comments, grade.GetComments())
+		updateField(&existingGrade.SectionID, grade.GetSectionID())
+
+		if len(grade.GetRubricScores()) > 0 {
+			existingGrade.RubricScores = rubricFromProto(grade.GetRubricScores())
+		}
+
+		if grade.GetSubmittedAt() != "" {
+			existingGrade.SubmittedAt = gradeTimeFromProto(grade.GetSubmittedAt())
+		}
+
+		if grade.GetDueAt() != "" {
+			existingGrade.DueAt = gradeTimeFromProto(grade.GetDueAt())
+		}
+		existingGrade.NumericValue = numericGradeValue(existingGrade.GradeValue)
+		existingGrade.ChangedBy = actorFromContext(ctx)
+
+		// Only apply the update if nobody bumped the version in the meantime.
+		previousVersion := existingGrade.Version
+		existingGrade.Version++
+		existingGrade.UpdatedAt = time.Now()
+
+		comments := existingGrade.Comments
+		if err := d.sealComments(existingGrade); err != nil {
+			return err
+		}
+
+		res, err := tx.NewUpdate().Model(existingGrade).WherePK().
+			Where("version = ?", previousVersion).Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to update grade: %w", err)
+		}
+
+		if rows, err := res.RowsAffected(); err == nil && rows == 0 {
+			return fmt.Errorf("%w", ErrGradeVersionConflict)
+		}
+
+		existingGrade.Comments = comments
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return existingGrade, nil
+}
+
+// UndoGradeChange restores the value a grade had before its latest change, provided it is still at
+// version. The undone state is recorded in the history like any update, so an undo can be undone.
+func (d *Database) UndoGradeChange(ctx context.Context, gradeID string, version int64) (*Grade, error) {
+	if gradeID == "" {
+		return nil, fmt.Errorf("%w", ErrGradeIDEmpty)
 	}
 
-	// Update the fields.
-	updateField := func(field *string, newValue string) {
-		if newValue != "" {
-			*field = newValue
+	grade := &Grade{GradeID: gradeID}
+
+	err := d.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		if err := tx.NewSelect().Model(grade).WherePK().For("UPDATE").Scan(ctx); err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return fmt.Errorf("%w", ErrGradeNotFound)
+			}
+
+			return fmt.Errorf("failed to get grade: %w", err)
+		}
+
+		if grade.Version != version {
+			return fmt.Errorf("%w", ErrGradeVersionConflict)
+		}
+
+		if grade.AppealStatus == appealUnderAppeal {
+			return fmt.Errorf("%w", ErrGradeUnderAppeal)
+		}
+
+		previous := &GradeHistory{}
+		if err := tx.NewSelect().Model(previous).Where("grade_id = ? AND version < ?", gradeID, grade.Version).
+			Order("version DESC").Limit(1).Scan(ctx); err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return fmt.Errorf("%w", ErrNoPriorGradeValue)
+			}
+
+			return fmt.Errorf("failed to get previous grade version: %w", err)
+		}
+
+		if _, err := tx.NewInsert().Model(newGradeHistory(grade)).Exec(ctx); err != nil {
+			return fmt.Errorf("failed to record grade history: %w", err)
+		}
+
+		grade.GradeValue = previous.GradeValue
+		grade.NumericValue = numericGradeValue(previous.GradeValue)
+		grade.ChangedBy = actorFromContext(ctx)
+		grade.Version++
+		grade.UpdatedAt = time.Now()
+
+		if _, err := tx.NewUpdate().Model(grade).WherePK().Exec(ctx); err != nil {
+			return fmt.Errorf("failed to update grade: %w", err)
 		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := d.openComments(grade); err != nil {
+		return nil, err
 	}
 
-	updateField(&existingGrade.StudentID, grade.GetStudentID())
-	updateField(&existingGrade.CourseID, grade.GetCourseID())
-	updateField(&existingGrade.Semester, grade.GetSemester())
-	updateField(&existingGrade.GradeType, grade.GetGradeType())
-	updateField(&existingGrade.ItemID, grade.GetItemID())
-	updateField(&existingGrade.GradeValue, grade.GetGradeValue())
-	updateField(&existingGrade.GradedBy, grade.GetGradedBy())
-	updateField(&existingGrade.Comments, grade.GetComments())
+	return grade, nil
+}
 
-	if _, err := d.db.NewUpdate().Model(existingGrade).WherePK().Exec(ctx); err != nil {
-		return nil, fmt.Errorf("failed to update grade: %w", err)
+// TransitionAppeal moves the appeal of a grade from one status to another, failing when the grade is
+// not in the from status. A non-empty value replaces the grade value, recording the previous state
+// in the history like an update.
+func (d *Database) TransitionAppeal(ctx context.Context, gradeID, from, to, value string) (*Grade, error) {
+	if gradeID == "" {
+		return nil, fmt.Errorf("%w", ErrGradeIDEmpty)
 	}
 
-	return existingGrade, nil
+	grade := &Grade{GradeID: gradeID}
+
+	err := d.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		if err := tx.NewSelect().Model(grade).WherePK().For("UPDATE").Scan(ctx); err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return fmt.Errorf("%w", ErrGradeNotFound)
+			}
+
+			return fmt.Errorf("failed to get grade: %w", err)
+		}
+
+		if grade.AppealStatus != from {
+			return fmt.Errorf("%w: grade is %s", ErrAppealTransition, appealStatusName(grade.AppealStatus))
+		}
+
+		if value != "" {
+			if _, err := tx.NewInsert().Model(newGradeHistory(grade)).Exec(ctx); err != nil {
+				return fmt.Errorf("failed to record grade history: %w", err)
+			}
+
+			grade.GradeValue = value
+			grade.NumericValue = numericGradeValue(value)
+			grade.ChangedBy = actorFromContext(ctx)
+			grade.Version++
+			grade.UpdatedAt = time.Now()
+		}
+
+		grade.AppealStatus = to
+
+		if _, err := tx.NewUpdate().Model(grade).WherePK().Exec(ctx); err != nil {
+			return fmt.Errorf("failed to update grade appeal: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := d.openComments(grade); err != nil {
+		return nil, err
+	}
+
+	return grade, nil
 }
 
-// DeleteGrade deletes a grade from the database.
+// RemoveGrade soft-deletes a grade, leaving a tombstone until it is purged, and records the
+// deletion by the context's actor in the audit log in the same transaction. A grade under appeal
+// is locked and is not removed.
 func (d *Database) RemoveGrade(ctx context.Context, gradeID string) error {
 	if gradeID == "" {
 		return fmt.Errorf("%w", ErrGradeIDEmpty)
 	}
 
-	grade := &Grade{GradeID: gradeID}
-	if _, err := d.db.NewDelete().Model(grade).Exec(ctx); err != nil {
-		return fmt.Errorf("failed to delete grade: %w", err)
+	return d.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		grade := &Grade{GradeID: gradeID}
+
+		res, err := tx.NewDelete().Model(grade).WherePK().Where("appeal_status <> ?", appealUnderAppeal).Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to delete grade: %w", err)
+		}
+
+		rows, err := res.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("failed to count deleted grades: %w", err)
+		}
+
+		if rows > 0 {
+			entry := &AuditEntry{GradeID: gradeID, Action: auditActionDelete, Actor: actorFromContext(ctx)}
+			if _, err := tx.NewInsert().Model(entry).Exec(ctx); err != nil {
+				return fmt.Errorf("failed to record grade deletion: %w", err)
+			}
+
+			return nil
+		}
+
+		appealed, err := tx.NewSelect().Model((*Grade)(nil)).
+			Where("grade_id = ? AND appeal_status = ?", gradeID, appealUnderAppeal).Exists(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to check grade appeal: %w", err)
+		}
+
+		if appealed {
+			return fmt.Errorf("%w", ErrGradeUnderAppeal)
+		}
+
+		return fmt.Errorf("%w", ErrGradeNotFound)
+	})
+}
+
+// PurgeTombstones permanently deletes the grades soft-deleted before cutoff, from both the live and
+// the archive table, and returns the number of grades purged.
+func (d *Database) PurgeTombstones(ctx context.Context, cutoff time.Time) (int64, error) {
+	var purged int64
+
+	for _, table := range []string{"grades", archivedGradesTable} {
+		res, err := d.db.NewDelete().Model((*Grade)(nil)).ModelTableExpr(table+" AS grade").
+			WhereDeleted().Where("deleted_at < ?", cutoff).ForceDelete().Exec(ctx)
+		if err != nil {
+			return purged, fmt.Errorf("failed to purge tombstones from %s: %w", table, err)
+		}
+
+		rows, err := res.RowsAffected()
+		if err != nil {
+			return purged, fmt.Errorf("failed to count purged tombstones: %w", err)
+		}
+
+		purged += rows
 	}
 
-	return nil
+	return purged, nil
+}
+
+// SoftDeleteStudentGrades soft-deletes every grade of a student in one transaction, recording an
+// audit entry by actor for each, and returns the IDs of the removed grades. The grades stay
+// recoverable as tombstones until PurgeTombstones deletes them.
+func (d *Database) SoftDeleteStudentGrades(ctx context.Context, studentID, actor string) ([]string, error) {
+	if studentID == "" {
+		return nil, fmt.Errorf("%w", ErrStudentIDEmpty)
+	}
+
+	var gradeIDs []string
+
+	if err := d.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		if err := tx.NewSelect().Model((*Grade)(nil)).Column("grade_id").Where("student_id = ?", studentID).
+			Order("grade_id").For("UPDATE").Scan(ctx, &gradeIDs); err != nil {
+			return fmt.Errorf("failed to lock student grades: %w", err)
+		}
+
+		if len(gradeIDs) == 0 {
+			return nil
+		}
+
+		return inChunks(gradeIDs, d.inListChunkSize, func(chunk []string) error {
+			if _, err := tx.NewDelete().Model((*Grade)(nil)).Where("grade_id IN (?)", bun.In(chunk)).
+				Exec(ctx); err != nil {
+				return fmt.Errorf("failed to delete student grades: %w", err)
+			}
+
+			entries := make([]*AuditEntry, 0, len(chunk))
+			for _, gradeID := range chunk {
+				entries = append(entries, &AuditEntry{GradeID: gradeID, Action: auditActionDelete, Actor: actor})
+			}
+
+			if _, err := tx.NewInsert().Model(&entries).Exec(ctx); err != nil {
+				return fmt.Errorf("failed to record grade deletions: %w", err)
+			}
+
+			return nil
+		})
+	}); err != nil {
+		return nil, err
+	}
+
+	return gradeIDs, nil
 }
 
 // GetStudentSemesterGrades retrieves all grades for a student in a semester.
@@ -241,10 +1306,1069 @@ func (d *Database) GetStudentSemesterGrades(ctx context.Context, studentID, seme
 	}
 
 	var grades []*Grade
-	if err := d.db.NewSelect().Model(&grades).Where("student_id = ? AND semester = ?",
+	if err := d.reader(ctx).NewSelect().Model(&grades).Where("student_id = ? AND semester = ?",
 		studentID, semester).Scan(ctx); err != nil {
 		return nil, fmt.Errorf("failed to get student semester grades: %w", err)
 	}
 
+	if err := d.openComments(grades...); err != nil {
+		return nil, err
+	}
+
+	return grades, nil
+}
+
+// GetStudentGrades retrieves every grade of a student, across all semesters.
+func (d *Database) GetStudentGrades(ctx context.Context, studentID string) ([]*Grade, error) {
+	if studentID == "" {
+		return nil, fmt.Errorf("%w", ErrStudentIDEmpty)
+	}
+
+	var grades []*Grade
+	if err := d.reader(ctx).NewSelect().Model(&grades).Where("student_id = ?", studentID).Scan(ctx); err != nil {
+		return nil, fmt.Errorf("failed to get student grades: %w", err)
+	}
+
+	if err := d.openComments(grades...); err != nil {
+		return nil, err
+	}
+
+	return grades, nil
+}
+
+// GetStudentGradesGrouped retrieves every grade of a student in a single query, grouped by semester
+// and then by course, so a student's whole record is read without a query per semester.
+func (d *Database) GetStudentGradesGrouped(ctx context.Context,
+	studentID string,
+) (map[string]map[string][]*Grade, error) {
+	grades, err := d.GetStudentGrades(ctx, studentID)
+	if err != nil {
+		return nil, err
+	}
+
+	return groupBySemesterAndCourse(grades), nil
+}
+
+// groupBySemesterAndCourse groups grades by semester and then by course, keeping their order.
+func groupBySemesterAndCourse(grades []*Grade) map[string]map[string][]*Grade {
+	groups := make(map[string]map[string][]*Grade)
+
+	for _, grade := range grades {
+		courses, ok := groups[grade.Semester]
+		if !ok {
+			courses = make(map[string][]*Grade)
+			groups[grade.Semester] = courses
+		}
+
+		courses[grade.CourseID] = append(courses[grade.CourseID], grade)
+	}
+
+	return groups
+}
+
+// ArchiveSemester moves all grades of a semester to the archive table in a single statement, so a
+// grade written concurrently is either moved or left in place, never lost or copied twice.
+// Tombstones move along with the other grades but are not counted: it returns the number of
+// live grades archived.
+func (d *Database) ArchiveSemester(ctx context.Context, semester string) (int64, error) {
+	if semester == "" {
+		return 0, fmt.Errorf("%w", ErrSemesterEmpty)
+	}
+
+	var archived int64
+	if err := archiveSemesterQuery(d.db, semester, institutionFromContext(ctx)).Scan(ctx, &archived); err != nil {
+		return 0, fmt.Errorf("failed to archive semester: %w", err)
+	}
+
+	return archived, nil
+}
+
+// archiveSemesterQuery builds the statement moving a semester's grades, scoped to an institution
+// unless it is empty, and counting the live grades moved. The columns are named because the two
+// tables may order them differently after migrations.
+func archiveSemesterQuery(db *bun.DB, semester, institutionID string) *bun.RawQuery {
+	columns := bun.In(identifiers(columnNames(db, (*Grade)(nil))))
+
+	return db.NewRaw(`WITH moved AS (DELETE FROM ? WHERE semester = ? AND (? = '' OR institution_id = ?) RETURNING ?), `+
+		`archived AS (INSERT INTO ? (?) SELECT ? FROM moved RETURNING deleted_at) `+
+		`SELECT count(*) FROM archived WHERE deleted_at IS NULL`,
+		bun.Ident("grades"), semester, institutionID, institutionID, columns,
+		bun.Ident(archivedGradesTable), columns, columns)
+}
+
+// GetArchivedCourseGrades retrieves the archived grades for a course.
+func (d *Database) GetArchivedCourseGrades(ctx context.Context, courseID, semester string) ([]*Grade, error) {
+	if courseID == "" {
+		return nil, fmt.Errorf("%w", ErrCourseIDEmpty)
+	}
+
+	var grades []*Grade
+	if err := d.db.NewSelect().Model(&grades).ModelTableExpr(archivedGradesTable+" AS grade").
+		Where("course_id = ? AND semester = ?", courseID, semester).Scan(ctx); err != nil {
+		return nil, fmt.Errorf("failed to get archived course grades: %w", err)
+	}
+
+	if err := d.openComments(grades...); err != nil {
+		return nil, err
+	}
+
+	return grades, nil
+}
+
+// GetGradeByID retrieves the latest state of a grade.
+func (d *Database) GetGradeByID(ctx context.Context, gradeID string) (*Grade, error) {
+	if gradeID == "" {
+		return nil, fmt.Errorf("%w", ErrGradeIDEmpty)
+	}
+
+	grade := &Grade{GradeID: gradeID}
+	if err := d.reader(ctx).NewSelect().Model(grade).WherePK().Scan(ctx); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("%w", ErrGradeNotFound)
+		}
+
+		return nil, fmt.Errorf("failed to get grade: %w", err)
+	}
+
+	if err := d.openComments(grade); err != nil {
+		return nil, err
+	}
+
+	return grade, nil
+}
+
+// GradeKey identifies a grade by its ID or, when the ID is empty, by its student, course, semester
+// and item.
+type GradeKey struct {
+	GradeID   string
+	StudentID string
+	CourseID  string
+	Semester  string
+	ItemID    string
+}
+
+// gradeValueQuery selects only the value of the grade identified by key. When the natural key
+// matches several grades, the most recently updated one wins.
+func gradeValueQuery(db bun.IDB, key GradeKey) (*bun.SelectQuery, error) {
+	query := db.NewSelect().Model((*Grade)(nil)).Column("grade_value")
+
+	switch {
+	case key.GradeID != "":
+		return query.Where("grade_id = ?", key.GradeID), nil
+	case key.StudentID == "":
+		return nil, fmt.Errorf("%w", ErrStudentIDEmpty)
+	case key.CourseID == "":
+		return nil, fmt.Errorf("%w", ErrCourseIDEmpty)
+	}
+
+	return query.Where("student_id = ? AND course_id = ? AND semester = ? AND item_id = ?",
+		key.StudentID, key.CourseID, key.Semester, key.ItemID).
+		Order("updated_at DESC").Limit(1), nil
+}
+
+// GetGradeValue retrieves only the value of a grade, without reading the rest of the record.
+func (d *Database) GetGradeValue(ctx context.Context, key GradeKey) (string, error) {
+	query, err := gradeValueQuery(d.reader(ctx), key)
+	if err != nil {
+		return "", err
+	}
+
+	var value string
+	if err := query.Scan(ctx, &value); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", fmt.Errorf("%w", ErrGradeNotFound)
+		}
+
+		return "", fmt.Errorf("failed to get grade value: %w", err)
+	}
+
+	return value, nil
+}
+
+// GetGradeVersion retrieves a grade as it was at the given version.
+func (d *Database) GetGradeVersion(ctx context.Context, gradeID string, version int64) (*Grade, error) {
+	grade, err := d.GetGradeByID(ctx, gradeID)
+	if err != nil {
+		return nil, err
+	}
+
+	if grade.Version == version {
+		return grade, nil
+	}
+
+	history := &GradeHistory{}
+	if err := d.reader(ctx).NewSelect().Model(history).Where("grade_id = ? AND version = ?",
+		gradeID, version).Limit(1).Scan(ctx); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("%w", ErrGradeVersionNotFound)
+		}
+
+		return nil, fmt.Errorf("failed to get grade version: %w", err)
+	}
+
+	grade = history.toGrade()
+	if err := d.openComments(grade); err != nil {
+		return nil, err
+	}
+
+	return grade, nil
+}
+
+// likePatternEscaper escapes the characters that have special meaning in a LIKE pattern.
+var likePatternEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// escapeLikePattern escapes a user supplied term so it matches literally inside a LIKE pattern.
+// Postgres uses the backslash as the default LIKE escape character.
+func escapeLikePattern(term string) string {
+	return likePatternEscaper.Replace(term)
+}
+
+// validateSearchTerm ensures a search term is non-empty and bounded in length.
+func validateSearchTerm(term string) error {
+	if term == "" {
+		return fmt.Errorf("%w", ErrSearchTermEmpty)
+	}
+
+	if len(term) > maxSearchTermLength {
+		return fmt.Errorf("%w: at most %d characters allowed", ErrSearchTermTooLong, maxSearchTermLength)
+	}
+
+	return nil
+}
+
+// SearchGradeComments retrieves the grades of a course whose comments contain the term.
+func (d *Database) SearchGradeComments(ctx context.Context, courseID, semester, term string) ([]*Grade, error) {
+	if courseID == "" {
+		return nil, fmt.Errorf("%w", ErrCourseIDEmpty)
+	}
+
+	if err := validateSearchTerm(term); err != nil {
+		return nil, err
+	}
+
+	// Encrypted comments cannot be matched in SQL, so they are decrypted and filtered here instead.
+	if d.comments != nil {
+		grades, err := d.GetCourseGrades(ctx, courseID, semester)
+		if err != nil {
+			return nil, fmt.Errorf("failed to search grade comments: %w", err)
+		}
+
+		return filterCommentsContaining(grades, term), nil
+	}
+
+	var grades []*Grade
+	if err := d.db.NewSelect().Model(&grades).Where("course_id = ? AND semester = ? AND comments ILIKE ?",
+		courseID, semester, "%"+escapeLikePattern(term)+"%").Scan(ctx); err != nil {
+		return nil, fmt.Errorf("failed to search grade comments: %w", err)
+	}
+
 	return grades, nil
 }
+
+// filterCommentsContaining keeps the grades whose comments contain the term, ignoring case.
+func filterCommentsContaining(grades []*Grade, term string) []*Grade {
+	term = strings.ToLower(term)
+
+	var matches []*Grade
+
+	for _, grade := range grades {
+		if strings.Contains(strings.ToLower(grade.Comments), term) {
+			matches = append(matches, grade)
+		}
+	}
+
+	return matches
+}
+
+// courseETag derives an ETag from the number of grades in a course and their latest update time.
+func courseETag(count int64, lastUpdated time.Time) string {
+	sum := sha256.Sum256([]byte(fmt.Sprintf("%d:%d", count, lastUpdated.UnixNano())))
+
+	return hex.EncodeToString(sum[:16])
+}
+
+// combineETags returns a single ETag for the ETags of several course offerings read together, in
+// request order. It is empty when any of them is.
+func combineETags(etags []string) string {
+	switch {
+	case slices.Contains(etags, ""):
+		return ""
+	case len(etags) == 1:
+		return etags[0]
+	default:
+		sum := sha256.Sum256([]byte(strings.Join(etags, "\x00")))
+
+		return hex.EncodeToString(sum[:16])
+	}
+}
+
+// GetCourseGradesETag computes the ETag of a course's grades with a single aggregate query.
+func (d *Database) GetCourseGradesETag(ctx context.Context, courseID, semester string) (string, error) {
+	if courseID == "" {
+		return "", fmt.Errorf("%w", ErrCourseIDEmpty)
+	}
+
+	var (
+		count       int64
+		lastUpdated sql.NullTime
+	)
+
+	if err := d.db.NewSelect().Model((*Grade)(nil)).
+		ColumnExpr("COUNT(*)").ColumnExpr("MAX(updated_at)").
+		Where("course_id = ? AND semester = ?", courseID, semester).
+		Scan(ctx, &count, &lastUpdated); err != nil {
+		return "", fmt.Errorf("failed to get course grades etag: %w", err)
+	}
+
+	return courseETag(count, lastUpdated.Time), nil
+}
+
+// SetGradeFlag marks or unmarks a grade for review.
+func (d *Database) SetGradeFlag(ctx context.Context, gradeID string, flagged bool) (*Grade, error) {
+	if gradeID == "" {
+		return nil, fmt.Errorf("%w", ErrGradeIDEmpty)
+	}
+
+	grade := &Grade{GradeID: gradeID, Flagged: flagged}
+
+	res, err := d.db.NewUpdate().Model(grade).Column("flagged").WherePK().Returning("*").Exec(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to set grade flag: %w", err)
+	}
+
+	if rows, err := res.RowsAffected(); err == nil && rows == 0 {
+		return nil, fmt.Errorf("%w", ErrGradeNotFound)
+	}
+
+	if err := d.openComments(grade); err != nil {
+		return nil, err
+	}
+
+	return grade, nil
+}
+
+// GetFlaggedGrades retrieves all grades marked for review in a course.
+func (d *Database) GetFlaggedGrades(ctx context.Context, courseID, semester string) ([]*Grade, error) {
+	if courseID == "" {
+		return nil, fmt.Errorf("%w", ErrCourseIDEmpty)
+	}
+
+	var grades []*Grade
+	if err := d.db.NewSelect().Model(&grades).Where("course_id = ? AND semester = ? AND flagged = true",
+		courseID, semester).Scan(ctx); err != nil {
+		return nil, fmt.Errorf("failed to get flagged grades: %w", err)
+	}
+
+	if err := d.openComments(grades...); err != nil {
+		return nil, err
+	}
+
+	return grades, nil
+}
+
+// validateCourseIDs ensures a course list is non-empty, bounded in length and has no empty IDs.
+func validateCourseIDs(courseIDs []string) error {
+	if len(courseIDs) == 0 {
+		return fmt.Errorf("%w", ErrCourseIDEmpty)
+	}
+
+	if len(courseIDs) > maxMultiCourseIDs {
+		return fmt.Errorf("%w: at most %d courses allowed", ErrTooManyCourses, maxMultiCourseIDs)
+	}
+
+	for _, courseID := range courseIDs {
+		if courseID == "" {
+			return fmt.Errorf("%w", ErrCourseIDEmpty)
+		}
+	}
+
+	return nil
+}
+
+// GetMultiCourseGrades retrieves the grades of several courses in one query, grouped by course.
+// Every requested course has an entry, empty when the course has no grades.
+func (d *Database) GetMultiCourseGrades(ctx context.Context,
+	courseIDs []string, semester string,
+) (map[string][]*Grade, error) {
+	if err := validateCourseIDs(courseIDs); err != nil {
+		return nil, err
+	}
+
+	var grades []*Grade
+	if err := d.db.NewSelect().Model(&grades).Where("course_id IN (?) AND semester = ?",
+		bun.In(courseIDs), semester).Scan(ctx); err != nil {
+		return nil, fmt.Errorf("failed to get multi course grades: %w", err)
+	}
+
+	if err := d.openComments(grades...); err != nil {
+		return nil, err
+	}
+
+	return groupGrades(courseIDs, grades, func(grade *Grade) string { return grade.CourseID }), nil
+}
+
+// groupGrades groups grades by the key returned by keyOf, with an empty group for each key without grades.
+func groupGrades(keys []string, grades []*Grade, keyOf func(*Grade) string) map[string][]*Grade {
+	groups := make(map[string][]*Grade, len(keys))
+	for _, key := range keys {
+		groups[key] = []*Grade{}
+	}
+
+	for _, grade := range grades {
+		groups[keyOf(grade)] = append(groups[keyOf(grade)], grade)
+	}
+
+	return groups
+}
+
+// validateStudentPrefix ensures a student ID prefix is long enough to be selective and bounded in length.
+func validateStudentPrefix(prefix string) error {
+	if len(prefix) < minStudentPrefixLength {
+		return fmt.Errorf("%w: at least %d characters required", ErrStudentPrefixTooShort, minStudentPrefixLength)
+	}
+
+	if len(prefix) > maxSearchTermLength {
+		return fmt.Errorf("%w: at most %d characters allowed", ErrSearchTermTooLong, maxSearchTermLength)
+	}
+
+	return nil
+}
+
+// SearchStudentGrades retrieves the grades of students whose ID starts with the prefix, ordered by
+// student ID. At most limit grades are returned.
+func (d *Database) SearchStudentGrades(ctx context.Context, prefix string, limit int) ([]*Grade, error) {
+	if err := validateStudentPrefix(prefix); err != nil {
+		return nil, err
+	}
+
+	var grades []*Grade
+	if err := d.db.NewSelect().Model(&grades).Where("student_id LIKE ?", escapeLikePattern(prefix)+"%").
+		Order("student_id", "grade_id").Limit(limit).Scan(ctx); err != nil {
+		return nil, fmt.Errorf("failed to search student grades: %w", err)
+	}
+
+	if err := d.openComments(grades...); err != nil {
+		return nil, err
+	}
+
+	return grades, nil
+}
+
+// RecordFailedEvent stores an event whose delivery failed in the dead-letter table.
+func (d *Database) RecordFailedEvent(ctx context.Context, event *FailedEvent) error {
+	if _, err := d.db.NewInsert().Model(event).Exec(ctx); err != nil {
+		return fmt.Errorf("failed to record failed event: %w", err)
+	}
+
+	return nil
+}
+
+// GetFailedEvents retrieves up to limit dead-lettered events, oldest first.
+func (d *Database) GetFailedEvents(ctx context.Context, limit int) ([]*FailedEvent, error) {
+	var events []*FailedEvent
+	if err := d.db.NewSelect().Model(&events).Order("event_id").Limit(limit).Scan(ctx); err != nil {
+		return nil, fmt.Errorf("failed to get failed events: %w", err)
+	}
+
+	return events, nil
+}
+
+// RecordFailedEventAttempt records another failed delivery attempt of a dead-lettered event.
+func (d *Database) RecordFailedEventAttempt(ctx context.Context, eventID int64, lastError string) error {
+	if _, err := d.db.NewUpdate().Model((*FailedEvent)(nil)).
+		Set("attempts = attempts + 1").
+		Set("last_error = ?", lastError).
+		Set("last_attempt_at = ?", time.Now()).
+		Where("event_id = ?", eventID).Exec(ctx); err != nil {
+		return fmt.Errorf("failed to record failed event attempt: %w", err)
+	}
+
+	return nil
+}
+
+// RemoveFailedEvent removes a delivered event from the dead-letter table.
+func (d *Database) RemoveFailedEvent(ctx context.Context, eventID int64) error {
+	if _, err := d.db.NewDelete().Model((*FailedEvent)(nil)).Where("event_id = ?", eventID).Exec(ctx); err != nil {
+		return fmt.Errorf("failed to remove failed event: %w", err)
+	}
+
+	return nil
+}
+
+// validateSemesters ensures a semester list is non-empty, bounded in length and has no empty semesters.
+func validateSemesters(semesters []string) error {
+	if len(semesters) > maxMultiSemesters {
+		return fmt.Errorf("%w: at most %d semesters allowed", ErrTooManySemesters, maxMultiSemesters)
+	}
+
+	if len(semesters) == 0 || slices.Contains(semesters, "") {
+		return fmt.Errorf("%w", ErrSemesterEmpty)
+	}
+
+	return nil
+}
+
+// GetCourseGradesBySemester retrieves the grades of a course across several semesters in one query,
+// grouped by semester. Every requested semester has an entry, empty when it has no grades.
+func (d *Database) GetCourseGradesBySemester(ctx context.Context,
+	courseID string, semesters []string,
+) (map[string][]*Grade, error) {
+	if courseID == "" {
+		return nil, fmt.Errorf("%w", ErrCourseIDEmpty)
+	}
+
+	if err := validateSemesters(semesters); err != nil {
+		return nil, err
+	}
+
+	var grades []*Grade
+	if err := d.db.NewSelect().Model(&grades).Where("course_id = ? AND semester IN (?)",
+		courseID, bun.In(semesters)).Scan(ctx); err != nil {
+		return nil, fmt.Errorf("failed to get course grades by semester: %w", err)
+	}
+
+	if err := d.openComments(grades...); err != nil {
+		return nil, err
+	}
+
+	return groupGrades(semesters, grades, func(grade *Grade) string { return grade.Semester }), nil
+}
+
+// GetStudentGradesBySemester retrieves the grades of a student across several semesters in one query,
+// grouped by semester. Every requested semester has an entry, empty when it has no grades.
+func (d *Database) GetStudentGradesBySemester(ctx context.Context,
+	studentID string, semesters []string,
+) (map[string][]*Grade, error) {
+	if studentID == "" {
+		return nil, fmt.Errorf("%w", ErrStudentIDEmpty)
+	}
+
+	if err := validateSemesters(semesters); err != nil {
+		return nil, err
+	}
+
+	var grades []*Grade
+	if err := d.reader(ctx).NewSelect().Model(&grades).Where("student_id = ? AND semester IN (?)",
+		studentID, bun.In(semesters)).Scan(ctx); err != nil {
+		return nil, fmt.Errorf("failed to get student grades by semester: %w", err)
+	}
+
+	if err := d.openComments(grades...); err != nil {
+		return nil, err
+	}
+
+	return groupGrades(semesters, grades, func(grade *Grade) string { return grade.Semester }), nil
+}
+
+// GetGradeVersions retrieves every version of a grade, oldest first, ending with its latest state.
+// A removed grade keeps its versions until its tombstone is purged.
+func (d *Database) GetGradeVersions(ctx context.Context, gradeID string) ([]*Grade, error) {
+	if gradeID == "" {
+		return nil, fmt.Errorf("%w", ErrGradeIDEmpty)
+	}
+
+	grade := &Grade{GradeID: gradeID}
+	if err := d.reader(ctx).NewSelect().Model(grade).WherePK().WhereAllWithDeleted().Scan(ctx); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("%w", ErrGradeNotFound)
+		}
+
+		return nil, fmt.Errorf("failed to get grade: %w", err)
+	}
+
+	var history []*GradeHistory
+	if err := d.db.NewSelect().Model(&history).Where("grade_id = ?", gradeID).
+		Order("version").Scan(ctx); err != nil {
+		return nil, fmt.Errorf("failed to get grade history: %w", err)
+	}
+
+	versions := make([]*Grade, 0, len(history)+1)
+	for _, entry := range history {
+		versions = append(versions, entry.toGrade())
+	}
+
+	versions = append(versions, grade)
+	if err := d.openComments(versions...); err != nil {
+		return nil, err
+	}
+
+	return versions, nil
+}
+
+// GetCourseGradeVersions retrieves every version of the grades of a course during a semester, keyed
+// by grade ID. Each list holds the recorded history oldest first, followed by the current grade.
+func (d *Database) GetCourseGradeVersions(ctx context.Context,
+	courseID, semester string,
+) (map[string][]*Grade, error) {
+	grades, err := d.GetCourseGrades(ctx, courseID, semester)
+	if err != nil {
+		return nil, err
+	}
+
+	versions := make(map[string][]*Grade, len(grades))
+	if len(grades) == 0 {
+		return versions, nil
+	}
+
+	gradeIDs := make([]string, 0, len(grades))
+	for _, grade := range grades {
+		gradeIDs = append(gradeIDs, grade.GradeID)
+	}
+
+	var history []*GradeHistory
+
+	if err := inChunks(gradeIDs, d.inListChunkSize, func(chunk []string) error {
+		var entries []*GradeHistory
+		if err := d.db.NewSelect().Model(&entries).Where("grade_id IN (?)", bun.In(chunk)).
+			Order("grade_id", "version").Scan(ctx); err != nil {
+			return fmt.Errorf("failed to get grade history: %w", err)
+		}
+
+		history = append(history, entries...)
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	for _, entry := range history {
+		version := entry.toGrade()
+		if err := d.openComments(version); err != nil {
+			return nil, err
+		}
+
+		versions[entry.GradeID] = append(versions[entry.GradeID], version)
+	}
+
+	for _, grade := range grades {
+		versions[grade.GradeID] = append(versions[grade.GradeID], grade)
+	}
+
+	return versions, nil
+}
+
+// RecordAudit stores an audit log entry.
+func (d *Database) RecordAudit(ctx context.Context, entry *AuditEntry) error {
+	if _, err := d.db.NewInsert().Model(entry).Exec(ctx); err != nil {
+		return fmt.Errorf("failed to record audit entry: %w", err)
+	}
+
+	return nil
+}
+
+// GetGradeAudit retrieves the audit log entries of a grade, oldest first.
+func (d *Database) GetGradeAudit(ctx context.Context, gradeID string) ([]*AuditEntry, error) {
+	var entries []*AuditEntry
+	if err := d.db.NewSelect().Model(&entries).Where("grade_id = ?", gradeID).
+		Order("created_at", "audit_id").Scan(ctx); err != nil {
+		return nil, fmt.Errorf("failed to get grade audit log: %w", err)
+	}
+
+	return entries, nil
+}
+
+// GradeTypeSummary aggregates the grades of one grade type.
+type GradeTypeSummary struct {
+	GradeType string `bun:"grade_type"`
+	// Count includes non-numeric grades, which NumericCount and Average leave out.
+	Count        int64 `bun:"count"`
+	NumericCount int64 `bun:"numeric_count"`
+	// Average is nil when the grade type has no numeric grade.
+	Average *float64 `bun:"average"`
+}
+
+// GetSemesterGradeTypeSummary counts the grades of a semester and averages their numeric values per
+// grade type, ordered by grade type. A semester without grades has no summaries.
+func (d *Database) GetSemesterGradeTypeSummary(ctx context.Context, semester string) ([]*GradeTypeSummary, error) {
+	if semester == "" {
+		return nil, fmt.Errorf("%w", ErrSemesterEmpty)
+	}
+
+	var summaries []*GradeTypeSummary
+	if err := d.db.NewSelect().Model((*Grade)(nil)).
+		Column("grade_type").
+		ColumnExpr("COUNT(*) AS count").
+		ColumnExpr("COUNT(numeric_value) AS numeric_count").
+		ColumnExpr("AVG(numeric_value) AS average").
+		Where("semester = ?", semester).
+		Group("grade_type").Order("grade_type").
+		Scan(ctx, &summaries); err != nil {
+		return nil, fmt.Errorf("failed to get semester grade type summary: %w", err)
+	}
+
+	return summaries, nil
+}
+
+// SetNotificationOptOut records whether a student opted out of grade change notifications.
+func (d *Database) SetNotificationOptOut(ctx context.Context, studentID string, optOut bool) error {
+	if studentID == "" {
+		return fmt.Errorf("%w", ErrStudentIDEmpty)
+	}
+
+	if !optOut {
+		if _, err := d.db.NewDelete().Model((*NotificationOptOut)(nil)).
+			Where("institution_id = ? AND student_id = ?", institutionFromContext(ctx), studentID).
+			Exec(ctx); err != nil {
+			return fmt.Errorf("failed to opt in to notifications: %w", err)
+		}
+
+		return nil
+	}
+
+	optOutRow := &NotificationOptOut{InstitutionID: institutionFromContext(ctx), StudentID: studentID}
+	if _, err := d.db.NewInsert().Model(optOutRow).
+		On("CONFLICT (institution_id, student_id) DO NOTHING").Exec(ctx); err != nil {
+		return fmt.Errorf("failed to opt out of notifications: %w", err)
+	}
+
+	return nil
+}
+
+// IsNotificationOptedOut reports whether a student opted out of grade change notifications.
+func (d *Database) IsNotificationOptedOut(ctx context.Context, studentID string) (bool, error) {
+	optedOut, err := d.db.NewSelect().Model((*NotificationOptOut)(nil)).
+		Where("institution_id = ? AND student_id = ?", institutionFromContext(ctx), studentID).Exists(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to get notification opt-out: %w", err)
+	}
+
+	return optedOut, nil
+}
+
+// SetNotificationDigest records whether a student gets a periodic digest of grade changes.
+func (d *Database) SetNotificationDigest(ctx context.Context, studentID string, digest bool) error {
+	if studentID == "" {
+		return fmt.Errorf("%w", ErrStudentIDEmpty)
+	}
+
+	if !digest {
+		if _, err := d.db.NewDelete().Model((*NotificationDigest)(nil)).
+			Where("institution_id = ? AND student_id = ?", institutionFromContext(ctx), studentID).
+			Exec(ctx); err != nil {
+			return fmt.Errorf("failed to disable notification digest: %w", err)
+		}
+
+		return nil
+	}
+
+	digestRow := &NotificationDigest{InstitutionID: institutionFromContext(ctx), StudentID: studentID}
+	if _, err := d.db.NewInsert().Model(digestRow).
+		On("CONFLICT (institution_id, student_id) DO NOTHING").Exec(ctx); err != nil {
+		return fmt.Errorf("failed to enable notification digest: %w", err)
+	}
+
+	return nil
+}
+
+// IsNotificationDigestEnabled reports whether a student gets a periodic digest of grade changes.
+func (d *Database) IsNotificationDigestEnabled(ctx context.Context, studentID string) (bool, error) {
+	digest, err := d.db.NewSelect().Model((*NotificationDigest)(nil)).
+		Where("institution_id = ? AND student_id = ?", institutionFromContext(ctx), studentID).Exists(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to get notification digest preference: %w", err)
+	}
+
+	return digest, nil
+}
+
+// validateCourseGrader ensures both the course and the grader are named.
+func validateCourseGrader(courseID, graderID string) error {
+	if courseID == "" {
+		return fmt.Errorf("%w", ErrCourseIDEmpty)
+	}
+
+	if graderID == "" {
+		return fmt.Errorf("%w", ErrGraderIDEmpty)
+	}
+
+	return nil
+}
+
+// AddCourseGrader allows a grader to grade a course. Adding a listed grader again does nothing.
+func (d *Database) AddCourseGrader(ctx context.Context, courseID, graderID string) error {
+	if err := validateCourseGrader(courseID, graderID); err != nil {
+		return err
+	}
+
+	grader := &CourseGrader{InstitutionID: institutionFromContext(ctx), CourseID: courseID, GraderID: graderID}
+	if _, err := d.db.NewInsert().Model(grader).
+		On("CONFLICT (institution_id, course_id, grader_id) DO NOTHING").Exec(ctx); err != nil {
+		return fmt.Errorf("failed to add course grader: %w", err)
+	}
+
+	return nil
+}
+
+// RemoveCourseGrader removes a grader from the graders of a course.
+func (d *Database) RemoveCourseGrader(ctx context.Context, courseID, graderID string) error {
+	if err := validateCourseGrader(courseID, graderID); err != nil {
+		return err
+	}
+
+	if _, err := d.db.NewDelete().Model((*CourseGrader)(nil)).
+		Where("institution_id = ? AND course_id = ? AND grader_id = ?", institutionFromContext(ctx), courseID, graderID).
+		Exec(ctx); err != nil {
+		return fmt.Errorf("failed to remove course grader: %w", err)
+	}
+
+	return nil
+}
+
+// GetCourseGraders retrieves the graders allowed to grade a course, ordered by grader ID. The list is
+// empty when the course is unrestricted.
+func (d *Database) GetCourseGraders(ctx context.Context, courseID string) ([]string, error) {
+	if courseID == "" {
+		return nil, fmt.Errorf("%w", ErrCourseIDEmpty)
+	}
+
+	var graders []string
+	if err := d.db.NewSelect().Model((*CourseGrader)(nil)).Column("grader_id").
+		Where("institution_id = ? AND course_id = ?", institutionFromContext(ctx), courseID).
+		Order("grader_id").Scan(ctx, &graders); err != nil {
+		return nil, fmt.Errorf("failed to get course graders: %w", err)
+	}
+
+	return graders, nil
+}
+
+// SetCourseRelease sets the release time of a course offering's grades; the zero time releases
+// them immediately.
+func (d *Database) SetCourseRelease(ctx context.Context, courseID, semester string, releaseAt time.Time) error {
+	switch {
+	case courseID == "":
+		return fmt.Errorf("%w", ErrCourseIDEmpty)
+	case semester == "":
+		return fmt.Errorf("%w", ErrSemesterEmpty)
+	}
+
+	if releaseAt.IsZero() {
+		if _, err := d.db.NewDelete().Model((*CourseRelease)(nil)).
+			Where("institution_id = ? AND course_id = ? AND semester = ?", institutionFromContext(ctx), courseID, semester).
+			Exec(ctx); err != nil {
+			return fmt.Errorf("failed to clear course release: %w", err)
+		}
+
+		return nil
+	}
+
+	release := &CourseRelease{
+		InstitutionID: institutionFromContext(ctx), CourseID: courseID, Semester: semester, ReleaseAt: releaseAt,
+	}
+	if _, err := d.db.NewInsert().Model(release).
+		On("CONFLICT (institution_id, course_id, semester) DO UPDATE").Set("release_at = EXCLUDED.release_at").
+		Exec(ctx); err != nil {
+		return fmt.Errorf("failed to set course release: %w", err)
+	}
+
+	return nil
+}
+
+// GetPendingReleases retrieves the course offerings of the context's institution whose grades are
+// released after now.
+func (d *Database) GetPendingReleases(ctx context.Context, now time.Time) ([]*CourseRelease, error) {
+	var releases []*CourseRelease
+	if err := d.reader(ctx).NewSelect().Model(&releases).
+		Where("institution_id = ? AND release_at > ?", institutionFromContext(ctx), now).Scan(ctx); err != nil {
+		return nil, fmt.Errorf("failed to get pending course releases: %w", err)
+	}
+
+	return releases, nil
+}
+
+// SetGradeTypeScheme sets the grading scheme of a grade type in a course; an empty scheme removes the
+// mapping, so the grade type falls back to the global scheme.
+func (d *Database) SetGradeTypeScheme(ctx context.Context, courseID, gradeType string, scheme GradeScheme) error {
+	switch {
+	case courseID == "":
+		return fmt.Errorf("%w", ErrCourseIDEmpty)
+	case gradeType == "":
+		return fmt.Errorf("%w", ErrGradeTypeEmpty)
+	}
+
+	if scheme == "" {
+		if _, err := d.db.NewDelete().Model((*GradeTypeScheme)(nil)).
+			Where("institution_id = ? AND course_id = ? AND grade_type = ?", institutionFromContext(ctx), courseID, gradeType).
+			Exec(ctx); err != nil {
+			return fmt.Errorf("failed to remove grade type scheme: %w", err)
+		}
+
+		return nil
+	}
+
+	mapping := &GradeTypeScheme{
+		InstitutionID: institutionFromContext(ctx), CourseID: courseID, GradeType: gradeType, Scheme: string(scheme),
+	}
+	if _, err := d.db.NewInsert().Model(mapping).
+		On("CONFLICT (institution_id, course_id, grade_type) DO UPDATE").Set("scheme = EXCLUDED.scheme").
+		Exec(ctx); err != nil {
+		return fmt.Errorf("failed to set grade type scheme: %w", err)
+	}
+
+	return nil
+}
+
+// GetGradeTypeSchemes retrieves the grading schemes of a course's grade types, keyed by grade type.
+func (d *Database) GetGradeTypeSchemes(ctx context.Context, courseID string) (map[string]GradeScheme, error) {
+	if courseID == "" {
+		return nil, fmt.Errorf("%w", ErrCourseIDEmpty)
+	}
+
+	var mappings []*GradeTypeScheme
+	if err := d.db.NewSelect().Model(&mappings).
+		Where("institution_id = ? AND course_id = ?", institutionFromContext(ctx), courseID).Scan(ctx); err != nil {
+		return nil, fmt.Errorf("failed to get grade type schemes: %w", err)
+	}
+
+	schemes := make(map[string]GradeScheme, len(mappings))
+	for _, mapping := range mappings {
+		schemes[mapping.GradeType] = GradeScheme(mapping.Scheme)
+	}
+
+	return schemes, nil
+}
+
+// SetAttemptPolicy sets the attempt policy of a course; an empty policy removes it, so every attempt
+// is returned.
+func (d *Database) SetAttemptPolicy(ctx context.Context, courseID string, policy AttemptPolicy) error {
+	if courseID == "" {
+		return fmt.Errorf("%w", ErrCourseIDEmpty)
+	}
+
+	if policy == "" {
+		if _, err := d.db.NewDelete().Model((*CourseAttemptPolicy)(nil)).
+			Where("institution_id = ? AND course_id = ?", institutionFromContext(ctx), courseID).Exec(ctx); err != nil {
+			return fmt.Errorf("failed to remove attempt policy: %w", err)
+		}
+
+		return nil
+	}
+
+	row := &CourseAttemptPolicy{InstitutionID: institutionFromContext(ctx), CourseID: courseID, Policy: string(policy)}
+	if _, err := d.db.NewInsert().Model(row).
+		On("CONFLICT (institution_id, course_id) DO UPDATE").Set("policy = EXCLUDED.policy").
+		Exec(ctx); err != nil {
+		return fmt.Errorf("failed to set attempt policy: %w", err)
+	}
+
+	return nil
+}
+
+// GetAttemptPolicy retrieves the attempt policy of a course, empty when it has none.
+func (d *Database) GetAttemptPolicy(ctx context.Context, courseID string) (AttemptPolicy, error) {
+	if courseID == "" {
+		return "", fmt.Errorf("%w", ErrCourseIDEmpty)
+	}
+
+	row := &CourseAttemptPolicy{}
+	if err := d.reader(ctx).NewSelect().Model(row).
+		Where("institution_id = ? AND course_id = ?", institutionFromContext(ctx), courseID).Scan(ctx); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", nil
+		}
+
+		return "", fmt.Errorf("failed to get attempt policy: %w", err)
+	}
+
+	return AttemptPolicy(row.Policy), nil
+}
+
+// courseStatsView is the materialized view holding the statistics of every course and semester.
+const courseStatsView = "course_stats"
+
+// CourseStats summarizes the grades of a course in a semester.
+type CourseStats struct {
+	bun.BaseModel `bun:"table:course_stats,alias:course_stats"`
+
+	InstitutionID string `bun:"institution_id"`
+	CourseID      string `bun:"course_id"`
+	Semester      string `bun:"semester"`
+	// Count includes non-numeric grades, which the other statistics leave out.
+	Count        int64 `bun:"count"`
+	NumericCount int64 `bun:"numeric_count"`
+	// Average, Minimum and Maximum are nil when the course has no numeric grade.
+	Average *float64 `bun:"average"`
+	Minimum *float64 `bun:"minimum"`
+	Maximum *float64 `bun:"maximum"`
+	// RefreshedAt is when the statistics were computed.
+	RefreshedAt time.Time `bun:"refreshed_at"`
+	// Live reports that the statistics were computed from the grades instead of read from the view.
+	Live bool `bun:"-"`
+}
+
+// createCourseStatsView creates the course statistics view and the unique index that lets it be
+// refreshed without blocking reads.
+func (d *Database) createCourseStatsView(ctx context.Context) error {
+	if _, err := d.db.ExecContext(ctx, `CREATE MATERIALIZED VIEW IF NOT EXISTS ? AS
+		SELECT institution_id, course_id, semester,
+			COUNT(*) AS count, COUNT(numeric_value) AS numeric_count, AVG(numeric_value) AS average,
+			MIN(numeric_value) AS minimum, MAX(numeric_value) AS maximum, now() AS refreshed_at
+		FROM grades WHERE deleted_at IS NULL
+		GROUP BY institution_id, course_id, semester`, bun.Ident(courseStatsView)); err != nil {
+		return fmt.Errorf("failed to create course stats view: %w", err)
+	}
+
+	if _, err := d.db.ExecContext(ctx, "CREATE UNIQUE INDEX IF NOT EXISTS ? ON ? (institution_id, course_id, semester)",
+		bun.Ident(courseStatsView+"_key_idx"), bun.Ident(courseStatsView)); err != nil {
+		return fmt.Errorf("failed to index course stats view: %w", err)
+	}
+
+	return nil
+}
+
+// RefreshCourseStats recomputes the course statistics view. Reads keep being served from the previous
+// contents until the refresh completes.
+func (d *Database) RefreshCourseStats(ctx context.Context) error {
+	if _, err := d.db.ExecContext(ctx, "REFRESH MATERIALIZED VIEW CONCURRENTLY ?",
+		bun.Ident(courseStatsView)); err != nil {
+		return fmt.Errorf("failed to refresh course stats: %w", err)
+	}
+
+	return nil
+}
+
+// GetCourseStats retrieves the statistics of a course in a semester from the course statistics view.
+// When the view holds no statistics for the course, or they are older than maxStaleness, they are
+// computed from the grades instead.
+func (d *Database) GetCourseStats(ctx context.Context,
+	courseID, semester string, maxStaleness time.Duration,
+) (*CourseStats, error) {
+	if courseID == "" {
+		return nil, fmt.Errorf("%w", ErrCourseIDEmpty)
+	}
+
+	stats := new(CourseStats)
+
+	err := d.reader(ctx).NewSelect().Model(stats).
+		Where("course_id = ? AND semester = ?", courseID, semester).
+		Apply(scopeInstitution(ctx)).Scan(ctx)
+
+	switch {
+	case err == nil && time.Since(stats.RefreshedAt) <= maxStaleness:
+		return stats, nil
+	case err != nil && !errors.Is(err, sql.ErrNoRows):
+		return nil, fmt.Errorf("failed to get course stats: %w", err)
+	}
+
+	return d.computeCourseStats(ctx, courseID, semester)
+}
+
+// computeCourseStats computes the statistics of a course in a semester from its grades.
+func (d *Database) computeCourseStats(ctx context.Context, courseID, semester string) (*CourseStats, error) {
+	stats := &CourseStats{
+		InstitutionID: institutionFromContext(ctx),
+		CourseID:      courseID,
+		Semester:      semester,
+		RefreshedAt:   time.Now(),
+		Live:          true,
+	}
+
+	if err := d.reader(ctx).NewSelect().Model((*Grade)(nil)).
+		ColumnExpr("COUNT(*)").ColumnExpr("COUNT(numeric_value)").ColumnExpr("AVG(numeric_value)").
+		ColumnExpr("MIN(numeric_value)").ColumnExpr("MAX(numeric_value)").
+		Where("course_id = ? AND semester = ?", courseID, semester).
+		Scan(ctx, &stats.Count, &stats.NumericCount, &stats.Average, &stats.Minimum, &stats.Maximum); err != nil {
+		return nil, fmt.Errorf("failed to compute course stats: %w", err)
+	}
+
+	return stats, nil
+}
@@ -3,9 +3,16 @@ package main
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	gpb "github.com/BetterGR/grades-microservice/protos"
 	"github.com/google/uuid"
@@ -46,6 +53,33 @@ func TestDatabaseSimpleFlow(t *testing.T) {
 	testDeleteGrade(ctx, t, database, gradeID)
 }
 
+func TestArchiveSemesterQueryMovesNamedColumns(t *testing.T) {
+	db := bun.NewDB(nil, pgdialect.New())
+
+	query := archiveSemesterQuery(db, "Winter_2023", "inst-1").String()
+	assert.True(t, strings.HasPrefix(query, `WITH moved AS (DELETE FROM "grades" WHERE semester = 'Winter_2023' `+
+		`AND ('inst-1' = '' OR institution_id = 'inst-1') RETURNING "grade_id", "student_id", `), query)
+	assert.Contains(t, query, `INSERT INTO "archived_grades" ("grade_id", "student_id", `)
+	assert.Regexp(t, `SELECT "grade_id", "student_id", .+" FROM moved RETURNING deleted_at`, query)
+	assert.True(t, strings.HasSuffix(query, "SELECT count(*) FROM archived WHERE deleted_at IS NULL"), query)
+	assert.NotContains(t, query, "SELECT *")
+}
+
+func TestGradeHistoryRoundTrip(t *testing.T) {
+	gradedAt := time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC)
+	grade := &Grade{
+		GradeID: "g1", StudentID: "s1", CourseID: "c1", Semester: "Winter_2023", GradeType: "exam",
+		ItemID: "final", GradeValue: "87", GradedBy: "prof", GradedAt: gradedAt, UpdatedAt: gradedAt.Add(time.Hour),
+		Comments: "good", Version: 3, NumericValue: numericGradeValue("87"),
+		RubricScores:  RubricScores{{Criterion: "clarity", Points: 8, Max: 10}},
+		InstitutionID: "uni-a",
+		SubmittedAt:   gradedAt.Add(-time.Hour), DueAt: gradedAt.Add(-2 * time.Hour),
+		AppealStatus: appealUnderAppeal, SectionID: "sec-1", ChangedBy: "ta-1",
+	}
+
+	assert.Equal(t, grade, newGradeHistory(grade).toGrade(), "history keeps every field of a version")
+}
+
 // setupTestDatabaseWithoutConstraints creates a database connection that skips foreign key constraints
 // for testing purposes.
 func setupTestDatabaseWithoutConstraints() (*Database, error) {
@@ -80,6 +114,14 @@ func setupTestDatabaseWithoutConstraints() (*Database, error) {
 		return nil, fmt.Errorf("failed to create test table: %w", err)
 	}
 
+	// Bring a table left over from an older run up to date.
+	for _, column := range gradeColumnMigrations {
+		if _, err := bunDB.ExecContext(ctx, "ALTER TABLE grades ADD COLUMN IF NOT EXISTS "+column); err != nil {
+			_, _ = bunDB.ExecContext(ctx, "SET session_replication_role = 'origin';")
+			return nil, fmt.Errorf("failed to migrate test table: %w", err)
+		}
+	}
+
 	return &Database{db: bunDB}, nil
 }
 
@@ -177,3 +219,698 @@ func testDeleteGrade(ctx context.Context, t *testing.T, database *Database, grad
 	t.Log("Grade deletion successful")
 	t.Log("Test completed successfully")
 }
+
+// likePatternToRegexp mirrors Postgres ILIKE semantics with the default backslash escape.
+func likePatternToRegexp(pattern string) *regexp.Regexp {
+	var expr strings.Builder
+
+	expr.WriteString("(?is)^")
+
+	for i := 0; i < len(pattern); i++ {
+		switch c := pattern[i]; {
+		case c == '\\' && i+1 < len(pattern):
+			i++
+			expr.WriteString(regexp.QuoteMeta(string(pattern[i])))
+		case c == '%':
+			expr.WriteString(".*")
+		case c == '_':
+			expr.WriteString(".")
+		default:
+			expr.WriteString(regexp.QuoteMeta(string(c)))
+		}
+	}
+
+	expr.WriteString("$")
+
+	return regexp.MustCompile(expr.String())
+}
+
+func TestEscapeLikePattern(t *testing.T) {
+	tests := []struct {
+		name     string
+		term     string
+		expected string
+	}{
+		{name: "plain", term: "good work", expected: "good work"},
+		{name: "percent", term: "50%", expected: `50\%`},
+		{name: "underscore", term: "hw_1", expected: `hw\_1`},
+		{name: "backslash", term: `a\b`, expected: `a\\b`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, escapeLikePattern(tt.term))
+		})
+	}
+}
+
+func TestEscapeLikePatternMatchesLiterally(t *testing.T) {
+	pattern := likePatternToRegexp("%" + escapeLikePattern("50%") + "%")
+
+	assert.True(t, pattern.MatchString("Scored 50% on the bonus"))
+	assert.False(t, pattern.MatchString("Scored 500 on the bonus"))
+
+	underscore := likePatternToRegexp("%" + escapeLikePattern("hw_1") + "%")
+
+	assert.True(t, underscore.MatchString("late HW_1 submission"))
+	assert.False(t, underscore.MatchString("late hwx1 submission"))
+}
+
+func TestNumericGradeValue(t *testing.T) {
+	number := numericGradeValue(" 87.5 ")
+	require.NotNil(t, number)
+	assert.InDelta(t, 87.5, *number, 0)
+
+	assert.Nil(t, numericGradeValue("A-"))
+	assert.Nil(t, numericGradeValue("PASS"))
+	assert.Nil(t, numericGradeValue(""))
+}
+
+// TestNumericValueColumn checks that numeric grades populate numeric_value and letters leave it NULL.
+func TestNumericValueColumn(t *testing.T) {
+	if os.Getenv("DB_TESTS") != "true" {
+		t.Skip("Skipping database tests. Set DB_TESTS=true to run them.")
+	}
+
+	database, err := setupTestDatabaseWithoutConstraints()
+	require.NoError(t, err, "Failed to initialize test database")
+
+	defer cleanupTestDatabase(database)
+
+	ctx := context.Background()
+	studentID, courseID, semester, _ := createTestData()
+
+	numericID := testAddGrade(ctx, t, database, buildTestGrade(studentID, courseID, semester, "92.5"))
+	letterID := testAddGrade(ctx, t, database, buildTestGrade(studentID, courseID, semester, "B+"))
+
+	defer func() {
+		_, _ = database.db.ExecContext(ctx, "DELETE FROM grades WHERE grade_id IN (?, ?)", numericID, letterID)
+	}()
+
+	var numericValue sql.NullFloat64
+
+	err = database.db.NewSelect().Model((*Grade)(nil)).Column("numeric_value").
+		Where("grade_id = ?", numericID).Scan(ctx, &numericValue)
+	require.NoError(t, err)
+	assert.True(t, numericValue.Valid)
+	assert.InDelta(t, 92.5, numericValue.Float64, 0)
+
+	err = database.db.NewSelect().Model((*Grade)(nil)).Column("numeric_value").
+		Where("grade_id = ?", letterID).Scan(ctx, &numericValue)
+	require.NoError(t, err)
+	assert.False(t, numericValue.Valid, "letter grades must leave numeric_value NULL")
+}
+
+// TestDatabaseDuplicatePolicies checks both duplicate policies on a real database.
+func TestDatabaseDuplicatePolicies(t *testing.T) {
+	if os.Getenv("DB_TESTS") != "true" {
+		t.Skip("Skipping database tests. Set DB_TESTS=true to run them.")
+	}
+
+	database, err := setupTestDatabaseWithoutConstraints()
+	require.NoError(t, err, "Failed to initialize test database")
+
+	defer cleanupTestDatabase(database)
+
+	ctx := context.Background()
+
+	_, err = database.db.NewCreateTable().IfNotExists().Model((*GradeHistory)(nil)).Exec(ctx)
+	require.NoError(t, err)
+
+	studentID, courseID, semester, _ := createTestData()
+
+	defer func() {
+		_, _ = database.db.ExecContext(ctx, "DELETE FROM grades WHERE course_id = ?", courseID)
+		_, _ = database.db.ExecContext(ctx, "DELETE FROM grade_history WHERE course_id = ?", courseID)
+	}()
+
+	first := testAddGrade(ctx, t, database, buildTestGrade(studentID, courseID, semester, "70"))
+
+	database.onDuplicate = duplicateReject
+	_, err = database.AddGrade(ctx, buildTestGrade(studentID, courseID, semester, "85"))
+	require.ErrorIs(t, err, ErrGradeAlreadyExists)
+
+	database.onDuplicate = duplicateUpdate
+	updated, err := database.AddGrade(ctx, buildTestGrade(studentID, courseID, semester, "85"))
+	require.NoError(t, err)
+	assert.Equal(t, first, updated.GradeID)
+	assert.Equal(t, int64(2), updated.Version)
+
+	grades, err := database.GetStudentCourseGrades(ctx, courseID, semester, studentID)
+	require.NoError(t, err)
+	require.Len(t, grades, 1)
+	assert.Equal(t, "85", grades[0].GradeValue)
+}
+
+// TestDatabasePurgeTombstones checks that removed grades are hidden and purged only once expired.
+func TestDatabasePurgeTombstones(t *testing.T) {
+	if os.Getenv("DB_TESTS") != "true" {
+		t.Skip("Skipping database tests. Set DB_TESTS=true to run them.")
+	}
+
+	database, err := setupTestDatabaseWithoutConstraints()
+	require.NoError(t, err, "Failed to initialize test database")
+
+	defer cleanupTestDatabase(database)
+
+	ctx := context.Background()
+
+	_, err = database.db.NewCreateTable().IfNotExists().Model((*Grade)(nil)).
+		ModelTableExpr(archivedGradesTable).Exec(ctx)
+	require.NoError(t, err)
+
+	studentID, courseID, semester, gradeValue := createTestData()
+	expired := testAddGrade(ctx, t, database, buildTestGrade(studentID, courseID, semester, gradeValue))
+	recent := testAddGrade(ctx, t, database, buildTestGrade(studentID, courseID, semester, gradeValue))
+
+	defer func() {
+		_, _ = database.db.ExecContext(ctx, "DELETE FROM grades WHERE course_id = ?", courseID)
+	}()
+
+	require.NoError(t, database.RemoveGrade(ctx, expired))
+	require.NoError(t, database.RemoveGrade(ctx, recent))
+
+	_, err = database.GetGradeByID(ctx, expired)
+	require.ErrorIs(t, err, ErrGradeNotFound, "removed grades are hidden")
+
+	_, err = database.db.ExecContext(ctx, "UPDATE grades SET deleted_at = now() - interval '40 days' WHERE grade_id = ?",
+		expired)
+	require.NoError(t, err)
+
+	purged, err := database.PurgeTombstones(ctx, time.Now().AddDate(0, 0, -30))
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), purged)
+
+	remaining, err := database.db.NewSelect().Model((*Grade)(nil)).WhereDeleted().
+		Where("course_id = ?", courseID).Count(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, remaining)
+}
+
+// TestDatabaseSoftDeleteStudentGrades checks that a student's grades are removed together and audited.
+func TestDatabaseSoftDeleteStudentGrades(t *testing.T) {
+	if os.Getenv("DB_TESTS") != "true" {
+		t.Skip("Skipping database tests. Set DB_TESTS=true to run them.")
+	}
+
+	database, err := setupTestDatabaseWithoutConstraints()
+	require.NoError(t, err, "Failed to initialize test database")
+
+	defer cleanupTestDatabase(database)
+
+	ctx := context.Background()
+
+	studentID, courseID, semester, gradeValue := createTestData()
+	first := testAddGrade(ctx, t, database, buildTestGrade(studentID, courseID, semester, gradeValue))
+	second := testAddGrade(ctx, t, database, buildTestGrade(studentID, courseID, semester, gradeValue))
+	other := testAddGrade(ctx, t, database, buildTestGrade(studentID+"-other", courseID, semester, gradeValue))
+
+	defer func() {
+		_, _ = database.db.ExecContext(ctx, "DELETE FROM grades WHERE course_id = ?", courseID)
+		_, _ = database.db.ExecContext(ctx, "DELETE FROM audit_log WHERE grade_id IN (?)",
+			bun.In([]string{first, second}))
+	}()
+
+	gradeIDs, err := database.SoftDeleteStudentGrades(ctx, studentID, "admin-1")
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{first, second}, gradeIDs)
+
+	grades, err := database.GetStudentGrades(ctx, studentID)
+	require.NoError(t, err)
+	assert.Empty(t, grades, "removed grades are hidden")
+
+	_, err = database.GetGradeByID(ctx, other)
+	require.NoError(t, err, "other students' grades are kept")
+
+	tombstones, err := database.db.NewSelect().Model((*Grade)(nil)).WhereDeleted().
+		Where("student_id = ?", studentID).Count(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 2, tombstones)
+
+	entries, err := database.GetGradeAudit(ctx, first)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.Equal(t, auditActionDelete, entries[0].Action)
+	assert.Equal(t, "admin-1", entries[0].Actor)
+}
+
+// TestDatabaseInstitutionScoping checks that grade queries never cross institutions.
+func TestDatabaseInstitutionScoping(t *testing.T) {
+	if os.Getenv("DB_TESTS") != "true" {
+		t.Skip("Skipping database tests. Set DB_TESTS=true to run them.")
+	}
+
+	database, err := setupTestDatabaseWithoutConstraints()
+	require.NoError(t, err, "Failed to initialize test database")
+
+	defer cleanupTestDatabase(database)
+
+	studentID, courseID, semester, gradeValue := createTestData()
+	uniA := withInstitution(context.Background(), "uni-a")
+	uniB := withInstitution(context.Background(), "uni-b")
+
+	defer func() {
+		_, _ = database.db.ExecContext(context.Background(), "DELETE FROM grades WHERE course_id = ?", courseID)
+	}()
+
+	gradeID := testAddGrade(uniA, t, database, buildTestGrade(studentID, courseID, semester, gradeValue))
+
+	grades, err := database.GetCourseGrades(uniB, courseID, semester)
+	require.NoError(t, err)
+	assert.Empty(t, grades, "grades of another institution must not be visible")
+
+	_, err = database.GetGradeByID(uniB, gradeID)
+	require.ErrorIs(t, err, ErrGradeNotFound)
+
+	require.ErrorIs(t, database.RemoveGrade(uniB, gradeID), ErrGradeNotFound)
+
+	grade, err := database.GetGradeByID(uniA, gradeID)
+	require.NoError(t, err, "removing from another institution must not affect the grade")
+	assert.Equal(t, "uni-a", grade.InstitutionID)
+}
+
+// TestDatabasePoolExhausted checks that a single-connection pool reports a busy database while its
+// connection is held.
+func TestDatabasePoolExhausted(t *testing.T) {
+	if os.Getenv("DB_TESTS") != "true" {
+		t.Skip("Skipping database tests. Set DB_TESTS=true to run them.")
+	}
+
+	database, err := setupTestDatabaseWithoutConstraints()
+	require.NoError(t, err, "Failed to initialize test database")
+
+	defer cleanupTestDatabase(database)
+
+	database.db.SetMaxOpenConns(1)
+	pooled := newPooledDatabase(database, 1, 100*time.Millisecond)
+
+	ctx := context.Background()
+	studentID, courseID, semester, gradeValue := createTestData()
+
+	defer func() {
+		_, _ = database.db.ExecContext(ctx, "DELETE FROM grades WHERE course_id = ?", courseID)
+	}()
+
+	testAddGrade(ctx, t, database, buildTestGrade(studentID, courseID, semester, gradeValue))
+
+	release := holdConnection(t, pooled, courseID, semester)
+
+	_, err = pooled.GetCourseGrades(ctx, courseID, semester)
+	require.ErrorIs(t, err, ErrDatabaseBusy)
+
+	release()
+
+	grades, err := pooled.GetCourseGrades(ctx, courseID, semester)
+	require.NoError(t, err)
+	assert.Len(t, grades, 1)
+}
+
+// TestDatabaseSchemaInitialization checks that schema initialization is idempotent and creates every
+// table and index of the schema.
+func TestDatabaseSchemaInitialization(t *testing.T) {
+	if os.Getenv("DB_TESTS") != "true" {
+		t.Skip("Skipping database tests. Set DB_TESTS=true to run them.")
+	}
+
+	database, err := setupTestDatabaseWithoutConstraints()
+	require.NoError(t, err, "Failed to initialize test database")
+
+	defer cleanupTestDatabase(database)
+
+	ctx := context.Background()
+
+	require.NoError(t, database.createSchemaIfNotExists(ctx))
+	require.NoError(t, database.createSchemaIfNotExists(ctx), "initialization must be idempotent")
+
+	var tables []string
+	require.NoError(t, database.db.NewSelect().TableExpr("information_schema.tables").Column("table_name").
+		Where("table_schema = current_schema()").Scan(ctx, &tables))
+
+	var indexes []string
+	require.NoError(t, database.db.NewSelect().TableExpr("pg_indexes").Column("indexname").
+		Where("schemaname = current_schema()").Scan(ctx, &indexes))
+
+	assert.Contains(t, tables, archivedGradesTable)
+
+	for _, table := range schemaTables {
+		assert.Contains(t, tables, table.name)
+
+		for _, index := range table.indexes {
+			assert.Contains(t, indexes, index.name)
+		}
+	}
+
+	require.NoError(t, database.validateSchema(ctx, schemaValidationStrict))
+}
+
+// TestDatabaseWarmup checks that warmup leaves the requested number of connections open and idle.
+func TestDatabaseWarmup(t *testing.T) {
+	if os.Getenv("DB_TESTS") != "true" {
+		t.Skip("Skipping database tests. Set DB_TESTS=true to run them.")
+	}
+
+	database, err := setupTestDatabaseWithoutConstraints()
+	require.NoError(t, err, "Failed to initialize test database")
+
+	defer cleanupTestDatabase(database)
+
+	warmed, err := database.warmup(context.Background(), 4)
+	require.NoError(t, err)
+	assert.Equal(t, 4, warmed)
+
+	stats := database.db.Stats()
+	assert.Equal(t, 4, stats.OpenConnections)
+	assert.Equal(t, 4, stats.Idle)
+
+	database.db.SetMaxOpenConns(2)
+
+	warmed, err = database.warmup(context.Background(), 4)
+	require.NoError(t, err)
+	assert.Equal(t, 2, warmed, "warmup must not open more connections than the pool allows")
+}
+
+func TestGradeValueQuerySelectsOnlyValue(t *testing.T) {
+	db := bun.NewDB(nil, pgdialect.New())
+
+	query, err := gradeValueQuery(db, GradeKey{GradeID: "g1"})
+	require.NoError(t, err)
+	assert.Equal(t, `SELECT "grade"."grade_value" FROM "grades" AS "grade" `+
+		`WHERE (grade_id = 'g1') AND "grade"."deleted_at" IS NULL`, query.String())
+
+	query, err = gradeValueQuery(db, GradeKey{StudentID: "s1", CourseID: "c1", Semester: "Winter_2023", ItemID: "i1"})
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(query.String(), `SELECT "grade"."grade_value" FROM "grades" AS "grade" `),
+		query.String())
+	assert.Contains(t, query.String(), "LIMIT 1")
+
+	_, err = gradeValueQuery(db, GradeKey{CourseID: "c1"})
+	require.ErrorIs(t, err, ErrStudentIDEmpty)
+
+	_, err = gradeValueQuery(db, GradeKey{StudentID: "s1"})
+	require.ErrorIs(t, err, ErrCourseIDEmpty)
+}
+
+func TestInChunks(t *testing.T) {
+	var chunks [][]int
+
+	require.NoError(t, inChunks([]int{1, 2, 3, 4, 5}, 2, func(chunk []int) error {
+		chunks = append(chunks, chunk)
+
+		return nil
+	}))
+	assert.Equal(t, [][]int{{1, 2}, {3, 4}, {5}}, chunks)
+
+	calls := 0
+	require.NoError(t, inChunks(nil, 2, func([]int) error {
+		calls++
+
+		return nil
+	}))
+	assert.Zero(t, calls, "an empty list runs no query")
+
+	chunks = nil
+	require.NoError(t, inChunks([]int{1, 2, 3}, 0, func(chunk []int) error {
+		chunks = append(chunks, chunk)
+
+		return nil
+	}))
+	assert.Equal(t, [][]int{{1, 2, 3}}, chunks, "a size of 0 uses the default")
+
+	errStop := errors.New("stop")
+	calls = 0
+	require.ErrorIs(t, inChunks([]int{1, 2, 3}, 1, func([]int) error {
+		calls++
+
+		return errStop
+	}), errStop)
+	assert.Equal(t, 1, calls, "the first error stops the remaining chunks")
+}
+
+// TestDatabaseInListChunking checks that IN lists longer than the chunk size still match every row.
+func TestDatabaseInListChunking(t *testing.T) {
+	if os.Getenv("DB_TESTS") != "true" {
+		t.Skip("Skipping database tests. Set DB_TESTS=true to run them.")
+	}
+
+	database, err := setupTestDatabaseWithoutConstraints()
+	require.NoError(t, err, "Failed to initialize test database")
+
+	defer cleanupTestDatabase(database)
+
+	ctx := context.Background()
+	database.inListChunkSize = 2
+
+	studentID, courseID, semester, gradeValue := createTestData()
+
+	defer func() {
+		_, _ = database.db.ExecContext(ctx, "DELETE FROM grades WHERE course_id = ?", courseID)
+		_, _ = database.db.ExecContext(ctx, "DELETE FROM grade_history WHERE course_id = ?", courseID)
+	}()
+
+	for i := range 5 {
+		grade := buildTestGrade(fmt.Sprintf("%s-%d", studentID, i), courseID, semester, gradeValue)
+		grade.GradeID = testAddGrade(ctx, t, database, grade)
+		grade.GradeValue = "90"
+
+		_, err := database.UpdateGrade(ctx, grade)
+		require.NoError(t, err)
+	}
+
+	versions, err := database.GetCourseGradeVersions(ctx, courseID, semester)
+	require.NoError(t, err)
+	require.Len(t, versions, 5, "every grade is found across the chunks")
+
+	for gradeID, gradeVersions := range versions {
+		require.Len(t, gradeVersions, 2, gradeID)
+		assert.Equal(t, gradeValue, gradeVersions[0].GradeValue)
+		assert.Equal(t, "90", gradeVersions[1].GradeValue)
+	}
+}
+
+func TestDatabaseCopyGrades(t *testing.T) {
+	if os.Getenv("DB_TESTS") != "true" {
+		t.Skip("Skipping database tests. Set DB_TESTS=true to run them.")
+	}
+
+	database, err := setupTestDatabaseWithoutConstraints()
+	require.NoError(t, err, "Failed to initialize test database")
+
+	defer cleanupTestDatabase(database)
+
+	ctx := context.Background()
+	studentID, insertedCourse, semester, _ := createTestData()
+	_, copiedCourse, _, _ := createTestData()
+
+	defer func() {
+		_, _ = database.db.ExecContext(ctx, "DELETE FROM grades WHERE course_id IN (?, ?)", insertedCourse, copiedCourse)
+	}()
+
+	batch := func(courseID string) []*gpb.SingleGrade {
+		grades := make([]*gpb.SingleGrade, 0, 5)
+		for i := range 5 {
+			grade := buildTestGrade(fmt.Sprintf("%s-%d", studentID, i), courseID, semester, strconv.Itoa(80+i))
+			grade.Comments = fmt.Sprintf("line %d\n\twith \\ escapes", i)
+			grade.RubricScores = []*gpb.RubricScore{{Criterion: "style", Points: float64(i), Max: 5}}
+			grades = append(grades, grade)
+		}
+
+		return grades
+	}
+
+	database.copyThreshold = 0
+	inserted, err := database.AddGrades(ctx, batch(insertedCourse))
+	require.NoError(t, err)
+	require.Len(t, inserted, 5)
+
+	database.copyThreshold = 2
+	copied, err := database.AddGrades(ctx, batch(copiedCourse))
+	require.NoError(t, err)
+	require.Len(t, copied, 5)
+
+	byStudent := func(grades []*Grade) {
+		sort.Slice(grades, func(i, j int) bool { return grades[i].StudentID < grades[j].StudentID })
+	}
+
+	want, err := database.GetCourseGrades(ctx, insertedCourse, semester)
+	require.NoError(t, err)
+	byStudent(want)
+
+	got, err := database.GetCourseGrades(ctx, copiedCourse, semester)
+	require.NoError(t, err)
+	require.Len(t, got, len(want), "every copied grade is queryable")
+	byStudent(got)
+
+	for i, grade := range got {
+		assert.Equal(t, copied[i].GradeID, grade.GradeID, "the returned grades are the stored ones")
+		assert.True(t, copied[i].GradedAt.Equal(grade.GradedAt), "the returned timestamps are the stored ones")
+
+		grade.GradeID, grade.CourseID, grade.GradedAt, grade.UpdatedAt = "", "", time.Time{}, time.Time{}
+		want[i].GradeID, want[i].CourseID, want[i].GradedAt, want[i].UpdatedAt = "", "", time.Time{}, time.Time{}
+	}
+
+	assert.Equal(t, want, got, "COPY stores what INSERT stores")
+}
+
+func TestGroupBySemesterAndCourse(t *testing.T) {
+	grades := []*Grade{
+		{GradeID: "1", Semester: "Winter_2024", CourseID: "c1"},
+		{GradeID: "2", Semester: "Winter_2024", CourseID: "c2"},
+		{GradeID: "3", Semester: "Spring_2024", CourseID: "c1"},
+		{GradeID: "4", Semester: "Winter_2024", CourseID: "c1"},
+	}
+
+	groups := groupBySemesterAndCourse(grades)
+
+	assert.Equal(t, map[string]map[string][]*Grade{
+		"Winter_2024": {"c1": {grades[0], grades[3]}, "c2": {grades[1]}},
+		"Spring_2024": {"c1": {grades[2]}},
+	}, groups)
+	assert.Empty(t, groupBySemesterAndCourse(nil))
+}
+
+// queryCounter is a query hook counting the queries run by a database.
+type queryCounter struct {
+	count atomic.Int64
+}
+
+func (c *queryCounter) BeforeQuery(ctx context.Context, _ *bun.QueryEvent) context.Context {
+	c.count.Add(1)
+
+	return ctx
+}
+
+func (c *queryCounter) AfterQuery(context.Context, *bun.QueryEvent) {}
+
+// TestDatabaseStudentGradesGrouped checks that the grouped read returns what reading each semester
+// returns, in a single query.
+func TestDatabaseStudentGradesGrouped(t *testing.T) {
+	if os.Getenv("DB_TESTS") != "true" {
+		t.Skip("Skipping database tests. Set DB_TESTS=true to run them.")
+	}
+
+	database, err := setupTestDatabaseWithoutConstraints()
+	require.NoError(t, err, "Failed to initialize test database")
+
+	defer cleanupTestDatabase(database)
+
+	ctx := context.Background()
+	studentID, courseID, _, gradeValue := createTestData()
+	semesters := []string{"Winter_2024", "Spring_2024", "Summer_2024"}
+
+	defer func() {
+		_, _ = database.db.ExecContext(ctx, "DELETE FROM grades WHERE student_id = ?", studentID)
+	}()
+
+	for _, semester := range semesters {
+		for _, course := range []string{courseID, courseID + "-2"} {
+			testAddGrade(ctx, t, database, buildTestGrade(studentID, course, semester, gradeValue))
+		}
+	}
+
+	counter := &queryCounter{}
+	database.db.AddQueryHook(counter)
+
+	looped := make(map[string]map[string][]*Grade)
+
+	for _, semester := range semesters {
+		grades, err := database.GetStudentSemesterGrades(ctx, studentID, semester)
+		require.NoError(t, err)
+
+		looped[semester] = groupBySemesterAndCourse(grades)[semester]
+	}
+
+	loopedQueries := counter.count.Swap(0)
+
+	grouped, err := database.GetStudentGradesGrouped(ctx, studentID)
+	require.NoError(t, err)
+
+	assert.Equal(t, int64(len(semesters)), loopedQueries)
+	assert.Equal(t, int64(1), counter.count.Load(), "the grouped read runs a single query")
+	require.Len(t, grouped, len(semesters))
+
+	for _, semester := range semesters {
+		require.Len(t, grouped[semester], 2, semester)
+
+		for course, grades := range looped[semester] {
+			assert.ElementsMatch(t, grades, grouped[semester][course], "%s %s", semester, course)
+		}
+	}
+}
+
+// TestDatabaseCourseStatsView checks that the course statistics view is created, serves refreshed
+// statistics, and that stale statistics are computed live.
+func TestDatabaseCourseStatsView(t *testing.T) {
+	if os.Getenv("DB_TESTS") != "true" {
+		t.Skip("Skipping database tests. Set DB_TESTS=true to run them.")
+	}
+
+	database, err := setupTestDatabaseWithoutConstraints()
+	require.NoError(t, err, "Failed to initialize test database")
+
+	defer cleanupTestDatabase(database)
+
+	ctx := context.Background()
+	require.NoError(t, database.createSchemaIfNotExists(ctx))
+
+	exists, err := database.db.NewSelect().TableExpr("pg_matviews").
+		Where("matviewname = ?", courseStatsView).Exists(ctx)
+	require.NoError(t, err)
+	require.True(t, exists, "the course stats view must be created")
+
+	studentID, courseID, semester, gradeValue := createTestData()
+
+	defer func() {
+		_, _ = database.db.ExecContext(ctx, "DELETE FROM grades WHERE course_id = ?", courseID)
+		_ = database.RefreshCourseStats(ctx)
+	}()
+
+	testAddGrade(ctx, t, database, buildTestGrade(studentID, courseID, semester, gradeValue))
+
+	stats, err := database.GetCourseStats(ctx, courseID, semester, time.Hour)
+	require.NoError(t, err)
+	assert.True(t, stats.Live, "grades added since the last refresh are computed live")
+	assert.Equal(t, int64(1), stats.Count)
+
+	require.NoError(t, database.RefreshCourseStats(ctx))
+
+	stats, err = database.GetCourseStats(ctx, courseID, semester, time.Hour)
+	require.NoError(t, err)
+	assert.False(t, stats.Live, "refreshed statistics are read from the view")
+	assert.Equal(t, int64(1), stats.Count)
+	require.NotNil(t, stats.Average)
+	assert.InDelta(t, 100, *stats.Average, 1e-9)
+
+	stats, err = database.GetCourseStats(ctx, courseID, semester, 0)
+	require.NoError(t, err)
+	assert.True(t, stats.Live, "statistics older than the threshold are computed live")
+}
+
+// TestDatabaseIDGenerator checks that added grades take their IDs from the configured generator.
+func TestDatabaseIDGenerator(t *testing.T) {
+	if os.Getenv("DB_TESTS") != "true" {
+		t.Skip("Skipping database tests. Set DB_TESTS=true to run them.")
+	}
+
+	database, err := setupTestDatabaseWithoutConstraints()
+	require.NoError(t, err, "Failed to initialize test database")
+
+	defer cleanupTestDatabase(database)
+
+	ctx := context.Background()
+	prefix := uuid.New().String()
+	database.newID = sequentialIDs(prefix)
+
+	studentID, courseID, semester, gradeValue := createTestData()
+
+	defer func() {
+		_, _ = database.db.ExecContext(ctx, "DELETE FROM grades WHERE course_id = ?", courseID)
+	}()
+
+	added, err := database.AddGrade(ctx, buildTestGrade(studentID, courseID, semester, gradeValue))
+	require.NoError(t, err)
+	assert.Equal(t, prefix+"-1", added.GradeID)
+
+	stored, err := database.GetGradeByID(ctx, prefix+"-1")
+	require.NoError(t, err)
+	assert.Equal(t, courseID, stored.CourseID)
+}
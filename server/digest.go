@@ -0,0 +1,162 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	gpb "github.com/BetterGR/grades-microservice/protos"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/klog/v2"
+)
+
+// digestQueue holds the grade changes of the students who asked for a digest until the next
+// flush. Changes are kept in memory, so the pending ones are flushed on shutdown rather than stored.
+type digestQueue struct {
+	mu sync.Mutex
+	// pending maps a student to their changes, one per grade, in the order the grades first changed.
+	pending map[string][]*StudentNotification
+}
+
+// newDigestQueue creates an empty queue.
+func newDigestQueue() *digestQueue {
+	return &digestQueue{pending: make(map[string][]*StudentNotification)}
+}
+
+// add queues a change for the student's next digest. A grade that already has a pending change
+// keeps its place and takes the new value, so a digest reports every grade once.
+func (q *digestQueue) add(change *StudentNotification) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	changes := q.pending[change.StudentID]
+	for i, pending := range changes {
+		if pending.GradeID == change.GradeID {
+			changes[i] = change
+
+			return
+		}
+	}
+
+	q.pending[change.StudentID] = append(changes, change)
+}
+
+// take empties the queue and returns a digest per student, ordered by student ID.
+func (q *digestQueue) take() []*StudentDigest {
+	q.mu.Lock()
+	pending := q.pending
+	q.pending = make(map[string][]*StudentNotification)
+	q.mu.Unlock()
+
+	digests := make([]*StudentDigest, 0, len(pending))
+	for studentID, changes := range pending {
+		digests = append(digests, &StudentDigest{StudentID: studentID, Changes: changes})
+	}
+
+	sort.Slice(digests, func(i, j int) bool {
+		return digests[i].StudentID < digests[j].StudentID
+	})
+
+	return digests
+}
+
+// requeue puts back a digest that could not be sent. Its changes go before those queued since,
+// unless a grade changed again in the meantime.
+func (q *digestQueue) requeue(digest *StudentDigest) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	newer := q.pending[digest.StudentID]
+	changed := make(map[string]struct{}, len(newer))
+
+	for _, change := range newer {
+		changed[change.GradeID] = struct{}{}
+	}
+
+	var changes []*StudentNotification
+
+	for _, change := range digest.Changes {
+		if _, ok := changed[change.GradeID]; !ok {
+			changes = append(changes, change)
+		}
+	}
+
+	q.pending[digest.StudentID] = append(changes, newer...)
+}
+
+// flushDigests sends every student with pending changes their digest and returns the number sent.
+// Digests that fail to send are logged and kept for the next flush.
+func (s *GradesServer) flushDigests(ctx context.Context) int {
+	if s.digests == nil {
+		return 0
+	}
+
+	sent := 0
+
+	for _, digest := range s.digests.take() {
+		if err := s.notifier.NotifyStudentDigest(ctx, digest); err != nil {
+			klog.FromContext(ctx).Error(err, "Failed to send notification digest, kept for the next flush",
+				"student_id", digest.StudentID, "changes", len(digest.Changes))
+			s.digests.requeue(digest)
+
+			continue
+		}
+
+		sent++
+	}
+
+	return sent
+}
+
+// runDigestFlushes sends the pending notification digests every interval until the context is done.
+func (s *GradesServer) runDigestFlushes(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if sent := s.flushDigests(ctx); sent > 0 {
+				klog.V(logLevelDebug).InfoS("Sent notification digests", "digests", sent)
+			}
+		}
+	}
+}
+
+// SetNotificationDigest makes a student get a periodic digest of their grade changes instead of a
+// notification for each, or back. Students may only change their own preference; staff and admins
+// may change anyone's.
+func (s *GradesServer) SetNotificationDigest(ctx context.Context,
+	req *gpb.SetNotificationDigestRequest,
+) (*gpb.SetNotificationDigestResponse, error) {
+	claims, err := s.getClaims(ctx, req.GetToken())
+	if err != nil {
+		return nil, fmt.Errorf("authentication failed: %w",
+			status.Error(codes.Unauthenticated, err.Error()))
+	}
+
+	if !claims.HasRole(roleStaff) && !claims.HasRole(roleAdmin) && callerIdentity(claims) != req.GetStudentID() {
+		return nil, fmt.Errorf("authorization failed: %w",
+			status.Error(codes.PermissionDenied, "students may only change their own notification preference"))
+	}
+
+	logger := klog.FromContext(ctx)
+	logger.V(logLevelDebug).Info("Received request to set notification digest", "student_id", req.GetStudentID(),
+		"digest", req.GetDigest())
+
+	err = s.db.SetNotificationDigest(ctx, req.GetStudentID(), req.GetDigest())
+	if errors.Is(err, ErrStudentIDEmpty) {
+		return nil, fmt.Errorf("failed to set notification digest: %w",
+			status.Error(codes.InvalidArgument, err.Error()))
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to set notification digest: %w", err)
+	}
+
+	return &gpb.SetNotificationDigestResponse{}, nil
+}
@@ -0,0 +1,126 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	gpb "github.com/BetterGR/grades-microservice/protos"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestDigestQueue(t *testing.T) {
+	queue := newDigestQueue()
+
+	queue.add(&StudentNotification{StudentID: "s2", GradeID: "g1", GradeValue: "80"})
+	queue.add(&StudentNotification{StudentID: "s1", GradeID: "g2", GradeValue: "70"})
+	queue.add(&StudentNotification{StudentID: "s1", GradeID: "g3", GradeValue: "60"})
+	queue.add(&StudentNotification{StudentID: "s1", GradeID: "g2", GradeValue: "75"})
+
+	digests := queue.take()
+	require.Len(t, digests, 2)
+	assert.Equal(t, "s1", digests[0].StudentID)
+	require.Len(t, digests[0].Changes, 2, "a grade changed twice is reported once")
+	assert.Equal(t, "g2", digests[0].Changes[0].GradeID, "a grade keeps the place of its first change")
+	assert.Equal(t, "75", digests[0].Changes[0].GradeValue, "with its latest value")
+	assert.Empty(t, queue.take(), "taking empties the queue")
+
+	// A failed digest goes back before newer changes, unless they superseded it.
+	queue.add(&StudentNotification{StudentID: "s1", GradeID: "g3", GradeValue: "65"})
+	queue.add(&StudentNotification{StudentID: "s1", GradeID: "g4", GradeValue: "90"})
+	queue.requeue(digests[0])
+
+	digests = queue.take()
+	require.Len(t, digests, 1)
+
+	var values []string
+	for _, change := range digests[0].Changes {
+		values = append(values, change.GradeID+"="+change.GradeValue)
+	}
+
+	assert.Equal(t, []string{"g2=75", "g3=65", "g4=90"}, values)
+}
+
+func TestUpdateGradeQueuesDigest(t *testing.T) {
+	notifier := &MockNotifier{}
+	db := NewMockDatabase()
+	server := &GradesServer{db: db, notifier: notifier, digests: newDigestQueue()}
+	client := setupClient(t, func(s *GradesServer) {
+		s.db = db
+		s.notifier = notifier
+		s.digests = server.digests
+	})
+
+	grade := createTestGrade()
+	_, err := client.AddSingleGrade(context.Background(), &gpb.AddSingleGradeRequest{Token: "test-token", Grade: grade})
+	require.NoError(t, err)
+
+	other := createTestGrade()
+	other.StudentID = grade.GetStudentID()
+	_, err = client.AddSingleGrade(context.Background(), &gpb.AddSingleGradeRequest{Token: "test-token", Grade: other})
+	require.NoError(t, err)
+
+	_, err = client.SetNotificationDigest(context.Background(), &gpb.SetNotificationDigestRequest{
+		Token: "test-token", StudentID: grade.GetStudentID(), Digest: true,
+	})
+	require.NoError(t, err)
+
+	for _, change := range []struct {
+		grade *gpb.SingleGrade
+		value string
+	}{{grade, "B"}, {other, "C"}, {grade, "A"}} {
+		change.grade.GradeValue = change.value
+		_, err = client.UpdateSingleGrade(context.Background(), &gpb.UpdateSingleGradeRequest{
+			Token: "test-token", Grade: change.grade,
+		})
+		require.NoError(t, err)
+	}
+
+	assert.Empty(t, notifier.Sent(), "digest students are not notified of each change")
+
+	notifier.digestErr = errors.New("mail server down")
+	assert.Zero(t, server.flushDigests(context.Background()))
+
+	notifier.digestErr = nil
+	assert.Equal(t, 1, server.flushDigests(context.Background()), "a failed digest is sent at the next flush")
+
+	digests := notifier.Digests()
+	require.Len(t, digests, 1, "multiple changes produce a single digest")
+	assert.Equal(t, grade.GetStudentID(), digests[0].StudentID)
+	require.Len(t, digests[0].Changes, 2)
+	assert.Equal(t, grade.GetGradeID(), digests[0].Changes[0].GradeID)
+	assert.Equal(t, "A", digests[0].Changes[0].GradeValue)
+	assert.Equal(t, "C", digests[0].Changes[1].GradeValue)
+	assert.Zero(t, server.flushDigests(context.Background()))
+
+	_, err = client.SetNotificationDigest(context.Background(), &gpb.SetNotificationDigestRequest{
+		Token: "test-token", StudentID: grade.GetStudentID(), Digest: false,
+	})
+	require.NoError(t, err)
+
+	grade.GradeValue = "D"
+	_, err = client.UpdateSingleGrade(context.Background(), &gpb.UpdateSingleGradeRequest{
+		Token: "test-token", Grade: grade,
+	})
+	require.NoError(t, err)
+	assert.Len(t, notifier.Sent(), 1, "students back from digests are notified right away")
+}
+
+func TestSetNotificationDigestAuthorization(t *testing.T) {
+	student := setupClient(t, func(s *GradesServer) {
+		verifiedCaller(roleStudent)(s)
+	})
+
+	_, err := student.SetNotificationDigest(context.Background(), &gpb.SetNotificationDigestRequest{
+		Token: studentToken, StudentID: "student-1", Digest: true,
+	})
+	require.NoError(t, err)
+
+	_, err = student.SetNotificationDigest(context.Background(), &gpb.SetNotificationDigestRequest{
+		Token: studentToken, StudentID: "student-2", Digest: true,
+	})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
@@ -0,0 +1,732 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	gpb "github.com/BetterGR/grades-microservice/protos"
+	"google.golang.org/protobuf/proto"
+	"k8s.io/klog/v2"
+)
+
+// maxDivergentIDs bounds the grade IDs a single divergence log entry names.
+const maxDivergentIDs = 10
+
+type keepGradeIDsKey struct{}
+
+// withKeptGradeIDs makes the grades added with the context keep the grade IDs they carry, so a
+// secondary store holds every grade under the ID the primary gave it.
+func withKeptGradeIDs(ctx context.Context) context.Context {
+	return context.WithValue(ctx, keepGradeIDsKey{}, true)
+}
+
+// keptGradeIDsFromContext reports whether the context asks added grades to keep their grade IDs.
+func keptGradeIDsFromContext(ctx context.Context) bool {
+	keep, _ := ctx.Value(keepGradeIDsKey{}).(bool)
+
+	return keep
+}
+
+// DualWriteDB verifies a migration to a new database backend: it writes to both the primary and
+// the secondary, compares what they return, and logs and counts every divergence. Callers always
+// get the primary's result, and the secondary failing never fails a call.
+//
+// Writes reach the secondary only once the primary succeeded, and reads are compared only for
+// grade data. Failed events, audit reads, ETags, statistics and streams are served by the primary
+// alone, since they are either local to a store or too large to buffer for comparison.
+type DualWriteDB struct {
+	primary   DBInterface
+	secondary DBInterface
+	// divergences and secondaryFailures map a method to its *atomic.Uint64 count.
+	divergences       sync.Map
+	secondaryFailures sync.Map
+}
+
+// Verify that DualWriteDB implements DBInterface at compile time.
+var _ DBInterface = (*DualWriteDB)(nil)
+
+// NewDualWriteDB serves every call from primary and mirrors it to secondary.
+func NewDualWriteDB(primary, secondary DBInterface) *DualWriteDB {
+	return &DualWriteDB{primary: primary, secondary: secondary}
+}
+
+// Divergences returns the number of calls to method whose secondary result differed from the primary's.
+func (d *DualWriteDB) Divergences(method string) uint64 {
+	return loadCount(&d.divergences, method)
+}
+
+// SecondaryFailures returns the number of calls to method that failed on the secondary alone.
+func (d *DualWriteDB) SecondaryFailures(method string) uint64 {
+	return loadCount(&d.secondaryFailures, method)
+}
+
+// loadCount returns the count of method in counts.
+func loadCount(counts *sync.Map, method string) uint64 {
+	counter, ok := counts.Load(method)
+	if !ok {
+		return 0
+	}
+
+	return counter.(*atomic.Uint64).Load()
+}
+
+// addCount increments the count of method in counts.
+func addCount(counts *sync.Map, method string) {
+	counter, ok := counts.Load(method)
+	if !ok {
+		counter, _ = counts.LoadOrStore(method, new(atomic.Uint64))
+	}
+
+	counter.(*atomic.Uint64).Add(1)
+}
+
+// secondaryFailed records a call to method that failed on the secondary alone.
+func (d *DualWriteDB) secondaryFailed(ctx context.Context, method string, err error) {
+	addCount(&d.secondaryFailures, method)
+	klog.FromContext(ctx).Error(err, "Dual write secondary failed", "method", method)
+}
+
+// compare records a divergence when the results of method differ once normalized.
+func (d *DualWriteDB) compare(ctx context.Context, method string, primary, secondary interface{}) {
+	primary, secondary = normalizeResult(primary), normalizeResult(secondary)
+	if reflect.DeepEqual(primary, secondary) {
+		return
+	}
+
+	d.diverged(ctx, method, describeDivergence(primary, secondary)...)
+}
+
+// diverged records a divergence of method, logged with the key-value pairs describing it.
+func (d *DualWriteDB) diverged(ctx context.Context, method string, keysAndValues ...interface{}) {
+	addCount(&d.divergences, method)
+	klog.FromContext(ctx).Info("Dual write divergence", append([]interface{}{"method", method}, keysAndValues...)...)
+}
+
+// mirrored records the outcome of mirroring a call to method to the secondary, which returned
+// mirrored or failed with err.
+func (d *DualWriteDB) mirrored(ctx context.Context, method string, result, mirrored interface{}, err error) {
+	if err != nil {
+		d.secondaryFailed(ctx, method, err)
+
+		return
+	}
+
+	d.compare(ctx, method, result, mirrored)
+}
+
+// mirrorWrite writes with the primary and, once that succeeded, with the secondary, comparing the
+// results. It returns the primary's result.
+func mirrorWrite[T any](ctx context.Context, d *DualWriteDB, method string,
+	write func(ctx context.Context, db DBInterface) (T, error),
+) (T, error) {
+	result, err := write(ctx, d.primary)
+	if err != nil {
+		return result, err
+	}
+
+	mirrored, err := write(withKeptGradeIDs(ctx), d.secondary)
+	d.mirrored(ctx, method, result, mirrored, err)
+
+	return result, nil
+}
+
+// mirrorExec is mirrorWrite for writes returning only an error.
+func (d *DualWriteDB) mirrorExec(ctx context.Context, method string,
+	write func(ctx context.Context, db DBInterface) error,
+) error {
+	_, err := mirrorWrite(ctx, d, method, func(ctx context.Context, db DBInterface) (struct{}, error) {
+		return struct{}{}, write(ctx, db)
+	})
+
+	return err
+}
+
+// compareRead reads from both databases and compares the results. It returns the primary's result.
+// A read failing on one side alone is a divergence too when the other side lacks a grade, or when
+// only the primary failed.
+func compareRead[T any](ctx context.Context, d *DualWriteDB, method string,
+	read func(db DBInterface) (T, error),
+) (T, error) {
+	result, err := read(d.primary)
+	mirrored, mirrorErr := read(d.secondary)
+
+	switch {
+	case err == nil && mirrorErr == nil:
+		d.compare(ctx, method, result, mirrored)
+	case err == nil && (errors.Is(mirrorErr, ErrGradeNotFound) || errors.Is(mirrorErr, ErrGradeVersionNotFound)):
+		d.diverged(ctx, method, "secondary_error", mirrorErr.Error())
+	case err == nil:
+		d.secondaryFailed(ctx, method, mirrorErr)
+	case mirrorErr == nil:
+		d.diverged(ctx, method, "primary_error", err.Error())
+	}
+
+	return result, err
+}
+
+// normalizeResult strips what legitimately differs between two stores holding the same data from a
+// result: the timestamps a store assigns, the precision it keeps times at, and the order of rows
+// returned without an order.
+func normalizeResult(result interface{}) interface{} {
+	switch value := result.(type) {
+	case *Grade:
+		return normalizeGrade(value)
+	case []*Grade:
+		return normalizeGrades(value)
+	case map[string][]*Grade:
+		normalized := make(map[string][]*Grade, len(value))
+		for key, grades := range value {
+			normalized[key] = normalizeGrades(grades)
+		}
+
+		return normalized
+	case map[string]map[string][]*Grade:
+		normalized := make(map[string]map[string][]*Grade, len(value))
+		for key, groups := range value {
+			normalized[key] = normalizeResult(groups).(map[string][]*Grade)
+		}
+
+		return normalized
+	case []string:
+		if len(value) == 0 {
+			return []string(nil)
+		}
+
+		normalized := append([]string(nil), value...)
+		sort.Strings(normalized)
+
+		return normalized
+	case []*CourseRelease:
+		normalized := make([]CourseRelease, 0, len(value))
+		for _, release := range value {
+			normalized = append(normalized, CourseRelease{
+				CourseID: release.CourseID, Semester: release.Semester, ReleaseAt: normalizeTime(release.ReleaseAt),
+			})
+		}
+
+		sort.Slice(normalized, func(i, j int) bool {
+			if normalized[i].CourseID != normalized[j].CourseID {
+				return normalized[i].CourseID < normalized[j].CourseID
+			}
+
+			return normalized[i].Semester < normalized[j].Semester
+		})
+
+		return normalized
+	default:
+		return result
+	}
+}
+
+// normalizeGrade returns a copy of grade without the timestamps a store assigns.
+func normalizeGrade(grade *Grade) *Grade {
+	if grade == nil {
+		return nil
+	}
+
+	normalized := *grade
+	normalized.GradedAt, normalized.UpdatedAt, normalized.DeletedAt = time.Time{}, time.Time{}, time.Time{}
+	normalized.SubmittedAt, normalized.DueAt = normalizeTime(grade.SubmittedAt), normalizeTime(grade.DueAt)
+
+	return &normalized
+}
+
+// normalizeGrades returns normalized copies of grades ordered by grade ID and version; nil when empty.
+func normalizeGrades(grades []*Grade) []*Grade {
+	if len(grades) == 0 {
+		return nil
+	}
+
+	normalized := make([]*Grade, 0, len(grades))
+	for _, grade := range grades {
+		normalized = append(normalized, normalizeGrade(grade))
+	}
+
+	sort.Slice(normalized, func(i, j int) bool {
+		if normalized[i].GradeID != normalized[j].GradeID {
+			return normalized[i].GradeID < normalized[j].GradeID
+		}
+
+		return normalized[i].Version < normalized[j].Version
+	})
+
+	return normalized
+}
+
+// normalizeTime returns t in UTC at the microsecond precision of PostgreSQL.
+func normalizeTime(t time.Time) time.Time {
+	if t.IsZero() {
+		return time.Time{}
+	}
+
+	return t.UTC().Truncate(time.Microsecond)
+}
+
+// describeDivergence returns the log key-value pairs telling what differs between two normalized
+// results. Grades are named by ID only, so no grade value or comment reaches the log.
+func describeDivergence(primary, secondary interface{}) []interface{} {
+	switch value := primary.(type) {
+	case *Grade:
+		return []interface{}{"grade_ids", divergentGradeIDs([]*Grade{value}, []*Grade{secondary.(*Grade)})}
+	case []*Grade:
+		return []interface{}{"grade_ids", divergentGradeIDs(value, secondary.([]*Grade))}
+	case map[string][]*Grade:
+		other := secondary.(map[string][]*Grade)
+		keys := make(map[string]struct{}, len(value)+len(other))
+
+		for key := range value {
+			keys[key] = struct{}{}
+		}
+
+		for key := range other {
+			keys[key] = struct{}{}
+		}
+
+		var ids []string
+		for key := range keys {
+			ids = append(ids, divergentGradeIDs(value[key], other[key])...)
+		}
+
+		sort.Strings(ids)
+
+		return []interface{}{"grade_ids", ids[:min(len(ids), maxDivergentIDs)]}
+	case int64, bool, AttemptPolicy, []string, []CourseRelease:
+		return []interface{}{"primary", primary, "secondary", secondary}
+	default:
+		// Grade values, grouped grades and summaries are left out of the log.
+		return nil
+	}
+}
+
+// divergentGradeIDs returns the IDs of the grades missing from either side or differing between
+// them, sorted and capped at maxDivergentIDs.
+func divergentGradeIDs(primary, secondary []*Grade) []string {
+	versions := func(grades []*Grade) map[string][]*Grade {
+		byID := make(map[string][]*Grade, len(grades))
+		for _, grade := range grades {
+			if grade != nil {
+				byID[grade.GradeID] = append(byID[grade.GradeID], grade)
+			}
+		}
+
+		return byID
+	}
+
+	primaryByID, secondaryByID := versions(primary), versions(secondary)
+
+	var ids []string
+
+	for id, grades := range primaryByID {
+		if !reflect.DeepEqual(grades, secondaryByID[id]) {
+			ids = append(ids, id)
+		}
+	}
+
+	for id := range secondaryByID {
+		if _, ok := primaryByID[id]; !ok {
+			ids = append(ids, id)
+		}
+	}
+
+	sort.Strings(ids)
+
+	return ids[:min(len(ids), maxDivergentIDs)]
+}
+
+// withGradeID returns a copy of grade carrying gradeID.
+func withGradeID(grade *gpb.SingleGrade, gradeID string) *gpb.SingleGrade {
+	mirrored := proto.Clone(grade).(*gpb.SingleGrade)
+	mirrored.GradeID = gradeID
+
+	return mirrored
+}
+
+// AddGrade adds the grade to the secondary under the ID the primary gave it.
+func (d *DualWriteDB) AddGrade(ctx context.Context, grade *gpb.SingleGrade) (*Grade, error) {
+	added, err := d.primary.AddGrade(ctx, grade)
+	if err != nil {
+		return nil, err
+	}
+
+	mirrored, err := d.secondary.AddGrade(withKeptGradeIDs(ctx), withGradeID(grade, added.GradeID))
+	d.mirrored(ctx, "AddGrade", added, mirrored, err)
+
+	return added, nil
+}
+
+// AddGrades adds the grades to the secondary under the IDs the primary gave them.
+func (d *DualWriteDB) AddGrades(ctx context.Context, grades []*gpb.SingleGrade) ([]*Grade, error) {
+	added, err := d.primary.AddGrades(ctx, grades)
+	if err != nil {
+		return nil, err
+	}
+
+	withIDs := make([]*gpb.SingleGrade, 0, len(grades))
+	for i, grade := range grades {
+		withIDs = append(withIDs, withGradeID(grade, added[i].GradeID))
+	}
+
+	mirrored, err := d.secondary.AddGrades(withKeptGradeIDs(ctx), withIDs)
+	d.mirrored(ctx, "AddGrades", added, mirrored, err)
+
+	return added, nil
+}
+
+// GetCourseGrades compares the secondary's grades with the primary's.
+func (d *DualWriteDB) GetCourseGrades(ctx context.Context, courseID, semester string) ([]*Grade, error) {
+	return compareRead(ctx, d, "GetCourseGrades", func(db DBInterface) ([]*Grade, error) {
+		return db.GetCourseGrades(ctx, courseID, semester)
+	})
+}
+
+// StreamCourseGrades streams from the primary only.
+func (d *DualWriteDB) StreamCourseGrades(ctx context.Context,
+	courseID, semester string, fn func(*Grade) error,
+) error {
+	return d.primary.StreamCourseGrades(ctx, courseID, semester, fn)
+}
+
+// StreamSemesterGrades streams from the primary only.
+func (d *DualWriteDB) StreamSemesterGrades(ctx context.Context, semester string, fn func(*Grade) error) error {
+	return d.primary.StreamSemesterGrades(ctx, semester, fn)
+}
+
+// GetStudentCourseGrades compares the secondary's grades with the primary's.
+func (d *DualWriteDB) GetStudentCourseGrades(ctx context.Context,
+	courseID, semester, studentID string,
+) ([]*Grade, error) {
+	return compareRead(ctx, d, "GetStudentCourseGrades", func(db DBInterface) ([]*Grade, error) {
+		return db.GetStudentCourseGrades(ctx, courseID, semester, studentID)
+	})
+}
+
+// UpdateGrade updates the grade on both databases.
+func (d *DualWriteDB) UpdateGrade(ctx context.Context, grade *gpb.SingleGrade) (*Grade, error) {
+	return mirrorWrite(ctx, d, "UpdateGrade", func(ctx context.Context, db DBInterface) (*Grade, error) {
+		return db.UpdateGrade(ctx, grade)
+	})
+}
+
+// UndoGradeChange undoes the change on both databases.
+func (d *DualWriteDB) UndoGradeChange(ctx context.Context, gradeID string, version int64) (*Grade, error) {
+	return mirrorWrite(ctx, d, "UndoGradeChange", func(ctx context.Context, db DBInterface) (*Grade, error) {
+		return db.UndoGradeChange(ctx, gradeID, version)
+	})
+}
+
+// TransitionAppeal moves the appeal on both databases.
+func (d *DualWriteDB) TransitionAppeal(ctx context.Context, gradeID, from, to, value string) (*Grade, error) {
+	return mirrorWrite(ctx, d, "TransitionAppeal", func(ctx context.Context, db DBInterface) (*Grade, error) {
+		return db.TransitionAppeal(ctx, gradeID, from, to, value)
+	})
+}
+
+// RemoveGrade removes the grade from both databases.
+func (d *DualWriteDB) RemoveGrade(ctx context.Context, gradeID string) error {
+	return d.mirrorExec(ctx, "RemoveGrade", func(ctx context.Context, db DBInterface) error {
+		return db.RemoveGrade(ctx, gradeID)
+	})
+}
+
+// PurgeTombstones purges the tombstones of both databases.
+func (d *DualWriteDB) PurgeTombstones(ctx context.Context, cutoff time.Time) (int64, error) {
+	return mirrorWrite(ctx, d, "PurgeTombstones", func(ctx context.Context, db DBInterface) (int64, error) {
+		return db.PurgeTombstones(ctx, cutoff)
+	})
+}
+
+// SoftDeleteStudentGrades soft-deletes the student's grades on both databases.
+func (d *DualWriteDB) SoftDeleteStudentGrades(ctx context.Context, studentID, actor string) ([]string, error) {
+	return mirrorWrite(ctx, d, "SoftDeleteStudentGrades", func(ctx context.Context, db DBInterface) ([]string, error) {
+		return db.SoftDeleteStudentGrades(ctx, studentID, actor)
+	})
+}
+
+// GetStudentSemesterGrades compares the secondary's grades with the primary's.
+func (d *DualWriteDB) GetStudentSemesterGrades(ctx context.Context, studentID, semester string) ([]*Grade, error) {
+	return compareRead(ctx, d, "GetStudentSemesterGrades", func(db DBInterface) ([]*Grade, error) {
+		return db.GetStudentSemesterGrades(ctx, studentID, semester)
+	})
+}
+
+// GetStudentGrades compares the secondary's grades with the primary's.
+func (d *DualWriteDB) GetStudentGrades(ctx context.Context, studentID string) ([]*Grade, error) {
+	return compareRead(ctx, d, "GetStudentGrades", func(db DBInterface) ([]*Grade, error) {
+		return db.GetStudentGrades(ctx, studentID)
+	})
+}
+
+// GetStudentGradesGrouped compares the secondary's grades with the primary's.
+func (d *DualWriteDB) GetStudentGradesGrouped(ctx context.Context,
+	studentID string,
+) (map[string]map[string][]*Grade, error) {
+	return compareRead(ctx, d, "GetStudentGradesGrouped", func(db DBInterface) (map[string]map[string][]*Grade, error) {
+		return db.GetStudentGradesGrouped(ctx, studentID)
+	})
+}
+
+// ArchiveSemester archives the semester on both databases.
+func (d *DualWriteDB) ArchiveSemester(ctx context.Context, semester string) (int64, error) {
+	return mirrorWrite(ctx, d, "ArchiveSemester", func(ctx context.Context, db DBInterface) (int64, error) {
+		return db.ArchiveSemester(ctx, semester)
+	})
+}
+
+// GetArchivedCourseGrades compares the secondary's grades with the primary's.
+func (d *DualWriteDB) GetArchivedCourseGrades(ctx context.Context, courseID, semester string) ([]*Grade, error) {
+	return compareRead(ctx, d, "GetArchivedCourseGrades", func(db DBInterface) ([]*Grade, error) {
+		return db.GetArchivedCourseGrades(ctx, courseID, semester)
+	})
+}
+
+// GetGradeByID compares the secondary's grade with the primary's.
+func (d *DualWriteDB) GetGradeByID(ctx context.Context, gradeID string) (*Grade, error) {
+	return compareRead(ctx, d, "GetGradeByID", func(db DBInterface) (*Grade, error) {
+		return db.GetGradeByID(ctx, gradeID)
+	})
+}
+
+// GetGradeValue compares the secondary's value with the primary's.
+func (d *DualWriteDB) GetGradeValue(ctx context.Context, key GradeKey) (string, error) {
+	return compareRead(ctx, d, "GetGradeValue", func(db DBInterface) (string, error) {
+		return db.GetGradeValue(ctx, key)
+	})
+}
+
+// GetGradeVersion compares the secondary's version with the primary's.
+func (d *DualWriteDB) GetGradeVersion(ctx context.Context, gradeID string, version int64) (*Grade, error) {
+	return compareRead(ctx, d, "GetGradeVersion", func(db DBInterface) (*Grade, error) {
+		return db.GetGradeVersion(ctx, gradeID, version)
+	})
+}
+
+// SearchGradeComments compares the secondary's grades with the primary's.
+func (d *DualWriteDB) SearchGradeComments(ctx context.Context, courseID, semester, term string) ([]*Grade, error) {
+	return compareRead(ctx, d, "SearchGradeComments", func(db DBInterface) ([]*Grade, error) {
+		return db.SearchGradeComments(ctx, courseID, semester, term)
+	})
+}
+
+// GetCourseGradesETag reads from the primary only, since ETags derive from store-assigned timestamps.
+func (d *DualWriteDB) GetCourseGradesETag(ctx context.Context, courseID, semester string) (string, error) {
+	return d.primary.GetCourseGradesETag(ctx, courseID, semester)
+}
+
+// SetGradeFlag flags the grade on both databases.
+func (d *DualWriteDB) SetGradeFlag(ctx context.Context, gradeID string, flagged bool) (*Grade, error) {
+	return mirrorWrite(ctx, d, "SetGradeFlag", func(ctx context.Context, db DBInterface) (*Grade, error) {
+		return db.SetGradeFlag(ctx, gradeID, flagged)
+	})
+}
+
+// GetFlaggedGrades compares the secondary's grades with the primary's.
+func (d *DualWriteDB) GetFlaggedGrades(ctx context.Context, courseID, semester string) ([]*Grade, error) {
+	return compareRead(ctx, d, "GetFlaggedGrades", func(db DBInterface) ([]*Grade, error) {
+		return db.GetFlaggedGrades(ctx, courseID, semester)
+	})
+}
+
+// GetMultiCourseGrades compares the secondary's grades with the primary's.
+func (d *DualWriteDB) GetMultiCourseGrades(ctx context.Context,
+	courseIDs []string, semester string,
+) (map[string][]*Grade, error) {
+	return compareRead(ctx, d, "GetMultiCourseGrades", func(db DBInterface) (map[string][]*Grade, error) {
+		return db.GetMultiCourseGrades(ctx, courseIDs, semester)
+	})
+}
+
+// GetCourseGradesBySemester compares the secondary's grades with the primary's.
+func (d *DualWriteDB) GetCourseGradesBySemester(ctx context.Context,
+	courseID string, semesters []string,
+) (map[string][]*Grade, error) {
+	return compareRead(ctx, d, "GetCourseGradesBySemester", func(db DBInterface) (map[string][]*Grade, error) {
+		return db.GetCourseGradesBySemester(ctx, courseID, semesters)
+	})
+}
+
+// GetStudentGradesBySemester compares the secondary's grades with the primary's.
+func (d *DualWriteDB) GetStudentGradesBySemester(ctx context.Context,
+	studentID string, semesters []string,
+) (map[string][]*Grade, error) {
+	return compareRead(ctx, d, "GetStudentGradesBySemester", func(db DBInterface) (map[string][]*Grade, error) {
+		return db.GetStudentGradesBySemester(ctx, studentID, semesters)
+	})
+}
+
+// SearchStudentGrades compares the secondary's grades with the primary's.
+func (d *DualWriteDB) SearchStudentGrades(ctx context.Context, prefix string, limit int) ([]*Grade, error) {
+	return compareRead(ctx, d, "SearchStudentGrades", func(db DBInterface) ([]*Grade, error) {
+		return db.SearchStudentGrades(ctx, prefix, limit)
+	})
+}
+
+// RecordFailedEvent records the event on the primary only; failed events are retried from it alone.
+func (d *DualWriteDB) RecordFailedEvent(ctx context.Context, event *FailedEvent) error {
+	return d.primary.RecordFailedEvent(ctx, event)
+}
+
+// GetFailedEvents reads from the primary only.
+func (d *DualWriteDB) GetFailedEvents(ctx context.Context, limit int) ([]*FailedEvent, error) {
+	return d.primary.GetFailedEvents(ctx, limit)
+}
+
+// RecordFailedEventAttempt records the attempt on the primary only.
+func (d *DualWriteDB) RecordFailedEventAttempt(ctx context.Context, eventID int64, lastError string) error {
+	return d.primary.RecordFailedEventAttempt(ctx, eventID, lastError)
+}
+
+// RemoveFailedEvent removes the event from the primary only.
+func (d *DualWriteDB) RemoveFailedEvent(ctx context.Context, eventID int64) error {
+	return d.primary.RemoveFailedEvent(ctx, eventID)
+}
+
+// GetGradeVersions compares the secondary's versions with the primary's.
+func (d *DualWriteDB) GetGradeVersions(ctx context.Context, gradeID string) ([]*Grade, error) {
+	return compareRead(ctx, d, "GetGradeVersions", func(db DBInterface) ([]*Grade, error) {
+		return db.GetGradeVersions(ctx, gradeID)
+	})
+}
+
+// GetCourseGradeVersions compares the secondary's versions with the primary's.
+func (d *DualWriteDB) GetCourseGradeVersions(ctx context.Context,
+	courseID, semester string,
+) (map[string][]*Grade, error) {
+	return compareRead(ctx, d, "GetCourseGradeVersions", func(db DBInterface) (map[string][]*Grade, error) {
+		return db.GetCourseGradeVersions(ctx, courseID, semester)
+	})
+}
+
+// RecordAudit records the entry on both databases.
+func (d *DualWriteDB) RecordAudit(ctx context.Context, entry *AuditEntry) error {
+	return d.mirrorExec(ctx, "RecordAudit", func(ctx context.Context, db DBInterface) error {
+		// Each database assigns its own audit ID to the entry.
+		mirrored := *entry
+
+		return db.RecordAudit(ctx, &mirrored)
+	})
+}
+
+// GetGradeAudit reads from the primary only, since audit entries carry store-assigned IDs and times.
+func (d *DualWriteDB) GetGradeAudit(ctx context.Context, gradeID string) ([]*AuditEntry, error) {
+	return d.primary.GetGradeAudit(ctx, gradeID)
+}
+
+// GetSemesterGradeTypeSummary compares the secondary's summary with the primary's.
+func (d *DualWriteDB) GetSemesterGradeTypeSummary(ctx context.Context, semester string) ([]*GradeTypeSummary, error) {
+	return compareRead(ctx, d, "GetSemesterGradeTypeSummary", func(db DBInterface) ([]*GradeTypeSummary, error) {
+		return db.GetSemesterGradeTypeSummary(ctx, semester)
+	})
+}
+
+// SetNotificationOptOut sets the opt-out on both databases.
+func (d *DualWriteDB) SetNotificationOptOut(ctx context.Context, studentID string, optOut bool) error {
+	return d.mirrorExec(ctx, "SetNotificationOptOut", func(ctx context.Context, db DBInterface) error {
+		return db.SetNotificationOptOut(ctx, studentID, optOut)
+	})
+}
+
+// IsNotificationOptedOut compares the secondary's opt-out with the primary's.
+func (d *DualWriteDB) IsNotificationOptedOut(ctx context.Context, studentID string) (bool, error) {
+	return compareRead(ctx, d, "IsNotificationOptedOut", func(db DBInterface) (bool, error) {
+		return db.IsNotificationOptedOut(ctx, studentID)
+	})
+}
+
+// SetNotificationDigest sets the digest preference on both databases.
+func (d *DualWriteDB) SetNotificationDigest(ctx context.Context, studentID string, digest bool) error {
+	return d.mirrorExec(ctx, "SetNotificationDigest", func(ctx context.Context, db DBInterface) error {
+		return db.SetNotificationDigest(ctx, studentID, digest)
+	})
+}
+
+// IsNotificationDigestEnabled compares the secondary's digest preference with the primary's.
+func (d *DualWriteDB) IsNotificationDigestEnabled(ctx context.Context, studentID string) (bool, error) {
+	return compareRead(ctx, d, "IsNotificationDigestEnabled", func(db DBInterface) (bool, error) {
+		return db.IsNotificationDigestEnabled(ctx, studentID)
+	})
+}
+
+// AddCourseGrader adds the grader on both databases.
+func (d *DualWriteDB) AddCourseGrader(ctx context.Context, courseID, graderID string) error {
+	return d.mirrorExec(ctx, "AddCourseGrader", func(ctx context.Context, db DBInterface) error {
+		return db.AddCourseGrader(ctx, courseID, graderID)
+	})
+}
+
+// RemoveCourseGrader removes the grader from both databases.
+func (d *DualWriteDB) RemoveCourseGrader(ctx context.Context, courseID, graderID string) error {
+	return d.mirrorExec(ctx, "RemoveCourseGrader", func(ctx context.Context, db DBInterface) error {
+		return db.RemoveCourseGrader(ctx, courseID, graderID)
+	})
+}
+
+// GetCourseGraders compares the secondary's graders with the primary's.
+func (d *DualWriteDB) GetCourseGraders(ctx context.Context, courseID string) ([]string, error) {
+	return compareRead(ctx, d, "GetCourseGraders", func(db DBInterface) ([]string, error) {
+		return db.GetCourseGraders(ctx, courseID)
+	})
+}
+
+// SetCourseRelease sets the release on both databases.
+func (d *DualWriteDB) SetCourseRelease(ctx context.Context, courseID, semester string, releaseAt time.Time) error {
+	return d.mirrorExec(ctx, "SetCourseRelease", func(ctx context.Context, db DBInterface) error {
+		return db.SetCourseRelease(ctx, courseID, semester, releaseAt)
+	})
+}
+
+// GetPendingReleases compares the secondary's releases with the primary's.
+func (d *DualWriteDB) GetPendingReleases(ctx context.Context, now time.Time) ([]*CourseRelease, error) {
+	return compareRead(ctx, d, "GetPendingReleases", func(db DBInterface) ([]*CourseRelease, error) {
+		return db.GetPendingReleases(ctx, now)
+	})
+}
+
+// SetGradeTypeScheme sets the scheme on both databases.
+func (d *DualWriteDB) SetGradeTypeScheme(ctx context.Context, courseID, gradeType string,
+	scheme GradeScheme,
+) error {
+	return d.mirrorExec(ctx, "SetGradeTypeScheme", func(ctx context.Context, db DBInterface) error {
+		return db.SetGradeTypeScheme(ctx, courseID, gradeType, scheme)
+	})
+}
+
+// GetGradeTypeSchemes compares the secondary's schemes with the primary's.
+func (d *DualWriteDB) GetGradeTypeSchemes(ctx context.Context, courseID string) (map[string]GradeScheme, error) {
+	return compareRead(ctx, d, "GetGradeTypeSchemes", func(db DBInterface) (map[string]GradeScheme, error) {
+		return db.GetGradeTypeSchemes(ctx, courseID)
+	})
+}
+
+// SetAttemptPolicy sets the policy on both databases.
+func (d *DualWriteDB) SetAttemptPolicy(ctx context.Context, courseID string, policy AttemptPolicy) error {
+	return d.mirrorExec(ctx, "SetAttemptPolicy", func(ctx context.Context, db DBInterface) error {
+		return db.SetAttemptPolicy(ctx, courseID, policy)
+	})
+}
+
+// GetAttemptPolicy compares the secondary's policy with the primary's.
+func (d *DualWriteDB) GetAttemptPolicy(ctx context.Context, courseID string) (AttemptPolicy, error) {
+	return compareRead(ctx, d, "GetAttemptPolicy", func(db DBInterface) (AttemptPolicy, error) {
+		return db.GetAttemptPolicy(ctx, courseID)
+	})
+}
+
+// RefreshCourseStats refreshes the statistics of both databases.
+func (d *DualWriteDB) RefreshCourseStats(ctx context.Context) error {
+	return d.mirrorExec(ctx, "RefreshCourseStats", func(ctx context.Context, db DBInterface) error {
+		return db.RefreshCourseStats(ctx)
+	})
+}
+
+// GetCourseStats reads from the primary only, since each database refreshes its statistics at its
+// own time.
+func (d *DualWriteDB) GetCourseStats(ctx context.Context,
+	courseID, semester string, maxStaleness time.Duration,
+) (*CourseStats, error) {
+	return d.primary.GetCourseStats(ctx, courseID, semester, maxStaleness)
+}
@@ -0,0 +1,120 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	gpb "github.com/BetterGR/grades-microservice/protos"
+	"github.com/go-logr/logr/funcr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/klog/v2"
+)
+
+// failingAddDatabase fails every AddGrade.
+type failingAddDatabase struct {
+	*MockDatabase
+}
+
+func (f failingAddDatabase) AddGrade(context.Context, *gpb.SingleGrade) (*Grade, error) {
+	return nil, errors.New("secondary unavailable")
+}
+
+func TestDualWriteDBDetectsDivergence(t *testing.T) {
+	var lines []string
+
+	logger := funcr.New(func(prefix, args string) {
+		lines = append(lines, args)
+	}, funcr.Options{})
+	ctx := klog.NewContext(context.Background(), logger)
+
+	primary := NewMockDatabase(WithIDGenerator(sequentialIDs("grade")))
+	secondary := NewMockDatabase(WithIDGenerator(sequentialIDs("other")))
+	dual := NewDualWriteDB(primary, secondary)
+
+	grade := createTestGrade()
+	grade.GradeID = ""
+
+	added, err := dual.AddGrade(ctx, grade)
+	require.NoError(t, err)
+	assert.Equal(t, "grade-1", added.GradeID)
+
+	mirrored, err := secondary.GetGradeByID(ctx, added.GradeID)
+	require.NoError(t, err, "the secondary keeps the primary's grade ID")
+	assert.Equal(t, added.GradeValue, mirrored.GradeValue)
+
+	// Stores stamping their own times do not diverge.
+	mirrored.GradedAt = mirrored.GradedAt.Add(time.Second)
+
+	grades, err := dual.GetCourseGrades(ctx, added.CourseID, added.Semester)
+	require.NoError(t, err)
+	assert.Len(t, grades, 1)
+	assert.Zero(t, dual.Divergences("GetCourseGrades"))
+	assert.Empty(t, lines)
+
+	// The secondary drifts from the primary.
+	_, err = secondary.UpdateGrade(ctx, &gpb.SingleGrade{GradeID: added.GradeID, GradeValue: "42"})
+	require.NoError(t, err)
+
+	grades, err = dual.GetCourseGrades(ctx, added.CourseID, added.Semester)
+	require.NoError(t, err)
+	require.Len(t, grades, 1)
+	assert.Equal(t, added.GradeValue, grades[0].GradeValue, "the primary's result is returned")
+	assert.Equal(t, uint64(1), dual.Divergences("GetCourseGrades"))
+	require.Len(t, lines, 1)
+	assert.Contains(t, lines[0], "Dual write divergence")
+	assert.Contains(t, lines[0], `"method"="GetCourseGrades"`)
+	assert.Contains(t, lines[0], added.GradeID)
+	assert.NotContains(t, lines[0], "42", "grade values stay out of the log")
+
+	// A grade the secondary lacks diverges too.
+	grade.GradeID = ""
+	_, err = primary.AddGrade(ctx, grade)
+	require.NoError(t, err)
+
+	_, err = dual.GetGradeByID(ctx, "grade-2")
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), dual.Divergences("GetGradeByID"))
+}
+
+func TestDualWriteDBToleratesSecondaryFailures(t *testing.T) {
+	primary := NewMockDatabase()
+	dual := NewDualWriteDB(primary, failingAddDatabase{NewMockDatabase()})
+
+	added, err := dual.AddGrade(context.Background(), createTestGrade())
+	require.NoError(t, err, "a failing secondary never fails the call")
+	assert.Equal(t, uint64(1), dual.SecondaryFailures("AddGrade"))
+
+	_, err = primary.GetGradeByID(context.Background(), added.GradeID)
+	require.NoError(t, err)
+
+	_, err = dual.AddGrade(context.Background(), nil)
+	require.ErrorIs(t, err, ErrGradeNil, "the primary's errors are returned")
+	assert.Equal(t, uint64(1), dual.SecondaryFailures("AddGrade"), "a failed write is not mirrored")
+}
+
+func TestNormalizeResult(t *testing.T) {
+	graded := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	submitted := time.Date(2024, 1, 2, 3, 4, 5, 123456789, time.FixedZone("IST", 2*60*60))
+
+	primary := []*Grade{
+		{GradeID: "b", GradeValue: "80", GradedAt: graded, SubmittedAt: submitted},
+		{GradeID: "a", GradeValue: "90"},
+	}
+	secondary := []*Grade{
+		{GradeID: "a", GradeValue: "90", UpdatedAt: graded},
+		{GradeID: "b", GradeValue: "80", SubmittedAt: submitted.UTC().Truncate(time.Microsecond)},
+	}
+
+	assert.Equal(t, normalizeResult(primary), normalizeResult(secondary))
+	assert.Equal(t, normalizeResult([]string{}), normalizeResult([]string(nil)))
+	assert.Equal(t, normalizeResult([]string{"g2", "g1"}), normalizeResult([]string{"g1", "g2"}))
+
+	secondary[1].GradeValue = "85"
+	assert.NotEqual(t, normalizeResult(primary), normalizeResult(secondary))
+	assert.Equal(t, []string{"b"}, divergentGradeIDs(normalizeGrades(primary), normalizeGrades(secondary)))
+	assert.True(t, strings.HasPrefix(primary[0].SubmittedAt.Location().String(), "IST"), "inputs are not modified")
+}
@@ -0,0 +1,43 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+)
+
+// DuplicatePolicy decides what adding a grade does when the student already has a grade for the
+// same item of the course and semester. The zero value keeps both grades.
+type DuplicatePolicy string
+
+// Supported duplicate policies, selected with ON_DUPLICATE.
+const (
+	// duplicateAllow adds the new grade next to the existing one.
+	duplicateAllow DuplicatePolicy = "allow"
+	// duplicateUpdate turns the add into an update of the existing grade.
+	duplicateUpdate DuplicatePolicy = "update"
+	// duplicateReject refuses the add.
+	duplicateReject DuplicatePolicy = "reject"
+)
+
+var (
+	ErrDuplicatePolicyInvalid = errors.New("invalid duplicate policy")
+	ErrGradeAlreadyExists     = errors.New("student already has a grade for this item")
+)
+
+// parseDuplicatePolicy parses a policy name, treating an empty name as duplicateAllow.
+func parseDuplicatePolicy(name string) (DuplicatePolicy, error) {
+	switch policy := DuplicatePolicy(name); policy {
+	case "", duplicateAllow:
+		return duplicateAllow, nil
+	case duplicateUpdate, duplicateReject:
+		return policy, nil
+	default:
+		return "", fmt.Errorf("%w: %q", ErrDuplicatePolicyInvalid, name)
+	}
+}
+
+// enforced reports whether the policy applies to a grade. Grades without an item ID have no
+// natural key and are never duplicates.
+func (p DuplicatePolicy) enforced(itemID string) bool {
+	return itemID != "" && (p == duplicateUpdate || p == duplicateReject)
+}
@@ -0,0 +1,87 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	gpb "github.com/BetterGR/grades-microservice/protos"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestParseDuplicatePolicy(t *testing.T) {
+	for name, want := range map[string]DuplicatePolicy{
+		"":       duplicateAllow,
+		"allow":  duplicateAllow,
+		"update": duplicateUpdate,
+		"reject": duplicateReject,
+	} {
+		policy, err := parseDuplicatePolicy(name)
+		require.NoError(t, err, name)
+		assert.Equal(t, want, policy, name)
+	}
+
+	_, err := parseDuplicatePolicy("merge")
+	require.ErrorIs(t, err, ErrDuplicatePolicyInvalid)
+}
+
+// addDuplicate adds a grade and then a second grade for the same student and item.
+func addDuplicate(t *testing.T, policy DuplicatePolicy) (*MockDatabase, *gpb.SingleGrade, error) {
+	t.Helper()
+
+	mockDB := NewMockDatabase()
+	mockDB.onDuplicate = policy
+	client := setupClient(t, func(s *GradesServer) {
+		s.db = mockDB
+	})
+
+	first := createTestGrade()
+	first.GradeValue = "70"
+	_, err := client.AddSingleGrade(context.Background(), &gpb.AddSingleGradeRequest{Token: "test-token", Grade: first})
+	require.NoError(t, err)
+
+	resubmission := createTestGrade()
+	resubmission.StudentID = first.GetStudentID()
+	resubmission.CourseID = first.GetCourseID()
+	resubmission.ItemID = first.GetItemID()
+	resubmission.GradeValue = "85"
+	_, err = client.AddSingleGrade(context.Background(), &gpb.AddSingleGradeRequest{
+		Token: "test-token", Grade: resubmission,
+	})
+
+	return mockDB, first, err
+}
+
+func TestDuplicateAddAllow(t *testing.T) {
+	mockDB, _, err := addDuplicate(t, duplicateAllow)
+	require.NoError(t, err)
+	assert.Len(t, mockDB.grades, 2)
+}
+
+func TestDuplicateAddUpdate(t *testing.T) {
+	mockDB, first, err := addDuplicate(t, duplicateUpdate)
+	require.NoError(t, err)
+	require.Len(t, mockDB.grades, 1)
+
+	grade := mockDB.grades[first.GetGradeID()]
+	require.NotNil(t, grade)
+	assert.Equal(t, "85", grade.GradeValue)
+	assert.Equal(t, int64(2), grade.Version)
+	require.Len(t, mockDB.history[first.GetGradeID()], 1)
+	assert.Equal(t, "70", mockDB.history[first.GetGradeID()][0].GradeValue)
+}
+
+func TestDuplicateAddReject(t *testing.T) {
+	mockDB, first, err := addDuplicate(t, duplicateReject)
+	assert.Equal(t, codes.AlreadyExists, status.Code(err))
+	require.Len(t, mockDB.grades, 1)
+	assert.Equal(t, "70", mockDB.grades[first.GetGradeID()].GradeValue)
+}
+
+func TestDuplicatePolicyIgnoresGradesWithoutItem(t *testing.T) {
+	assert.False(t, duplicateReject.enforced(""))
+	assert.False(t, duplicateAllow.enforced("hw1"))
+	assert.True(t, duplicateUpdate.enforced("hw1"))
+}
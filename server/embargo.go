@@ -0,0 +1,148 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	gpb "github.com/BetterGR/grades-microservice/protos"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/klog/v2"
+)
+
+var ErrGradePendingRelease = errors.New("grade is pending release")
+
+// courseSemester identifies a course offering.
+type courseSemester struct {
+	courseID string
+	semester string
+}
+
+// gradeEmbargo maps the course offerings whose grades are not released yet to their release time.
+// A nil embargo hides nothing.
+type gradeEmbargo map[courseSemester]time.Time
+
+// loadEmbargo returns the grades hidden from the role: until their course's release time, grades
+// are hidden from students while staff and admins see them.
+func (s *GradesServer) loadEmbargo(ctx context.Context, role string) (gradeEmbargo, error) {
+	if role != roleStudent {
+		return nil, nil
+	}
+
+	releases, err := s.db.GetPendingReleases(ctx, time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get course release times: %w", err)
+	}
+
+	embargo := make(gradeEmbargo, len(releases))
+	for _, release := range releases {
+		embargo[courseSemester{release.CourseID, release.Semester}] = release.ReleaseAt
+	}
+
+	return embargo, nil
+}
+
+// releaseTime returns the release time of a course offering, reporting false when it is released.
+func (e gradeEmbargo) releaseTime(courseID, semester string) (time.Time, bool) {
+	releaseAt, ok := e[courseSemester{courseID, semester}]
+
+	return releaseAt, ok
+}
+
+// apply marks the grades of unreleased courses as pending release, hiding their value and comments.
+func (e gradeEmbargo) apply(grades ...*gpb.SingleGrade) {
+	if len(e) == 0 {
+		return
+	}
+
+	for _, grade := range grades {
+		releaseAt, pending := e.releaseTime(grade.GetCourseID(), grade.GetSemester())
+		if !pending {
+			continue
+		}
+
+		grade.GradeValue = ""
+		grade.EffectiveValue = ""
+		grade.Comments = ""
+		grade.RubricScores = nil
+		grade.PendingRelease = true
+		grade.ReleaseAt = gradeTimeToProto(releaseAt)
+	}
+}
+
+// released returns the grades of released courses.
+func (e gradeEmbargo) released(grades []*Grade) []*Grade {
+	if len(e) == 0 {
+		return grades
+	}
+
+	var result []*Grade
+
+	for _, grade := range grades {
+		if _, pending := e.releaseTime(grade.CourseID, grade.Semester); !pending {
+			result = append(result, grade)
+		}
+	}
+
+	return result
+}
+
+// checkGradeValueReleased fails with FailedPrecondition when the grade a GetGradeValue request
+// names is pending release for the view. A grade named by its ID is read to learn its course.
+func (s *GradesServer) checkGradeValueReleased(ctx context.Context, view gradeView,
+	req *gpb.GetGradeValueRequest,
+) error {
+	if len(view.embargo) == 0 {
+		return nil
+	}
+
+	courseID, semester := req.GetCourseID(), req.GetSemester()
+
+	if req.GetGradeID() != "" {
+		grade, err := s.db.GetGradeByID(ctx, req.GetGradeID())
+		if err != nil {
+			return fmt.Errorf("failed to get grade value: %w", err)
+		}
+
+		courseID, semester = grade.CourseID, grade.Semester
+	}
+
+	if _, pending := view.embargo.releaseTime(courseID, semester); pending {
+		return fmt.Errorf("failed to get grade value: %w",
+			status.Error(codes.FailedPrecondition, ErrGradePendingRelease.Error()))
+	}
+
+	return nil
+}
+
+// SetCourseReleaseTime sets when the grades of a course offering become visible to students; an
+// empty release time releases them immediately. Requires the staff or admin role.
+func (s *GradesServer) SetCourseReleaseTime(ctx context.Context,
+	req *gpb.SetCourseReleaseTimeRequest,
+) (*gpb.SetCourseReleaseTimeResponse, error) {
+	if err := s.requireRole(ctx, req.GetToken(), roleStaff, roleAdmin); err != nil {
+		return nil, err
+	}
+
+	logger := klog.FromContext(ctx)
+	logger.V(logLevelDebug).Info("Received request to set course release time", "course_id", req.GetCourseID(),
+		"semester", req.GetSemester(), "release_at", req.GetReleaseAt())
+
+	releaseAt, err := parseGradeTime(req.GetReleaseAt())
+	if err != nil {
+		return nil, fmt.Errorf("failed to set course release time: %w",
+			status.Error(codes.InvalidArgument, err.Error()))
+	}
+
+	err = s.db.SetCourseRelease(ctx, req.GetCourseID(), req.GetSemester(), releaseAt)
+	if errors.Is(err, ErrCourseIDEmpty) || errors.Is(err, ErrSemesterEmpty) {
+		return nil, fmt.Errorf("failed to set course release time: %w",
+			status.Error(codes.InvalidArgument, err.Error()))
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to set course release time: %w", err)
+	}
+
+	return &gpb.SetCourseReleaseTimeResponse{}, nil
+}
@@ -0,0 +1,226 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	gpb "github.com/BetterGR/grades-microservice/protos"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// setupEmbargoClients returns a staff and a student client sharing db, with one grade added.
+func setupEmbargoClients(t *testing.T) (gpb.GradesServiceClient, gpb.GradesServiceClient, *gpb.SingleGrade) {
+	t.Helper()
+
+	db := NewMockDatabase()
+	staff := setupClient(t, func(s *GradesServer) {
+		s.db = db
+		s.Claims = RoleClaims{role: roleStaff}
+	})
+	student := setupClient(t, func(s *GradesServer) {
+		s.db = db
+		s.Claims = RoleClaims{role: roleStudent}
+	})
+
+	added, err := staff.AddSingleGrade(context.Background(),
+		&gpb.AddSingleGradeRequest{Token: "test-token", Grade: createTestGrade()})
+	require.NoError(t, err)
+
+	return staff, student, added.GetGrade()
+}
+
+// studentCourseGrade returns the single grade the client sees for the grade's course.
+func studentCourseGrade(t *testing.T, client gpb.GradesServiceClient, grade *gpb.SingleGrade) *gpb.SingleGrade {
+	t.Helper()
+
+	resp, err := client.GetStudentCourseGrades(context.Background(), &gpb.GetStudentCourseGradesRequest{
+		Token: "test-token", StudentID: grade.GetStudentID(), CourseID: grade.GetCourseID(),
+		Semester: grade.GetSemester(),
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.GetGrades(), 1)
+
+	return resp.GetGrades()[0]
+}
+
+func TestGradesHiddenFromStudentsUntilRelease(t *testing.T) {
+	staff, student, grade := setupEmbargoClients(t)
+
+	releaseAt := time.Now().Add(24 * time.Hour).UTC().Format(time.RFC3339)
+	_, err := staff.SetCourseReleaseTime(context.Background(), &gpb.SetCourseReleaseTimeRequest{
+		Token: "test-token", CourseID: grade.GetCourseID(), Semester: grade.GetSemester(), ReleaseAt: releaseAt,
+	})
+	require.NoError(t, err)
+
+	hidden := studentCourseGrade(t, student, grade)
+	assert.True(t, hidden.GetPendingRelease())
+	assert.Equal(t, releaseAt, hidden.GetReleaseAt())
+	assert.Empty(t, hidden.GetGradeValue())
+	assert.Empty(t, hidden.GetEffectiveValue())
+	assert.Empty(t, hidden.GetComments())
+	assert.Equal(t, grade.GetGradeID(), hidden.GetGradeID(), "the pending grade is still listed")
+
+	_, err = student.GetGradeValue(context.Background(),
+		&gpb.GetGradeValueRequest{Token: "test-token", GradeID: grade.GetGradeID()})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	visible := studentCourseGrade(t, staff, grade)
+	assert.False(t, visible.GetPendingRelease())
+	assert.Equal(t, "A", visible.GetGradeValue())
+	assert.Equal(t, "Excellent work!", visible.GetComments())
+}
+
+func TestGradesVisibleToStudentsAfterRelease(t *testing.T) {
+	staff, student, grade := setupEmbargoClients(t)
+
+	_, err := staff.SetCourseReleaseTime(context.Background(), &gpb.SetCourseReleaseTimeRequest{
+		Token: "test-token", CourseID: grade.GetCourseID(), Semester: grade.GetSemester(),
+		ReleaseAt: time.Now().Add(-time.Hour).UTC().Format(time.RFC3339),
+	})
+	require.NoError(t, err)
+
+	for _, client := range []gpb.GradesServiceClient{student, staff} {
+		released := studentCourseGrade(t, client, grade)
+		assert.False(t, released.GetPendingRelease())
+		assert.Equal(t, "A", released.GetGradeValue())
+	}
+
+	value, err := student.GetGradeValue(context.Background(),
+		&gpb.GetGradeValueRequest{Token: "test-token", GradeID: grade.GetGradeID()})
+	require.NoError(t, err)
+	assert.Equal(t, "A", value.GetGradeValue())
+}
+
+func TestClearCourseReleaseTime(t *testing.T) {
+	staff, student, grade := setupEmbargoClients(t)
+
+	for _, releaseAt := range []string{time.Now().Add(time.Hour).UTC().Format(time.RFC3339), ""} {
+		_, err := staff.SetCourseReleaseTime(context.Background(), &gpb.SetCourseReleaseTimeRequest{
+			Token: "test-token", CourseID: grade.GetCourseID(), Semester: grade.GetSemester(), ReleaseAt: releaseAt,
+		})
+		require.NoError(t, err)
+	}
+
+	assert.Equal(t, "A", studentCourseGrade(t, student, grade).GetGradeValue())
+}
+
+func TestSetCourseReleaseTimeRejects(t *testing.T) {
+	staff, student, grade := setupEmbargoClients(t)
+
+	_, err := student.SetCourseReleaseTime(context.Background(), &gpb.SetCourseReleaseTimeRequest{
+		Token: "test-token", CourseID: grade.GetCourseID(), Semester: grade.GetSemester(),
+	})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = staff.SetCourseReleaseTime(context.Background(), &gpb.SetCourseReleaseTimeRequest{
+		Token: "test-token", CourseID: grade.GetCourseID(), Semester: grade.GetSemester(), ReleaseAt: "tomorrow",
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = staff.SetCourseReleaseTime(context.Background(),
+		&gpb.SetCourseReleaseTimeRequest{Token: "test-token", CourseID: grade.GetCourseID()})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestEmbargoHidesDerivedValues(t *testing.T) {
+	db := NewMockDatabase()
+	weighted := func(role string) func(*GradesServer) {
+		return func(s *GradesServer) {
+			s.db = db
+			s.Claims = RoleClaims{role: role}
+			s.weights = GradeWeights{"exam": 100}
+		}
+	}
+	staff := setupClient(t, weighted(roleStaff))
+	student := setupClient(t, weighted(roleStudent))
+
+	grade := createTestGrade()
+	for _, value := range []string{"60", "90"} {
+		grade.GradeID = "exam-" + value
+		grade.ItemID = value
+		grade.GradeValue = value
+		_, err := staff.AddSingleGrade(context.Background(),
+			&gpb.AddSingleGradeRequest{Token: "test-token", Grade: grade})
+		require.NoError(t, err)
+	}
+
+	_, err := staff.SetCourseReleaseTime(context.Background(), &gpb.SetCourseReleaseTimeRequest{
+		Token: "test-token", CourseID: grade.GetCourseID(), Semester: grade.GetSemester(),
+		ReleaseAt: time.Now().Add(time.Hour).UTC().Format(time.RFC3339),
+	})
+	require.NoError(t, err)
+
+	gradesReq := &gpb.GetStudentCourseGradesRequest{
+		Token: "test-token", StudentID: grade.GetStudentID(), CourseID: grade.GetCourseID(),
+		Semester: grade.GetSemester(),
+	}
+	projectReq := &gpb.ProjectRequiredGradeRequest{
+		Token: "test-token", StudentID: grade.GetStudentID(), CourseID: grade.GetCourseID(),
+		Semester: grade.GetSemester(), TargetGrade: 80,
+	}
+
+	hidden, err := student.GetStudentCourseGrades(context.Background(), gradesReq)
+	require.NoError(t, err)
+	assert.Equal(t, trendInsufficientData, hidden.GetTrend())
+
+	projection, err := student.ProjectRequiredGrade(context.Background(), projectReq)
+	require.NoError(t, err)
+	assert.Zero(t, projection.GetCurrentGrade())
+	assert.Equal(t, []string{"exam"}, projection.GetRemainingComponents())
+
+	visible, err := staff.GetStudentCourseGrades(context.Background(), gradesReq)
+	require.NoError(t, err)
+	assert.NotEqual(t, trendInsufficientData, visible.GetTrend())
+
+	projection, err = staff.ProjectRequiredGrade(context.Background(), projectReq)
+	require.NoError(t, err)
+	assert.NotZero(t, projection.GetCurrentGrade())
+}
+
+func TestCourseGradesETagFollowsRelease(t *testing.T) {
+	staff, student, grade := setupEmbargoClients(t)
+
+	courseETag := func(client gpb.GradesServiceClient) string {
+		t.Helper()
+
+		resp, err := client.GetCourseGrades(context.Background(), &gpb.GetCourseGradesRequest{
+			Token: "test-token", CourseID: grade.GetCourseID(), Semester: grade.GetSemester(),
+		})
+		require.NoError(t, err)
+		require.NotEmpty(t, resp.GetEtag())
+
+		return resp.GetEtag()
+	}
+
+	setRelease := func(releaseAt time.Time) {
+		t.Helper()
+
+		_, err := staff.SetCourseReleaseTime(context.Background(), &gpb.SetCourseReleaseTimeRequest{
+			Token: "test-token", CourseID: grade.GetCourseID(), Semester: grade.GetSemester(),
+			ReleaseAt: releaseAt.UTC().Format(time.RFC3339),
+		})
+		require.NoError(t, err)
+	}
+
+	setRelease(time.Now().Add(time.Hour))
+
+	pending := courseETag(student)
+	assert.NotEqual(t, pending, courseETag(staff), "roles see different grades")
+
+	// The release time passing changes what the student sees, though no grade changed.
+	setRelease(time.Now().Add(-time.Hour))
+
+	released := courseETag(student)
+	assert.NotEqual(t, pending, released)
+
+	resp, err := student.GetCourseGrades(context.Background(), &gpb.GetCourseGradesRequest{
+		Token: "test-token", CourseID: grade.GetCourseID(), Semester: grade.GetSemester(), IfNoneMatch: pending,
+	})
+	require.NoError(t, err)
+	assert.False(t, resp.GetNotModified())
+	assert.Len(t, resp.GetGrades(), 1)
+}
@@ -0,0 +1,95 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	gpb "github.com/BetterGR/grades-microservice/protos"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"k8s.io/klog/v2"
+)
+
+// Output encodings of GetGradeFlexible.
+const (
+	// gradeEncodingProto is the protobuf wire format.
+	gradeEncodingProto = "proto"
+	// gradeEncodingJSON is the canonical protobuf JSON mapping with every field present.
+	gradeEncodingJSON = "json"
+	// gradeEncodingCompact is single-line protobuf JSON leaving out fields that are not set.
+	gradeEncodingCompact = "compact"
+)
+
+var ErrGradeEncodingInvalid = errors.New("format must be proto, json or compact")
+
+// parseGradeEncoding validates an output encoding, defaulting to proto when empty.
+func parseGradeEncoding(format string) (string, error) {
+	switch format = strings.ToLower(strings.TrimSpace(format)); format {
+	case "":
+		return gradeEncodingProto, nil
+	case gradeEncodingProto, gradeEncodingJSON, gradeEncodingCompact:
+		return format, nil
+	default:
+		return "", fmt.Errorf("%w: %q", ErrGradeEncodingInvalid, format)
+	}
+}
+
+// encodeGrade serializes a grade in an output encoding, returning the data and its content type.
+func encodeGrade(grade *gpb.SingleGrade, encoding string) ([]byte, string, error) {
+	var (
+		data        []byte
+		contentType = "application/json"
+		err         error
+	)
+
+	switch encoding {
+	case gradeEncodingJSON:
+		data, err = protojson.MarshalOptions{UseProtoNames: true, EmitUnpopulated: true}.Marshal(grade)
+	case gradeEncodingCompact:
+		data, err = protojson.MarshalOptions{UseProtoNames: true}.Marshal(grade)
+	default:
+		data, err = proto.Marshal(grade)
+		contentType = "application/x-protobuf"
+	}
+
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to encode grade as %s: %w", encoding, err)
+	}
+
+	return data, contentType, nil
+}
+
+// GetGradeFlexible returns a grade like GetGrade, serialized as protobuf, JSON or compact JSON,
+// so clients without generated code can read it in the encoding they prefer.
+func (s *GradesServer) GetGradeFlexible(ctx context.Context,
+	req *gpb.GetGradeFlexibleRequest,
+) (*gpb.GetGradeFlexibleResponse, error) {
+	encoding, err := parseGradeEncoding(req.GetFormat())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get grade: %w", status.Error(codes.InvalidArgument, err.Error()))
+	}
+
+	logger := klog.FromContext(ctx)
+	logger.V(logLevelDebug).Info("Received request for encoded grade", "grade_id", req.GetGradeID(),
+		"format", encoding)
+
+	resp, err := s.GetGrade(ctx, &gpb.GetGradeRequest{
+		Token:   req.GetToken(),
+		GradeID: req.GetGradeID(),
+		Version: req.GetVersion(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	data, contentType, err := encodeGrade(resp.GetGrade(), encoding)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get grade: %w", status.Error(codes.Internal, err.Error()))
+	}
+
+	return &gpb.GetGradeFlexibleResponse{Data: data, Format: encoding, ContentType: contentType}, nil
+}
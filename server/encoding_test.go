@@ -0,0 +1,97 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	gpb "github.com/BetterGR/grades-microservice/protos"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestParseGradeEncoding(t *testing.T) {
+	for format, want := range map[string]string{
+		"":          gradeEncodingProto,
+		"proto":     gradeEncodingProto,
+		" JSON ":    gradeEncodingJSON,
+		"compact":   gradeEncodingCompact,
+		"Compact\n": gradeEncodingCompact,
+	} {
+		encoding, err := parseGradeEncoding(format)
+		require.NoError(t, err, format)
+		assert.Equal(t, want, encoding, format)
+	}
+
+	_, err := parseGradeEncoding("xml")
+	require.ErrorIs(t, err, ErrGradeEncodingInvalid)
+}
+
+func TestGetGradeFlexible(t *testing.T) {
+	db := NewMockDatabase()
+	client := setupClient(t, func(s *GradesServer) { s.db = db })
+
+	added, err := db.AddGrade(context.Background(), createTestGrade())
+	require.NoError(t, err)
+
+	want, err := client.GetGrade(context.Background(),
+		&gpb.GetGradeRequest{Token: "test-token", GradeID: added.GradeID})
+	require.NoError(t, err)
+
+	for _, format := range []string{"", gradeEncodingProto, gradeEncodingJSON, gradeEncodingCompact} {
+		resp, err := client.GetGradeFlexible(context.Background(), &gpb.GetGradeFlexibleRequest{
+			Token: "test-token", GradeID: added.GradeID, Format: format,
+		})
+		require.NoError(t, err, format)
+
+		grade := &gpb.SingleGrade{}
+
+		if resp.GetFormat() == gradeEncodingProto {
+			assert.Equal(t, "application/x-protobuf", resp.GetContentType())
+			require.NoError(t, proto.Unmarshal(resp.GetData(), grade))
+		} else {
+			assert.Equal(t, "application/json", resp.GetContentType())
+			require.True(t, json.Valid(resp.GetData()), format)
+			require.NoError(t, protojson.Unmarshal(resp.GetData(), grade))
+		}
+
+		assert.True(t, proto.Equal(want.GetGrade(), grade), format)
+	}
+
+	full, err := client.GetGradeFlexible(context.Background(), &gpb.GetGradeFlexibleRequest{
+		Token: "test-token", GradeID: added.GradeID, Format: gradeEncodingJSON,
+	})
+	require.NoError(t, err)
+
+	compact, err := client.GetGradeFlexible(context.Background(), &gpb.GetGradeFlexibleRequest{
+		Token: "test-token", GradeID: added.GradeID, Format: gradeEncodingCompact,
+	})
+	require.NoError(t, err)
+
+	// The JSON encoding has every field, the compact one only those that are set.
+	var fields, setFields map[string]any
+	require.NoError(t, json.Unmarshal(full.GetData(), &fields))
+	require.NoError(t, json.Unmarshal(compact.GetData(), &setFields))
+	assert.Contains(t, fields, "flagged")
+	assert.NotContains(t, setFields, "flagged")
+	assert.Equal(t, "A", setFields["grade_value"])
+	assert.Less(t, len(compact.GetData()), len(full.GetData()))
+}
+
+func TestGetGradeFlexibleErrors(t *testing.T) {
+	client := setupClient(t)
+
+	_, err := client.GetGradeFlexible(context.Background(), &gpb.GetGradeFlexibleRequest{
+		Token: "test-token", GradeID: "grade-1", Format: "xml",
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = client.GetGradeFlexible(context.Background(), &gpb.GetGradeFlexibleRequest{
+		Token: "test-token", GradeID: "missing", Format: gradeEncodingJSON,
+	})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
@@ -0,0 +1,73 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	gpb "github.com/BetterGR/grades-microservice/protos"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/klog/v2"
+)
+
+var (
+	ErrStudentNotEnrolled = errors.New("student is not enrolled in the course")
+	ErrEnrollmentCheck    = errors.New("failed to check enrollment")
+)
+
+// EnrollmentChecker tells whether a student is enrolled in a course during a semester, as known to
+// the enrollment service.
+type EnrollmentChecker interface {
+	IsEnrolled(ctx context.Context, studentID, courseID, semester string) (bool, error)
+}
+
+// noopEnrollmentChecker reports every student as enrolled; it is the default until an enrollment
+// service is configured.
+type noopEnrollmentChecker struct{}
+
+// IsEnrolled reports true.
+func (noopEnrollmentChecker) IsEnrolled(context.Context, string, string, string) (bool, error) {
+	return true, nil
+}
+
+// checkEnrollment fails with ErrStudentNotEnrolled when the student of a new grade is not enrolled
+// in its course during its semester. When the enrollment service fails, the grade is rejected with
+// ErrEnrollmentCheck, or admitted with the failure logged when ENROLLMENT_FAIL_OPEN is set.
+func (s *GradesServer) checkEnrollment(ctx context.Context, grade *gpb.SingleGrade) error {
+	if s.enrollment == nil {
+		return nil
+	}
+
+	enrolled, err := s.enrollment.IsEnrolled(ctx, grade.GetStudentID(), grade.GetCourseID(), grade.GetSemester())
+	if err != nil {
+		if s.enrollmentFailOpen {
+			klog.FromContext(ctx).Error(err, "Failed to check enrollment, admitting the grade",
+				"student_id", grade.GetStudentID(), "course_id", grade.GetCourseID(), "semester", grade.GetSemester())
+
+			return nil
+		}
+
+		return fmt.Errorf("%w: %w", ErrEnrollmentCheck, err)
+	}
+
+	if !enrolled {
+		return fmt.Errorf("%w: student %s, course %s, semester %s", ErrStudentNotEnrolled,
+			grade.GetStudentID(), grade.GetCourseID(), grade.GetSemester())
+	}
+
+	return nil
+}
+
+// enrollmentStatus reports ErrStudentNotEnrolled as FailedPrecondition and ErrEnrollmentCheck as
+// Unavailable.
+func enrollmentStatus(err error) error {
+	switch {
+	case errors.Is(err, ErrStudentNotEnrolled):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, ErrEnrollmentCheck):
+		return status.Error(codes.Unavailable, err.Error())
+	}
+
+	return err
+}
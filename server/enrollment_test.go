@@ -0,0 +1,78 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	gpb "github.com/BetterGR/grades-microservice/protos"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// MockEnrollmentChecker reports the students in enrolled as enrolled in every course, or fails
+// with err while it is set.
+type MockEnrollmentChecker struct {
+	enrolled map[string]bool
+	err      error
+}
+
+// IsEnrolled reports whether the student is in enrolled.
+func (c *MockEnrollmentChecker) IsEnrolled(_ context.Context, studentID, _, _ string) (bool, error) {
+	if c.err != nil {
+		return false, c.err
+	}
+
+	return c.enrolled[studentID], nil
+}
+
+func TestAddSingleGradeChecksEnrollment(t *testing.T) {
+	checker := &MockEnrollmentChecker{enrolled: map[string]bool{"enrolled-student": true}}
+	client := setupClient(t, func(s *GradesServer) {
+		s.enrollment = checker
+	})
+
+	grade := createTestGrade()
+	grade.StudentID = "enrolled-student"
+	_, err := client.AddSingleGrade(context.Background(), &gpb.AddSingleGradeRequest{Token: "test-token", Grade: grade})
+	require.NoError(t, err, "grades of enrolled students are accepted")
+
+	grade = createTestGrade()
+	grade.StudentID = "other-student"
+	_, err = client.AddSingleGrade(context.Background(), &gpb.AddSingleGradeRequest{Token: "test-token", Grade: grade})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err), "grades of students not enrolled are rejected")
+
+	checker.err = errors.New("enrollment service down")
+	_, err = client.AddSingleGrade(context.Background(), &gpb.AddSingleGradeRequest{Token: "test-token", Grade: grade})
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+}
+
+func TestCheckEnrollmentFailOpen(t *testing.T) {
+	server := &GradesServer{
+		enrollment:         &MockEnrollmentChecker{err: errors.New("enrollment service down")},
+		enrollmentFailOpen: true,
+	}
+
+	assert.NoError(t, server.checkEnrollment(context.Background(), createTestGrade()),
+		"grades are admitted while the service is down")
+
+	server.enrollment = noopEnrollmentChecker{}
+	assert.NoError(t, server.checkEnrollment(context.Background(), createTestGrade()))
+}
+
+func TestValidateNewGradeChecksEnrollment(t *testing.T) {
+	server := &GradesServer{
+		db:         NewMockDatabase(),
+		enrollment: &MockEnrollmentChecker{enrolled: map[string]bool{"enrolled-student": true}},
+	}
+	schemes := server.newGradeTypeSchemes()
+
+	grade := createTestGrade()
+	grade.StudentID = "enrolled-student"
+	require.NoError(t, server.validateNewGrade(context.Background(), schemes, grade))
+
+	err := server.validateNewGrade(context.Background(), schemes, createTestGrade())
+	assert.ErrorIs(t, err, ErrStudentNotEnrolled, "batch and imported grades are checked too")
+}
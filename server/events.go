@@ -0,0 +1,219 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"k8s.io/klog/v2"
+)
+
+// Grade event types.
+const (
+	eventGradeAdded   = "grade.added"
+	eventGradeUpdated = "grade.updated"
+	eventGradeRemoved = "grade.removed"
+)
+
+const (
+	// failedEventsBatchSize is the number of dead-lettered events retried in one pass.
+	failedEventsBatchSize = 100
+	// webhookTimeout bounds a single webhook delivery.
+	webhookTimeout = 5 * time.Second
+)
+
+var ErrWebhookRejected = errors.New("webhook rejected the event")
+
+// GradeEvent describes a change to a grade.
+type GradeEvent struct {
+	Type          string    `json:"type"`
+	GradeID       string    `json:"grade_id"`
+	StudentID     string    `json:"student_id,omitempty"`
+	CourseID      string    `json:"course_id,omitempty"`
+	Semester      string    `json:"semester,omitempty"`
+	GradeValue    string    `json:"grade_value,omitempty"`
+	InstitutionID string    `json:"institution_id,omitempty"`
+	OccurredAt    time.Time `json:"occurred_at"`
+
+	// grade is the grade changed, pushed to the watchers of its course; it is never delivered
+	// downstream.
+	grade *Grade
+}
+
+// newGradeEvent creates an event of the given type for a grade.
+func newGradeEvent(eventType string, grade *Grade) *GradeEvent {
+	return &GradeEvent{
+		Type:          eventType,
+		GradeID:       grade.GradeID,
+		StudentID:     grade.StudentID,
+		CourseID:      grade.CourseID,
+		Semester:      grade.Semester,
+		GradeValue:    grade.GradeValue,
+		InstitutionID: grade.InstitutionID,
+		OccurredAt:    time.Now(),
+		grade:         grade,
+	}
+}
+
+// addedEventType returns the event type for a grade returned by an add, which updated an existing
+// grade instead when the duplicate policy is update.
+func addedEventType(grade *Grade) string {
+	if grade.Version > 1 {
+		return eventGradeUpdated
+	}
+
+	return eventGradeAdded
+}
+
+// EventPublisher delivers grade events to downstream consumers.
+type EventPublisher interface {
+	Publish(ctx context.Context, event *GradeEvent) error
+}
+
+// webhookPublisher posts grade events as JSON to a webhook URL.
+type webhookPublisher struct {
+	url    string
+	client *http.Client
+}
+
+// newWebhookPublisher creates a publisher posting to url.
+func newWebhookPublisher(url string) *webhookPublisher {
+	return &webhookPublisher{url: url, client: &http.Client{Timeout: webhookTimeout}}
+}
+
+// Publish posts the event and fails unless the webhook answers with a 2xx status.
+func (p *webhookPublisher) Publish(ctx context.Context, event *GradeEvent) error {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to encode event: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create webhook request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to call webhook: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("%w: status %d", ErrWebhookRejected, resp.StatusCode)
+	}
+
+	return nil
+}
+
+// publishEvent pushes a grade event to the watchers of its course and publishes it. Publish
+// failures are recorded in the dead-letter store to be retried later, so they never fail the
+// request that caused the event.
+func (s *GradesServer) publishEvent(ctx context.Context, event *GradeEvent) {
+	// Removals name only the grade; it belonged to the institution the request is scoped to.
+	if event.InstitutionID == "" {
+		event.InstitutionID = institutionFromContext(ctx)
+	}
+
+	s.watchers.broadcast(event)
+
+	if s.events == nil {
+		return
+	}
+
+	logger := klog.FromContext(ctx)
+
+	publishErr := s.events.Publish(ctx, event)
+	if publishErr == nil {
+		return
+	}
+
+	payload, err := json.Marshal(event)
+	if err != nil {
+		logger.Error(err, "Failed to encode grade event for the dead-letter store", "type", event.Type)
+
+		return
+	}
+
+	failed := &FailedEvent{EventType: event.Type, Payload: string(payload), LastError: publishErr.Error()}
+	if err := s.db.RecordFailedEvent(ctx, failed); err != nil {
+		logger.Error(err, "Failed to record failed grade event, event lost", "type", event.Type,
+			"grade_id", event.GradeID)
+
+		return
+	}
+
+	logger.Error(publishErr, "Failed to publish grade event, moved to dead-letter store", "type", event.Type,
+		"grade_id", event.GradeID)
+}
+
+// retryFailedEvents re-publishes dead-lettered events, removing the ones delivered.
+// It returns the number of events delivered and the number that failed again.
+func (s *GradesServer) retryFailedEvents(ctx context.Context) (int, int, error) {
+	if s.events == nil {
+		return 0, 0, nil
+	}
+
+	failedEvents, err := s.db.GetFailedEvents(ctx, failedEventsBatchSize)
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to get failed events: %w", err)
+	}
+
+	delivered, stillFailing := 0, 0
+
+	for _, failed := range failedEvents {
+		event := &GradeEvent{}
+		if err := json.Unmarshal([]byte(failed.Payload), event); err != nil {
+			return delivered, stillFailing, fmt.Errorf("failed to decode failed event %d: %w", failed.EventID, err)
+		}
+
+		if publishErr := s.events.Publish(ctx, event); publishErr != nil {
+			stillFailing++
+
+			if err := s.db.RecordFailedEventAttempt(ctx, failed.EventID, publishErr.Error()); err != nil {
+				return delivered, stillFailing, fmt.Errorf("failed to record event retry: %w", err)
+			}
+
+			continue
+		}
+
+		if err := s.db.RemoveFailedEvent(ctx, failed.EventID); err != nil {
+			return delivered, stillFailing, fmt.Errorf("failed to remove delivered event: %w", err)
+		}
+
+		delivered++
+	}
+
+	return delivered, stillFailing, nil
+}
+
+// runFailedEventRetries retries dead-lettered events every interval until the context is done.
+func (s *GradesServer) runFailedEventRetries(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			delivered, stillFailing, err := s.retryFailedEvents(ctx)
+			if err != nil {
+				klog.ErrorS(err, "Failed to retry failed grade events")
+
+				continue
+			}
+
+			if delivered > 0 || stillFailing > 0 {
+				klog.V(logLevelDebug).InfoS("Retried failed grade events", "delivered", delivered,
+					"still_failing", stillFailing)
+			}
+		}
+	}
+}
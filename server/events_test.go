@@ -0,0 +1,123 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	gpb "github.com/BetterGR/grades-microservice/protos"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var errPublisherDown = errors.New("publisher down")
+
+// FlakyPublisher fails to publish while down and records the events it delivers.
+type FlakyPublisher struct {
+	down      atomic.Bool
+	mutex     sync.Mutex
+	published []*GradeEvent
+}
+
+func (p *FlakyPublisher) Publish(_ context.Context, event *GradeEvent) error {
+	if p.down.Load() {
+		return errPublisherDown
+	}
+
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	p.published = append(p.published, event)
+
+	return nil
+}
+
+func TestFailedEventsAreDeadLetteredAndRetried(t *testing.T) {
+	mockDB := NewMockDatabase()
+	publisher := &FlakyPublisher{}
+	publisher.down.Store(true)
+
+	client := setupClient(t, func(s *GradesServer) {
+		s.db = mockDB
+		s.events = publisher
+	})
+
+	grade := createTestGrade()
+	_, err := client.AddSingleGrade(context.Background(), &gpb.AddSingleGradeRequest{Token: "test-token", Grade: grade})
+	require.NoError(t, err, "a publish failure must not fail the request")
+
+	failed, err := mockDB.GetFailedEvents(context.Background(), failedEventsBatchSize)
+	require.NoError(t, err)
+	require.Len(t, failed, 1)
+	assert.Equal(t, eventGradeAdded, failed[0].EventType)
+	assert.Equal(t, errPublisherDown.Error(), failed[0].LastError)
+
+	// Retrying while the publisher is still down keeps the event and counts the attempt.
+	resp, err := client.RetryFailedEvents(context.Background(), &gpb.RetryFailedEventsRequest{Token: "test-token"})
+	require.NoError(t, err)
+	assert.Equal(t, int32(0), resp.GetDeliveredCount())
+	assert.Equal(t, int32(1), resp.GetStillFailingCount())
+
+	failed, err = mockDB.GetFailedEvents(context.Background(), failedEventsBatchSize)
+	require.NoError(t, err)
+	require.Len(t, failed, 1)
+	assert.Equal(t, 2, failed[0].Attempts)
+
+	publisher.down.Store(false)
+
+	resp, err = client.RetryFailedEvents(context.Background(), &gpb.RetryFailedEventsRequest{Token: "test-token"})
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), resp.GetDeliveredCount())
+	assert.Equal(t, int32(0), resp.GetStillFailingCount())
+
+	failed, err = mockDB.GetFailedEvents(context.Background(), failedEventsBatchSize)
+	require.NoError(t, err)
+	assert.Empty(t, failed)
+
+	require.Len(t, publisher.published, 1)
+	assert.Equal(t, eventGradeAdded, publisher.published[0].Type)
+	assert.Equal(t, grade.GetGradeID(), publisher.published[0].GradeID)
+	assert.Equal(t, grade.GetStudentID(), publisher.published[0].StudentID)
+}
+
+func TestWebhookPublisher(t *testing.T) {
+	var (
+		reject   atomic.Bool
+		received atomic.Pointer[GradeEvent]
+	)
+
+	webhook := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if reject.Load() {
+			w.WriteHeader(http.StatusServiceUnavailable)
+
+			return
+		}
+
+		event := &GradeEvent{}
+		if err := json.NewDecoder(r.Body).Decode(event); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+
+			return
+		}
+
+		received.Store(event)
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer webhook.Close()
+
+	publisher := newWebhookPublisher(webhook.URL)
+	event := newGradeEvent(eventGradeUpdated, &Grade{GradeID: "g1", CourseID: "c1", GradeValue: "95"})
+
+	require.NoError(t, publisher.Publish(context.Background(), event))
+	require.NotNil(t, received.Load())
+	assert.Equal(t, "g1", received.Load().GradeID)
+	assert.Equal(t, eventGradeUpdated, received.Load().Type)
+
+	reject.Store(true)
+	require.ErrorIs(t, publisher.Publish(context.Background(), event), ErrWebhookRejected)
+}
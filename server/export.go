@@ -0,0 +1,281 @@
+package main
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	gpb "github.com/BetterGR/grades-microservice/protos"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/klog/v2"
+)
+
+// Sheet names of the course grades workbook.
+const (
+	gradesSheetName  = "Grades"
+	summarySheetName = "Summary"
+)
+
+// spreadsheetNamespace is the SpreadsheetML namespace of workbook and worksheet parts.
+const spreadsheetNamespace = "http://schemas.openxmlformats.org/spreadsheetml/2006/main"
+
+// gradesSheetHeader is the header row of the grades sheet.
+var gradesSheetHeader = []string{
+	"Grade ID", "Student ID", "Course ID", "Semester", "Grade Type", "Item ID",
+	"Grade Value", "Graded By", "Graded At", "Comments",
+}
+
+// xlsxCell is a worksheet cell. Numbers are stored as values so spreadsheets can compute with them;
+// text is stored inline.
+type xlsxCell struct {
+	Ref    string      `xml:"r,attr"`
+	Type   string      `xml:"t,attr,omitempty"`
+	Value  string      `xml:"v,omitempty"`
+	Inline *xlsxInline `xml:"is,omitempty"`
+}
+
+// xlsxInline is the inline text of a cell.
+type xlsxInline struct {
+	Text string `xml:"t"`
+}
+
+// xlsxRow is a worksheet row.
+type xlsxRow struct {
+	Index int        `xml:"r,attr"`
+	Cells []xlsxCell `xml:"c"`
+}
+
+// xlsxWorksheet is a worksheet part.
+type xlsxWorksheet struct {
+	XMLName xml.Name  `xml:"worksheet"`
+	Xmlns   string    `xml:"xmlns,attr"`
+	Rows    []xlsxRow `xml:"sheetData>row"`
+}
+
+// xlsxSheet is the entry of a worksheet in the workbook part.
+type xlsxSheet struct {
+	Name    string `xml:"name,attr"`
+	SheetID int    `xml:"sheetId,attr"`
+	RelID   string `xml:"http://schemas.openxmlformats.org/officeDocument/2006/relationships id,attr"`
+}
+
+// xlsxWorkbook is the workbook part.
+type xlsxWorkbook struct {
+	XMLName xml.Name    `xml:"workbook"`
+	Xmlns   string      `xml:"xmlns,attr"`
+	Sheets  []xlsxSheet `xml:"sheets>sheet"`
+}
+
+// worksheetBuilder appends rows to a worksheet.
+type worksheetBuilder struct {
+	sheet xlsxWorksheet
+}
+
+// addRow appends a row of cells. Strings become text cells, float64 and int values number cells.
+func (b *worksheetBuilder) addRow(values ...interface{}) {
+	row := xlsxRow{Index: len(b.sheet.Rows) + 1}
+
+	for column, value := range values {
+		cell := xlsxCell{Ref: columnName(column) + strconv.Itoa(row.Index)}
+
+		switch v := value.(type) {
+		case float64:
+			cell.Value = strconv.FormatFloat(v, 'f', -1, 64)
+		case int:
+			cell.Value = strconv.Itoa(v)
+		default:
+			cell.Type = "inlineStr"
+			cell.Inline = &xlsxInline{Text: fmt.Sprint(v)}
+		}
+
+		row.Cells = append(row.Cells, cell)
+	}
+
+	b.sheet.Rows = append(b.sheet.Rows, row)
+}
+
+// columnName returns the spreadsheet name of a zero-based column: A, B, ..., Z, AA and so on.
+func columnName(column int) string {
+	name := ""
+	for column++; column > 0; column = (column - 1) / 26 {
+		name = string(rune('A'+(column-1)%26)) + name
+	}
+
+	return name
+}
+
+// gradeCell returns the value of a grade for a cell, as a number when it is numeric.
+func gradeCell(value string) interface{} {
+	if classifyGradeValue(value) == gradeKindNumeric {
+		number, _ := parseNumericGrade(value)
+
+		return number
+	}
+
+	return value
+}
+
+// gradesWorksheet lists the grades one per row under a header row.
+func gradesWorksheet(grades []*Grade, rounder GradeRounder) xlsxWorksheet {
+	builder := worksheetBuilder{sheet: xlsxWorksheet{Xmlns: spreadsheetNamespace}}
+
+	header := make([]interface{}, 0, len(gradesSheetHeader))
+	for _, title := range gradesSheetHeader {
+		header = append(header, title)
+	}
+
+	builder.addRow(header...)
+
+	for _, grade := range grades {
+		builder.addRow(grade.GradeID, grade.StudentID, grade.CourseID, grade.Semester, grade.GradeType,
+			grade.ItemID, gradeCell(rounder.Round(grade.GradeValue)), grade.GradedBy,
+			grade.GradedAt.UTC().Format(time.RFC3339), grade.Comments)
+	}
+
+	return builder.sheet
+}
+
+// summaryWorksheet holds the grade count, the average numeric grade and the distribution of values.
+func summaryWorksheet(grades []*Grade, rounder GradeRounder) xlsxWorksheet {
+	builder := worksheetBuilder{sheet: xlsxWorksheet{Xmlns: spreadsheetNamespace}}
+
+	_, average := studentAverages(grades, rounder)
+
+	builder.addRow("Count", len(grades))
+	builder.addRow("Numeric Count", len(segmentGrades(grades).numeric))
+	builder.addRow("Average", average)
+	builder.addRow()
+	builder.addRow("Grade Value", "Count")
+
+	for _, bucket := range gradeDistribution(grades, rounder) {
+		builder.addRow(gradeCell(bucket.value), bucket.count)
+	}
+
+	return builder.sheet
+}
+
+// buildCourseGradesWorkbook renders grades as an xlsx workbook with a grades sheet and a summary sheet.
+func buildCourseGradesWorkbook(grades []*Grade, rounder GradeRounder) ([]byte, error) {
+	workbook := xlsxWorkbook{
+		Xmlns: spreadsheetNamespace,
+		Sheets: []xlsxSheet{
+			{Name: gradesSheetName, SheetID: 1, RelID: "rId1"},
+			{Name: summarySheetName, SheetID: 2, RelID: "rId2"},
+		},
+	}
+
+	parts := []struct {
+		name    string
+		content interface{}
+	}{
+		{"[Content_Types].xml", xlsxContentTypes},
+		{"_rels/.rels", xlsxRootRels},
+		{"xl/_rels/workbook.xml.rels", xlsxWorkbookRels},
+		{"xl/workbook.xml", workbook},
+		{"xl/worksheets/sheet1.xml", gradesWorksheet(grades, rounder)},
+		{"xl/worksheets/sheet2.xml", summaryWorksheet(grades, rounder)},
+	}
+
+	var buf bytes.Buffer
+
+	archive := zip.NewWriter(&buf)
+
+	for _, part := range parts {
+		if err := writeWorkbookPart(archive, part.name, part.content); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := archive.Close(); err != nil {
+		return nil, fmt.Errorf("failed to write workbook: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// writeWorkbookPart adds a part to the workbook archive. Strings are written verbatim and any
+// other content is marshaled as XML.
+func writeWorkbookPart(archive *zip.Writer, name string, content interface{}) error {
+	part, err := archive.Create(name)
+	if err != nil {
+		return fmt.Errorf("failed to create workbook part %s: %w", name, err)
+	}
+
+	if _, err := part.Write([]byte(xml.Header)); err != nil {
+		return fmt.Errorf("failed to write workbook part %s: %w", name, err)
+	}
+
+	if text, ok := content.(string); ok {
+		_, err = part.Write([]byte(text))
+	} else {
+		err = xml.NewEncoder(part).Encode(content)
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to write workbook part %s: %w", name, err)
+	}
+
+	return nil
+}
+
+// Fixed parts of the workbook, tying the workbook and its two worksheets together.
+const (
+	xlsxContentTypes = `<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types">` +
+		`<Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/>` +
+		`<Default Extension="xml" ContentType="application/xml"/>` +
+		`<Override PartName="/xl/workbook.xml" ` +
+		`ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/>` +
+		`<Override PartName="/xl/worksheets/sheet1.xml" ` +
+		`ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/>` +
+		`<Override PartName="/xl/worksheets/sheet2.xml" ` +
+		`ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/>` +
+		`</Types>`
+	xlsxRootRels = `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+		`<Relationship Id="rId1" Target="xl/workbook.xml" ` +
+		`Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument"/>` +
+		`</Relationships>`
+	xlsxWorkbookRels = `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+		`<Relationship Id="rId1" Target="worksheets/sheet1.xml" ` +
+		`Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet"/>` +
+		`<Relationship Id="rId2" Target="worksheets/sheet2.xml" ` +
+		`Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet"/>` +
+		`</Relationships>`
+)
+
+// ExportCourseGradesXLSX exports the grades of a course in a semester as an Excel workbook with a
+// grades sheet and a summary sheet. Requires the staff or admin role.
+func (s *GradesServer) ExportCourseGradesXLSX(ctx context.Context,
+	req *gpb.ExportCourseGradesXLSXRequest,
+) (*gpb.ExportCourseGradesXLSXResponse, error) {
+	if err := s.requireRole(ctx, req.GetToken(), roleStaff, roleAdmin); err != nil {
+		return nil, err
+	}
+
+	logger := klog.FromContext(ctx)
+	logger.V(logLevelDebug).Info("Received request to export course grades", "course_id", req.GetCourseID(),
+		"semester", req.GetSemester())
+
+	grades, err := s.db.GetCourseGrades(ctx, req.GetCourseID(), req.GetSemester())
+	if errors.Is(err, ErrCourseIDEmpty) {
+		return nil, fmt.Errorf("failed to export course grades: %w",
+			status.Error(codes.InvalidArgument, err.Error()))
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to export course grades: %w", err)
+	}
+
+	workbook, err := buildCourseGradesWorkbook(grades, s.rounder)
+	if err != nil {
+		return nil, fmt.Errorf("failed to export course grades: %w", err)
+	}
+
+	return &gpb.ExportCourseGradesXLSXResponse{
+		Workbook: workbook,
+		Filename: req.GetCourseID() + "_" + req.GetSemester() + ".xlsx",
+	}, nil
+}
@@ -0,0 +1,139 @@
+package main
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/xml"
+	"io"
+	"testing"
+
+	gpb "github.com/BetterGR/grades-microservice/protos"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// openWorkbook opens an xlsx workbook and returns its parts by name.
+func openWorkbook(t *testing.T, workbook []byte) map[string][]byte {
+	t.Helper()
+
+	archive, err := zip.NewReader(bytes.NewReader(workbook), int64(len(workbook)))
+	require.NoError(t, err)
+
+	parts := make(map[string][]byte)
+
+	for _, file := range archive.File {
+		reader, err := file.Open()
+		require.NoError(t, err)
+
+		content, err := io.ReadAll(reader)
+		require.NoError(t, err)
+		require.NoError(t, reader.Close())
+
+		parts[file.Name] = content
+	}
+
+	return parts
+}
+
+// worksheetCells parses a worksheet part into its cells by reference, such as "B2".
+func worksheetCells(t *testing.T, part []byte) map[string]xlsxCell {
+	t.Helper()
+
+	var sheet xlsxWorksheet
+	require.NoError(t, xml.Unmarshal(part, &sheet))
+
+	cells := make(map[string]xlsxCell)
+
+	for _, row := range sheet.Rows {
+		for _, cell := range row.Cells {
+			cells[cell.Ref] = cell
+		}
+	}
+
+	return cells
+}
+
+// cellText returns the inline text of a cell, failing if it is not a text cell.
+func cellText(t *testing.T, cell xlsxCell) string {
+	t.Helper()
+
+	require.Equal(t, "inlineStr", cell.Type, "cell %s must hold text", cell.Ref)
+	require.NotNil(t, cell.Inline)
+
+	return cell.Inline.Text
+}
+
+// cellNumber returns the value of a number cell, failing if it is not one.
+func cellNumber(t *testing.T, cell xlsxCell) string {
+	t.Helper()
+
+	require.Empty(t, cell.Type, "cell %s must hold a number", cell.Ref)
+
+	return cell.Value
+}
+
+func TestColumnName(t *testing.T) {
+	assert.Equal(t, "A", columnName(0))
+	assert.Equal(t, "J", columnName(9))
+	assert.Equal(t, "Z", columnName(25))
+	assert.Equal(t, "AA", columnName(26))
+	assert.Equal(t, "AZ", columnName(51))
+}
+
+func TestBuildCourseGradesWorkbook(t *testing.T) {
+	grades := []*Grade{
+		{GradeID: "g1", StudentID: "s1", CourseID: "c1", Semester: "Winter_2023", GradeValue: "90", Comments: "a < b"},
+		{GradeID: "g2", StudentID: "s2", CourseID: "c1", Semester: "Winter_2023", GradeValue: "80"},
+		{GradeID: "g3", StudentID: "s3", CourseID: "c1", Semester: "Winter_2023", GradeValue: "B"},
+	}
+
+	workbook, err := buildCourseGradesWorkbook(grades, roundingNone)
+	require.NoError(t, err)
+
+	parts := openWorkbook(t, workbook)
+	require.Contains(t, parts, "[Content_Types].xml")
+
+	var book xlsxWorkbook
+	require.NoError(t, xml.Unmarshal(parts["xl/workbook.xml"], &book))
+	require.Len(t, book.Sheets, 2)
+	assert.Equal(t, gradesSheetName, book.Sheets[0].Name)
+	assert.Equal(t, summarySheetName, book.Sheets[1].Name)
+
+	gradesSheet := worksheetCells(t, parts["xl/worksheets/sheet1.xml"])
+	assert.Equal(t, "Grade ID", cellText(t, gradesSheet["A1"]))
+	assert.Equal(t, "Grade Value", cellText(t, gradesSheet["G1"]))
+	assert.Equal(t, "g1", cellText(t, gradesSheet["A2"]))
+	assert.Equal(t, "90", cellNumber(t, gradesSheet["G2"]), "numeric grades must be numbers")
+	assert.Equal(t, "a < b", cellText(t, gradesSheet["J2"]))
+	assert.Equal(t, "B", cellText(t, gradesSheet["G4"]))
+
+	summary := worksheetCells(t, parts["xl/worksheets/sheet2.xml"])
+	assert.Equal(t, "Count", cellText(t, summary["A1"]))
+	assert.Equal(t, "3", cellNumber(t, summary["B1"]))
+	assert.Equal(t, "2", cellNumber(t, summary["B2"]))
+	assert.Equal(t, "85", cellNumber(t, summary["B3"]))
+	assert.Equal(t, "Grade Value", cellText(t, summary["A5"]))
+	assert.Equal(t, "80", cellNumber(t, summary["A6"]))
+	assert.Equal(t, "1", cellNumber(t, summary["B6"]))
+	assert.Equal(t, "B", cellText(t, summary["A8"]))
+}
+
+func TestExportCourseGradesXLSX(t *testing.T) {
+	client := setupClient(t)
+
+	grade := createTestGrade()
+	grade.GradeValue = "95"
+	_, err := client.AddSingleGrade(context.Background(), &gpb.AddSingleGradeRequest{Token: "test-token", Grade: grade})
+	require.NoError(t, err)
+
+	resp, err := client.ExportCourseGradesXLSX(context.Background(), &gpb.ExportCourseGradesXLSXRequest{
+		Token: "test-token", CourseID: grade.GetCourseID(), Semester: grade.GetSemester(),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, grade.GetCourseID()+"_Winter_2023.xlsx", resp.GetFilename())
+
+	gradesSheet := worksheetCells(t, openWorkbook(t, resp.GetWorkbook())["xl/worksheets/sheet1.xml"])
+	assert.Equal(t, grade.GetStudentID(), cellText(t, gradesSheet["B2"]))
+	assert.Equal(t, "95", cellNumber(t, gradesSheet["G2"]))
+}
@@ -0,0 +1,393 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	gpb "github.com/BetterGR/grades-microservice/protos"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/klog/v2"
+)
+
+const (
+	// maxGradeOverrides bounds the hypothetical changes of a single simulation.
+	maxGradeOverrides = 100
+	// defaultMaxLeaderboardSize caps the students of a leaderboard when LEADERBOARD_MAX_SIZE is unset.
+	defaultMaxLeaderboardSize = 100
+)
+
+var (
+	ErrTooManyOverrides   = errors.New("too many grade overrides")
+	ErrOverrideUnknown    = errors.New("override targets a grade the student does not have")
+	ErrOverrideIncomplete = errors.New("a new hypothetical grade needs a course and semester")
+	ErrOverrideValueEmpty = errors.New("override grade value is empty")
+)
+
+// courseScore returns a student's final score in a course from its grades: the weighted average of
+// the graded components when weights cover any of them, otherwise the mean of the numeric grades.
+// It reports false when the course has no numeric grade.
+func courseScore(grades []*Grade, weights GradeWeights) (float64, bool) {
+	if len(weights) > 0 {
+		if projection := projectRequiredGrade(grades, weights, 0); len(projection.remaining) < len(weights) {
+			return projection.current, true
+		}
+	}
+
+	numeric := segmentGrades(grades).numeric
+	if len(numeric) == 0 {
+		return 0, false
+	}
+
+	var sum float64
+
+	for _, grade := range numeric {
+		value, _ := parseNumericGrade(grade.GradeValue)
+		sum += value
+	}
+
+	return sum / float64(len(numeric)), true
+}
+
+// computeGPA returns the unweighted mean of a student's course scores and the number of courses
+// it covers. Grades are grouped by course and semester, so a retaken course counts once per
+// semester; courses without numeric grades are left out.
+func computeGPA(grades []*Grade, weights GradeWeights) (float64, int) {
+	type courseKey struct{ courseID, semester string }
+
+	courses := make(map[courseKey][]*Grade)
+	for _, grade := range grades {
+		key := courseKey{grade.CourseID, grade.Semester}
+		courses[key] = append(courses[key], grade)
+	}
+
+	var (
+		sum   float64
+		count int
+	)
+
+	for _, courseGrades := range courses {
+		if score, ok := courseScore(courseGrades, weights); ok {
+			sum += score
+			count++
+		}
+	}
+
+	if count == 0 {
+		return 0, 0
+	}
+
+	return sum / float64(count), count
+}
+
+// applyGradeOverrides returns a student's grades with the hypothetical changes applied. An override
+// with a grade ID replaces the value of that grade; one without adds a hypothetical grade. The
+// stored grades are copied, never modified.
+func applyGradeOverrides(studentID string, grades []*Grade, overrides []*gpb.GradeOverride) ([]*Grade, error) {
+	merged := make([]*Grade, 0, len(grades)+len(overrides))
+	byID := make(map[string]*Grade, len(grades))
+
+	for _, grade := range grades {
+		simulated := *grade
+		merged = append(merged, &simulated)
+		byID[grade.GradeID] = &simulated
+	}
+
+	for _, override := range overrides {
+		if strings.TrimSpace(override.GetGradeValue()) == "" {
+			return nil, fmt.Errorf("%w", ErrOverrideValueEmpty)
+		}
+
+		if override.GetGradeID() != "" {
+			grade, ok := byID[override.GetGradeID()]
+			if !ok {
+				return nil, fmt.Errorf("%w: %s", ErrOverrideUnknown, override.GetGradeID())
+			}
+
+			grade.GradeValue = override.GetGradeValue()
+
+			continue
+		}
+
+		if override.GetCourseID() == "" || override.GetSemester() == "" {
+			return nil, fmt.Errorf("%w", ErrOverrideIncomplete)
+		}
+
+		merged = append(merged, &Grade{
+			StudentID:  studentID,
+			CourseID:   override.GetCourseID(),
+			Semester:   override.GetSemester(),
+			GradeType:  override.GetGradeType(),
+			GradeValue: override.GetGradeValue(),
+		})
+	}
+
+	return merged, nil
+}
+
+// semesterGrades returns the grades of the semester.
+func semesterGrades(grades []*Grade, semester string) []*Grade {
+	var result []*Grade
+
+	for _, grade := range grades {
+		if grade.Semester == semester {
+			result = append(result, grade)
+		}
+	}
+
+	return result
+}
+
+// SimulateGradeChange recomputes a student's semester and cumulative GPA as if hypothetical grade
+// changes applied, for advising. Nothing is written. Students may only simulate their own grades;
+// staff and admins may simulate anyone's.
+func (s *GradesServer) SimulateGradeChange(ctx context.Context,
+	req *gpb.SimulateGradeChangeRequest,
+) (*gpb.SimulateGradeChangeResponse, error) {
+	claims, err := s.getClaims(ctx, req.GetToken())
+	if err != nil {
+		return nil, fmt.Errorf("authentication failed: %w",
+			status.Error(codes.Unauthenticated, err.Error()))
+	}
+
+	if !claims.HasRole(roleStaff) && !claims.HasRole(roleAdmin) && callerIdentity(claims) != req.GetStudentID() {
+		return nil, fmt.Errorf("authorization failed: %w",
+			status.Error(codes.PermissionDenied, "students may only simulate their own grades"))
+	}
+
+	logger := klog.FromContext(ctx)
+	logger.V(logLevelDebug).Info("Received request to simulate grade change", "student_id", req.GetStudentID(),
+		"semester", req.GetSemester(), "overrides", len(req.GetOverrides()))
+
+	if len(req.GetOverrides()) > maxGradeOverrides {
+		return nil, fmt.Errorf("failed to simulate grade change: %w", status.Error(codes.InvalidArgument,
+			fmt.Sprintf("%v: %d, maximum is %d", ErrTooManyOverrides, len(req.GetOverrides()), maxGradeOverrides)))
+	}
+
+	for _, override := range req.GetOverrides() {
+		if err := s.validator.ValidateGradeValue(override.GetGradeValue()); err != nil {
+			return nil, fmt.Errorf("failed to simulate grade change: %w",
+				status.Error(codes.InvalidArgument, err.Error()))
+		}
+	}
+
+	grades, err := s.db.GetStudentGrades(ctx, req.GetStudentID())
+	if errors.Is(err, ErrStudentIDEmpty) {
+		return nil, fmt.Errorf("failed to simulate grade change: %w",
+			status.Error(codes.InvalidArgument, err.Error()))
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to simulate grade change: %w", err)
+	}
+
+	// Grades hidden from the student must not show through the GPA.
+	embargo, err := s.loadEmbargo(ctx, gradeViewRole(claims))
+	if err != nil {
+		return nil, err
+	}
+
+	grades = embargo.released(grades)
+
+	simulated, err := applyGradeOverrides(req.GetStudentID(), grades, req.GetOverrides())
+	if err != nil {
+		return nil, fmt.Errorf("failed to simulate grade change: %w",
+			status.Error(codes.InvalidArgument, err.Error()))
+	}
+
+	currentSemester, _ := computeGPA(semesterGrades(grades, req.GetSemester()), s.weights)
+	currentCumulative, _ := computeGPA(grades, s.weights)
+	semesterGPA, semesterCourses := computeGPA(semesterGrades(simulated, req.GetSemester()), s.weights)
+	cumulativeGPA, cumulativeCourses := computeGPA(simulated, s.weights)
+
+	return &gpb.SimulateGradeChangeResponse{
+		SemesterGpa:           s.rounder.RoundNumber(semesterGPA),
+		CumulativeGpa:         s.rounder.RoundNumber(cumulativeGPA),
+		CurrentSemesterGpa:    s.rounder.RoundNumber(currentSemester),
+		CurrentCumulativeGpa:  s.rounder.RoundNumber(currentCumulative),
+		SemesterCourseCount:   int32(semesterCourses),
+		CumulativeCourseCount: int32(cumulativeCourses),
+	}, nil
+}
+
+// leaderboardEntry is a student's standing in a semester.
+type leaderboardEntry struct {
+	studentID string
+	gpa       float64
+	courses   int
+}
+
+// rankStudents computes the GPA of every student with a numeric grade and returns the top limit
+// of them, best first. Ties are broken by the number of courses, more first, then by student ID.
+func rankStudents(grades []*Grade, weights GradeWeights, limit int) []leaderboardEntry {
+	students := make(map[string][]*Grade)
+	for _, grade := range grades {
+		students[grade.StudentID] = append(students[grade.StudentID], grade)
+	}
+
+	entries := make([]leaderboardEntry, 0, len(students))
+
+	for studentID, studentGrades := range students {
+		if gpa, courses := computeGPA(studentGrades, weights); courses > 0 {
+			entries = append(entries, leaderboardEntry{studentID: studentID, gpa: gpa, courses: courses})
+		}
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		switch {
+		case entries[i].gpa != entries[j].gpa:
+			return entries[i].gpa > entries[j].gpa
+		case entries[i].courses != entries[j].courses:
+			return entries[i].courses > entries[j].courses
+		default:
+			return entries[i].studentID < entries[j].studentID
+		}
+	})
+
+	return entries[:min(limit, len(entries))]
+}
+
+// leaderboardSize returns the number of students to rank: the requested number, capped by maxSize,
+// or maxSize itself when none is requested. A maxSize of zero or less means the default cap.
+func leaderboardSize(requested, maxSize int) int {
+	if maxSize <= 0 {
+		maxSize = defaultMaxLeaderboardSize
+	}
+
+	if requested <= 0 || requested > maxSize {
+		return maxSize
+	}
+
+	return requested
+}
+
+// GetSemesterLeaderboard ranks the students of a semester by GPA across all their courses and
+// returns the top ones. Students without numeric grades are left out. Requires the admin role.
+func (s *GradesServer) GetSemesterLeaderboard(ctx context.Context,
+	req *gpb.GetSemesterLeaderboardRequest,
+) (*gpb.GetSemesterLeaderboardResponse, error) {
+	if err := s.requireRole(ctx, req.GetToken(), roleAdmin); err != nil {
+		return nil, err
+	}
+
+	logger := klog.FromContext(ctx)
+	logger.V(logLevelDebug).Info("Received request for semester leaderboard", "semester", req.GetSemester(),
+		"limit", req.GetLimit())
+
+	var grades []*Grade
+
+	err := s.db.StreamSemesterGrades(ctx, req.GetSemester(), func(grade *Grade) error {
+		grades = append(grades, grade)
+
+		return nil
+	})
+	if errors.Is(err, ErrSemesterEmpty) {
+		return nil, fmt.Errorf("failed to get semester leaderboard: %w",
+			status.Error(codes.InvalidArgument, err.Error()))
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to get semester leaderboard: %w", err)
+	}
+
+	entries := rankStudents(grades, s.weights, leaderboardSize(int(req.GetLimit()), s.maxLeaderboardSize))
+
+	resp := &gpb.GetSemesterLeaderboardResponse{Entries: make([]*gpb.LeaderboardEntry, 0, len(entries))}
+	for i, entry := range entries {
+		resp.Entries = append(resp.Entries, &gpb.LeaderboardEntry{
+			Rank:        int32(i + 1),
+			StudentID:   entry.studentID,
+			Gpa:         s.rounder.RoundNumber(entry.gpa),
+			CourseCount: int32(entry.courses),
+		})
+	}
+
+	return resp, nil
+}
+
+// courseCredits returns the credits of the courses of the grades, as known to the course service.
+// Lookup failures never fail the caller; the courses are left out and weigh equally instead.
+func courseCredits(ctx context.Context, resolver CourseResolver, grades []*Grade) map[string]float64 {
+	courseIDs := uniqueCourseIDs(grades)
+	if resolver == nil || len(courseIDs) == 0 {
+		return nil
+	}
+
+	courses, err := resolver.GetCourses(ctx, courseIDs)
+	if err != nil {
+		klog.FromContext(ctx).Error(err, "Failed to resolve course credits, weighing courses equally",
+			"course_ids", courseIDs)
+
+		return nil
+	}
+
+	credits := make(map[string]float64, len(courses))
+
+	for courseID, course := range courses {
+		if course != nil {
+			credits[courseID] = course.Credits
+		}
+	}
+
+	return credits
+}
+
+// GetStudentSemesterGPA returns a student's semester GPA: the grade points of their letter grades on
+// the GRADE_POINT_SCALE scale, averaged with each grade weighted by the credits of its course when
+// the course service knows them. Grades the scale does not cover, such as numeric and pass/fail
+// grades, are excluded and counted apart. Students may only get their own GPA; staff and admins may
+// get anyone's.
+func (s *GradesServer) GetStudentSemesterGPA(ctx context.Context,
+	req *gpb.GetStudentSemesterGPARequest,
+) (*gpb.GetStudentSemesterGPAResponse, error) {
+	claims, err := s.getClaims(ctx, req.GetToken())
+	if err != nil {
+		return nil, fmt.Errorf("authentication failed: %w",
+			status.Error(codes.Unauthenticated, err.Error()))
+	}
+
+	if !claims.HasRole(roleStaff) && !claims.HasRole(roleAdmin) && callerIdentity(claims) != req.GetStudentID() {
+		return nil, fmt.Errorf("authorization failed: %w",
+			status.Error(codes.PermissionDenied, "students may only get their own GPA"))
+	}
+
+	logger := klog.FromContext(ctx)
+	logger.V(logLevelDebug).Info("Received request for student semester GPA", "student_id", req.GetStudentID(),
+		"semester", req.GetSemester())
+
+	if req.GetSemester() == "" {
+		return nil, fmt.Errorf("failed to get student semester GPA: %w",
+			status.Error(codes.InvalidArgument, ErrSemesterEmpty.Error()))
+	}
+
+	grades, err := s.db.GetStudentSemesterGrades(ctx, req.GetStudentID(), req.GetSemester())
+	if errors.Is(err, ErrStudentIDEmpty) {
+		return nil, fmt.Errorf("failed to get student semester GPA: %w",
+			status.Error(codes.InvalidArgument, err.Error()))
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to get student semester GPA: %w", err)
+	}
+
+	// Grades hidden from the student must not show through the GPA.
+	embargo, err := s.loadEmbargo(ctx, gradeViewRole(claims))
+	if err != nil {
+		return nil, err
+	}
+
+	grades = embargo.released(grades)
+
+	scale := s.gradeScale
+	if len(scale) == 0 {
+		if scale, err = parseGradeScale(""); err != nil {
+			return nil, fmt.Errorf("failed to get student semester GPA: %w", err)
+		}
+	}
+
+	average := computeGradePointAverage(grades, scale, courseCredits(ctx, s.courses, grades))
+
+	return &gpb.GetStudentSemesterGPAResponse{
+		Gpa:           math.Round(average.gpa*100) / 100,
+		IncludedCount: int32(average.included),
+		ExcludedCount: int32(average.excluded),
+	}, nil
+}
@@ -0,0 +1,268 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	gpb "github.com/BetterGR/grades-microservice/protos"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestComputeGPA(t *testing.T) {
+	grades := []*Grade{
+		{CourseID: "c1", Semester: "Winter_2024", GradeType: "Homework", GradeValue: "80"},
+		{CourseID: "c1", Semester: "Winter_2024", GradeType: "Final", GradeValue: "100"},
+		{CourseID: "c2", Semester: "Winter_2024", GradeType: "Final", GradeValue: "70"},
+		{CourseID: "c3", Semester: "Winter_2024", GradeType: "Final", GradeValue: "A"},
+	}
+
+	// c1 averages 90 and c2 70; c3 has no numeric grade.
+	gpa, courses := computeGPA(grades, nil)
+	assert.InDelta(t, 80, gpa, 1e-9)
+	assert.Equal(t, 2, courses)
+
+	// With weights, c1 scores 0.25*80 + 0.75*100 = 95.
+	gpa, _ = computeGPA(grades, GradeWeights{"homework": 25, "final": 75})
+	assert.InDelta(t, 82.5, gpa, 1e-9)
+
+	gpa, courses = computeGPA(nil, nil)
+	assert.Zero(t, gpa)
+	assert.Zero(t, courses)
+}
+
+func TestApplyGradeOverrides(t *testing.T) {
+	stored := &Grade{GradeID: "g1", StudentID: "s1", CourseID: "c1", Semester: "Winter_2024", GradeValue: "60"}
+
+	merged, err := applyGradeOverrides("s1", []*Grade{stored}, []*gpb.GradeOverride{
+		{GradeID: "g1", GradeValue: "90"},
+		{CourseID: "c2", Semester: "Winter_2024", GradeValue: "75"},
+	})
+	require.NoError(t, err)
+	require.Len(t, merged, 2)
+	assert.Equal(t, "90", merged[0].GradeValue)
+	assert.Equal(t, "60", stored.GradeValue, "stored grades must not be modified")
+	assert.Equal(t, "c2", merged[1].CourseID)
+	assert.Equal(t, "s1", merged[1].StudentID)
+
+	_, err = applyGradeOverrides("s1", []*Grade{stored}, []*gpb.GradeOverride{{GradeID: "g2", GradeValue: "90"}})
+	require.ErrorIs(t, err, ErrOverrideUnknown)
+
+	_, err = applyGradeOverrides("s1", nil, []*gpb.GradeOverride{{CourseID: "c2", GradeValue: "90"}})
+	require.ErrorIs(t, err, ErrOverrideIncomplete)
+
+	_, err = applyGradeOverrides("s1", []*Grade{stored}, []*gpb.GradeOverride{{GradeID: "g1"}})
+	require.ErrorIs(t, err, ErrOverrideValueEmpty)
+}
+
+func TestSimulateGradeChange(t *testing.T) {
+	db := NewMockDatabase()
+	client := setupClient(t, func(s *GradesServer) { s.db = db })
+
+	add := func(courseID, semester, value string) *Grade {
+		t.Helper()
+
+		grade, err := db.AddGrade(context.Background(), &gpb.SingleGrade{
+			StudentID: "student-1", CourseID: courseID, Semester: semester, GradeType: "Final",
+			ItemID: courseID + semester, GradeValue: value,
+		})
+		require.NoError(t, err)
+
+		return grade
+	}
+
+	failed := add("234218", "Winter_2024", "60")
+	add("234247", "Winter_2024", "80")
+	add("104031", "Spring_2023", "100")
+
+	resp, err := client.SimulateGradeChange(context.Background(), &gpb.SimulateGradeChangeRequest{
+		Token: "test-token", StudentID: "student-1", Semester: "Winter_2024",
+		Overrides: []*gpb.GradeOverride{
+			{GradeID: failed.GradeID, GradeValue: "90"},
+			{CourseID: "236363", Semester: "Winter_2024", GradeType: "Final", GradeValue: "70"},
+		},
+	})
+	require.NoError(t, err)
+	assert.InDelta(t, 70, resp.GetCurrentSemesterGpa(), 1e-9)
+	assert.InDelta(t, 80, resp.GetCurrentCumulativeGpa(), 1e-9)
+	assert.InDelta(t, 80, resp.GetSemesterGpa(), 1e-9)
+	assert.InDelta(t, 85, resp.GetCumulativeGpa(), 1e-9)
+	assert.Equal(t, int32(3), resp.GetSemesterCourseCount())
+	assert.Equal(t, int32(4), resp.GetCumulativeCourseCount())
+
+	// The simulation writes nothing.
+	stored, err := db.GetGradeByID(context.Background(), failed.GradeID)
+	require.NoError(t, err)
+	assert.Equal(t, "60", stored.GradeValue)
+
+	grades, err := db.GetStudentGrades(context.Background(), "student-1")
+	require.NoError(t, err)
+	assert.Len(t, grades, 3)
+
+	_, err = client.SimulateGradeChange(context.Background(), &gpb.SimulateGradeChangeRequest{
+		Token: "test-token", StudentID: "student-1",
+		Overrides: []*gpb.GradeOverride{{GradeID: "unknown", GradeValue: "90"}},
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestSimulateGradeChangeOnlyOwnGrades(t *testing.T) {
+	client := setupClient(t, func(s *GradesServer) {
+		verifiedCaller(roleStudent)(s)
+	})
+
+	_, err := client.SimulateGradeChange(context.Background(),
+		&gpb.SimulateGradeChangeRequest{Token: studentToken, StudentID: "student-2"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = client.SimulateGradeChange(context.Background(),
+		&gpb.SimulateGradeChangeRequest{Token: studentToken, StudentID: "student-1"})
+	require.NoError(t, err)
+}
+
+func TestRankStudents(t *testing.T) {
+	grade := func(studentID, courseID, value string) *Grade {
+		return &Grade{StudentID: studentID, CourseID: courseID, Semester: "Winter_2024", GradeValue: value}
+	}
+
+	grades := []*Grade{
+		grade("carol", "c1", "90"),
+		grade("alice", "c1", "80"),
+		grade("alice", "c2", "100"),
+		grade("bob", "c1", "90"),
+		grade("dave", "c1", "95"),
+		grade("dave", "c2", "85"),
+		grade("erin", "c1", "A"),
+	}
+
+	entries := rankStudents(grades, nil, 10)
+
+	// Alice, Bob, Carol and Dave all average 90: Alice and Dave took more courses, and equal
+	// standings are ordered by student ID. Erin has no numeric grade.
+	var order []string
+	for _, entry := range entries {
+		order = append(order, entry.studentID)
+		assert.InDelta(t, 90, entry.gpa, 1e-9)
+	}
+
+	assert.Equal(t, []string{"alice", "dave", "bob", "carol"}, order)
+
+	entries = rankStudents(append(grades, grade("frank", "c1", "99")), nil, 2)
+	require.Len(t, entries, 2)
+	assert.Equal(t, "frank", entries[0].studentID)
+	assert.Equal(t, "alice", entries[1].studentID)
+}
+
+func TestLeaderboardSize(t *testing.T) {
+	assert.Equal(t, 10, leaderboardSize(10, 50))
+	assert.Equal(t, 50, leaderboardSize(0, 50))
+	assert.Equal(t, 50, leaderboardSize(500, 50))
+	assert.Equal(t, defaultMaxLeaderboardSize, leaderboardSize(0, 0))
+}
+
+func TestGetSemesterLeaderboard(t *testing.T) {
+	db := NewMockDatabase()
+	admin := setupClient(t, func(s *GradesServer) {
+		s.db = db
+		s.maxLeaderboardSize = 2
+	})
+
+	for _, grade := range []*gpb.SingleGrade{
+		{StudentID: "s1", CourseID: "c1", Semester: "Winter_2024", ItemID: "final", GradeValue: "70"},
+		{StudentID: "s2", CourseID: "c1", Semester: "Winter_2024", ItemID: "final", GradeValue: "95"},
+		{StudentID: "s3", CourseID: "c1", Semester: "Winter_2024", ItemID: "final", GradeValue: "95"},
+		{StudentID: "s4", CourseID: "c1", Semester: "Spring_2024", ItemID: "final", GradeValue: "100"},
+	} {
+		_, err := db.AddGrade(context.Background(), grade)
+		require.NoError(t, err)
+	}
+
+	resp, err := admin.GetSemesterLeaderboard(context.Background(),
+		&gpb.GetSemesterLeaderboardRequest{Token: "test-token", Semester: "Winter_2024", Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, resp.GetEntries(), 2, "the limit is capped")
+	assert.Equal(t, int32(1), resp.GetEntries()[0].GetRank())
+	assert.Equal(t, "s2", resp.GetEntries()[0].GetStudentID())
+	assert.Equal(t, "s3", resp.GetEntries()[1].GetStudentID())
+	assert.InDelta(t, 95, resp.GetEntries()[1].GetGpa(), 1e-9)
+	assert.Equal(t, int32(1), resp.GetEntries()[1].GetCourseCount())
+
+	_, err = admin.GetSemesterLeaderboard(context.Background(), &gpb.GetSemesterLeaderboardRequest{Token: "test-token"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	staff := setupClient(t, func(s *GradesServer) { s.Claims = RoleClaims{role: roleStaff} })
+	_, err = staff.GetSemesterLeaderboard(context.Background(),
+		&gpb.GetSemesterLeaderboardRequest{Token: "test-token", Semester: "Winter_2024"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestGetStudentSemesterGPA(t *testing.T) {
+	db := NewMockDatabase()
+	resolver := &MockCourseResolver{catalog: map[string]*CourseInfo{
+		"c1": {CourseID: "c1", Credits: 4},
+		"c2": {CourseID: "c2", Credits: 2},
+	}}
+	client := setupClient(t, func(s *GradesServer) {
+		s.db = db
+		s.courses = resolver
+	})
+
+	for _, grade := range []*gpb.SingleGrade{
+		{StudentID: "s1", CourseID: "c1", Semester: "Winter_2024", GradeValue: "A-"},
+		{StudentID: "s1", CourseID: "c2", Semester: "Winter_2024", GradeValue: "C+"},
+		{StudentID: "s1", CourseID: "c3", Semester: "Winter_2024", GradeValue: "Pass"},
+		{StudentID: "s1", CourseID: "c4", Semester: "Winter_2024", GradeValue: "88"},
+		{StudentID: "s1", CourseID: "c1", Semester: "Spring_2024", GradeValue: "F"},
+	} {
+		_, err := db.AddGrade(context.Background(), grade)
+		require.NoError(t, err)
+	}
+
+	resp, err := client.GetStudentSemesterGPA(context.Background(), &gpb.GetStudentSemesterGPARequest{
+		Token: "test-token", StudentID: "s1", Semester: "Winter_2024",
+	})
+	require.NoError(t, err)
+	// (3.7 * 4 + 2.3 * 2) / 6.
+	assert.InDelta(t, 3.23, resp.GetGpa(), 1e-9)
+	assert.Equal(t, int32(2), resp.GetIncludedCount())
+	assert.Equal(t, int32(2), resp.GetExcludedCount())
+
+	custom := setupClient(t, func(s *GradesServer) {
+		s.db = db
+		s.gradeScale = GradeScale{"A-": 9, "C+": 6, "PASS": 10}
+	})
+	resp, err = custom.GetStudentSemesterGPA(context.Background(), &gpb.GetStudentSemesterGPARequest{
+		Token: "test-token", StudentID: "s1", Semester: "Winter_2024",
+	})
+	require.NoError(t, err)
+	assert.InDelta(t, 8.33, resp.GetGpa(), 1e-9)
+	assert.Equal(t, int32(3), resp.GetIncludedCount())
+	assert.Equal(t, int32(1), resp.GetExcludedCount())
+
+	resp, err = client.GetStudentSemesterGPA(context.Background(), &gpb.GetStudentSemesterGPARequest{
+		Token: "test-token", StudentID: "s2", Semester: "Winter_2024",
+	})
+	require.NoError(t, err)
+	assert.Zero(t, resp.GetGpa())
+	assert.Zero(t, resp.GetIncludedCount())
+
+	_, err = client.GetStudentSemesterGPA(context.Background(),
+		&gpb.GetStudentSemesterGPARequest{Token: "test-token", StudentID: "s1"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestGetStudentSemesterGPAOnlyOwnGPA(t *testing.T) {
+	client := setupClient(t, func(s *GradesServer) {
+		verifiedCaller(roleStudent)(s)
+	})
+
+	_, err := client.GetStudentSemesterGPA(context.Background(),
+		&gpb.GetStudentSemesterGPARequest{Token: studentToken, StudentID: "student-2", Semester: "Winter_2024"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = client.GetStudentSemesterGPA(context.Background(),
+		&gpb.GetStudentSemesterGPARequest{Token: studentToken, StudentID: "student-1", Semester: "Winter_2024"})
+	require.NoError(t, err)
+}
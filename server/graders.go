@@ -0,0 +1,152 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	gpb "github.com/BetterGR/grades-microservice/protos"
+	ms "github.com/TekClinic/MicroService-Lib"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/klog/v2"
+)
+
+// authorizeGrader ensures the caller may grade the course: admins always may, and other callers
+// must be on the course's allow-list unless the course has none.
+func (s *GradesServer) authorizeGrader(ctx context.Context, claims ms.Claims, courseID string) error {
+	if claims.HasRole(roleAdmin) || courseID == "" {
+		return nil
+	}
+
+	graders, err := s.db.GetCourseGraders(ctx, courseID)
+	if err != nil {
+		return fmt.Errorf("failed to get course graders: %w", err)
+	}
+
+	if len(graders) == 0 || slices.Contains(graders, callerIdentity(claims)) {
+		return nil
+	}
+
+	return fmt.Errorf("authorization failed: %w",
+		status.Error(codes.PermissionDenied, "caller may not grade course "+courseID))
+}
+
+// courseGraderChecks authorizes the caller to grade courses for the length of a request touching
+// many grades, checking each course once.
+type courseGraderChecks struct {
+	server  *GradesServer
+	claims  ms.Claims
+	courses map[string]error
+}
+
+// newCourseGraderChecks creates the checks for the caller with the given claims.
+func (s *GradesServer) newCourseGraderChecks(claims ms.Claims) *courseGraderChecks {
+	return &courseGraderChecks{server: s, claims: claims, courses: make(map[string]error)}
+}
+
+// authorize returns the outcome of authorizeGrader for the course.
+func (c *courseGraderChecks) authorize(ctx context.Context, courseID string) error {
+	err, ok := c.courses[courseID]
+	if !ok {
+		err = c.server.authorizeGrader(ctx, c.claims, courseID)
+		c.courses[courseID] = err
+	}
+
+	return err
+}
+
+// authorizeGradeUpdate ensures the caller may grade the course of an existing grade, and the course
+// the update moves it to, if any. Unknown grades are left for the update to report.
+func (s *GradesServer) authorizeGradeUpdate(ctx context.Context, claims ms.Claims, grade *gpb.SingleGrade) error {
+	if claims.HasRole(roleAdmin) {
+		return nil
+	}
+
+	existing, err := s.db.GetGradeByID(ctx, grade.GetGradeID())
+	if errors.Is(err, ErrGradeNotFound) || errors.Is(err, ErrGradeIDEmpty) {
+		return nil
+	} else if err != nil {
+		return fmt.Errorf("failed to get grade: %w", err)
+	}
+
+	if err := s.authorizeGrader(ctx, claims, existing.CourseID); err != nil {
+		return err
+	}
+
+	if grade.GetCourseID() != "" && grade.GetCourseID() != existing.CourseID {
+		return s.authorizeGrader(ctx, claims, grade.GetCourseID())
+	}
+
+	return nil
+}
+
+// courseGraderStatus reports invalid course grader requests as InvalidArgument.
+func courseGraderStatus(err error) error {
+	if errors.Is(err, ErrCourseIDEmpty) || errors.Is(err, ErrGraderIDEmpty) {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return err
+}
+
+// AddCourseGrader allows a grader to grade a course, restricting the course to its listed graders.
+// Requires the admin role.
+func (s *GradesServer) AddCourseGrader(ctx context.Context,
+	req *gpb.AddCourseGraderRequest,
+) (*gpb.AddCourseGraderResponse, error) {
+	if err := s.requireRole(ctx, req.GetToken(), roleAdmin); err != nil {
+		return nil, err
+	}
+
+	logger := klog.FromContext(ctx)
+	logger.V(logLevelDebug).Info("Received request to add course grader", "course_id", req.GetCourseID(),
+		"grader_id", req.GetGraderID())
+
+	if err := s.db.AddCourseGrader(ctx, req.GetCourseID(), req.GetGraderID()); err != nil {
+		return nil, fmt.Errorf("failed to add course grader: %w", courseGraderStatus(err))
+	}
+
+	return &gpb.AddCourseGraderResponse{}, nil
+}
+
+// RemoveCourseGrader removes a grader from the graders of a course. Removing the last grader lifts
+// the restriction. Requires the admin role.
+func (s *GradesServer) RemoveCourseGrader(ctx context.Context,
+	req *gpb.RemoveCourseGraderRequest,
+) (*gpb.RemoveCourseGraderResponse, error) {
+	if err := s.requireRole(ctx, req.GetToken(), roleAdmin); err != nil {
+		return nil, err
+	}
+
+	logger := klog.FromContext(ctx)
+	logger.V(logLevelDebug).Info("Received request to remove course grader", "course_id", req.GetCourseID(),
+		"grader_id", req.GetGraderID())
+
+	if err := s.db.RemoveCourseGrader(ctx, req.GetCourseID(), req.GetGraderID()); err != nil {
+		return nil, fmt.Errorf("failed to remove course grader: %w", courseGraderStatus(err))
+	}
+
+	return &gpb.RemoveCourseGraderResponse{}, nil
+}
+
+// GetCourseGraders returns the graders allowed to grade a course, empty when anyone may. Requires the
+// staff or admin role.
+func (s *GradesServer) GetCourseGraders(ctx context.Context,
+	req *gpb.GetCourseGradersRequest,
+) (*gpb.GetCourseGradersResponse, error) {
+	if err := s.requireRole(ctx, req.GetToken(), roleStaff, roleAdmin); err != nil {
+		return nil, err
+	}
+
+	logger := klog.FromContext(ctx)
+	logger.V(logLevelDebug).Info("Received request for course graders", "course_id", req.GetCourseID())
+
+	graders, err := s.db.GetCourseGraders(ctx, req.GetCourseID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get course graders: %w", courseGraderStatus(err))
+	}
+
+	return &gpb.GetCourseGradersResponse{GraderIDs: graders}, nil
+}
@@ -0,0 +1,201 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+
+	gpb "github.com/BetterGR/grades-microservice/protos"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// setupGraderClient returns a client for staff graders, identified by the token they send, backed by db.
+func setupGraderClient(t *testing.T, db *MockDatabase) gpb.GradesServiceClient {
+	t.Helper()
+
+	return setupClient(t, func(s *GradesServer) {
+		s.db = db
+		verifiedCaller(roleStaff)(s)
+	})
+}
+
+func TestCourseGradersRestrictGrading(t *testing.T) {
+	db := NewMockDatabase()
+	admin := setupClient(t, func(s *GradesServer) {
+		s.db = db
+		s.Claims = RoleClaims{role: roleAdmin}
+	})
+	grader := setupGraderClient(t, db)
+	allowed, denied := tokenFor("ta-1"), tokenFor("ta-2")
+
+	grade := createTestGrade()
+
+	_, err := admin.AddCourseGrader(context.Background(), &gpb.AddCourseGraderRequest{
+		Token: "test-token", CourseID: grade.GetCourseID(), GraderID: "ta-1",
+	})
+	require.NoError(t, err)
+
+	graders, err := grader.GetCourseGraders(context.Background(), &gpb.GetCourseGradersRequest{
+		Token: allowed, CourseID: grade.GetCourseID(),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"ta-1"}, graders.GetGraderIDs())
+
+	_, err = grader.AddSingleGrade(context.Background(), &gpb.AddSingleGradeRequest{Token: denied, Grade: grade})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = grader.AddSingleGrade(context.Background(), &gpb.AddSingleGradeRequest{Token: allowed, Grade: grade})
+	require.NoError(t, err)
+
+	update := func(token string) *gpb.UpdateSingleGradeRequest {
+		return &gpb.UpdateSingleGradeRequest{
+			Token: token, Grade: &gpb.SingleGrade{GradeID: grade.GetGradeID(), GradeValue: "B"},
+		}
+	}
+
+	_, err = grader.UpdateSingleGrade(context.Background(), update(denied))
+	assert.Equal(t, codes.PermissionDenied, status.Code(err), "the course of the stored grade is checked")
+
+	_, err = grader.UpdateSingleGrade(context.Background(), update(allowed))
+	require.NoError(t, err)
+
+	_, err = admin.UpdateSingleGrade(context.Background(), update("test-token"))
+	require.NoError(t, err, "administrators may always grade")
+
+	remove := func(token string) *gpb.RemoveSingleGradeRequest {
+		return &gpb.RemoveSingleGradeRequest{Token: token, GradeID: grade.GetGradeID()}
+	}
+
+	_, err = grader.RemoveSingleGrade(context.Background(), remove(denied))
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = grader.RemoveSingleGrade(context.Background(), remove(allowed))
+	require.NoError(t, err)
+}
+
+func TestCourseGradersRestrictBulkWrites(t *testing.T) {
+	db := NewMockDatabase()
+	grader := setupGraderClient(t, db)
+	allowed, denied := tokenFor("ta-1"), tokenFor("ta-2")
+
+	require.NoError(t, db.AddCourseGrader(context.Background(), "restricted", "ta-1"))
+
+	restricted, open := createTestGrade(), createTestGrade()
+	restricted.CourseID, open.CourseID = "restricted", "open"
+
+	stream, err := grader.BatchAddGradesStream(context.Background(), &gpb.BatchAddGradesRequest{
+		Token: denied, Grades: []*gpb.SingleGrade{restricted, open},
+	})
+	require.NoError(t, err)
+
+	var summary *gpb.BatchAddGradesProgress
+
+	for {
+		progress, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		require.NoError(t, err)
+
+		if progress.GetDone() {
+			summary = progress
+		} else if len(progress.GetErrors()) > 0 {
+			assert.Equal(t, int32(0), progress.GetErrors()[0].GetIndex())
+		}
+	}
+
+	require.NotNil(t, summary)
+	assert.Equal(t, int32(1), summary.GetAddedCount(), "only the course without graders is written")
+	assert.Equal(t, int32(1), summary.GetErrorCount())
+
+	importReq := func(token string, dryRun bool) *gpb.ImportGradesCSVRequest {
+		return &gpb.ImportGradesCSVRequest{
+			Token:   token,
+			Content: []byte("Student,Grade\ns1,90\n"),
+			DryRun:  dryRun,
+			Mapping: &gpb.ImportColumnMapping{
+				Columns:  map[string]string{"student_id": "Student", "grade_value": "Grade"},
+				Defaults: map[string]string{"course_id": "restricted", "semester": "Winter_2023"},
+			},
+		}
+	}
+
+	for _, dryRun := range []bool{true, false} {
+		imported, err := grader.ImportGradesCSV(context.Background(), importReq(denied, dryRun))
+		require.NoError(t, err)
+		assert.Zero(t, imported.GetImportedCount())
+		assert.Empty(t, imported.GetGrades())
+		require.Len(t, imported.GetErrors(), 1, "dry run %v", dryRun)
+		assert.Equal(t, int32(2), imported.GetErrors()[0].GetLine())
+	}
+
+	imported, err := grader.ImportGradesCSV(context.Background(), importReq(allowed, false))
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), imported.GetImportedCount())
+
+	assign := func(token string) error {
+		_, err := grader.AssignDefaultToUngraded(context.Background(), &gpb.AssignDefaultToUngradedRequest{
+			Token: token, CourseID: "restricted", Semester: "Winter_2023", ItemID: "hw1",
+			StudentIDs: []string{"s2"}, DefaultValue: "0",
+		})
+
+		return err
+	}
+
+	assert.Equal(t, codes.PermissionDenied, status.Code(assign(denied)))
+	require.NoError(t, assign(allowed))
+}
+
+func TestCourseGradersEmptyListUnrestricted(t *testing.T) {
+	db := NewMockDatabase()
+	admin := setupClient(t, func(s *GradesServer) {
+		s.db = db
+		s.Claims = RoleClaims{role: roleAdmin}
+	})
+	grader := setupGraderClient(t, db)
+	token := tokenFor("ta-2")
+
+	grade := createTestGrade()
+	_, err := grader.AddSingleGrade(context.Background(), &gpb.AddSingleGradeRequest{Token: token, Grade: grade})
+	require.NoError(t, err, "a course without graders may be graded by anyone")
+
+	_, err = admin.AddCourseGrader(context.Background(), &gpb.AddCourseGraderRequest{
+		Token: "test-token", CourseID: grade.GetCourseID(), GraderID: "ta-1",
+	})
+	require.NoError(t, err)
+
+	_, err = admin.RemoveCourseGrader(context.Background(), &gpb.RemoveCourseGraderRequest{
+		Token: "test-token", CourseID: grade.GetCourseID(), GraderID: "ta-1",
+	})
+	require.NoError(t, err)
+
+	_, err = grader.UpdateSingleGrade(context.Background(), &gpb.UpdateSingleGradeRequest{
+		Token: token, Grade: &gpb.SingleGrade{GradeID: grade.GetGradeID(), GradeValue: "B"},
+	})
+	require.NoError(t, err, "removing the last grader lifts the restriction")
+}
+
+func TestCourseGraderManagement(t *testing.T) {
+	staff := setupClient(t, func(s *GradesServer) {
+		s.Claims = RoleClaims{role: roleStaff}
+	})
+
+	_, err := staff.AddCourseGrader(context.Background(), &gpb.AddCourseGraderRequest{
+		Token: "test-token", CourseID: "course", GraderID: "ta-1",
+	})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err), "only administrators manage graders")
+
+	admin := setupClient(t, func(s *GradesServer) {
+		s.Claims = RoleClaims{role: roleAdmin}
+	})
+
+	_, err = admin.AddCourseGrader(context.Background(), &gpb.AddCourseGraderRequest{
+		Token: "test-token", CourseID: "course",
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
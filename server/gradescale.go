@@ -0,0 +1,112 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// defaultGradeScaleSpec is the 4.0 scale letter grades are converted with when GRADE_POINT_SCALE is unset.
+const defaultGradeScaleSpec = "A+:4.0,A:4.0,A-:3.7,B+:3.3,B:3.0,B-:2.7,C+:2.3,C:2.0,C-:1.7,D+:1.3,D:1.0,D-:0.7,F:0"
+
+var ErrGradeScaleInvalid = errors.New("invalid grade point scale")
+
+// GradeScale maps a letter grade, in upper case, to its grade points. Values missing from the
+// scale, such as numeric and pass/fail grades, carry no grade points.
+type GradeScale map[string]float64
+
+// parseGradeScale parses a "letter:points[,letter:points]" spec such as "A:4,B:3,C:2,D:1,F:0".
+// An empty spec yields the default 4.0 scale.
+func parseGradeScale(spec string) (GradeScale, error) {
+	if strings.TrimSpace(spec) == "" {
+		spec = defaultGradeScaleSpec
+	}
+
+	scale := GradeScale{}
+
+	for _, entry := range strings.Split(spec, ",") {
+		if strings.TrimSpace(entry) == "" {
+			continue
+		}
+
+		letter, rawPoints, ok := strings.Cut(entry, ":")
+		if !ok {
+			return nil, fmt.Errorf("%w: entry %q is not letter:points", ErrGradeScaleInvalid, entry)
+		}
+
+		letter = strings.ToUpper(strings.TrimSpace(letter))
+		if letter == "" {
+			return nil, fmt.Errorf("%w: empty letter", ErrGradeScaleInvalid)
+		}
+
+		points, err := strconv.ParseFloat(strings.TrimSpace(rawPoints), 64)
+		if err != nil || math.IsNaN(points) || math.IsInf(points, 0) || points < 0 {
+			return nil, fmt.Errorf("%w: points of %q must be a non-negative number, got %q",
+				ErrGradeScaleInvalid, letter, strings.TrimSpace(rawPoints))
+		}
+
+		if _, duplicate := scale[letter]; duplicate {
+			return nil, fmt.Errorf("%w: %q is listed twice", ErrGradeScaleInvalid, letter)
+		}
+
+		scale[letter] = points
+	}
+
+	if len(scale) == 0 {
+		return nil, fmt.Errorf("%w: no letters", ErrGradeScaleInvalid)
+	}
+
+	return scale, nil
+}
+
+// points returns the grade points of a grade value, ignoring case and surrounding whitespace. It
+// reports false for values the scale does not cover.
+func (s GradeScale) points(value string) (float64, bool) {
+	points, ok := s[strings.ToUpper(strings.TrimSpace(value))]
+
+	return points, ok
+}
+
+// gradePointAverage is the grade point average of a set of grades.
+type gradePointAverage struct {
+	gpa      float64
+	included int
+	excluded int
+}
+
+// computeGradePointAverage averages the grade points of the grades the scale covers, each weighted
+// by the credits of its course; courses missing from credits, or worth no credits, weigh 1. Grades
+// the scale does not cover are excluded and counted apart. The average is zero when no grade is
+// included.
+func computeGradePointAverage(grades []*Grade, scale GradeScale, credits map[string]float64) gradePointAverage {
+	var (
+		average             gradePointAverage
+		weightedSum, weight float64
+	)
+
+	for _, grade := range grades {
+		points, ok := scale.points(grade.GradeValue)
+		if !ok {
+			average.excluded++
+
+			continue
+		}
+
+		gradeWeight := credits[grade.CourseID]
+		if gradeWeight <= 0 {
+			gradeWeight = 1
+		}
+
+		weightedSum += points * gradeWeight
+		weight += gradeWeight
+		average.included++
+	}
+
+	if weight > 0 {
+		average.gpa = weightedSum / weight
+	}
+
+	return average
+}
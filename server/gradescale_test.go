@@ -0,0 +1,87 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseGradeScale(t *testing.T) {
+	scale, err := parseGradeScale("")
+	require.NoError(t, err)
+	assert.Len(t, scale, 13)
+	assert.InDelta(t, 3.7, scale["A-"], 1e-9)
+	assert.InDelta(t, 0, scale["F"], 1e-9)
+
+	scale, err = parseGradeScale(" a : 5 , b:4,, f:0 ")
+	require.NoError(t, err)
+	assert.Equal(t, GradeScale{"A": 5, "B": 4, "F": 0}, scale)
+
+	for _, spec := range []string{"A", "A:four", "A:-1", "A:NaN", ":3", "A:4,a:3", ","} {
+		_, err := parseGradeScale(spec)
+		require.ErrorIs(t, err, ErrGradeScaleInvalid, spec)
+	}
+}
+
+func TestGradeScalePoints(t *testing.T) {
+	scale, err := parseGradeScale("")
+	require.NoError(t, err)
+
+	tests := []struct {
+		value  string
+		points float64
+		ok     bool
+	}{
+		{"A+", 4, true},
+		{"A", 4, true},
+		{" b+ ", 3.3, true},
+		{"B-", 2.7, true},
+		{"D-", 0.7, true},
+		{"F", 0, true},
+		{"P", 0, false},
+		{"Pass", 0, false},
+		{"Fail", 0, false},
+		{"85", 0, false},
+		{"", 0, false},
+	}
+
+	for _, tt := range tests {
+		points, ok := scale.points(tt.value)
+		assert.Equal(t, tt.ok, ok, tt.value)
+		assert.InDelta(t, tt.points, points, 1e-9, tt.value)
+	}
+}
+
+func TestComputeGradePointAverage(t *testing.T) {
+	scale, err := parseGradeScale("")
+	require.NoError(t, err)
+
+	grades := []*Grade{
+		{CourseID: "c1", GradeValue: "A"},
+		{CourseID: "c2", GradeValue: "B"},
+		{CourseID: "c3", GradeValue: "P"},
+		{CourseID: "c4", GradeValue: "91"},
+	}
+
+	tests := []struct {
+		name    string
+		credits map[string]float64
+		gpa     float64
+	}{
+		{"unweighted", nil, 3.5},
+		{"weighted by credits", map[string]float64{"c1": 3, "c2": 1}, 3.75},
+		{"unknown courses weigh 1", map[string]float64{"c1": 3}, 3.75},
+		{"courses without credits weigh 1", map[string]float64{"c1": 0, "c2": 0}, 3.5},
+	}
+
+	for _, tt := range tests {
+		average := computeGradePointAverage(grades, scale, tt.credits)
+		assert.InDelta(t, tt.gpa, average.gpa, 1e-9, tt.name)
+		assert.Equal(t, 2, average.included, tt.name)
+		assert.Equal(t, 2, average.excluded, tt.name)
+	}
+
+	assert.Equal(t, gradePointAverage{excluded: 1}, computeGradePointAverage([]*Grade{{GradeValue: "Pass"}}, scale, nil))
+	assert.Equal(t, gradePointAverage{}, computeGradePointAverage(nil, scale, nil))
+}
@@ -0,0 +1,155 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	gpb "github.com/BetterGR/grades-microservice/protos"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/klog/v2"
+)
+
+const (
+	// defaultHonorRollMinGPA is the semester GPA the honor roll requires when HONOR_ROLL_MIN_GPA is unset.
+	defaultHonorRollMinGPA = 90
+	// defaultHonorRollMinCourses is the number of courses a student needs to be considered for the
+	// honor roll when HONOR_ROLL_MIN_COURSES is unset.
+	defaultHonorRollMinCourses = 3
+)
+
+// Honor roll statuses.
+const (
+	honorRollQualified    = "qualified"
+	honorRollNotQualified = "not_qualified"
+	honorRollNotEligible  = "not_eligible"
+)
+
+var ErrCourseCreditsUnavailable = errors.New("course credits are unavailable without a course service")
+
+// HonorRollPolicy decides who makes the honor roll of a semester: students with enough courses and
+// credits in the semester whose GPA reaches the threshold.
+type HonorRollPolicy struct {
+	// MinGPA is the lowest semester GPA on the honor roll.
+	MinGPA float64
+	// MinCourses is the number of courses with numeric grades a student needs to be eligible.
+	MinCourses int
+	// MinCredits is the credits of those courses a student needs to be eligible; zero requires none.
+	MinCredits float64
+}
+
+// honorRollPolicyFromEnv reads the honor roll policy from HONOR_ROLL_MIN_GPA, HONOR_ROLL_MIN_COURSES
+// and HONOR_ROLL_MIN_CREDITS.
+func honorRollPolicyFromEnv() HonorRollPolicy {
+	return HonorRollPolicy{
+		MinGPA:     envFloat("HONOR_ROLL_MIN_GPA", defaultHonorRollMinGPA),
+		MinCourses: max(envInt("HONOR_ROLL_MIN_COURSES", defaultHonorRollMinCourses), 0),
+		MinCredits: max(envFloat("HONOR_ROLL_MIN_CREDITS", 0), 0),
+	}
+}
+
+// status returns the honor roll status of a student with the GPA over courses worth credits.
+func (p HonorRollPolicy) status(gpa float64, courses int, credits float64) string {
+	switch {
+	case courses == 0 || courses < p.MinCourses || credits < p.MinCredits:
+		return honorRollNotEligible
+	case gpa >= p.MinGPA:
+		return honorRollQualified
+	default:
+		return honorRollNotQualified
+	}
+}
+
+// semesterCredits sums the credits of the courses with numeric grades, as known to the course
+// service. Courses it cannot resolve count no credits.
+func semesterCredits(ctx context.Context, resolver CourseResolver, grades []*Grade) (float64, error) {
+	courseIDs := uniqueCourseIDs(segmentGrades(grades).numeric)
+	if len(courseIDs) == 0 {
+		return 0, nil
+	}
+
+	courses, err := resolver.GetCourses(ctx, courseIDs)
+	if err != nil {
+		return 0, fmt.Errorf("failed to resolve courses: %w", err)
+	}
+
+	var credits float64
+
+	for _, courseID := range courseIDs {
+		if course, ok := courses[courseID]; ok && course != nil {
+			credits += course.Credits
+		}
+	}
+
+	return credits, nil
+}
+
+// GetHonorRollStatus reports whether a student makes the honor roll of a semester, comparing the
+// semester GPA to the configured threshold. Students with too few courses or credits are not
+// eligible, whatever their GPA. Students may only check their own status; staff and admins may
+// check anyone's.
+func (s *GradesServer) GetHonorRollStatus(ctx context.Context,
+	req *gpb.GetHonorRollStatusRequest,
+) (*gpb.GetHonorRollStatusResponse, error) {
+	claims, err := s.getClaims(ctx, req.GetToken())
+	if err != nil {
+		return nil, fmt.Errorf("authentication failed: %w",
+			status.Error(codes.Unauthenticated, err.Error()))
+	}
+
+	if !claims.HasRole(roleStaff) && !claims.HasRole(roleAdmin) && callerIdentity(claims) != req.GetStudentID() {
+		return nil, fmt.Errorf("authorization failed: %w",
+			status.Error(codes.PermissionDenied, "students may only check their own honor roll status"))
+	}
+
+	logger := klog.FromContext(ctx)
+	logger.V(logLevelDebug).Info("Received request for honor roll status", "student_id", req.GetStudentID(),
+		"semester", req.GetSemester())
+
+	if req.GetSemester() == "" {
+		return nil, fmt.Errorf("failed to get honor roll status: %w",
+			status.Error(codes.InvalidArgument, ErrSemesterEmpty.Error()))
+	}
+
+	policy := s.honorRoll
+	if policy.MinCredits > 0 && s.courses == nil {
+		return nil, fmt.Errorf("failed to get honor roll status: %w",
+			status.Error(codes.FailedPrecondition, ErrCourseCreditsUnavailable.Error()))
+	}
+
+	grades, err := s.db.GetStudentSemesterGrades(ctx, req.GetStudentID(), req.GetSemester())
+	if errors.Is(err, ErrStudentIDEmpty) {
+		return nil, fmt.Errorf("failed to get honor roll status: %w",
+			status.Error(codes.InvalidArgument, err.Error()))
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to get honor roll status: %w", err)
+	}
+
+	// Grades hidden from the student must not show through the status.
+	embargo, err := s.loadEmbargo(ctx, gradeViewRole(claims))
+	if err != nil {
+		return nil, err
+	}
+
+	grades = embargo.released(grades)
+	gpa, courses := computeGPA(grades, s.weights)
+
+	var credits float64
+	if policy.MinCredits > 0 {
+		if credits, err = semesterCredits(ctx, s.courses, grades); err != nil {
+			return nil, fmt.Errorf("failed to get honor roll status: %w",
+				status.Error(codes.Unavailable, err.Error()))
+		}
+	}
+
+	return &gpb.GetHonorRollStatusResponse{
+		Status:      policy.status(gpa, courses, credits),
+		Gpa:         s.rounder.RoundNumber(gpa),
+		Threshold:   policy.MinGPA,
+		CourseCount: int32(courses),
+		MinCourses:  int32(policy.MinCourses),
+		Credits:     credits,
+		MinCredits:  policy.MinCredits,
+	}, nil
+}
@@ -0,0 +1,134 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	gpb "github.com/BetterGR/grades-microservice/protos"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestHonorRollPolicyStatus(t *testing.T) {
+	policy := HonorRollPolicy{MinGPA: 90, MinCourses: 2, MinCredits: 6}
+
+	assert.Equal(t, honorRollQualified, policy.status(90, 2, 6))
+	assert.Equal(t, honorRollNotQualified, policy.status(89.9, 3, 9))
+	assert.Equal(t, honorRollNotEligible, policy.status(100, 1, 9), "too few courses")
+	assert.Equal(t, honorRollNotEligible, policy.status(100, 2, 5), "too few credits")
+	assert.Equal(t, honorRollNotEligible, HonorRollPolicy{MinGPA: 90}.status(0, 0, 0), "no courses")
+}
+
+func TestGetHonorRollStatus(t *testing.T) {
+	db := NewMockDatabase()
+	client := setupClient(t, func(s *GradesServer) {
+		s.db = db
+		s.honorRoll = HonorRollPolicy{MinGPA: 90, MinCourses: 2}
+	})
+
+	for _, grade := range []*gpb.SingleGrade{
+		{StudentID: "s1", CourseID: "c1", Semester: "Winter_2024", GradeValue: "95"},
+		{StudentID: "s1", CourseID: "c2", Semester: "Winter_2024", GradeValue: "88"},
+		{StudentID: "s2", CourseID: "c1", Semester: "Winter_2024", GradeValue: "80"},
+		{StudentID: "s2", CourseID: "c2", Semester: "Winter_2024", GradeValue: "85"},
+		{StudentID: "s3", CourseID: "c1", Semester: "Winter_2024", GradeValue: "100"},
+		{StudentID: "s3", CourseID: "c2", Semester: "Winter_2024", GradeValue: "Pass"},
+	} {
+		_, err := db.AddGrade(context.Background(), grade)
+		require.NoError(t, err)
+	}
+
+	tests := []struct {
+		studentID string
+		status    string
+		gpa       float64
+		courses   int32
+	}{
+		{studentID: "s1", status: honorRollQualified, gpa: 91.5, courses: 2},
+		{studentID: "s2", status: honorRollNotQualified, gpa: 82.5, courses: 2},
+		// The pass/fail course does not count, which leaves s3 a course short.
+		{studentID: "s3", status: honorRollNotEligible, gpa: 100, courses: 1},
+	}
+
+	for _, tt := range tests {
+		resp, err := client.GetHonorRollStatus(context.Background(), &gpb.GetHonorRollStatusRequest{
+			Token: "test-token", StudentID: tt.studentID, Semester: "Winter_2024",
+		})
+		require.NoError(t, err, tt.studentID)
+		assert.Equal(t, tt.status, resp.GetStatus(), tt.studentID)
+		assert.InDelta(t, tt.gpa, resp.GetGpa(), 1e-9, tt.studentID)
+		assert.Equal(t, tt.courses, resp.GetCourseCount(), tt.studentID)
+		assert.InDelta(t, 90, resp.GetThreshold(), 1e-9)
+		assert.Equal(t, int32(2), resp.GetMinCourses())
+	}
+
+	_, err := client.GetHonorRollStatus(context.Background(),
+		&gpb.GetHonorRollStatusRequest{Token: "test-token", StudentID: "s1"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestGetHonorRollStatusCredits(t *testing.T) {
+	db := NewMockDatabase()
+	resolver := &MockCourseResolver{catalog: map[string]*CourseInfo{
+		"c1": {CourseID: "c1", Credits: 3},
+		"c2": {CourseID: "c2", Credits: 2},
+	}}
+	client := setupClient(t, func(s *GradesServer) {
+		s.db = db
+		s.courses = resolver
+		s.honorRoll = HonorRollPolicy{MinGPA: 90, MinCourses: 2, MinCredits: 6}
+	})
+
+	for _, courseID := range []string{"c1", "c2", "unknown"} {
+		_, err := db.AddGrade(context.Background(), &gpb.SingleGrade{
+			StudentID: "s1", CourseID: courseID, Semester: "Winter_2024", GradeValue: "95",
+		})
+		require.NoError(t, err)
+	}
+
+	resp, err := client.GetHonorRollStatus(context.Background(), &gpb.GetHonorRollStatusRequest{
+		Token: "test-token", StudentID: "s1", Semester: "Winter_2024",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, honorRollNotEligible, resp.GetStatus(), "the unknown course counts no credits")
+	assert.InDelta(t, 5, resp.GetCredits(), 1e-9)
+
+	resolver.catalog["c3"] = &CourseInfo{CourseID: "c3", Credits: 1}
+	_, err = db.AddGrade(context.Background(), &gpb.SingleGrade{
+		StudentID: "s1", CourseID: "c3", Semester: "Winter_2024", GradeValue: "90",
+	})
+	require.NoError(t, err)
+
+	resp, err = client.GetHonorRollStatus(context.Background(), &gpb.GetHonorRollStatusRequest{
+		Token: "test-token", StudentID: "s1", Semester: "Winter_2024",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, honorRollQualified, resp.GetStatus())
+	assert.InDelta(t, 6, resp.GetCredits(), 1e-9)
+
+	noCourses := setupClient(t, func(s *GradesServer) {
+		s.db = db
+		s.honorRoll = HonorRollPolicy{MinGPA: 90, MinCredits: 6}
+	})
+	_, err = noCourses.GetHonorRollStatus(context.Background(), &gpb.GetHonorRollStatusRequest{
+		Token: "test-token", StudentID: "s1", Semester: "Winter_2024",
+	})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
+
+func TestGetHonorRollStatusOnlyOwnStatus(t *testing.T) {
+	client := setupClient(t, func(s *GradesServer) {
+		verifiedCaller(roleStudent)(s)
+	})
+
+	_, err := client.GetHonorRollStatus(context.Background(),
+		&gpb.GetHonorRollStatusRequest{Token: studentToken, StudentID: "student-2", Semester: "Winter_2024"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	resp, err := client.GetHonorRollStatus(context.Background(),
+		&gpb.GetHonorRollStatusRequest{Token: studentToken, StudentID: "student-1", Semester: "Winter_2024"})
+	require.NoError(t, err)
+	assert.Equal(t, honorRollNotEligible, resp.GetStatus())
+}
@@ -0,0 +1,298 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	gpb "github.com/BetterGR/grades-microservice/protos"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/klog/v2"
+)
+
+// Value transforms an import mapping may apply to grade values, in the order given.
+const (
+	// transformStripPercent drops a trailing percent sign, as in "87%".
+	transformStripPercent = "strip_percent"
+	// transformDecimalComma reads a decimal comma as a decimal point, as in "87,5".
+	transformDecimalComma = "decimal_comma"
+	// transformUppercase uppercases letter grades, as in "b+".
+	transformUppercase = "uppercase"
+)
+
+var (
+	ErrImportMappingInvalid = errors.New("invalid import mapping")
+	ErrImportColumnMissing  = errors.New("mapped column missing from the file")
+	ErrImportFileInvalid    = errors.New("invalid import file")
+	ErrImportRowMalformed   = errors.New("row does not match the mapping")
+)
+
+// importFields maps the grade fields a file column may fill to their setters.
+var importFields = map[string]func(grade *gpb.SingleGrade, value string){
+	"student_id":  func(g *gpb.SingleGrade, v string) { g.StudentID = v },
+	"course_id":   func(g *gpb.SingleGrade, v string) { g.CourseID = v },
+	"semester":    func(g *gpb.SingleGrade, v string) { g.Semester = v },
+	"grade_type":  func(g *gpb.SingleGrade, v string) { g.GradeType = v },
+	"item_id":     func(g *gpb.SingleGrade, v string) { g.ItemID = v },
+	"grade_value": func(g *gpb.SingleGrade, v string) { g.GradeValue = v },
+	"graded_by":   func(g *gpb.SingleGrade, v string) { g.GradedBy = v },
+	"comments":    func(g *gpb.SingleGrade, v string) { g.Comments = v },
+}
+
+// importMapping turns the rows of a file exported by some student information system into grades.
+type importMapping struct {
+	// columns maps each mapped grade field to the index of its column in the file.
+	columns map[string]int
+	// defaults fills the fields no column maps, such as a course ID missing from the file.
+	defaults map[string]string
+	// transforms apply to grade values, in order.
+	transforms []string
+	// width is the number of columns of the header.
+	width int
+}
+
+// newImportMapping checks the mapping of a request against the header of the file.
+func newImportMapping(mapping *gpb.ImportColumnMapping, header []string) (*importMapping, error) {
+	m := &importMapping{
+		columns:  make(map[string]int, len(mapping.GetColumns())),
+		defaults: mapping.GetDefaults(),
+		width:    len(header),
+	}
+
+	positions := make(map[string]int, len(header))
+	for i, name := range header {
+		positions[normalizeImportHeader(name)] = i
+	}
+
+	for field, column := range mapping.GetColumns() {
+		if _, ok := importFields[field]; !ok {
+			return nil, fmt.Errorf("%w: unknown grade field %q", ErrImportMappingInvalid, field)
+		}
+
+		index, ok := positions[normalizeImportHeader(column)]
+		if !ok {
+			return nil, fmt.Errorf("%w: %q for %s", ErrImportColumnMissing, column, field)
+		}
+
+		m.columns[field] = index
+	}
+
+	for field := range m.defaults {
+		if _, ok := importFields[field]; !ok {
+			return nil, fmt.Errorf("%w: unknown grade field %q", ErrImportMappingInvalid, field)
+		}
+	}
+
+	for _, field := range []string{"student_id", "grade_value"} {
+		if _, ok := m.columns[field]; !ok {
+			return nil, fmt.Errorf("%w: no column maps %s", ErrImportMappingInvalid, field)
+		}
+	}
+
+	for _, transform := range mapping.GetValueTransforms() {
+		switch transform {
+		case transformStripPercent, transformDecimalComma, transformUppercase:
+			m.transforms = append(m.transforms, transform)
+		default:
+			return nil, fmt.Errorf("%w: unknown value transform %q", ErrImportMappingInvalid, transform)
+		}
+	}
+
+	return m, nil
+}
+
+// normalizeImportHeader matches column names regardless of case, surrounding spaces and the byte
+// order mark some spreadsheets write before the first one.
+func normalizeImportHeader(name string) string {
+	return strings.ToLower(strings.TrimSpace(strings.TrimPrefix(name, "\ufeff")))
+}
+
+// transformValue applies the value transforms to a grade value.
+func (m *importMapping) transformValue(value string) string {
+	for _, transform := range m.transforms {
+		switch transform {
+		case transformStripPercent:
+			value = strings.TrimSpace(strings.TrimSuffix(value, "%"))
+		case transformDecimalComma:
+			value = strings.Replace(value, ",", ".", 1)
+		case transformUppercase:
+			value = strings.ToUpper(value)
+		}
+	}
+
+	return value
+}
+
+// grade maps a row of the file to a grade. Rows with a different number of columns than the
+// header, or without a student or value, do not match the mapping.
+func (m *importMapping) grade(row []string) (*gpb.SingleGrade, error) {
+	if len(row) != m.width {
+		return nil, fmt.Errorf("%w: %d columns, the header has %d", ErrImportRowMalformed, len(row), m.width)
+	}
+
+	grade := &gpb.SingleGrade{}
+
+	for field, value := range m.defaults {
+		importFields[field](grade, value)
+	}
+
+	for field, index := range m.columns {
+		if value := strings.TrimSpace(row[index]); value != "" {
+			importFields[field](grade, value)
+		}
+	}
+
+	grade.GradeValue = m.transformValue(grade.GetGradeValue())
+
+	switch {
+	case grade.GetStudentID() == "":
+		return nil, fmt.Errorf("%w: %w", ErrImportRowMalformed, ErrStudentIDEmpty)
+	case grade.GetGradeValue() == "":
+		return nil, fmt.Errorf("%w: grade value is empty", ErrImportRowMalformed)
+	}
+
+	return grade, nil
+}
+
+// importRows reads a CSV file and maps its rows to grades. Rows that do not match the mapping are
+// reported by line and left out; lines[i] is the line of grades[i].
+func importRows(content []byte, mapping *gpb.ImportColumnMapping) ([]*gpb.SingleGrade, []int32,
+	[]*gpb.ImportRowError, error,
+) {
+	reader := csv.NewReader(bytes.NewReader(content))
+	reader.FieldsPerRecord = -1
+
+	if delimiter := []rune(mapping.GetDelimiter()); len(delimiter) == 1 {
+		reader.Comma = delimiter[0]
+	} else if len(delimiter) > 1 {
+		return nil, nil, nil, fmt.Errorf("%w: delimiter must be a single character", ErrImportMappingInvalid)
+	}
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("%w: no header: %w", ErrImportFileInvalid, err)
+	}
+
+	m, err := newImportMapping(mapping, header)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	var (
+		grades []*gpb.SingleGrade
+		lines  []int32
+		errs   []*gpb.ImportRowError
+	)
+
+	for {
+		row, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			var parseErr *csv.ParseError
+			if !errors.As(err, &parseErr) {
+				return nil, nil, nil, fmt.Errorf("%w: %w", ErrImportFileInvalid, err)
+			}
+
+			errs = append(errs, &gpb.ImportRowError{Line: int32(parseErr.StartLine), Message: err.Error()})
+
+			continue
+		}
+
+		if len(grades)+len(errs) >= maxBatchGrades {
+			return nil, nil, nil, fmt.Errorf("%w: more than %d rows", ErrTooManyGrades, maxBatchGrades)
+		}
+
+		line, _ := reader.FieldPos(0)
+
+		grade, err := m.grade(row)
+		if err != nil {
+			errs = append(errs, &gpb.ImportRowError{Line: int32(line), Message: err.Error()})
+
+			continue
+		}
+
+		grades = append(grades, grade)
+		lines = append(lines, int32(line))
+	}
+
+	return grades, lines, errs, nil
+}
+
+// ImportGradesCSV adds the grades of a CSV file exported by a student information system. The
+// mapping in the request names the file's column for each grade field, fills fields the file
+// lacks and transforms values, so files of differing layouts can be imported as they are. Rows
+// that do not match the mapping or fail validation are reported by line; the rest are added in
+// chunks like a batch add. A dry run reports the grades without adding them. Requires the staff or
+// admin role; rows of courses the caller may not grade are reported.
+func (s *GradesServer) ImportGradesCSV(ctx context.Context,
+	req *gpb.ImportGradesCSVRequest,
+) (*gpb.ImportGradesCSVResponse, error) {
+	claims, err := s.authorizeRoles(ctx, req.GetToken(), roleStaff, roleAdmin)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx = withActor(ctx, callerIdentity(claims))
+
+	logger := klog.FromContext(ctx)
+	logger.V(logLevelDebug).Info("Received request to import grades", "bytes", len(req.GetContent()),
+		"dry_run", req.GetDryRun())
+
+	grades, lines, errs, err := importRows(req.GetContent(), req.GetMapping())
+	if err != nil {
+		return nil, fmt.Errorf("failed to import grades: %w", status.Error(codes.InvalidArgument, err.Error()))
+	}
+
+	resp := &gpb.ImportGradesCSVResponse{}
+	schemes := s.newGradeTypeSchemes()
+	graders := s.newCourseGraderChecks(claims)
+
+	if req.GetDryRun() {
+		for i, grade := range grades {
+			err := s.validateNewGrade(ctx, schemes, grade)
+			if err == nil {
+				err = graders.authorize(ctx, grade.GetCourseID())
+			}
+
+			if err != nil {
+				errs = append(errs, &gpb.ImportRowError{Line: lines[i], Message: err.Error()})
+
+				continue
+			}
+
+			resp.Grades = append(resp.Grades, grade)
+		}
+	} else {
+		for start := 0; start < len(grades); start += batchAddChunkSize {
+			end := min(start+batchAddChunkSize, len(grades))
+
+			added, chunkErrs, err := s.addGradeChunk(ctx, schemes, graders, grades[start:end], start)
+			if err != nil {
+				return nil, fmt.Errorf("import aborted after %d grades: %w", resp.GetImportedCount(), err)
+			}
+
+			resp.ImportedCount += int32(added)
+
+			for _, chunkErr := range chunkErrs {
+				errs = append(errs, &gpb.ImportRowError{Line: lines[chunkErr.GetIndex()], Message: chunkErr.GetMessage()})
+			}
+		}
+	}
+
+	sort.SliceStable(errs, func(i, j int) bool {
+		return errs[i].GetLine() < errs[j].GetLine()
+	})
+
+	resp.Errors = errs
+
+	return resp, nil
+}
@@ -0,0 +1,139 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	gpb "github.com/BetterGR/grades-microservice/protos"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestImportMappingTransforms(t *testing.T) {
+	m := &importMapping{transforms: []string{transformStripPercent, transformDecimalComma, transformUppercase}}
+
+	assert.Equal(t, "87.5", m.transformValue("87,5 %"))
+	assert.Equal(t, "B+", m.transformValue("b+"))
+	assert.Equal(t, "90", (&importMapping{}).transformValue("90"), "values are kept without transforms")
+}
+
+func TestImportGradesCSVFormats(t *testing.T) {
+	client := setupClient(t)
+
+	formats := map[string]*gpb.ImportGradesCSVRequest{
+		"comma separated with percentages": {
+			Content: []byte("ID,Course,Term,Final Grade\n" +
+				"s1,234218,Winter_2023,87.5%\n" +
+				"s2,234218,Winter_2023,92 %\n"),
+			Mapping: &gpb.ImportColumnMapping{
+				Columns: map[string]string{
+					"student_id": "ID", "course_id": "Course", "semester": "Term", "grade_value": "Final Grade",
+				},
+				Defaults:        map[string]string{"grade_type": "final"},
+				ValueTransforms: []string{transformStripPercent},
+			},
+		},
+		"semicolon separated with decimal commas": {
+			Content: []byte("\ufeffstudent number;TYPE;score\n" +
+				"s1;final;87,5\n" +
+				"s2;final;92\n"),
+			Mapping: &gpb.ImportColumnMapping{
+				Columns: map[string]string{
+					"student_id": "Student Number", "grade_type": "Type", "grade_value": "Score",
+				},
+				Defaults:        map[string]string{"course_id": "234218", "semester": "Winter_2023"},
+				ValueTransforms: []string{transformDecimalComma},
+				Delimiter:       ";",
+			},
+		},
+	}
+
+	var want []*gpb.SingleGrade
+
+	for name, req := range formats {
+		req.Token, req.DryRun = "test-token", true
+
+		resp, err := client.ImportGradesCSV(context.Background(), req)
+		require.NoError(t, err, name)
+		assert.Empty(t, resp.GetErrors(), name)
+		assert.Zero(t, resp.GetImportedCount(), "a dry run adds nothing")
+		require.Len(t, resp.GetGrades(), 2, name)
+
+		if want == nil {
+			want = resp.GetGrades()
+
+			continue
+		}
+
+		for i, grade := range resp.GetGrades() {
+			assert.True(t, proto.Equal(want[i], grade), "%s: %v != %v", name, want[i], grade)
+		}
+	}
+
+	assert.Equal(t, "s1", want[0].GetStudentID())
+	assert.Equal(t, "234218", want[0].GetCourseID())
+	assert.Equal(t, "Winter_2023", want[0].GetSemester())
+	assert.Equal(t, "final", want[0].GetGradeType())
+	assert.Equal(t, "87.5", want[0].GetGradeValue())
+}
+
+func TestImportGradesCSV(t *testing.T) {
+	db := NewMockDatabase()
+	client := setupClient(t, func(s *GradesServer) {
+		s.db = db
+	})
+
+	resp, err := client.ImportGradesCSV(context.Background(), &gpb.ImportGradesCSVRequest{
+		Token: "test-token",
+		Content: []byte("Student,Grade\n" +
+			"s1,90\n" +
+			"s2\n" +
+			",80\n" +
+			"s3,\n" +
+			"s4,75\n"),
+		Mapping: &gpb.ImportColumnMapping{
+			Columns:  map[string]string{"student_id": "Student", "grade_value": "Grade"},
+			Defaults: map[string]string{"course_id": "c1", "semester": "Winter_2023"},
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), resp.GetImportedCount())
+
+	var lines []int32
+	for _, rowErr := range resp.GetErrors() {
+		lines = append(lines, rowErr.GetLine())
+	}
+
+	assert.Equal(t, []int32{3, 4, 5}, lines, "rows that do not match the mapping are reported by line")
+
+	grades, err := db.GetCourseGrades(context.Background(), "c1", "Winter_2023")
+	require.NoError(t, err)
+	assert.Len(t, grades, 2)
+}
+
+func TestImportGradesCSVInvalidMapping(t *testing.T) {
+	client := setupClient(t)
+	content := []byte("Student,Grade\ns1,90\n")
+
+	for name, mapping := range map[string]*gpb.ImportColumnMapping{
+		"missing column": {Columns: map[string]string{"student_id": "Student", "grade_value": "Final Grade"}},
+		"unknown field":  {Columns: map[string]string{"student_id": "Student", "grade_value": "Grade", "gpa": "Grade"}},
+		"no value":       {Columns: map[string]string{"student_id": "Student"}},
+		"unknown transform": {
+			Columns:         map[string]string{"student_id": "Student", "grade_value": "Grade"},
+			ValueTransforms: []string{"round"},
+		},
+	} {
+		_, err := client.ImportGradesCSV(context.Background(), &gpb.ImportGradesCSVRequest{
+			Token: "test-token", Content: content, Mapping: mapping,
+		})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err), name)
+	}
+
+	student := setupClient(t, func(s *GradesServer) { s.Claims = RoleClaims{role: roleStudent} })
+	_, err := student.ImportGradesCSV(context.Background(), &gpb.ImportGradesCSVRequest{Token: "test-token"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
@@ -0,0 +1,239 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	gpb "github.com/BetterGR/grades-microservice/protos"
+	"github.com/uptrace/bun"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// institutionHeader is the metadata key naming the institution a request targets.
+const institutionHeader = "x-institution-id"
+
+var (
+	ErrInstitutionRequired = errors.New("institution ID is required")
+	ErrInstitutionMismatch = errors.New("request targets another institution")
+)
+
+// institutionClaims is implemented by claims that name the caller's institution.
+type institutionClaims interface {
+	GetInstitutionID() string
+}
+
+// tokenRequest is implemented by every request carrying the caller's token.
+type tokenRequest interface {
+	GetToken() string
+}
+
+// gradeRequest is implemented by requests carrying a single grade.
+type gradeRequest interface {
+	GetGrade() *gpb.SingleGrade
+}
+
+// gradesRequest is implemented by requests carrying several grades.
+type gradesRequest interface {
+	GetGrades() []*gpb.SingleGrade
+}
+
+type institutionContextKey struct{}
+
+// withInstitution returns a context scoping every grade query to the institution.
+func withInstitution(ctx context.Context, institutionID string) context.Context {
+	return context.WithValue(ctx, institutionContextKey{}, institutionID)
+}
+
+// institutionFromContext returns the institution the context is scoped to, or "" when unscoped.
+func institutionFromContext(ctx context.Context) string {
+	institutionID, _ := ctx.Value(institutionContextKey{}).(string)
+
+	return institutionID
+}
+
+// scopeInstitution restricts a grade query to the institution of the context.
+func scopeInstitution(ctx context.Context) func(*bun.SelectQuery) *bun.SelectQuery {
+	return func(query *bun.SelectQuery) *bun.SelectQuery {
+		if institutionID := institutionFromContext(ctx); institutionID != "" {
+			query.Where("?TableAlias.institution_id = ?", institutionID)
+		}
+
+		return query
+	}
+}
+
+var (
+	_ bun.BeforeSelectHook = (*Grade)(nil)
+	_ bun.BeforeUpdateHook = (*Grade)(nil)
+	_ bun.BeforeDeleteHook = (*Grade)(nil)
+)
+
+// BeforeSelect scopes grade reads to the institution of the context.
+func (*Grade) BeforeSelect(ctx context.Context, query *bun.SelectQuery) error {
+	scopeInstitution(ctx)(query)
+
+	return nil
+}
+
+// BeforeUpdate scopes grade updates to the institution of the context.
+func (*Grade) BeforeUpdate(ctx context.Context, query *bun.UpdateQuery) error {
+	if institutionID := institutionFromContext(ctx); institutionID != "" {
+		query.Where("?TableAlias.institution_id = ?", institutionID)
+	}
+
+	return nil
+}
+
+// BeforeDelete scopes grade deletes to the institution of the context.
+func (*Grade) BeforeDelete(ctx context.Context, query *bun.DeleteQuery) error {
+	if institutionID := institutionFromContext(ctx); institutionID != "" {
+		query.Where("?TableAlias.institution_id = ?", institutionID)
+	}
+
+	return nil
+}
+
+// requestedInstitution returns the institution a request targets, taken from the institution
+// header and the grades it carries, or "" when it names none. Conflicting institutions are an error.
+func requestedInstitution(ctx context.Context, req interface{}) (string, error) {
+	var named []string
+
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		named = append(named, md.Get(institutionHeader)...)
+	}
+
+	if r, ok := req.(gradeRequest); ok {
+		named = append(named, r.GetGrade().GetInstitutionID())
+	}
+
+	if r, ok := req.(gradesRequest); ok {
+		for _, grade := range r.GetGrades() {
+			named = append(named, grade.GetInstitutionID())
+		}
+	}
+
+	var institutionID string
+
+	for _, name := range named {
+		switch {
+		case name == "":
+		case institutionID == "":
+			institutionID = name
+		case name != institutionID:
+			return "", fmt.Errorf("%w", ErrInstitutionMismatch)
+		}
+	}
+
+	return institutionID, nil
+}
+
+// resolveInstitution returns the institution a request is scoped to. The institution in the caller's
+// claims always wins and a request naming another one is denied. Callers whose claims name no
+// institution must name one in the request, which only administrators may do.
+func (s *GradesServer) resolveInstitution(ctx context.Context, req interface{}) (string, error) {
+	var token string
+	if r, ok := req.(tokenRequest); ok {
+		token = r.GetToken()
+	}
+
+	claims, err := s.getClaims(ctx, token)
+	if err != nil {
+		return "", fmt.Errorf("authentication failed: %w", status.Error(codes.Unauthenticated, err.Error()))
+	}
+
+	requested, err := requestedInstitution(ctx, req)
+	if err != nil {
+		return "", fmt.Errorf("authorization failed: %w", status.Error(codes.PermissionDenied, err.Error()))
+	}
+
+	var claimed string
+	if c, ok := claims.(institutionClaims); ok {
+		claimed = c.GetInstitutionID()
+	}
+
+	switch {
+	case claimed != "" && requested != "" && requested != claimed:
+		return "", fmt.Errorf("authorization failed: %w",
+			status.Error(codes.PermissionDenied, ErrInstitutionMismatch.Error()))
+	case claimed != "":
+		return claimed, nil
+	case requested == "":
+		return "", fmt.Errorf("failed to resolve institution: %w",
+			status.Error(codes.InvalidArgument, ErrInstitutionRequired.Error()))
+	case !claims.HasRole(roleAdmin):
+		return "", fmt.Errorf("authorization failed: %w",
+			status.Error(codes.PermissionDenied, "only administrators may choose the institution"))
+	default:
+		return requested, nil
+	}
+}
+
+// institutionInterceptor scopes each unary request to the caller's institution when multi-tenancy
+// is enabled.
+func (s *GradesServer) institutionInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, _ *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		if !s.multiTenant {
+			return handler(ctx, req)
+		}
+
+		institutionID, err := s.resolveInstitution(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+
+		return handler(withInstitution(ctx, institutionID), req)
+	}
+}
+
+// institutionStreamInterceptor scopes each streaming request to the caller's institution when
+// multi-tenancy is enabled.
+func (s *GradesServer) institutionStreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, _ *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		if !s.multiTenant {
+			return handler(srv, stream)
+		}
+
+		return handler(srv, &institutionStream{ServerStream: stream, server: s})
+	}
+}
+
+// institutionStream resolves the institution from the request once it is received, and scopes
+// the stream context to it from then on.
+type institutionStream struct {
+	grpc.ServerStream
+	server *GradesServer
+	ctx    context.Context
+}
+
+// RecvMsg receives the request and resolves its institution.
+func (st *institutionStream) RecvMsg(m interface{}) error {
+	if err := st.ServerStream.RecvMsg(m); err != nil {
+		return fmt.Errorf("failed to receive request: %w", err)
+	}
+
+	institutionID, err := st.server.resolveInstitution(st.ServerStream.Context(), m)
+	if err != nil {
+		return err
+	}
+
+	st.ctx = withInstitution(st.ServerStream.Context(), institutionID)
+
+	return nil
+}
+
+// Context returns the stream context, scoped to the institution once the request is received.
+func (st *institutionStream) Context() context.Context {
+	if st.ctx != nil {
+		return st.ctx
+	}
+
+	return st.ServerStream.Context()
+}
@@ -0,0 +1,170 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	gpb "github.com/BetterGR/grades-microservice/protos"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// institutionToken returns a token for a member of the institution, for use with verifiedCaller.
+func institutionToken(institutionID string) string {
+	return tokenWithClaims(map[string]string{"sub": "staff@" + institutionID, "institution_id": institutionID})
+}
+
+// setupInstitutionClient returns a multi-tenant staff client that verifies tokens, backed by db.
+// Callers act for the institution of the token they send.
+func setupInstitutionClient(t *testing.T, db *MockDatabase) gpb.GradesServiceClient {
+	t.Helper()
+
+	return setupClient(t, verifiedCaller(roleStaff), func(s *GradesServer) {
+		s.db = db
+		s.multiTenant = true
+	})
+}
+
+func TestRequestedInstitution(t *testing.T) {
+	header := metadata.NewIncomingContext(context.Background(), metadata.Pairs(institutionHeader, "uni-a"))
+
+	institutionID, err := requestedInstitution(header, &gpb.GetCourseGradesRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, "uni-a", institutionID)
+
+	institutionID, err = requestedInstitution(context.Background(),
+		&gpb.AddSingleGradeRequest{Grade: &gpb.SingleGrade{InstitutionID: "uni-b"}})
+	require.NoError(t, err)
+	assert.Equal(t, "uni-b", institutionID)
+
+	institutionID, err = requestedInstitution(context.Background(), &gpb.GetCourseGradesRequest{})
+	require.NoError(t, err)
+	assert.Empty(t, institutionID)
+
+	_, err = requestedInstitution(header, &gpb.AddSingleGradeRequest{Grade: &gpb.SingleGrade{InstitutionID: "uni-b"}})
+	require.ErrorIs(t, err, ErrInstitutionMismatch)
+}
+
+func TestInstitutionIsolation(t *testing.T) {
+	client := setupInstitutionClient(t, NewMockDatabase())
+	tokenA, tokenB := institutionToken("uni-a"), institutionToken("uni-b")
+
+	grade := createTestGrade()
+	_, err := client.AddSingleGrade(context.Background(), &gpb.AddSingleGradeRequest{Token: tokenA, Grade: grade})
+	require.NoError(t, err)
+
+	courseReq := &gpb.GetCourseGradesRequest{
+		Token: tokenA, CourseID: grade.GetCourseID(), Semester: grade.GetSemester(),
+	}
+
+	own, err := client.GetCourseGrades(context.Background(), courseReq)
+	require.NoError(t, err)
+	require.Len(t, own.GetGrades(), 1)
+	assert.Equal(t, "uni-a", own.GetGrades()[0].GetInstitutionID())
+
+	courseReq.Token = tokenB
+	other, err := client.GetCourseGrades(context.Background(), courseReq)
+	require.NoError(t, err)
+	assert.Empty(t, other.GetGrades(), "grades of another institution must not be visible")
+
+	_, err = client.GetGrade(context.Background(), &gpb.GetGradeRequest{Token: tokenB, GradeID: grade.GetGradeID()})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = client.RemoveSingleGrade(context.Background(), &gpb.RemoveSingleGradeRequest{
+		Token: tokenB, GradeID: grade.GetGradeID(),
+	})
+	require.Error(t, err)
+
+	_, err = client.GetGrade(context.Background(), &gpb.GetGradeRequest{Token: tokenA, GradeID: grade.GetGradeID()})
+	require.NoError(t, err, "the grade must survive removal attempts from another institution")
+}
+
+func TestInstitutionCrossTenantRequestDenied(t *testing.T) {
+	client := setupInstitutionClient(t, NewMockDatabase())
+	token := institutionToken("uni-b")
+
+	ctx := metadata.AppendToOutgoingContext(context.Background(), institutionHeader, "uni-a")
+	_, err := client.GetCourseGrades(ctx, &gpb.GetCourseGradesRequest{
+		Token: token, CourseID: "course", Semester: "Winter_2023",
+	})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	grade := createTestGrade()
+	grade.InstitutionID = "uni-a"
+	_, err = client.AddSingleGrade(context.Background(), &gpb.AddSingleGradeRequest{Token: token, Grade: grade})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestInstitutionRequired(t *testing.T) {
+	client := setupClient(t, func(s *GradesServer) {
+		s.multiTenant = true
+		s.Claims = RoleClaims{role: roleStaff}
+	})
+
+	req := &gpb.GetCourseGradesRequest{Token: "test-token", CourseID: "course", Semester: "Winter_2023"}
+	_, err := client.GetCourseGrades(context.Background(), req)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	ctx := metadata.AppendToOutgoingContext(context.Background(), institutionHeader, "uni-a")
+	_, err = client.GetCourseGrades(ctx, req)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err), "only administrators may choose the institution")
+
+	admin := setupClient(t, func(s *GradesServer) {
+		s.multiTenant = true
+		s.Claims = RoleClaims{role: roleAdmin}
+	})
+	_, err = admin.GetCourseGrades(ctx, req)
+	require.NoError(t, err)
+}
+
+func TestInstitutionCourseSettingsIsolated(t *testing.T) {
+	db := NewMockDatabase()
+	client := setupClient(t, verifiedCaller(roleAdmin), func(s *GradesServer) {
+		s.db = db
+		s.multiTenant = true
+	})
+	tokenA, tokenB := institutionToken("uni-a"), institutionToken("uni-b")
+
+	_, err := client.AddCourseGrader(context.Background(),
+		&gpb.AddCourseGraderRequest{Token: tokenA, CourseID: "234218", GraderID: "ta-1"})
+	require.NoError(t, err)
+
+	_, err = client.SetAttemptPolicy(context.Background(),
+		&gpb.SetAttemptPolicyRequest{Token: tokenA, CourseID: "234218", Policy: string(attemptBest)})
+	require.NoError(t, err)
+
+	graders, err := client.GetCourseGraders(context.Background(),
+		&gpb.GetCourseGradersRequest{Token: tokenA, CourseID: "234218"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"ta-1"}, graders.GetGraderIDs())
+
+	graders, err = client.GetCourseGraders(context.Background(),
+		&gpb.GetCourseGradersRequest{Token: tokenB, CourseID: "234218"})
+	require.NoError(t, err)
+	assert.Empty(t, graders.GetGraderIDs(), "another institution's course of the same ID is unrestricted")
+
+	ctxA, ctxB := withInstitution(context.Background(), "uni-a"), withInstitution(context.Background(), "uni-b")
+
+	policy, err := db.GetAttemptPolicy(ctxA, "234218")
+	require.NoError(t, err)
+	assert.Equal(t, attemptBest, policy)
+
+	policy, err = db.GetAttemptPolicy(ctxB, "234218")
+	require.NoError(t, err)
+	assert.Empty(t, policy)
+}
+
+func TestVerifiedTokenNamesInstitution(t *testing.T) {
+	s := &GradesServer{BaseServiceServer: libVerifier{role: roleStaff}}
+
+	institutionID, err := s.resolveInstitution(context.Background(),
+		&gpb.GetCourseGradesRequest{Token: institutionToken("uni-a")})
+	require.NoError(t, err)
+	assert.Equal(t, "uni-a", institutionID, "staff are scoped to the institution of their token")
+
+	_, err = s.resolveInstitution(context.Background(), &gpb.GetCourseGradesRequest{Token: tokenFor("staff-1")})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -0,0 +1,129 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+var ErrGradeLabelsInvalid = errors.New("invalid grade labels")
+
+// labelBound labels numeric grade values of at least min.
+type labelBound struct {
+	min   float64
+	label string
+}
+
+// localeLabels translates canonical grade values into the representation of one locale.
+type localeLabels struct {
+	// values labels canonical values exactly, keyed by upper-case value.
+	values map[string]string
+	// bounds label the remaining numeric values, highest bound first.
+	bounds []labelBound
+}
+
+// GradeLabels maps a locale, matched case-insensitively, to the labels of its grades.
+type GradeLabels map[string]localeLabels
+
+// parseGradeLabels parses a "locale:mapping[,mapping][;locale:...]" spec, where a mapping is either
+// value=label for an exact canonical value or >=min=label for numeric values of at least min, such
+// as "de:>=90=1,>=80=2,>=70=3,>=60=4,>=50=5,>=0=6;en-us:>=90=A,>=80=B,>=70=C,>=60=D,>=0=F".
+// An empty spec yields no labels.
+func parseGradeLabels(spec string) (GradeLabels, error) {
+	labels := GradeLabels{}
+
+	for _, entry := range strings.Split(spec, ";") {
+		if strings.TrimSpace(entry) == "" {
+			continue
+		}
+
+		rawLocale, mappings, ok := strings.Cut(entry, ":")
+		locale := strings.ToLower(strings.TrimSpace(rawLocale))
+
+		if !ok || locale == "" {
+			return nil, fmt.Errorf("%w: entry %q is not locale:mappings", ErrGradeLabelsInvalid, entry)
+		}
+
+		parsed, err := parseLocaleLabels(mappings)
+		if err != nil {
+			return nil, fmt.Errorf("%w: locale %q: %w", ErrGradeLabelsInvalid, locale, err)
+		}
+
+		labels[locale] = parsed
+	}
+
+	return labels, nil
+}
+
+// parseLocaleLabels parses the comma-separated mappings of one locale.
+func parseLocaleLabels(mappings string) (localeLabels, error) {
+	parsed := localeLabels{values: map[string]string{}}
+
+	for _, mapping := range strings.Split(mappings, ",") {
+		mapping = strings.TrimSpace(mapping)
+		if mapping == "" {
+			continue
+		}
+
+		bound, isBound := strings.CutPrefix(mapping, ">=")
+
+		value, label, ok := strings.Cut(bound, "=")
+		value, label = strings.TrimSpace(value), strings.TrimSpace(label)
+
+		if !ok || value == "" || label == "" {
+			return localeLabels{}, fmt.Errorf("mapping %q is not value=label or >=min=label", mapping)
+		}
+
+		if !isBound {
+			parsed.values[strings.ToUpper(value)] = label
+
+			continue
+		}
+
+		minimum, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return localeLabels{}, fmt.Errorf("bound of %q: %w", mapping, err)
+		}
+
+		parsed.bounds = append(parsed.bounds, labelBound{min: minimum, label: label})
+	}
+
+	sort.Slice(parsed.bounds, func(i, j int) bool {
+		return parsed.bounds[i].min > parsed.bounds[j].min
+	})
+
+	return parsed, nil
+}
+
+// display returns value as the locale shows it. A locale without labels of its own, such as
+// "de-AT", falls back to its language, "de"; values no label covers are returned as they are.
+func (l GradeLabels) display(locale, value string) string {
+	locale = strings.ToLower(strings.TrimSpace(locale))
+
+	labels, ok := l[locale]
+	if !ok {
+		language, _, _ := strings.Cut(locale, "-")
+		if labels, ok = l[language]; !ok {
+			return value
+		}
+	}
+
+	if label, ok := labels.values[strings.ToUpper(strings.TrimSpace(value))]; ok {
+		return label
+	}
+
+	numeric, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+	if err != nil {
+		return value
+	}
+
+	for _, bound := range labels.bounds {
+		if numeric >= bound.min {
+			return bound.label
+		}
+	}
+
+	return value
+}
@@ -0,0 +1,88 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	gpb "github.com/BetterGR/grades-microservice/protos"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testGradeLabels = "de:>=90=1,>=80=2,>=70=3,>=60=4,>=50=5,>=0=6,A=1,B=2,C=3,D=4,F=6;" +
+	"en-US:>=90=A,>=80=B,>=70=C,>=60=D,>=0=F"
+
+func TestParseGradeLabels(t *testing.T) {
+	labels, err := parseGradeLabels("")
+	require.NoError(t, err)
+	assert.Empty(t, labels)
+
+	labels, err = parseGradeLabels(testGradeLabels)
+	require.NoError(t, err)
+	assert.Len(t, labels, 2)
+	assert.Equal(t, 90.0, labels["en-us"].bounds[0].min, "bounds are kept highest first")
+
+	for _, spec := range []string{"de", ":A=1", "de:A", "de:A=", "de:>=x=1", "de:>=90"} {
+		_, err := parseGradeLabels(spec)
+		require.ErrorIs(t, err, ErrGradeLabelsInvalid, spec)
+	}
+}
+
+func TestGradeLabelsDisplay(t *testing.T) {
+	labels, err := parseGradeLabels(testGradeLabels)
+	require.NoError(t, err)
+
+	tests := []struct {
+		locale string
+		value  string
+		want   string
+	}{
+		{locale: "de", value: "95", want: "1"},
+		{locale: "de", value: "72.5", want: "3"},
+		{locale: "de", value: "12", want: "6"},
+		{locale: "de", value: "b", want: "2"},
+		{locale: "DE-at", value: "85", want: "2"},
+		{locale: "en-US", value: "85", want: "B"},
+		{locale: "en-us", value: "59", want: "F"},
+		{locale: "en-US", value: "Pass", want: "Pass"},
+		{locale: "en-US", value: "-5", want: "-5"},
+		{locale: "fr", value: "85", want: "85"},
+		{locale: "de", value: "", want: ""},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, labels.display(tt.locale, tt.value), "%s %s", tt.locale, tt.value)
+	}
+}
+
+func TestGetGradeLocale(t *testing.T) {
+	labels, err := parseGradeLabels(testGradeLabels)
+	require.NoError(t, err)
+
+	db := NewMockDatabase()
+	client := setupClient(t, func(s *GradesServer) {
+		s.db = db
+		s.labels = labels
+	})
+
+	grade := createTestGrade()
+	grade.GradeValue = "83"
+
+	added, err := db.AddGrade(context.Background(), grade)
+	require.NoError(t, err)
+
+	for locale, want := range map[string]string{"de": "2", "en-US": "B", "fr": "83", "": ""} {
+		resp, err := client.GetGrade(context.Background(),
+			&gpb.GetGradeRequest{Token: "test-token", GradeID: added.GradeID, Locale: locale})
+		require.NoError(t, err, locale)
+		assert.Equal(t, "83", resp.GetGrade().GetGradeValue(), "the canonical value is kept")
+		assert.Equal(t, want, resp.GetGrade().GetDisplayValue(), locale)
+	}
+
+	resp, err := client.GetCourseGrades(context.Background(), &gpb.GetCourseGradesRequest{
+		Token: "test-token", CourseID: grade.GetCourseID(), Semester: grade.GetSemester(), Locale: "de",
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.GetGrades(), 1)
+	assert.Equal(t, "2", resp.GetGrades()[0].GetDisplayValue())
+}
@@ -0,0 +1,136 @@
+package main
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/go-logr/logr"
+	"google.golang.org/grpc"
+	"k8s.io/klog/v2"
+)
+
+// requestSampler selects one in every rate requests for debug logging.
+type requestSampler struct {
+	rate    uint64
+	counter atomic.Uint64
+}
+
+// newRequestSampler creates a sampler keeping one in rate requests; a rate of 1 or less keeps every request.
+func newRequestSampler(rate int) *requestSampler {
+	if rate < 1 {
+		rate = 1
+	}
+
+	return &requestSampler{rate: uint64(rate)}
+}
+
+// Sample reports whether the current request should be logged.
+func (s *requestSampler) Sample() bool {
+	return (s.counter.Add(1)-1)%s.rate == 0
+}
+
+// errorsOnlySink drops info logs while still passing errors through to the wrapped sink.
+type errorsOnlySink struct {
+	logr.LogSink
+}
+
+func (s errorsOnlySink) Enabled(int) bool {
+	return false
+}
+
+func (s errorsOnlySink) WithValues(keysAndValues ...interface{}) logr.LogSink {
+	return errorsOnlySink{s.LogSink.WithValues(keysAndValues...)}
+}
+
+func (s errorsOnlySink) WithName(name string) logr.LogSink {
+	return errorsOnlySink{s.LogSink.WithName(name)}
+}
+
+func (s errorsOnlySink) WithCallDepth(depth int) logr.LogSink {
+	if sink, ok := s.LogSink.(logr.CallDepthLogSink); ok {
+		return errorsOnlySink{sink.WithCallDepth(depth)}
+	}
+
+	return s
+}
+
+// sloMonitor counts, per method, the requests slower than a latency objective.
+type sloMonitor struct {
+	threshold time.Duration
+	exceeded  sync.Map // method -> *atomic.Uint64
+}
+
+// newSLOMonitor creates a monitor for the latency objective; a threshold of zero or less disables it.
+func newSLOMonitor(threshold time.Duration) *sloMonitor {
+	if threshold <= 0 {
+		return nil
+	}
+
+	return &sloMonitor{threshold: threshold}
+}
+
+// observe records the duration of a request, reporting whether it exceeded the objective.
+// A nil monitor observes nothing.
+func (m *sloMonitor) observe(method string, duration time.Duration) bool {
+	if m == nil || duration <= m.threshold {
+		return false
+	}
+
+	counter, ok := m.exceeded.Load(method)
+	if !ok {
+		counter, _ = m.exceeded.LoadOrStore(method, new(atomic.Uint64))
+	}
+
+	counter.(*atomic.Uint64).Add(1)
+
+	return true
+}
+
+// Exceeded returns the number of requests to method that exceeded the objective.
+func (m *sloMonitor) Exceeded(method string) uint64 {
+	if m == nil {
+		return 0
+	}
+
+	counter, ok := m.exceeded.Load(method)
+	if !ok {
+		return 0
+	}
+
+	return counter.(*atomic.Uint64).Load()
+}
+
+// loggingInterceptor attaches a per-request logger to the context and logs each request.
+// Only sampled requests keep their debug logs; errors and requests exceeding the latency
+// objective are logged for every request.
+func loggingInterceptor(sampler *requestSampler, slo *sloMonitor) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		logger := klog.FromContext(ctx).WithValues("method", info.FullMethod)
+		requestLogger := logger
+
+		if !sampler.Sample() && logger.GetSink() != nil {
+			requestLogger = logger.WithSink(errorsOnlySink{logger.GetSink()})
+		}
+
+		start := time.Now()
+		resp, err := handler(klog.NewContext(ctx, requestLogger), req)
+		duration := time.Since(start)
+
+		if err != nil {
+			requestLogger.Error(err, "Request failed", "duration", duration)
+		} else {
+			requestLogger.V(logLevelDebug).Info("Request handled", "duration", duration)
+		}
+
+		if slo.observe(info.FullMethod, duration) {
+			logger.Info("Request exceeded latency SLO", "duration", duration, "slo", slo.threshold,
+				"exceeded_count", slo.Exceeded(info.FullMethod))
+		}
+
+		return resp, err
+	}
+}
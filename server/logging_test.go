@@ -0,0 +1,146 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr/funcr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"k8s.io/klog/v2"
+)
+
+func TestRequestSamplerKeepsConfiguredFraction(t *testing.T) {
+	for _, rate := range []int{1, 4, 10} {
+		sampler := newRequestSampler(rate)
+		sampled := 0
+
+		for range 1000 {
+			if sampler.Sample() {
+				sampled++
+			}
+		}
+
+		assert.InDelta(t, 1000/rate, sampled, 1, "rate %d", rate)
+	}
+
+	// Invalid rates fall back to logging every request.
+	sampler := newRequestSampler(0)
+	assert.True(t, sampler.Sample())
+	assert.True(t, sampler.Sample())
+}
+
+func TestLoggingInterceptorAlwaysLogsErrors(t *testing.T) {
+	var (
+		mutex sync.Mutex
+		lines []string
+	)
+
+	logger := funcr.New(func(prefix, args string) {
+		mutex.Lock()
+		defer mutex.Unlock()
+
+		lines = append(lines, args)
+	}, funcr.Options{Verbosity: logLevelDebug})
+	ctx := klog.NewContext(context.Background(), logger)
+
+	interceptor := loggingInterceptor(newRequestSampler(5), nil)
+	info := &grpc.UnaryServerInfo{FullMethod: "/grades.GradesService/GetCourseGrades"}
+	errFailed := errors.New("boom")
+
+	for i := range 20 {
+		_, err := interceptor(ctx, nil, info, func(ctx context.Context, _ interface{}) (interface{}, error) {
+			klog.FromContext(ctx).V(logLevelDebug).Info("Handler debug log")
+
+			if i%2 == 1 {
+				return nil, errFailed
+			}
+
+			return struct{}{}, nil
+		})
+		if i%2 == 1 {
+			require.ErrorIs(t, err, errFailed)
+		}
+	}
+
+	count := func(substr string) int {
+		matches := 0
+
+		for _, line := range lines {
+			if strings.Contains(line, substr) {
+				matches++
+			}
+		}
+
+		return matches
+	}
+
+	// Requests 0, 5, 10 and 15 are sampled.
+	assert.Equal(t, 4, count("Handler debug log"))
+	assert.Equal(t, 2, count("Request handled"))
+	// Every failed request is logged, sampled or not.
+	assert.Equal(t, 10, count("Request failed"))
+}
+
+func TestLoggingInterceptorWarnsOnSlowRequests(t *testing.T) {
+	var (
+		mutex sync.Mutex
+		lines []string
+	)
+
+	logger := funcr.New(func(prefix, args string) {
+		mutex.Lock()
+		defer mutex.Unlock()
+
+		lines = append(lines, args)
+	}, funcr.Options{})
+	ctx := klog.NewContext(context.Background(), logger)
+
+	slo := newSLOMonitor(10 * time.Millisecond)
+	// Sample one request in 100 so the warning must bypass sampling.
+	interceptor := loggingInterceptor(newRequestSampler(100), slo)
+	slow := &grpc.UnaryServerInfo{FullMethod: "/grades.GradesService/GetCourseGrades"}
+	fast := &grpc.UnaryServerInfo{FullMethod: "/grades.GradesService/GetGradeValue"}
+
+	slowHandler := func(context.Context, interface{}) (interface{}, error) {
+		time.Sleep(20 * time.Millisecond)
+
+		return struct{}{}, nil
+	}
+	fastHandler := func(context.Context, interface{}) (interface{}, error) {
+		return struct{}{}, nil
+	}
+
+	for range 2 {
+		_, err := interceptor(ctx, nil, slow, slowHandler)
+		require.NoError(t, err)
+
+		_, err = interceptor(ctx, nil, fast, fastHandler)
+		require.NoError(t, err)
+	}
+
+	assert.Equal(t, uint64(2), slo.Exceeded(slow.FullMethod))
+	assert.Equal(t, uint64(0), slo.Exceeded(fast.FullMethod))
+
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	require.Len(t, lines, 2)
+
+	for _, line := range lines {
+		assert.Contains(t, line, "exceeded latency SLO")
+		assert.Contains(t, line, slow.FullMethod)
+	}
+}
+
+func TestSLOMonitorDisabled(t *testing.T) {
+	slo := newSLOMonitor(0)
+	assert.Nil(t, slo)
+	assert.False(t, slo.observe("/grades.GradesService/GetCourseGrades", time.Hour))
+	assert.Zero(t, slo.Exceeded("/grades.GradesService/GetCourseGrades"))
+}
@@ -0,0 +1,184 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"k8s.io/klog/v2"
+)
+
+const (
+	// defaultPoolMetricsInterval is how often the connection pool gauges are refreshed by default.
+	defaultPoolMetricsInterval = 15 * time.Second
+	// metricsReadHeaderTimeout bounds reading the headers of a scrape.
+	metricsReadHeaderTimeout = 5 * time.Second
+)
+
+// metricsCollector writes its metrics in the Prometheus text exposition format.
+type metricsCollector interface {
+	writeMetrics(w io.Writer) error
+}
+
+// metricsRegistry serves the metrics of every registered collector on /metrics.
+type metricsRegistry struct {
+	mu         sync.Mutex
+	collectors []metricsCollector
+}
+
+// register adds a collector to the metrics served.
+func (r *metricsRegistry) register(collector metricsCollector) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.collectors = append(r.collectors, collector)
+}
+
+// ServeHTTP writes the metrics of every collector, in the order they were registered.
+func (r *metricsRegistry) ServeHTTP(w http.ResponseWriter, _ *http.Request) {
+	r.mu.Lock()
+	collectors := append([]metricsCollector(nil), r.collectors...)
+	r.mu.Unlock()
+
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+
+	for _, collector := range collectors {
+		if err := collector.writeMetrics(w); err != nil {
+			klog.ErrorS(err, "Failed to write metrics")
+
+			return
+		}
+	}
+}
+
+// serveMetrics serves the metrics of a registry on /metrics at addr until the server fails.
+func serveMetrics(addr string, registry *metricsRegistry) {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", registry)
+
+	server := &http.Server{Addr: addr, Handler: mux, ReadHeaderTimeout: metricsReadHeaderTimeout}
+	if err := server.ListenAndServe(); err != nil {
+		klog.ErrorS(err, "Metrics server stopped", "addr", addr)
+	}
+}
+
+// poolGauge is a gauge read from the statistics of a connection pool.
+type poolGauge struct {
+	name  string
+	help  string
+	value func(stats sql.DBStats) float64
+}
+
+// poolGauges are the connection pool statistics exported, labeled by pool.
+var poolGauges = []poolGauge{
+	{"grades_db_open_connections", "Established connections, in use or idle.",
+		func(s sql.DBStats) float64 { return float64(s.OpenConnections) }},
+	{"grades_db_in_use_connections", "Connections currently in use.",
+		func(s sql.DBStats) float64 { return float64(s.InUse) }},
+	{"grades_db_idle_connections", "Idle connections.",
+		func(s sql.DBStats) float64 { return float64(s.Idle) }},
+	{"grades_db_max_open_connections", "Maximum number of open connections, 0 when unlimited.",
+		func(s sql.DBStats) float64 { return float64(s.MaxOpenConnections) }},
+	{"grades_db_wait_count", "Total number of connections waited for.",
+		func(s sql.DBStats) float64 { return float64(s.WaitCount) }},
+	{"grades_db_wait_duration_seconds", "Total time spent waiting for a connection.",
+		func(s sql.DBStats) float64 { return s.WaitDuration.Seconds() }},
+}
+
+// poolMetrics exports the statistics of the database connection pools as gauges. The statistics are
+// snapshot each refresh rather than on every scrape, so scrapes never contend with queries for the
+// pool locks.
+type poolMetrics struct {
+	// pools are the connection pools by label, such as primary and replica.
+	pools map[string]*sql.DB
+
+	mu    sync.RWMutex
+	stats map[string]sql.DBStats
+}
+
+// newPoolMetrics returns the gauges of the given pools, with a first snapshot taken.
+func newPoolMetrics(pools map[string]*sql.DB) *poolMetrics {
+	m := &poolMetrics{pools: pools}
+	m.refresh()
+
+	return m
+}
+
+// refresh snapshots the statistics of every pool.
+func (m *poolMetrics) refresh() {
+	stats := make(map[string]sql.DBStats, len(m.pools))
+	for label, pool := range m.pools {
+		stats[label] = pool.Stats()
+	}
+
+	m.mu.Lock()
+	m.stats = stats
+	m.mu.Unlock()
+}
+
+// snapshot returns the statistics of a pool as of the last refresh.
+func (m *poolMetrics) snapshot(label string) (sql.DBStats, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	stats, ok := m.stats[label]
+
+	return stats, ok
+}
+
+// run refreshes the statistics each interval until the context is done.
+func (m *poolMetrics) run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			m.refresh()
+		}
+	}
+}
+
+// writeMetrics writes every gauge for every pool, pools in label order.
+func (m *poolMetrics) writeMetrics(w io.Writer) error {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	labels := make([]string, 0, len(m.stats))
+	for label := range m.stats {
+		labels = append(labels, label)
+	}
+
+	sort.Strings(labels)
+
+	for _, gauge := range poolGauges {
+		if _, err := fmt.Fprintf(w, "# HELP %s %s\n# TYPE %s gauge\n", gauge.name, gauge.help, gauge.name); err != nil {
+			return err
+		}
+
+		for _, label := range labels {
+			if _, err := fmt.Fprintf(w, "%s{pool=%q} %g\n", gauge.name, label, gauge.value(m.stats[label])); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// connectionPools returns the connection pools of the database by label.
+func (d *Database) connectionPools() map[string]*sql.DB {
+	pools := map[string]*sql.DB{"primary": d.db.DB}
+	if d.replica != nil {
+		pools["replica"] = d.replica.DB
+	}
+
+	return pools
+}
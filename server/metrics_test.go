@@ -0,0 +1,55 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPoolMetricsReportsStats(t *testing.T) {
+	database := &Database{db: unconnectedDB(), replica: unconnectedDB()}
+	database.db.SetMaxOpenConns(7)
+
+	metrics := newPoolMetrics(database.connectionPools())
+
+	primary, ok := metrics.snapshot("primary")
+	require.True(t, ok, "the primary pool is reported")
+	assert.Equal(t, 7, primary.MaxOpenConnections)
+	assert.Zero(t, primary.InUse, "nothing has been queried")
+
+	_, ok = metrics.snapshot("replica")
+	assert.True(t, ok, "the replica pool is reported")
+
+	// Stats are snapshot on refresh, not read on every scrape.
+	database.db.SetMaxOpenConns(9)
+
+	primary, _ = metrics.snapshot("primary")
+	assert.Equal(t, 7, primary.MaxOpenConnections)
+
+	metrics.refresh()
+
+	primary, _ = metrics.snapshot("primary")
+	assert.Equal(t, 9, primary.MaxOpenConnections)
+}
+
+func TestMetricsRegistryServesPoolGauges(t *testing.T) {
+	database := &Database{db: unconnectedDB()}
+	database.db.SetMaxOpenConns(4)
+
+	registry := &metricsRegistry{}
+	registry.register(newPoolMetrics(database.connectionPools()))
+
+	recorder := httptest.NewRecorder()
+	registry.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+
+	require.Equal(t, http.StatusOK, recorder.Code)
+
+	body := recorder.Body.String()
+	assert.Contains(t, body, "# TYPE grades_db_open_connections gauge\n")
+	assert.Contains(t, body, "grades_db_max_open_connections{pool=\"primary\"} 4\n")
+	assert.Contains(t, body, "grades_db_wait_duration_seconds{pool=\"primary\"} 0\n")
+	assert.NotContains(t, body, "pool=\"replica\"", "no replica is configured")
+}
@@ -0,0 +1,259 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	gpb "github.com/BetterGR/grades-microservice/protos"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/klog/v2"
+)
+
+// Normalization methods.
+const (
+	// normalizeZScore maps each section onto the mean and standard deviation of the whole course.
+	normalizeZScore = "z_score"
+	// normalizeMeanShift moves each section's mean onto the mean of the whole course.
+	normalizeMeanShift = "mean_shift"
+)
+
+// normalizedPrecision is the number of decimals normalized grades are kept to.
+const normalizedPrecision = 2
+
+var ErrNormalizeMethodInvalid = errors.New("normalization must be z_score or mean_shift")
+
+// parseNormalizeMethod validates a normalization method, defaulting to z_score.
+func parseNormalizeMethod(method string) (string, error) {
+	switch method = strings.ToLower(strings.TrimSpace(method)); method {
+	case "":
+		return normalizeZScore, nil
+	case normalizeZScore, normalizeMeanShift:
+		return method, nil
+	default:
+		return "", fmt.Errorf("%w: %q", ErrNormalizeMethodInvalid, method)
+	}
+}
+
+// numericMoments returns the mean and population standard deviation of the numeric grades, which
+// are zero without grades.
+func numericMoments(grades []*Grade) (float64, float64) {
+	if len(grades) == 0 {
+		return 0, 0
+	}
+
+	var sum float64
+
+	values := make([]float64, 0, len(grades))
+
+	for _, grade := range grades {
+		number, _ := parseNumericGrade(grade.GradeValue)
+		values = append(values, number)
+		sum += number
+	}
+
+	mean := sum / float64(len(values))
+
+	var squares float64
+	for _, value := range values {
+		squares += (value - mean) * (value - mean)
+	}
+
+	return mean, math.Sqrt(squares / float64(len(values)))
+}
+
+// sectionNormalization is a section's numeric grades and their normalized copies.
+type sectionNormalization struct {
+	sectionID  string
+	grades     []*Grade
+	normalized []*Grade
+	mean       float64
+	stdDev     float64
+}
+
+// courseNormalization is the normalization of the numeric grades of a course item across sections.
+type courseNormalization struct {
+	sections []sectionNormalization
+	mean     float64
+	stdDev   float64
+}
+
+// normalizeSections aligns the numeric grades of each section with the whole course. z_score maps
+// a grade x of a section with mean m and standard deviation sd to courseMean + courseSD*(x-m)/sd,
+// so every section ends with the course's mean and spread; a section whose grades are all equal
+// has no spread to scale and is shifted instead. mean_shift maps x to x - m + courseMean, keeping
+// each section's spread. Results are kept between minNumericGrade and maxNumericGrade, so clamped
+// sections may end slightly off the course mean. Grades without a section form a section of their
+// own, and non-numeric grades are left out. The grades are left untouched.
+func normalizeSections(grades []*Grade, method string) courseNormalization {
+	numeric := segmentGrades(grades).numeric
+	bySection := make(map[string][]*Grade)
+
+	for _, grade := range numeric {
+		bySection[grade.SectionID] = append(bySection[grade.SectionID], grade)
+	}
+
+	normalization := courseNormalization{sections: make([]sectionNormalization, 0, len(bySection))}
+	normalization.mean, normalization.stdDev = numericMoments(numeric)
+
+	for sectionID, sectionGrades := range bySection {
+		section := sectionNormalization{sectionID: sectionID, grades: sectionGrades}
+		section.mean, section.stdDev = numericMoments(sectionGrades)
+
+		for _, grade := range sectionGrades {
+			number, _ := parseNumericGrade(grade.GradeValue)
+
+			if method == normalizeZScore && section.stdDev > 0 {
+				number = normalization.mean + normalization.stdDev*(number-section.mean)/section.stdDev
+			} else {
+				number = number - section.mean + normalization.mean
+			}
+
+			number = min(max(number, minNumericGrade), maxNumericGrade)
+			scale := math.Pow(10, normalizedPrecision)
+
+			copied := *grade
+			copied.GradeValue = strconv.FormatFloat(math.Round(number*scale)/scale, 'f', -1, 64)
+			section.normalized = append(section.normalized, &copied)
+		}
+
+		normalization.sections = append(normalization.sections, section)
+	}
+
+	sort.Slice(normalization.sections, func(i, j int) bool {
+		return normalization.sections[i].sectionID < normalization.sections[j].sectionID
+	})
+
+	return normalization
+}
+
+// NormalizeAcrossSections aligns the grade distributions of the sections of a course item, so a
+// section graded more harshly or leniently than the others is brought in line with the course.
+// The normalized grades are previewed, with the statistics of every section, unless apply is set;
+// then every changed grade is updated, recording its history, except grades under appeal, which
+// are counted as skipped. Updates are made grade by grade, so a failure part way leaves the grades
+// before it normalized. Requires the staff role and, for non-admins, permission to grade the course.
+func (s *GradesServer) NormalizeAcrossSections(ctx context.Context,
+	req *gpb.NormalizeAcrossSectionsRequest,
+) (*gpb.NormalizeAcrossSectionsResponse, error) {
+	claims, err := s.authorizeRoles(ctx, req.GetToken(), roleStaff, roleAdmin)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx = withActor(ctx, callerIdentity(claims))
+
+	logger := klog.FromContext(ctx)
+	logger.V(logLevelDebug).Info("Received request to normalize across sections", "course_id", req.GetCourseID(),
+		"semester", req.GetSemester(), "item_id", req.GetItemID(), "method", req.GetMethod(), "apply", req.GetApply())
+
+	switch {
+	case req.GetCourseID() == "":
+		return nil, fmt.Errorf("failed to normalize across sections: %w",
+			status.Error(codes.InvalidArgument, ErrCourseIDEmpty.Error()))
+	case strings.TrimSpace(req.GetItemID()) == "":
+		return nil, fmt.Errorf("failed to normalize across sections: %w",
+			status.Error(codes.InvalidArgument, ErrItemIDEmpty.Error()))
+	}
+
+	method, err := parseNormalizeMethod(req.GetMethod())
+	if err != nil {
+		return nil, fmt.Errorf("failed to normalize across sections: %w",
+			status.Error(codes.InvalidArgument, err.Error()))
+	}
+
+	if err := s.authorizeGrader(ctx, claims, req.GetCourseID()); err != nil {
+		return nil, err
+	}
+
+	courseGrades, err := s.db.GetCourseGrades(ctx, req.GetCourseID(), req.GetSemester())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get course grades: %w", err)
+	}
+
+	var grades []*Grade
+
+	for _, grade := range courseGrades {
+		if grade.ItemID == req.GetItemID() {
+			grades = append(grades, grade)
+		}
+	}
+
+	normalization := normalizeSections(grades, method)
+
+	resp := &gpb.NormalizeAcrossSectionsResponse{
+		CourseMean:   normalization.mean,
+		CourseStdDev: normalization.stdDev,
+	}
+
+	for _, section := range normalization.sections {
+		normalizedMean, _ := numericMoments(section.normalized)
+
+		resp.Sections = append(resp.Sections, &gpb.SectionStats{
+			SectionID:      section.sectionID,
+			Count:          int32(len(section.grades)),
+			Mean:           section.mean,
+			StdDev:         section.stdDev,
+			NormalizedMean: normalizedMean,
+			After:          distributionToProto(gradeDistribution(section.normalized, s.rounder)),
+		})
+
+		for i, grade := range section.grades {
+			resp.Grades = append(resp.Grades, &gpb.NormalizedGrade{
+				GradeID:   grade.GradeID,
+				StudentID: grade.StudentID,
+				SectionID: section.sectionID,
+				Before:    s.rounder.Round(grade.GradeValue),
+				After:     s.rounder.Round(section.normalized[i].GradeValue),
+			})
+		}
+	}
+
+	if !req.GetApply() {
+		return resp, nil
+	}
+
+	for _, section := range normalization.sections {
+		for i, grade := range section.grades {
+			skipped, err := s.applyNormalizedGrade(ctx, grade, s.rounder.Round(section.normalized[i].GradeValue))
+			if err != nil {
+				return nil, fmt.Errorf("failed to normalize across sections: %w", err)
+			}
+
+			if skipped {
+				resp.SkippedCount++
+			}
+		}
+	}
+
+	resp.Applied = true
+
+	logger.Info("Normalized grades across sections", "course_id", req.GetCourseID(), "semester", req.GetSemester(),
+		"item_id", req.GetItemID(), "method", method, "grades", len(resp.GetGrades()), "skipped", resp.GetSkippedCount())
+
+	return resp, nil
+}
+
+// applyNormalizedGrade stores the normalized value of a grade when it changed, reporting true when
+// the grade is under appeal and was left unchanged.
+func (s *GradesServer) applyNormalizedGrade(ctx context.Context, grade *Grade, value string) (bool, error) {
+	if value == grade.GradeValue {
+		return false, nil
+	}
+
+	updated, err := s.db.UpdateGrade(ctx, &gpb.SingleGrade{GradeID: grade.GradeID, GradeValue: value})
+	if errors.Is(err, ErrGradeUnderAppeal) {
+		return true, nil
+	} else if err != nil {
+		return false, err
+	}
+
+	s.publishEvent(ctx, newGradeEvent(eventGradeUpdated, updated))
+
+	return false, nil
+}
@@ -0,0 +1,228 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	gpb "github.com/BetterGR/grades-microservice/protos"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// sectionGrades builds grades of a section with the given values.
+func sectionGrades(sectionID string, values ...string) []*Grade {
+	grades := make([]*Grade, 0, len(values))
+	for _, value := range values {
+		grades = append(grades, &Grade{SectionID: sectionID, GradeValue: value})
+	}
+
+	return grades
+}
+
+// normalizedValues returns the normalized values of every section by section ID.
+func normalizedValues(normalization courseNormalization) map[string][]string {
+	values := make(map[string][]string)
+
+	for _, section := range normalization.sections {
+		for _, grade := range section.normalized {
+			values[section.sectionID] = append(values[section.sectionID], grade.GradeValue)
+		}
+	}
+
+	return values
+}
+
+func TestParseNormalizeMethod(t *testing.T) {
+	method, err := parseNormalizeMethod("")
+	require.NoError(t, err)
+	assert.Equal(t, normalizeZScore, method)
+
+	method, err = parseNormalizeMethod(" Mean_Shift ")
+	require.NoError(t, err)
+	assert.Equal(t, normalizeMeanShift, method)
+
+	_, err = parseNormalizeMethod("percentile")
+	require.ErrorIs(t, err, ErrNormalizeMethodInvalid)
+}
+
+func TestNumericMoments(t *testing.T) {
+	mean, stdDev := numericMoments(sectionGrades("a", "2", "4", "4", "4", "5", "5", "7", "9"))
+	assert.InDelta(t, 5, mean, 1e-9)
+	assert.InDelta(t, 2, stdDev, 1e-9)
+
+	mean, stdDev = numericMoments(nil)
+	assert.Zero(t, mean)
+	assert.Zero(t, stdDev)
+}
+
+func TestNormalizeSections(t *testing.T) {
+	// Section a averages 70 and b 90 with the same spread; the course averages 80.
+	grades := append(sectionGrades("a", "60", "70", "80", "B+"), sectionGrades("b", "80", "90", "100")...)
+
+	shifted := normalizeSections(grades, normalizeMeanShift)
+	assert.InDelta(t, 80, shifted.mean, 1e-9)
+	assert.InDelta(t, 12.91, shifted.stdDev, 0.01)
+	assert.Equal(t, map[string][]string{"a": {"70", "80", "90"}, "b": {"70", "80", "90"}},
+		normalizedValues(shifted))
+
+	require.Len(t, shifted.sections, 2)
+	assert.Equal(t, "a", shifted.sections[0].sectionID)
+	assert.InDelta(t, 70, shifted.sections[0].mean, 1e-9)
+	assert.InDelta(t, 8.165, shifted.sections[0].stdDev, 0.001)
+
+	// z-scores stretch each section to the course spread: 80 +- 12.91 * 10 / 8.165.
+	scored := normalizeSections(grades, normalizeZScore)
+	assert.Equal(t, map[string][]string{"a": {"64.19", "80", "95.81"}, "b": {"64.19", "80", "95.81"}},
+		normalizedValues(scored))
+
+	for _, section := range scored.sections {
+		mean, stdDev := numericMoments(section.normalized)
+		assert.InDelta(t, scored.mean, mean, 0.01)
+		assert.InDelta(t, scored.stdDev, stdDev, 0.01)
+	}
+
+	assert.Equal(t, "60", grades[0].GradeValue, "the grades are left untouched")
+}
+
+func TestNormalizeSectionsEdgeCases(t *testing.T) {
+	// A section without spread is shifted.
+	grades := append(sectionGrades("flat", "50", "50"), sectionGrades("high", "90", "100")...)
+	assert.Equal(t, map[string][]string{"flat": {"72.5", "72.5"}, "high": {"49.72", "95.28"}},
+		normalizedValues(normalizeSections(grades, normalizeZScore)))
+
+	// The outlier would land far above maxNumericGrade and is clamped.
+	grades = append(sectionGrades("outlier", "0", "0", "0", "100"), sectionGrades("full", "100", "100")...)
+	assert.Equal(t, map[string][]string{"outlier": {"21.13", "21.13", "21.13", "100"}, "full": {"50", "50"}},
+		normalizedValues(normalizeSections(grades, normalizeZScore)))
+
+	grades = append(sectionGrades("low", "0", "10"), sectionGrades("top", "100", "100")...)
+	assert.Equal(t, map[string][]string{"low": {"47.5", "57.5"}, "top": {"52.5", "52.5"}},
+		normalizedValues(normalizeSections(grades, normalizeMeanShift)))
+
+	grades = append(sectionGrades("", "40", "60"), sectionGrades("x", "100")...)
+	assert.Equal(t, map[string][]string{"": {"56.67", "76.67"}, "x": {"66.67"}},
+		normalizedValues(normalizeSections(grades, normalizeMeanShift)))
+
+	assert.Empty(t, normalizeSections(sectionGrades("a", "A", "Pass"), normalizeZScore).sections)
+}
+
+// addSectionGrades adds a grade of hw1 in course c1 for each student, in the given section.
+func addSectionGrades(t *testing.T, db *MockDatabase, sectionID string, values map[string]string) {
+	t.Helper()
+
+	for studentID, value := range values {
+		grade := createTestGrade()
+		grade.StudentID, grade.CourseID, grade.ItemID = studentID, "c1", "hw1"
+		grade.SectionID, grade.GradeValue = sectionID, value
+		_, err := db.AddGrade(context.Background(), grade)
+		require.NoError(t, err)
+	}
+}
+
+func TestNormalizeAcrossSectionsPreview(t *testing.T) {
+	db := NewMockDatabase()
+	client := setupClient(t, func(s *GradesServer) {
+		s.db = db
+		s.Claims = RoleClaims{role: roleStaff}
+	})
+
+	addSectionGrades(t, db, "s1", map[string]string{"a": "60", "b": "70", "c": "80"})
+	addSectionGrades(t, db, "s2", map[string]string{"d": "80", "e": "90", "f": "100"})
+
+	resp, err := client.NormalizeAcrossSections(context.Background(), &gpb.NormalizeAcrossSectionsRequest{
+		Token: "test-token", CourseID: "c1", Semester: "Winter_2023", ItemID: "hw1", Method: normalizeMeanShift,
+	})
+	require.NoError(t, err)
+
+	assert.False(t, resp.GetApplied())
+	assert.InDelta(t, 80, resp.GetCourseMean(), 1e-9)
+	require.Len(t, resp.GetSections(), 2)
+	assert.Equal(t, "s1", resp.GetSections()[0].GetSectionID())
+	assert.Equal(t, int32(3), resp.GetSections()[0].GetCount())
+	assert.InDelta(t, 70, resp.GetSections()[0].GetMean(), 1e-9)
+	assert.InDelta(t, 80, resp.GetSections()[0].GetNormalizedMean(), 1e-9)
+	assert.InDelta(t, 90, resp.GetSections()[1].GetMean(), 1e-9)
+
+	after := make(map[string]string)
+
+	for _, grade := range resp.GetGrades() {
+		after[grade.GetStudentID()] = grade.GetBefore() + "->" + grade.GetAfter()
+	}
+
+	assert.Equal(t, map[string]string{
+		"a": "60->70", "b": "70->80", "c": "80->90", "d": "80->70", "e": "90->80", "f": "100->90",
+	}, after)
+
+	// A preview writes nothing.
+	grades, err := db.GetCourseGrades(context.Background(), "c1", "Winter_2023")
+	require.NoError(t, err)
+
+	for _, grade := range grades {
+		assert.Equal(t, int64(1), grade.Version, grade.StudentID)
+	}
+}
+
+func TestNormalizeAcrossSectionsApply(t *testing.T) {
+	db := NewMockDatabase()
+	client := setupClient(t, func(s *GradesServer) {
+		s.db = db
+		s.Claims = RoleClaims{role: roleStaff}
+	})
+
+	addSectionGrades(t, db, "s1", map[string]string{"a": "60", "b": "70", "c": "80"})
+	addSectionGrades(t, db, "s2", map[string]string{"d": "80", "e": "90", "f": "100"})
+
+	for _, grade := range db.grades {
+		if grade.StudentID == "f" {
+			grade.AppealStatus = appealUnderAppeal
+		}
+	}
+
+	resp, err := client.NormalizeAcrossSections(context.Background(), &gpb.NormalizeAcrossSectionsRequest{
+		Token: "test-token", CourseID: "c1", Semester: "Winter_2023", ItemID: "hw1", Method: normalizeMeanShift,
+		Apply: true,
+	})
+	require.NoError(t, err)
+
+	assert.True(t, resp.GetApplied())
+	assert.Equal(t, int32(1), resp.GetSkippedCount())
+
+	grades, err := db.GetCourseGrades(context.Background(), "c1", "Winter_2023")
+	require.NoError(t, err)
+
+	stored := make(map[string]string)
+	for _, grade := range grades {
+		stored[grade.StudentID] = grade.GradeValue
+	}
+
+	assert.Equal(t, map[string]string{"a": "70", "b": "80", "c": "90", "d": "70", "e": "80", "f": "100"}, stored)
+}
+
+func TestNormalizeAcrossSectionsErrors(t *testing.T) {
+	client := setupClient(t, func(s *GradesServer) {
+		s.db = NewMockDatabase()
+		s.Claims = RoleClaims{role: roleStaff}
+	})
+
+	for _, req := range []*gpb.NormalizeAcrossSectionsRequest{
+		{Token: "test-token", ItemID: "hw1"},
+		{Token: "test-token", CourseID: "c1"},
+		{Token: "test-token", CourseID: "c1", ItemID: "hw1", Method: "percentile"},
+	} {
+		_, err := client.NormalizeAcrossSections(context.Background(), req)
+		require.Error(t, err)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err), req.String())
+	}
+
+	students := setupClient(t, func(s *GradesServer) {
+		s.db = NewMockDatabase()
+		s.Claims = RoleClaims{role: roleStudent}
+	})
+
+	_, err := students.NormalizeAcrossSections(context.Background(), &gpb.NormalizeAcrossSectionsRequest{
+		Token: "test-token", CourseID: "c1", ItemID: "hw1",
+	})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
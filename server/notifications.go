@@ -0,0 +1,127 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	gpb "github.com/BetterGR/grades-microservice/protos"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/klog/v2"
+)
+
+// StudentNotification tells a student that one of their grades changed.
+type StudentNotification struct {
+	StudentID  string
+	GradeID    string
+	CourseID   string
+	Semester   string
+	GradeValue string
+}
+
+// StudentDigest summarizes the changes to a student's grades since the previous digest, one per
+// grade with its latest value, in the order the grades first changed.
+type StudentDigest struct {
+	StudentID string
+	Changes   []*StudentNotification
+}
+
+// Notifier delivers notifications to students, by email, push or any other channel.
+type Notifier interface {
+	NotifyStudent(ctx context.Context, notification *StudentNotification) error
+	NotifyStudentDigest(ctx context.Context, digest *StudentDigest) error
+}
+
+// noopNotifier drops every notification; it is the default until a delivery channel is configured.
+type noopNotifier struct{}
+
+// NotifyStudent does nothing.
+func (noopNotifier) NotifyStudent(context.Context, *StudentNotification) error {
+	return nil
+}
+
+// NotifyStudentDigest does nothing.
+func (noopNotifier) NotifyStudentDigest(context.Context, *StudentDigest) error {
+	return nil
+}
+
+// notifyStudent tells the student of a grade about its new value, unless they opted out, or queues
+// the change for their next digest when they asked for one. Failures are logged and never fail the
+// request that changed the grade.
+func (s *GradesServer) notifyStudent(ctx context.Context, grade *Grade) {
+	if s.notifier == nil {
+		return
+	}
+
+	logger := klog.FromContext(ctx)
+
+	optedOut, err := s.db.IsNotificationOptedOut(ctx, grade.StudentID)
+	if err != nil {
+		logger.Error(err, "Failed to check notification opt-out, student not notified", "grade_id", grade.GradeID)
+
+		return
+	}
+
+	if optedOut {
+		return
+	}
+
+	notification := &StudentNotification{
+		StudentID:  grade.StudentID,
+		GradeID:    grade.GradeID,
+		CourseID:   grade.CourseID,
+		Semester:   grade.Semester,
+		GradeValue: s.rounder.Round(grade.GradeValue),
+	}
+
+	if s.digests != nil {
+		digest, err := s.db.IsNotificationDigestEnabled(ctx, grade.StudentID)
+
+		switch {
+		case err != nil:
+			// Better a notification the student did not ask for than none at all.
+			logger.Error(err, "Failed to check notification digest preference, student notified now",
+				"grade_id", grade.GradeID)
+		case digest:
+			s.digests.add(notification)
+
+			return
+		}
+	}
+
+	if err := s.notifier.NotifyStudent(ctx, notification); err != nil {
+		logger.Error(err, "Failed to notify student of grade change", "grade_id", grade.GradeID)
+	}
+}
+
+// SetNotificationOptOut opts a student out of grade change notifications, or back in. Students may
+// only change their own preference; staff and admins may change anyone's.
+func (s *GradesServer) SetNotificationOptOut(ctx context.Context,
+	req *gpb.SetNotificationOptOutRequest,
+) (*gpb.SetNotificationOptOutResponse, error) {
+	claims, err := s.getClaims(ctx, req.GetToken())
+	if err != nil {
+		return nil, fmt.Errorf("authentication failed: %w",
+			status.Error(codes.Unauthenticated, err.Error()))
+	}
+
+	if !claims.HasRole(roleStaff) && !claims.HasRole(roleAdmin) && callerIdentity(claims) != req.GetStudentID() {
+		return nil, fmt.Errorf("authorization failed: %w",
+			status.Error(codes.PermissionDenied, "students may only change their own notification preference"))
+	}
+
+	logger := klog.FromContext(ctx)
+	logger.V(logLevelDebug).Info("Received request to set notification opt-out", "student_id", req.GetStudentID(),
+		"opt_out", req.GetOptOut())
+
+	err = s.db.SetNotificationOptOut(ctx, req.GetStudentID(), req.GetOptOut())
+	if errors.Is(err, ErrStudentIDEmpty) {
+		return nil, fmt.Errorf("failed to set notification opt-out: %w",
+			status.Error(codes.InvalidArgument, err.Error()))
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to set notification opt-out: %w", err)
+	}
+
+	return &gpb.SetNotificationOptOutResponse{}, nil
+}
@@ -0,0 +1,153 @@
+package main
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	gpb "github.com/BetterGR/grades-microservice/protos"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// MockNotifier records the notifications and digests it is asked to send. Digests fail with
+// digestErr while it is set.
+type MockNotifier struct {
+	mu        sync.Mutex
+	sent      []*StudentNotification
+	digests   []*StudentDigest
+	digestErr error
+}
+
+// NotifyStudent records the notification.
+func (n *MockNotifier) NotifyStudent(_ context.Context, notification *StudentNotification) error {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	n.sent = append(n.sent, notification)
+
+	return nil
+}
+
+// NotifyStudentDigest records the digest.
+func (n *MockNotifier) NotifyStudentDigest(_ context.Context, digest *StudentDigest) error {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	if n.digestErr != nil {
+		return n.digestErr
+	}
+
+	n.digests = append(n.digests, digest)
+
+	return nil
+}
+
+// Digests returns the digests recorded so far.
+func (n *MockNotifier) Digests() []*StudentDigest {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	return append([]*StudentDigest(nil), n.digests...)
+}
+
+// Sent returns the notifications recorded so far.
+func (n *MockNotifier) Sent() []*StudentNotification {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	return append([]*StudentNotification(nil), n.sent...)
+}
+
+// setupNotifyingClient returns a client whose server notifies through notifier, backed by db.
+func setupNotifyingClient(t *testing.T, db *MockDatabase, notifier Notifier) gpb.GradesServiceClient {
+	t.Helper()
+
+	return setupClient(t, func(s *GradesServer) {
+		s.db = db
+		s.notifier = notifier
+	})
+}
+
+func TestUpdateGradeNotifiesStudent(t *testing.T) {
+	notifier := &MockNotifier{}
+	client := setupNotifyingClient(t, NewMockDatabase(), notifier)
+
+	grade := createTestGrade()
+	_, err := client.AddSingleGrade(context.Background(), &gpb.AddSingleGradeRequest{Token: "test-token", Grade: grade})
+	require.NoError(t, err)
+	assert.Empty(t, notifier.Sent(), "adding a grade must not notify the student")
+
+	grade.GradeValue = "B"
+	_, err = client.UpdateSingleGrade(context.Background(), &gpb.UpdateSingleGradeRequest{
+		Token: "test-token", Grade: grade,
+	})
+	require.NoError(t, err)
+
+	sent := notifier.Sent()
+	require.Len(t, sent, 1)
+	assert.Equal(t, grade.GetStudentID(), sent[0].StudentID)
+	assert.Equal(t, grade.GetCourseID(), sent[0].CourseID)
+	assert.Equal(t, grade.GetGradeID(), sent[0].GradeID)
+	assert.Equal(t, "B", sent[0].GradeValue)
+}
+
+func TestUpdateGradeRespectsOptOut(t *testing.T) {
+	notifier := &MockNotifier{}
+	client := setupNotifyingClient(t, NewMockDatabase(), notifier)
+
+	grade := createTestGrade()
+	_, err := client.AddSingleGrade(context.Background(), &gpb.AddSingleGradeRequest{Token: "test-token", Grade: grade})
+	require.NoError(t, err)
+
+	_, err = client.SetNotificationOptOut(context.Background(), &gpb.SetNotificationOptOutRequest{
+		Token: "test-token", StudentID: grade.GetStudentID(), OptOut: true,
+	})
+	require.NoError(t, err)
+
+	grade.GradeValue = "B"
+	_, err = client.UpdateSingleGrade(context.Background(), &gpb.UpdateSingleGradeRequest{
+		Token: "test-token", Grade: grade,
+	})
+	require.NoError(t, err)
+	assert.Empty(t, notifier.Sent(), "opted out students must not be notified")
+
+	_, err = client.SetNotificationOptOut(context.Background(), &gpb.SetNotificationOptOutRequest{
+		Token: "test-token", StudentID: grade.GetStudentID(), OptOut: false,
+	})
+	require.NoError(t, err)
+
+	grade.GradeValue = "C"
+	_, err = client.UpdateSingleGrade(context.Background(), &gpb.UpdateSingleGradeRequest{
+		Token: "test-token", Grade: grade,
+	})
+	require.NoError(t, err)
+	assert.Len(t, notifier.Sent(), 1)
+}
+
+func TestSetNotificationOptOutAuthorization(t *testing.T) {
+	student := setupClient(t, func(s *GradesServer) {
+		verifiedCaller("student")(s)
+	})
+
+	_, err := student.SetNotificationOptOut(context.Background(), &gpb.SetNotificationOptOutRequest{
+		Token: studentToken, StudentID: "student-1", OptOut: true,
+	})
+	require.NoError(t, err)
+
+	_, err = student.SetNotificationOptOut(context.Background(), &gpb.SetNotificationOptOutRequest{
+		Token: studentToken, StudentID: "student-2", OptOut: true,
+	})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	staff := setupClient(t, func(s *GradesServer) {
+		s.Claims = RoleClaims{role: roleStaff}
+	})
+
+	_, err = staff.SetNotificationOptOut(context.Background(), &gpb.SetNotificationOptOutRequest{
+		Token: "test-token", OptOut: true,
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -0,0 +1,163 @@
+package main
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"google.golang.org/grpc/stats"
+	"k8s.io/klog/v2"
+)
+
+const (
+	// defaultMaxRecvMsgSize is the largest request gRPC accepts when MAX_RECV_MSG_SIZE is unset.
+	defaultMaxRecvMsgSize = 4 << 20
+	// defaultPayloadWarnRatio is the share of the request size limit above which requests are
+	// logged when PAYLOAD_WARN_RATIO is unset.
+	defaultPayloadWarnRatio = 0.8
+)
+
+// payloadBuckets are the upper bounds, in bytes, of the request size histogram buckets. Larger
+// requests are counted in a last, unbounded bucket.
+var payloadBuckets = []int{1 << 10, 4 << 10, 16 << 10, 64 << 10, 256 << 10, 1 << 20, 4 << 20}
+
+// payloadHistogram counts the requests of a method by size. Recording only takes atomic increments.
+type payloadHistogram struct {
+	counts []atomic.Uint64
+	bytes  atomic.Uint64
+}
+
+// observe counts a request of size bytes.
+func (h *payloadHistogram) observe(size int) {
+	h.counts[sort.SearchInts(payloadBuckets, size)].Add(1)
+	h.bytes.Add(uint64(size))
+}
+
+// payloadSnapshot is the state of a payloadHistogram at one point in time.
+type payloadSnapshot struct {
+	// Counts holds the requests per bucket of payloadBuckets, followed by the larger requests.
+	Counts []uint64
+	// Bytes is the total size of the requests.
+	Bytes uint64
+}
+
+// Count returns the number of requests in the snapshot.
+func (s payloadSnapshot) Count() uint64 {
+	var count uint64
+	for _, bucket := range s.Counts {
+		count += bucket
+	}
+
+	return count
+}
+
+// payloadAudit is a gRPC stats handler recording the size distribution of incoming requests per
+// method, and logging the requests that come close to the size limit so it can be tuned before
+// legitimate imports hit it.
+type payloadAudit struct {
+	limit  int
+	warnAt int
+	// methods maps a method to its *payloadHistogram.
+	methods sync.Map
+}
+
+// Verify that payloadAudit implements stats.Handler at compile time.
+var _ stats.Handler = (*payloadAudit)(nil)
+
+// newPayloadAudit creates an audit of requests limited to limit bytes, logging those of at least
+// warnRatio of the limit. A ratio of zero or less logs nothing.
+func newPayloadAudit(limit int, warnRatio float64) *payloadAudit {
+	warnAt := 0
+	if warnRatio > 0 {
+		warnAt = max(int(float64(limit)*warnRatio), 1)
+	}
+
+	return &payloadAudit{limit: limit, warnAt: warnAt}
+}
+
+// payloadMethodKey is the context key of the method a request is made to.
+type payloadMethodKey struct{}
+
+// observe records a request of size bytes to method.
+func (a *payloadAudit) observe(ctx context.Context, method string, size int) {
+	histogram, ok := a.methods.Load(method)
+	if !ok {
+		histogram, _ = a.methods.LoadOrStore(method, &payloadHistogram{
+			counts: make([]atomic.Uint64, len(payloadBuckets)+1),
+		})
+	}
+
+	histogram.(*payloadHistogram).observe(size)
+
+	if a.warnAt > 0 && size >= a.warnAt {
+		klog.FromContext(ctx).Info("Request payload close to the size limit", "method", method, "bytes", size,
+			"limit", a.limit)
+	}
+}
+
+// Snapshot returns the size distribution of the requests to method so far.
+func (a *payloadAudit) Snapshot(method string) payloadSnapshot {
+	snapshot := payloadSnapshot{Counts: make([]uint64, len(payloadBuckets)+1)}
+
+	histogram, ok := a.methods.Load(method)
+	if !ok {
+		return snapshot
+	}
+
+	for i := range snapshot.Counts {
+		snapshot.Counts[i] = histogram.(*payloadHistogram).counts[i].Load()
+	}
+
+	snapshot.Bytes = histogram.(*payloadHistogram).bytes.Load()
+
+	return snapshot
+}
+
+// runSummaries logs the size distribution of the requests to every method each interval until the
+// context is done.
+func (a *payloadAudit) runSummaries(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			a.methods.Range(func(method, _ interface{}) bool {
+				snapshot := a.Snapshot(method.(string))
+				klog.InfoS("Request payload sizes", "method", method, "count", snapshot.Count(),
+					"bytes", snapshot.Bytes, "bucket_bounds", payloadBuckets, "bucket_counts", snapshot.Counts)
+
+				return true
+			})
+		}
+	}
+}
+
+// TagRPC remembers the method of a request for HandleRPC.
+func (a *payloadAudit) TagRPC(ctx context.Context, info *stats.RPCTagInfo) context.Context {
+	return context.WithValue(ctx, payloadMethodKey{}, info.FullMethodName)
+}
+
+// HandleRPC records the size of every message received from a client, including each message of a
+// client stream.
+func (a *payloadAudit) HandleRPC(ctx context.Context, rpcStats stats.RPCStats) {
+	payload, ok := rpcStats.(*stats.InPayload)
+	if !ok || payload.IsClient() {
+		return
+	}
+
+	method, _ := ctx.Value(payloadMethodKey{}).(string)
+	a.observe(ctx, method, payload.Length)
+}
+
+// TagConn leaves connections untagged.
+func (a *payloadAudit) TagConn(ctx context.Context, _ *stats.ConnTagInfo) context.Context {
+	return ctx
+}
+
+// HandleConn ignores connection events.
+func (a *payloadAudit) HandleConn(context.Context, stats.ConnStats) {}
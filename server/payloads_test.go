@@ -0,0 +1,66 @@
+package main
+
+import (
+	"context"
+	"net"
+	"strings"
+	"testing"
+
+	gpb "github.com/BetterGR/grades-microservice/protos"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/stats"
+)
+
+func TestPayloadAuditBuckets(t *testing.T) {
+	audit := newPayloadAudit(defaultMaxRecvMsgSize, defaultPayloadWarnRatio)
+	ctx := audit.TagRPC(context.Background(), &stats.RPCTagInfo{FullMethodName: "/grades/Add"})
+
+	for _, size := range []int{10, 1 << 10, 1<<10 + 1, 3 << 20, 5 << 20} {
+		audit.HandleRPC(ctx, &stats.InPayload{Length: size})
+	}
+
+	audit.HandleRPC(ctx, &stats.InPayload{Client: true, Length: 10})
+	audit.HandleRPC(ctx, &stats.OutPayload{Length: 10})
+
+	snapshot := audit.Snapshot("/grades/Add")
+	assert.Equal(t, []uint64{2, 1, 0, 0, 0, 0, 1, 1}, snapshot.Counts)
+	assert.Equal(t, uint64(5), snapshot.Count(), "responses and client-side payloads are not requests")
+	assert.Equal(t, uint64(10+1<<10+1<<10+1+3<<20+5<<20), snapshot.Bytes)
+
+	assert.Zero(t, audit.Snapshot("/grades/Other").Count())
+}
+
+func TestPayloadAuditRecordsLargeRequest(t *testing.T) {
+	audit := newPayloadAudit(defaultMaxRecvMsgSize, defaultPayloadWarnRatio)
+
+	grpcServer := grpc.NewServer(grpc.StatsHandler(audit))
+	gpb.RegisterGradesServiceServer(grpcServer, &TestGradesServer{GradesServer: &GradesServer{
+		db: NewMockDatabase(), Claims: MockClaims{},
+	}})
+
+	listener, err := net.Listen(connectionProtocol, "localhost:0")
+	require.NoError(t, err)
+
+	go func() { _ = grpcServer.Serve(listener) }()
+	t.Cleanup(grpcServer.Stop)
+
+	conn, err := grpc.NewClient(listener.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+
+	client := gpb.NewGradesServiceClient(conn)
+
+	grade := createTestGrade()
+	grade.Comments = strings.Repeat("x", 200_000)
+
+	_, err = client.AddSingleGrade(context.Background(), &gpb.AddSingleGradeRequest{Token: "test-token", Grade: grade})
+	require.NoError(t, err)
+
+	snapshot := audit.Snapshot(gpb.GradesService_AddSingleGrade_FullMethodName)
+	require.Equal(t, uint64(1), snapshot.Count())
+	assert.Equal(t, uint64(1), snapshot.Counts[4], "a 200 KB request falls in the 256 KiB bucket")
+	assert.Greater(t, snapshot.Bytes, uint64(200_000))
+}
@@ -0,0 +1,202 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// PDF page layout, in points.
+const (
+	pdfPageWidth  = 595
+	pdfPageHeight = 842
+	pdfMargin     = 56
+	// pdfCharWidth is the average width of a Helvetica character relative to the font size, used to
+	// wrap lines.
+	pdfCharWidth = 0.5
+)
+
+// Styles of the lines of a PDF document.
+const (
+	pdfBody = iota
+	pdfHeading
+	pdfSubheading
+)
+
+// pdfStyle is the font of a line style and the height of its lines.
+type pdfStyle struct {
+	font    string
+	size    float64
+	leading float64
+}
+
+// pdfStyles are the styles by line style: regular body text and bold headings.
+var pdfStyles = map[int]pdfStyle{
+	pdfBody:       {font: "F1", size: 11, leading: 15},
+	pdfHeading:    {font: "F2", size: 16, leading: 24},
+	pdfSubheading: {font: "F2", size: 12, leading: 20},
+}
+
+// pdfLine is a line of text of a PDF document.
+type pdfLine struct {
+	text  string
+	style int
+}
+
+// parsePDFLines reads text as the lines of a PDF document: lines starting with "# " are headings,
+// lines starting with "## " subheadings, and the rest body text.
+func parsePDFLines(text string) []pdfLine {
+	var lines []pdfLine
+
+	for _, line := range strings.Split(strings.TrimRight(text, "\n"), "\n") {
+		switch {
+		case strings.HasPrefix(line, "## "):
+			lines = append(lines, pdfLine{text: strings.TrimPrefix(line, "## "), style: pdfSubheading})
+		case strings.HasPrefix(line, "# "):
+			lines = append(lines, pdfLine{text: strings.TrimPrefix(line, "# "), style: pdfHeading})
+		default:
+			lines = append(lines, pdfLine{text: line, style: pdfBody})
+		}
+	}
+
+	return lines
+}
+
+// wrapPDFLine splits a line at spaces into lines fitting the width of a page.
+func wrapPDFLine(line pdfLine) []pdfLine {
+	width := int((pdfPageWidth - 2*pdfMargin) / (pdfStyles[line.style].size * pdfCharWidth))
+	words := strings.Fields(line.text)
+
+	if utf8.RuneCountInString(line.text) <= width || len(words) == 0 {
+		return []pdfLine{line}
+	}
+
+	var (
+		lines   []pdfLine
+		current string
+	)
+
+	for _, word := range words {
+		if current != "" && utf8.RuneCountInString(current)+1+utf8.RuneCountInString(word) > width {
+			lines = append(lines, pdfLine{text: current, style: line.style})
+			current = ""
+		}
+
+		if current != "" {
+			current += " "
+		}
+
+		current += word
+	}
+
+	return append(lines, pdfLine{text: current, style: line.style})
+}
+
+// escapePDFText escapes text as the body of a PDF string in WinAnsiEncoding. Characters outside
+// Latin-1 are replaced with "?", as the standard fonts cannot show them.
+func escapePDFText(text string) string {
+	var b strings.Builder
+
+	for _, r := range text {
+		switch {
+		case r == '\\' || r == '(' || r == ')':
+			b.WriteByte('\\')
+			b.WriteRune(r)
+		case r >= ' ' && r < 0x7f:
+			b.WriteRune(r)
+		case r >= 0xa0 && r <= 0xff:
+			fmt.Fprintf(&b, "\\%03o", r)
+		default:
+			b.WriteByte('?')
+		}
+	}
+
+	return b.String()
+}
+
+// pdfPages lays lines out on A4 pages, returning the content stream of each page.
+func pdfPages(lines []pdfLine) []string {
+	var (
+		pages   []string
+		content strings.Builder
+	)
+
+	y := float64(pdfPageHeight - pdfMargin)
+
+	for _, line := range lines {
+		for _, wrapped := range wrapPDFLine(line) {
+			style := pdfStyles[wrapped.style]
+
+			if y-style.leading < pdfMargin && content.Len() > 0 {
+				pages = append(pages, content.String())
+				content.Reset()
+
+				y = pdfPageHeight - pdfMargin
+			}
+
+			y -= style.leading
+
+			if wrapped.text != "" {
+				fmt.Fprintf(&content, "BT /%s %g Tf %d %.2f Td (%s) Tj ET\n", style.font, style.size, pdfMargin, y,
+					escapePDFText(wrapped.text))
+			}
+		}
+	}
+
+	return append(pages, content.String())
+}
+
+// renderPDF renders lines of text as an A4 PDF document titled title, in the standard Helvetica
+// fonts so no font needs to be embedded. Lines too long for the page are wrapped and the document
+// runs over as many pages as needed.
+func renderPDF(title string, lines []pdfLine) []byte {
+	pages := pdfPages(lines)
+
+	// Objects 1 to 5 are the catalog, page tree, info and fonts; each page is followed by its content.
+	objects := []string{
+		"<< /Type /Catalog /Pages 2 0 R >>",
+		"",
+		fmt.Sprintf("<< /Title (%s) /Producer (grades-microservice) >>", escapePDFText(title)),
+		"<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica /Encoding /WinAnsiEncoding >>",
+		"<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica-Bold /Encoding /WinAnsiEncoding >>",
+	}
+
+	kids := make([]string, 0, len(pages))
+
+	for _, content := range pages {
+		page := len(objects) + 1
+		kids = append(kids, fmt.Sprintf("%d 0 R", page))
+		objects = append(objects,
+			fmt.Sprintf("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %d %d] "+
+				"/Resources << /Font << /F1 4 0 R /F2 5 0 R >> >> /Contents %d 0 R >>",
+				pdfPageWidth, pdfPageHeight, page+1),
+			fmt.Sprintf("<< /Length %d >>\nstream\n%sendstream", len(content), content))
+	}
+
+	objects[1] = fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(pages))
+
+	var buf bytes.Buffer
+
+	buf.WriteString("%PDF-1.4\n")
+
+	offsets := make([]int, len(objects))
+
+	for i, object := range objects {
+		offsets[i] = buf.Len()
+		fmt.Fprintf(&buf, "%d 0 obj\n%s\nendobj\n", i+1, object)
+	}
+
+	xref := buf.Len()
+
+	fmt.Fprintf(&buf, "xref\n0 %d\n0000000000 65535 f \n", len(objects)+1)
+
+	for _, offset := range offsets {
+		fmt.Fprintf(&buf, "%010d 00000 n \n", offset)
+	}
+
+	fmt.Fprintf(&buf, "trailer\n<< /Size %d /Root 1 0 R /Info 3 0 R >>\nstartxref\n%d\n%%%%EOF\n",
+		len(objects)+1, xref)
+
+	return buf.Bytes()
+}
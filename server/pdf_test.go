@@ -0,0 +1,85 @@
+package main
+
+import (
+	"bytes"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// pdfShownText matches the strings shown by the text operators of a content stream.
+var pdfShownText = regexp.MustCompile(`\(((?:[^\\)]|\\.)*)\) Tj`)
+
+// pdfText extracts the lines of text shown by a PDF document written by renderPDF.
+func pdfText(t *testing.T, pdf []byte) []string {
+	t.Helper()
+
+	require.True(t, bytes.HasPrefix(pdf, []byte("%PDF-1.4\n")))
+	require.True(t, bytes.HasSuffix(pdf, []byte("%%EOF\n")))
+
+	var lines []string
+
+	for _, match := range pdfShownText.FindAllSubmatch(pdf, -1) {
+		var b strings.Builder
+
+		escaped := string(match[1])
+		for i := 0; i < len(escaped); i++ {
+			switch {
+			case escaped[i] != '\\':
+				b.WriteByte(escaped[i])
+			case i+3 < len(escaped) && escaped[i+1] >= '0' && escaped[i+1] <= '7':
+				code, err := strconv.ParseUint(escaped[i+1:i+4], 8, 8)
+				require.NoError(t, err)
+				b.WriteRune(rune(code))
+
+				i += 3
+			default:
+				b.WriteByte(escaped[i+1])
+				i++
+			}
+		}
+
+		lines = append(lines, b.String())
+	}
+
+	return lines
+}
+
+func TestRenderPDF(t *testing.T) {
+	lines := parsePDFLines("# Title\n## Section (a)\nC:\\path \u00e9t\u00e9 \u4e2d\n\nlast")
+	require.Len(t, lines, 5)
+	assert.Equal(t, pdfHeading, lines[0].style)
+	assert.Equal(t, pdfSubheading, lines[1].style)
+	assert.Equal(t, pdfBody, lines[2].style)
+
+	pdf := renderPDF("doc", lines)
+	assert.Equal(t, []string{"Title", "Section (a)", "C:\\path \u00e9t\u00e9 ?", "last"}, pdfText(t, pdf))
+
+	// The cross-reference table points at every object.
+	for i := 1; i <= 7; i++ {
+		offset := bytes.Index(pdf, []byte(strconv.Itoa(i)+" 0 obj\n"))
+		require.Positive(t, offset)
+		assert.Contains(t, string(pdf), "\n"+strings.Repeat("0", 10-len(strconv.Itoa(offset)))+
+			strconv.Itoa(offset)+" 00000 n \n")
+	}
+}
+
+func TestRenderPDFPaginatesAndWraps(t *testing.T) {
+	var lines []pdfLine
+	for i := range 100 {
+		lines = append(lines, pdfLine{text: "line " + strconv.Itoa(i)})
+	}
+
+	lines = append(lines, pdfLine{text: strings.Repeat("word ", 40)})
+
+	pdf := renderPDF("doc", lines)
+	assert.Contains(t, string(pdf), "/Count 3", "100 lines run over several pages")
+
+	text := pdfText(t, pdf)
+	assert.Equal(t, "line 99", text[99])
+	assert.Greater(t, len(text), 101, "the long line is wrapped")
+}
@@ -0,0 +1,105 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+
+	gpb "github.com/BetterGR/grades-microservice/protos"
+)
+
+var ErrGradeTimeInvalid = errors.New("invalid grade time, expected RFC 3339")
+
+// PenaltyPolicy deducts points from grades submitted after their due time. The zero value applies
+// no penalty.
+type PenaltyPolicy struct {
+	// PointsPerDay is deducted for every started day past the due time.
+	PointsPerDay float64
+	// MaxPenalty caps the total deduction; zero leaves it uncapped.
+	MaxPenalty float64
+}
+
+// penaltyPolicyFromEnv reads the late penalty from LATE_PENALTY_PER_DAY and LATE_PENALTY_MAX.
+func penaltyPolicyFromEnv() PenaltyPolicy {
+	return PenaltyPolicy{
+		PointsPerDay: max(envFloat("LATE_PENALTY_PER_DAY", 0), 0),
+		MaxPenalty:   max(envFloat("LATE_PENALTY_MAX", 0), 0),
+	}
+}
+
+// penalty returns the points deducted from a submission, zero when it was on time or either time
+// is unknown.
+func (p PenaltyPolicy) penalty(submittedAt, dueAt time.Time) float64 {
+	if submittedAt.IsZero() || dueAt.IsZero() || !submittedAt.After(dueAt) {
+		return 0
+	}
+
+	daysLate := math.Ceil(submittedAt.Sub(dueAt).Hours() / 24)
+	penalty := daysLate * p.PointsPerDay
+
+	if p.MaxPenalty > 0 {
+		penalty = min(penalty, p.MaxPenalty)
+	}
+
+	return penalty
+}
+
+// EffectiveValue returns the rounded value of a grade after its late penalty, never below zero.
+// Non-numeric grades are returned unpenalized. The stored value is left intact.
+func (p PenaltyPolicy) EffectiveValue(grade *Grade, rounder GradeRounder) string {
+	number, ok := parseNumericGrade(grade.GradeValue)
+	if !ok {
+		return rounder.Round(grade.GradeValue)
+	}
+
+	penalty := p.penalty(grade.SubmittedAt, grade.DueAt)
+	if penalty == 0 {
+		return rounder.Round(grade.GradeValue)
+	}
+
+	return strconv.FormatFloat(rounder.RoundNumber(max(number-penalty, 0)), 'f', -1, 64)
+}
+
+// parseGradeTime parses an RFC 3339 submission or due time, returning the zero time when empty.
+func parseGradeTime(value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+
+	parsed, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%w: %q", ErrGradeTimeInvalid, value)
+	}
+
+	return parsed, nil
+}
+
+// validateGradeTimes checks the submission and due times of a grade.
+func validateGradeTimes(grade *gpb.SingleGrade) error {
+	for _, value := range []string{grade.GetSubmittedAt(), grade.GetDueAt()} {
+		if _, err := parseGradeTime(value); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// gradeTimeFromProto returns a submission or due time of a request, which validateGradeTimes has
+// already checked; an invalid time is treated as unknown.
+func gradeTimeFromProto(value string) time.Time {
+	parsed, _ := parseGradeTime(value)
+
+	return parsed
+}
+
+// gradeTimeToProto formats a submission or due time for a response, empty when unknown.
+func gradeTimeToProto(value time.Time) string {
+	if value.IsZero() {
+		return ""
+	}
+
+	return value.UTC().Format(time.RFC3339)
+}
@@ -0,0 +1,101 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	gpb "github.com/BetterGR/grades-microservice/protos"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestPenaltyPolicyEffectiveValue(t *testing.T) {
+	due := time.Date(2025, time.March, 10, 23, 59, 0, 0, time.UTC)
+	policy := PenaltyPolicy{PointsPerDay: 5, MaxPenalty: 20}
+
+	tests := []struct {
+		name      string
+		value     string
+		submitted time.Time
+		want      string
+	}{
+		{"on time", "90", due.Add(-time.Hour), "90"},
+		{"exactly at the due time", "90", due, "90"},
+		{"unknown submission", "90", time.Time{}, "90"},
+		{"an hour late counts a day", "90", due.Add(time.Hour), "85"},
+		{"three days late", "90", due.Add(72 * time.Hour), "75"},
+		{"clamped to the maximum penalty", "90", due.Add(30 * 24 * time.Hour), "70"},
+		{"never below zero", "10", due.Add(30 * 24 * time.Hour), "0"},
+		{"letter grades are not penalized", "A", due.Add(72 * time.Hour), "A"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			grade := &Grade{GradeValue: tt.value, SubmittedAt: tt.submitted, DueAt: due}
+			assert.Equal(t, tt.want, policy.EffectiveValue(grade, roundingNone))
+			assert.Equal(t, tt.value, grade.GradeValue, "the raw value must stay intact")
+		})
+	}
+}
+
+func TestPenaltyPolicyUncapped(t *testing.T) {
+	due := time.Date(2025, time.March, 10, 0, 0, 0, 0, time.UTC)
+	grade := &Grade{GradeValue: "90", SubmittedAt: due.Add(10 * 24 * time.Hour), DueAt: due}
+
+	assert.Equal(t, "40", PenaltyPolicy{PointsPerDay: 5}.EffectiveValue(grade, roundingNone))
+	assert.Equal(t, "90", PenaltyPolicy{}.EffectiveValue(grade, roundingNone))
+}
+
+func TestGetStudentCourseGradesEffectiveValue(t *testing.T) {
+	client := setupClient(t, func(s *GradesServer) {
+		s.latePenalty = PenaltyPolicy{PointsPerDay: 10, MaxPenalty: 15}
+	})
+
+	onTime := createTestGrade()
+	onTime.GradeValue = "80"
+	onTime.DueAt = "2025-03-10T23:59:00Z"
+	onTime.SubmittedAt = "2025-03-10T12:00:00Z"
+
+	late := createTestGrade()
+	late.StudentID = onTime.GetStudentID()
+	late.CourseID = onTime.GetCourseID()
+	late.GradeValue = "80"
+	late.DueAt = "2025-03-10T23:59:00Z"
+	late.SubmittedAt = "2025-03-13T08:00:00Z"
+
+	for _, grade := range []*gpb.SingleGrade{onTime, late} {
+		_, err := client.AddSingleGrade(context.Background(), &gpb.AddSingleGradeRequest{Token: "test-token", Grade: grade})
+		require.NoError(t, err)
+	}
+
+	resp, err := client.GetStudentCourseGrades(context.Background(), &gpb.GetStudentCourseGradesRequest{
+		Token: "test-token", CourseID: onTime.GetCourseID(), Semester: onTime.GetSemester(),
+		StudentID: onTime.GetStudentID(),
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.GetGrades(), 2)
+
+	for _, grade := range resp.GetGrades() {
+		assert.Equal(t, "80", grade.GetGradeValue(), "the raw value must stay intact")
+
+		if grade.GetGradeID() == late.GetGradeID() {
+			assert.Equal(t, "65", grade.GetEffectiveValue(), "late grades are penalized up to the maximum")
+			assert.Equal(t, late.GetSubmittedAt(), grade.GetSubmittedAt())
+		} else {
+			assert.Equal(t, "80", grade.GetEffectiveValue())
+		}
+	}
+}
+
+func TestAddGradeInvalidTime(t *testing.T) {
+	client := setupClient(t)
+
+	grade := createTestGrade()
+	grade.DueAt = "next tuesday"
+
+	_, err := client.AddSingleGrade(context.Background(), &gpb.AddSingleGradeRequest{Token: "test-token", Grade: grade})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -0,0 +1,530 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	gpb "github.com/BetterGR/grades-microservice/protos"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// defaultAcquireTimeout bounds the wait for a free database connection when DB_ACQUIRE_TIMEOUT is unset.
+	defaultAcquireTimeout = 5 * time.Second
+	// defaultMinIdleConns is the number of connections warmed up when DB_MIN_IDLE_CONNS is unset.
+	defaultMinIdleConns = 2
+)
+
+// ErrDatabaseBusy is returned when no database connection frees up within the acquire timeout.
+var ErrDatabaseBusy = errors.New("database busy")
+
+// pooledDatabase admits at most as many concurrent operations as the connection pool holds, so an
+// exhausted pool fails fast with ErrDatabaseBusy instead of queueing until the request deadline.
+// Each operation holds a single slot until it returns, including the reads it makes internally.
+type pooledDatabase struct {
+	db      DBInterface
+	slots   chan struct{}
+	timeout time.Duration
+}
+
+// warmup opens and pings conns connections at once, then returns them to the pool as idle
+// connections, so the first requests after startup do not pay for connecting. It never opens more
+// connections than the pool allows and returns the number it warmed up.
+func (d *Database) warmup(ctx context.Context, conns int) (int, error) {
+	if maxOpen := d.db.Stats().MaxOpenConnections; maxOpen > 0 {
+		conns = min(conns, maxOpen)
+	}
+
+	d.db.SetMaxIdleConns(conns)
+
+	opened := make([]*sql.Conn, 0, conns)
+
+	defer func() {
+		for _, conn := range opened {
+			_ = conn.Close()
+		}
+	}()
+
+	for range conns {
+		conn, err := d.db.Conn(ctx)
+		if err != nil {
+			return 0, fmt.Errorf("failed to open connection: %w", err)
+		}
+
+		opened = append(opened, conn.Conn)
+
+		if err := conn.PingContext(ctx); err != nil {
+			return 0, fmt.Errorf("failed to ping connection: %w", err)
+		}
+	}
+
+	return len(opened), nil
+}
+
+// Verify that pooledDatabase implements DBInterface at compile time.
+var _ DBInterface = (*pooledDatabase)(nil)
+
+// newPooledDatabase limits db to maxConns concurrent operations, each waiting at most timeout for a slot.
+func newPooledDatabase(db DBInterface, maxConns int, timeout time.Duration) *pooledDatabase {
+	return &pooledDatabase{db: db, slots: make(chan struct{}, maxConns), timeout: timeout}
+}
+
+// acquire waits for a free slot and returns the function releasing it. It fails with ErrDatabaseBusy
+// once the timeout passes, and with the context error when the request ends first.
+func (p *pooledDatabase) acquire(ctx context.Context) (func(), error) {
+	timer := time.NewTimer(p.timeout)
+	defer timer.Stop()
+
+	select {
+	case p.slots <- struct{}{}:
+		return func() { <-p.slots }, nil
+	case <-timer.C:
+		return nil, fmt.Errorf("%w: no connection available after %s", ErrDatabaseBusy, p.timeout)
+	case <-ctx.Done():
+		return nil, fmt.Errorf("failed to acquire database connection: %w", ctx.Err())
+	}
+}
+
+// run calls fn once a slot is free.
+func (p *pooledDatabase) run(ctx context.Context, fn func() error) error {
+	release, err := p.acquire(ctx)
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	return fn()
+}
+
+// withConnection calls fn once a slot of p is free and returns its result.
+func withConnection[T any](ctx context.Context, p *pooledDatabase, fn func() (T, error)) (T, error) {
+	release, err := p.acquire(ctx)
+	if err != nil {
+		var zero T
+
+		return zero, err
+	}
+	defer release()
+
+	return fn()
+}
+
+// busyStatus reports ErrDatabaseBusy as ResourceExhausted and ErrCircuitOpen as Unavailable, so
+// callers can tell a saturated pool or an unhealthy database from a failing or slow query.
+func busyStatus(err error) error {
+	switch {
+	case errors.Is(err, ErrDatabaseBusy):
+		return status.Error(codes.ResourceExhausted, err.Error())
+	case errors.Is(err, ErrCircuitOpen):
+		return status.Error(codes.Unavailable, err.Error())
+	}
+
+	return err
+}
+
+// busyInterceptor applies busyStatus to the errors of unary requests.
+func busyInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, _ *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		resp, err := handler(ctx, req)
+
+		return resp, busyStatus(err)
+	}
+}
+
+// busyStreamInterceptor applies busyStatus to the errors of streaming requests.
+func busyStreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, _ *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		return busyStatus(handler(srv, stream))
+	}
+}
+
+// AddGrade runs once a connection is free.
+func (p *pooledDatabase) AddGrade(ctx context.Context, grade *gpb.SingleGrade) (*Grade, error) {
+	return withConnection(ctx, p, func() (*Grade, error) {
+		return p.db.AddGrade(ctx, grade)
+	})
+}
+
+// AddGrades runs once a connection is free.
+func (p *pooledDatabase) AddGrades(ctx context.Context, grades []*gpb.SingleGrade) ([]*Grade, error) {
+	return withConnection(ctx, p, func() ([]*Grade, error) {
+		return p.db.AddGrades(ctx, grades)
+	})
+}
+
+// GetCourseGrades runs once a connection is free.
+func (p *pooledDatabase) GetCourseGrades(ctx context.Context, courseID, semester string) ([]*Grade, error) {
+	return withConnection(ctx, p, func() ([]*Grade, error) {
+		return p.db.GetCourseGrades(ctx, courseID, semester)
+	})
+}
+
+// StreamCourseGrades runs once a connection is free.
+func (p *pooledDatabase) StreamCourseGrades(ctx context.Context,
+	courseID, semester string, fn func(*Grade) error,
+) error {
+	return p.run(ctx, func() error {
+		return p.db.StreamCourseGrades(ctx, courseID, semester, fn)
+	})
+}
+
+// StreamSemesterGrades runs once a connection is free.
+func (p *pooledDatabase) StreamSemesterGrades(ctx context.Context, semester string, fn func(*Grade) error) error {
+	return p.run(ctx, func() error {
+		return p.db.StreamSemesterGrades(ctx, semester, fn)
+	})
+}
+
+// GetStudentCourseGrades runs once a connection is free.
+func (p *pooledDatabase) GetStudentCourseGrades(ctx context.Context,
+	courseID, semester, studentID string,
+) ([]*Grade, error) {
+	return withConnection(ctx, p, func() ([]*Grade, error) {
+		return p.db.GetStudentCourseGrades(ctx, courseID, semester, studentID)
+	})
+}
+
+// UpdateGrade runs once a connection is free.
+func (p *pooledDatabase) UpdateGrade(ctx context.Context, grade *gpb.SingleGrade) (*Grade, error) {
+	return withConnection(ctx, p, func() (*Grade, error) {
+		return p.db.UpdateGrade(ctx, grade)
+	})
+}
+
+// UndoGradeChange runs once a connection is free.
+func (p *pooledDatabase) UndoGradeChange(ctx context.Context, gradeID string, version int64) (*Grade, error) {
+	return withConnection(ctx, p, func() (*Grade, error) {
+		return p.db.UndoGradeChange(ctx, gradeID, version)
+	})
+}
+
+// TransitionAppeal runs once a connection is free.
+func (p *pooledDatabase) TransitionAppeal(ctx context.Context, gradeID, from, to, value string) (*Grade, error) {
+	return withConnection(ctx, p, func() (*Grade, error) {
+		return p.db.TransitionAppeal(ctx, gradeID, from, to, value)
+	})
+}
+
+// RemoveGrade runs once a connection is free.
+func (p *pooledDatabase) RemoveGrade(ctx context.Context, gradeID string) error {
+	return p.run(ctx, func() error {
+		return p.db.RemoveGrade(ctx, gradeID)
+	})
+}
+
+// PurgeTombstones runs once a connection is free.
+func (p *pooledDatabase) PurgeTombstones(ctx context.Context, cutoff time.Time) (int64, error) {
+	return withConnection(ctx, p, func() (int64, error) {
+		return p.db.PurgeTombstones(ctx, cutoff)
+	})
+}
+
+// SoftDeleteStudentGrades runs once a connection is free.
+func (p *pooledDatabase) SoftDeleteStudentGrades(ctx context.Context, studentID, actor string) ([]string, error) {
+	return withConnection(ctx, p, func() ([]string, error) {
+		return p.db.SoftDeleteStudentGrades(ctx, studentID, actor)
+	})
+}
+
+// GetStudentSemesterGrades runs once a connection is free.
+func (p *pooledDatabase) GetStudentSemesterGrades(ctx context.Context, studentID, semester string) ([]*Grade, error) {
+	return withConnection(ctx, p, func() ([]*Grade, error) {
+		return p.db.GetStudentSemesterGrades(ctx, studentID, semester)
+	})
+}
+
+// GetStudentGrades runs once a connection is free.
+func (p *pooledDatabase) GetStudentGrades(ctx context.Context, studentID string) ([]*Grade, error) {
+	return withConnection(ctx, p, func() ([]*Grade, error) {
+		return p.db.GetStudentGrades(ctx, studentID)
+	})
+}
+
+// GetStudentGradesGrouped runs once a connection is free.
+func (p *pooledDatabase) GetStudentGradesGrouped(ctx context.Context,
+	studentID string,
+) (map[string]map[string][]*Grade, error) {
+	return withConnection(ctx, p, func() (map[string]map[string][]*Grade, error) {
+		return p.db.GetStudentGradesGrouped(ctx, studentID)
+	})
+}
+
+// ArchiveSemester runs once a connection is free.
+func (p *pooledDatabase) ArchiveSemester(ctx context.Context, semester string) (int64, error) {
+	return withConnection(ctx, p, func() (int64, error) {
+		return p.db.ArchiveSemester(ctx, semester)
+	})
+}
+
+// GetArchivedCourseGrades runs once a connection is free.
+func (p *pooledDatabase) GetArchivedCourseGrades(ctx context.Context, courseID, semester string) ([]*Grade, error) {
+	return withConnection(ctx, p, func() ([]*Grade, error) {
+		return p.db.GetArchivedCourseGrades(ctx, courseID, semester)
+	})
+}
+
+// GetGradeByID runs once a connection is free.
+func (p *pooledDatabase) GetGradeByID(ctx context.Context, gradeID string) (*Grade, error) {
+	return withConnection(ctx, p, func() (*Grade, error) {
+		return p.db.GetGradeByID(ctx, gradeID)
+	})
+}
+
+// AddCourseGrader runs once a connection is free.
+func (p *pooledDatabase) AddCourseGrader(ctx context.Context, courseID, graderID string) error {
+	return p.run(ctx, func() error {
+		return p.db.AddCourseGrader(ctx, courseID, graderID)
+	})
+}
+
+// RemoveCourseGrader runs once a connection is free.
+func (p *pooledDatabase) RemoveCourseGrader(ctx context.Context, courseID, graderID string) error {
+	return p.run(ctx, func() error {
+		return p.db.RemoveCourseGrader(ctx, courseID, graderID)
+	})
+}
+
+// GetCourseGraders runs once a connection is free.
+func (p *pooledDatabase) GetCourseGraders(ctx context.Context, courseID string) ([]string, error) {
+	return withConnection(ctx, p, func() ([]string, error) {
+		return p.db.GetCourseGraders(ctx, courseID)
+	})
+}
+
+// SetCourseRelease runs once a connection is free.
+func (p *pooledDatabase) SetCourseRelease(ctx context.Context, courseID, semester string, releaseAt time.Time) error {
+	return p.run(ctx, func() error {
+		return p.db.SetCourseRelease(ctx, courseID, semester, releaseAt)
+	})
+}
+
+// GetPendingReleases runs once a connection is free.
+func (p *pooledDatabase) GetPendingReleases(ctx context.Context, now time.Time) ([]*CourseRelease, error) {
+	return withConnection(ctx, p, func() ([]*CourseRelease, error) {
+		return p.db.GetPendingReleases(ctx, now)
+	})
+}
+
+// SetGradeTypeScheme runs once a connection is free.
+func (p *pooledDatabase) SetGradeTypeScheme(ctx context.Context, courseID, gradeType string, scheme GradeScheme) error {
+	return p.run(ctx, func() error {
+		return p.db.SetGradeTypeScheme(ctx, courseID, gradeType, scheme)
+	})
+}
+
+// GetGradeTypeSchemes runs once a connection is free.
+func (p *pooledDatabase) GetGradeTypeSchemes(ctx context.Context, courseID string) (map[string]GradeScheme, error) {
+	return withConnection(ctx, p, func() (map[string]GradeScheme, error) {
+		return p.db.GetGradeTypeSchemes(ctx, courseID)
+	})
+}
+
+// SetAttemptPolicy runs once a connection is free.
+func (p *pooledDatabase) SetAttemptPolicy(ctx context.Context, courseID string, policy AttemptPolicy) error {
+	return p.run(ctx, func() error {
+		return p.db.SetAttemptPolicy(ctx, courseID, policy)
+	})
+}
+
+// GetAttemptPolicy runs once a connection is free.
+func (p *pooledDatabase) GetAttemptPolicy(ctx context.Context, courseID string) (AttemptPolicy, error) {
+	return withConnection(ctx, p, func() (AttemptPolicy, error) {
+		return p.db.GetAttemptPolicy(ctx, courseID)
+	})
+}
+
+// RefreshCourseStats runs once a connection is free.
+func (p *pooledDatabase) RefreshCourseStats(ctx context.Context) error {
+	return p.run(ctx, func() error {
+		return p.db.RefreshCourseStats(ctx)
+	})
+}
+
+// GetCourseStats runs once a connection is free.
+func (p *pooledDatabase) GetCourseStats(ctx context.Context,
+	courseID, semester string, maxStaleness time.Duration,
+) (*CourseStats, error) {
+	return withConnection(ctx, p, func() (*CourseStats, error) {
+		return p.db.GetCourseStats(ctx, courseID, semester, maxStaleness)
+	})
+}
+
+// GetGradeValue runs once a connection is free.
+func (p *pooledDatabase) GetGradeValue(ctx context.Context, key GradeKey) (string, error) {
+	return withConnection(ctx, p, func() (string, error) {
+		return p.db.GetGradeValue(ctx, key)
+	})
+}
+
+// GetGradeVersion runs once a connection is free.
+func (p *pooledDatabase) GetGradeVersion(ctx context.Context, gradeID string, version int64) (*Grade, error) {
+	return withConnection(ctx, p, func() (*Grade, error) {
+		return p.db.GetGradeVersion(ctx, gradeID, version)
+	})
+}
+
+// SearchGradeComments runs once a connection is free.
+func (p *pooledDatabase) SearchGradeComments(ctx context.Context, courseID, semester, term string) ([]*Grade, error) {
+	return withConnection(ctx, p, func() ([]*Grade, error) {
+		return p.db.SearchGradeComments(ctx, courseID, semester, term)
+	})
+}
+
+// GetCourseGradesETag runs once a connection is free.
+func (p *pooledDatabase) GetCourseGradesETag(ctx context.Context, courseID, semester string) (string, error) {
+	return withConnection(ctx, p, func() (string, error) {
+		return p.db.GetCourseGradesETag(ctx, courseID, semester)
+	})
+}
+
+// SetGradeFlag runs once a connection is free.
+func (p *pooledDatabase) SetGradeFlag(ctx context.Context, gradeID string, flagged bool) (*Grade, error) {
+	return withConnection(ctx, p, func() (*Grade, error) {
+		return p.db.SetGradeFlag(ctx, gradeID, flagged)
+	})
+}
+
+// GetFlaggedGrades runs once a connection is free.
+func (p *pooledDatabase) GetFlaggedGrades(ctx context.Context, courseID, semester string) ([]*Grade, error) {
+	return withConnection(ctx, p, func() ([]*Grade, error) {
+		return p.db.GetFlaggedGrades(ctx, courseID, semester)
+	})
+}
+
+// GetMultiCourseGrades runs once a connection is free.
+func (p *pooledDatabase) GetMultiCourseGrades(ctx context.Context,
+	courseIDs []string, semester string,
+) (map[string][]*Grade, error) {
+	return withConnection(ctx, p, func() (map[string][]*Grade, error) {
+		return p.db.GetMultiCourseGrades(ctx, courseIDs, semester)
+	})
+}
+
+// GetCourseGradesBySemester runs once a connection is free.
+func (p *pooledDatabase) GetCourseGradesBySemester(ctx context.Context,
+	courseID string, semesters []string,
+) (map[string][]*Grade, error) {
+	return withConnection(ctx, p, func() (map[string][]*Grade, error) {
+		return p.db.GetCourseGradesBySemester(ctx, courseID, semesters)
+	})
+}
+
+// GetStudentGradesBySemester runs once a connection is free.
+func (p *pooledDatabase) GetStudentGradesBySemester(ctx context.Context,
+	studentID string, semesters []string,
+) (map[string][]*Grade, error) {
+	return withConnection(ctx, p, func() (map[string][]*Grade, error) {
+		return p.db.GetStudentGradesBySemester(ctx, studentID, semesters)
+	})
+}
+
+// SearchStudentGrades runs once a connection is free.
+func (p *pooledDatabase) SearchStudentGrades(ctx context.Context, prefix string, limit int) ([]*Grade, error) {
+	return withConnection(ctx, p, func() ([]*Grade, error) {
+		return p.db.SearchStudentGrades(ctx, prefix, limit)
+	})
+}
+
+// RecordFailedEvent runs once a connection is free.
+func (p *pooledDatabase) RecordFailedEvent(ctx context.Context, event *FailedEvent) error {
+	return p.run(ctx, func() error {
+		return p.db.RecordFailedEvent(ctx, event)
+	})
+}
+
+// GetFailedEvents runs once a connection is free.
+func (p *pooledDatabase) GetFailedEvents(ctx context.Context, limit int) ([]*FailedEvent, error) {
+	return withConnection(ctx, p, func() ([]*FailedEvent, error) {
+		return p.db.GetFailedEvents(ctx, limit)
+	})
+}
+
+// RecordFailedEventAttempt runs once a connection is free.
+func (p *pooledDatabase) RecordFailedEventAttempt(ctx context.Context, eventID int64, lastError string) error {
+	return p.run(ctx, func() error {
+		return p.db.RecordFailedEventAttempt(ctx, eventID, lastError)
+	})
+}
+
+// RemoveFailedEvent runs once a connection is free.
+func (p *pooledDatabase) RemoveFailedEvent(ctx context.Context, eventID int64) error {
+	return p.run(ctx, func() error {
+		return p.db.RemoveFailedEvent(ctx, eventID)
+	})
+}
+
+// GetGradeVersions runs once a connection is free.
+func (p *pooledDatabase) GetGradeVersions(ctx context.Context, gradeID string) ([]*Grade, error) {
+	return withConnection(ctx, p, func() ([]*Grade, error) {
+		return p.db.GetGradeVersions(ctx, gradeID)
+	})
+}
+
+// GetCourseGradeVersions runs once a connection is free.
+func (p *pooledDatabase) GetCourseGradeVersions(ctx context.Context,
+	courseID, semester string,
+) (map[string][]*Grade, error) {
+	return withConnection(ctx, p, func() (map[string][]*Grade, error) {
+		return p.db.GetCourseGradeVersions(ctx, courseID, semester)
+	})
+}
+
+// RecordAudit runs once a connection is free.
+func (p *pooledDatabase) RecordAudit(ctx context.Context, entry *AuditEntry) error {
+	return p.run(ctx, func() error {
+		return p.db.RecordAudit(ctx, entry)
+	})
+}
+
+// GetGradeAudit runs once a connection is free.
+func (p *pooledDatabase) GetGradeAudit(ctx context.Context, gradeID string) ([]*AuditEntry, error) {
+	return withConnection(ctx, p, func() ([]*AuditEntry, error) {
+		return p.db.GetGradeAudit(ctx, gradeID)
+	})
+}
+
+// GetSemesterGradeTypeSummary runs once a connection is free.
+func (p *pooledDatabase) GetSemesterGradeTypeSummary(ctx context.Context,
+	semester string,
+) ([]*GradeTypeSummary, error) {
+	return withConnection(ctx, p, func() ([]*GradeTypeSummary, error) {
+		return p.db.GetSemesterGradeTypeSummary(ctx, semester)
+	})
+}
+
+// SetNotificationOptOut runs once a connection is free.
+func (p *pooledDatabase) SetNotificationOptOut(ctx context.Context, studentID string, optOut bool) error {
+	return p.run(ctx, func() error {
+		return p.db.SetNotificationOptOut(ctx, studentID, optOut)
+	})
+}
+
+// IsNotificationOptedOut runs once a connection is free.
+func (p *pooledDatabase) IsNotificationOptedOut(ctx context.Context, studentID string) (bool, error) {
+	return withConnection(ctx, p, func() (bool, error) {
+		return p.db.IsNotificationOptedOut(ctx, studentID)
+	})
+}
+
+// SetNotificationDigest runs once a connection is free.
+func (p *pooledDatabase) SetNotificationDigest(ctx context.Context, studentID string, digest bool) error {
+	return p.run(ctx, func() error {
+		return p.db.SetNotificationDigest(ctx, studentID, digest)
+	})
+}
+
+// IsNotificationDigestEnabled runs once a connection is free.
+func (p *pooledDatabase) IsNotificationDigestEnabled(ctx context.Context, studentID string) (bool, error) {
+	return withConnection(ctx, p, func() (bool, error) {
+		return p.db.IsNotificationDigestEnabled(ctx, studentID)
+	})
+}
@@ -0,0 +1,84 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	gpb "github.com/BetterGR/grades-microservice/protos"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// holdConnection streams the course through pooled and keeps its slot until the returned function is called.
+func holdConnection(t *testing.T, pooled *pooledDatabase, courseID, semester string) func() {
+	t.Helper()
+
+	held := make(chan struct{})
+	release := make(chan struct{})
+	done := make(chan error, 1)
+
+	go func() {
+		done <- pooled.StreamCourseGrades(context.Background(), courseID, semester, func(*Grade) error {
+			close(held)
+			<-release
+
+			return nil
+		})
+	}()
+
+	<-held
+
+	return func() {
+		close(release)
+		require.NoError(t, <-done)
+	}
+}
+
+func TestPooledDatabaseBusy(t *testing.T) {
+	pooled := newPooledDatabase(NewMockDatabase(), 1, 50*time.Millisecond)
+	grade := createTestGrade()
+
+	_, err := pooled.AddGrade(context.Background(), grade)
+	require.NoError(t, err)
+
+	release := holdConnection(t, pooled, grade.GetCourseID(), grade.GetSemester())
+
+	_, err = pooled.GetCourseGrades(context.Background(), grade.GetCourseID(), grade.GetSemester())
+	require.ErrorIs(t, err, ErrDatabaseBusy)
+
+	release()
+
+	grades, err := pooled.GetCourseGrades(context.Background(), grade.GetCourseID(), grade.GetSemester())
+	require.NoError(t, err)
+	assert.Len(t, grades, 1)
+}
+
+func TestPooledDatabaseRequestDeadline(t *testing.T) {
+	pooled := newPooledDatabase(NewMockDatabase(), 1, time.Minute)
+	pooled.slots <- struct{}{}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	_, err := pooled.GetCourseGrades(ctx, "course", "Winter_2023")
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.NotErrorIs(t, err, ErrDatabaseBusy, "a request deadline is not reported as a busy database")
+}
+
+func TestBusyDatabaseStatus(t *testing.T) {
+	pooled := newPooledDatabase(NewMockDatabase(), 1, 10*time.Millisecond)
+	pooled.slots <- struct{}{}
+
+	client := setupClient(t, func(s *GradesServer) {
+		s.db = pooled
+	})
+
+	_, err := client.GetCourseGrades(context.Background(), &gpb.GetCourseGradesRequest{
+		Token: "test-token", CourseID: "course", Semester: "Winter_2023",
+	})
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	assert.Contains(t, status.Convert(err).Message(), "database busy")
+}
@@ -0,0 +1,154 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
+	gpb "github.com/BetterGR/grades-microservice/protos"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/klog/v2"
+)
+
+const (
+	// defaultProbationThreshold is the GPA below which a student is on probation when
+	// PROBATION_GPA_THRESHOLD is unset.
+	defaultProbationThreshold = 65
+	// defaultProbationMinCourses is the number of graded courses a GPA must cover before it can put a
+	// student on probation when PROBATION_MIN_COURSES is unset.
+	defaultProbationMinCourses = 2
+)
+
+// Probation modes, deciding which GPA is compared to the threshold.
+const (
+	probationModeCumulative = "cumulative"
+	probationModeSemester   = "semester"
+)
+
+// Probation statuses.
+const (
+	probationStatusProbation        = "probation"
+	probationStatusGoodStanding     = "good_standing"
+	probationStatusInsufficientData = "insufficient_data"
+)
+
+var ErrInvalidProbationMode = errors.New("invalid probation mode")
+
+// ProbationPolicy decides which students are on academic probation: those whose GPA, over enough
+// graded courses, falls below the threshold.
+type ProbationPolicy struct {
+	// Threshold is the lowest GPA in good standing.
+	Threshold float64
+	// Mode is probationModeCumulative to judge the GPA across all semesters, or
+	// probationModeSemester to judge the GPA of a single semester.
+	Mode string
+	// MinCourses is the number of courses with numeric grades the GPA must cover.
+	MinCourses int
+}
+
+// parseProbationMode parses PROBATION_MODE, defaulting to cumulative.
+func parseProbationMode(raw string) (string, error) {
+	switch raw {
+	case "":
+		return probationModeCumulative, nil
+	case probationModeCumulative, probationModeSemester:
+		return raw, nil
+	default:
+		return "", fmt.Errorf("%w: %q, must be %q or %q", ErrInvalidProbationMode, raw,
+			probationModeCumulative, probationModeSemester)
+	}
+}
+
+// probationPolicyFromEnv reads the probation policy from PROBATION_GPA_THRESHOLD, PROBATION_MODE
+// and PROBATION_MIN_COURSES.
+func probationPolicyFromEnv() (ProbationPolicy, error) {
+	mode, err := parseProbationMode(os.Getenv("PROBATION_MODE"))
+	if err != nil {
+		return ProbationPolicy{}, err
+	}
+
+	return ProbationPolicy{
+		Threshold:  envFloat("PROBATION_GPA_THRESHOLD", defaultProbationThreshold),
+		Mode:       mode,
+		MinCourses: max(envInt("PROBATION_MIN_COURSES", defaultProbationMinCourses), 1),
+	}, nil
+}
+
+// status returns the probation status of a student with the GPA over courses, and how far the GPA
+// falls short of the threshold; the deficit is zero unless the student is on probation.
+func (p ProbationPolicy) status(gpa float64, courses int) (string, float64) {
+	switch {
+	case courses == 0 || courses < p.MinCourses:
+		return probationStatusInsufficientData, 0
+	case gpa < p.Threshold:
+		return probationStatusProbation, p.Threshold - gpa
+	default:
+		return probationStatusGoodStanding, 0
+	}
+}
+
+// GetProbationStatus reports whether a student is on academic probation, comparing their GPA to
+// the configured threshold. The configured mode picks the cumulative GPA or that of the requested
+// semester. Students with too few graded courses get "insufficient_data" rather than a verdict.
+// Students may only check their own status; staff and admins may check anyone's.
+func (s *GradesServer) GetProbationStatus(ctx context.Context,
+	req *gpb.GetProbationStatusRequest,
+) (*gpb.GetProbationStatusResponse, error) {
+	claims, err := s.getClaims(ctx, req.GetToken())
+	if err != nil {
+		return nil, fmt.Errorf("authentication failed: %w",
+			status.Error(codes.Unauthenticated, err.Error()))
+	}
+
+	if !claims.HasRole(roleStaff) && !claims.HasRole(roleAdmin) && callerIdentity(claims) != req.GetStudentID() {
+		return nil, fmt.Errorf("authorization failed: %w",
+			status.Error(codes.PermissionDenied, "students may only check their own probation status"))
+	}
+
+	logger := klog.FromContext(ctx)
+	logger.V(logLevelDebug).Info("Received request for probation status", "student_id", req.GetStudentID(),
+		"semester", req.GetSemester())
+
+	policy := s.probation
+
+	var grades []*Grade
+
+	if policy.Mode == probationModeSemester {
+		if req.GetSemester() == "" {
+			return nil, fmt.Errorf("failed to get probation status: %w",
+				status.Error(codes.InvalidArgument, ErrSemesterEmpty.Error()))
+		}
+
+		grades, err = s.db.GetStudentSemesterGrades(ctx, req.GetStudentID(), req.GetSemester())
+	} else {
+		grades, err = s.db.GetStudentGrades(ctx, req.GetStudentID())
+	}
+
+	if errors.Is(err, ErrStudentIDEmpty) {
+		return nil, fmt.Errorf("failed to get probation status: %w",
+			status.Error(codes.InvalidArgument, err.Error()))
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to get probation status: %w", err)
+	}
+
+	// Grades hidden from the student must not show through the status.
+	embargo, err := s.loadEmbargo(ctx, gradeViewRole(claims))
+	if err != nil {
+		return nil, err
+	}
+
+	gpa, courses := computeGPA(embargo.released(grades), s.weights)
+	standing, deficit := policy.status(gpa, courses)
+
+	return &gpb.GetProbationStatusResponse{
+		Status:      standing,
+		Gpa:         s.rounder.RoundNumber(gpa),
+		Threshold:   policy.Threshold,
+		Deficit:     s.rounder.RoundNumber(deficit),
+		Mode:        policy.Mode,
+		CourseCount: int32(courses),
+		MinCourses:  int32(policy.MinCourses),
+	}, nil
+}
@@ -0,0 +1,145 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	gpb "github.com/BetterGR/grades-microservice/protos"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestParseProbationMode(t *testing.T) {
+	mode, err := parseProbationMode("")
+	require.NoError(t, err)
+	assert.Equal(t, probationModeCumulative, mode)
+
+	mode, err = parseProbationMode(probationModeSemester)
+	require.NoError(t, err)
+	assert.Equal(t, probationModeSemester, mode)
+
+	_, err = parseProbationMode("yearly")
+	require.ErrorIs(t, err, ErrInvalidProbationMode)
+}
+
+func TestProbationPolicyStatus(t *testing.T) {
+	policy := ProbationPolicy{Threshold: 65, MinCourses: 2}
+
+	standing, deficit := policy.status(60, 2)
+	assert.Equal(t, probationStatusProbation, standing)
+	assert.InDelta(t, 5, deficit, 1e-9)
+
+	standing, deficit = policy.status(65, 3)
+	assert.Equal(t, probationStatusGoodStanding, standing)
+	assert.Zero(t, deficit)
+
+	standing, deficit = policy.status(40, 1)
+	assert.Equal(t, probationStatusInsufficientData, standing, "too few courses")
+	assert.Zero(t, deficit)
+}
+
+func addProbationGrades(t *testing.T, db *MockDatabase) {
+	t.Helper()
+
+	for _, grade := range []*gpb.SingleGrade{
+		{StudentID: "s1", CourseID: "c1", Semester: "Winter_2023", GradeValue: "50"},
+		{StudentID: "s1", CourseID: "c2", Semester: "Winter_2023", GradeValue: "60"},
+		{StudentID: "s1", CourseID: "c3", Semester: "Spring_2024", GradeValue: "90"},
+		{StudentID: "s1", CourseID: "c4", Semester: "Spring_2024", GradeValue: "80"},
+		{StudentID: "s2", CourseID: "c1", Semester: "Winter_2023", GradeValue: "80"},
+		{StudentID: "s2", CourseID: "c2", Semester: "Winter_2023", GradeValue: "70"},
+		{StudentID: "s3", CourseID: "c1", Semester: "Winter_2023", GradeValue: "30"},
+		{StudentID: "s3", CourseID: "c2", Semester: "Winter_2023", GradeValue: "Fail"},
+	} {
+		_, err := db.AddGrade(context.Background(), grade)
+		require.NoError(t, err)
+	}
+}
+
+func TestGetProbationStatus(t *testing.T) {
+	db := NewMockDatabase()
+	client := setupClient(t, func(s *GradesServer) {
+		s.db = db
+		s.probation = ProbationPolicy{Threshold: 70, Mode: probationModeCumulative, MinCourses: 2}
+	})
+	addProbationGrades(t, db)
+
+	tests := []struct {
+		studentID string
+		status    string
+		gpa       float64
+		deficit   float64
+	}{
+		{studentID: "s1", status: probationStatusGoodStanding, gpa: 70},
+		{studentID: "s2", status: probationStatusGoodStanding, gpa: 75},
+		// The pass/fail course does not count, which leaves s3 a course short of a verdict.
+		{studentID: "s3", status: probationStatusInsufficientData, gpa: 30},
+		{studentID: "unknown", status: probationStatusInsufficientData},
+	}
+
+	for _, tt := range tests {
+		resp, err := client.GetProbationStatus(context.Background(), &gpb.GetProbationStatusRequest{
+			Token: "test-token", StudentID: tt.studentID,
+		})
+		require.NoError(t, err, tt.studentID)
+		assert.Equal(t, tt.status, resp.GetStatus(), tt.studentID)
+		assert.InDelta(t, tt.gpa, resp.GetGpa(), 1e-9, tt.studentID)
+		assert.InDelta(t, tt.deficit, resp.GetDeficit(), 1e-9, tt.studentID)
+		assert.Equal(t, probationModeCumulative, resp.GetMode())
+		assert.InDelta(t, 70, resp.GetThreshold(), 1e-9)
+	}
+
+	_, err := client.GetProbationStatus(context.Background(), &gpb.GetProbationStatusRequest{Token: "test-token"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestGetProbationStatusSemesterMode(t *testing.T) {
+	db := NewMockDatabase()
+	client := setupClient(t, func(s *GradesServer) {
+		s.db = db
+		s.probation = ProbationPolicy{Threshold: 70, Mode: probationModeSemester, MinCourses: 2}
+	})
+	addProbationGrades(t, db)
+
+	// A poor semester puts a student on probation even when their cumulative GPA is in good standing.
+	resp, err := client.GetProbationStatus(context.Background(), &gpb.GetProbationStatusRequest{
+		Token: "test-token", StudentID: "s1", Semester: "Winter_2023",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, probationStatusProbation, resp.GetStatus())
+	assert.InDelta(t, 55, resp.GetGpa(), 1e-9)
+	assert.InDelta(t, 15, resp.GetDeficit(), 1e-9)
+	assert.Equal(t, int32(2), resp.GetCourseCount())
+	assert.Equal(t, probationModeSemester, resp.GetMode())
+
+	resp, err = client.GetProbationStatus(context.Background(), &gpb.GetProbationStatusRequest{
+		Token: "test-token", StudentID: "s1", Semester: "Spring_2024",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, probationStatusGoodStanding, resp.GetStatus())
+
+	_, err = client.GetProbationStatus(context.Background(), &gpb.GetProbationStatusRequest{
+		Token: "test-token", StudentID: "s1",
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err), "semester mode needs a semester")
+}
+
+func TestGetProbationStatusAuthorization(t *testing.T) {
+	student := setupClient(t, func(s *GradesServer) {
+		verifiedCaller(roleStudent)(s)
+		s.probation = ProbationPolicy{Threshold: 70, Mode: probationModeCumulative, MinCourses: 2}
+	})
+
+	resp, err := student.GetProbationStatus(context.Background(), &gpb.GetProbationStatusRequest{
+		Token: studentToken, StudentID: "student-1",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, probationStatusInsufficientData, resp.GetStatus())
+
+	_, err = student.GetProbationStatus(context.Background(), &gpb.GetProbationStatusRequest{
+		Token: studentToken, StudentID: "student-2",
+	})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
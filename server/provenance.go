@@ -0,0 +1,169 @@
+package main
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	gpb "github.com/BetterGR/grades-microservice/protos"
+	ms "github.com/TekClinic/MicroService-Lib"
+	"k8s.io/klog/v2"
+)
+
+// Provenance event kinds.
+const (
+	provenanceCreated = "created"
+	provenanceUpdated = "updated"
+	provenanceViewed  = "viewed"
+	provenanceDeleted = "deleted"
+)
+
+// Audit actions.
+const (
+	// auditActionView is recorded when a grade is read.
+	auditActionView = "view"
+	// auditActionDelete is recorded when a grade is removed.
+	auditActionDelete = "delete"
+)
+
+// unknownCaller names callers whose claims carry no identity.
+const unknownCaller = "unknown"
+
+var ErrTokenMalformed = errors.New("token is not a JWT")
+
+// subjectClaims is implemented by claims that expose the token subject.
+type subjectClaims interface {
+	GetSubject() string
+}
+
+// tokenIdentity is who a token was issued to: its subject and, for institution-scoped callers,
+// their institution. The auth library verifies tokens but does not expose either.
+type tokenIdentity struct {
+	Subject       string `json:"sub"`
+	InstitutionID string `json:"institution_id"`
+}
+
+// verifiedClaims are the roles the auth library verified a token for, with the token's identity.
+type verifiedClaims struct {
+	ms.Claims
+	identity tokenIdentity
+}
+
+// GetSubject returns the subject of the verified token.
+func (c verifiedClaims) GetSubject() string {
+	return c.identity.Subject
+}
+
+// GetInstitutionID returns the institution of the verified token, or "" when it names none.
+func (c verifiedClaims) GetInstitutionID() string {
+	return c.identity.InstitutionID
+}
+
+// parseTokenIdentity returns the identity a JWT was issued to. It does not check the signature, so
+// it must only be called on tokens the auth library has verified.
+func parseTokenIdentity(token string) (tokenIdentity, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return tokenIdentity{}, fmt.Errorf("%w", ErrTokenMalformed)
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(parts[1], "="))
+	if err != nil {
+		return tokenIdentity{}, fmt.Errorf("%w: %w", ErrTokenMalformed, err)
+	}
+
+	var identity tokenIdentity
+	if err := json.Unmarshal(payload, &identity); err != nil {
+		return tokenIdentity{}, fmt.Errorf("%w: %w", ErrTokenMalformed, err)
+	}
+
+	return identity, nil
+}
+
+type actorContextKey struct{}
+
+// withActor returns a context recording actor as the author of the grade changes written with it.
+func withActor(ctx context.Context, actor string) context.Context {
+	return context.WithValue(ctx, actorContextKey{}, actor)
+}
+
+// actorFromContext returns the author of the grade changes written with the context, or "" when
+// none was recorded.
+func actorFromContext(ctx context.Context) string {
+	actor, _ := ctx.Value(actorContextKey{}).(string)
+
+	return actor
+}
+
+// callerIdentity names the caller by the subject of their token, or unknownCaller when it has none.
+func callerIdentity(claims ms.Claims) string {
+	if subject, ok := claims.(subjectClaims); ok && subject.GetSubject() != "" {
+		return subject.GetSubject()
+	}
+
+	return unknownCaller
+}
+
+// recordAccess records that the caller read a grade. Failures are logged and never fail the read.
+func (s *GradesServer) recordAccess(ctx context.Context, gradeID string, claims ms.Claims) {
+	entry := &AuditEntry{GradeID: gradeID, Action: auditActionView, Actor: callerIdentity(claims)}
+	if err := s.db.RecordAudit(ctx, entry); err != nil {
+		klog.FromContext(ctx).Error(err, "Failed to record grade access", "grade_id", gradeID)
+	}
+}
+
+// provenanceTimeline assembles the chronological provenance of a grade from all of its versions,
+// oldest first, and the recorded accesses. The oldest version is reported as the creation.
+func provenanceTimeline(versions []*Grade, accesses []*AuditEntry) []*gpb.ProvenanceEvent {
+	type timedEvent struct {
+		at    time.Time
+		event *gpb.ProvenanceEvent
+	}
+
+	events := make([]timedEvent, 0, len(versions)+len(accesses))
+
+	for i, version := range versions {
+		kind, at := provenanceUpdated, version.UpdatedAt
+		if i == 0 {
+			kind, at = provenanceCreated, version.GradedAt
+		}
+
+		events = append(events, timedEvent{at: at, event: &gpb.ProvenanceEvent{
+			Kind:       kind,
+			OccurredAt: at.UTC().Format(time.RFC3339Nano),
+			Actor:      version.GradedBy,
+			Version:    version.Version,
+			GradeValue: version.GradeValue,
+		}})
+	}
+
+	for _, access := range accesses {
+		kind := provenanceViewed
+		if access.Action == auditActionDelete {
+			kind = provenanceDeleted
+		}
+
+		events = append(events, timedEvent{at: access.CreatedAt, event: &gpb.ProvenanceEvent{
+			Kind:       kind,
+			OccurredAt: access.CreatedAt.UTC().Format(time.RFC3339Nano),
+			Actor:      access.Actor,
+		}})
+	}
+
+	// Versions are already in order, so a stable sort keeps changes made at the same instant in version order.
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].at.Before(events[j].at)
+	})
+
+	timeline := make([]*gpb.ProvenanceEvent, 0, len(events))
+	for _, event := range events {
+		timeline = append(timeline, event.event)
+	}
+
+	return timeline
+}
@@ -0,0 +1,133 @@
+package main
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"testing"
+	"time"
+
+	ms "github.com/TekClinic/MicroService-Lib"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// libVerifier verifies tokens the way the auth library does: it accepts any well-formed JWT and
+// returns claims carrying only the role, leaving the caller's identity in the token itself.
+type libVerifier struct {
+	ms.BaseServiceServer
+	role string
+}
+
+func (v libVerifier) VerifyToken(_ context.Context, token string) (ms.Claims, error) {
+	if _, err := parseTokenIdentity(token); err != nil {
+		return nil, err
+	}
+
+	return RoleClaims{role: v.role}, nil
+}
+
+// verifiedCaller makes the server verify tokens through libVerifier instead of injecting claims,
+// so callers are identified by the subject of the token they send, as in production.
+func verifiedCaller(role string) func(*GradesServer) {
+	return func(s *GradesServer) {
+		s.Claims = nil
+		s.BaseServiceServer = libVerifier{role: role}
+	}
+}
+
+// tokenFor returns an unsigned JWT whose subject is subject, for use with verifiedCaller.
+func tokenFor(subject string) string {
+	return tokenWithClaims(map[string]string{"sub": subject})
+}
+
+// tokenWithClaims returns an unsigned JWT carrying the claims.
+func tokenWithClaims(claims map[string]string) string {
+	payload, _ := json.Marshal(claims)
+
+	return "eyJhbGciOiJub25lIn0." + base64.RawURLEncoding.EncodeToString(payload) + ".sig"
+}
+
+// Tokens of the student and grader most tests act as.
+var (
+	studentToken = tokenFor("student-1")
+	graderToken  = tokenFor("grader-1")
+)
+
+func TestParseTokenIdentity(t *testing.T) {
+	identity, err := parseTokenIdentity(tokenFor("user-7"))
+	require.NoError(t, err)
+	assert.Equal(t, tokenIdentity{Subject: "user-7"}, identity)
+
+	identity, err = parseTokenIdentity(tokenWithClaims(map[string]string{"sub": "user-7", "institution_id": "uni-a"}))
+	require.NoError(t, err)
+	assert.Equal(t, tokenIdentity{Subject: "user-7", InstitutionID: "uni-a"}, identity)
+
+	notAnObject := "a." + base64.RawURLEncoding.EncodeToString([]byte("[]")) + ".c"
+
+	for _, token := range []string{"test-token", "a.!!.c", notAnObject} {
+		_, err := parseTokenIdentity(token)
+		assert.ErrorIs(t, err, ErrTokenMalformed, token)
+	}
+}
+
+func TestCallerIdentity(t *testing.T) {
+	s := &GradesServer{BaseServiceServer: libVerifier{role: roleStaff}}
+
+	claims, err := s.getClaims(context.Background(), tokenFor("user-7"))
+	require.NoError(t, err)
+	assert.True(t, claims.HasRole(roleStaff))
+	assert.Equal(t, "user-7", callerIdentity(claims))
+
+	claims, err = s.getClaims(context.Background(), tokenFor(""))
+	require.NoError(t, err)
+	assert.Equal(t, unknownCaller, callerIdentity(claims))
+
+	// Claims from the library alone carry no identity.
+	assert.Equal(t, unknownCaller, callerIdentity(RoleClaims{role: roleStaff}))
+	assert.Equal(t, unknownCaller, callerIdentity(nil))
+
+	_, err = s.getClaims(context.Background(), "test-token")
+	require.Error(t, err)
+}
+
+func TestProvenanceTimelineOrdering(t *testing.T) {
+	start := time.Date(2025, time.January, 10, 9, 0, 0, 0, time.UTC)
+	versions := []*Grade{
+		{Version: 1, GradeValue: "70", GradedBy: "ta", GradedAt: start, UpdatedAt: start},
+		{Version: 2, GradeValue: "75", GradedBy: "ta", GradedAt: start, UpdatedAt: start.Add(2 * time.Hour)},
+		{Version: 3, GradeValue: "80", GradedBy: "lecturer", GradedAt: start, UpdatedAt: start.Add(4 * time.Hour)},
+	}
+	accesses := []*AuditEntry{
+		{Actor: "student-1", CreatedAt: start.Add(time.Hour)},
+		{Actor: "student-1", CreatedAt: start.Add(3 * time.Hour)},
+		{Actor: "auditor-1", CreatedAt: start.Add(5 * time.Hour)},
+		{Actor: "admin-1", Action: auditActionDelete, CreatedAt: start.Add(6 * time.Hour)},
+	}
+
+	timeline := provenanceTimeline(versions, accesses)
+	require.Len(t, timeline, 7)
+
+	kinds := make([]string, 0, len(timeline))
+	for _, event := range timeline {
+		kinds = append(kinds, event.GetKind())
+	}
+
+	assert.Equal(t, []string{
+		provenanceCreated, provenanceViewed, provenanceUpdated,
+		provenanceViewed, provenanceUpdated, provenanceViewed, provenanceDeleted,
+	}, kinds)
+	assert.Equal(t, "70", timeline[0].GetGradeValue())
+	assert.Equal(t, int64(3), timeline[4].GetVersion())
+	assert.Equal(t, "lecturer", timeline[4].GetActor())
+	assert.Equal(t, "auditor-1", timeline[5].GetActor())
+	assert.Equal(t, start.Add(time.Hour).Format(time.RFC3339Nano), timeline[1].GetOccurredAt())
+}
+
+func TestProvenanceTimelineOnlyCreation(t *testing.T) {
+	now := time.Now()
+	timeline := provenanceTimeline([]*Grade{{Version: 1, GradeValue: "A", GradedAt: now, UpdatedAt: now}}, nil)
+
+	require.Len(t, timeline, 1)
+	assert.Equal(t, provenanceCreated, timeline[0].GetKind())
+}
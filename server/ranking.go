@@ -0,0 +1,197 @@
+package main
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	gpb "github.com/BetterGR/grades-microservice/protos"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/klog/v2"
+)
+
+// Policies for students missing weighted components in a weighted ranking.
+const (
+	// missingZero scores a missing component minNumericGrade, as if it were failed.
+	missingZero = "zero"
+	// missingRenormalize averages over the graded components only, rescaling their weights.
+	missingRenormalize = "renormalize"
+	// missingExclude leaves students missing a component out of the ranking.
+	missingExclude = "exclude"
+)
+
+var ErrMissingPolicyInvalid = errors.New("missing component policy must be zero, renormalize or exclude")
+
+// parseMissingPolicy validates a missing component policy, treating an empty policy as fallback.
+func parseMissingPolicy(policy, fallback string) (string, error) {
+	switch policy = strings.ToLower(strings.TrimSpace(policy)); policy {
+	case "":
+		return fallback, nil
+	case missingZero, missingRenormalize, missingExclude:
+		return policy, nil
+	default:
+		return "", fmt.Errorf("%w: %q", ErrMissingPolicyInvalid, policy)
+	}
+}
+
+// weightedFinal is a student's weighted final grade in a course and their place in the class.
+type weightedFinal struct {
+	studentID string
+	final     float64
+	// missing lists the weighted components the student has no numeric grade for, sorted.
+	missing    []string
+	rank       int
+	percentile float64
+}
+
+// weightedFinalGrade computes a student's weighted final grade from their grades in a course, applying
+// policy to the components without a numeric grade. It reports false when the student is not to be
+// ranked: nothing is graded yet, or a component is missing under the exclude policy. The weights
+// must be normalized.
+func weightedFinalGrade(grades []*Grade, weights GradeWeights, policy string) (float64, []string, bool) {
+	projection := projectRequiredGrade(grades, weights, 0)
+	if len(projection.remaining) == len(weights) {
+		return 0, projection.remaining, false
+	}
+
+	if len(projection.remaining) == 0 || policy == missingRenormalize {
+		return projection.current, projection.remaining, true
+	}
+
+	if policy == missingExclude {
+		return 0, projection.remaining, false
+	}
+
+	var totalWeight, missingWeight float64
+
+	for _, weight := range weights {
+		totalWeight += weight
+	}
+
+	for _, component := range projection.remaining {
+		missingWeight += weights[component]
+	}
+
+	gradedWeight := totalWeight - missingWeight
+	final := (projection.current*gradedWeight + minNumericGrade*missingWeight) / totalWeight
+
+	return final, projection.remaining, true
+}
+
+// rankWeightedFinals computes the weighted final grade of every student of a course and ranks them,
+// best first, rounding the finals with rounder so students shown the same final share a rank.
+// Tied students share the best rank of their group, the next student ranking after all of them.
+// A student's percentile is the percentage of ranked students with a final at or below theirs.
+// It also returns the students left unranked, sorted.
+func rankWeightedFinals(grades []*Grade, weights GradeWeights, policy string,
+	rounder GradeRounder,
+) ([]weightedFinal, []string) {
+	students := make(map[string][]*Grade)
+	for _, grade := range grades {
+		students[grade.StudentID] = append(students[grade.StudentID], grade)
+	}
+
+	var (
+		finals   []weightedFinal
+		unranked []string
+	)
+
+	for studentID, studentGrades := range students {
+		final, missing, ok := weightedFinalGrade(studentGrades, weights, policy)
+		if !ok {
+			unranked = append(unranked, studentID)
+
+			continue
+		}
+
+		finals = append(finals, weightedFinal{studentID: studentID, final: rounder.RoundNumber(final), missing: missing})
+	}
+
+	sort.Strings(unranked)
+	sort.Slice(finals, func(i, j int) bool {
+		if finals[i].final != finals[j].final {
+			return finals[i].final > finals[j].final
+		}
+
+		return finals[i].studentID < finals[j].studentID
+	})
+
+	for i := range finals {
+		finals[i].rank = i + 1
+		if i > 0 && finals[i].final == finals[i-1].final {
+			finals[i].rank = finals[i-1].rank
+		}
+
+		// The students at or below a final are all but those ranked above it.
+		finals[i].percentile = 100 * float64(len(finals)-finals[i].rank+1) / float64(len(finals))
+	}
+
+	return finals, unranked
+}
+
+// GetWeightedCourseRanking ranks the students of a course by their weighted final grade, computed
+// from their component grades with the configured grade weights, with each student's rank and
+// percentile. Students missing components are handled by the request's policy, or by
+// WEIGHTED_RANKING_MISSING_POLICY when it names none; students with nothing graded are never
+// ranked. Requires the staff role and, for non-admins, permission to grade the course.
+func (s *GradesServer) GetWeightedCourseRanking(ctx context.Context,
+	req *gpb.GetWeightedCourseRankingRequest,
+) (*gpb.GetWeightedCourseRankingResponse, error) {
+	claims, err := s.authorizeRoles(ctx, req.GetToken(), roleStaff, roleAdmin)
+	if err != nil {
+		return nil, err
+	}
+
+	logger := klog.FromContext(ctx)
+	logger.V(logLevelDebug).Info("Received request for weighted course ranking", "course_id", req.GetCourseID(),
+		"semester", req.GetSemester(), "missing_policy", req.GetMissingPolicy())
+
+	if req.GetCourseID() == "" {
+		return nil, fmt.Errorf("failed to get weighted course ranking: %w",
+			status.Error(codes.InvalidArgument, ErrCourseIDEmpty.Error()))
+	}
+
+	policy, err := parseMissingPolicy(req.GetMissingPolicy(), cmp.Or(s.missingComponentPolicy, missingZero))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get weighted course ranking: %w",
+			status.Error(codes.InvalidArgument, err.Error()))
+	}
+
+	if len(s.weights) == 0 {
+		return nil, fmt.Errorf("failed to get weighted course ranking: %w",
+			status.Error(codes.FailedPrecondition, ErrGradeWeightsMissing.Error()))
+	}
+
+	if err := s.authorizeGrader(ctx, claims, req.GetCourseID()); err != nil {
+		return nil, err
+	}
+
+	grades, err := s.db.GetCourseGrades(ctx, req.GetCourseID(), req.GetSemester())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get course grades: %w", err)
+	}
+
+	finals, unranked := rankWeightedFinals(grades, s.weights, policy, s.rounder)
+
+	resp := &gpb.GetWeightedCourseRankingResponse{
+		Entries:          make([]*gpb.WeightedRankingEntry, 0, len(finals)),
+		UnrankedStudents: unranked,
+		MissingPolicy:    policy,
+	}
+
+	for _, final := range finals {
+		resp.Entries = append(resp.Entries, &gpb.WeightedRankingEntry{
+			Rank:              int32(final.rank),
+			StudentID:         final.studentID,
+			WeightedFinal:     final.final,
+			Percentile:        final.percentile,
+			MissingComponents: final.missing,
+		})
+	}
+
+	return resp, nil
+}
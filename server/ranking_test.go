@@ -0,0 +1,169 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	gpb "github.com/BetterGR/grades-microservice/protos"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// rankingGrades are the component grades of a course weighted homework:30, midterm:20, final:50.
+// s1 and s5 both finish on 86; s3 has no final, and s4 only a letter grade.
+func rankingGrades() []*gpb.SingleGrade {
+	grade := func(studentID, gradeType, value string) *gpb.SingleGrade {
+		return &gpb.SingleGrade{
+			StudentID: studentID, CourseID: "c1", Semester: "Winter_2024", GradeType: gradeType, GradeValue: value,
+		}
+	}
+
+	return []*gpb.SingleGrade{
+		grade("s1", "Homework", "80"), grade("s1", "Homework", "100"), grade("s1", "Midterm", "70"),
+		grade("s1", "Final", "90"),
+		grade("s2", "Homework", "100"), grade("s2", "Midterm", "90"), grade("s2", "Final", "80"),
+		grade("s3", "Homework", "90"), grade("s3", "Midterm", "80"),
+		grade("s4", "Final", "A"),
+		grade("s5", "Homework", "90"), grade("s5", "Midterm", "70"), grade("s5", "Final", "90"),
+	}
+}
+
+func TestParseMissingPolicy(t *testing.T) {
+	policy, err := parseMissingPolicy("", missingZero)
+	require.NoError(t, err)
+	assert.Equal(t, missingZero, policy)
+
+	policy, err = parseMissingPolicy(" Exclude ", missingZero)
+	require.NoError(t, err)
+	assert.Equal(t, missingExclude, policy)
+
+	_, err = parseMissingPolicy("average", missingZero)
+	require.ErrorIs(t, err, ErrMissingPolicyInvalid)
+}
+
+func TestWeightedFinalGrade(t *testing.T) {
+	weights, err := parseGradeWeights("homework:30,midterm:20,final:50")
+	require.NoError(t, err)
+
+	grades := []*Grade{{GradeType: "homework", GradeValue: "90"}, {GradeType: "midterm", GradeValue: "80"}}
+
+	tests := []struct {
+		policy string
+		final  float64
+		ok     bool
+	}{
+		{missingZero, 43, true},
+		{missingRenormalize, 86, true},
+		{missingExclude, 0, false},
+	}
+
+	for _, tt := range tests {
+		final, missing, ok := weightedFinalGrade(grades, weights, tt.policy)
+		assert.InDelta(t, tt.final, final, 1e-9, tt.policy)
+		assert.Equal(t, tt.ok, ok, tt.policy)
+		assert.Equal(t, []string{"final"}, missing, tt.policy)
+	}
+
+	_, _, ok := weightedFinalGrade([]*Grade{{GradeType: "final", GradeValue: "A"}}, weights, missingZero)
+	assert.False(t, ok, "nothing graded")
+}
+
+func TestGetWeightedCourseRanking(t *testing.T) {
+	db := NewMockDatabase()
+	client := setupClient(t, func(s *GradesServer) {
+		s.db = db
+		s.Claims = RoleClaims{role: roleStaff}
+		s.weights = GradeWeights{"homework": 30, "midterm": 20, "final": 50}
+	})
+
+	for _, grade := range rankingGrades() {
+		_, err := db.AddGrade(context.Background(), grade)
+		require.NoError(t, err)
+	}
+
+	type entry struct {
+		studentID  string
+		rank       int32
+		final      float64
+		percentile float64
+	}
+
+	tests := []struct {
+		policy   string
+		entries  []entry
+		unranked []string
+	}{
+		{
+			policy: missingZero,
+			entries: []entry{
+				{"s2", 1, 88, 100}, {"s1", 2, 86, 75}, {"s5", 2, 86, 75}, {"s3", 4, 43, 25},
+			},
+			unranked: []string{"s4"},
+		},
+		{
+			policy: missingRenormalize,
+			entries: []entry{
+				{"s2", 1, 88, 100}, {"s1", 2, 86, 75}, {"s3", 2, 86, 75}, {"s5", 2, 86, 75},
+			},
+			unranked: []string{"s4"},
+		},
+		{
+			policy:   missingExclude,
+			entries:  []entry{{"s2", 1, 88, 100}, {"s1", 2, 86, 200.0 / 3}, {"s5", 2, 86, 200.0 / 3}},
+			unranked: []string{"s3", "s4"},
+		},
+	}
+
+	for _, tt := range tests {
+		resp, err := client.GetWeightedCourseRanking(context.Background(), &gpb.GetWeightedCourseRankingRequest{
+			Token: "test-token", CourseID: "c1", Semester: "Winter_2024", MissingPolicy: tt.policy,
+		})
+		require.NoError(t, err, tt.policy)
+		assert.Equal(t, tt.policy, resp.GetMissingPolicy())
+		assert.Equal(t, tt.unranked, resp.GetUnrankedStudents(), tt.policy)
+		require.Len(t, resp.GetEntries(), len(tt.entries), tt.policy)
+
+		for i, want := range tt.entries {
+			got := resp.GetEntries()[i]
+			assert.Equal(t, want.studentID, got.GetStudentID(), tt.policy)
+			assert.Equal(t, want.rank, got.GetRank(), tt.policy)
+			assert.InDelta(t, want.final, got.GetWeightedFinal(), 1e-9, tt.policy)
+			assert.InDelta(t, want.percentile, got.GetPercentile(), 1e-9, tt.policy)
+		}
+	}
+
+	// The server's policy applies when the request names none.
+	resp, err := client.GetWeightedCourseRanking(context.Background(), &gpb.GetWeightedCourseRankingRequest{
+		Token: "test-token", CourseID: "c1", Semester: "Winter_2024",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, missingZero, resp.GetMissingPolicy())
+	assert.Equal(t, []string{"final"}, resp.GetEntries()[3].GetMissingComponents())
+}
+
+func TestGetWeightedCourseRankingErrors(t *testing.T) {
+	client := setupClient(t, func(s *GradesServer) {
+		s.Claims = RoleClaims{role: roleStaff}
+		s.weights = GradeWeights{"final": 100}
+	})
+
+	for _, req := range []*gpb.GetWeightedCourseRankingRequest{
+		{Token: "test-token", Semester: "Winter_2024"},
+		{Token: "test-token", CourseID: "c1", MissingPolicy: "average"},
+	} {
+		_, err := client.GetWeightedCourseRanking(context.Background(), req)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err), req.String())
+	}
+
+	unweighted := setupClient(t, func(s *GradesServer) { s.Claims = RoleClaims{role: roleStaff} })
+	_, err := unweighted.GetWeightedCourseRanking(context.Background(),
+		&gpb.GetWeightedCourseRankingRequest{Token: "test-token", CourseID: "c1"})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	student := setupClient(t, func(s *GradesServer) { s.Claims = RoleClaims{role: roleStudent} })
+	_, err = student.GetWeightedCourseRanking(context.Background(),
+		&gpb.GetWeightedCourseRankingRequest{Token: "test-token", CourseID: "c1"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
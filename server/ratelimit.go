@@ -0,0 +1,152 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	gpb "github.com/BetterGR/grades-microservice/protos"
+	ms "github.com/TekClinic/MicroService-Lib"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// courseWriteCleanupInterval is how often the buckets of courses no longer written to are dropped.
+const courseWriteCleanupInterval = time.Minute
+
+var ErrCourseWriteRateExceeded = errors.New("too many grade writes to the course, retry later")
+
+// courseWriteBucket is the token bucket of a course.
+type courseWriteBucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// courseWriteLimiter caps the grade writes to each course, so a script cannot overwrite a course's
+// grades en masse. Each course has a token bucket holding up to a minute's worth of writes and
+// refilled continuously, so short bursts pass while a sustained flood is throttled. A nil limiter
+// admits every write.
+type courseWriteLimiter struct {
+	// perMinute is the number of writes a course is allowed per minute, and the size of its bucket.
+	perMinute float64
+	now       func() time.Time
+
+	mu      sync.Mutex
+	buckets map[string]*courseWriteBucket
+}
+
+// newCourseWriteLimiter allows perMinute writes per course per minute, or returns nil, admitting
+// every write, when perMinute is 0 or less.
+func newCourseWriteLimiter(perMinute int) *courseWriteLimiter {
+	if perMinute <= 0 {
+		return nil
+	}
+
+	return &courseWriteLimiter{
+		perMinute: float64(perMinute),
+		now:       time.Now,
+		buckets:   make(map[string]*courseWriteBucket),
+	}
+}
+
+// refill adds the tokens earned by a bucket since it was last used.
+func (l *courseWriteLimiter) refill(bucket *courseWriteBucket, now time.Time) {
+	elapsed := now.Sub(bucket.last).Minutes()
+	bucket.tokens = min(l.perMinute, bucket.tokens+elapsed*l.perMinute)
+	bucket.last = now
+}
+
+// allow takes a token from the bucket of a course, reporting false when it is empty.
+func (l *courseWriteLimiter) allow(courseID string) bool {
+	if l == nil {
+		return true
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+
+	bucket, ok := l.buckets[courseID]
+	if !ok {
+		bucket = &courseWriteBucket{tokens: l.perMinute, last: now}
+		l.buckets[courseID] = bucket
+	}
+
+	l.refill(bucket, now)
+
+	if bucket.tokens < 1 {
+		return false
+	}
+
+	bucket.tokens--
+
+	return true
+}
+
+// cleanup drops the buckets that have refilled completely, which behave like new ones.
+func (l *courseWriteLimiter) cleanup() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+
+	for courseID, bucket := range l.buckets {
+		l.refill(bucket, now)
+
+		if bucket.tokens >= l.perMinute {
+			delete(l.buckets, courseID)
+		}
+	}
+}
+
+// runCleanups drops the buckets of idle courses each interval until the context is done.
+func (l *courseWriteLimiter) runCleanups(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			l.cleanup()
+		}
+	}
+}
+
+// checkCourseWriteRate fails with ResourceExhausted when the course has used up its writes for now.
+// Admins are never throttled.
+func (s *GradesServer) checkCourseWriteRate(claims ms.Claims, courseID string) error {
+	if claims.HasRole(roleAdmin) || s.courseWrites.allow(courseID) {
+		return nil
+	}
+
+	return status.Error(codes.ResourceExhausted, fmt.Sprintf("%v: %s", ErrCourseWriteRateExceeded, courseID))
+}
+
+// checkGradeUpdateRate applies checkCourseWriteRate to an update, counting it against the course it
+// names or, when it names none, the course of the existing grade. Unknown grades are left for the
+// update to report.
+func (s *GradesServer) checkGradeUpdateRate(ctx context.Context, claims ms.Claims, grade *gpb.SingleGrade) error {
+	if s.courseWrites == nil || claims.HasRole(roleAdmin) {
+		return nil
+	}
+
+	courseID := grade.GetCourseID()
+	if courseID == "" {
+		existing, err := s.db.GetGradeByID(ctx, grade.GetGradeID())
+		switch {
+		case errors.Is(err, ErrGradeNotFound), errors.Is(err, ErrGradeIDEmpty):
+			return nil
+		case err != nil:
+			return fmt.Errorf("failed to get grade: %w", err)
+		}
+
+		courseID = existing.CourseID
+	}
+
+	return s.checkCourseWriteRate(claims, courseID)
+}
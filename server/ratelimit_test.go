@@ -0,0 +1,90 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	gpb "github.com/BetterGR/grades-microservice/protos"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestCourseWriteLimiter(t *testing.T) {
+	assert.Nil(t, newCourseWriteLimiter(0), "a zero rate disables the cap")
+	assert.True(t, (*courseWriteLimiter)(nil).allow("c1"))
+
+	now := time.Now()
+	limiter := newCourseWriteLimiter(2)
+	limiter.now = func() time.Time { return now }
+
+	assert.True(t, limiter.allow("c1"))
+	assert.True(t, limiter.allow("c1"))
+	assert.False(t, limiter.allow("c1"), "the burst is a minute's worth of writes")
+	assert.True(t, limiter.allow("c2"), "courses have their own buckets")
+
+	now = now.Add(30 * time.Second)
+	assert.True(t, limiter.allow("c1"), "tokens refill over the minute")
+	assert.False(t, limiter.allow("c1"))
+
+	limiter.cleanup()
+	assert.Len(t, limiter.buckets, 1, "c1 is still draining while c2 has refilled")
+
+	now = now.Add(time.Minute)
+	limiter.cleanup()
+	assert.Empty(t, limiter.buckets, "refilled buckets are dropped")
+}
+
+func TestCourseWriteRateThrottlesOneCourse(t *testing.T) {
+	db := NewMockDatabase()
+	client := setupClient(t, func(s *GradesServer) {
+		s.db = db
+		verifiedCaller(roleStaff)(s)
+		s.courseWrites = newCourseWriteLimiter(3)
+	})
+
+	add := func(courseID string) (*gpb.SingleGrade, error) {
+		grade := createTestGrade()
+		grade.CourseID = courseID
+		_, err := client.AddSingleGrade(context.Background(), &gpb.AddSingleGradeRequest{Token: graderToken, Grade: grade})
+
+		return grade, err
+	}
+
+	first, err := add("busy")
+	require.NoError(t, err)
+
+	_, err = add("busy")
+	require.NoError(t, err)
+
+	_, err = client.UpdateSingleGrade(context.Background(), &gpb.UpdateSingleGradeRequest{
+		Token: graderToken, Grade: &gpb.SingleGrade{GradeID: first.GetGradeID(), GradeValue: "B"},
+	})
+	require.NoError(t, err, "updates count against the course of the grade")
+
+	_, err = add("busy")
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	_, err = client.UpdateSingleGrade(context.Background(), &gpb.UpdateSingleGradeRequest{
+		Token: graderToken, Grade: &gpb.SingleGrade{GradeID: first.GetGradeID(), GradeValue: "C"},
+	})
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	_, err = add("quiet")
+	assert.NoError(t, err, "other courses are unaffected")
+}
+
+func TestCourseWriteRateAdminBypass(t *testing.T) {
+	client := setupClient(t, func(s *GradesServer) {
+		s.courseWrites = newCourseWriteLimiter(1)
+	})
+
+	for range 3 {
+		grade := createTestGrade()
+		grade.CourseID = "busy"
+		_, err := client.AddSingleGrade(context.Background(), &gpb.AddSingleGradeRequest{Token: "test-token", Grade: grade})
+		require.NoError(t, err, "admins are never throttled")
+	}
+}
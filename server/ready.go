@@ -0,0 +1,150 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"k8s.io/klog/v2"
+)
+
+const (
+	// defaultReadyCheckInterval is how often the database is checked for the readiness file by default.
+	defaultReadyCheckInterval = 5 * time.Second
+	// readyCheckTimeout bounds a single database check.
+	readyCheckTimeout = 2 * time.Second
+)
+
+// readinessFile keeps a marker file present while the service is ready, for orchestrators probing a
+// file rather than an endpoint: the file is written while the database answers and removed when it
+// stops answering or the service shuts down. A nil readinessFile writes nothing.
+type readinessFile struct {
+	path string
+	ping func(ctx context.Context) error
+
+	mu    sync.Mutex
+	ready bool
+	// stopped is set on shutdown, after which the file is never written again.
+	stopped bool
+}
+
+// newReadinessFile marks readiness at path while ping succeeds.
+func newReadinessFile(path string, ping func(ctx context.Context) error) *readinessFile {
+	return &readinessFile{path: path, ping: ping}
+}
+
+// set writes or removes the file when readiness changes.
+func (r *readinessFile) set(ready bool) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.update(ready)
+}
+
+// update writes or removes the file when readiness changes; r.mu must be held. The file is written
+// to a temporary file renamed into place, so a probe never sees it half written.
+func (r *readinessFile) update(ready bool) error {
+	if r.stopped || ready == r.ready {
+		return nil
+	}
+
+	if !ready {
+		if err := os.Remove(r.path); err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("failed to remove readiness file: %w", err)
+		}
+
+		r.ready = false
+
+		return nil
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(r.path), filepath.Base(r.path)+".*")
+	if err != nil {
+		return fmt.Errorf("failed to write readiness file: %w", err)
+	}
+
+	_, err = fmt.Fprintln(tmp, time.Now().UTC().Format(time.RFC3339))
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+
+	if err == nil {
+		err = os.Rename(tmp.Name(), r.path)
+	}
+
+	if err != nil {
+		os.Remove(tmp.Name())
+
+		return fmt.Errorf("failed to write readiness file: %w", err)
+	}
+
+	r.ready = true
+
+	return nil
+}
+
+// check pings the database and updates the file accordingly.
+func (r *readinessFile) check(ctx context.Context) {
+	ctx, cancel := context.WithTimeout(ctx, readyCheckTimeout)
+	defer cancel()
+
+	pingErr := r.ping(ctx)
+	if pingErr != nil {
+		klog.ErrorS(pingErr, "Database check failed, service not ready", "path", r.path)
+	}
+
+	if err := r.set(pingErr == nil); err != nil {
+		klog.ErrorS(err, "Failed to update readiness file", "path", r.path)
+	}
+}
+
+// run checks readiness right away, then each interval until the context is done or shutdown starts.
+func (r *readinessFile) run(ctx context.Context, interval time.Duration) {
+	if r == nil {
+		return
+	}
+
+	// A file left behind by a process that did not shut down cleanly says nothing about this one.
+	if err := os.Remove(r.path); err != nil && !os.IsNotExist(err) {
+		klog.ErrorS(err, "Failed to remove stale readiness file", "path", r.path)
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		r.check(ctx)
+
+		select {
+		case <-ctx.Done():
+			r.shutdown()
+
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// shutdown removes the file for good, so probes see the service as not ready while it drains.
+func (r *readinessFile) shutdown() {
+	if r == nil {
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := r.update(false); err != nil {
+		klog.ErrorS(err, "Failed to remove readiness file", "path", r.path)
+	}
+
+	r.stopped = true
+}
+
+// ping checks that the database answers.
+func (d *Database) ping(ctx context.Context) error {
+	return d.db.PingContext(ctx)
+}
@@ -0,0 +1,111 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// errDatabaseDown fails the database checks of readiness tests.
+var errDatabaseDown = errors.New("database down")
+
+// fakePing returns a ping failing while down is set.
+func fakePing(down *atomic.Bool) func(ctx context.Context) error {
+	return func(context.Context) error {
+		if down.Load() {
+			return errDatabaseDown
+		}
+
+		return nil
+	}
+}
+
+func TestReadinessFileFollowsDatabase(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "ready")
+
+	var down atomic.Bool
+
+	readiness := newReadinessFile(path, fakePing(&down))
+
+	readiness.check(context.Background())
+	assert.FileExists(t, path)
+
+	down.Store(true)
+	readiness.check(context.Background())
+	assert.NoFileExists(t, path)
+
+	down.Store(false)
+	readiness.check(context.Background())
+	assert.FileExists(t, path)
+
+	entries, err := os.ReadDir(filepath.Dir(path))
+	require.NoError(t, err)
+	assert.Len(t, entries, 1, "no temporary file is left behind")
+}
+
+func TestReadinessFileShutdown(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "ready")
+
+	var down atomic.Bool
+
+	readiness := newReadinessFile(path, fakePing(&down))
+
+	readiness.check(context.Background())
+	require.FileExists(t, path)
+
+	readiness.shutdown()
+	assert.NoFileExists(t, path)
+
+	// A check racing the shutdown must not mark the draining service ready again.
+	readiness.check(context.Background())
+	assert.NoFileExists(t, path)
+}
+
+func TestReadinessFileRun(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "ready")
+	require.NoError(t, os.WriteFile(path, []byte("stale\n"), 0o600))
+
+	var down atomic.Bool
+
+	down.Store(true)
+
+	readiness := newReadinessFile(path, fakePing(&down))
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+		readiness.run(ctx, 10*time.Millisecond)
+	}()
+
+	assert.Eventually(t, func() bool {
+		_, err := os.Stat(path)
+
+		return os.IsNotExist(err)
+	}, time.Second, 5*time.Millisecond, "a stale file is removed while the database is down")
+
+	down.Store(false)
+	assert.Eventually(t, func() bool {
+		_, err := os.Stat(path)
+
+		return err == nil
+	}, time.Second, 5*time.Millisecond, "the file appears once the database answers")
+
+	cancel()
+	<-done
+	assert.NoFileExists(t, path)
+}
+
+func TestNilReadinessFile(t *testing.T) {
+	var readiness *readinessFile
+
+	readiness.shutdown()
+	readiness.run(context.Background(), time.Millisecond)
+}
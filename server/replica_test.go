@@ -0,0 +1,141 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	gpb "github.com/BetterGR/grades-microservice/protos"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect/pgdialect"
+	"github.com/uptrace/bun/driver/pgdriver"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// unconnectedDB returns a bun database that never connects, for checking query routing.
+func unconnectedDB() *bun.DB {
+	return bun.NewDB(sql.OpenDB(pgdriver.NewConnector(pgdriver.WithDSN("postgres://localhost/unused"))),
+		pgdialect.New())
+}
+
+func TestDatabaseReader(t *testing.T) {
+	primary, replica := unconnectedDB(), unconnectedDB()
+
+	database := &Database{db: primary, replica: replica}
+	assert.Same(t, replica, database.reader(context.Background()), "plain reads use the replica")
+	assert.Same(t, primary, database.reader(withPrimaryReads(context.Background())),
+		"read-after-write reads use the primary")
+
+	database = &Database{db: primary}
+	assert.Same(t, primary, database.reader(context.Background()), "without a replica every read uses the primary")
+}
+
+func TestParseConsistency(t *testing.T) {
+	consistency, err := parseConsistency("", consistencyEventual)
+	require.NoError(t, err)
+	assert.Equal(t, consistencyEventual, consistency)
+
+	consistency, err = parseConsistency(consistencyStrong, consistencyEventual)
+	require.NoError(t, err)
+	assert.Equal(t, consistencyStrong, consistency)
+
+	_, err = parseConsistency("linearizable", consistencyEventual)
+	require.ErrorIs(t, err, ErrInvalidConsistency)
+}
+
+// primaryReadsDatabase records whether each grade read asked for the primary database.
+type primaryReadsDatabase struct {
+	*MockDatabase
+	primaryReads []bool
+}
+
+// GetGradeByID records where the read was routed.
+func (p *primaryReadsDatabase) GetGradeByID(ctx context.Context, gradeID string) (*Grade, error) {
+	p.primaryReads = append(p.primaryReads, primaryReadsFromContext(ctx))
+
+	return p.MockDatabase.GetGradeByID(ctx, gradeID)
+}
+
+// GetCourseGrades records where the read was routed.
+func (p *primaryReadsDatabase) GetCourseGrades(ctx context.Context, courseID, semester string) ([]*Grade, error) {
+	p.primaryReads = append(p.primaryReads, primaryReadsFromContext(ctx))
+
+	return p.MockDatabase.GetCourseGrades(ctx, courseID, semester)
+}
+
+func TestGetGradeReadAfterWrite(t *testing.T) {
+	db := &primaryReadsDatabase{MockDatabase: NewMockDatabase()}
+	client := setupClient(t, func(s *GradesServer) {
+		s.db = db
+	})
+
+	grade := createTestGrade()
+	_, err := client.AddSingleGrade(context.Background(), &gpb.AddSingleGradeRequest{Token: "test-token", Grade: grade})
+	require.NoError(t, err)
+
+	_, err = client.GetGrade(context.Background(), &gpb.GetGradeRequest{
+		Token: "test-token", GradeID: grade.GetGradeID(), ReadAfterWrite: true,
+	})
+	require.NoError(t, err)
+
+	_, err = client.GetGrade(context.Background(), &gpb.GetGradeRequest{Token: "test-token", GradeID: grade.GetGradeID()})
+	require.NoError(t, err)
+
+	assert.Equal(t, []bool{true, false}, db.primaryReads)
+}
+
+func TestStrongReadsUsePrimary(t *testing.T) {
+	db := &primaryReadsDatabase{MockDatabase: NewMockDatabase()}
+	client := setupClient(t, func(s *GradesServer) {
+		s.db = db
+	})
+
+	grade := createTestGrade()
+	_, err := client.AddSingleGrade(context.Background(), &gpb.AddSingleGradeRequest{Token: "test-token", Grade: grade})
+	require.NoError(t, err)
+
+	for _, consistency := range []string{consistencyStrong, consistencyEventual, ""} {
+		_, err = client.GetGrade(context.Background(), &gpb.GetGradeRequest{
+			Token: "test-token", GradeID: grade.GetGradeID(), Consistency: consistency,
+		})
+		require.NoError(t, err, consistency)
+
+		_, err = client.GetCourseGrades(context.Background(), &gpb.GetCourseGradesRequest{
+			Token: "test-token", CourseID: grade.GetCourseID(), Semester: grade.GetSemester(), Consistency: consistency,
+		})
+		require.NoError(t, err, consistency)
+	}
+
+	assert.Equal(t, []bool{true, true, false, false, false, false}, db.primaryReads,
+		"only strong reads use the primary; reads are eventual by default")
+
+	_, err = client.GetGrade(context.Background(), &gpb.GetGradeRequest{
+		Token: "test-token", GradeID: grade.GetGradeID(), Consistency: "linearizable",
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestReadConsistencyDefault(t *testing.T) {
+	db := &primaryReadsDatabase{MockDatabase: NewMockDatabase()}
+	client := setupClient(t, func(s *GradesServer) {
+		s.db = db
+		s.readConsistency = consistencyStrong
+	})
+
+	grade := createTestGrade()
+	_, err := client.AddSingleGrade(context.Background(), &gpb.AddSingleGradeRequest{Token: "test-token", Grade: grade})
+	require.NoError(t, err)
+
+	_, err = client.GetGrade(context.Background(), &gpb.GetGradeRequest{Token: "test-token", GradeID: grade.GetGradeID()})
+	require.NoError(t, err)
+
+	_, err = client.GetGrade(context.Background(), &gpb.GetGradeRequest{
+		Token: "test-token", GradeID: grade.GetGradeID(), Consistency: consistencyEventual,
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, []bool{true, false}, db.primaryReads, "a request's consistency overrides the server's")
+}
@@ -0,0 +1,209 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	gpb "github.com/BetterGR/grades-microservice/protos"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/klog/v2"
+)
+
+const (
+	// defaultResearchMinGroupSize is the k research datasets are anonymous for when
+	// RESEARCH_MIN_GROUP_SIZE is unset.
+	defaultResearchMinGroupSize = 5
+	// researchGeneralized replaces a quasi-identifier generalized away.
+	researchGeneralized = "*"
+	// researchUnknownPeriod is the period of semesters not named "<term>_<year>".
+	researchUnknownPeriod = "unknown"
+)
+
+// researchPeriod buckets a semester named "<term>_<year>" into a range of years calendar years,
+// aligned on multiples of years so every semester of a range gets the same label.
+func researchPeriod(semester string, years int) string {
+	_, yearPart, found := strings.Cut(semester, "_")
+
+	year, err := strconv.Atoi(yearPart)
+	if !found || err != nil {
+		return researchUnknownPeriod
+	}
+
+	if years <= 1 {
+		return strconv.Itoa(year)
+	}
+
+	start := year - year%years
+
+	return fmt.Sprintf("%d-%d", start, start+years-1)
+}
+
+// researchGroup is the grades sharing the quasi-identifiers of a record.
+type researchGroup struct {
+	records  []*gpb.ResearchRecord
+	students map[string]struct{}
+}
+
+// researchGroups groups records by course, period and grade type.
+type researchGroups map[[3]string]*researchGroup
+
+// group returns the group of a record, created empty if needed.
+func (groups researchGroups) group(record *gpb.ResearchRecord) *researchGroup {
+	key := [3]string{record.GetCourseID(), record.GetPeriod(), record.GetGradeType()}
+
+	group, ok := groups[key]
+	if !ok {
+		group = &researchGroup{students: make(map[string]struct{})}
+		groups[key] = group
+	}
+
+	return group
+}
+
+// researchDataset is a de-identified dataset and what was done to make it k-anonymous.
+type researchDataset struct {
+	records     []*gpb.ResearchRecord
+	generalized int
+	suppressed  int
+}
+
+// anonymizeResearchGrades de-identifies grades so each record shares its course, period and grade
+// type with the grades of at least k students. Direct identifiers are dropped, semesters are
+// bucketed into periods of periodYears years, and values are rounded by round. Groups of fewer
+// than k students have their grade type generalized to "*", merging the small groups of a course
+// and period; grades whose merged group is still smaller than k are suppressed.
+func anonymizeResearchGrades(grades []*Grade, k, periodYears int, round func(string) string) researchDataset {
+	groups := make(researchGroups)
+
+	for _, grade := range grades {
+		record := &gpb.ResearchRecord{
+			CourseID:   grade.CourseID,
+			Period:     researchPeriod(grade.Semester, periodYears),
+			GradeType:  grade.GradeType,
+			GradeValue: round(grade.GradeValue),
+		}
+
+		group := groups.group(record)
+		group.records = append(group.records, record)
+		group.students[grade.StudentID] = struct{}{}
+	}
+
+	var dataset researchDataset
+
+	generalized := make(researchGroups)
+
+	for _, group := range groups {
+		if len(group.students) >= k {
+			dataset.records = append(dataset.records, group.records...)
+
+			continue
+		}
+
+		for _, record := range group.records {
+			record.GradeType = researchGeneralized
+		}
+
+		merged := generalized.group(group.records[0])
+		merged.records = append(merged.records, group.records...)
+
+		for student := range group.students {
+			merged.students[student] = struct{}{}
+		}
+	}
+
+	for _, group := range generalized {
+		if len(group.students) < k {
+			dataset.suppressed += len(group.records)
+
+			continue
+		}
+
+		dataset.generalized += len(group.records)
+		dataset.records = append(dataset.records, group.records...)
+	}
+
+	sort.Slice(dataset.records, func(i, j int) bool {
+		a, b := dataset.records[i], dataset.records[j]
+
+		switch {
+		case a.GetCourseID() != b.GetCourseID():
+			return a.GetCourseID() < b.GetCourseID()
+		case a.GetPeriod() != b.GetPeriod():
+			return a.GetPeriod() < b.GetPeriod()
+		case a.GetGradeType() != b.GetGradeType():
+			return a.GetGradeType() < b.GetGradeType()
+		default:
+			return a.GetGradeValue() < b.GetGradeValue()
+		}
+	})
+
+	return dataset
+}
+
+// ExportResearchDataset exports the grades of semesters de-identified for research: student, grade
+// and item IDs, graders, comments and times are dropped, semesters are bucketed into periods, and
+// the dataset is made k-anonymous over course, period and grade type by generalizing grade types
+// and suppressing what stays in groups of fewer than k students. k is RESEARCH_MIN_GROUP_SIZE,
+// raised by the request. Requires the admin role.
+func (s *GradesServer) ExportResearchDataset(ctx context.Context,
+	req *gpb.ExportResearchDatasetRequest,
+) (*gpb.ExportResearchDatasetResponse, error) {
+	if err := s.requireRole(ctx, req.GetToken(), roleAdmin); err != nil {
+		return nil, err
+	}
+
+	logger := klog.FromContext(ctx)
+	logger.V(logLevelDebug).Info("Received request to export a research dataset", "semesters", req.GetSemesters(),
+		"k", req.GetK(), "period_years", req.GetPeriodYears())
+
+	if len(req.GetSemesters()) == 0 {
+		return nil, fmt.Errorf("failed to export research dataset: %w",
+			status.Error(codes.InvalidArgument, ErrSemesterEmpty.Error()))
+	}
+
+	k := max(s.researchMinGroupSize, int(req.GetK()), 1)
+
+	var grades []*Grade
+
+	seen := make(map[string]bool, len(req.GetSemesters()))
+
+	for _, semester := range req.GetSemesters() {
+		if seen[semester] {
+			continue
+		}
+
+		seen[semester] = true
+
+		err := s.db.StreamSemesterGrades(ctx, semester, func(grade *Grade) error {
+			grades = append(grades, grade)
+
+			return nil
+		})
+
+		switch {
+		case errors.Is(err, ErrSemesterEmpty):
+			return nil, fmt.Errorf("failed to export research dataset: %w",
+				status.Error(codes.InvalidArgument, err.Error()))
+		case err != nil:
+			return nil, fmt.Errorf("failed to export research dataset: %w", err)
+		}
+	}
+
+	dataset := anonymizeResearchGrades(grades, k, int(req.GetPeriodYears()), s.rounder.Round)
+
+	logger.Info("Exported research dataset", "k", k, "total", len(grades), "generalized", dataset.generalized,
+		"suppressed", dataset.suppressed)
+
+	return &gpb.ExportResearchDatasetResponse{
+		Records:          dataset.records,
+		K:                int32(k),
+		TotalCount:       int32(len(grades)),
+		GeneralizedCount: int32(dataset.generalized),
+		SuppressedCount:  int32(dataset.suppressed),
+	}, nil
+}
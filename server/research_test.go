@@ -0,0 +1,93 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	gpb "github.com/BetterGR/grades-microservice/protos"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestResearchPeriod(t *testing.T) {
+	assert.Equal(t, "2023", researchPeriod("Winter_2023", 1))
+	assert.Equal(t, "2022-2023", researchPeriod("Winter_2023", 2))
+	assert.Equal(t, "2022-2023", researchPeriod("Fall_2022", 2))
+	assert.Equal(t, researchUnknownPeriod, researchPeriod("2023", 2))
+}
+
+// researchGrades returns a grade of the given type in course c1 for each of students students,
+// numbered from first.
+func researchGrades(gradeType, semester string, first, students int) []*Grade {
+	grades := make([]*Grade, 0, students)
+	for i := first; i < first+students; i++ {
+		grades = append(grades, &Grade{
+			GradeID: fmt.Sprintf("%s-%s-%d", gradeType, semester, i), StudentID: fmt.Sprintf("s%d", i),
+			CourseID: "c1", Semester: semester, GradeType: gradeType, GradeValue: "80",
+		})
+	}
+
+	return grades
+}
+
+func TestAnonymizeResearchGradesSuppressesSmallGroups(t *testing.T) {
+	var grades []*Grade
+
+	// A large group, kept as is.
+	grades = append(grades, researchGrades("Exam", "Winter_2023", 0, 5)...)
+	// Two small groups of the same course and period, which reach k once merged.
+	grades = append(grades, researchGrades("Quiz", "Winter_2023", 0, 2)...)
+	grades = append(grades, researchGrades("Lab", "Spring_2023", 2, 3)...)
+	// A small group alone in its period, suppressed.
+	grades = append(grades, researchGrades("Exam", "Winter_2021", 0, 2)...)
+	// One student's several grades count once toward k.
+	grades = append(grades, researchGrades("Essay", "Winter_2019", 0, 1)...)
+	grades = append(grades, &Grade{StudentID: "s0", CourseID: "c1", Semester: "Winter_2019", GradeType: "Essay"})
+
+	dataset := anonymizeResearchGrades(grades, 4, 1, func(value string) string { return value })
+
+	assert.Equal(t, 5, dataset.generalized)
+	assert.Equal(t, 4, dataset.suppressed)
+	require.Len(t, dataset.records, 10)
+
+	counts := make(map[string]int)
+	for _, record := range dataset.records {
+		counts[record.GetPeriod()+"/"+record.GetGradeType()]++
+	}
+
+	assert.Equal(t, map[string]int{"2023/Exam": 5, "2023/*": 5}, counts)
+}
+
+func TestExportResearchDataset(t *testing.T) {
+	db := NewMockDatabase()
+	client := setupClient(t, func(s *GradesServer) {
+		s.db = db
+		s.researchMinGroupSize = 3
+	})
+
+	for _, grade := range append(researchGrades("Exam", "Winter_2023", 0, 3),
+		researchGrades("Quiz", "Winter_2023", 0, 1)...) {
+		_, err := db.AddGrade(context.Background(), gradeToProto(grade))
+		require.NoError(t, err)
+	}
+
+	resp, err := client.ExportResearchDataset(context.Background(), &gpb.ExportResearchDatasetRequest{
+		Token: "test-token", Semesters: []string{"Winter_2023"}, K: 2,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int32(3), resp.GetK(), "requests cannot lower the configured k")
+	assert.Equal(t, int32(4), resp.GetTotalCount())
+	assert.Equal(t, int32(1), resp.GetSuppressedCount())
+	require.Len(t, resp.GetRecords(), 3)
+
+	for _, record := range resp.GetRecords() {
+		assert.Equal(t, &gpb.ResearchRecord{CourseID: "c1", Period: "2023", GradeType: "Exam", GradeValue: "80"},
+			record)
+	}
+
+	_, err = client.ExportResearchDataset(context.Background(), &gpb.ExportResearchDatasetRequest{Token: "test-token"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
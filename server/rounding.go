@@ -0,0 +1,91 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"strconv"
+)
+
+// GradeRounder rounds numeric grade values according to an institutional policy.
+// The zero value keeps values unchanged.
+type GradeRounder string
+
+// Supported rounding policies.
+const (
+	// roundingNone keeps grade values as they were entered.
+	roundingNone GradeRounder = "none"
+	// roundingInteger rounds to the nearest integer, with ties going to the even integer.
+	roundingInteger GradeRounder = "integer"
+	// roundingHalfUp rounds to one decimal place, with ties going up.
+	roundingHalfUp GradeRounder = "half-up"
+)
+
+// Places where the rounding policy can be applied.
+const (
+	roundOnRead  = "read"
+	roundOnStore = "store"
+)
+
+var (
+	ErrRoundingModeInvalid  = errors.New("invalid grade rounding mode")
+	ErrRoundingPhaseInvalid = errors.New("invalid grade rounding phase")
+)
+
+// parseGradeRounder parses a rounding policy name, treating an empty name as roundingNone.
+func parseGradeRounder(mode string) (GradeRounder, error) {
+	switch rounder := GradeRounder(mode); rounder {
+	case "", roundingNone:
+		return roundingNone, nil
+	case roundingInteger, roundingHalfUp:
+		return rounder, nil
+	default:
+		return "", fmt.Errorf("%w: %q", ErrRoundingModeInvalid, mode)
+	}
+}
+
+// gradeRoundingFromEnv reads the rounding policy from GRADE_ROUNDING and reports whether it
+// should be applied when grades are stored (GRADE_ROUNDING_ON=store) instead of only on read.
+func gradeRoundingFromEnv() (GradeRounder, bool, error) {
+	rounder, err := parseGradeRounder(os.Getenv("GRADE_ROUNDING"))
+	if err != nil {
+		return "", false, err
+	}
+
+	switch phase := os.Getenv("GRADE_ROUNDING_ON"); phase {
+	case "", roundOnRead:
+		return rounder, false, nil
+	case roundOnStore:
+		return rounder, true, nil
+	default:
+		return "", false, fmt.Errorf("%w: %q", ErrRoundingPhaseInvalid, phase)
+	}
+}
+
+// RoundNumber rounds a numeric grade according to the policy.
+func (r GradeRounder) RoundNumber(value float64) float64 {
+	switch r {
+	case roundingInteger:
+		return math.RoundToEven(value)
+	case roundingHalfUp:
+		return math.Floor(value*10+0.5) / 10
+	default:
+		return value
+	}
+}
+
+// Round rounds a grade value according to the policy. Non-numeric values such as letter grades
+// are returned unchanged.
+func (r GradeRounder) Round(value string) string {
+	if r == "" || r == roundingNone {
+		return value
+	}
+
+	number, ok := parseNumericGrade(value)
+	if !ok {
+		return value
+	}
+
+	return strconv.FormatFloat(r.RoundNumber(number), 'f', -1, 64)
+}
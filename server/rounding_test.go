@@ -0,0 +1,72 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGradeRounderModes(t *testing.T) {
+	tests := []struct {
+		rounder GradeRounder
+		value   string
+		want    string
+	}{
+		{roundingNone, "89.5", "89.5"},
+		{roundingNone, "90.49", "90.49"},
+		{roundingNone, " 90.490 ", " 90.490 "},
+		{roundingInteger, "89.5", "90"},
+		{roundingInteger, "88.5", "88"},
+		{roundingInteger, "90.49", "90"},
+		{roundingInteger, "89.51", "90"},
+		{roundingHalfUp, "89.5", "89.5"},
+		{roundingHalfUp, "90.49", "90.5"},
+		{roundingHalfUp, "89.45", "89.5"},
+		{roundingHalfUp, "89.44", "89.4"},
+		{roundingHalfUp, "100", "100"},
+		{roundingInteger, "A", "A"},
+		{roundingHalfUp, "", ""},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, tt.rounder.Round(tt.value), "%s(%q)", tt.rounder, tt.value)
+	}
+}
+
+func TestParseGradeRounder(t *testing.T) {
+	for mode, want := range map[string]GradeRounder{
+		"":        roundingNone,
+		"none":    roundingNone,
+		"integer": roundingInteger,
+		"half-up": roundingHalfUp,
+	} {
+		rounder, err := parseGradeRounder(mode)
+		require.NoError(t, err)
+		assert.Equal(t, want, rounder)
+	}
+
+	_, err := parseGradeRounder("half-down")
+	require.ErrorIs(t, err, ErrRoundingModeInvalid)
+}
+
+func TestGradeRoundingFromEnv(t *testing.T) {
+	t.Setenv("GRADE_ROUNDING", "integer")
+	t.Setenv("GRADE_ROUNDING_ON", "store")
+
+	rounder, onStore, err := gradeRoundingFromEnv()
+	require.NoError(t, err)
+	assert.Equal(t, roundingInteger, rounder)
+	assert.True(t, onStore)
+
+	t.Setenv("GRADE_ROUNDING_ON", "")
+
+	_, onStore, err = gradeRoundingFromEnv()
+	require.NoError(t, err)
+	assert.False(t, onStore)
+
+	t.Setenv("GRADE_ROUNDING_ON", "later")
+
+	_, _, err = gradeRoundingFromEnv()
+	require.ErrorIs(t, err, ErrRoundingPhaseInvalid)
+}
@@ -0,0 +1,120 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+
+	gpb "github.com/BetterGR/grades-microservice/protos"
+)
+
+var ErrRubricInvalid = errors.New("invalid rubric scores")
+
+// RubricScore is the score of a grade on a single rubric criterion.
+type RubricScore struct {
+	Criterion string  `json:"criterion"`
+	Points    float64 `json:"points"`
+	Max       float64 `json:"max"`
+}
+
+// RubricScores is the per-criterion breakdown of a grade, stored as JSONB. Requests are validated
+// before they are written, and the stored JSON is validated again when it is read.
+type RubricScores []RubricScore
+
+// validate ensures every criterion is named once, has a positive maximum and points within it.
+func (r RubricScores) validate() error {
+	seen := make(map[string]bool, len(r))
+
+	for i, score := range r {
+		criterion := strings.TrimSpace(score.Criterion)
+		if criterion == "" {
+			return fmt.Errorf("%w: criterion %d has no name", ErrRubricInvalid, i)
+		}
+
+		if seen[criterion] {
+			return fmt.Errorf("%w: criterion %q listed twice", ErrRubricInvalid, criterion)
+		}
+
+		seen[criterion] = true
+
+		if !(score.Max > 0) || math.IsInf(score.Max, 0) {
+			return fmt.Errorf("%w: criterion %q must have a positive maximum", ErrRubricInvalid, criterion)
+		}
+
+		if !(score.Points >= 0 && score.Points <= score.Max) {
+			return fmt.Errorf("%w: criterion %q points must be between 0 and %g", ErrRubricInvalid, criterion,
+				score.Max)
+		}
+	}
+
+	return nil
+}
+
+// Scan decodes the scores from JSON, rejecting unknown fields and invalid scores.
+func (r *RubricScores) Scan(src interface{}) error {
+	var raw []byte
+
+	switch value := src.(type) {
+	case nil:
+		*r = nil
+
+		return nil
+	case []byte:
+		raw = value
+	case string:
+		raw = []byte(value)
+	default:
+		return fmt.Errorf("%w: unsupported type %T", ErrRubricInvalid, src)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.DisallowUnknownFields()
+
+	var scores []RubricScore
+	if err := decoder.Decode(&scores); err != nil {
+		return fmt.Errorf("%w: %w", ErrRubricInvalid, err)
+	}
+
+	if err := RubricScores(scores).validate(); err != nil {
+		return err
+	}
+
+	*r = scores
+
+	return nil
+}
+
+// rubricFromProto converts the rubric scores of a request.
+func rubricFromProto(scores []*gpb.RubricScore) RubricScores {
+	if len(scores) == 0 {
+		return nil
+	}
+
+	rubric := make(RubricScores, 0, len(scores))
+	for _, score := range scores {
+		rubric = append(rubric, RubricScore{
+			Criterion: score.GetCriterion(),
+			Points:    score.GetPoints(),
+			Max:       score.GetMax(),
+		})
+	}
+
+	return rubric
+}
+
+// toProto converts the rubric scores for a response.
+func (r RubricScores) toProto() []*gpb.RubricScore {
+	if len(r) == 0 {
+		return nil
+	}
+
+	scores := make([]*gpb.RubricScore, 0, len(r))
+	for _, score := range r {
+		scores = append(scores, &gpb.RubricScore{Criterion: score.Criterion, Points: score.Points, Max: score.Max})
+	}
+
+	return scores
+}
@@ -0,0 +1,98 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	gpb "github.com/BetterGR/grades-microservice/protos"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestRubricScoresValidate(t *testing.T) {
+	valid := RubricScores{{Criterion: "clarity", Points: 4, Max: 5}, {Criterion: "accuracy", Points: 0, Max: 10}}
+	require.NoError(t, valid.validate())
+	require.NoError(t, RubricScores(nil).validate())
+
+	for name, rubric := range map[string]RubricScores{
+		"unnamed":      {{Points: 1, Max: 5}},
+		"duplicate":    {{Criterion: "clarity", Points: 1, Max: 5}, {Criterion: "clarity", Points: 2, Max: 5}},
+		"zero max":     {{Criterion: "clarity", Points: 0, Max: 0}},
+		"above max":    {{Criterion: "clarity", Points: 6, Max: 5}},
+		"negative":     {{Criterion: "clarity", Points: -1, Max: 5}},
+		"negative max": {{Criterion: "clarity", Points: -2, Max: -1}},
+	} {
+		require.ErrorIs(t, rubric.validate(), ErrRubricInvalid, name)
+	}
+}
+
+func TestRubricScoresRoundTrip(t *testing.T) {
+	rubric := RubricScores{{Criterion: "clarity", Points: 4.5, Max: 5}, {Criterion: "accuracy", Points: 7, Max: 10}}
+
+	raw, err := json.Marshal(rubric)
+	require.NoError(t, err)
+
+	var scanned RubricScores
+	require.NoError(t, scanned.Scan(raw))
+	assert.Equal(t, rubric, scanned)
+
+	require.NoError(t, scanned.Scan(nil))
+	assert.Nil(t, scanned)
+}
+
+func TestRubricScoresRejectsMalformedJSON(t *testing.T) {
+	for name, raw := range map[string]string{
+		"syntax":        `[{"criterion": "clarity", "points": 4`,
+		"not a list":    `{"criterion": "clarity", "points": 4, "max": 5}`,
+		"unknown field": `[{"criterion": "clarity", "points": 4, "max": 5, "weight": 2}]`,
+		"wrong type":    `[{"criterion": "clarity", "points": "four", "max": 5}]`,
+		"invalid score": `[{"criterion": "clarity", "points": 9, "max": 5}]`,
+	} {
+		var scanned RubricScores
+		require.ErrorIs(t, scanned.Scan([]byte(raw)), ErrRubricInvalid, name)
+	}
+}
+
+func TestRubricScoresThroughRPC(t *testing.T) {
+	client := setupClient(t)
+
+	grade := createTestGrade()
+	grade.RubricScores = []*gpb.RubricScore{
+		{Criterion: "clarity", Points: 4, Max: 5},
+		{Criterion: "accuracy", Points: 8, Max: 10},
+	}
+	_, err := client.AddSingleGrade(context.Background(), &gpb.AddSingleGradeRequest{Token: "test-token", Grade: grade})
+	require.NoError(t, err)
+
+	resp, err := client.GetGrade(context.Background(), &gpb.GetGradeRequest{
+		Token: "test-token", GradeID: grade.GetGradeID(),
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.GetGrade().GetRubricScores(), 2)
+	assert.Equal(t, "accuracy", resp.GetGrade().GetRubricScores()[1].GetCriterion())
+	assert.InDelta(t, 8, resp.GetGrade().GetRubricScores()[1].GetPoints(), 0)
+
+	_, err = client.UpdateSingleGrade(context.Background(), &gpb.UpdateSingleGradeRequest{
+		Token: "test-token",
+		Grade: &gpb.SingleGrade{
+			GradeID:      grade.GetGradeID(),
+			RubricScores: []*gpb.RubricScore{{Criterion: "clarity", Points: 5, Max: 5}},
+		},
+	})
+	require.NoError(t, err)
+
+	resp, err = client.GetGrade(context.Background(), &gpb.GetGradeRequest{
+		Token: "test-token", GradeID: grade.GetGradeID(),
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.GetGrade().GetRubricScores(), 1)
+	assert.InDelta(t, 5, resp.GetGrade().GetRubricScores()[0].GetPoints(), 0)
+
+	invalid := createTestGrade()
+	invalid.RubricScores = []*gpb.RubricScore{{Criterion: "clarity", Points: 7, Max: 5}}
+	_, err = client.AddSingleGrade(context.Background(), &gpb.AddSingleGradeRequest{Token: "test-token", Grade: invalid})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
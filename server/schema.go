@@ -0,0 +1,179 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"reflect"
+	"sort"
+	"strings"
+
+	"k8s.io/klog/v2"
+)
+
+// Schema validation modes, selected with SCHEMA_VALIDATION.
+const (
+	// schemaValidationStrict refuses to start when a table drifted from its model.
+	schemaValidationStrict = "strict"
+	// schemaValidationWarn logs the differences and starts anyway.
+	schemaValidationWarn = "warn"
+	// schemaValidationOff skips the check.
+	schemaValidationOff = "off"
+)
+
+var ErrSchemaMismatch = errors.New("database schema does not match the models")
+
+// sqlTypeAliases maps the SQL types bun declares for model fields to the names Postgres reports
+// in information_schema.columns.udt_name.
+var sqlTypeAliases = map[string]string{
+	"varchar":                  "varchar",
+	"character varying":        "varchar",
+	"text":                     "text",
+	"boolean":                  "bool",
+	"smallint":                 "int2",
+	"smallserial":              "int2",
+	"integer":                  "int4",
+	"serial":                   "int4",
+	"bigint":                   "int8",
+	"bigserial":                "int8",
+	"real":                     "float4",
+	"double precision":         "float8",
+	"timestamp":                "timestamp",
+	"timestamptz":              "timestamptz",
+	"timestamp with time zone": "timestamptz",
+	"uuid":                     "uuid",
+	"jsonb":                    "jsonb",
+	"bytea":                    "bytea",
+}
+
+// schemaValidationMode reads SCHEMA_VALIDATION, defaulting to strict.
+func schemaValidationMode() string {
+	switch mode := os.Getenv("SCHEMA_VALIDATION"); mode {
+	case schemaValidationStrict, schemaValidationWarn, schemaValidationOff:
+		return mode
+	case "":
+		return schemaValidationStrict
+	default:
+		klog.Warningf("Invalid value %q for SCHEMA_VALIDATION, using default %s", mode, schemaValidationStrict)
+
+		return schemaValidationStrict
+	}
+}
+
+// normalizeSQLType returns the Postgres udt_name of a declared SQL type.
+func normalizeSQLType(sqlType string) string {
+	sqlType = strings.ToLower(strings.TrimSpace(sqlType))
+	if alias, ok := sqlTypeAliases[sqlType]; ok {
+		return alias
+	}
+
+	return sqlType
+}
+
+// liveColumn is a column of an existing table as reported by information_schema.
+type liveColumn struct {
+	Name string `bun:"column_name"`
+	Type string `bun:"udt_name"`
+}
+
+// diffTableSchema compares the columns a model expects, by name and udt_name, against the live columns.
+// It returns the columns that are missing or have a different type, and the live columns the model
+// does not know about, both sorted by column name.
+func diffTableSchema(expected map[string]string, live []liveColumn) ([]string, []string) {
+	liveTypes := make(map[string]string, len(live))
+	for _, column := range live {
+		liveTypes[column.Name] = column.Type
+	}
+
+	var mismatched, unexpected []string
+
+	for name, expectedType := range expected {
+		liveType, exists := liveTypes[name]
+
+		switch {
+		case !exists:
+			mismatched = append(mismatched, fmt.Sprintf("%s: missing, expected %s", name, expectedType))
+		case liveType != expectedType:
+			mismatched = append(mismatched, fmt.Sprintf("%s: type %s, expected %s", name, liveType, expectedType))
+		}
+	}
+
+	for _, column := range live {
+		if _, known := expected[column.Name]; !known {
+			unexpected = append(unexpected, column.Name)
+		}
+	}
+
+	sort.Strings(mismatched)
+	sort.Strings(unexpected)
+
+	return mismatched, unexpected
+}
+
+// modelColumns returns the columns of a model with the udt_name of their declared SQL type.
+func (d *Database) modelColumns(model interface{}) map[string]string {
+	table := d.db.Table(reflect.TypeOf(model).Elem())
+
+	columns := make(map[string]string, len(table.Fields))
+	for _, field := range table.Fields {
+		columns[field.Name] = normalizeSQLType(field.CreateTableSQLType)
+	}
+
+	return columns
+}
+
+// validateTable compares a live table against a model and returns the differing columns.
+func (d *Database) validateTable(ctx context.Context, table string, model interface{}) ([]string, []string, error) {
+	var live []liveColumn
+	if err := d.db.NewSelect().TableExpr("information_schema.columns").
+		Column("column_name", "udt_name").
+		Where("table_schema = current_schema() AND table_name = ?", table).
+		Scan(ctx, &live); err != nil {
+		return nil, nil, fmt.Errorf("failed to read columns of table %s: %w", table, err)
+	}
+
+	mismatched, unexpected := diffTableSchema(d.modelColumns(model), live)
+
+	return mismatched, unexpected, nil
+}
+
+// validateSchema verifies that every table matches its model. In strict mode a mismatch is returned
+// as an error listing the differing columns; in warn mode it is only logged. Extra columns the models
+// do not use are always only logged.
+func (d *Database) validateSchema(ctx context.Context, mode string) error {
+	if mode == schemaValidationOff {
+		return nil
+	}
+
+	tables := append([]schemaTable{{name: archivedGradesTable, model: (*Grade)(nil)}}, schemaTables...)
+
+	var problems []string
+
+	for _, table := range tables {
+		mismatched, unexpected, err := d.validateTable(ctx, table.name, table.model)
+		if err != nil {
+			return err
+		}
+
+		for _, column := range mismatched {
+			problems = append(problems, table.name+"."+column)
+		}
+
+		if len(unexpected) > 0 {
+			klog.Warningf("Table %s has columns unknown to the model: %s", table.name, strings.Join(unexpected, ", "))
+		}
+	}
+
+	if len(problems) == 0 {
+		return nil
+	}
+
+	if mode == schemaValidationWarn {
+		klog.Errorf("Database schema does not match the models: %s", strings.Join(problems, "; "))
+
+		return nil
+	}
+
+	return fmt.Errorf("%w: %s", ErrSchemaMismatch, strings.Join(problems, "; "))
+}
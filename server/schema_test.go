@@ -0,0 +1,80 @@
+package main
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect/pgdialect"
+)
+
+func TestModelColumnsUseLiveTypeNames(t *testing.T) {
+	database := &Database{db: bun.NewDB(nil, pgdialect.New())}
+	columns := database.modelColumns((*Grade)(nil))
+
+	assert.Equal(t, "varchar", columns["grade_id"])
+	assert.Equal(t, "timestamptz", columns["graded_at"])
+	assert.Equal(t, "int8", columns["version"])
+	assert.Equal(t, "bool", columns["flagged"])
+	assert.Equal(t, "float8", columns["numeric_value"])
+	assert.Equal(t, "timestamptz", columns["deleted_at"])
+	assert.Equal(t, "jsonb", columns["rubric_scores"])
+}
+
+func TestDiffTableSchema(t *testing.T) {
+	expected := map[string]string{"grade_id": "varchar", "version": "int8", "flagged": "bool"}
+	live := []liveColumn{
+		{Name: "grade_id", Type: "varchar"},
+		{Name: "version", Type: "text"},
+		{Name: "legacy_notes", Type: "text"},
+	}
+
+	mismatched, unexpected := diffTableSchema(expected, live)
+	assert.Equal(t, []string{"flagged: missing, expected bool", "version: type text, expected int8"}, mismatched)
+	assert.Equal(t, []string{"legacy_notes"}, unexpected)
+
+	mismatched, unexpected = diffTableSchema(expected, []liveColumn{
+		{Name: "grade_id", Type: "varchar"}, {Name: "version", Type: "int8"}, {Name: "flagged", Type: "bool"},
+	})
+	assert.Empty(t, mismatched)
+	assert.Empty(t, unexpected)
+}
+
+// TestValidateTableDetectsDrift checks a drifted table against the Grade model on a real database.
+func TestValidateTableDetectsDrift(t *testing.T) {
+	if os.Getenv("DB_TESTS") != "true" {
+		t.Skip("Skipping database tests. Set DB_TESTS=true to run them.")
+	}
+
+	database, err := setupTestDatabaseWithoutConstraints()
+	require.NoError(t, err, "Failed to initialize test database")
+
+	defer cleanupTestDatabase(database)
+
+	ctx := context.Background()
+	table := "grades_schema_drift_test"
+
+	_, err = database.db.NewCreateTable().Model((*Grade)(nil)).ModelTableExpr(table).Exec(ctx)
+	require.NoError(t, err)
+
+	defer func() {
+		_, _ = database.db.NewDropTable().TableExpr(table).IfExists().Exec(ctx)
+	}()
+
+	mismatched, _, err := database.validateTable(ctx, table, (*Grade)(nil))
+	require.NoError(t, err)
+	assert.Empty(t, mismatched)
+
+	// Simulate manual schema edits.
+	_, err = database.db.ExecContext(ctx, "ALTER TABLE ? DROP COLUMN flagged", bun.Ident(table))
+	require.NoError(t, err)
+	_, err = database.db.ExecContext(ctx, "ALTER TABLE ? ALTER COLUMN version TYPE TEXT", bun.Ident(table))
+	require.NoError(t, err)
+
+	mismatched, _, err = database.validateTable(ctx, table, (*Grade)(nil))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"flagged: missing, expected bool", "version: type text, expected int8"}, mismatched)
+}
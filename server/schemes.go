@@ -0,0 +1,138 @@
+package main
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	gpb "github.com/BetterGR/grades-microservice/protos"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/klog/v2"
+)
+
+// gradeTypeSchemes resolves the grading scheme of grades by course and grade type for the length of
+// a request, reading the mappings of each course once.
+type gradeTypeSchemes struct {
+	db      DBInterface
+	courses map[string]map[string]GradeScheme
+}
+
+// newGradeTypeSchemes creates a resolver reading the mappings from the server's database.
+func (s *GradesServer) newGradeTypeSchemes() *gradeTypeSchemes {
+	return &gradeTypeSchemes{db: s.db, courses: make(map[string]map[string]GradeScheme)}
+}
+
+// lookup returns the scheme mapped to a grade type of a course, or an empty scheme when the grade
+// type is unmapped and falls back to the global scheme.
+func (r *gradeTypeSchemes) lookup(ctx context.Context, courseID, gradeType string) (GradeScheme, error) {
+	if courseID == "" || gradeType == "" {
+		return "", nil
+	}
+
+	schemes, ok := r.courses[courseID]
+	if !ok {
+		var err error
+		if schemes, err = r.db.GetGradeTypeSchemes(ctx, courseID); err != nil {
+			return "", fmt.Errorf("failed to get grade type schemes: %w", err)
+		}
+
+		r.courses[courseID] = schemes
+	}
+
+	return schemes[gradeType], nil
+}
+
+// updatedGradeScheme returns the scheme mapped to the grade type of a grade after an update, whose
+// empty course and grade type keep the stored ones. Unknown grades are left for the update to report.
+func (s *GradesServer) updatedGradeScheme(ctx context.Context, grade *gpb.SingleGrade) (GradeScheme, error) {
+	courseID, gradeType := grade.GetCourseID(), grade.GetGradeType()
+
+	if courseID == "" || gradeType == "" {
+		existing, err := s.db.GetGradeByID(ctx, grade.GetGradeID())
+		switch {
+		case errors.Is(err, ErrGradeNotFound), errors.Is(err, ErrGradeIDEmpty):
+			return "", nil
+		case err != nil:
+			return "", fmt.Errorf("failed to get grade: %w", err)
+		}
+
+		courseID, gradeType = cmp.Or(courseID, existing.CourseID), cmp.Or(gradeType, existing.GradeType)
+	}
+
+	return s.newGradeTypeSchemes().lookup(ctx, courseID, gradeType)
+}
+
+// gradeTypeSchemeStatus reports invalid grade type scheme requests as InvalidArgument.
+func gradeTypeSchemeStatus(err error) error {
+	if errors.Is(err, ErrCourseIDEmpty) || errors.Is(err, ErrGradeTypeEmpty) || errors.Is(err, ErrGradeSchemeInvalid) {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return err
+}
+
+// SetGradeTypeScheme makes the grades of a type in a course validate against their own grading
+// scheme; an empty scheme removes the mapping, falling back to the global scheme. Requires the staff
+// role and, for non-admins, permission to grade the course.
+func (s *GradesServer) SetGradeTypeScheme(ctx context.Context,
+	req *gpb.SetGradeTypeSchemeRequest,
+) (*gpb.SetGradeTypeSchemeResponse, error) {
+	claims, err := s.authorizeRoles(ctx, req.GetToken(), roleStaff, roleAdmin)
+	if err != nil {
+		return nil, err
+	}
+
+	logger := klog.FromContext(ctx)
+	logger.V(logLevelDebug).Info("Received request to set grade type scheme", "course_id", req.GetCourseID(),
+		"grade_type", req.GetGradeType(), "scheme", req.GetScheme())
+
+	var scheme GradeScheme
+
+	if req.GetScheme() != "" {
+		if scheme, err = parseGradeScheme(req.GetScheme()); err != nil {
+			return nil, fmt.Errorf("failed to set grade type scheme: %w", gradeTypeSchemeStatus(err))
+		}
+	}
+
+	if err := s.authorizeGrader(ctx, claims, req.GetCourseID()); err != nil {
+		return nil, err
+	}
+
+	if err := s.db.SetGradeTypeScheme(ctx, req.GetCourseID(), req.GetGradeType(), scheme); err != nil {
+		return nil, fmt.Errorf("failed to set grade type scheme: %w", gradeTypeSchemeStatus(err))
+	}
+
+	return &gpb.SetGradeTypeSchemeResponse{}, nil
+}
+
+// GetGradeTypeSchemes returns the grading schemes of a course's grade types, ordered by grade type.
+// Grade types not listed use the global scheme. Requires the staff or admin role.
+func (s *GradesServer) GetGradeTypeSchemes(ctx context.Context,
+	req *gpb.GetGradeTypeSchemesRequest,
+) (*gpb.GetGradeTypeSchemesResponse, error) {
+	if err := s.requireRole(ctx, req.GetToken(), roleStaff, roleAdmin); err != nil {
+		return nil, err
+	}
+
+	logger := klog.FromContext(ctx)
+	logger.V(logLevelDebug).Info("Received request for grade type schemes", "course_id", req.GetCourseID())
+
+	schemes, err := s.db.GetGradeTypeSchemes(ctx, req.GetCourseID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get grade type schemes: %w", gradeTypeSchemeStatus(err))
+	}
+
+	resp := &gpb.GetGradeTypeSchemesResponse{Schemes: make([]*gpb.GradeTypeScheme, 0, len(schemes))}
+	for gradeType, scheme := range schemes {
+		resp.Schemes = append(resp.Schemes, &gpb.GradeTypeScheme{GradeType: gradeType, Scheme: string(scheme)})
+	}
+
+	sort.Slice(resp.Schemes, func(i, j int) bool {
+		return resp.Schemes[i].GetGradeType() < resp.Schemes[j].GetGradeType()
+	})
+
+	return resp, nil
+}
@@ -0,0 +1,135 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	gpb "github.com/BetterGR/grades-microservice/protos"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestGradeTypeSchemesValidateByType(t *testing.T) {
+	db := NewMockDatabase()
+	client := setupClient(t, func(s *GradesServer) {
+		s.db = db
+		s.Claims = RoleClaims{role: roleStaff}
+		s.validator = &Validator{scheme: schemeAuto}
+	})
+
+	_, err := client.SetGradeTypeScheme(context.Background(), &gpb.SetGradeTypeSchemeRequest{
+		Token: "test-token", CourseID: "c1", GradeType: "Lab", Scheme: "passfail",
+	})
+	require.NoError(t, err)
+
+	_, err = client.SetGradeTypeScheme(context.Background(), &gpb.SetGradeTypeSchemeRequest{
+		Token: "test-token", CourseID: "c1", GradeType: "Exam", Scheme: "numeric",
+	})
+	require.NoError(t, err)
+
+	tests := []struct {
+		courseID  string
+		gradeType string
+		value     string
+		valid     bool
+	}{
+		{"c1", "Lab", "Pass", true},
+		{"c1", "Lab", "95", false},
+		{"c1", "Exam", "95", true},
+		{"c1", "Exam", "Pass", false},
+		{"c1", "Quiz", "B+", true},     // unmapped types use the global scheme
+		{"c1", "Quiz", "great", false}, // which still applies
+		{"c2", "Lab", "95", true},      // mappings are per course
+	}
+
+	for _, tt := range tests {
+		grade := createTestGrade()
+		grade.CourseID, grade.GradeType, grade.GradeValue = tt.courseID, tt.gradeType, tt.value
+
+		_, err := client.AddSingleGrade(context.Background(), &gpb.AddSingleGradeRequest{Token: "test-token", Grade: grade})
+		if tt.valid {
+			require.NoError(t, err, "%s %s %s", tt.courseID, tt.gradeType, tt.value)
+		} else {
+			assert.Equal(t, codes.InvalidArgument, status.Code(err), "%s %s %s", tt.courseID, tt.gradeType, tt.value)
+		}
+	}
+
+	resp, err := client.GetGradeTypeSchemes(context.Background(),
+		&gpb.GetGradeTypeSchemesRequest{Token: "test-token", CourseID: "c1"})
+	require.NoError(t, err)
+	require.Len(t, resp.GetSchemes(), 2)
+	assert.Equal(t, "Exam", resp.GetSchemes()[0].GetGradeType())
+	assert.Equal(t, "passfail", resp.GetSchemes()[1].GetScheme())
+}
+
+func TestGradeTypeSchemesOnUpdateAndBatch(t *testing.T) {
+	db := NewMockDatabase()
+	client := setupClient(t, func(s *GradesServer) { s.db = db })
+
+	require.NoError(t, db.SetGradeTypeScheme(context.Background(), "c1", "Lab", schemePassFail))
+
+	lab := createTestGrade()
+	lab.CourseID, lab.GradeType, lab.GradeValue = "c1", "Lab", "Pass"
+	added, err := db.AddGrade(context.Background(), lab)
+	require.NoError(t, err)
+
+	// The update names neither the course nor the grade type, so the stored ones decide the scheme.
+	_, err = client.UpdateSingleGrade(context.Background(), &gpb.UpdateSingleGradeRequest{
+		Token: "test-token", Grade: &gpb.SingleGrade{GradeID: added.GradeID, GradeValue: "80"},
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = client.UpdateSingleGrade(context.Background(), &gpb.UpdateSingleGradeRequest{
+		Token: "test-token", Grade: &gpb.SingleGrade{GradeID: added.GradeID, GradeValue: "Fail"},
+	})
+	require.NoError(t, err)
+
+	stream, err := client.BatchAddGradesStream(context.Background(), &gpb.BatchAddGradesRequest{
+		Token: "test-token",
+		Grades: []*gpb.SingleGrade{
+			{StudentID: "s1", CourseID: "c1", GradeType: "Lab", ItemID: "lab2", GradeValue: "Pass"},
+			{StudentID: "s2", CourseID: "c1", GradeType: "Lab", ItemID: "lab2", GradeValue: "70"},
+			{StudentID: "s3", CourseID: "c1", GradeType: "Exam", ItemID: "exam", GradeValue: "70"},
+		},
+	})
+	require.NoError(t, err)
+
+	progress, err := stream.Recv()
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), progress.GetAddedCount())
+	require.Len(t, progress.GetErrors(), 1)
+	assert.Equal(t, int32(1), progress.GetErrors()[0].GetIndex())
+}
+
+func TestSetGradeTypeScheme(t *testing.T) {
+	db := NewMockDatabase()
+	client := setupClient(t, func(s *GradesServer) { s.db = db })
+
+	for _, req := range []*gpb.SetGradeTypeSchemeRequest{
+		{Token: "test-token", GradeType: "Lab", Scheme: "passfail"},
+		{Token: "test-token", CourseID: "c1", Scheme: "passfail"},
+		{Token: "test-token", CourseID: "c1", GradeType: "Lab", Scheme: "percent"},
+	} {
+		_, err := client.SetGradeTypeScheme(context.Background(), req)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err), req.String())
+	}
+
+	require.NoError(t, db.SetGradeTypeScheme(context.Background(), "c1", "Lab", schemePassFail))
+
+	_, err := client.SetGradeTypeScheme(context.Background(), &gpb.SetGradeTypeSchemeRequest{
+		Token: "test-token", CourseID: "c1", GradeType: "Lab",
+	})
+	require.NoError(t, err)
+
+	schemes, err := db.GetGradeTypeSchemes(context.Background(), "c1")
+	require.NoError(t, err)
+	assert.Empty(t, schemes, "an empty scheme removes the mapping")
+
+	student := setupClient(t, func(s *GradesServer) { s.Claims = RoleClaims{role: roleStudent} })
+	_, err = student.SetGradeTypeScheme(context.Background(), &gpb.SetGradeTypeSchemeRequest{
+		Token: "test-token", CourseID: "c1", GradeType: "Lab", Scheme: "passfail",
+	})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
@@ -2,11 +2,16 @@ package main
 
 import (
 	"context"
+	"errors"
 	"flag"
 	"fmt"
 	"log"
 	"net"
 	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
 
 	gpb "github.com/BetterGR/grades-microservice/protos"
 	ms "github.com/TekClinic/MicroService-Lib"
@@ -14,22 +19,78 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 	"k8s.io/klog/v2"
 )
 
 const (
 	connectionProtocol = "tcp"
 	logLevelDebug      = 5
+	// defaultMaxResultRows is the default cap on grades returned by a single list read.
+	defaultMaxResultRows = 10000
+)
+
+// Roles recognized by the role-guarded RPCs.
+const (
+	roleAdmin   = "admin"
+	roleStaff   = "staff"
+	roleAuditor = "auditor"
 )
 
 // DBInterface defines the interface for database operations.
 type DBInterface interface {
 	AddGrade(ctx context.Context, grade *gpb.SingleGrade) (*Grade, error)
+	AddGrades(ctx context.Context, grades []*gpb.SingleGrade) ([]*Grade, error)
 	GetCourseGrades(ctx context.Context, courseID, semester string) ([]*Grade, error)
+	StreamCourseGrades(ctx context.Context, courseID, semester string, fn func(*Grade) error) error
+	StreamSemesterGrades(ctx context.Context, semester string, fn func(*Grade) error) error
 	GetStudentCourseGrades(ctx context.Context, courseID, semester, studentID string) ([]*Grade, error)
 	UpdateGrade(ctx context.Context, grade *gpb.SingleGrade) (*Grade, error)
+	UndoGradeChange(ctx context.Context, gradeID string, version int64) (*Grade, error)
+	TransitionAppeal(ctx context.Context, gradeID, from, to, value string) (*Grade, error)
 	RemoveGrade(ctx context.Context, gradeID string) error
+	PurgeTombstones(ctx context.Context, cutoff time.Time) (int64, error)
+	SoftDeleteStudentGrades(ctx context.Context, studentID, actor string) ([]string, error)
 	GetStudentSemesterGrades(ctx context.Context, studentID, semester string) ([]*Grade, error)
+	GetStudentGrades(ctx context.Context, studentID string) ([]*Grade, error)
+	GetStudentGradesGrouped(ctx context.Context, studentID string) (map[string]map[string][]*Grade, error)
+	ArchiveSemester(ctx context.Context, semester string) (int64, error)
+	GetArchivedCourseGrades(ctx context.Context, courseID, semester string) ([]*Grade, error)
+	GetGradeByID(ctx context.Context, gradeID string) (*Grade, error)
+	GetGradeValue(ctx context.Context, key GradeKey) (string, error)
+	GetGradeVersion(ctx context.Context, gradeID string, version int64) (*Grade, error)
+	SearchGradeComments(ctx context.Context, courseID, semester, term string) ([]*Grade, error)
+	GetCourseGradesETag(ctx context.Context, courseID, semester string) (string, error)
+	SetGradeFlag(ctx context.Context, gradeID string, flagged bool) (*Grade, error)
+	GetFlaggedGrades(ctx context.Context, courseID, semester string) ([]*Grade, error)
+	GetMultiCourseGrades(ctx context.Context, courseIDs []string, semester string) (map[string][]*Grade, error)
+	GetCourseGradesBySemester(ctx context.Context, courseID string, semesters []string) (map[string][]*Grade, error)
+	GetStudentGradesBySemester(ctx context.Context, studentID string, semesters []string) (map[string][]*Grade, error)
+	SearchStudentGrades(ctx context.Context, prefix string, limit int) ([]*Grade, error)
+	RecordFailedEvent(ctx context.Context, event *FailedEvent) error
+	GetFailedEvents(ctx context.Context, limit int) ([]*FailedEvent, error)
+	RecordFailedEventAttempt(ctx context.Context, eventID int64, lastError string) error
+	RemoveFailedEvent(ctx context.Context, eventID int64) error
+	GetGradeVersions(ctx context.Context, gradeID string) ([]*Grade, error)
+	GetCourseGradeVersions(ctx context.Context, courseID, semester string) (map[string][]*Grade, error)
+	RecordAudit(ctx context.Context, entry *AuditEntry) error
+	GetGradeAudit(ctx context.Context, gradeID string) ([]*AuditEntry, error)
+	GetSemesterGradeTypeSummary(ctx context.Context, semester string) ([]*GradeTypeSummary, error)
+	SetNotificationOptOut(ctx context.Context, studentID string, optOut bool) error
+	IsNotificationOptedOut(ctx context.Context, studentID string) (bool, error)
+	SetNotificationDigest(ctx context.Context, studentID string, digest bool) error
+	IsNotificationDigestEnabled(ctx context.Context, studentID string) (bool, error)
+	AddCourseGrader(ctx context.Context, courseID, graderID string) error
+	RemoveCourseGrader(ctx context.Context, courseID, graderID string) error
+	GetCourseGraders(ctx context.Context, courseID string) ([]string, error)
+	SetCourseRelease(ctx context.Context, courseID, semester string, releaseAt time.Time) error
+	GetPendingReleases(ctx context.Context, now time.Time) ([]*CourseRelease, error)
+	SetGradeTypeScheme(ctx context.Context, courseID, gradeType string, scheme GradeScheme) error
+	GetGradeTypeSchemes(ctx context.Context, courseID string) (map[string]GradeScheme, error)
+	SetAttemptPolicy(ctx context.Context, courseID string, policy AttemptPolicy) error
+	GetAttemptPolicy(ctx context.Context, courseID string) (AttemptPolicy, error)
+	RefreshCourseStats(ctx context.Context) error
+	GetCourseStats(ctx context.Context, courseID, semester string, maxStaleness time.Duration) (*CourseStats, error)
 }
 
 // GradesServer is the server struct still needs to implement the GradesServiceServer interface.
@@ -39,6 +100,89 @@ type GradesServer struct {
 	ms.BaseServiceServer
 	db     DBInterface
 	Claims ms.Claims
+	// courses enriches grades with course details; enrichment is skipped when nil.
+	courses CourseResolver
+	// staleCache serves the last successful reads while the database is unreachable; disabled when nil.
+	staleCache *gradesCache
+	// maxResultRows caps the number of grades returned by a list read; unlimited when 0.
+	maxResultRows int
+	// rounder rounds numeric grades in responses and statistics, leaving stored values intact.
+	rounder GradeRounder
+	// roundOnStore also rounds grade values before they are written.
+	roundOnStore bool
+	// events publishes grade changes; events are not published when nil.
+	events EventPublisher
+	// watchers pushes grade changes to the watches of their course; watching is disabled when nil.
+	watchers *gradeWatchers
+	// notifier tells students about changes to their grades; students are not notified when nil.
+	notifier Notifier
+	// enrollment rejects grades of students not enrolled in the course; enrollment is not checked when nil.
+	enrollment EnrollmentChecker
+	// enrollmentFailOpen admits grades when the enrollment check fails instead of rejecting them.
+	enrollmentFailOpen bool
+	// digests holds the changes for the students who asked for a periodic digest until the next flush;
+	// every student is notified of each change right away when nil.
+	digests *digestQueue
+	// validator checks grade values and semesters before they are written; nil accepts every value.
+	validator *Validator
+	// latePenalty computes the effective value of late submissions.
+	latePenalty PenaltyPolicy
+	// maxCommentNewlines caps the consecutive line breaks kept in comments; unlimited when 0.
+	maxCommentNewlines int
+	// maxLeaderboardSize caps the students of a semester leaderboard; the default cap when 0.
+	maxLeaderboardSize int
+	// calendar resolves the semesters of an academic year; the zero value is the default calendar.
+	calendar AcademicCalendar
+	// weights are the default course component weights used for grade projections.
+	weights GradeWeights
+	// honorRoll decides who makes the honor roll of a semester.
+	honorRoll HonorRollPolicy
+	// probation decides which students are on academic probation.
+	probation ProbationPolicy
+	// readConsistency is the consistency of reads that do not ask for one.
+	readConsistency string
+	// signer signs official exports; nil when no signing key is configured.
+	signer *exportSigner
+	// transcripts renders transcripts as PDF documents; the default template is used when nil.
+	transcripts *transcriptRenderer
+	// researchMinGroupSize is the smallest k research datasets are made k-anonymous for.
+	researchMinGroupSize int
+	// poolMetrics exports the connection pool statistics of the database.
+	poolMetrics *poolMetrics
+	// missingComponentPolicy decides how weighted rankings treat students missing weighted components.
+	missingComponentPolicy string
+	// gradeScale converts letter grades to grade points; the default 4.0 scale is used when empty.
+	gradeScale GradeScale
+	// allowTestData enables generating synthetic grades for load testing.
+	allowTestData bool
+	// readiness keeps a marker file present while the service is ready; no file is written when nil.
+	readiness *readinessFile
+	// undoWindow is how long graders may undo their own grade changes.
+	undoWindow time.Duration
+	// labels translate grade values into the representation of each configured locale.
+	labels GradeLabels
+	// tombstoneRetention is how long removed grades stay recoverable before they may be purged.
+	tombstoneRetention time.Duration
+	// courseStatsMaxStaleness is how old course statistics may be before they are computed live.
+	courseStatsMaxStaleness time.Duration
+	// objectStore receives semester exports; exports are unavailable when nil.
+	objectStore ObjectStore
+	// exportBucket is the bucket semester exports are uploaded to.
+	exportBucket string
+	// exportFormat is the format of semester exports that request none.
+	exportFormat string
+	// courseWrites caps the grade writes per course per minute; writes are not capped when nil.
+	courseWrites *courseWriteLimiter
+	// exportStreams caps the course grade streams open at once; unlimited when nil.
+	exportStreams *streamLimiter
+	// streams tracks the course grade streams in flight so shutdown can drain them; untracked when nil.
+	streams *streamDrain
+	// multiTenant scopes every request to the caller's institution.
+	multiTenant bool
+	// trimRequests strips surrounding whitespace from request identifiers and grade fields.
+	trimRequests bool
+	// strictRequests rejects requests with fields unknown to the server or marked deprecated.
+	strictRequests bool
 }
 
 // VerifyToken returns the injected Claims instead of the default.
@@ -55,69 +199,559 @@ func (s *GradesServer) VerifyToken(ctx context.Context, token string) error {
 	return nil
 }
 
+// getClaims verifies the token and returns the caller's claims, preferring the injected Claims.
+func (s *GradesServer) getClaims(ctx context.Context, token string) (ms.Claims, error) {
+	if s.Claims != nil {
+		return s.Claims, nil
+	}
+
+	claims, err := s.BaseServiceServer.VerifyToken(ctx, token)
+	if err != nil {
+		return nil, fmt.Errorf("failed to verify token: %w", err)
+	}
+
+	identity, err := parseTokenIdentity(token)
+	if err != nil {
+		return nil, fmt.Errorf("failed to verify token: %w", err)
+	}
+
+	return verifiedClaims{Claims: claims, identity: identity}, nil
+}
+
+// requireRole verifies the token and ensures the caller holds at least one of the given roles.
+func (s *GradesServer) requireRole(ctx context.Context, token string, roles ...string) error {
+	_, err := s.authorizeRoles(ctx, token, roles...)
+
+	return err
+}
+
+// authorizeRoles is requireRole returning the caller's claims.
+func (s *GradesServer) authorizeRoles(ctx context.Context, token string, roles ...string) (ms.Claims, error) {
+	claims, err := s.getClaims(ctx, token)
+	if err != nil {
+		return nil, fmt.Errorf("authentication failed: %w",
+			status.Error(codes.Unauthenticated, err.Error()))
+	}
+
+	for _, role := range roles {
+		if claims.HasRole(role) {
+			return claims, nil
+		}
+	}
+
+	return nil, fmt.Errorf("authorization failed: %w",
+		status.Error(codes.PermissionDenied, "caller lacks the required role"))
+}
+
 func initGradesMicroserviceServer() (*GradesServer, error) {
 	base, err := ms.CreateBaseServiceServer()
 	if err != nil {
 		return nil, fmt.Errorf("failed to create base service: %w", err)
 	}
 
+	rounder, roundOnStore, err := gradeRoundingFromEnv()
+	if err != nil {
+		return nil, fmt.Errorf("failed to configure grade rounding: %w", err)
+	}
+
+	scheme, err := parseGradeScheme(os.Getenv("GRADE_SCHEME"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to configure grade scheme: %w", err)
+	}
+
+	validator, err := newValidator(scheme, os.Getenv("SEMESTER_PATTERN"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to configure semester pattern: %w", err)
+	}
+
+	weights, err := parseGradeWeights(os.Getenv("GRADE_WEIGHTS"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to configure grade weights: %w", err)
+	}
+
+	labels, err := parseGradeLabels(os.Getenv("GRADE_LABELS"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to configure grade labels: %w", err)
+	}
+
+	calendar, err := parseAcademicCalendar(os.Getenv("ACADEMIC_YEAR_TERMS"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to configure academic calendar: %w", err)
+	}
+
+	exportFormat, err := parseExportFormat(os.Getenv("EXPORT_FORMAT"), exportFormatCSV)
+	if err != nil {
+		return nil, fmt.Errorf("failed to configure semester exports: %w", err)
+	}
+
+	probation, err := probationPolicyFromEnv()
+	if err != nil {
+		return nil, fmt.Errorf("failed to configure academic probation: %w", err)
+	}
+
+	readConsistency, err := parseConsistency(os.Getenv("READ_CONSISTENCY"), consistencyEventual)
+	if err != nil {
+		return nil, fmt.Errorf("failed to configure read consistency: %w", err)
+	}
+
+	var signer *exportSigner
+
+	if spec := os.Getenv("SIGNING_KEY"); spec != "" {
+		if signer, err = parseSigningKey(spec); err != nil {
+			return nil, fmt.Errorf("failed to load signing key: %w", err)
+		}
+	}
+
+	missingComponentPolicy, err := parseMissingPolicy(os.Getenv("WEIGHTED_RANKING_MISSING_POLICY"), missingZero)
+	if err != nil {
+		return nil, fmt.Errorf("failed to configure weighted rankings: %w", err)
+	}
+
+	gradeScale, err := parseGradeScale(os.Getenv("GRADE_POINT_SCALE"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to configure grade point scale: %w", err)
+	}
+
+	transcripts, err := newTranscriptRenderer(os.Getenv("TRANSCRIPT_TEMPLATE"), os.Getenv("TRANSCRIPT_INSTITUTION"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to configure transcripts: %w", err)
+	}
+
 	database, err := InitializeDatabase()
 	if err != nil {
 		return nil, fmt.Errorf("failed to initialize database: %w", err)
 	}
 
-	return &GradesServer{
+	var db DBInterface = database
+
+	// Bound the wait for a pooled connection, so an exhausted pool fails fast with a clear error.
+	if maxConns := envInt("DB_MAX_OPEN_CONNS", 0); maxConns > 0 {
+		db = newPooledDatabase(database, maxConns, envDuration("DB_ACQUIRE_TIMEOUT", defaultAcquireTimeout))
+	}
+
+	// Fail fast while the database keeps failing, instead of letting every request wait out its timeout.
+	if failureRate := envFloat("DB_BREAKER_FAILURE_RATE", 0); failureRate > 0 {
+		db = newBreakerDatabase(db, newCircuitBreaker(failureRate, envInt("DB_BREAKER_WINDOW", defaultBreakerWindow),
+			envDuration("DB_BREAKER_COOLDOWN", defaultBreakerCooldown)))
+	}
+
+	// Mirror every call to a second database while migrating to it, logging where the two diverge.
+	if dsn := os.Getenv("DUAL_WRITE_DSN"); dsn != "" {
+		secondary, err := ConnectDB(WithDSN(dsn))
+		if err != nil {
+			return nil, fmt.Errorf("failed to connect to the dual write database: %w", err)
+		}
+
+		if err := secondary.createSchemaIfNotExists(context.Background()); err != nil {
+			return nil, fmt.Errorf("failed to create the dual write database schema: %w", err)
+		}
+
+		db = NewDualWriteDB(db, secondary)
+	}
+
+	server := &GradesServer{
 		BaseServiceServer:                base,
 		UnimplementedGradesServiceServer: gpb.UnimplementedGradesServiceServer{},
-		db:                               database,
-	}, nil
+		db:                               db,
+		maxResultRows:                    max(envInt("MAX_RESULT_ROWS", defaultMaxResultRows), 0),
+		rounder:                          rounder,
+		roundOnStore:                     roundOnStore,
+		validator:                        validator,
+		latePenalty:                      penaltyPolicyFromEnv(),
+		maxCommentNewlines:               max(envInt("COMMENT_MAX_NEWLINES", defaultMaxCommentNewlines), 0),
+		maxLeaderboardSize:               envInt("LEADERBOARD_MAX_SIZE", defaultMaxLeaderboardSize),
+		calendar:                         calendar,
+		weights:                          weights,
+		labels:                           labels,
+		honorRoll:                        honorRollPolicyFromEnv(),
+		probation:                        probation,
+		readConsistency:                  readConsistency,
+		signer:                           signer,
+		poolMetrics:                      newPoolMetrics(database.connectionPools()),
+		transcripts:                      transcripts,
+		gradeScale:                       gradeScale,
+		missingComponentPolicy:           missingComponentPolicy,
+		researchMinGroupSize:             envInt("RESEARCH_MIN_GROUP_SIZE", defaultResearchMinGroupSize),
+		undoWindow:                       envDuration("UNDO_WINDOW", defaultUndoWindow),
+		tombstoneRetention:               tombstoneRetentionFromEnv(),
+		courseStatsMaxStaleness:          envDuration("COURSE_STATS_MAX_STALENESS", defaultCourseStatsMaxStaleness),
+		exportBucket:                     os.Getenv("EXPORT_BUCKET"),
+		exportFormat:                     exportFormat,
+		exportStreams:                    newStreamLimiter(envInt("MAX_EXPORT_STREAMS", 0)),
+		courseWrites:                     newCourseWriteLimiter(envInt("COURSE_WRITES_PER_MINUTE", 0)),
+		streams:                          newStreamDrain(),
+		multiTenant:                      envBool("MULTI_TENANT", false),
+		trimRequests:                     envBool("TRIM_REQUEST_FIELDS", true),
+		strictRequests:                   envBool("STRICT_REQUESTS", false),
+		notifier:                         noopNotifier{},
+		enrollment:                       noopEnrollmentChecker{},
+		enrollmentFailOpen:               envBool("ENROLLMENT_FAIL_OPEN", false),
+		allowTestData:                    envBool("ALLOW_TEST_DATA", false),
+		watchers:                         newGradeWatchers(envInt("WATCH_BUFFER", defaultWatchBuffer)),
+	}
+
+	if url := os.Getenv("EVENTS_WEBHOOK_URL"); url != "" {
+		server.events = newWebhookPublisher(url)
+	}
+
+	if endpoint := os.Getenv("EXPORT_STORE_URL"); endpoint != "" {
+		server.objectStore = newHTTPObjectStore(endpoint)
+	}
+
+	if envInt("NOTIFICATION_DIGEST_INTERVAL_HOURS", 0) > 0 {
+		server.digests = newDigestQueue()
+	}
+
+	if path := os.Getenv("READY_FILE"); path != "" {
+		server.readiness = newReadinessFile(path, database.ping)
+	}
+
+	if envBool("SERVE_STALE_ON_ERROR", false) {
+		server.staleCache = newGradesCache(envInt("READ_CACHE_SIZE", defaultReadCacheSize))
+	}
+
+	return server, nil
+}
+
+// readGrades runs a list read and remembers its result. When stale serving is enabled and the
+// database cannot be reached, the last successful result for the same key is returned instead,
+// along with true to mark it as stale. Strong reads are never served stale.
+func (s *GradesServer) readGrades(ctx context.Context, key string,
+	read func() ([]*Grade, error),
+) ([]*Grade, bool, error) {
+	// Cached reads must never cross institutions.
+	if institutionID := institutionFromContext(ctx); institutionID != "" {
+		key = institutionID + "/" + key
+	}
+
+	grades, err := read()
+	if s.staleCache == nil {
+		return grades, false, err
+	}
+
+	if err == nil {
+		s.staleCache.Put(key, grades)
+
+		return grades, false, nil
+	}
+
+	if isConnectionError(err) && !primaryReadsFromContext(ctx) {
+		if cached, ok := s.staleCache.Get(key); ok {
+			klog.FromContext(ctx).Error(err, "Database unreachable, serving stale grades", "key", key)
+
+			return cached, true, nil
+		}
+	}
+
+	return nil, false, err
 }
 
 // GetCourseGrades returns all students grades for a specific course for a specific semester.
 func (s *GradesServer) GetCourseGrades(ctx context.Context,
 	req *gpb.GetCourseGradesRequest,
 ) (*gpb.GetCourseGradesResponse, error) {
-	if err := s.VerifyToken(ctx, req.GetToken()); err != nil {
+	claims, err := s.getClaims(ctx, req.GetToken())
+	if err != nil {
 		return nil, fmt.Errorf("authentication failed: %w",
 			status.Error(codes.Unauthenticated, err.Error()))
 	}
 
 	logger := klog.FromContext(ctx)
 	logger.V(logLevelDebug).Info("Received request for course grades", "course_id", req.GetCourseID(),
-		"semester", req.GetSemester())
+		"semester", req.GetSemester(), "consistency", req.GetConsistency())
+
+	ctx, err = s.withConsistency(ctx, req.GetConsistency(), false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get course grades: %w", err)
+	}
+
+	view, err := s.gradeView(ctx, claims)
+	if err != nil {
+		return nil, err
+	}
+
+	view.locale = req.GetLocale()
+
+	if req.GetAnonymize() {
+		if err := s.requireRole(ctx, req.GetToken(), roleStaff, roleAdmin); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(req.GetSemesters()) > 0 {
+		return s.getCourseGradesBySemester(ctx, req, view)
+	}
+
+	etag, err := s.courseGradesETag(ctx, req, view, []string{req.GetSemester()})
+	if err != nil {
+		return nil, err
+	}
+
+	if req.GetIfNoneMatch() != "" && req.GetIfNoneMatch() == etag {
+		return &gpb.GetCourseGradesResponse{Etag: etag, NotModified: true}, nil
+	}
 
 	// get course grades.
-	grades, err := s.db.GetCourseGrades(ctx, req.GetCourseID(), req.GetSemester())
+	grades, stale, err := s.readGrades(ctx, "course:"+req.GetCourseID()+":"+req.GetSemester(),
+		func() ([]*Grade, error) {
+			return s.db.GetCourseGrades(ctx, req.GetCourseID(), req.GetSemester())
+		})
 	if err != nil {
 		return nil, fmt.Errorf("failed to get course grades: %w", err)
 	}
 
+	if req.GetIncludeArchived() {
+		archived, err := s.db.GetArchivedCourseGrades(ctx, req.GetCourseID(), req.GetSemester())
+		if err != nil {
+			return nil, fmt.Errorf("failed to get archived course grades: %w", err)
+		}
+
+		grades = append(grades, archived...)
+	}
+
+	gradesResponse, truncated := s.createGradesResponse(grades, view)
+
+	if req.GetAnonymize() {
+		anonymizer, err := newStudentAnonymizer()
+		if err != nil {
+			return nil, err
+		}
+
+		anonymizer.Apply(gradesResponse)
+	}
+
 	return &gpb.GetCourseGradesResponse{
-		Grades: s.createGradesResponse(grades),
+		Grades:    gradesResponse,
+		Etag:      etag,
+		Stale:     stale,
+		Truncated: truncated,
 	}, nil
 }
 
+// courseGradesETag returns the ETag of a course's grades during the semesters as the view sees them
+// and the request shapes them. It is empty when the state of a semester is unknown because the
+// database is unreachable and the response may be served from the stale cache.
+func (s *GradesServer) courseGradesETag(ctx context.Context, req *gpb.GetCourseGradesRequest, view gradeView,
+	semesters []string,
+) (string, error) {
+	etags := make([]string, 0, len(semesters))
+
+	for _, semester := range semesters {
+		etag, err := s.db.GetCourseGradesETag(ctx, req.GetCourseID(), semester)
+		if err != nil && (s.staleCache == nil || !isConnectionError(err)) {
+			return "", fmt.Errorf("failed to get course grades etag: %w", err)
+		}
+
+		etags = append(etags, view.etag(etag, req.GetCourseID(), semester,
+			"include_archived="+strconv.FormatBool(req.GetIncludeArchived()),
+			"anonymize="+strconv.FormatBool(req.GetAnonymize())))
+	}
+
+	return combineETags(etags), nil
+}
+
+// getCourseGradesBySemester serves a GetCourseGrades request for several semesters, grouped by semester.
+func (s *GradesServer) getCourseGradesBySemester(ctx context.Context,
+	req *gpb.GetCourseGradesRequest, view gradeView,
+) (*gpb.GetCourseGradesResponse, error) {
+	semesters := uniqueInOrder(req.GetSemesters())
+	if err := validateSemesters(semesters); err != nil {
+		return nil, fmt.Errorf("failed to get course grades: %w", status.Error(codes.InvalidArgument, err.Error()))
+	}
+
+	etag, err := s.courseGradesETag(ctx, req, view, semesters)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.GetIfNoneMatch() != "" && req.GetIfNoneMatch() == etag {
+		return &gpb.GetCourseGradesResponse{Etag: etag, NotModified: true}, nil
+	}
+
+	grades, stale, err := s.readGrades(ctx, "course:"+req.GetCourseID()+":"+strings.Join(semesters, ","),
+		func() ([]*Grade, error) {
+			groups, err := s.db.GetCourseGradesBySemester(ctx, req.GetCourseID(), semesters)
+			if err != nil {
+				return nil, err
+			}
+
+			var grades []*Grade
+			for _, semester := range semesters {
+				grades = append(grades, groups[semester]...)
+			}
+
+			return grades, nil
+		})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get course grades: %w", err)
+	}
+
+	groups := groupGrades(semesters, grades, func(grade *Grade) string { return grade.Semester })
+
+	// A single anonymizer keeps a student's token the same across all requested semesters.
+	var anonymizer *studentAnonymizer
+	if req.GetAnonymize() {
+		if anonymizer, err = newStudentAnonymizer(); err != nil {
+			return nil, err
+		}
+	}
+
+	semesterGrades := make([]*gpb.SemesterGrades, 0, len(semesters))
+
+	for _, semester := range semesters {
+		grades := groups[semester]
+
+		if req.GetIncludeArchived() {
+			archived, err := s.db.GetArchivedCourseGrades(ctx, req.GetCourseID(), semester)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get archived course grades: %w", err)
+			}
+
+			grades = append(grades, archived...)
+		}
+
+		gradesResponse, truncated := s.createGradesResponse(grades, view)
+		if anonymizer != nil {
+			anonymizer.Apply(gradesResponse)
+		}
+
+		semesterGrades = append(semesterGrades, &gpb.SemesterGrades{
+			Semester:  semester,
+			Grades:    gradesResponse,
+			Truncated: truncated,
+		})
+	}
+
+	return &gpb.GetCourseGradesResponse{SemesterGrades: semesterGrades, Etag: etag, Stale: stale}, nil
+}
+
+// StreamCourseGrades streams the grades of a course during a semester one grade per message, for
+// courses too large for GetCourseGrades. Rows are read from the database only as fast as the client
+// consumes them: each send blocks while the client's flow-control window is full, which pauses the
+// row iteration instead of buffering the rest of the course.
+func (s *GradesServer) StreamCourseGrades(req *gpb.StreamCourseGradesRequest,
+	stream gpb.GradesService_StreamCourseGradesServer,
+) error {
+	ctx := stream.Context()
+
+	claims, err := s.getClaims(ctx, req.GetToken())
+	if err != nil {
+		return fmt.Errorf("authentication failed: %w",
+			status.Error(codes.Unauthenticated, err.Error()))
+	}
+
+	logger := klog.FromContext(ctx)
+	logger.V(logLevelDebug).Info("Received request to stream course grades", "course_id", req.GetCourseID(),
+		"semester", req.GetSemester())
+
+	release, err := s.exportStreams.acquire()
+	if err != nil {
+		return fmt.Errorf("failed to stream course grades: %w", err)
+	}
+	defer release()
+
+	// Shutdown signals the stream to stop at the next grade, and cancels ctx if it does not in time.
+	ctx, done, err := s.streams.track(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to stream course grades: %w", err)
+	}
+	defer done()
+
+	view, err := s.gradeView(ctx, claims)
+	if err != nil {
+		return err
+	}
+
+	view.locale = req.GetLocale()
+
+	err = s.db.StreamCourseGrades(ctx, req.GetCourseID(), req.GetSemester(), func(grade *Grade) error {
+		if err := s.streams.stopped(ctx); err != nil {
+			return err
+		}
+
+		if err := stream.Send(s.gradeResponse(grade, view)); err != nil {
+			if ctx.Err() != nil {
+				return s.streams.stopped(ctx)
+			}
+
+			return fmt.Errorf("failed to send grade: %w", err)
+		}
+
+		return nil
+	})
+
+	switch {
+	case err != nil && ctx.Err() != nil:
+		return fmt.Errorf("failed to stream course grades: %w", s.streams.stopped(ctx))
+	case errors.Is(err, ErrCourseIDEmpty):
+		return fmt.Errorf("failed to stream course grades: %w", status.Error(codes.InvalidArgument, err.Error()))
+	case err != nil:
+		return fmt.Errorf("failed to stream course grades: %w", err)
+	}
+
+	return nil
+}
+
 // GetStudentCourseGrades returns all grades for a specific student in a specific course for a specific semester.
 func (s *GradesServer) GetStudentCourseGrades(ctx context.Context,
 	req *gpb.GetStudentCourseGradesRequest,
 ) (*gpb.GetStudentCourseGradesResponse, error) {
-	if err := s.VerifyToken(ctx, req.GetToken()); err != nil {
+	claims, err := s.getClaims(ctx, req.GetToken())
+	if err != nil {
 		return nil, fmt.Errorf("authentication failed: %w",
 			status.Error(codes.Unauthenticated, err.Error()))
 	}
 
 	logger := klog.FromContext(ctx)
 	logger.V(logLevelDebug).Info("Received request for student course grades", "course_id", req.GetCourseID(),
-		"semester", req.GetSemester(), "student_id", req.GetStudentID())
+		"semester", req.GetSemester(), "student_id", req.GetStudentID(), "consistency", req.GetConsistency())
+
+	ctx, err = s.withConsistency(ctx, req.GetConsistency(), false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get student course grades: %w", err)
+	}
+
+	view, err := s.gradeView(ctx, claims)
+	if err != nil {
+		return nil, err
+	}
+
+	view.locale = req.GetLocale()
 
 	// get student course grades.
-	grades, err := s.db.GetStudentCourseGrades(ctx, req.GetCourseID(), req.GetSemester(), req.GetStudentID())
+	grades, stale, err := s.readGrades(ctx,
+		"student-course:"+req.GetStudentID()+":"+req.GetCourseID()+":"+req.GetSemester(),
+		func() ([]*Grade, error) {
+			return s.db.GetStudentCourseGrades(ctx, req.GetCourseID(), req.GetSemester(), req.GetStudentID())
+		})
 	if err != nil {
 		return nil, fmt.Errorf("failed to get student course grades: %w", err)
 	}
 
+	var policy AttemptPolicy
+
+	if !req.GetRawAttempts() && req.GetCourseID() != "" {
+		// Grades served from cache while the database is down are returned as every attempt.
+		if policy, err = s.db.GetAttemptPolicy(ctx, req.GetCourseID()); err != nil {
+			logger.Error(err, "Failed to get attempt policy, returning every attempt", "course_id", req.GetCourseID())
+		}
+
+		grades = policy.aggregate(grades)
+	}
+
+	gradesResponse, truncated := s.createGradesResponse(grades, view)
+	for i, grade := range gradesResponse {
+		if !grade.GetPendingRelease() {
+			grade.EffectiveValue = s.latePenalty.EffectiveValue(grades[i], s.rounder)
+		}
+	}
+
 	return &gpb.GetStudentCourseGradesResponse{
-		Grades: s.createGradesResponse(grades),
+		Grades:        gradesResponse,
+		Stale:         stale,
+		Trend:         gradeTrend(view.embargo.released(grades), s.rounder),
+		Truncated:     truncated,
+		AttemptPolicy: string(policy),
 	}, nil
 }
 
@@ -125,74 +759,196 @@ func (s *GradesServer) GetStudentCourseGrades(ctx context.Context,
 func (s *GradesServer) AddSingleGrade(ctx context.Context,
 	req *gpb.AddSingleGradeRequest,
 ) (*gpb.AddSingleGradeResponse, error) {
-	if err := s.VerifyToken(ctx, req.GetToken()); err != nil {
+	claims, err := s.getClaims(ctx, req.GetToken())
+	if err != nil {
 		return nil, fmt.Errorf("authentication failed: %w",
 			status.Error(codes.Unauthenticated, err.Error()))
 	}
 
+	ctx = withActor(ctx, callerIdentity(claims))
+
 	logger := klog.FromContext(ctx)
 	logger.V(logLevelDebug).Info("Received request for add single grade", "course_id", req.GetGrade().GetCourseID(),
 		"semester", req.GetGrade().GetSemester(), "student_id", req.GetGrade().GetStudentID())
 
+	if err := s.authorizeGrader(ctx, claims, req.GetGrade().GetCourseID()); err != nil {
+		return nil, err
+	}
+
+	view, err := s.gradeView(ctx, claims)
+	if err != nil {
+		return nil, err
+	}
+
+	scheme, err := s.newGradeTypeSchemes().lookup(ctx, req.GetGrade().GetCourseID(), req.GetGrade().GetGradeType())
+	if err != nil {
+		return nil, fmt.Errorf("failed to add single grade: %w", err)
+	}
+
+	if err := s.validator.ValidateGradeValueAs(scheme, req.GetGrade().GetGradeValue()); err != nil {
+		return nil, fmt.Errorf("failed to add single grade: %w", status.Error(codes.InvalidArgument, err.Error()))
+	}
+
+	if err := s.validator.ValidateSemester(req.GetGrade().GetSemester()); err != nil {
+		return nil, fmt.Errorf("failed to add single grade: %w", status.Error(codes.InvalidArgument, err.Error()))
+	}
+
+	if err := rubricFromProto(req.GetGrade().GetRubricScores()).validate(); err != nil {
+		return nil, fmt.Errorf("failed to add single grade: %w", status.Error(codes.InvalidArgument, err.Error()))
+	}
+
+	if err := validateGradeTimes(req.GetGrade()); err != nil {
+		return nil, fmt.Errorf("failed to add single grade: %w", status.Error(codes.InvalidArgument, err.Error()))
+	}
+
+	if err := s.checkEnrollment(ctx, req.GetGrade()); err != nil {
+		return nil, fmt.Errorf("failed to add single grade: %w", enrollmentStatus(err))
+	}
+
+	if err := s.checkCourseWriteRate(claims, req.GetGrade().GetCourseID()); err != nil {
+		return nil, fmt.Errorf("failed to add single grade: %w", err)
+	}
+
+	if s.roundOnStore && req.GetGrade() != nil {
+		req.Grade.GradeValue = s.rounder.Round(req.GetGrade().GetGradeValue())
+	}
+
+	if req.GetGrade() != nil {
+		req.Grade.Comments = sanitizeComment(req.GetGrade().GetComments(), s.maxCommentNewlines)
+	}
+
 	// add grade.
-	if _, err := s.db.AddGrade(ctx, req.GetGrade()); err != nil {
+	addedGrade, err := s.db.AddGrade(ctx, req.GetGrade())
+	if errors.Is(err, ErrGradeAlreadyExists) {
+		return nil, fmt.Errorf("failed to add single grade: %w", status.Error(codes.AlreadyExists, err.Error()))
+	} else if err != nil {
 		return nil, fmt.Errorf("failed to add single grade: %w", err)
 	}
 
-	return &gpb.AddSingleGradeResponse{Grade: req.GetGrade()}, nil
+	s.publishEvent(ctx, newGradeEvent(addedEventType(addedGrade), addedGrade))
+
+	// Respond with the stored grade, which carries the generated ID and the timestamps set on insert.
+	return &gpb.AddSingleGradeResponse{Grade: s.gradeResponse(addedGrade, view)}, nil
 }
 
 // UpdateSingleGrade updates a single grade for a specific student in a specific course for a specific semester.
 func (s *GradesServer) UpdateSingleGrade(ctx context.Context,
 	req *gpb.UpdateSingleGradeRequest,
 ) (*gpb.UpdateSingleGradeResponse, error) {
-	if err := s.VerifyToken(ctx, req.GetToken()); err != nil {
+	claims, err := s.getClaims(ctx, req.GetToken())
+	if err != nil {
 		return nil, fmt.Errorf("authentication failed: %w",
 			status.Error(codes.Unauthenticated, err.Error()))
 	}
 
+	ctx = withActor(ctx, callerIdentity(claims))
+
 	logger := klog.FromContext(ctx)
 	logger.V(logLevelDebug).Info("Received request for update single grade", "course_id", req.GetGrade().GetCourseID(),
 		"semester", req.GetGrade().GetSemester(), "student_id", req.GetGrade().GetStudentID())
 
-	// update grade.
-	updatedGrade, err := s.db.UpdateGrade(ctx, req.GetGrade())
+	view, err := s.gradeView(ctx, claims)
 	if err != nil {
+		return nil, err
+	}
+
+	if err := s.authorizeGradeUpdate(ctx, claims, req.GetGrade()); err != nil {
+		return nil, err
+	}
+
+	if err := s.checkGradeUpdateRate(ctx, claims, req.GetGrade()); err != nil {
 		return nil, fmt.Errorf("failed to update single grade: %w", err)
 	}
 
-	// updated grade.
-	grade := &gpb.SingleGrade{
-		GradeID:    updatedGrade.GradeID,
-		StudentID:  updatedGrade.StudentID,
-		CourseID:   updatedGrade.CourseID,
-		Semester:   updatedGrade.Semester,
-		GradeType:  updatedGrade.GradeType,
-		ItemID:     updatedGrade.ItemID,
-		GradeValue: updatedGrade.GradeValue,
-		GradedBy:   updatedGrade.GradedBy,
-		Comments:   updatedGrade.Comments,
+	// An empty value keeps the stored one, so only new values are validated.
+	if value := req.GetGrade().GetGradeValue(); value != "" {
+		scheme, err := s.updatedGradeScheme(ctx, req.GetGrade())
+		if err != nil {
+			return nil, fmt.Errorf("failed to update single grade: %w", err)
+		}
+
+		if err := s.validator.ValidateGradeValueAs(scheme, value); err != nil {
+			return nil, fmt.Errorf("failed to update single grade: %w",
+				status.Error(codes.InvalidArgument, err.Error()))
+		}
 	}
 
-	return &gpb.UpdateSingleGradeResponse{Grade: grade}, nil
-}
+	if err := rubricFromProto(req.GetGrade().GetRubricScores()).validate(); err != nil {
+		return nil, fmt.Errorf("failed to update single grade: %w", status.Error(codes.InvalidArgument, err.Error()))
+	}
 
-// RemoveSingleGrade removes a single grade for a specific student in a specific course for a specific semester.
-func (s *GradesServer) RemoveSingleGrade(ctx context.Context,
-	req *gpb.RemoveSingleGradeRequest,
-) (*gpb.RemoveSingleGradeResponse, error) {
-	if err := s.VerifyToken(ctx, req.GetToken()); err != nil {
-		return nil, fmt.Errorf("authentication failed: %w",
-			status.Error(codes.Unauthenticated, err.Error()))
+	if err := validateGradeTimes(req.GetGrade()); err != nil {
+		return nil, fmt.Errorf("failed to update single grade: %w", status.Error(codes.InvalidArgument, err.Error()))
 	}
 
-	logger := klog.FromContext(ctx)
-	logger.V(logLevelDebug).Info("Received request to remove a single grade", "grade_id", req.GetGradeID())
+	if s.roundOnStore && req.GetGrade() != nil {
+		req.Grade.GradeValue = s.rounder.Round(req.GetGrade().GetGradeValue())
+	}
 
-	if err := s.db.RemoveGrade(ctx, req.GetGradeID()); err != nil {
-		return nil, fmt.Errorf("failed to remove single grade: %w", err)
+	if req.GetGrade() != nil {
+		req.Grade.Comments = sanitizeComment(req.GetGrade().GetComments(), s.maxCommentNewlines)
 	}
 
+	// update grade.
+	updatedGrade, err := s.db.UpdateGrade(ctx, req.GetGrade())
+
+	switch {
+	case errors.Is(err, ErrGradeUnderAppeal):
+		return nil, fmt.Errorf("failed to update single grade: %w",
+			status.Error(codes.FailedPrecondition, err.Error()))
+	case errors.Is(err, ErrGradeVersionConflict):
+		return nil, fmt.Errorf("failed to update single grade: %w", status.Error(codes.Aborted, err.Error()))
+	case errors.Is(err, ErrGradeNotFound):
+		return nil, fmt.Errorf("failed to update single grade: %w", status.Error(codes.NotFound, err.Error()))
+	case err != nil:
+		return nil, fmt.Errorf("failed to update single grade: %w", err)
+	}
+
+	s.publishEvent(ctx, newGradeEvent(eventGradeUpdated, updatedGrade))
+	s.notifyStudent(ctx, updatedGrade)
+
+	return &gpb.UpdateSingleGradeResponse{Grade: s.gradeResponse(updatedGrade, view)}, nil
+}
+
+// RemoveSingleGrade removes a single grade for a specific student in a specific course for a specific semester.
+func (s *GradesServer) RemoveSingleGrade(ctx context.Context,
+	req *gpb.RemoveSingleGradeRequest,
+) (*gpb.RemoveSingleGradeResponse, error) {
+	claims, err := s.getClaims(ctx, req.GetToken())
+	if err != nil {
+		return nil, fmt.Errorf("authentication failed: %w",
+			status.Error(codes.Unauthenticated, err.Error()))
+	}
+
+	ctx = withActor(ctx, callerIdentity(claims))
+
+	logger := klog.FromContext(ctx)
+	logger.V(logLevelDebug).Info("Received request to remove a single grade", "grade_id", req.GetGradeID())
+
+	grade, err := s.db.GetGradeByID(ctx, req.GetGradeID())
+	if err == nil {
+		if err := s.authorizeGrader(ctx, claims, grade.CourseID); err != nil {
+			return nil, err
+		}
+
+		err = s.db.RemoveGrade(ctx, grade.GradeID)
+	}
+
+	switch {
+	case errors.Is(err, ErrGradeUnderAppeal):
+		return nil, fmt.Errorf("failed to remove single grade: %w",
+			status.Error(codes.FailedPrecondition, err.Error()))
+	case errors.Is(err, ErrGradeNotFound):
+		return nil, fmt.Errorf("failed to remove single grade: %w", status.Error(codes.NotFound, err.Error()))
+	case errors.Is(err, ErrGradeIDEmpty):
+		return nil, fmt.Errorf("failed to remove single grade: %w",
+			status.Error(codes.InvalidArgument, err.Error()))
+	case err != nil:
+		return nil, fmt.Errorf("failed to remove single grade: %w", err)
+	}
+
+	s.publishEvent(ctx, newGradeEvent(eventGradeRemoved, grade))
+
 	return &gpb.RemoveSingleGradeResponse{}, nil
 }
 
@@ -200,43 +956,720 @@ func (s *GradesServer) RemoveSingleGrade(ctx context.Context,
 func (s *GradesServer) GetStudentSemesterGrades(ctx context.Context,
 	req *gpb.GetStudentSemesterGradesRequest,
 ) (*gpb.GetStudentSemesterGradesResponse, error) {
-	if err := s.VerifyToken(ctx, req.GetToken()); err != nil {
+	claims, err := s.getClaims(ctx, req.GetToken())
+	if err != nil {
 		return nil, fmt.Errorf("authentication failed: %w",
 			status.Error(codes.Unauthenticated, err.Error()))
 	}
 
 	logger := klog.FromContext(ctx)
 	logger.V(logLevelDebug).Info("Received request for student semester grades",
-		"semester", req.GetSemester(), "student_id", req.GetStudentID())
+		"semester", req.GetSemester(), "student_id", req.GetStudentID(), "consistency", req.GetConsistency())
+
+	ctx, err = s.withConsistency(ctx, req.GetConsistency(), false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get student semester grades: %w", err)
+	}
+
+	view, err := s.gradeView(ctx, claims)
+	if err != nil {
+		return nil, err
+	}
+
+	view.locale = req.GetLocale()
 
 	// get student semester grades.
-	grades, err := s.db.GetStudentSemesterGrades(ctx, req.GetStudentID(), req.GetSemester())
+	grades, stale, err := s.readGrades(ctx, "student-semester:"+req.GetStudentID()+":"+req.GetSemester(),
+		func() ([]*Grade, error) {
+			return s.db.GetStudentSemesterGrades(ctx, req.GetStudentID(), req.GetSemester())
+		})
 	if err != nil {
 		return nil, fmt.Errorf("failed to get student semester grades: %w", err)
 	}
 
-	return &gpb.GetStudentSemesterGradesResponse{
-		Grades: s.createGradesResponse(grades),
+	gradesResponse, truncated := s.createGradesResponse(grades, view)
+	resp := &gpb.GetStudentSemesterGradesResponse{
+		Grades:    gradesResponse,
+		Stale:     stale,
+		Truncated: truncated,
+	}
+
+	if req.GetIncludeCourseInfo() && s.courses != nil {
+		resp.Courses, resp.UnresolvedCourseIDs = enrichCourses(ctx, s.courses, grades)
+	}
+
+	return resp, nil
+}
+
+// ArchiveSemester moves all grades of a semester to cold storage.
+func (s *GradesServer) ArchiveSemester(ctx context.Context,
+	req *gpb.ArchiveSemesterRequest,
+) (*gpb.ArchiveSemesterResponse, error) {
+	if err := s.requireRole(ctx, req.GetToken(), roleAdmin); err != nil {
+		return nil, err
+	}
+
+	logger := klog.FromContext(ctx)
+	logger.V(logLevelDebug).Info("Received request to archive semester", "semester", req.GetSemester())
+
+	archived, err := s.db.ArchiveSemester(ctx, req.GetSemester())
+	if err != nil {
+		return nil, fmt.Errorf("failed to archive semester: %w", err)
+	}
+
+	return &gpb.ArchiveSemesterResponse{ArchivedCount: archived}, nil
+}
+
+// GetGrade returns a single grade, either the latest state or a specific historical version. A
+// caller polling a grade passes the version it holds and is told when it is not modified.
+func (s *GradesServer) GetGrade(ctx context.Context, req *gpb.GetGradeRequest) (*gpb.GetGradeResponse, error) {
+	claims, err := s.getClaims(ctx, req.GetToken())
+	if err != nil {
+		return nil, fmt.Errorf("authentication failed: %w",
+			status.Error(codes.Unauthenticated, err.Error()))
+	}
+
+	logger := klog.FromContext(ctx)
+	logger.V(logLevelDebug).Info("Received request for grade", "grade_id", req.GetGradeID(),
+		"version", req.GetVersion(), "if_version_not", req.GetIfVersionNot(), "consistency", req.GetConsistency())
+
+	ctx, err = s.withConsistency(ctx, req.GetConsistency(), req.GetReadAfterWrite())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get grade: %w", err)
+	}
+
+	view, err := s.gradeView(ctx, claims)
+	if err != nil {
+		return nil, err
+	}
+
+	view.locale = req.GetLocale()
+
+	var grade *Grade
+
+	if req.GetVersion() == 0 {
+		grade, err = s.db.GetGradeByID(ctx, req.GetGradeID())
+	} else {
+		grade, err = s.db.GetGradeVersion(ctx, req.GetGradeID(), req.GetVersion())
+	}
+
+	switch {
+	case errors.Is(err, ErrGradeNotFound), errors.Is(err, ErrGradeVersionNotFound):
+		return nil, fmt.Errorf("failed to get grade: %w", status.Error(codes.NotFound, err.Error()))
+	case errors.Is(err, ErrGradeIDEmpty):
+		return nil, fmt.Errorf("failed to get grade: %w", status.Error(codes.InvalidArgument, err.Error()))
+	case err != nil:
+		return nil, fmt.Errorf("failed to get grade: %w", err)
+	}
+
+	if req.GetIfVersionNot() != 0 && grade.Version == req.GetIfVersionNot() {
+		return &gpb.GetGradeResponse{NotModified: true}, nil
+	}
+
+	s.recordAccess(ctx, grade.GradeID, claims)
+
+	return &gpb.GetGradeResponse{Grade: s.gradeResponse(grade, view)}, nil
+}
+
+// GetGradeValue returns only the value of a grade, identified by its ID or by its student, course,
+// semester and item, for clients that need nothing else.
+func (s *GradesServer) GetGradeValue(ctx context.Context,
+	req *gpb.GetGradeValueRequest,
+) (*gpb.GetGradeValueResponse, error) {
+	claims, err := s.getClaims(ctx, req.GetToken())
+	if err != nil {
+		return nil, fmt.Errorf("authentication failed: %w",
+			status.Error(codes.Unauthenticated, err.Error()))
+	}
+
+	logger := klog.FromContext(ctx)
+	logger.V(logLevelDebug).Info("Received request for grade value", "grade_id", req.GetGradeID(),
+		"student_id", req.GetStudentID(), "course_id", req.GetCourseID(), "semester", req.GetSemester(),
+		"item_id", req.GetItemID(), "consistency", req.GetConsistency())
+
+	ctx, err = s.withConsistency(ctx, req.GetConsistency(), false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get grade value: %w", err)
+	}
+
+	view, err := s.gradeView(ctx, claims)
+	if err != nil {
+		return nil, err
+	}
+
+	value, err := s.db.GetGradeValue(ctx, GradeKey{
+		GradeID:   req.GetGradeID(),
+		StudentID: req.GetStudentID(),
+		CourseID:  req.GetCourseID(),
+		Semester:  req.GetSemester(),
+		ItemID:    req.GetItemID(),
+	})
+
+	switch {
+	case errors.Is(err, ErrGradeNotFound):
+		return nil, fmt.Errorf("failed to get grade value: %w", status.Error(codes.NotFound, err.Error()))
+	case errors.Is(err, ErrStudentIDEmpty), errors.Is(err, ErrCourseIDEmpty):
+		return nil, fmt.Errorf("failed to get grade value: %w", status.Error(codes.InvalidArgument, err.Error()))
+	case err != nil:
+		return nil, fmt.Errorf("failed to get grade value: %w", err)
+	}
+
+	if err := s.checkGradeValueReleased(ctx, view, req); err != nil {
+		return nil, err
+	}
+
+	return &gpb.GetGradeValueResponse{GradeValue: s.rounder.Round(value)}, nil
+}
+
+// GetGradeProvenance returns the chronological history of a grade from its versions and access log.
+func (s *GradesServer) GetGradeProvenance(ctx context.Context,
+	req *gpb.GetGradeProvenanceRequest,
+) (*gpb.GetGradeProvenanceResponse, error) {
+	if err := s.requireRole(ctx, req.GetToken(), roleStaff, roleAuditor, roleAdmin); err != nil {
+		return nil, err
+	}
+
+	logger := klog.FromContext(ctx)
+	logger.V(logLevelDebug).Info("Received request for grade provenance", "grade_id", req.GetGradeID())
+
+	versions, err := s.db.GetGradeVersions(ctx, req.GetGradeID())
+
+	switch {
+	case errors.Is(err, ErrGradeNotFound):
+		return nil, fmt.Errorf("failed to get grade provenance: %w", status.Error(codes.NotFound, err.Error()))
+	case errors.Is(err, ErrGradeIDEmpty):
+		return nil, fmt.Errorf("failed to get grade provenance: %w",
+			status.Error(codes.InvalidArgument, err.Error()))
+	case err != nil:
+		return nil, fmt.Errorf("failed to get grade provenance: %w", err)
+	}
+
+	accesses, err := s.db.GetGradeAudit(ctx, req.GetGradeID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get grade provenance: %w", err)
+	}
+
+	return &gpb.GetGradeProvenanceResponse{Events: provenanceTimeline(versions, accesses)}, nil
+}
+
+// ProjectRequiredGrade computes the score a student needs on the ungraded components of a course
+// to reach a target final grade, using the weights from the request or the configured defaults.
+func (s *GradesServer) ProjectRequiredGrade(ctx context.Context,
+	req *gpb.ProjectRequiredGradeRequest,
+) (*gpb.ProjectRequiredGradeResponse, error) {
+	claims, err := s.getClaims(ctx, req.GetToken())
+	if err != nil {
+		return nil, fmt.Errorf("authentication failed: %w",
+			status.Error(codes.Unauthenticated, err.Error()))
+	}
+
+	logger := klog.FromContext(ctx)
+	logger.V(logLevelDebug).Info("Received request to project required grade", "course_id", req.GetCourseID(),
+		"semester", req.GetSemester(), "student_id", req.GetStudentID(), "target_grade", req.GetTargetGrade())
+
+	target := req.GetTargetGrade()
+	if target < minNumericGrade || target > maxNumericGrade {
+		return nil, fmt.Errorf("failed to project required grade: %w", status.Error(codes.InvalidArgument,
+			fmt.Sprintf("target grade must be between %d and %d", minNumericGrade, maxNumericGrade)))
+	}
+
+	weights := s.weights
+	if len(req.GetWeights()) > 0 {
+		if weights, err = GradeWeights(req.GetWeights()).normalize(); err != nil {
+			return nil, fmt.Errorf("failed to project required grade: %w",
+				status.Error(codes.InvalidArgument, err.Error()))
+		}
+	}
+
+	if len(weights) == 0 {
+		return nil, fmt.Errorf("failed to project required grade: %w",
+			status.Error(codes.FailedPrecondition, ErrGradeWeightsMissing.Error()))
+	}
+
+	grades, err := s.db.GetStudentCourseGrades(ctx, req.GetCourseID(), req.GetSemester(), req.GetStudentID())
+	if errors.Is(err, ErrStudentIDEmpty) {
+		return nil, fmt.Errorf("failed to project required grade: %w",
+			status.Error(codes.InvalidArgument, err.Error()))
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to project required grade: %w", err)
+	}
+
+	view, err := s.gradeView(ctx, claims)
+	if err != nil {
+		return nil, err
+	}
+
+	// Unreleased grades must not leak through the projection.
+	projection := projectRequiredGrade(view.embargo.released(grades), weights, target)
+
+	return &gpb.ProjectRequiredGradeResponse{
+		RequiredGrade:       s.rounder.RoundNumber(projection.required),
+		Reachable:           projection.reachable,
+		CurrentGrade:        s.rounder.RoundNumber(projection.current),
+		RemainingComponents: projection.remaining,
+	}, nil
+}
+
+// GetGradingVelocity reports how fast an item of a course is being graded and projects when grading
+// completes for a roster of the given size. Requires the staff role.
+func (s *GradesServer) GetGradingVelocity(ctx context.Context,
+	req *gpb.GetGradingVelocityRequest,
+) (*gpb.GetGradingVelocityResponse, error) {
+	if err := s.requireRole(ctx, req.GetToken(), roleStaff, roleAdmin); err != nil {
+		return nil, err
+	}
+
+	logger := klog.FromContext(ctx)
+	logger.V(logLevelDebug).Info("Received request for grading velocity", "course_id", req.GetCourseID(),
+		"semester", req.GetSemester(), "item_id", req.GetItemID())
+
+	if req.GetItemID() == "" {
+		return nil, fmt.Errorf("failed to get grading velocity: %w",
+			status.Error(codes.InvalidArgument, ErrItemIDEmpty.Error()))
+	}
+
+	var deadline time.Time
+
+	if req.GetDeadline() != "" {
+		var err error
+		if deadline, err = time.Parse(time.RFC3339, req.GetDeadline()); err != nil {
+			return nil, fmt.Errorf("failed to get grading velocity: %w",
+				status.Error(codes.InvalidArgument, "deadline must be in RFC 3339 format"))
+		}
+	}
+
+	grades, err := s.db.GetCourseGrades(ctx, req.GetCourseID(), req.GetSemester())
+	if errors.Is(err, ErrCourseIDEmpty) {
+		return nil, fmt.Errorf("failed to get grading velocity: %w",
+			status.Error(codes.InvalidArgument, err.Error()))
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to get grading velocity: %w", err)
+	}
+
+	activity := dailyGradingActivity(grades, req.GetItemID())
+	velocity := projectGradingVelocity(activity, int(req.GetRosterSize()), deadline, time.Now())
+
+	resp := &gpb.GetGradingVelocityResponse{
+		GradesPerDay:   velocity.gradesPerDay,
+		GradedCount:    int32(velocity.graded),
+		RemainingCount: int32(velocity.remaining),
+		Status:         velocity.status,
+		MissesDeadline: velocity.missesDeadline,
+		DailyCounts:    make([]*gpb.DailyGradingCount, 0, len(activity)),
+	}
+
+	if !velocity.completion.IsZero() {
+		resp.ProjectedCompletion = velocity.completion.UTC().Format(time.RFC3339)
+	}
+
+	for day, count := range activity {
+		resp.DailyCounts = append(resp.DailyCounts, &gpb.DailyGradingCount{Date: day, Count: int32(count)})
+	}
+
+	sort.Slice(resp.DailyCounts, func(i, j int) bool {
+		return resp.DailyCounts[i].GetDate() < resp.DailyCounts[j].GetDate()
+	})
+
+	return resp, nil
+}
+
+// CompareCourseSemesters compares the numeric grades of a course between two semesters, matching
+// students by ID. Students graded in only one of the semesters are flagged. Requires the staff role.
+func (s *GradesServer) CompareCourseSemesters(ctx context.Context,
+	req *gpb.CompareCourseSemestersRequest,
+) (*gpb.CompareCourseSemestersResponse, error) {
+	if err := s.requireRole(ctx, req.GetToken(), roleStaff, roleAdmin); err != nil {
+		return nil, err
+	}
+
+	logger := klog.FromContext(ctx)
+	logger.V(logLevelDebug).Info("Received request to compare course semesters", "course_id", req.GetCourseID(),
+		"semester_a", req.GetSemesterA(), "semester_b", req.GetSemesterB())
+
+	groups, err := s.db.GetCourseGradesBySemester(ctx, req.GetCourseID(),
+		[]string{req.GetSemesterA(), req.GetSemesterB()})
+	if errors.Is(err, ErrCourseIDEmpty) || errors.Is(err, ErrSemesterEmpty) {
+		return nil, fmt.Errorf("failed to compare course semesters: %w",
+			status.Error(codes.InvalidArgument, err.Error()))
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to compare course semesters: %w", err)
+	}
+
+	deltas, averageA, averageB := compareSemesters(groups[req.GetSemesterA()], groups[req.GetSemesterB()], s.rounder)
+
+	resp := &gpb.CompareCourseSemestersResponse{
+		Students:     make([]*gpb.StudentSemesterDelta, 0, len(deltas)),
+		AverageA:     averageA,
+		AverageB:     averageB,
+		AverageDelta: averageB - averageA,
+	}
+
+	for _, delta := range deltas {
+		resp.Students = append(resp.Students, &gpb.StudentSemesterDelta{
+			StudentID: delta.studentID,
+			AverageA:  delta.averageA,
+			AverageB:  delta.averageB,
+			Delta:     delta.delta,
+			OnlyInA:   delta.onlyA,
+			OnlyInB:   delta.onlyB,
+		})
+	}
+
+	return resp, nil
+}
+
+// GetSemesterGradeTypeSummary returns the number of grades and the average numeric grade of each
+// grade type across a semester. Requires the admin role.
+func (s *GradesServer) GetSemesterGradeTypeSummary(ctx context.Context,
+	req *gpb.GetSemesterGradeTypeSummaryRequest,
+) (*gpb.GetSemesterGradeTypeSummaryResponse, error) {
+	if err := s.requireRole(ctx, req.GetToken(), roleAdmin); err != nil {
+		return nil, err
+	}
+
+	logger := klog.FromContext(ctx)
+	logger.V(logLevelDebug).Info("Received request for semester grade type summary", "semester", req.GetSemester())
+
+	summaries, err := s.db.GetSemesterGradeTypeSummary(ctx, req.GetSemester())
+	if errors.Is(err, ErrSemesterEmpty) {
+		return nil, fmt.Errorf("failed to get semester grade type summary: %w",
+			status.Error(codes.InvalidArgument, err.Error()))
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to get semester grade type summary: %w", err)
+	}
+
+	resp := &gpb.GetSemesterGradeTypeSummaryResponse{
+		Summaries: make([]*gpb.GradeTypeSummary, 0, len(summaries)),
+	}
+
+	for _, summary := range summaries {
+		entry := &gpb.GradeTypeSummary{
+			GradeType:    summary.GradeType,
+			Count:        summary.Count,
+			NumericCount: summary.NumericCount,
+		}
+
+		if summary.Average != nil {
+			entry.Average = *summary.Average
+		}
+
+		resp.Summaries = append(resp.Summaries, entry)
+	}
+
+	return resp, nil
+}
+
+// SearchGradeComments returns the grades of a course whose comments contain the search term.
+func (s *GradesServer) SearchGradeComments(ctx context.Context,
+	req *gpb.SearchGradeCommentsRequest,
+) (*gpb.SearchGradeCommentsResponse, error) {
+	claims, err := s.getClaims(ctx, req.GetToken())
+	if err != nil {
+		return nil, fmt.Errorf("authentication failed: %w",
+			status.Error(codes.Unauthenticated, err.Error()))
+	}
+
+	logger := klog.FromContext(ctx)
+	logger.V(logLevelDebug).Info("Received request to search grade comments", "course_id", req.GetCourseID(),
+		"semester", req.GetSemester())
+
+	view, err := s.gradeView(ctx, claims)
+	if err != nil {
+		return nil, err
+	}
+
+	grades, err := s.db.SearchGradeComments(ctx, req.GetCourseID(), req.GetSemester(), req.GetQuery())
+	if errors.Is(err, ErrSearchTermEmpty) || errors.Is(err, ErrSearchTermTooLong) {
+		return nil, fmt.Errorf("failed to search grade comments: %w",
+			status.Error(codes.InvalidArgument, err.Error()))
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to search grade comments: %w", err)
+	}
+
+	gradesResponse, truncated := s.createGradesResponse(grades, view)
+
+	return &gpb.SearchGradeCommentsResponse{
+		Grades:    gradesResponse,
+		Truncated: truncated,
+	}, nil
+}
+
+// SetGradeFlag marks or unmarks a grade for review.
+func (s *GradesServer) SetGradeFlag(ctx context.Context,
+	req *gpb.SetGradeFlagRequest,
+) (*gpb.SetGradeFlagResponse, error) {
+	claims, err := s.authorizeRoles(ctx, req.GetToken(), roleStaff, roleAdmin)
+	if err != nil {
+		return nil, err
+	}
+
+	logger := klog.FromContext(ctx)
+	logger.V(logLevelDebug).Info("Received request to set grade flag", "grade_id", req.GetGradeID(),
+		"flagged", req.GetFlagged())
+
+	view, err := s.gradeView(ctx, claims)
+	if err != nil {
+		return nil, err
+	}
+
+	grade, err := s.db.GetGradeByID(ctx, req.GetGradeID())
+	if err == nil {
+		if err := s.authorizeGrader(ctx, claims, grade.CourseID); err != nil {
+			return nil, err
+		}
+
+		grade, err = s.db.SetGradeFlag(ctx, grade.GradeID, req.GetFlagged())
+	}
+
+	if errors.Is(err, ErrGradeNotFound) {
+		return nil, fmt.Errorf("failed to set grade flag: %w", status.Error(codes.NotFound, err.Error()))
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to set grade flag: %w", err)
+	}
+
+	return &gpb.SetGradeFlagResponse{Grade: s.gradeResponse(grade, view)}, nil
+}
+
+// GetFlaggedGrades returns all grades marked for review in a course for a specific semester.
+func (s *GradesServer) GetFlaggedGrades(ctx context.Context,
+	req *gpb.GetFlaggedGradesRequest,
+) (*gpb.GetFlaggedGradesResponse, error) {
+	claims, err := s.authorizeRoles(ctx, req.GetToken(), roleStaff, roleAdmin)
+	if err != nil {
+		return nil, err
+	}
+
+	logger := klog.FromContext(ctx)
+	logger.V(logLevelDebug).Info("Received request for flagged grades", "course_id", req.GetCourseID(),
+		"semester", req.GetSemester())
+
+	view, err := s.gradeView(ctx, claims)
+	if err != nil {
+		return nil, err
+	}
+
+	grades, err := s.db.GetFlaggedGrades(ctx, req.GetCourseID(), req.GetSemester())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get flagged grades: %w", err)
+	}
+
+	gradesResponse, truncated := s.createGradesResponse(grades, view)
+
+	return &gpb.GetFlaggedGradesResponse{
+		Grades:    gradesResponse,
+		Truncated: truncated,
+	}, nil
+}
+
+// GetMultiCourseGrades returns the grades of several courses for a specific semester, grouped by course.
+func (s *GradesServer) GetMultiCourseGrades(ctx context.Context,
+	req *gpb.GetMultiCourseGradesRequest,
+) (*gpb.GetMultiCourseGradesResponse, error) {
+	claims, err := s.getClaims(ctx, req.GetToken())
+	if err != nil {
+		return nil, fmt.Errorf("authentication failed: %w",
+			status.Error(codes.Unauthenticated, err.Error()))
+	}
+
+	logger := klog.FromContext(ctx)
+	logger.V(logLevelDebug).Info("Received request for multi course grades", "course_ids", req.GetCourseIDs(),
+		"semester", req.GetSemester())
+
+	view, err := s.gradeView(ctx, claims)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.GetAnonymize() {
+		if err := s.requireRole(ctx, req.GetToken(), roleStaff, roleAdmin); err != nil {
+			return nil, err
+		}
+	}
+
+	courseIDs := uniqueInOrder(req.GetCourseIDs())
+
+	groups, err := s.db.GetMultiCourseGrades(ctx, courseIDs, req.GetSemester())
+	if errors.Is(err, ErrCourseIDEmpty) || errors.Is(err, ErrTooManyCourses) {
+		return nil, fmt.Errorf("failed to get multi course grades: %w",
+			status.Error(codes.InvalidArgument, err.Error()))
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get multi course grades: %w", err)
+	}
+
+	// A single anonymizer keeps a student's token the same across all requested courses.
+	var anonymizer *studentAnonymizer
+	if req.GetAnonymize() {
+		if anonymizer, err = newStudentAnonymizer(); err != nil {
+			return nil, err
+		}
+	}
+
+	courses := make([]*gpb.CourseGrades, 0, len(courseIDs))
+	for _, courseID := range courseIDs {
+		gradesResponse, truncated := s.createGradesResponse(groups[courseID], view)
+		if anonymizer != nil {
+			anonymizer.Apply(gradesResponse)
+		}
+
+		courses = append(courses, &gpb.CourseGrades{
+			CourseID:  courseID,
+			Grades:    gradesResponse,
+			Truncated: truncated,
+		})
+	}
+
+	return &gpb.GetMultiCourseGradesResponse{Courses: courses}, nil
+}
+
+// SearchStudentGrades returns the grades of students whose ID starts with the given prefix.
+func (s *GradesServer) SearchStudentGrades(ctx context.Context,
+	req *gpb.SearchStudentGradesRequest,
+) (*gpb.SearchStudentGradesResponse, error) {
+	claims, err := s.authorizeRoles(ctx, req.GetToken(), roleStaff, roleAdmin)
+	if err != nil {
+		return nil, err
+	}
+
+	logger := klog.FromContext(ctx)
+	logger.V(logLevelDebug).Info("Received request to search student grades", "prefix", req.GetPrefix())
+
+	view, err := s.gradeView(ctx, claims)
+	if err != nil {
+		return nil, err
+	}
+
+	// Read one extra row to tell whether the cap cut the results short.
+	grades, err := s.db.SearchStudentGrades(ctx, req.GetPrefix(), maxStudentPrefixResults+1)
+	if errors.Is(err, ErrStudentPrefixTooShort) || errors.Is(err, ErrSearchTermTooLong) {
+		return nil, fmt.Errorf("failed to search student grades: %w",
+			status.Error(codes.InvalidArgument, err.Error()))
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to search student grades: %w", err)
+	}
+
+	truncated := len(grades) > maxStudentPrefixResults
+	if truncated {
+		grades = grades[:maxStudentPrefixResults]
+	}
+
+	gradesResponse, capped := s.createGradesResponse(grades, view)
+
+	return &gpb.SearchStudentGradesResponse{
+		Grades:    gradesResponse,
+		Truncated: truncated || capped,
 	}, nil
 }
 
-func (s *GradesServer) createGradesResponse(grades []*Grade) []*gpb.SingleGrade {
+// RetryFailedEvents re-publishes grade events whose delivery previously failed.
+func (s *GradesServer) RetryFailedEvents(ctx context.Context,
+	req *gpb.RetryFailedEventsRequest,
+) (*gpb.RetryFailedEventsResponse, error) {
+	if err := s.requireRole(ctx, req.GetToken(), roleAdmin); err != nil {
+		return nil, err
+	}
+
+	logger := klog.FromContext(ctx)
+	logger.V(logLevelDebug).Info("Received request to retry failed events")
+
+	delivered, stillFailing, err := s.retryFailedEvents(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retry failed events: %w", err)
+	}
+
+	return &gpb.RetryFailedEventsResponse{
+		DeliveredCount:    int32(delivered),
+		StillFailingCount: int32(stillFailing),
+	}, nil
+}
+
+// uniqueInOrder removes repeated values, keeping the first occurrence of each so responses
+// follow the request order.
+func uniqueInOrder(values []string) []string {
+	unique := make([]string, 0, len(values))
+	seen := make(map[string]bool, len(values))
+
+	for _, value := range values {
+		if !seen[value] {
+			seen[value] = true
+			unique = append(unique, value)
+		}
+	}
+
+	return unique
+}
+
+// createGradesResponse converts grades to their protobuf form as the view shows them, keeping at
+// most maxResultRows of them. It reports true when grades were dropped to respect the limit.
+func (s *GradesServer) createGradesResponse(grades []*Grade, view gradeView) ([]*gpb.SingleGrade, bool) {
+	truncated := false
+	if s.maxResultRows > 0 && len(grades) > s.maxResultRows {
+		grades = grades[:s.maxResultRows]
+		truncated = true
+	}
+
 	gradesResponse := make([]*gpb.SingleGrade, 0, len(grades))
 	for _, grade := range grades {
-		gradesResponse = append(gradesResponse, &gpb.SingleGrade{
-			GradeID:    grade.GradeID,
-			StudentID:  grade.StudentID,
-			CourseID:   grade.CourseID,
-			Semester:   grade.Semester,
-			GradeType:  grade.GradeType,
-			ItemID:     grade.ItemID,
-			GradeValue: grade.GradeValue,
-			GradedBy:   grade.GradedBy,
-			Comments:   grade.Comments,
-		})
+		gradesResponse = append(gradesResponse, s.gradeResponse(grade, view))
 	}
 
-	return gradesResponse
+	return gradesResponse, truncated
+}
+
+// gradeResponse converts a grade for a response as the view shows it, applying the rounding policy.
+func (s *GradesServer) gradeResponse(grade *Grade, view gradeView) *gpb.SingleGrade {
+	resp := gradeToProto(grade)
+	resp.GradeValue = s.rounder.Round(resp.GetGradeValue())
+
+	resp = shapeGradeForRole(resp, view.role)
+	view.embargo.apply(resp)
+
+	if view.locale != "" {
+		resp.DisplayValue = s.labels.display(view.locale, resp.GetGradeValue())
+	}
+
+	return resp
+}
+
+// gradeToProto converts a database grade to its protobuf representation.
+func gradeToProto(grade *Grade) *gpb.SingleGrade {
+	return &gpb.SingleGrade{
+		GradeID:       grade.GradeID,
+		StudentID:     grade.StudentID,
+		CourseID:      grade.CourseID,
+		Semester:      grade.Semester,
+		GradeType:     grade.GradeType,
+		ItemID:        grade.ItemID,
+		GradeValue:    grade.GradeValue,
+		GradedBy:      grade.GradedBy,
+		Comments:      grade.Comments,
+		Version:       grade.Version,
+		Flagged:       grade.Flagged,
+		RubricScores:  grade.RubricScores.toProto(),
+		InstitutionID: grade.InstitutionID,
+		AppealStatus:  grade.AppealStatus,
+		SectionID:     grade.SectionID,
+		SubmittedAt:   gradeTimeToProto(grade.SubmittedAt),
+		DueAt:         gradeTimeToProto(grade.DueAt),
+		GradedAt:      timestampToProto(grade.GradedAt),
+		UpdatedAt:     timestampToProto(grade.UpdatedAt),
+	}
+}
+
+// timestampToProto converts a time to its protobuf representation, nil when unknown.
+func timestampToProto(value time.Time) *timestamppb.Timestamp {
+	if value.IsZero() {
+		return nil
+	}
+
+	return timestamppb.New(value)
 }
 
 // main server function.
@@ -256,6 +1689,26 @@ func main() {
 		klog.Fatalf("Failed to initialize server: %v", err)
 	}
 
+	if interval := envInt("EVENT_RETRY_INTERVAL_SECONDS", 0); server.events != nil && interval > 0 {
+		go server.runFailedEventRetries(context.Background(), time.Duration(interval)*time.Second)
+	}
+
+	if interval := envInt("TOMBSTONE_PURGE_INTERVAL_HOURS", 0); interval > 0 {
+		go server.runTombstonePurges(context.Background(), time.Duration(interval)*time.Hour)
+	}
+
+	if interval := envInt("COURSE_STATS_REFRESH_INTERVAL_SECONDS", 0); interval > 0 {
+		go server.runCourseStatsRefreshes(context.Background(), time.Duration(interval)*time.Second)
+	}
+
+	if interval := envInt("NOTIFICATION_DIGEST_INTERVAL_HOURS", 0); server.digests != nil && interval > 0 {
+		go server.runDigestFlushes(context.Background(), time.Duration(interval)*time.Hour)
+	}
+
+	if server.courseWrites != nil {
+		go server.courseWrites.runCleanups(context.Background(), courseWriteCleanupInterval)
+	}
+
 	// create a listener.
 	address := "localhost:" + os.Getenv("GRPC_PORT")
 
@@ -264,12 +1717,65 @@ func main() {
 		klog.Error("Failed to listen", "error", err)
 	}
 
+	maxRecvMsgSize := envInt("MAX_RECV_MSG_SIZE", defaultMaxRecvMsgSize)
+
 	// create a grpc server.
-	grpcServer := grpc.NewServer()
+	options := []grpc.ServerOption{
+		grpc.MaxRecvMsgSize(maxRecvMsgSize),
+		grpc.ChainUnaryInterceptor(loggingInterceptor(newRequestSampler(envInt("LOG_SAMPLE_RATE", 1)),
+			newSLOMonitor(time.Duration(envInt("SLO_LATENCY_MS", 0))*time.Millisecond)),
+			busyInterceptor(), server.strictInterceptor(), server.trimInterceptor(), server.institutionInterceptor()),
+		grpc.ChainStreamInterceptor(busyStreamInterceptor(), server.strictStreamInterceptor(),
+			server.trimStreamInterceptor(), server.institutionStreamInterceptor()),
+	}
+
+	// Cap the streams of a single client connection, so a few clients cannot hold every resource.
+	if maxStreams := envInt("MAX_CONCURRENT_STREAMS", 0); maxStreams > 0 {
+		options = append(options, grpc.MaxConcurrentStreams(uint32(maxStreams)))
+	}
+
+	// Record the size of requests, logging those close to the limit, to tune the limit and spot abuse.
+	if envBool("PAYLOAD_AUDIT", true) {
+		audit := newPayloadAudit(maxRecvMsgSize, envFloat("PAYLOAD_WARN_RATIO", defaultPayloadWarnRatio))
+		options = append(options, grpc.StatsHandler(audit))
+
+		if interval := envInt("PAYLOAD_SUMMARY_INTERVAL_SECONDS", 0); interval > 0 {
+			go audit.runSummaries(context.Background(), time.Duration(interval)*time.Second)
+		}
+	}
+
+	// Serve the connection pool gauges to Prometheus, so a saturated pool shows before requests fail.
+	if port := os.Getenv("METRICS_PORT"); port != "" {
+		metrics := &metricsRegistry{}
+		metrics.register(server.poolMetrics)
+
+		go server.poolMetrics.run(context.Background(), envDuration("DB_METRICS_INTERVAL", defaultPoolMetricsInterval))
+
+		go serveMetrics(":"+port, metrics)
+	}
+
+	grpcServer := grpc.NewServer(options...)
 	gpb.RegisterGradesServiceServer(grpcServer, server)
 	klog.V(logLevelDebug).Info("Grades server is running on port " + os.Getenv("GRPC_PORT"))
+
+	// Serve returns as soon as shutdown starts, so wait for the in-flight calls to end before exiting.
+	stopped := make(chan struct{})
+
+	go func() {
+		defer close(stopped)
+		server.stopOnSignal(grpcServer, envDuration("SHUTDOWN_DRAIN_TIMEOUT", defaultShutdownDrainTimeout))
+	}()
+
+	// Mark the service ready for file-based probes once it listens, while the database answers.
+	go server.readiness.run(context.Background(), envDuration("READY_CHECK_INTERVAL", defaultReadyCheckInterval))
+
 	// serve the grpc server.
 	if err := grpcServer.Serve(lis); err != nil {
 		log.Fatalf("Failed to serve: %v", err)
 	}
+
+	<-stopped
+
+	// Pending digests are only held in memory, so send them before exiting.
+	server.flushDigests(context.Background())
 }
@@ -4,11 +4,17 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
+	"maps"
 	"net"
 	"os"
 	"os/exec"
+	"slices"
+	"sort"
 	"strings"
 	"sync"
+	"sync/atomic"
+	"syscall"
 	"testing"
 	"time"
 
@@ -18,13 +24,12 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
 	"k8s.io/klog"
 )
 
-// ErrGradeNotFound is returned when a grade cannot be found.
-var ErrGradeNotFound = errors.New("grade not found")
-
 // MockClaims overrides Claims behavior for testing.
 type MockClaims struct {
 	ms.Claims
@@ -40,24 +45,65 @@ func (m MockClaims) GetRole() string {
 	return "test-role"
 }
 
+// RoleClaims grants exactly one role, for testing role-guarded RPCs.
+type RoleClaims struct {
+	ms.Claims
+	role string
+}
+
+// HasRole reports whether the role matches the granted one.
+func (r RoleClaims) HasRole(role string) bool {
+	return role == r.role
+}
+
 // MockDatabase is a mock implementation of the Database interface for testing.
 type MockDatabase struct {
-	grades map[string]*Grade
-	mutex  sync.RWMutex
+	grades          map[string]*Grade
+	archived        map[string]*Grade
+	tombstones      map[string]*Grade
+	history         map[string][]*Grade
+	failedEvents    []*FailedEvent
+	nextEventID     int64
+	audit           []*AuditEntry
+	optedOut        map[string]bool
+	digests         map[string]bool
+	graders         map[string][]string
+	releases        map[institutionRelease]time.Time
+	typeSchemes     map[string]map[string]GradeScheme
+	attemptPolicies map[string]AttemptPolicy
+	statsRefresh    int
+	onDuplicate     DuplicatePolicy
+	newID           func() string
+	mutex           sync.RWMutex
 }
 
 // Verify that MockDatabase implements DBInterface at compile time.
 var _ DBInterface = (*MockDatabase)(nil)
 
 // NewMockDatabase creates a new mock database.
-func NewMockDatabase() *MockDatabase {
+func NewMockDatabase(opts ...DatabaseOption) *MockDatabase {
+	newID := newDatabaseOptions(opts...).newID
+	if newID == nil {
+		newID = uuid.NewString
+	}
+
 	return &MockDatabase{
-		grades: make(map[string]*Grade),
+		grades:          make(map[string]*Grade),
+		archived:        make(map[string]*Grade),
+		tombstones:      make(map[string]*Grade),
+		optedOut:        make(map[string]bool),
+		digests:         make(map[string]bool),
+		graders:         make(map[string][]string),
+		releases:        make(map[institutionRelease]time.Time),
+		typeSchemes:     make(map[string]map[string]GradeScheme),
+		attemptPolicies: make(map[string]AttemptPolicy),
+		history:         make(map[string][]*Grade),
+		newID:           newID,
 	}
 }
 
 // AddGrade adds a grade to the mock database.
-func (m *MockDatabase) AddGrade(_ context.Context, grade *gpb.SingleGrade) (*Grade, error) {
+func (m *MockDatabase) AddGrade(ctx context.Context, grade *gpb.SingleGrade) (*Grade, error) {
 	if grade == nil {
 		return nil, ErrGradeNil
 	}
@@ -73,23 +119,57 @@ func (m *MockDatabase) AddGrade(_ context.Context, grade *gpb.SingleGrade) (*Gra
 	m.mutex.Lock()
 	defer m.mutex.Unlock()
 
+	institutionID := grade.GetInstitutionID()
+	if scoped := institutionFromContext(ctx); scoped != "" {
+		institutionID = scoped
+	}
+
+	if m.onDuplicate.enforced(grade.GetItemID()) {
+		if existing := m.findByNaturalKey(institutionID, grade); existing != nil {
+			if m.onDuplicate == duplicateReject {
+				return nil, ErrGradeAlreadyExists
+			}
+
+			snapshot := *existing
+			m.history[existing.GradeID] = append(m.history[existing.GradeID], &snapshot)
+			m.updateGradeFields(existing, &gpb.SingleGrade{
+				GradeType: grade.GetGradeType(), GradeValue: grade.GetGradeValue(),
+				GradedBy: grade.GetGradedBy(), Comments: grade.GetComments(),
+				RubricScores: grade.GetRubricScores(),
+			})
+			existing.ChangedBy = actorFromContext(ctx)
+			existing.UpdatedAt = time.Now()
+			existing.Version++
+
+			return existing, nil
+		}
+	}
+
 	gradeID := grade.GetGradeID()
 	if gradeID == "" {
-		gradeID = uuid.New().String()
+		gradeID = m.newID()
 	}
 
 	dbGrade := &Grade{
-		GradeID:    gradeID,
-		StudentID:  grade.GetStudentID(),
-		CourseID:   grade.GetCourseID(),
-		Semester:   grade.GetSemester(),
-		GradeType:  grade.GetGradeType(),
-		ItemID:     grade.GetItemID(),
-		GradeValue: grade.GetGradeValue(),
-		GradedBy:   grade.GetGradedBy(),
-		GradedAt:   time.Now(),
-		UpdatedAt:  time.Now(),
-		Comments:   grade.GetComments(),
+		GradeID:       gradeID,
+		StudentID:     grade.GetStudentID(),
+		CourseID:      grade.GetCourseID(),
+		Semester:      grade.GetSemester(),
+		GradeType:     grade.GetGradeType(),
+		ItemID:        grade.GetItemID(),
+		GradeValue:    grade.GetGradeValue(),
+		GradedBy:      grade.GetGradedBy(),
+		GradedAt:      time.Now(),
+		UpdatedAt:     time.Now(),
+		Comments:      grade.GetComments(),
+		Version:       1,
+		NumericValue:  numericGradeValue(grade.GetGradeValue()),
+		RubricScores:  rubricFromProto(grade.GetRubricScores()),
+		InstitutionID: institutionID,
+		SubmittedAt:   gradeTimeFromProto(grade.GetSubmittedAt()),
+		DueAt:         gradeTimeFromProto(grade.GetDueAt()),
+		ChangedBy:     actorFromContext(ctx),
+		SectionID:     grade.GetSectionID(),
 	}
 
 	m.grades[gradeID] = dbGrade
@@ -97,15 +177,58 @@ func (m *MockDatabase) AddGrade(_ context.Context, grade *gpb.SingleGrade) (*Gra
 	return dbGrade, nil
 }
 
+// findByNaturalKey returns the grade of the same institution, student, course, semester and item, if any.
+func (m *MockDatabase) findByNaturalKey(institutionID string, grade *gpb.SingleGrade) *Grade {
+	for _, existing := range m.grades {
+		if existing.InstitutionID == institutionID && existing.StudentID == grade.GetStudentID() &&
+			existing.CourseID == grade.GetCourseID() && existing.Semester == grade.GetSemester() &&
+			existing.ItemID == grade.GetItemID() {
+			return existing
+		}
+	}
+
+	return nil
+}
+
+// AddGrades adds several grades to the mock database, adding none when any of them is invalid.
+func (m *MockDatabase) AddGrades(ctx context.Context, grades []*gpb.SingleGrade) ([]*Grade, error) {
+	for _, grade := range grades {
+		if grade == nil {
+			return nil, ErrGradeNil
+		}
+
+		if grade.GetStudentID() == "" {
+			return nil, ErrStudentIDEmpty
+		}
+
+		if grade.GetCourseID() == "" {
+			return nil, ErrCourseIDEmpty
+		}
+	}
+
+	added := make([]*Grade, 0, len(grades))
+
+	for _, grade := range grades {
+		dbGrade, err := m.AddGrade(ctx, grade)
+		if err != nil {
+			return nil, err
+		}
+
+		added = append(added, dbGrade)
+	}
+
+	return added, nil
+}
+
 // GetCourseGrades gets grades for a course in a specific semester.
-func (m *MockDatabase) GetCourseGrades(_ context.Context, courseID, semester string) ([]*Grade, error) {
+func (m *MockDatabase) GetCourseGrades(ctx context.Context, courseID, semester string) ([]*Grade, error) {
 	m.mutex.RLock()
 	defer m.mutex.RUnlock()
 
 	var result []*Grade
 
 	for _, grade := range m.grades {
-		if grade.CourseID == courseID && grade.Semester == semester {
+		if grade.CourseID == courseID && grade.Semester == semester && inInstitution(ctx, grade) {
 			result = append(result, grade)
 		}
 	}
@@ -113,9 +236,68 @@ func (m *MockDatabase) GetCourseGrades(_ context.Context, courseID, semester str
 	return result, nil
 }
 
+// StreamCourseGrades calls fn with each grade of a course, ordered by grade ID.
+func (m *MockDatabase) StreamCourseGrades(ctx context.Context, courseID, semester string,
+	fn func(*Grade) error,
+) error {
+	if courseID == "" {
+		return ErrCourseIDEmpty
+	}
+
+	grades, err := m.GetCourseGrades(ctx, courseID, semester)
+	if err != nil {
+		return err
+	}
+
+	sort.Slice(grades, func(i, j int) bool { return grades[i].GradeID < grades[j].GradeID })
+
+	for _, grade := range grades {
+		if err := fn(grade); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// StreamSemesterGrades calls fn with each grade of a semester, ordered by course and grade ID.
+func (m *MockDatabase) StreamSemesterGrades(ctx context.Context, semester string, fn func(*Grade) error) error {
+	if semester == "" {
+		return ErrSemesterEmpty
+	}
+
+	m.mutex.RLock()
+
+	var grades []*Grade
+
+	for _, grade := range m.grades {
+		if grade.Semester == semester && inInstitution(ctx, grade) {
+			grades = append(grades, grade)
+		}
+	}
+
+	m.mutex.RUnlock()
+
+	sort.Slice(grades, func(i, j int) bool {
+		if grades[i].CourseID != grades[j].CourseID {
+			return grades[i].CourseID < grades[j].CourseID
+		}
+
+		return grades[i].GradeID < grades[j].GradeID
+	})
+
+	for _, grade := range grades {
+		if err := fn(grade); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // GetStudentCourseGrades gets grades for a student in a course for a specific semester.
 func (m *MockDatabase) GetStudentCourseGrades(
-	_ context.Context,
+	ctx context.Context,
 	courseID, semester, studentID string,
 ) ([]*Grade, error) {
 	m.mutex.RLock()
@@ -124,7 +306,8 @@ func (m *MockDatabase) GetStudentCourseGrades(
 	var result []*Grade
 
 	for _, grade := range m.grades {
-		if grade.CourseID == courseID && grade.Semester == semester && grade.StudentID == studentID {
+		if grade.CourseID == courseID && grade.Semester == semester && grade.StudentID == studentID &&
+			inInstitution(ctx, grade) {
 			result = append(result, grade)
 		}
 	}
@@ -133,7 +316,7 @@ func (m *MockDatabase) GetStudentCourseGrades(
 }
 
 // UpdateGrade updates a grade in the mock database.
-func (m *MockDatabase) UpdateGrade(_ context.Context, grade *gpb.SingleGrade) (*Grade, error) {
+func (m *MockDatabase) UpdateGrade(ctx context.Context, grade *gpb.SingleGrade) (*Grade, error) {
 	if grade == nil {
 		return nil, ErrGradeNil
 	}
@@ -146,13 +329,97 @@ func (m *MockDatabase) UpdateGrade(_ context.Context, grade *gpb.SingleGrade) (*
 	defer m.mutex.Unlock()
 
 	existing, exists := m.grades[grade.GetGradeID()]
-	if !exists {
+	if !exists || !inInstitution(ctx, existing) {
 		return nil, ErrGradeNotFound
 	}
 
+	if existing.AppealStatus == appealUnderAppeal {
+		return nil, ErrGradeUnderAppeal
+	}
+
+	// Keep the previous state as history.
+	snapshot := *existing
+	m.history[existing.GradeID] = append(m.history[existing.GradeID], &snapshot)
+
 	// Update fields if provided
 	m.updateGradeFields(existing, grade)
+	existing.ChangedBy = actorFromContext(ctx)
+	existing.UpdatedAt = time.Now()
+	existing.Version++
+
+	return existing, nil
+}
+
+// UndoGradeChange restores the value a grade had before its latest change in the mock database.
+func (m *MockDatabase) UndoGradeChange(ctx context.Context, gradeID string, version int64) (*Grade, error) {
+	if gradeID == "" {
+		return nil, ErrGradeIDEmpty
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	existing, exists := m.grades[gradeID]
+	if !exists || !inInstitution(ctx, existing) {
+		return nil, ErrGradeNotFound
+	}
+
+	if existing.Version != version {
+		return nil, ErrGradeVersionConflict
+	}
+
+	if existing.AppealStatus == appealUnderAppeal {
+		return nil, ErrGradeUnderAppeal
+	}
+
+	history := m.history[gradeID]
+	if len(history) == 0 {
+		return nil, ErrNoPriorGradeValue
+	}
+
+	previous := history[len(history)-1]
+	snapshot := *existing
+	m.history[gradeID] = append(history, &snapshot)
+
+	existing.GradeValue = previous.GradeValue
+	existing.NumericValue = numericGradeValue(previous.GradeValue)
+	existing.ChangedBy = actorFromContext(ctx)
 	existing.UpdatedAt = time.Now()
+	existing.Version++
+
+	return existing, nil
+}
+
+// TransitionAppeal moves the appeal of a grade from one status to another in the mock database.
+func (m *MockDatabase) TransitionAppeal(ctx context.Context, gradeID, from, to, value string) (*Grade, error) {
+	if gradeID == "" {
+		return nil, ErrGradeIDEmpty
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	existing, exists := m.grades[gradeID]
+	if !exists || !inInstitution(ctx, existing) {
+		return nil, ErrGradeNotFound
+	}
+
+	if existing.AppealStatus != from {
+		return nil, fmt.Errorf("%w: grade is %s", ErrAppealTransition, appealStatusName(existing.AppealStatus))
+	}
+
+	if value != "" {
+		snapshot := *existing
+		m.history[gradeID] = append(m.history[gradeID], &snapshot)
+
+		existing.GradeValue = value
+		existing.NumericValue = numericGradeValue(value)
+		existing.ChangedBy = actorFromContext(ctx)
+		existing.UpdatedAt = time.Now()
+		existing.Version++
+	}
+
+	existing.AppealStatus = to
 
 	return existing, nil
 }
@@ -181,6 +448,7 @@ func (m *MockDatabase) updateGradeFields(existing *Grade, grade *gpb.SingleGrade
 
 	if grade.GetGradeValue() != "" {
 		existing.GradeValue = grade.GetGradeValue()
+		existing.NumericValue = numericGradeValue(grade.GetGradeValue())
 	}
 
 	if grade.GetGradedBy() != "" {
@@ -190,10 +458,26 @@ func (m *MockDatabase) updateGradeFields(existing *Grade, grade *gpb.SingleGrade
 	if grade.GetComments() != "" {
 		existing.Comments = grade.GetComments()
 	}
+
+	if len(grade.GetRubricScores()) > 0 {
+		existing.RubricScores = rubricFromProto(grade.GetRubricScores())
+	}
+
+	if grade.GetSubmittedAt() != "" {
+		existing.SubmittedAt = gradeTimeFromProto(grade.GetSubmittedAt())
+	}
+
+	if grade.GetDueAt() != "" {
+		existing.DueAt = gradeTimeFromProto(grade.GetDueAt())
+	}
+
+	if grade.GetSectionID() != "" {
+		existing.SectionID = grade.GetSectionID()
+	}
 }
 
 // RemoveGrade removes a grade from the mock database.
-func (m *MockDatabase) RemoveGrade(_ context.Context, gradeID string) error {
+func (m *MockDatabase) RemoveGrade(ctx context.Context, gradeID string) error {
 	if gradeID == "" {
 		return ErrGradeIDEmpty
 	}
@@ -201,148 +485,1760 @@ func (m *MockDatabase) RemoveGrade(_ context.Context, gradeID string) error {
 	m.mutex.Lock()
 	defer m.mutex.Unlock()
 
-	if _, exists := m.grades[gradeID]; !exists {
+	grade, exists := m.grades[gradeID]
+	if !exists || !inInstitution(ctx, grade) {
 		return ErrGradeNotFound
 	}
 
+	if grade.AppealStatus == appealUnderAppeal {
+		return ErrGradeUnderAppeal
+	}
+
+	grade.DeletedAt = time.Now()
+	m.tombstones[gradeID] = grade
 	delete(m.grades, gradeID)
 
+	m.audit = append(m.audit, &AuditEntry{
+		AuditID: int64(len(m.audit) + 1), GradeID: gradeID, Action: auditActionDelete,
+		Actor: actorFromContext(ctx), CreatedAt: grade.DeletedAt,
+	})
+
 	return nil
 }
 
-// GetStudentSemesterGrades gets all grades for a student in a specific semester.
-func (m *MockDatabase) GetStudentSemesterGrades(_ context.Context, studentID, semester string) ([]*Grade, error) {
-	m.mutex.RLock()
-	defer m.mutex.RUnlock()
+// PurgeTombstones permanently deletes the grades soft-deleted before cutoff.
+func (m *MockDatabase) PurgeTombstones(ctx context.Context, cutoff time.Time) (int64, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
 
-	var result []*Grade
+	var purged int64
 
-	for _, grade := range m.grades {
-		if grade.StudentID == studentID && grade.Semester == semester {
-			result = append(result, grade)
+	for gradeID, grade := range m.tombstones {
+		if grade.DeletedAt.Before(cutoff) && inInstitution(ctx, grade) {
+			delete(m.tombstones, gradeID)
+
+			purged++
 		}
 	}
 
-	return result, nil
+	return purged, nil
 }
 
-// TestGradesServer wraps GradesServer for testing.
-type TestGradesServer struct {
-	*GradesServer
-}
+// SoftDeleteStudentGrades soft-deletes every grade of a student, recording an audit entry for each.
+func (m *MockDatabase) SoftDeleteStudentGrades(ctx context.Context, studentID, actor string) ([]string, error) {
+	if studentID == "" {
+		return nil, ErrStudentIDEmpty
+	}
 
-func TestMain(m *testing.M) {
-	// Load .env file.
-	cmd := exec.Command("cat", "../.env")
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
 
-	output, err := cmd.Output()
-	if err != nil {
-		panic("Error reading .env file: " + err.Error())
-	}
+	var gradeIDs []string
 
-	// Set environment variables.
-	for _, line := range strings.Split(string(output), "\n") {
-		if line = strings.TrimSpace(line); line != "" && !strings.HasPrefix(line, "#") {
-			parts := strings.SplitN(line, "=", 2)
-			if len(parts) == 2 {
-				// Remove quotes from the value if they exist.
-				value := strings.Trim(parts[1], `"'`)
-				os.Setenv(parts[0], value)
-			}
+	for gradeID, grade := range m.grades {
+		if grade.StudentID == studentID && inInstitution(ctx, grade) {
+			gradeIDs = append(gradeIDs, gradeID)
 		}
 	}
 
-	// Set a mock DSN to avoid connecting to real database
-	os.Setenv("DSN", "mock_dsn")
+	slices.Sort(gradeIDs)
 
-	// Run tests and capture the result.
-	result := m.Run()
+	now := time.Now()
 
-	// Print custom summary.
-	if result == 0 {
-		klog.Info("\n\n [Summary] All tests passed.")
-	} else {
-		klog.Errorf("\n\n [Summary] Some tests failed. number of tests that failed: %d", result)
+	for _, gradeID := range gradeIDs {
+		grade := m.grades[gradeID]
+		grade.DeletedAt = now
+		m.tombstones[gradeID] = grade
+		delete(m.grades, gradeID)
+
+		m.audit = append(m.audit, &AuditEntry{
+			AuditID: int64(len(m.audit) + 1), GradeID: gradeID, Action: auditActionDelete, Actor: actor, CreatedAt: now,
+		})
 	}
 
-	// Exit with the test result code.
-	os.Exit(result)
+	return gradeIDs, nil
 }
 
-func createTestGrade() *gpb.SingleGrade {
-	return &gpb.SingleGrade{
-		GradeID:    uuid.New().String(),
-		StudentID:  uuid.New().String(),
-		CourseID:   uuid.New().String(),
-		Semester:   "Winter_2023",
-		GradeType:  "Exam",
-		ItemID:     uuid.New().String(),
-		GradeValue: "A",
-		GradedBy:   "Professor X",
-		Comments:   "Excellent work!",
+// GetStudentSemesterGrades gets all grades for a student in a specific semester.
+func (m *MockDatabase) GetStudentSemesterGrades(ctx context.Context, studentID, semester string) ([]*Grade, error) {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	var result []*Grade
+
+	for _, grade := range m.grades {
+		if grade.StudentID == studentID && grade.Semester == semester && inInstitution(ctx, grade) {
+			result = append(result, grade)
+		}
 	}
+
+	return result, nil
 }
 
-func startTestServer() (*grpc.Server, net.Listener, *TestGradesServer, error) {
-	// Create a base server
-	base, err := ms.CreateBaseServiceServer()
-	if err != nil {
-		return nil, nil, nil, fmt.Errorf("failed to create base service: %w", err)
+// GetStudentGrades gets every grade of a student.
+func (m *MockDatabase) GetStudentGrades(ctx context.Context, studentID string) ([]*Grade, error) {
+	if studentID == "" {
+		return nil, ErrStudentIDEmpty
 	}
 
-	// Use mock database instead of real one
-	mockDB := NewMockDatabase()
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
 
-	// Create the grades server with mock database
-	server := &GradesServer{
-		BaseServiceServer:                base,
-		UnimplementedGradesServiceServer: gpb.UnimplementedGradesServiceServer{},
-		db:                               mockDB,
-		Claims:                           MockClaims{},
+	var result []*Grade
+
+	for _, grade := range m.grades {
+		if grade.StudentID == studentID && inInstitution(ctx, grade) {
+			result = append(result, grade)
+		}
 	}
 
-	testServer := &TestGradesServer{GradesServer: server}
-	grpcServer := grpc.NewServer()
-	gpb.RegisterGradesServiceServer(grpcServer, testServer)
+	return result, nil
+}
 
-	listener, err := net.Listen(connectionProtocol, "localhost:0") // Use port 0 to get a random available port
+// GetStudentGradesGrouped gets every grade of a student, grouped by semester and course.
+func (m *MockDatabase) GetStudentGradesGrouped(ctx context.Context,
+	studentID string,
+) (map[string]map[string][]*Grade, error) {
+	grades, err := m.GetStudentGrades(ctx, studentID)
 	if err != nil {
-		return nil, nil, nil, fmt.Errorf("failed to listen: %w", err)
+		return nil, err
 	}
 
-	go func() {
-		if err := grpcServer.Serve(listener); err != nil {
-			panic("Failed to serve: " + err.Error())
+	return groupBySemesterAndCourse(grades), nil
+}
+
+// ArchiveSemester moves a semester's grades to the mock archive.
+func (m *MockDatabase) ArchiveSemester(ctx context.Context, semester string) (int64, error) {
+	if semester == "" {
+		return 0, ErrSemesterEmpty
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	var archived int64
+
+	for gradeID, grade := range m.grades {
+		if grade.Semester == semester && inInstitution(ctx, grade) {
+			m.archived[gradeID] = grade
+			delete(m.grades, gradeID)
+			archived++
 		}
-	}()
+	}
 
-	return grpcServer, listener, testServer, nil
+	return archived, nil
 }
 
-func setupClient(t *testing.T) gpb.GradesServiceClient {
-	t.Helper()
+// GetArchivedCourseGrades gets archived grades for a course in a specific semester.
+func (m *MockDatabase) GetArchivedCourseGrades(ctx context.Context, courseID, semester string) ([]*Grade, error) {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
 
-	grpcServer, listener, _, err := startTestServer()
-	require.NoError(t, err)
-	t.Cleanup(func() {
-		grpcServer.Stop()
-	})
+	var result []*Grade
 
-	// Using grpc.Dial is deprecated but keeping for now as it's a test
-	// #nosec G402 -- This is a test and we're using insecure credentials intentionally
-	// TODO: Update to use grpc.NewClient in the future
-	conn, err := grpc.NewClient(listener.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
-	require.NoError(t, err)
-	t.Cleanup(func() {
-		conn.Close()
-	})
+	for _, grade := range m.archived {
+		if grade.CourseID == courseID && grade.Semester == semester && inInstitution(ctx, grade) {
+			result = append(result, grade)
+		}
+	}
 
-	return gpb.NewGradesServiceClient(conn)
+	return result, nil
 }
 
-func TestGetCourseGrades(t *testing.T) {
-	client := setupClient(t)
-	grade := createTestGrade()
+// GetGradeByID gets the latest state of a grade.
+func (m *MockDatabase) GetGradeByID(ctx context.Context, gradeID string) (*Grade, error) {
+	if gradeID == "" {
+		return nil, ErrGradeIDEmpty
+	}
+
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	grade, exists := m.grades[gradeID]
+	if !exists || !inInstitution(ctx, grade) {
+		return nil, ErrGradeNotFound
+	}
+
+	return grade, nil
+}
+
+// GetGradeValue gets only the value of a grade, by ID or by natural key.
+func (m *MockDatabase) GetGradeValue(ctx context.Context, key GradeKey) (string, error) {
+	if key.GradeID != "" {
+		grade, err := m.GetGradeByID(ctx, key.GradeID)
+		if err != nil {
+			return "", err
+		}
+
+		return grade.GradeValue, nil
+	}
+
+	if key.StudentID == "" {
+		return "", ErrStudentIDEmpty
+	}
+
+	if key.CourseID == "" {
+		return "", ErrCourseIDEmpty
+	}
+
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	var latest *Grade
+
+	for _, grade := range m.grades {
+		if grade.StudentID == key.StudentID && grade.CourseID == key.CourseID && grade.Semester == key.Semester &&
+			grade.ItemID == key.ItemID && inInstitution(ctx, grade) &&
+			(latest == nil || grade.UpdatedAt.After(latest.UpdatedAt)) {
+			latest = grade
+		}
+	}
+
+	if latest == nil {
+		return "", ErrGradeNotFound
+	}
+
+	return latest.GradeValue, nil
+}
+
+// GetGradeVersion gets a grade as it was at the given version.
+func (m *MockDatabase) GetGradeVersion(ctx context.Context, gradeID string, version int64) (*Grade, error) {
+	grade, err := m.GetGradeByID(ctx, gradeID)
+	if err != nil {
+		return nil, err
+	}
+
+	if grade.Version == version {
+		return grade, nil
+	}
+
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	for _, snapshot := range m.history[gradeID] {
+		if snapshot.Version == version {
+			return snapshot, nil
+		}
+	}
+
+	return nil, ErrGradeVersionNotFound
+}
+
+// SearchGradeComments gets grades of a course whose comments contain the term, ignoring case.
+func (m *MockDatabase) SearchGradeComments(ctx context.Context, courseID, semester, term string) ([]*Grade, error) {
+	if err := validateSearchTerm(term); err != nil {
+		return nil, err
+	}
+
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	var result []*Grade
+
+	for _, grade := range m.grades {
+		if grade.CourseID == courseID && grade.Semester == semester && inInstitution(ctx, grade) &&
+			strings.Contains(strings.ToLower(grade.Comments), strings.ToLower(term)) {
+			result = append(result, grade)
+		}
+	}
+
+	return result, nil
+}
+
+// GetCourseGradesETag computes the ETag of a course's grades.
+func (m *MockDatabase) GetCourseGradesETag(ctx context.Context, courseID, semester string) (string, error) {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	var (
+		count       int64
+		lastUpdated time.Time
+	)
+
+	for _, grade := range m.grades {
+		if grade.CourseID == courseID && grade.Semester == semester && inInstitution(ctx, grade) {
+			count++
+
+			if grade.UpdatedAt.After(lastUpdated) {
+				lastUpdated = grade.UpdatedAt
+			}
+		}
+	}
+
+	return courseETag(count, lastUpdated), nil
+}
+
+// SetGradeFlag marks or unmarks a grade for review.
+func (m *MockDatabase) SetGradeFlag(ctx context.Context, gradeID string, flagged bool) (*Grade, error) {
+	if gradeID == "" {
+		return nil, ErrGradeIDEmpty
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	grade, exists := m.grades[gradeID]
+	if !exists || !inInstitution(ctx, grade) {
+		return nil, ErrGradeNotFound
+	}
+
+	grade.Flagged = flagged
+
+	return grade, nil
+}
+
+// GetFlaggedGrades gets the grades marked for review in a course for a specific semester.
+func (m *MockDatabase) GetFlaggedGrades(ctx context.Context, courseID, semester string) ([]*Grade, error) {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	var result []*Grade
+
+	for _, grade := range m.grades {
+		if grade.CourseID == courseID && grade.Semester == semester && grade.Flagged && inInstitution(ctx, grade) {
+			result = append(result, grade)
+		}
+	}
+
+	return result, nil
+}
+
+// GetMultiCourseGrades gets the grades of several courses for a specific semester, grouped by course.
+func (m *MockDatabase) GetMultiCourseGrades(
+	ctx context.Context,
+	courseIDs []string, semester string,
+) (map[string][]*Grade, error) {
+	if err := validateCourseIDs(courseIDs); err != nil {
+		return nil, err
+	}
+
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	requested := make(map[string]bool, len(courseIDs))
+	for _, courseID := range courseIDs {
+		requested[courseID] = true
+	}
+
+	var result []*Grade
+
+	for _, grade := range m.grades {
+		if requested[grade.CourseID] && grade.Semester == semester && inInstitution(ctx, grade) {
+			result = append(result, grade)
+		}
+	}
+
+	return groupGrades(courseIDs, result, func(grade *Grade) string { return grade.CourseID }), nil
+}
+
+// GetCourseGradesBySemester gets the grades of a course across several semesters, grouped by semester.
+func (m *MockDatabase) GetCourseGradesBySemester(
+	ctx context.Context,
+	courseID string, semesters []string,
+) (map[string][]*Grade, error) {
+	if courseID == "" {
+		return nil, ErrCourseIDEmpty
+	}
+
+	if err := validateSemesters(semesters); err != nil {
+		return nil, err
+	}
+
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	var result []*Grade
+
+	for _, grade := range m.grades {
+		if grade.CourseID == courseID && slices.Contains(semesters, grade.Semester) && inInstitution(ctx, grade) {
+			result = append(result, grade)
+		}
+	}
+
+	return groupGrades(semesters, result, func(grade *Grade) string { return grade.Semester }), nil
+}
+
+// GetStudentGradesBySemester gets the grades of a student across several semesters, grouped by semester.
+func (m *MockDatabase) GetStudentGradesBySemester(
+	ctx context.Context,
+	studentID string, semesters []string,
+) (map[string][]*Grade, error) {
+	if studentID == "" {
+		return nil, ErrStudentIDEmpty
+	}
+
+	if err := validateSemesters(semesters); err != nil {
+		return nil, err
+	}
+
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	var result []*Grade
+
+	for _, grade := range m.grades {
+		if grade.StudentID == studentID && slices.Contains(semesters, grade.Semester) && inInstitution(ctx, grade) {
+			result = append(result, grade)
+		}
+	}
+
+	return groupGrades(semesters, result, func(grade *Grade) string { return grade.Semester }), nil
+}
+
+// SearchStudentGrades gets the grades of students whose ID starts with the prefix, ordered by student ID.
+func (m *MockDatabase) SearchStudentGrades(ctx context.Context, prefix string, limit int) ([]*Grade, error) {
+	if err := validateStudentPrefix(prefix); err != nil {
+		return nil, err
+	}
+
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	var result []*Grade
+
+	for _, grade := range m.grades {
+		if strings.HasPrefix(grade.StudentID, prefix) && inInstitution(ctx, grade) {
+			result = append(result, grade)
+		}
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].StudentID != result[j].StudentID {
+			return result[i].StudentID < result[j].StudentID
+		}
+
+		return result[i].GradeID < result[j].GradeID
+	})
+
+	if len(result) > limit {
+		result = result[:limit]
+	}
+
+	return result, nil
+}
+
+// RecordFailedEvent stores a failed event in the mock dead-letter store.
+func (m *MockDatabase) RecordFailedEvent(_ context.Context, event *FailedEvent) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	m.nextEventID++
+	event.EventID = m.nextEventID
+	event.Attempts = 1
+	event.CreatedAt = time.Now()
+	event.LastAttemptAt = event.CreatedAt
+	m.failedEvents = append(m.failedEvents, event)
+
+	return nil
+}
+
+// GetFailedEvents gets up to limit failed events, oldest first.
+func (m *MockDatabase) GetFailedEvents(_ context.Context, limit int) ([]*FailedEvent, error) {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	events := m.failedEvents
+	if len(events) > limit {
+		events = events[:limit]
+	}
+
+	return append([]*FailedEvent(nil), events...), nil
+}
+
+// RecordFailedEventAttempt records another failed delivery attempt.
+func (m *MockDatabase) RecordFailedEventAttempt(_ context.Context, eventID int64, lastError string) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	for _, event := range m.failedEvents {
+		if event.EventID == eventID {
+			event.Attempts++
+			event.LastError = lastError
+			event.LastAttemptAt = time.Now()
+		}
+	}
+
+	return nil
+}
+
+// RemoveFailedEvent removes a failed event from the mock dead-letter store.
+func (m *MockDatabase) RemoveFailedEvent(_ context.Context, eventID int64) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	for i, event := range m.failedEvents {
+		if event.EventID == eventID {
+			m.failedEvents = append(m.failedEvents[:i], m.failedEvents[i+1:]...)
+
+			break
+		}
+	}
+
+	return nil
+}
+
+// GetGradeVersions gets every version of a grade, oldest first, ending with its latest state, also
+// for removed grades.
+func (m *MockDatabase) GetGradeVersions(ctx context.Context, gradeID string) ([]*Grade, error) {
+	if gradeID == "" {
+		return nil, ErrGradeIDEmpty
+	}
+
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	grade, exists := m.grades[gradeID]
+	if !exists {
+		grade, exists = m.tombstones[gradeID]
+	}
+
+	if !exists || !inInstitution(ctx, grade) {
+		return nil, ErrGradeNotFound
+	}
+
+	return append(append([]*Grade(nil), m.history[gradeID]...), grade), nil
+}
+
+// GetCourseGradeVersions gets every version of the grades of a course, keyed by grade ID.
+func (m *MockDatabase) GetCourseGradeVersions(ctx context.Context,
+	courseID, semester string,
+) (map[string][]*Grade, error) {
+	grades, err := m.GetCourseGrades(ctx, courseID, semester)
+	if err != nil {
+		return nil, err
+	}
+
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	versions := make(map[string][]*Grade, len(grades))
+	for _, grade := range grades {
+		versions[grade.GradeID] = append(append([]*Grade(nil), m.history[grade.GradeID]...), grade)
+	}
+
+	return versions, nil
+}
+
+// RecordAudit stores an audit log entry.
+func (m *MockDatabase) RecordAudit(_ context.Context, entry *AuditEntry) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	entry.AuditID = int64(len(m.audit) + 1)
+	entry.CreatedAt = time.Now()
+	m.audit = append(m.audit, entry)
+
+	return nil
+}
+
+// GetGradeAudit gets the audit log entries of a grade, oldest first.
+func (m *MockDatabase) GetGradeAudit(_ context.Context, gradeID string) ([]*AuditEntry, error) {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	var entries []*AuditEntry
+
+	for _, entry := range m.audit {
+		if entry.GradeID == gradeID {
+			entries = append(entries, entry)
+		}
+	}
+
+	return entries, nil
+}
+
+// GetSemesterGradeTypeSummary groups the grades of a semester by grade type.
+func (m *MockDatabase) GetSemesterGradeTypeSummary(ctx context.Context,
+	semester string,
+) ([]*GradeTypeSummary, error) {
+	if semester == "" {
+		return nil, ErrSemesterEmpty
+	}
+
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	summaries := make(map[string]*GradeTypeSummary)
+	sums := make(map[string]float64)
+
+	for _, grade := range m.grades {
+		if grade.Semester != semester || !inInstitution(ctx, grade) {
+			continue
+		}
+
+		summary, ok := summaries[grade.GradeType]
+		if !ok {
+			summary = &GradeTypeSummary{GradeType: grade.GradeType}
+			summaries[grade.GradeType] = summary
+		}
+
+		summary.Count++
+
+		if grade.NumericValue != nil {
+			summary.NumericCount++
+			sums[grade.GradeType] += *grade.NumericValue
+		}
+	}
+
+	result := make([]*GradeTypeSummary, 0, len(summaries))
+
+	for gradeType, summary := range summaries {
+		if summary.NumericCount > 0 {
+			average := sums[gradeType] / float64(summary.NumericCount)
+			summary.Average = &average
+		}
+
+		result = append(result, summary)
+	}
+
+	sort.Slice(result, func(i, j int) bool { return result[i].GradeType < result[j].GradeType })
+
+	return result, nil
+}
+
+// SetNotificationOptOut records whether a student opted out of notifications.
+func (m *MockDatabase) SetNotificationOptOut(ctx context.Context, studentID string, optOut bool) error {
+	if studentID == "" {
+		return ErrStudentIDEmpty
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	m.optedOut[tenantKey(ctx, studentID)] = optOut
+
+	return nil
+}
+
+// IsNotificationOptedOut reports whether a student opted out of notifications.
+func (m *MockDatabase) IsNotificationOptedOut(ctx context.Context, studentID string) (bool, error) {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	return m.optedOut[tenantKey(ctx, studentID)], nil
+}
+
+// institutionRelease keys the release time of a course offering within an institution.
+type institutionRelease struct {
+	institutionID string
+	courseSemester
+}
+
+// tenantKey keys a per-course or per-student setting within the institution of the context.
+func tenantKey(ctx context.Context, id string) string {
+	return institutionFromContext(ctx) + "/" + id
+}
+
+// SetNotificationDigest records whether a student gets a notification digest.
+func (m *MockDatabase) SetNotificationDigest(ctx context.Context, studentID string, digest bool) error {
+	if studentID == "" {
+		return ErrStudentIDEmpty
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	m.digests[tenantKey(ctx, studentID)] = digest
+
+	return nil
+}
+
+// IsNotificationDigestEnabled reports whether a student gets a notification digest.
+func (m *MockDatabase) IsNotificationDigestEnabled(ctx context.Context, studentID string) (bool, error) {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	return m.digests[tenantKey(ctx, studentID)], nil
+}
+
+// RefreshCourseStats counts the refreshes of the course statistics.
+func (m *MockDatabase) RefreshCourseStats(_ context.Context) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	m.statsRefresh++
+
+	return nil
+}
+
+// GetCourseStats computes the statistics of a course in a semester from its grades.
+func (m *MockDatabase) GetCourseStats(ctx context.Context,
+	courseID, semester string, _ time.Duration,
+) (*CourseStats, error) {
+	if courseID == "" {
+		return nil, ErrCourseIDEmpty
+	}
+
+	grades, err := m.GetCourseGrades(ctx, courseID, semester)
+	if err != nil {
+		return nil, err
+	}
+
+	stats := &CourseStats{CourseID: courseID, Semester: semester, RefreshedAt: time.Now(), Live: true}
+
+	var sum float64
+
+	for _, grade := range grades {
+		stats.Count++
+
+		if grade.NumericValue == nil {
+			continue
+		}
+
+		value := *grade.NumericValue
+		stats.NumericCount++
+		sum += value
+
+		if stats.Minimum == nil || value < *stats.Minimum {
+			stats.Minimum = &value
+		}
+
+		if stats.Maximum == nil || value > *stats.Maximum {
+			stats.Maximum = &value
+		}
+	}
+
+	if stats.NumericCount > 0 {
+		average := sum / float64(stats.NumericCount)
+		stats.Average = &average
+	}
+
+	return stats, nil
+}
+
+// AddCourseGrader allows a grader to grade a course.
+func (m *MockDatabase) AddCourseGrader(ctx context.Context, courseID, graderID string) error {
+	if err := validateCourseGrader(courseID, graderID); err != nil {
+		return err
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	key := tenantKey(ctx, courseID)
+	if !slices.Contains(m.graders[key], graderID) {
+		m.graders[key] = append(m.graders[key], graderID)
+		slices.Sort(m.graders[key])
+	}
+
+	return nil
+}
+
+// RemoveCourseGrader removes a grader from the graders of a course.
+func (m *MockDatabase) RemoveCourseGrader(ctx context.Context, courseID, graderID string) error {
+	if err := validateCourseGrader(courseID, graderID); err != nil {
+		return err
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	key := tenantKey(ctx, courseID)
+	m.graders[key] = slices.DeleteFunc(m.graders[key], func(id string) bool { return id == graderID })
+
+	return nil
+}
+
+// GetCourseGraders gets the graders allowed to grade a course.
+func (m *MockDatabase) GetCourseGraders(ctx context.Context, courseID string) ([]string, error) {
+	if courseID == "" {
+		return nil, ErrCourseIDEmpty
+	}
+
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	return slices.Clone(m.graders[tenantKey(ctx, courseID)]), nil
+}
+
+// SetCourseRelease sets or clears the release time of a course offering.
+func (m *MockDatabase) SetCourseRelease(ctx context.Context, courseID, semester string, releaseAt time.Time) error {
+	switch {
+	case courseID == "":
+		return ErrCourseIDEmpty
+	case semester == "":
+		return ErrSemesterEmpty
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	key := institutionRelease{institutionFromContext(ctx), courseSemester{courseID, semester}}
+	if releaseAt.IsZero() {
+		delete(m.releases, key)
+	} else {
+		m.releases[key] = releaseAt
+	}
+
+	return nil
+}
+
+// GetPendingReleases gets the course offerings released after now.
+func (m *MockDatabase) GetPendingReleases(ctx context.Context, now time.Time) ([]*CourseRelease, error) {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	var releases []*CourseRelease
+
+	for key, releaseAt := range m.releases {
+		if key.institutionID == institutionFromContext(ctx) && releaseAt.After(now) {
+			releases = append(releases, &CourseRelease{
+				InstitutionID: key.institutionID, CourseID: key.courseID, Semester: key.semester, ReleaseAt: releaseAt,
+			})
+		}
+	}
+
+	return releases, nil
+}
+
+// SetGradeTypeScheme sets or removes the grading scheme of a grade type in a course.
+func (m *MockDatabase) SetGradeTypeScheme(ctx context.Context, courseID, gradeType string, scheme GradeScheme) error {
+	switch {
+	case courseID == "":
+		return ErrCourseIDEmpty
+	case gradeType == "":
+		return ErrGradeTypeEmpty
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	key := tenantKey(ctx, courseID)
+	if scheme == "" {
+		delete(m.typeSchemes[key], gradeType)
+
+		return nil
+	}
+
+	if m.typeSchemes[key] == nil {
+		m.typeSchemes[key] = make(map[string]GradeScheme)
+	}
+
+	m.typeSchemes[key][gradeType] = scheme
+
+	return nil
+}
+
+// GetGradeTypeSchemes gets the grading schemes of a course's grade types.
+func (m *MockDatabase) GetGradeTypeSchemes(ctx context.Context, courseID string) (map[string]GradeScheme, error) {
+	if courseID == "" {
+		return nil, ErrCourseIDEmpty
+	}
+
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	return maps.Clone(m.typeSchemes[tenantKey(ctx, courseID)]), nil
+}
+
+// SetAttemptPolicy sets or removes the attempt policy of a course.
+func (m *MockDatabase) SetAttemptPolicy(ctx context.Context, courseID string, policy AttemptPolicy) error {
+	if courseID == "" {
+		return ErrCourseIDEmpty
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if policy == "" {
+		delete(m.attemptPolicies, tenantKey(ctx, courseID))
+
+		return nil
+	}
+
+	m.attemptPolicies[tenantKey(ctx, courseID)] = policy
+
+	return nil
+}
+
+// GetAttemptPolicy gets the attempt policy of a course.
+func (m *MockDatabase) GetAttemptPolicy(ctx context.Context, courseID string) (AttemptPolicy, error) {
+	if courseID == "" {
+		return "", ErrCourseIDEmpty
+	}
+
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	return m.attemptPolicies[tenantKey(ctx, courseID)], nil
+}
+
+// inInstitution reports whether a grade is visible to the institution the context is scoped to.
+func inInstitution(ctx context.Context, grade *Grade) bool {
+	institutionID := institutionFromContext(ctx)
+
+	return institutionID == "" || grade.InstitutionID == institutionID
+}
+
+// UnreachableDatabase wraps MockDatabase and fails course reads with a connection error when down.
+type UnreachableDatabase struct {
+	*MockDatabase
+	down atomic.Bool
+}
+
+func (u *UnreachableDatabase) connectionError() error {
+	return &net.OpError{Op: "dial", Net: connectionProtocol, Err: syscall.ECONNREFUSED}
+}
+
+// GetCourseGrades fails while the database is down.
+func (u *UnreachableDatabase) GetCourseGrades(ctx context.Context, courseID, semester string) ([]*Grade, error) {
+	if u.down.Load() {
+		return nil, u.connectionError()
+	}
+
+	return u.MockDatabase.GetCourseGrades(ctx, courseID, semester)
+}
+
+// GetCourseGradesETag fails while the database is down.
+func (u *UnreachableDatabase) GetCourseGradesETag(ctx context.Context, courseID, semester string) (string, error) {
+	if u.down.Load() {
+		return "", u.connectionError()
+	}
+
+	return u.MockDatabase.GetCourseGradesETag(ctx, courseID, semester)
+}
+
+// GetCourseGradesBySemester fails while the database is down.
+func (u *UnreachableDatabase) GetCourseGradesBySemester(ctx context.Context,
+	courseID string, semesters []string,
+) (map[string][]*Grade, error) {
+	if u.down.Load() {
+		return nil, u.connectionError()
+	}
+
+	return u.MockDatabase.GetCourseGradesBySemester(ctx, courseID, semesters)
+}
+
+// MockCourseResolver resolves courses from a fixed catalog and records each lookup.
+type MockCourseResolver struct {
+	catalog map[string]*CourseInfo
+	err     error
+	calls   [][]string
+}
+
+// GetCourses returns the known courses among the requested IDs.
+func (r *MockCourseResolver) GetCourses(_ context.Context, courseIDs []string) (map[string]*CourseInfo, error) {
+	r.calls = append(r.calls, courseIDs)
+
+	if r.err != nil {
+		return nil, r.err
+	}
+
+	result := make(map[string]*CourseInfo)
+
+	for _, courseID := range courseIDs {
+		if course, ok := r.catalog[courseID]; ok {
+			result[courseID] = course
+		}
+	}
+
+	return result, nil
+}
+
+// TestGradesServer wraps GradesServer for testing.
+type TestGradesServer struct {
+	*GradesServer
+}
+
+func TestMain(m *testing.M) {
+	// Load .env file.
+	cmd := exec.Command("cat", "../.env")
+
+	output, err := cmd.Output()
+	if err != nil {
+		panic("Error reading .env file: " + err.Error())
+	}
+
+	// Set environment variables.
+	for _, line := range strings.Split(string(output), "\n") {
+		if line = strings.TrimSpace(line); line != "" && !strings.HasPrefix(line, "#") {
+			parts := strings.SplitN(line, "=", 2)
+			if len(parts) == 2 {
+				// Remove quotes from the value if they exist.
+				value := strings.Trim(parts[1], `"'`)
+				os.Setenv(parts[0], value)
+			}
+		}
+	}
+
+	// Set a mock DSN to avoid connecting to real database
+	os.Setenv("DSN", "mock_dsn")
+
+	// Run tests and capture the result.
+	result := m.Run()
+
+	// Print custom summary.
+	if result == 0 {
+		klog.Info("\n\n [Summary] All tests passed.")
+	} else {
+		klog.Errorf("\n\n [Summary] Some tests failed. number of tests that failed: %d", result)
+	}
+
+	// Exit with the test result code.
+	os.Exit(result)
+}
+
+func createTestGrade() *gpb.SingleGrade {
+	return &gpb.SingleGrade{
+		GradeID:    uuid.New().String(),
+		StudentID:  uuid.New().String(),
+		CourseID:   uuid.New().String(),
+		Semester:   "Winter_2023",
+		GradeType:  "Exam",
+		ItemID:     uuid.New().String(),
+		GradeValue: "A",
+		GradedBy:   "Professor X",
+		Comments:   "Excellent work!",
+	}
+}
+
+func startTestServer(opts ...func(*GradesServer)) (*grpc.Server, net.Listener, *TestGradesServer, error) {
+	// Create a base server
+	base, err := ms.CreateBaseServiceServer()
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to create base service: %w", err)
+	}
+
+	// Use mock database instead of real one
+	mockDB := NewMockDatabase()
+
+	// Create the grades server with mock database
+	server := &GradesServer{
+		BaseServiceServer:                base,
+		UnimplementedGradesServiceServer: gpb.UnimplementedGradesServiceServer{},
+		db:                               mockDB,
+		Claims:                           MockClaims{},
+	}
+
+	for _, opt := range opts {
+		opt(server)
+	}
+
+	testServer := &TestGradesServer{GradesServer: server}
+	grpcServer := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(busyInterceptor(), server.strictInterceptor(), server.trimInterceptor(),
+			server.institutionInterceptor()),
+		grpc.ChainStreamInterceptor(busyStreamInterceptor(), server.strictStreamInterceptor(),
+			server.trimStreamInterceptor(), server.institutionStreamInterceptor()),
+	)
+	gpb.RegisterGradesServiceServer(grpcServer, testServer)
+
+	listener, err := net.Listen(connectionProtocol, "localhost:0") // Use port 0 to get a random available port
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to listen: %w", err)
+	}
+
+	go func() {
+		if err := grpcServer.Serve(listener); err != nil {
+			panic("Failed to serve: " + err.Error())
+		}
+	}()
+
+	return grpcServer, listener, testServer, nil
+}
+
+func setupClient(t *testing.T, opts ...func(*GradesServer)) gpb.GradesServiceClient {
+	t.Helper()
+
+	grpcServer, listener, _, err := startTestServer(opts...)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		grpcServer.Stop()
+	})
+
+	// Using grpc.Dial is deprecated but keeping for now as it's a test
+	// #nosec G402 -- This is a test and we're using insecure credentials intentionally
+	// TODO: Update to use grpc.NewClient in the future
+	conn, err := grpc.NewClient(listener.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		conn.Close()
+	})
+
+	return gpb.NewGradesServiceClient(conn)
+}
+
+// sequentialIDs returns a generator of the IDs prefix-1, prefix-2, and so on.
+func sequentialIDs(prefix string) func() string {
+	var next int
+
+	return func() string {
+		next++
+
+		return fmt.Sprintf("%s-%d", prefix, next)
+	}
+}
+
+func TestMockDatabaseIDGenerator(t *testing.T) {
+	db := NewMockDatabase(WithIDGenerator(sequentialIDs("grade")))
+
+	for _, want := range []string{"grade-1", "grade-2"} {
+		grade := createTestGrade()
+		grade.GradeID = ""
+
+		added, err := db.AddGrade(context.Background(), grade)
+		require.NoError(t, err)
+		assert.Equal(t, want, added.GradeID)
+	}
+
+	// A caller-supplied ID is kept.
+	grade := createTestGrade()
+	added, err := db.AddGrade(context.Background(), grade)
+	require.NoError(t, err)
+	assert.Equal(t, grade.GetGradeID(), added.GradeID)
+
+	// Without a generator IDs are random UUIDs.
+	grade.GradeID = ""
+	added, err = NewMockDatabase().AddGrade(context.Background(), grade)
+	require.NoError(t, err)
+	assert.NoError(t, uuid.Validate(added.GradeID))
+}
+
+func TestGetCourseGrades(t *testing.T) {
+	client := setupClient(t)
+	grade := createTestGrade()
+	_, err := client.AddSingleGrade(context.Background(), &gpb.AddSingleGradeRequest{
+		Token: "test-token",
+		Grade: grade,
+	})
+	require.NoError(t, err)
+
+	req := &gpb.GetCourseGradesRequest{
+		Token:    "test-token",
+		CourseID: grade.GetCourseID(), Semester: grade.GetSemester(),
+	}
+	resp, err := client.GetCourseGrades(context.Background(), req)
+	require.NoError(t, err)
+	assert.Equal(t, grade.GetCourseID(), resp.GetGrades()[0].GetCourseID())
+}
+
+// countingDatabase counts the rows StreamCourseGrades has read from the mock database.
+type countingDatabase struct {
+	*MockDatabase
+	produced atomic.Int64
+}
+
+func (c *countingDatabase) StreamCourseGrades(ctx context.Context, courseID, semester string,
+	fn func(*Grade) error,
+) error {
+	return c.MockDatabase.StreamCourseGrades(ctx, courseID, semester, func(grade *Grade) error {
+		c.produced.Add(1)
+
+		return fn(grade)
+	})
+}
+
+// throttledStream hands every sent grade to a reader over an unbuffered channel, so Send blocks
+// until the reader takes the grade, like a send on a full flow-control window.
+type throttledStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	sent chan *gpb.SingleGrade
+}
+
+func (s *throttledStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *throttledStream) Send(grade *gpb.SingleGrade) error {
+	select {
+	case s.sent <- grade:
+		return nil
+	case <-s.ctx.Done():
+		return s.ctx.Err()
+	}
+}
+
+func TestStreamCourseGradesFollowsSlowConsumer(t *testing.T) {
+	db := &countingDatabase{MockDatabase: NewMockDatabase()}
+	server := &GradesServer{db: db, Claims: MockClaims{}}
+
+	const total = 30
+
+	courseID := "c1"
+	for range total {
+		grade := createTestGrade()
+		grade.CourseID = courseID
+		_, err := db.AddGrade(context.Background(), grade)
+		require.NoError(t, err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	stream := &throttledStream{ctx: ctx, sent: make(chan *gpb.SingleGrade)}
+	done := make(chan error, 1)
+
+	go func() {
+		done <- server.StreamCourseGrades(&gpb.StreamCourseGradesRequest{
+			CourseID: courseID, Semester: "Winter_2023",
+		}, stream)
+	}()
+
+	for consumed := int64(1); consumed <= 20; consumed++ {
+		time.Sleep(time.Millisecond)
+
+		grade := <-stream.sent
+		assert.Equal(t, courseID, grade.GetCourseID())
+		// At most the row being sent is read ahead of the reader.
+		assert.LessOrEqual(t, db.produced.Load(), consumed+1)
+	}
+
+	// A consumer that goes away stops the iteration.
+	cancel()
+	require.Equal(t, codes.Canceled, status.Code(<-done))
+	assert.Less(t, db.produced.Load(), int64(total))
+}
+
+func TestStreamCourseGrades(t *testing.T) {
+	client := setupClient(t)
+
+	grade := createTestGrade()
+	_, err := client.AddSingleGrade(context.Background(), &gpb.AddSingleGradeRequest{Token: "test-token", Grade: grade})
+	require.NoError(t, err)
+
+	stream, err := client.StreamCourseGrades(context.Background(), &gpb.StreamCourseGradesRequest{
+		Token: "test-token", CourseID: grade.GetCourseID(), Semester: grade.GetSemester(),
+	})
+	require.NoError(t, err)
+
+	received, err := stream.Recv()
+	require.NoError(t, err)
+	assert.Equal(t, grade.GetGradeID(), received.GetGradeID())
+
+	_, err = stream.Recv()
+	require.ErrorIs(t, err, io.EOF)
+
+	stream, err = client.StreamCourseGrades(context.Background(), &gpb.StreamCourseGradesRequest{
+		Token: "test-token",
+	})
+	require.NoError(t, err)
+
+	_, err = stream.Recv()
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestGetStudentCourseGrades(t *testing.T) {
+	client := setupClient(t)
+	grade := createTestGrade()
+	_, err := client.AddSingleGrade(context.Background(), &gpb.AddSingleGradeRequest{
+		Token: "test-token",
+		Grade: grade,
+	})
+	require.NoError(t, err)
+
+	req := &gpb.GetStudentCourseGradesRequest{
+		Token:    "test-token",
+		CourseID: grade.GetCourseID(), Semester: grade.GetSemester(), StudentID: grade.GetStudentID(),
+	}
+	resp, err := client.GetStudentCourseGrades(context.Background(), req)
+	require.NoError(t, err)
+	assert.Equal(t, grade.GetStudentID(), resp.GetGrades()[0].GetStudentID())
+}
+
+func TestAddSingleGrade(t *testing.T) {
+	client := setupClient(t)
+	grade := createTestGrade()
+	req := &gpb.AddSingleGradeRequest{
+		Token: "test-token",
+		Grade: grade,
+	}
+
+	_, err := client.AddSingleGrade(context.Background(), req)
+	require.NoError(t, err)
+	assert.Equal(t, grade.GetStudentID(), req.GetGrade().GetStudentID())
+}
+
+func TestAddSingleGradeReturnsStoredGrade(t *testing.T) {
+	client := setupClient(t)
+	grade := createTestGrade()
+	grade.GradeID = ""
+
+	resp, err := client.AddSingleGrade(context.Background(), &gpb.AddSingleGradeRequest{Token: "test-token", Grade: grade})
+	require.NoError(t, err)
+	assert.NotEmpty(t, resp.GetGrade().GetGradeID(), "the generated ID is returned")
+	assert.NotEmpty(t, resp.GetGrade().GetGradedAt(), "timestamps set on insert are returned")
+	assert.Equal(t, grade.GetStudentID(), resp.GetGrade().GetStudentID())
+	assert.Equal(t, grade.GetGradeValue(), resp.GetGrade().GetGradeValue())
+}
+
+func TestGradeTimestampsRoundTrip(t *testing.T) {
+	db := NewMockDatabase()
+	client := setupClient(t, func(s *GradesServer) {
+		s.db = db
+	})
+
+	grade := createTestGrade()
+	added, err := client.AddSingleGrade(context.Background(),
+		&gpb.AddSingleGradeRequest{Token: "test-token", Grade: grade})
+	require.NoError(t, err)
+
+	stored, err := db.GetGradeByID(context.Background(), grade.GetGradeID())
+	require.NoError(t, err)
+
+	got, err := client.GetGrade(context.Background(),
+		&gpb.GetGradeRequest{Token: "test-token", GradeID: grade.GetGradeID()})
+	require.NoError(t, err)
+
+	for _, resp := range []*gpb.SingleGrade{added.GetGrade(), got.GetGrade()} {
+		require.NotNil(t, resp.GetGradedAt())
+		assert.True(t, resp.GetGradedAt().AsTime().Equal(stored.GradedAt), "graded_at is the stored time")
+
+		require.NotNil(t, resp.GetUpdatedAt())
+		assert.True(t, resp.GetUpdatedAt().AsTime().Equal(stored.UpdatedAt), "updated_at is the stored time")
+	}
+
+	updated, err := client.UpdateSingleGrade(context.Background(), &gpb.UpdateSingleGradeRequest{
+		Token: "test-token", Grade: &gpb.SingleGrade{GradeID: grade.GetGradeID(), GradeValue: "B"},
+	})
+	require.NoError(t, err)
+
+	stored, err = db.GetGradeByID(context.Background(), grade.GetGradeID())
+	require.NoError(t, err)
+	assert.True(t, updated.GetGrade().GetGradedAt().AsTime().Equal(stored.GradedAt))
+	assert.True(t, updated.GetGrade().GetUpdatedAt().AsTime().Equal(stored.UpdatedAt),
+		"updated_at is the time of the update")
+}
+
+func TestUpdateSingleGrade(t *testing.T) {
+	client := setupClient(t)
+	grade := createTestGrade()
+	_, err := client.AddSingleGrade(context.Background(), &gpb.AddSingleGradeRequest{
+		Token: "test-token",
+		Grade: grade,
+	})
+	require.NoError(t, err)
+
+	grade.GradeValue = "B"
+	req := &gpb.UpdateSingleGradeRequest{
+		Token: "test-token",
+		Grade: grade,
+	}
+
+	_, err = client.UpdateSingleGrade(context.Background(), req)
+	require.NoError(t, err)
+	assert.Equal(t, "B", req.GetGrade().GetGradeValue())
+}
+
+// ConflictingDatabase wraps MockDatabase and fails every update as modified concurrently.
+type ConflictingDatabase struct {
+	*MockDatabase
+}
+
+func (*ConflictingDatabase) UpdateGrade(context.Context, *gpb.SingleGrade) (*Grade, error) {
+	return nil, ErrGradeVersionConflict
+}
+
+func TestUpdateSingleGradeErrors(t *testing.T) {
+	client := setupClient(t)
+
+	_, err := client.UpdateSingleGrade(context.Background(), &gpb.UpdateSingleGradeRequest{
+		Token: "test-token", Grade: &gpb.SingleGrade{GradeID: "missing", GradeValue: "B"},
+	})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	db := &ConflictingDatabase{MockDatabase: NewMockDatabase()}
+	client = setupClient(t, func(s *GradesServer) {
+		s.db = db
+	})
+	grade := createTestGrade()
+	_, err = db.AddGrade(context.Background(), grade)
+	require.NoError(t, err)
+
+	_, err = client.UpdateSingleGrade(context.Background(), &gpb.UpdateSingleGradeRequest{
+		Token: "test-token", Grade: &gpb.SingleGrade{GradeID: grade.GetGradeID(), GradeValue: "B"},
+	})
+	assert.Equal(t, codes.Aborted, status.Code(err), "concurrent changes are retryable")
+}
+
+func TestRemoveSingleGrade(t *testing.T) {
+	client := setupClient(t)
+	grade := createTestGrade()
+	_, err := client.AddSingleGrade(context.Background(), &gpb.AddSingleGradeRequest{
+		Token: "test-token",
+		Grade: grade,
+	})
+	require.NoError(t, err)
+
+	req := &gpb.RemoveSingleGradeRequest{Token: "test-token", GradeID: grade.GetGradeID()}
+	_, err = client.RemoveSingleGrade(context.Background(), req)
+	require.NoError(t, err)
+
+	_, err = client.RemoveSingleGrade(context.Background(), req)
+	assert.Equal(t, codes.NotFound, status.Code(err), "a removed grade cannot be removed again")
+
+	_, err = client.RemoveSingleGrade(context.Background(), &gpb.RemoveSingleGradeRequest{
+		Token: "test-token", GradeID: uuid.New().String(),
+	})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestGetStudentSemesterGrades(t *testing.T) {
+	client := setupClient(t)
+	grade := createTestGrade()
+	_, err := client.AddSingleGrade(context.Background(), &gpb.AddSingleGradeRequest{
+		Token: "test-token",
+		Grade: grade,
+	})
+	require.NoError(t, err)
+
+	req := &gpb.GetStudentSemesterGradesRequest{
+		Token:    "test-token",
+		Semester: grade.GetSemester(), StudentID: grade.GetStudentID(),
+	}
+	resp, err := client.GetStudentSemesterGrades(context.Background(), req)
+	require.NoError(t, err)
+	assert.Equal(t, grade.GetStudentID(), resp.GetGrades()[0].GetStudentID())
+}
+
+func TestArchiveSemester(t *testing.T) {
+	client := setupClient(t)
+	grade := createTestGrade()
+	other := createTestGrade()
+	other.CourseID = grade.GetCourseID()
+	other.Semester = "Spring_2024"
+
+	for _, g := range []*gpb.SingleGrade{grade, other} {
+		_, err := client.AddSingleGrade(context.Background(), &gpb.AddSingleGradeRequest{Token: "test-token", Grade: g})
+		require.NoError(t, err)
+	}
+
+	resp, err := client.ArchiveSemester(context.Background(), &gpb.ArchiveSemesterRequest{
+		Token:    "test-token",
+		Semester: grade.GetSemester(),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), resp.GetArchivedCount())
+
+	// Live reads exclude archived grades by default.
+	live, err := client.GetCourseGrades(context.Background(), &gpb.GetCourseGradesRequest{
+		Token:    "test-token",
+		CourseID: grade.GetCourseID(), Semester: grade.GetSemester(),
+	})
+	require.NoError(t, err)
+	assert.Empty(t, live.GetGrades())
+
+	withArchive, err := client.GetCourseGrades(context.Background(), &gpb.GetCourseGradesRequest{
+		Token:    "test-token",
+		CourseID: grade.GetCourseID(), Semester: grade.GetSemester(), IncludeArchived: true,
+	})
+	require.NoError(t, err)
+	require.Len(t, withArchive.GetGrades(), 1)
+	assert.Equal(t, grade.GetGradeID(), withArchive.GetGrades()[0].GetGradeID())
+
+	// Other semesters stay in the live table.
+	remaining, err := client.GetCourseGrades(context.Background(), &gpb.GetCourseGradesRequest{
+		Token:    "test-token",
+		CourseID: other.GetCourseID(), Semester: other.GetSemester(),
+	})
+	require.NoError(t, err)
+	assert.Len(t, remaining.GetGrades(), 1)
+}
+
+func TestArchiveSemesterRequiresAdmin(t *testing.T) {
+	client := setupClient(t, func(s *GradesServer) {
+		s.Claims = RoleClaims{role: "student"}
+	})
+
+	_, err := client.ArchiveSemester(context.Background(), &gpb.ArchiveSemesterRequest{
+		Token:    "test-token",
+		Semester: "Winter_2023",
+	})
+	require.Error(t, err)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestGetGrade(t *testing.T) {
+	client := setupClient(t)
+	grade := createTestGrade()
+	_, err := client.AddSingleGrade(context.Background(), &gpb.AddSingleGradeRequest{
+		Token: "test-token",
+		Grade: grade,
+	})
+	require.NoError(t, err)
+
+	_, err = client.UpdateSingleGrade(context.Background(), &gpb.UpdateSingleGradeRequest{
+		Token: "test-token",
+		Grade: &gpb.SingleGrade{GradeID: grade.GetGradeID(), GradeValue: "B"},
+	})
+	require.NoError(t, err)
+
+	latest, err := client.GetGrade(context.Background(), &gpb.GetGradeRequest{
+		Token: "test-token", GradeID: grade.GetGradeID(),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "B", latest.GetGrade().GetGradeValue())
+	assert.Equal(t, int64(2), latest.GetGrade().GetVersion())
+
+	original, err := client.GetGrade(context.Background(), &gpb.GetGradeRequest{
+		Token: "test-token", GradeID: grade.GetGradeID(), Version: 1,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, grade.GetGradeValue(), original.GetGrade().GetGradeValue())
+	assert.Equal(t, int64(1), original.GetGrade().GetVersion())
+}
+
+func TestGetGradeIfVersionNot(t *testing.T) {
+	client := setupClient(t)
+	grade := createTestGrade()
+	_, err := client.AddSingleGrade(context.Background(), &gpb.AddSingleGradeRequest{
+		Token: "test-token",
+		Grade: grade,
+	})
+	require.NoError(t, err)
+
+	unchanged, err := client.GetGrade(context.Background(), &gpb.GetGradeRequest{
+		Token: "test-token", GradeID: grade.GetGradeID(), IfVersionNot: 1,
+	})
+	require.NoError(t, err)
+	assert.True(t, unchanged.GetNotModified())
+	assert.Nil(t, unchanged.GetGrade(), "an unchanged grade is not sent again")
+
+	_, err = client.UpdateSingleGrade(context.Background(), &gpb.UpdateSingleGradeRequest{
+		Token: "test-token",
+		Grade: &gpb.SingleGrade{GradeID: grade.GetGradeID(), GradeValue: "B"},
+	})
+	require.NoError(t, err)
+
+	changed, err := client.GetGrade(context.Background(), &gpb.GetGradeRequest{
+		Token: "test-token", GradeID: grade.GetGradeID(), IfVersionNot: 1,
+	})
+	require.NoError(t, err)
+	assert.False(t, changed.GetNotModified())
+	assert.Equal(t, "B", changed.GetGrade().GetGradeValue())
+	assert.Equal(t, int64(2), changed.GetGrade().GetVersion())
+
+	_, err = client.GetGrade(context.Background(), &gpb.GetGradeRequest{
+		Token: "test-token", GradeID: "missing", IfVersionNot: 1,
+	})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestGetGradeValue(t *testing.T) {
+	client := setupClient(t)
+	grade := createTestGrade()
+	_, err := client.AddSingleGrade(context.Background(), &gpb.AddSingleGradeRequest{
+		Token: "test-token",
+		Grade: grade,
+	})
+	require.NoError(t, err)
+
+	byID, err := client.GetGradeValue(context.Background(), &gpb.GetGradeValueRequest{
+		Token: "test-token", GradeID: grade.GetGradeID(),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, grade.GetGradeValue(), byID.GetGradeValue())
+
+	byKey, err := client.GetGradeValue(context.Background(), &gpb.GetGradeValueRequest{
+		Token: "test-token", StudentID: grade.GetStudentID(), CourseID: grade.GetCourseID(),
+		Semester: grade.GetSemester(), ItemID: grade.GetItemID(),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, grade.GetGradeValue(), byKey.GetGradeValue())
+
+	_, err = client.GetGradeValue(context.Background(), &gpb.GetGradeValueRequest{
+		Token: "test-token", StudentID: grade.GetStudentID(), CourseID: grade.GetCourseID(),
+		Semester: grade.GetSemester(), ItemID: "missing",
+	})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = client.GetGradeValue(context.Background(), &gpb.GetGradeValueRequest{Token: "test-token"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestGetGradeNotFound(t *testing.T) {
+	client := setupClient(t)
+	grade := createTestGrade()
+	_, err := client.AddSingleGrade(context.Background(), &gpb.AddSingleGradeRequest{
+		Token: "test-token",
+		Grade: grade,
+	})
+	require.NoError(t, err)
+
+	_, err = client.GetGrade(context.Background(), &gpb.GetGradeRequest{
+		Token: "test-token", GradeID: grade.GetGradeID(), Version: 7,
+	})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = client.GetGrade(context.Background(), &gpb.GetGradeRequest{
+		Token: "test-token", GradeID: uuid.New().String(),
+	})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestSearchGradeComments(t *testing.T) {
+	client := setupClient(t)
+	grade := createTestGrade()
+	grade.Comments = "Scored 50% on the bonus"
+	other := createTestGrade()
+	other.CourseID = grade.GetCourseID()
+	other.Comments = "Scored 500 on the bonus"
+
+	for _, g := range []*gpb.SingleGrade{grade, other} {
+		_, err := client.AddSingleGrade(context.Background(), &gpb.AddSingleGradeRequest{Token: "test-token", Grade: g})
+		require.NoError(t, err)
+	}
+
+	resp, err := client.SearchGradeComments(context.Background(), &gpb.SearchGradeCommentsRequest{
+		Token: "test-token", CourseID: grade.GetCourseID(), Semester: grade.GetSemester(), Query: "50%",
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.GetGrades(), 1)
+	assert.Equal(t, grade.GetGradeID(), resp.GetGrades()[0].GetGradeID())
+
+	_, err = client.SearchGradeComments(context.Background(), &gpb.SearchGradeCommentsRequest{
+		Token: "test-token", CourseID: grade.GetCourseID(), Semester: grade.GetSemester(),
+		Query: strings.Repeat("a", maxSearchTermLength+1),
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func addStudentSemesterGrades(t *testing.T, client gpb.GradesServiceClient, courseIDs ...string) *gpb.SingleGrade {
+	t.Helper()
+
+	grade := createTestGrade()
+
+	for _, courseID := range courseIDs {
+		g := createTestGrade()
+		g.StudentID = grade.GetStudentID()
+		g.CourseID = courseID
+		_, err := client.AddSingleGrade(context.Background(), &gpb.AddSingleGradeRequest{Token: "test-token", Grade: g})
+		require.NoError(t, err)
+	}
+
+	return grade
+}
+
+func TestGetStudentSemesterGradesCourseEnrichment(t *testing.T) {
+	resolver := &MockCourseResolver{catalog: map[string]*CourseInfo{
+		"234218": {CourseID: "234218", Name: "Data Structures", Credits: 3},
+		"234247": {CourseID: "234247", Name: "Algorithms", Credits: 3.5},
+	}}
+	client := setupClient(t, func(s *GradesServer) {
+		s.courses = resolver
+	})
+	grade := addStudentSemesterGrades(t, client, "234218", "234218", "234247", "999999")
+
+	resp, err := client.GetStudentSemesterGrades(context.Background(), &gpb.GetStudentSemesterGradesRequest{
+		Token: "test-token", Semester: grade.GetSemester(), StudentID: grade.GetStudentID(), IncludeCourseInfo: true,
+	})
+	require.NoError(t, err)
+	assert.Len(t, resp.GetGrades(), 4)
+
+	// All courses are resolved in one batched lookup without duplicates.
+	require.Len(t, resolver.calls, 1)
+	assert.ElementsMatch(t, []string{"234218", "234247", "999999"}, resolver.calls[0])
+
+	assert.Equal(t, "Data Structures", resp.GetCourses()["234218"].GetName())
+	assert.InDelta(t, 3.5, resp.GetCourses()["234247"].GetCredits(), 0.001)
+	assert.Equal(t, []string{"999999"}, resp.GetUnresolvedCourseIDs())
+}
+
+func TestGetStudentSemesterGradesCourseEnrichmentFailure(t *testing.T) {
+	resolver := &MockCourseResolver{err: errors.New("course service unavailable")}
+	client := setupClient(t, func(s *GradesServer) {
+		s.courses = resolver
+	})
+	grade := addStudentSemesterGrades(t, client, "234218", "234247")
+
+	resp, err := client.GetStudentSemesterGrades(context.Background(), &gpb.GetStudentSemesterGradesRequest{
+		Token: "test-token", Semester: grade.GetSemester(), StudentID: grade.GetStudentID(), IncludeCourseInfo: true,
+	})
+	require.NoError(t, err)
+	assert.Len(t, resp.GetGrades(), 2)
+	assert.Empty(t, resp.GetCourses())
+	assert.ElementsMatch(t, []string{"234218", "234247"}, resp.GetUnresolvedCourseIDs())
+}
+
+func TestGetCourseGradesETag(t *testing.T) {
+	client := setupClient(t)
+	grade := createTestGrade()
+	_, err := client.AddSingleGrade(context.Background(), &gpb.AddSingleGradeRequest{
+		Token: "test-token",
+		Grade: grade,
+	})
+	require.NoError(t, err)
+
+	req := &gpb.GetCourseGradesRequest{
+		Token:    "test-token",
+		CourseID: grade.GetCourseID(), Semester: grade.GetSemester(),
+	}
+	first, err := client.GetCourseGrades(context.Background(), req)
+	require.NoError(t, err)
+	require.NotEmpty(t, first.GetEtag())
+	assert.Len(t, first.GetGrades(), 1)
+
+	// A matching ETag skips the payload.
+	req.IfNoneMatch = first.GetEtag()
+	cached, err := client.GetCourseGrades(context.Background(), req)
+	require.NoError(t, err)
+	assert.True(t, cached.GetNotModified())
+	assert.Empty(t, cached.GetGrades())
+	assert.Equal(t, first.GetEtag(), cached.GetEtag())
+
+	// Any change produces a new ETag and the full list.
+	_, err = client.UpdateSingleGrade(context.Background(), &gpb.UpdateSingleGradeRequest{
+		Token: "test-token",
+		Grade: &gpb.SingleGrade{GradeID: grade.GetGradeID(), GradeValue: "B"},
+	})
+	require.NoError(t, err)
+
+	changed, err := client.GetCourseGrades(context.Background(), req)
+	require.NoError(t, err)
+	assert.False(t, changed.GetNotModified())
+	assert.NotEqual(t, first.GetEtag(), changed.GetEtag())
+	assert.Len(t, changed.GetGrades(), 1)
+}
+
+func TestGetCourseGradesETagFollowsRequestShape(t *testing.T) {
+	client := setupClient(t)
+	grade := createTestGrade()
+	_, err := client.AddSingleGrade(context.Background(), &gpb.AddSingleGradeRequest{
+		Token: "test-token",
+		Grade: grade,
+	})
+	require.NoError(t, err)
+
+	etag := func(req *gpb.GetCourseGradesRequest) string {
+		t.Helper()
+
+		req.Token, req.CourseID, req.Semester = "test-token", grade.GetCourseID(), grade.GetSemester()
+		resp, err := client.GetCourseGrades(context.Background(), req)
+		require.NoError(t, err)
+		require.NotEmpty(t, resp.GetEtag())
+
+		return resp.GetEtag()
+	}
+
+	plain := etag(&gpb.GetCourseGradesRequest{})
+	assert.Equal(t, plain, etag(&gpb.GetCourseGradesRequest{}))
+
+	shapes := map[string]*gpb.GetCourseGradesRequest{
+		"include_archived": {IncludeArchived: true},
+		"locale":           {Locale: "he"},
+		"anonymize":        {Anonymize: true},
+	}
+	for name, req := range shapes {
+		assert.NotEqual(t, plain, etag(req), name)
+	}
+
+	// An ETag from one request shape does not skip the payload of another.
+	resp, err := client.GetCourseGrades(context.Background(), &gpb.GetCourseGradesRequest{
+		Token: "test-token", CourseID: grade.GetCourseID(), Semester: grade.GetSemester(),
+		Anonymize: true, IfNoneMatch: plain,
+	})
+	require.NoError(t, err)
+	assert.False(t, resp.GetNotModified())
+	assert.Len(t, resp.GetGrades(), 1)
+}
+
+func TestGetCourseGradesServesStaleOnError(t *testing.T) {
+	database := &UnreachableDatabase{MockDatabase: NewMockDatabase()}
+	client := setupClient(t, func(s *GradesServer) {
+		s.db = database
+		s.staleCache = newGradesCache(defaultReadCacheSize)
+	})
+	grade := createTestGrade()
 	_, err := client.AddSingleGrade(context.Background(), &gpb.AddSingleGradeRequest{
 		Token: "test-token",
 		Grade: grade,
@@ -353,13 +2249,29 @@ func TestGetCourseGrades(t *testing.T) {
 		Token:    "test-token",
 		CourseID: grade.GetCourseID(), Semester: grade.GetSemester(),
 	}
-	resp, err := client.GetCourseGrades(context.Background(), req)
+	fresh, err := client.GetCourseGrades(context.Background(), req)
 	require.NoError(t, err)
-	assert.Equal(t, grade.GetCourseID(), resp.GetGrades()[0].GetCourseID())
+	assert.False(t, fresh.GetStale())
+
+	database.down.Store(true)
+
+	stale, err := client.GetCourseGrades(context.Background(), req)
+	require.NoError(t, err)
+	assert.True(t, stale.GetStale())
+	require.Len(t, stale.GetGrades(), 1)
+	assert.Equal(t, grade.GetGradeID(), stale.GetGrades()[0].GetGradeID())
+
+	// Reads that were never cached still fail.
+	req.CourseID = uuid.New().String()
+	_, err = client.GetCourseGrades(context.Background(), req)
+	require.Error(t, err)
 }
 
-func TestGetStudentCourseGrades(t *testing.T) {
-	client := setupClient(t)
+func TestGetCourseGradesFailsWithoutStaleServing(t *testing.T) {
+	database := &UnreachableDatabase{MockDatabase: NewMockDatabase()}
+	client := setupClient(t, func(s *GradesServer) {
+		s.db = database
+	})
 	grade := createTestGrade()
 	_, err := client.AddSingleGrade(context.Background(), &gpb.AddSingleGradeRequest{
 		Token: "test-token",
@@ -367,76 +2279,637 @@ func TestGetStudentCourseGrades(t *testing.T) {
 	})
 	require.NoError(t, err)
 
-	req := &gpb.GetStudentCourseGradesRequest{
+	database.down.Store(true)
+
+	_, err = client.GetCourseGrades(context.Background(), &gpb.GetCourseGradesRequest{
 		Token:    "test-token",
-		CourseID: grade.GetCourseID(), Semester: grade.GetSemester(), StudentID: grade.GetStudentID(),
+		CourseID: grade.GetCourseID(), Semester: grade.GetSemester(),
+	})
+	require.Error(t, err)
+}
+
+func TestFlaggedGrades(t *testing.T) {
+	client := setupClient(t)
+	grade := createTestGrade()
+	other := createTestGrade()
+	other.CourseID = grade.GetCourseID()
+
+	for _, g := range []*gpb.SingleGrade{grade, other} {
+		_, err := client.AddSingleGrade(context.Background(), &gpb.AddSingleGradeRequest{Token: "test-token", Grade: g})
+		require.NoError(t, err)
 	}
-	resp, err := client.GetStudentCourseGrades(context.Background(), req)
+
+	req := &gpb.GetFlaggedGradesRequest{
+		Token: "test-token", CourseID: grade.GetCourseID(), Semester: grade.GetSemester(),
+	}
+	none, err := client.GetFlaggedGrades(context.Background(), req)
 	require.NoError(t, err)
-	assert.Equal(t, grade.GetStudentID(), resp.GetGrades()[0].GetStudentID())
+	assert.Empty(t, none.GetGrades())
+
+	flagged, err := client.SetGradeFlag(context.Background(), &gpb.SetGradeFlagRequest{
+		Token: "test-token", GradeID: grade.GetGradeID(), Flagged: true,
+	})
+	require.NoError(t, err)
+	assert.True(t, flagged.GetGrade().GetFlagged())
+
+	resp, err := client.GetFlaggedGrades(context.Background(), req)
+	require.NoError(t, err)
+	require.Len(t, resp.GetGrades(), 1)
+	assert.Equal(t, grade.GetGradeID(), resp.GetGrades()[0].GetGradeID())
+
+	_, err = client.SetGradeFlag(context.Background(), &gpb.SetGradeFlagRequest{
+		Token: "test-token", GradeID: grade.GetGradeID(), Flagged: false,
+	})
+	require.NoError(t, err)
+
+	cleared, err := client.GetFlaggedGrades(context.Background(), req)
+	require.NoError(t, err)
+	assert.Empty(t, cleared.GetGrades())
+
+	_, err = client.SetGradeFlag(context.Background(), &gpb.SetGradeFlagRequest{
+		Token: "test-token", GradeID: uuid.New().String(), Flagged: true,
+	})
+	assert.Equal(t, codes.NotFound, status.Code(err))
 }
 
-func TestAddSingleGrade(t *testing.T) {
-	client := setupClient(t)
+func TestFlaggedGradesRequireStaff(t *testing.T) {
+	db := NewMockDatabase()
+	student := setupClient(t, func(s *GradesServer) {
+		s.db = db
+		s.Claims = RoleClaims{role: "student"}
+	})
+	grader := setupGraderClient(t, db)
+
 	grade := createTestGrade()
-	req := &gpb.AddSingleGradeRequest{
-		Token: "test-token",
-		Grade: grade,
+	require.NoError(t, db.AddCourseGrader(context.Background(), grade.GetCourseID(), "ta-1"))
+	_, err := grader.AddSingleGrade(context.Background(), &gpb.AddSingleGradeRequest{
+		Token: tokenFor("ta-1"), Grade: grade,
+	})
+	require.NoError(t, err)
+
+	_, err = student.SetGradeFlag(context.Background(), &gpb.SetGradeFlagRequest{
+		Token: "test-token", GradeID: grade.GetGradeID(), Flagged: true,
+	})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = student.GetFlaggedGrades(context.Background(), &gpb.GetFlaggedGradesRequest{
+		Token: "test-token", CourseID: grade.GetCourseID(), Semester: grade.GetSemester(),
+	})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = grader.SetGradeFlag(context.Background(), &gpb.SetGradeFlagRequest{
+		Token: tokenFor("ta-2"), GradeID: grade.GetGradeID(), Flagged: true,
+	})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err), "only the course's graders may flag its grades")
+
+	_, err = grader.SetGradeFlag(context.Background(), &gpb.SetGradeFlagRequest{
+		Token: tokenFor("ta-1"), GradeID: grade.GetGradeID(), Flagged: true,
+	})
+	require.NoError(t, err)
+}
+
+func TestGetMultiCourseGrades(t *testing.T) {
+	client := setupClient(t)
+	semester := "Spring2025"
+	courseA, courseB, courseEmpty := uuid.New().String(), uuid.New().String(), uuid.New().String()
+
+	for _, courseID := range []string{courseA, courseA, courseB} {
+		grade := createTestGrade()
+		grade.CourseID = courseID
+		grade.Semester = semester
+		_, err := client.AddSingleGrade(context.Background(), &gpb.AddSingleGradeRequest{Token: "test-token", Grade: grade})
+		require.NoError(t, err)
 	}
 
-	_, err := client.AddSingleGrade(context.Background(), req)
+	resp, err := client.GetMultiCourseGrades(context.Background(), &gpb.GetMultiCourseGradesRequest{
+		Token:     "test-token",
+		CourseIDs: []string{courseB, courseEmpty, courseA, courseB},
+		Semester:  semester,
+	})
 	require.NoError(t, err)
-	assert.Equal(t, grade.GetStudentID(), req.GetGrade().GetStudentID())
+
+	courses := resp.GetCourses()
+	require.Len(t, courses, 3)
+	assert.Equal(t, courseB, courses[0].GetCourseID())
+	assert.Len(t, courses[0].GetGrades(), 1)
+	assert.Equal(t, courseEmpty, courses[1].GetCourseID())
+	assert.Empty(t, courses[1].GetGrades())
+	assert.Equal(t, courseA, courses[2].GetCourseID())
+	assert.Len(t, courses[2].GetGrades(), 2)
+
+	for _, group := range courses {
+		for _, grade := range group.GetGrades() {
+			assert.Equal(t, group.GetCourseID(), grade.GetCourseID())
+		}
+	}
 }
 
-func TestUpdateSingleGrade(t *testing.T) {
+func TestGetMultiCourseGradesInvalidArgument(t *testing.T) {
+	client := setupClient(t)
+
+	_, err := client.GetMultiCourseGrades(context.Background(), &gpb.GetMultiCourseGradesRequest{
+		Token: "test-token", Semester: "Spring2025",
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	tooMany := make([]string, maxMultiCourseIDs+1)
+	for i := range tooMany {
+		tooMany[i] = uuid.New().String()
+	}
+
+	_, err = client.GetMultiCourseGrades(context.Background(), &gpb.GetMultiCourseGradesRequest{
+		Token: "test-token", CourseIDs: tooMany, Semester: "Spring2025",
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestGetStudentCourseGradesTrend(t *testing.T) {
 	client := setupClient(t)
+	studentID, courseID := uuid.New().String(), uuid.New().String()
+	req := &gpb.GetStudentCourseGradesRequest{
+		Token: "test-token", CourseID: courseID, Semester: "Winter_2023", StudentID: studentID,
+	}
+
+	resp, err := client.GetStudentCourseGrades(context.Background(), req)
+	require.NoError(t, err)
+	assert.Equal(t, trendInsufficientData, resp.GetTrend())
+
+	for _, value := range []string{"95", "85", "70"} {
+		grade := createTestGrade()
+		grade.StudentID = studentID
+		grade.CourseID = courseID
+		grade.GradeValue = value
+		_, err := client.AddSingleGrade(context.Background(), &gpb.AddSingleGradeRequest{Token: "test-token", Grade: grade})
+		require.NoError(t, err)
+	}
+
+	resp, err = client.GetStudentCourseGrades(context.Background(), req)
+	require.NoError(t, err)
+	assert.Equal(t, trendDeclining, resp.GetTrend())
+}
+
+func TestMaxResultRowsTruncatesListReads(t *testing.T) {
+	client := setupClient(t, func(s *GradesServer) {
+		s.maxResultRows = 2
+	})
+	courseID := uuid.New().String()
+
+	req := &gpb.GetCourseGradesRequest{Token: "test-token", CourseID: courseID, Semester: "Winter_2023"}
+
+	for i := range 3 {
+		grade := createTestGrade()
+		grade.CourseID = courseID
+		_, err := client.AddSingleGrade(context.Background(), &gpb.AddSingleGradeRequest{Token: "test-token", Grade: grade})
+		require.NoError(t, err)
+
+		if i == 1 {
+			// At the limit nothing is dropped.
+			resp, err := client.GetCourseGrades(context.Background(), req)
+			require.NoError(t, err)
+			assert.Len(t, resp.GetGrades(), 2)
+			assert.False(t, resp.GetTruncated())
+		}
+	}
+
+	resp, err := client.GetCourseGrades(context.Background(), req)
+	require.NoError(t, err)
+	assert.Len(t, resp.GetGrades(), 2)
+	assert.True(t, resp.GetTruncated())
+
+	multi, err := client.GetMultiCourseGrades(context.Background(), &gpb.GetMultiCourseGradesRequest{
+		Token: "test-token", CourseIDs: []string{courseID}, Semester: "Winter_2023",
+	})
+	require.NoError(t, err)
+	assert.Len(t, multi.GetCourses()[0].GetGrades(), 2)
+	assert.True(t, multi.GetCourses()[0].GetTruncated())
+}
+
+func TestGetCourseGradesAnonymized(t *testing.T) {
+	client := setupClient(t, func(s *GradesServer) {
+		s.Claims = RoleClaims{role: roleStaff}
+	})
+	courseID, studentID := uuid.New().String(), uuid.New().String()
+
+	for _, student := range []string{studentID, studentID, uuid.New().String()} {
+		grade := createTestGrade()
+		grade.CourseID = courseID
+		grade.StudentID = student
+		_, err := client.AddSingleGrade(context.Background(), &gpb.AddSingleGradeRequest{Token: "test-token", Grade: grade})
+		require.NoError(t, err)
+	}
+
+	resp, err := client.GetCourseGrades(context.Background(), &gpb.GetCourseGradesRequest{
+		Token: "test-token", CourseID: courseID, Semester: "Winter_2023", Anonymize: true,
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.GetGrades(), 3)
+
+	tokens := make(map[string]int)
+
+	for _, grade := range resp.GetGrades() {
+		assert.NotEqual(t, studentID, grade.GetStudentID())
+		assert.Equal(t, "A", grade.GetGradeValue())
+		tokens[grade.GetStudentID()]++
+	}
+
+	// The student with two grades maps to the same token both times.
+	counts := make([]int, 0, len(tokens))
+	for _, count := range tokens {
+		counts = append(counts, count)
+	}
+
+	assert.ElementsMatch(t, []int{1, 2}, counts)
+}
+
+func TestGetCourseGradesAnonymizeRequiresStaff(t *testing.T) {
+	client := setupClient(t, func(s *GradesServer) {
+		s.Claims = RoleClaims{role: "student"}
+	})
+
+	_, err := client.GetCourseGrades(context.Background(), &gpb.GetCourseGradesRequest{
+		Token: "test-token", CourseID: uuid.New().String(), Semester: "Winter_2023", Anonymize: true,
+	})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = client.GetMultiCourseGrades(context.Background(), &gpb.GetMultiCourseGradesRequest{
+		Token: "test-token", CourseIDs: []string{uuid.New().String()}, Semester: "Winter_2023", Anonymize: true,
+	})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestGradeRoundingOnRead(t *testing.T) {
+	mockDB := NewMockDatabase()
+	client := setupClient(t, func(s *GradesServer) {
+		s.db = mockDB
+		s.rounder = roundingInteger
+	})
+
 	grade := createTestGrade()
-	_, err := client.AddSingleGrade(context.Background(), &gpb.AddSingleGradeRequest{
-		Token: "test-token",
-		Grade: grade,
+	grade.GradeValue = "89.5"
+	_, err := client.AddSingleGrade(context.Background(), &gpb.AddSingleGradeRequest{Token: "test-token", Grade: grade})
+	require.NoError(t, err)
+
+	resp, err := client.GetCourseGrades(context.Background(), &gpb.GetCourseGradesRequest{
+		Token: "test-token", CourseID: grade.GetCourseID(), Semester: grade.GetSemester(),
 	})
 	require.NoError(t, err)
+	require.Len(t, resp.GetGrades(), 1)
+	assert.Equal(t, "90", resp.GetGrades()[0].GetGradeValue())
 
-	grade.GradeValue = "B"
-	req := &gpb.UpdateSingleGradeRequest{
-		Token: "test-token",
-		Grade: grade,
+	// The stored value is left intact.
+	stored, err := mockDB.GetGradeByID(context.Background(), grade.GetGradeID())
+	require.NoError(t, err)
+	assert.Equal(t, "89.5", stored.GradeValue)
+}
+
+func TestGradeRoundingOnStore(t *testing.T) {
+	mockDB := NewMockDatabase()
+	client := setupClient(t, func(s *GradesServer) {
+		s.db = mockDB
+		s.rounder = roundingHalfUp
+		s.roundOnStore = true
+	})
+
+	grade := createTestGrade()
+	grade.GradeValue = "90.49"
+	_, err := client.AddSingleGrade(context.Background(), &gpb.AddSingleGradeRequest{Token: "test-token", Grade: grade})
+	require.NoError(t, err)
+
+	stored, err := mockDB.GetGradeByID(context.Background(), grade.GetGradeID())
+	require.NoError(t, err)
+	assert.Equal(t, "90.5", stored.GradeValue)
+}
+
+func TestSearchStudentGrades(t *testing.T) {
+	client := setupClient(t, func(s *GradesServer) {
+		s.Claims = RoleClaims{role: roleStaff}
+	})
+	prefix := uuid.New().String()[:8]
+
+	for _, studentID := range []string{prefix + "-b", prefix + "-a", prefix + "-a", "x" + prefix} {
+		grade := createTestGrade()
+		grade.StudentID = studentID
+		_, err := client.AddSingleGrade(context.Background(), &gpb.AddSingleGradeRequest{Token: "test-token", Grade: grade})
+		require.NoError(t, err)
 	}
 
-	_, err = client.UpdateSingleGrade(context.Background(), req)
+	resp, err := client.SearchStudentGrades(context.Background(), &gpb.SearchStudentGradesRequest{
+		Token: "test-token", Prefix: prefix,
+	})
 	require.NoError(t, err)
-	assert.Equal(t, "B", req.GetGrade().GetGradeValue())
+	require.Len(t, resp.GetGrades(), 3)
+	assert.False(t, resp.GetTruncated())
+
+	studentIDs := make([]string, 0, len(resp.GetGrades()))
+	for _, grade := range resp.GetGrades() {
+		studentIDs = append(studentIDs, grade.GetStudentID())
+	}
+
+	assert.Equal(t, []string{prefix + "-a", prefix + "-a", prefix + "-b"}, studentIDs)
+
+	narrowed, err := client.SearchStudentGrades(context.Background(), &gpb.SearchStudentGradesRequest{
+		Token: "test-token", Prefix: prefix + "-b",
+	})
+	require.NoError(t, err)
+	assert.Len(t, narrowed.GetGrades(), 1)
 }
 
-func TestRemoveSingleGrade(t *testing.T) {
+func TestSearchStudentGradesGuards(t *testing.T) {
+	client := setupClient(t, func(s *GradesServer) {
+		s.Claims = RoleClaims{role: roleStaff}
+	})
+
+	_, err := client.SearchStudentGrades(context.Background(), &gpb.SearchStudentGradesRequest{
+		Token: "test-token", Prefix: "ab",
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = client.SearchStudentGrades(context.Background(), &gpb.SearchStudentGradesRequest{
+		Token: "test-token", Prefix: strings.Repeat("a", maxSearchTermLength+1),
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	studentClient := setupClient(t, func(s *GradesServer) {
+		s.Claims = RoleClaims{role: "student"}
+	})
+
+	_, err = studentClient.SearchStudentGrades(context.Background(), &gpb.SearchStudentGradesRequest{
+		Token: "test-token", Prefix: "abc",
+	})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestGetCourseGradesMultipleSemesters(t *testing.T) {
 	client := setupClient(t)
+	courseID := uuid.New().String()
+
+	for _, semester := range []string{"Fall2024", "Spring2025", "Spring2025", "Summer2025"} {
+		grade := createTestGrade()
+		grade.CourseID = courseID
+		grade.Semester = semester
+		_, err := client.AddSingleGrade(context.Background(), &gpb.AddSingleGradeRequest{Token: "test-token", Grade: grade})
+		require.NoError(t, err)
+	}
+
+	resp, err := client.GetCourseGrades(context.Background(), &gpb.GetCourseGradesRequest{
+		Token:     "test-token",
+		CourseID:  courseID,
+		Semesters: []string{"Spring2025", "Fall2024", "Winter2024", "Spring2025"},
+	})
+	require.NoError(t, err)
+
+	groups := resp.GetSemesterGrades()
+	require.Len(t, groups, 3)
+	assert.Equal(t, "Spring2025", groups[0].GetSemester())
+	assert.Len(t, groups[0].GetGrades(), 2)
+	assert.Equal(t, "Fall2024", groups[1].GetSemester())
+	assert.Len(t, groups[1].GetGrades(), 1)
+	assert.Equal(t, "Winter2024", groups[2].GetSemester())
+	assert.Empty(t, groups[2].GetGrades())
+
+	for _, group := range groups {
+		for _, grade := range group.GetGrades() {
+			assert.Equal(t, group.GetSemester(), grade.GetSemester())
+		}
+	}
+
+	// The single semester field keeps working.
+	single, err := client.GetCourseGrades(context.Background(), &gpb.GetCourseGradesRequest{
+		Token: "test-token", CourseID: courseID, Semester: "Summer2025",
+	})
+	require.NoError(t, err)
+	assert.Len(t, single.GetGrades(), 1)
+	assert.Empty(t, single.GetSemesterGrades())
+
+	_, err = client.GetCourseGrades(context.Background(), &gpb.GetCourseGradesRequest{
+		Token: "test-token", CourseID: courseID, Semesters: []string{"Fall2024", ""},
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestGetCourseGradesMultipleSemestersETagAndStale(t *testing.T) {
+	database := &UnreachableDatabase{MockDatabase: NewMockDatabase()}
+	client := setupClient(t, func(s *GradesServer) {
+		s.db = database
+		s.staleCache = newGradesCache(defaultReadCacheSize)
+	})
+	courseID := uuid.New().String()
+
+	grades := make(map[string]*gpb.SingleGrade)
+	for _, semester := range []string{"Fall2024", "Spring2025"} {
+		grade := createTestGrade()
+		grade.CourseID, grade.Semester = courseID, semester
+		_, err := client.AddSingleGrade(context.Background(), &gpb.AddSingleGradeRequest{Token: "test-token", Grade: grade})
+		require.NoError(t, err)
+
+		grades[semester] = grade
+	}
+
+	req := &gpb.GetCourseGradesRequest{
+		Token: "test-token", CourseID: courseID, Semesters: []string{"Fall2024", "Spring2025"},
+	}
+	first, err := client.GetCourseGrades(context.Background(), req)
+	require.NoError(t, err)
+	require.NotEmpty(t, first.GetEtag())
+
+	single, err := client.GetCourseGrades(context.Background(), &gpb.GetCourseGradesRequest{
+		Token: "test-token", CourseID: courseID, Semester: "Fall2024",
+	})
+	require.NoError(t, err)
+	assert.NotEqual(t, single.GetEtag(), first.GetEtag(), "the ETag covers every requested semester")
+
+	req.IfNoneMatch = first.GetEtag()
+	cached, err := client.GetCourseGrades(context.Background(), req)
+	require.NoError(t, err)
+	assert.True(t, cached.GetNotModified())
+	assert.Empty(t, cached.GetSemesterGrades())
+
+	// A change to any of the semesters changes the ETag.
+	_, err = client.UpdateSingleGrade(context.Background(), &gpb.UpdateSingleGradeRequest{
+		Token: "test-token", Grade: &gpb.SingleGrade{GradeID: grades["Spring2025"].GetGradeID(), GradeValue: "B"},
+	})
+	require.NoError(t, err)
+
+	changed, err := client.GetCourseGrades(context.Background(), req)
+	require.NoError(t, err)
+	assert.False(t, changed.GetNotModified())
+	assert.NotEqual(t, first.GetEtag(), changed.GetEtag())
+	require.Len(t, changed.GetSemesterGrades(), 2)
+
+	database.down.Store(true)
+
+	stale, err := client.GetCourseGrades(context.Background(), req)
+	require.NoError(t, err)
+	assert.True(t, stale.GetStale())
+	require.Len(t, stale.GetSemesterGrades(), 2)
+
+	for _, group := range stale.GetSemesterGrades() {
+		require.Len(t, group.GetGrades(), 1)
+		assert.Equal(t, grades[group.GetSemester()].GetGradeID(), group.GetGrades()[0].GetGradeID())
+	}
+}
+
+func TestGetGradeProvenance(t *testing.T) {
+	client := setupClient(t, func(s *GradesServer) {
+		verifiedCaller(roleAuditor)(s)
+	})
+	token := tokenFor("auditor-1")
+
 	grade := createTestGrade()
-	_, err := client.AddSingleGrade(context.Background(), &gpb.AddSingleGradeRequest{
-		Token: "test-token",
-		Grade: grade,
+	_, err := client.AddSingleGrade(context.Background(), &gpb.AddSingleGradeRequest{Token: token, Grade: grade})
+	require.NoError(t, err)
+
+	req := &gpb.GetGradeProvenanceRequest{Token: token, GradeID: grade.GetGradeID()}
+
+	// Without changes or reads only the creation is reported.
+	resp, err := client.GetGradeProvenance(context.Background(), req)
+	require.NoError(t, err)
+	require.Len(t, resp.GetEvents(), 1)
+	assert.Equal(t, provenanceCreated, resp.GetEvents()[0].GetKind())
+	assert.Equal(t, grade.GetGradeValue(), resp.GetEvents()[0].GetGradeValue())
+	assert.Equal(t, grade.GetGradedBy(), resp.GetEvents()[0].GetActor())
+
+	_, err = client.GetGrade(context.Background(), &gpb.GetGradeRequest{Token: token, GradeID: grade.GetGradeID()})
+	require.NoError(t, err)
+
+	_, err = client.UpdateSingleGrade(context.Background(), &gpb.UpdateSingleGradeRequest{
+		Token: token,
+		Grade: &gpb.SingleGrade{GradeID: grade.GetGradeID(), GradeValue: "B", GradedBy: "Professor Y"},
 	})
 	require.NoError(t, err)
 
-	req := &gpb.RemoveSingleGradeRequest{Token: "test-token", GradeID: grade.GetGradeID()}
-	_, err = client.RemoveSingleGrade(context.Background(), req)
+	resp, err = client.GetGradeProvenance(context.Background(), req)
+	require.NoError(t, err)
+
+	events := resp.GetEvents()
+	require.Len(t, events, 3)
+	assert.Equal(t, provenanceCreated, events[0].GetKind())
+	assert.Equal(t, provenanceViewed, events[1].GetKind())
+	assert.Equal(t, "auditor-1", events[1].GetActor())
+	assert.Equal(t, provenanceUpdated, events[2].GetKind())
+	assert.Equal(t, "B", events[2].GetGradeValue())
+	assert.Equal(t, "Professor Y", events[2].GetActor())
+	assert.Equal(t, int64(2), events[2].GetVersion())
+
+	for i := 1; i < len(events); i++ {
+		previous, err := time.Parse(time.RFC3339Nano, events[i-1].GetOccurredAt())
+		require.NoError(t, err)
+		current, err := time.Parse(time.RFC3339Nano, events[i].GetOccurredAt())
+		require.NoError(t, err)
+		assert.False(t, current.Before(previous))
+	}
+
+	_, err = client.RemoveSingleGrade(context.Background(), &gpb.RemoveSingleGradeRequest{
+		Token: token, GradeID: grade.GetGradeID(),
+	})
+	require.NoError(t, err)
+
+	resp, err = client.GetGradeProvenance(context.Background(), req)
 	require.NoError(t, err)
+	require.Len(t, resp.GetEvents(), 4, "a removed grade keeps its provenance")
+	assert.Equal(t, provenanceDeleted, resp.GetEvents()[3].GetKind())
+	assert.Equal(t, "auditor-1", resp.GetEvents()[3].GetActor())
 }
 
-func TestGetStudentSemesterGrades(t *testing.T) {
-	client := setupClient(t)
+func TestGetGradeProvenanceRequiresRole(t *testing.T) {
+	client := setupClient(t, func(s *GradesServer) {
+		s.Claims = RoleClaims{role: "student"}
+	})
+
+	_, err := client.GetGradeProvenance(context.Background(), &gpb.GetGradeProvenanceRequest{
+		Token: "test-token", GradeID: uuid.New().String(),
+	})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestGradeSchemeAutoRejectsInvalidValues(t *testing.T) {
+	client := setupClient(t, func(s *GradesServer) {
+		s.validator = &Validator{scheme: schemeAuto}
+	})
+
+	for _, value := range []string{"85", "Pass", "B+"} {
+		grade := createTestGrade()
+		grade.GradeValue = value
+		_, err := client.AddSingleGrade(context.Background(), &gpb.AddSingleGradeRequest{Token: "test-token", Grade: grade})
+		require.NoError(t, err, value)
+	}
+
 	grade := createTestGrade()
-	_, err := client.AddSingleGrade(context.Background(), &gpb.AddSingleGradeRequest{
-		Token: "test-token",
-		Grade: grade,
+	grade.GradeValue = "110"
+	_, err := client.AddSingleGrade(context.Background(), &gpb.AddSingleGradeRequest{Token: "test-token", Grade: grade})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	valid := createTestGrade()
+	_, err = client.AddSingleGrade(context.Background(), &gpb.AddSingleGradeRequest{Token: "test-token", Grade: valid})
+	require.NoError(t, err)
+
+	_, err = client.UpdateSingleGrade(context.Background(), &gpb.UpdateSingleGradeRequest{
+		Token: "test-token", Grade: &gpb.SingleGrade{GradeID: valid.GetGradeID(), GradeValue: "excellent"},
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestCompareCourseSemesters(t *testing.T) {
+	client := setupClient(t)
+	courseID := uuid.New().String()
+
+	for _, grade := range []*gpb.SingleGrade{
+		{StudentID: "matched", CourseID: courseID, Semester: "Winter_2023", GradeValue: "70"},
+		{StudentID: "matched", CourseID: courseID, Semester: "Winter_2024", GradeValue: "85"},
+		{StudentID: "only-a", CourseID: courseID, Semester: "Winter_2023", GradeValue: "90"},
+	} {
+		_, err := client.AddSingleGrade(context.Background(), &gpb.AddSingleGradeRequest{Token: "test-token", Grade: grade})
+		require.NoError(t, err)
+	}
+
+	resp, err := client.CompareCourseSemesters(context.Background(), &gpb.CompareCourseSemestersRequest{
+		Token: "test-token", CourseID: courseID, SemesterA: "Winter_2023", SemesterB: "Winter_2024",
 	})
 	require.NoError(t, err)
+	require.Len(t, resp.GetStudents(), 2)
+	assert.InDelta(t, 15, resp.GetStudents()[0].GetDelta(), 1e-9)
+	assert.True(t, resp.GetStudents()[1].GetOnlyInA())
+	assert.InDelta(t, 5, resp.GetAverageDelta(), 1e-9)
 
-	req := &gpb.GetStudentSemesterGradesRequest{
-		Token:    "test-token",
-		Semester: grade.GetSemester(), StudentID: grade.GetStudentID(),
+	_, err = client.CompareCourseSemesters(context.Background(), &gpb.CompareCourseSemestersRequest{
+		Token: "test-token", CourseID: courseID, SemesterA: "Winter_2023",
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestGetSemesterGradeTypeSummary(t *testing.T) {
+	client := setupClient(t)
+	semester := "Spring_" + uuid.New().String()
+
+	for _, grade := range []*gpb.SingleGrade{
+		{StudentID: "s1", CourseID: "c1", Semester: semester, GradeType: "Exam", GradeValue: "80"},
+		{StudentID: "s2", CourseID: "c2", Semester: semester, GradeType: "Exam", GradeValue: "90"},
+		{StudentID: "s3", CourseID: "c1", Semester: semester, GradeType: "Exam", GradeValue: "A"},
+		{StudentID: "s1", CourseID: "c1", Semester: semester, GradeType: "Project", GradeValue: "pass"},
+		{StudentID: "s1", CourseID: "c1", Semester: "Other_2023", GradeType: "Exam", GradeValue: "10"},
+	} {
+		_, err := client.AddSingleGrade(context.Background(), &gpb.AddSingleGradeRequest{Token: "test-token", Grade: grade})
+		require.NoError(t, err)
 	}
-	resp, err := client.GetStudentSemesterGrades(context.Background(), req)
+
+	resp, err := client.GetSemesterGradeTypeSummary(context.Background(), &gpb.GetSemesterGradeTypeSummaryRequest{
+		Token: "test-token", Semester: semester,
+	})
 	require.NoError(t, err)
-	assert.Equal(t, grade.GetStudentID(), resp.GetGrades()[0].GetStudentID())
+	require.Len(t, resp.GetSummaries(), 2)
+
+	exam, project := resp.GetSummaries()[0], resp.GetSummaries()[1]
+	assert.Equal(t, "Exam", exam.GetGradeType())
+	assert.Equal(t, int64(3), exam.GetCount(), "non-numeric grades are counted")
+	assert.Equal(t, int64(2), exam.GetNumericCount())
+	assert.InDelta(t, 85, exam.GetAverage(), 1e-9, "non-numeric grades are left out of the average")
+	assert.Equal(t, "Project", project.GetGradeType())
+	assert.Equal(t, int64(1), project.GetCount())
+	assert.Zero(t, project.GetAverage())
+
+	empty, err := client.GetSemesterGradeTypeSummary(context.Background(), &gpb.GetSemesterGradeTypeSummaryRequest{
+		Token: "test-token", Semester: "Empty_2023",
+	})
+	require.NoError(t, err)
+	assert.Empty(t, empty.GetSummaries())
 }
@@ -0,0 +1,201 @@
+package main
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	gpb "github.com/BetterGR/grades-microservice/protos"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/klog/v2"
+)
+
+const (
+	// signedExportFormat versions the canonical form of signed exports. It must change with the
+	// exported fields or their encoding.
+	signedExportFormat = "grades-export-v1"
+	// signedExportAlgorithm is the signature algorithm of signed exports.
+	signedExportAlgorithm = "ed25519"
+)
+
+var (
+	ErrSigningKeyInvalid = errors.New("invalid signing key")
+	ErrSigningKeyUnset   = errors.New("signed exports need a signing key, set SIGNING_KEY")
+)
+
+// exportSigner signs exports with the institution's private key.
+type exportSigner struct {
+	key ed25519.PrivateKey
+	// keyID identifies the public key that verifies the signatures, for recipients holding several.
+	keyID string
+}
+
+// parseSigningKey parses SIGNING_KEY: an Ed25519 private key in a PKCS #8 PEM block, or its 32-byte
+// seed in base64.
+func parseSigningKey(spec string) (*exportSigner, error) {
+	var key ed25519.PrivateKey
+
+	if block, _ := pem.Decode([]byte(spec)); block != nil {
+		parsed, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrSigningKeyInvalid, err)
+		}
+
+		edKey, ok := parsed.(ed25519.PrivateKey)
+		if !ok {
+			return nil, fmt.Errorf("%w: not an Ed25519 key", ErrSigningKeyInvalid)
+		}
+
+		key = edKey
+	} else {
+		seed, err := base64.StdEncoding.DecodeString(strings.TrimSpace(spec))
+		if err != nil {
+			return nil, fmt.Errorf("%w: neither PEM nor base64", ErrSigningKeyInvalid)
+		}
+
+		if len(seed) != ed25519.SeedSize {
+			return nil, fmt.Errorf("%w: seed must be %d bytes, got %d", ErrSigningKeyInvalid,
+				ed25519.SeedSize, len(seed))
+		}
+
+		key = ed25519.NewKeyFromSeed(seed)
+	}
+
+	publicKey, _ := key.Public().(ed25519.PublicKey)
+	fingerprint := sha256.Sum256(publicKey)
+
+	return &exportSigner{key: key, keyID: hex.EncodeToString(fingerprint[:8])}, nil
+}
+
+// sign returns the detached signature of content.
+func (s *exportSigner) sign(content []byte) []byte {
+	return ed25519.Sign(s.key, content)
+}
+
+// signedExportGrade is a grade in the canonical form of a signed export. Field order is fixed by
+// the struct, so the encoding never varies.
+type signedExportGrade struct {
+	GradeID    string `json:"grade_id"`
+	StudentID  string `json:"student_id"`
+	CourseID   string `json:"course_id"`
+	Semester   string `json:"semester"`
+	GradeType  string `json:"grade_type"`
+	ItemID     string `json:"item_id"`
+	GradeValue string `json:"grade_value"`
+	GradedBy   string `json:"graded_by"`
+	Version    int64  `json:"version"`
+	GradedAt   string `json:"graded_at"`
+	UpdatedAt  string `json:"updated_at"`
+}
+
+// signedExportDocument is the canonical form of a signed export.
+type signedExportDocument struct {
+	Format     string              `json:"format"`
+	CourseID   string              `json:"course_id"`
+	Semester   string              `json:"semester"`
+	ExportedAt string              `json:"exported_at"`
+	Grades     []signedExportGrade `json:"grades"`
+}
+
+// canonicalTime formats a time in UTC at the microsecond precision the database stores.
+func canonicalTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+
+	return t.UTC().Truncate(time.Microsecond).Format(time.RFC3339Nano)
+}
+
+// canonicalExport encodes the grades of a course deterministically: compact JSON with fixed field
+// order, grades ordered by ID and times in UTC, so the same grades always sign the same bytes.
+func canonicalExport(courseID, semester string, exportedAt time.Time, grades []*Grade,
+	rounder GradeRounder,
+) ([]byte, error) {
+	doc := signedExportDocument{
+		Format:     signedExportFormat,
+		CourseID:   courseID,
+		Semester:   semester,
+		ExportedAt: canonicalTime(exportedAt),
+		Grades:     make([]signedExportGrade, 0, len(grades)),
+	}
+
+	for _, grade := range grades {
+		doc.Grades = append(doc.Grades, signedExportGrade{
+			GradeID:    grade.GradeID,
+			StudentID:  grade.StudentID,
+			CourseID:   grade.CourseID,
+			Semester:   grade.Semester,
+			GradeType:  grade.GradeType,
+			ItemID:     grade.ItemID,
+			GradeValue: rounder.Round(grade.GradeValue),
+			GradedBy:   grade.GradedBy,
+			Version:    grade.Version,
+			GradedAt:   canonicalTime(grade.GradedAt),
+			UpdatedAt:  canonicalTime(grade.UpdatedAt),
+		})
+	}
+
+	sort.Slice(doc.Grades, func(i, j int) bool {
+		return doc.Grades[i].GradeID < doc.Grades[j].GradeID
+	})
+
+	content, err := json.Marshal(doc)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode export: %w", err)
+	}
+
+	return content, nil
+}
+
+// ExportCourseGradesSigned exports the grades of a course in a semester for official transcripts:
+// the canonical JSON content and a detached Ed25519 signature over it with the institution's key,
+// so recipients can verify the content came from the institution unaltered. Requires the staff or
+// admin role.
+func (s *GradesServer) ExportCourseGradesSigned(ctx context.Context,
+	req *gpb.ExportCourseGradesSignedRequest,
+) (*gpb.ExportCourseGradesSignedResponse, error) {
+	if err := s.requireRole(ctx, req.GetToken(), roleStaff, roleAdmin); err != nil {
+		return nil, err
+	}
+
+	logger := klog.FromContext(ctx)
+	logger.V(logLevelDebug).Info("Received request to export signed course grades", "course_id", req.GetCourseID(),
+		"semester", req.GetSemester())
+
+	if s.signer == nil {
+		return nil, fmt.Errorf("failed to export signed course grades: %w",
+			status.Error(codes.FailedPrecondition, ErrSigningKeyUnset.Error()))
+	}
+
+	grades, err := s.db.GetCourseGrades(ctx, req.GetCourseID(), req.GetSemester())
+	if errors.Is(err, ErrCourseIDEmpty) {
+		return nil, fmt.Errorf("failed to export signed course grades: %w",
+			status.Error(codes.InvalidArgument, err.Error()))
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to export signed course grades: %w", err)
+	}
+
+	content, err := canonicalExport(req.GetCourseID(), req.GetSemester(), time.Now(), grades, s.rounder)
+	if err != nil {
+		return nil, fmt.Errorf("failed to export signed course grades: %w", err)
+	}
+
+	return &gpb.ExportCourseGradesSignedResponse{
+		Content:   content,
+		Signature: s.signer.sign(content),
+		Algorithm: signedExportAlgorithm,
+		KeyID:     s.signer.keyID,
+		Filename:  req.GetCourseID() + "_" + req.GetSemester() + ".json",
+	}, nil
+}
@@ -0,0 +1,123 @@
+package main
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"testing"
+	"time"
+
+	"github.com/BetterGR/grades-microservice/clientlib"
+	gpb "github.com/BetterGR/grades-microservice/protos"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestParseSigningKey(t *testing.T) {
+	seed := make([]byte, ed25519.SeedSize)
+	_, err := rand.Read(seed)
+	require.NoError(t, err)
+
+	fromSeed, err := parseSigningKey(base64.StdEncoding.EncodeToString(seed))
+	require.NoError(t, err)
+
+	der, err := x509.MarshalPKCS8PrivateKey(ed25519.NewKeyFromSeed(seed))
+	require.NoError(t, err)
+
+	fromPEM, err := parseSigningKey(string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})))
+	require.NoError(t, err)
+	assert.Equal(t, fromSeed.key, fromPEM.key)
+	assert.Equal(t, fromSeed.keyID, fromPEM.keyID)
+	assert.Len(t, fromSeed.keyID, 16)
+
+	for _, spec := range []string{"not a key", base64.StdEncoding.EncodeToString(seed[:16])} {
+		_, err = parseSigningKey(spec)
+		require.ErrorIs(t, err, ErrSigningKeyInvalid, spec)
+	}
+}
+
+func TestCanonicalExport(t *testing.T) {
+	graded := time.Date(2024, 1, 2, 3, 4, 5, 123456789, time.FixedZone("IST", 2*60*60))
+	exportedAt := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+	grades := []*Grade{
+		{GradeID: "g2", StudentID: "s2", GradeValue: "80", GradedAt: graded},
+		{GradeID: "g1", StudentID: "s1", GradeValue: "90", GradedAt: graded.UTC()},
+	}
+
+	content, err := canonicalExport("c1", "Winter_2024", exportedAt, grades, roundingNone)
+	require.NoError(t, err)
+
+	reordered, err := canonicalExport("c1", "Winter_2024", exportedAt, []*Grade{grades[1], grades[0]}, roundingNone)
+	require.NoError(t, err)
+	assert.Equal(t, content, reordered, "the order grades are read in does not matter")
+
+	var doc signedExportDocument
+	require.NoError(t, json.Unmarshal(content, &doc))
+	assert.Equal(t, signedExportFormat, doc.Format)
+	require.Len(t, doc.Grades, 2)
+	assert.Equal(t, "g1", doc.Grades[0].GradeID)
+	assert.Equal(t, "2024-01-02T01:04:05.123456Z", doc.Grades[0].GradedAt)
+	assert.Equal(t, doc.Grades[0].GradedAt, doc.Grades[1].GradedAt, "times are canonical whatever their zone")
+}
+
+func TestExportCourseGradesSigned(t *testing.T) {
+	publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	db := NewMockDatabase()
+	client := setupClient(t, func(s *GradesServer) {
+		s.db = db
+		s.signer = &exportSigner{key: privateKey, keyID: "test"}
+	})
+
+	for _, studentID := range []string{"s1", "s2"} {
+		grade := createTestGrade()
+		grade.CourseID, grade.StudentID = "c1", studentID
+		_, err := db.AddGrade(context.Background(), grade)
+		require.NoError(t, err)
+	}
+
+	resp, err := client.ExportCourseGradesSigned(context.Background(), &gpb.ExportCourseGradesSignedRequest{
+		Token: "test-token", CourseID: "c1", Semester: "Winter_2023",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, clientlib.SignedExportAlgorithm, resp.GetAlgorithm())
+	assert.Equal(t, "test", resp.GetKeyID())
+	require.NoError(t, clientlib.VerifySignedExport(publicKey, resp.GetContent(), resp.GetSignature()))
+
+	var doc signedExportDocument
+	require.NoError(t, json.Unmarshal(resp.GetContent(), &doc))
+	require.Len(t, doc.Grades, 2)
+
+	doc.Grades[0].GradeValue = "100"
+	tampered, err := json.Marshal(doc)
+	require.NoError(t, err)
+	require.ErrorIs(t, clientlib.VerifySignedExport(publicKey, tampered, resp.GetSignature()),
+		clientlib.ErrSignatureInvalid, "a tampered export fails verification")
+}
+
+func TestExportCourseGradesSignedErrors(t *testing.T) {
+	unsigned := setupClient(t)
+	_, err := unsigned.ExportCourseGradesSigned(context.Background(), &gpb.ExportCourseGradesSignedRequest{
+		Token: "test-token", CourseID: "c1", Semester: "Winter_2023",
+	})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err), "exports are never sent unsigned")
+
+	_, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	student := setupClient(t, func(s *GradesServer) {
+		s.Claims = RoleClaims{role: roleStudent}
+		s.signer = &exportSigner{key: privateKey}
+	})
+	_, err = student.ExportCourseGradesSigned(context.Background(), &gpb.ExportCourseGradesSignedRequest{
+		Token: "test-token", CourseID: "c1", Semester: "Winter_2023",
+	})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
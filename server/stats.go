@@ -0,0 +1,414 @@
+package main
+
+import (
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Trend classifications of a student's grades in a course.
+const (
+	trendImproving        = "improving"
+	trendDeclining        = "declining"
+	trendStable           = "stable"
+	trendInsufficientData = "insufficient data"
+)
+
+// Grading progress classifications of an item.
+const (
+	progressComplete = "complete"
+	progressOnTrack  = "on track"
+	progressBehind   = "behind"
+	progressStalled  = "stalled"
+)
+
+const (
+	// velocityWindowDays is the number of recent days, today included, the grading velocity averages over.
+	velocityWindowDays = 7
+	// activityDateLayout formats the days of the grading activity histogram.
+	activityDateLayout = "2006-01-02"
+)
+
+// trendTolerance is the per-item slope, in grade points, below which grades are considered stable.
+const trendTolerance = 0.5
+
+// parseNumericGrade parses a grade value as a number, reporting false for non-numeric grades such as letters.
+func parseNumericGrade(value string) (float64, bool) {
+	number, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+	if err != nil || math.IsNaN(number) || math.IsInf(number, 0) {
+		return 0, false
+	}
+
+	return number, true
+}
+
+// gradeSegments groups grades by the kind of their value, so numeric statistics never mix in
+// letter grades and invalid values are kept apart.
+type gradeSegments struct {
+	numeric []*Grade
+	letter  []*Grade
+	invalid []*Grade
+}
+
+// segmentGrades splits grades into numeric, letter and invalid values.
+func segmentGrades(grades []*Grade) gradeSegments {
+	var segments gradeSegments
+
+	for _, grade := range grades {
+		switch classifyGradeValue(grade.GradeValue) {
+		case gradeKindNumeric:
+			segments.numeric = append(segments.numeric, grade)
+		case gradeKindLetter:
+			segments.letter = append(segments.letter, grade)
+		default:
+			segments.invalid = append(segments.invalid, grade)
+		}
+	}
+
+	return segments
+}
+
+// gradeTrend classifies whether numeric grades improve over time. The grades are ordered by
+// graded_at and the least-squares slope of their rounded values is compared to trendTolerance.
+// Only the numeric segment is used.
+func gradeTrend(grades []*Grade, rounder GradeRounder) string {
+	numeric := segmentGrades(grades).numeric
+
+	if len(numeric) < 2 {
+		return trendInsufficientData
+	}
+
+	sort.SliceStable(numeric, func(i, j int) bool {
+		return numeric[i].GradedAt.Before(numeric[j].GradedAt)
+	})
+
+	// Fit value = a + slope * position over the items in grading order.
+	var sumX, sumY, sumXY, sumXX float64
+
+	for i, grade := range numeric {
+		x := float64(i)
+		y, _ := parseNumericGrade(grade.GradeValue)
+		y = rounder.RoundNumber(y)
+		sumX += x
+		sumY += y
+		sumXY += x * y
+		sumXX += x * x
+	}
+
+	n := float64(len(numeric))
+	slope := (n*sumXY - sumX*sumY) / (n*sumXX - sumX*sumX)
+
+	switch {
+	case slope > trendTolerance:
+		return trendImproving
+	case slope < -trendTolerance:
+		return trendDeclining
+	default:
+		return trendStable
+	}
+}
+
+// dailyGradingActivity counts the students graded for an item per UTC day, keyed by activityDateLayout.
+// Only the first grade of each student counts.
+func dailyGradingActivity(grades []*Grade, itemID string) map[string]int {
+	first := make(map[string]time.Time)
+
+	for _, grade := range grades {
+		if grade.ItemID != itemID {
+			continue
+		}
+
+		if gradedAt, seen := first[grade.StudentID]; !seen || grade.GradedAt.Before(gradedAt) {
+			first[grade.StudentID] = grade.GradedAt
+		}
+	}
+
+	activity := make(map[string]int)
+	for _, gradedAt := range first {
+		activity[gradedAt.UTC().Format(activityDateLayout)]++
+	}
+
+	return activity
+}
+
+// gradingVelocity is the grading progress of an item toward its deadline.
+type gradingVelocity struct {
+	// gradesPerDay is the average number of students graded per day over the recent window.
+	gradesPerDay float64
+	// graded and remaining count the students graded so far and still to grade.
+	graded, remaining int
+	// completion is the projected completion time, zero when complete or stalled.
+	completion time.Time
+	// status is one of the progress classifications.
+	status string
+	// missesDeadline reports that the projection ends after the deadline, or never ends.
+	missesDeadline bool
+}
+
+// projectGradingVelocity projects when grading of an item finishes at the rate of the last
+// velocityWindowDays days. activity is the per-day histogram from dailyGradingActivity. A zero
+// deadline is never missed.
+func projectGradingVelocity(activity map[string]int, rosterSize int, deadline, now time.Time) gradingVelocity {
+	var velocity gradingVelocity
+
+	for _, count := range activity {
+		velocity.graded += count
+	}
+
+	today := now.UTC().Truncate(24 * time.Hour)
+	recent := 0
+
+	for day := range velocityWindowDays {
+		recent += activity[today.AddDate(0, 0, -day).Format(activityDateLayout)]
+	}
+
+	velocity.gradesPerDay = float64(recent) / velocityWindowDays
+	velocity.remaining = max(rosterSize-velocity.graded, 0)
+
+	switch {
+	case velocity.remaining == 0:
+		velocity.status = progressComplete
+
+		return velocity
+	case recent == 0:
+		velocity.status = progressStalled
+		velocity.missesDeadline = !deadline.IsZero()
+
+		return velocity
+	}
+
+	days := float64(velocity.remaining) / velocity.gradesPerDay
+	velocity.completion = now.Add(time.Duration(days * float64(24*time.Hour)))
+	velocity.missesDeadline = !deadline.IsZero() && velocity.completion.After(deadline)
+
+	velocity.status = progressOnTrack
+	if velocity.missesDeadline {
+		velocity.status = progressBehind
+	}
+
+	return velocity
+}
+
+// semesterDelta compares a student's average numeric grade in a course between two semesters.
+type semesterDelta struct {
+	studentID          string
+	averageA, averageB float64
+	// delta is averageB - averageA, zero unless the student was graded in both semesters.
+	delta float64
+	// onlyA and onlyB flag students graded in a single semester.
+	onlyA, onlyB bool
+}
+
+// studentAverages returns the average rounded numeric grade of each graded student and the average
+// over all numeric grades. Students with only non-numeric grades average zero, as does a set of
+// grades with no numeric value.
+func studentAverages(grades []*Grade, rounder GradeRounder) (map[string]float64, float64) {
+	type accumulator struct {
+		sum   float64
+		count int
+	}
+
+	students := make(map[string]*accumulator)
+
+	var class accumulator
+
+	for _, grade := range grades {
+		student, ok := students[grade.StudentID]
+		if !ok {
+			student = &accumulator{}
+			students[grade.StudentID] = student
+		}
+
+		value, ok := parseNumericGrade(grade.GradeValue)
+		if !ok {
+			continue
+		}
+
+		value = rounder.RoundNumber(value)
+		student.sum += value
+		student.count++
+		class.sum += value
+		class.count++
+	}
+
+	averages := make(map[string]float64, len(students))
+	for studentID, student := range students {
+		averages[studentID] = 0
+		if student.count > 0 {
+			averages[studentID] = student.sum / float64(student.count)
+		}
+	}
+
+	if class.count == 0 {
+		return averages, 0
+	}
+
+	return averages, class.sum / float64(class.count)
+}
+
+// compareSemesters matches the students of a course across two semesters by student ID and
+// returns their deltas ordered by student ID, along with the class average of each semester.
+func compareSemesters(gradesA, gradesB []*Grade, rounder GradeRounder) ([]semesterDelta, float64, float64) {
+	averagesA, classA := studentAverages(gradesA, rounder)
+	averagesB, classB := studentAverages(gradesB, rounder)
+
+	deltas := make([]semesterDelta, 0, len(averagesA)+len(averagesB))
+
+	for studentID, averageA := range averagesA {
+		delta := semesterDelta{studentID: studentID, averageA: averageA}
+
+		if averageB, ok := averagesB[studentID]; ok {
+			delta.averageB = averageB
+			delta.delta = averageB - averageA
+		} else {
+			delta.onlyA = true
+		}
+
+		deltas = append(deltas, delta)
+	}
+
+	for studentID, averageB := range averagesB {
+		if _, ok := averagesA[studentID]; !ok {
+			deltas = append(deltas, semesterDelta{studentID: studentID, averageB: averageB, onlyB: true})
+		}
+	}
+
+	sort.Slice(deltas, func(i, j int) bool { return deltas[i].studentID < deltas[j].studentID })
+
+	return deltas, classA, classB
+}
+
+// gradeBucket counts the grades sharing a rounded value.
+type gradeBucket struct {
+	value string
+	count int
+}
+
+// gradeDistribution counts the grades per rounded value. Numeric values come first in ascending
+// order, followed by the other values in alphabetical order.
+func gradeDistribution(grades []*Grade, rounder GradeRounder) []gradeBucket {
+	counts := make(map[string]int)
+
+	for _, grade := range grades {
+		counts[rounder.Round(grade.GradeValue)]++
+	}
+
+	buckets := make([]gradeBucket, 0, len(counts))
+	for value, count := range counts {
+		buckets = append(buckets, gradeBucket{value: value, count: count})
+	}
+
+	sort.Slice(buckets, func(i, j int) bool {
+		numberI, numericI := parseNumericGrade(buckets[i].value)
+		numberJ, numericJ := parseNumericGrade(buckets[j].value)
+
+		switch {
+		case numericI && numericJ:
+			return numberI < numberJ
+		case numericI != numericJ:
+			return numericI
+		default:
+			return buckets[i].value < buckets[j].value
+		}
+	})
+
+	return buckets
+}
+
+// gradePercentiles locates the numeric grades of a course within their distribution.
+type gradePercentiles struct {
+	// ranks maps each rounded value to the percentage of grades at or below it.
+	ranks map[float64]float64
+	// p25, p50 and p75 are the quartile boundaries, interpolated between neighbouring grades.
+	p25, p50, p75 float64
+	count         int
+}
+
+// computePercentiles computes the percentile rank of every rounded numeric grade and the quartile
+// boundaries. Non-numeric grades are left out. The highest grade, and so the only grade of a
+// single-grade course, ranks 100; without numeric grades there are no ranks and the quartiles are zero.
+func computePercentiles(grades []*Grade, rounder GradeRounder) gradePercentiles {
+	values := make([]float64, 0, len(grades))
+
+	for _, grade := range segmentGrades(grades).numeric {
+		number, _ := parseNumericGrade(grade.GradeValue)
+		values = append(values, rounder.RoundNumber(number))
+	}
+
+	sort.Float64s(values)
+
+	percentiles := gradePercentiles{ranks: make(map[float64]float64), count: len(values)}
+	if len(values) == 0 {
+		return percentiles
+	}
+
+	// Values are sorted, so the last index of each value counts the grades at or below it.
+	for i, value := range values {
+		percentiles.ranks[value] = 100 * float64(i+1) / float64(len(values))
+	}
+
+	percentiles.p25 = quantile(values, 0.25)
+	percentiles.p50 = quantile(values, 0.5)
+	percentiles.p75 = quantile(values, 0.75)
+
+	return percentiles
+}
+
+// quantile returns the q-quantile of sorted values, interpolating linearly between the closest
+// ranks. sorted must not be empty.
+func quantile(sorted []float64, q float64) float64 {
+	position := q * float64(len(sorted)-1)
+	lower := int(math.Floor(position))
+	upper := int(math.Ceil(position))
+
+	return sorted[lower] + (position-float64(lower))*(sorted[upper]-sorted[lower])
+}
+
+// gradeStatistics summarizes the grade values that parse as numbers.
+type gradeStatistics struct {
+	// count is the number of numeric values and skipped the number of other values.
+	count, skipped int
+	mean, median   float64
+	min, max       float64
+	// stdDev is the population standard deviation.
+	stdDev float64
+}
+
+// computeGradeStatistics computes the mean, median, minimum, maximum and population standard
+// deviation of the grade values that parse as numbers, counting the others as skipped. Every
+// statistic is zero without numeric values.
+func computeGradeStatistics(grades []*Grade) gradeStatistics {
+	var (
+		stats   gradeStatistics
+		numeric []*Grade
+	)
+
+	values := make([]float64, 0, len(grades))
+
+	for _, grade := range grades {
+		number, ok := parseNumericGrade(grade.GradeValue)
+		if !ok {
+			stats.skipped++
+
+			continue
+		}
+
+		numeric = append(numeric, grade)
+		values = append(values, number)
+	}
+
+	stats.count = len(values)
+	if stats.count == 0 {
+		return stats
+	}
+
+	sort.Float64s(values)
+
+	stats.mean, stats.stdDev = numericMoments(numeric)
+	stats.median = quantile(values, 0.5)
+	stats.min, stats.max = values[0], values[len(values)-1]
+
+	return stats
+}
@@ -0,0 +1,241 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// gradesAt builds grades with the given values, graded one day apart.
+func gradesAt(values ...string) []*Grade {
+	start := time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC)
+	grades := make([]*Grade, 0, len(values))
+
+	for i, value := range values {
+		grades = append(grades, &Grade{GradeValue: value, GradedAt: start.AddDate(0, 0, i)})
+	}
+
+	return grades
+}
+
+func TestParseNumericGrade(t *testing.T) {
+	value, ok := parseNumericGrade(" 87.5 ")
+	assert.True(t, ok)
+	assert.InDelta(t, 87.5, value, 0)
+
+	for _, invalid := range []string{"A", "", "NaN", "Inf", "pass"} {
+		_, ok := parseNumericGrade(invalid)
+		assert.False(t, ok, invalid)
+	}
+}
+
+func TestGradeTrend(t *testing.T) {
+	tests := []struct {
+		name   string
+		grades []*Grade
+		want   string
+	}{
+		{"improving", gradesAt("60", "70", "75", "90"), trendImproving},
+		{"declining", gradesAt("95", "88", "80", "71"), trendDeclining},
+		{"stable", gradesAt("80", "80.2", "79.9", "80"), trendStable},
+		{"two grades", gradesAt("50", "90"), trendImproving},
+		{"ignores letter grades", gradesAt("90", "A", "80", "B"), trendDeclining},
+		{"single numeric grade", gradesAt("A", "85"), trendInsufficientData},
+		{"no grades", nil, trendInsufficientData},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, gradeTrend(tt.grades, roundingNone))
+		})
+	}
+}
+
+func TestGradeTrendOrdersByGradedAt(t *testing.T) {
+	grades := gradesAt("60", "70", "80")
+	// Reverse the input order; the trend still follows the grading time.
+	grades[0], grades[2] = grades[2], grades[0]
+
+	assert.Equal(t, trendImproving, gradeTrend(grades, roundingNone))
+}
+
+func TestGradeTrendUsesRounding(t *testing.T) {
+	grades := gradesAt("89.6", "90", "90.4")
+
+	// Unrounded, the grades rise by 0.4 per item which is still stable.
+	assert.Equal(t, trendStable, gradeTrend(grades, roundingNone))
+	// Rounded to integers they all equal 90.
+	assert.Equal(t, trendStable, gradeTrend(grades, roundingInteger))
+
+	grades = gradesAt("88.5", "89.5", "90.5")
+	// Ties to even turn 88.5, 89.5, 90.5 into 88, 90, 90.
+	assert.Equal(t, trendImproving, gradeTrend(grades, roundingInteger))
+}
+
+func TestDailyGradingActivity(t *testing.T) {
+	day := time.Date(2025, time.March, 3, 10, 0, 0, 0, time.UTC)
+	grades := []*Grade{
+		{StudentID: "s1", ItemID: "hw1", GradedAt: day},
+		{StudentID: "s2", ItemID: "hw1", GradedAt: day.Add(time.Hour)},
+		{StudentID: "s3", ItemID: "hw1", GradedAt: day.AddDate(0, 0, 1)},
+		// A regrade of s1 and another item do not count.
+		{StudentID: "s1", ItemID: "hw1", GradedAt: day.AddDate(0, 0, 2)},
+		{StudentID: "s4", ItemID: "hw2", GradedAt: day},
+	}
+
+	assert.Equal(t, map[string]int{"2025-03-03": 2, "2025-03-04": 1}, dailyGradingActivity(grades, "hw1"))
+}
+
+func TestProjectGradingVelocity(t *testing.T) {
+	now := time.Date(2025, time.March, 10, 12, 0, 0, 0, time.UTC)
+	// 14 students graded during the last 7 days, 6 before that.
+	activity := map[string]int{"2025-03-10": 4, "2025-03-08": 6, "2025-03-04": 4, "2025-03-01": 6}
+
+	// 30 remaining at 2 per day take 15 days.
+	velocity := projectGradingVelocity(activity, 50, time.Time{}, now)
+	assert.InDelta(t, 2.0, velocity.gradesPerDay, 1e-9)
+	assert.Equal(t, 20, velocity.graded)
+	assert.Equal(t, 30, velocity.remaining)
+	assert.Equal(t, now.AddDate(0, 0, 15), velocity.completion)
+	assert.Equal(t, progressOnTrack, velocity.status)
+	assert.False(t, velocity.missesDeadline)
+
+	velocity = projectGradingVelocity(activity, 50, now.AddDate(0, 0, 20), now)
+	assert.Equal(t, progressOnTrack, velocity.status)
+	assert.False(t, velocity.missesDeadline)
+
+	velocity = projectGradingVelocity(activity, 50, now.AddDate(0, 0, 10), now)
+	assert.Equal(t, progressBehind, velocity.status)
+	assert.True(t, velocity.missesDeadline)
+
+	velocity = projectGradingVelocity(activity, 20, now.AddDate(0, 0, 1), now)
+	assert.Equal(t, progressComplete, velocity.status)
+	assert.Equal(t, 0, velocity.remaining)
+	assert.False(t, velocity.missesDeadline)
+}
+
+func TestProjectGradingVelocityStalled(t *testing.T) {
+	now := time.Date(2025, time.March, 10, 12, 0, 0, 0, time.UTC)
+	activity := map[string]int{"2025-02-20": 5}
+
+	velocity := projectGradingVelocity(activity, 50, time.Time{}, now)
+	assert.Equal(t, progressStalled, velocity.status)
+	assert.Zero(t, velocity.gradesPerDay)
+	assert.True(t, velocity.completion.IsZero())
+	assert.False(t, velocity.missesDeadline)
+
+	velocity = projectGradingVelocity(activity, 50, now.AddDate(0, 1, 0), now)
+	assert.True(t, velocity.missesDeadline, "a stalled item never meets its deadline")
+}
+
+func TestCompareSemesters(t *testing.T) {
+	gradesA := []*Grade{
+		{StudentID: "matched", GradeValue: "70"},
+		{StudentID: "matched", GradeValue: "80"},
+		{StudentID: "only-a", GradeValue: "60"},
+	}
+	gradesB := []*Grade{
+		{StudentID: "matched", GradeValue: "90"},
+		{StudentID: "matched", GradeValue: "A"},
+		{StudentID: "only-b", GradeValue: "100"},
+	}
+
+	deltas, averageA, averageB := compareSemesters(gradesA, gradesB, roundingNone)
+
+	assert.Equal(t, []semesterDelta{
+		{studentID: "matched", averageA: 75, averageB: 90, delta: 15},
+		{studentID: "only-a", averageA: 60, onlyA: true},
+		{studentID: "only-b", averageB: 100, onlyB: true},
+	}, deltas)
+	assert.InDelta(t, 70, averageA, 1e-9)
+	assert.InDelta(t, 95, averageB, 1e-9)
+}
+
+func TestGradeDistribution(t *testing.T) {
+	buckets := gradeDistribution(gradesAt("90", "B", "100", "90", "A", "85.4"), roundingInteger)
+
+	assert.Equal(t, []gradeBucket{
+		{value: "85", count: 1},
+		{value: "90", count: 2},
+		{value: "100", count: 1},
+		{value: "A", count: 1},
+		{value: "B", count: 1},
+	}, buckets)
+}
+
+func TestComputePercentiles(t *testing.T) {
+	percentiles := computePercentiles(gradesAt("40", "10", "30", "20", "100", "A", "90", "50", "60", "80", "70", "Pass"),
+		roundingNone)
+
+	assert.Equal(t, 10, percentiles.count, "non-numeric grades are left out")
+	require.Len(t, percentiles.ranks, 10)
+
+	for value := 10; value <= 100; value += 10 {
+		assert.InDelta(t, float64(value), percentiles.ranks[float64(value)], 1e-9, value)
+	}
+
+	assert.InDelta(t, 32.5, percentiles.p25, 1e-9)
+	assert.InDelta(t, 55, percentiles.p50, 1e-9)
+	assert.InDelta(t, 77.5, percentiles.p75, 1e-9)
+}
+
+func TestComputePercentilesTiesAndRounding(t *testing.T) {
+	percentiles := computePercentiles(gradesAt("79.6", "80", "90"), roundingInteger)
+
+	assert.Equal(t, map[float64]float64{80: 100 * 2.0 / 3, 90: 100}, percentiles.ranks)
+	assert.InDelta(t, 80, percentiles.p25, 1e-9)
+	assert.InDelta(t, 80, percentiles.p50, 1e-9)
+	assert.InDelta(t, 85, percentiles.p75, 1e-9)
+}
+
+func TestComputePercentilesSmallCourses(t *testing.T) {
+	single := computePercentiles(gradesAt("72"), roundingNone)
+	assert.Equal(t, map[float64]float64{72: 100}, single.ranks)
+	assert.InDelta(t, 72, single.p25, 1e-9)
+	assert.InDelta(t, 72, single.p75, 1e-9)
+
+	empty := computePercentiles(gradesAt("A", "B"), roundingNone)
+	assert.Zero(t, empty.count)
+	assert.Empty(t, empty.ranks)
+	assert.Zero(t, empty.p50)
+}
+
+func TestComputeGradeStatistics(t *testing.T) {
+	tests := []struct {
+		name   string
+		values []string
+		want   gradeStatistics
+	}{
+		{name: "empty", values: nil, want: gradeStatistics{}},
+		{name: "no numbers", values: []string{"A", "Pass", ""}, want: gradeStatistics{skipped: 3}},
+		{
+			name: "single", values: []string{"72.5"},
+			want: gradeStatistics{count: 1, mean: 72.5, median: 72.5, min: 72.5, max: 72.5},
+		},
+		{
+			name: "even count", values: []string{"9", "2", "5", "4", "4", "7", "5", "4"},
+			want: gradeStatistics{count: 8, mean: 5, median: 4.5, min: 2, max: 9, stdDev: 2},
+		},
+		{
+			name: "mixed", values: []string{"90", "B+", " 70 ", "80", "NaN"},
+			want: gradeStatistics{count: 3, skipped: 2, mean: 80, median: 80, min: 70, max: 90, stdDev: 8.16496580927726},
+		},
+		{
+			name: "out of range numbers count", values: []string{"-10", "110"},
+			want: gradeStatistics{count: 2, mean: 50, median: 50, min: -10, max: 110, stdDev: 60},
+		},
+	}
+
+	for _, tt := range tests {
+		got := computeGradeStatistics(gradesAt(tt.values...))
+		assert.Equal(t, tt.want.count, got.count, tt.name)
+		assert.Equal(t, tt.want.skipped, got.skipped, tt.name)
+		assert.InDelta(t, tt.want.mean, got.mean, 1e-9, tt.name)
+		assert.InDelta(t, tt.want.median, got.median, 1e-9, tt.name)
+		assert.InDelta(t, tt.want.min, got.min, 1e-9, tt.name)
+		assert.InDelta(t, tt.want.max, got.max, 1e-9, tt.name)
+		assert.InDelta(t, tt.want.stdDev, got.stdDev, 1e-9, tt.name)
+	}
+}
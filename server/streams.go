@@ -0,0 +1,200 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/klog/v2"
+)
+
+// defaultShutdownDrainTimeout is how long export streams get to finish on shutdown when
+// SHUTDOWN_DRAIN_TIMEOUT is unset.
+const defaultShutdownDrainTimeout = 30 * time.Second
+
+var (
+	// ErrTooManyStreams is returned when every export stream slot is taken.
+	ErrTooManyStreams = errors.New("too many concurrent export streams")
+	// ErrShuttingDown is returned to export streams stopped or refused because the server shuts down.
+	ErrShuttingDown = errors.New("server is shutting down")
+)
+
+// streamLimiter caps the export streams open at once. Streams beyond the cap are rejected right
+// away instead of waiting, since a client holding streams open could otherwise starve the rest.
+// A nil limiter admits every stream.
+type streamLimiter struct {
+	slots chan struct{}
+}
+
+// newStreamLimiter admits at most maxStreams streams at once, or every stream when maxStreams is 0 or less.
+func newStreamLimiter(maxStreams int) *streamLimiter {
+	if maxStreams <= 0 {
+		return nil
+	}
+
+	return &streamLimiter{slots: make(chan struct{}, maxStreams)}
+}
+
+// acquire takes a slot and returns the function releasing it. It fails with ResourceExhausted when
+// every slot is taken.
+func (l *streamLimiter) acquire() (func(), error) {
+	if l == nil {
+		return func() {}, nil
+	}
+
+	select {
+	case l.slots <- struct{}{}:
+		return func() { <-l.slots }, nil
+	default:
+		return nil, status.Error(codes.ResourceExhausted,
+			fmt.Sprintf("%v: at most %d", ErrTooManyStreams, cap(l.slots)))
+	}
+}
+
+// streamDrain tracks the export streams in flight so shutdown is not held up by them: draining
+// signals every stream to finish, through a shared shutdown context, and cancels those still
+// running after a timeout. A nil drain tracks nothing.
+type streamDrain struct {
+	// shutdown is done once draining starts.
+	shutdown context.Context
+	signal   context.CancelFunc
+
+	mu     sync.Mutex
+	active map[uint64]context.CancelFunc
+	nextID uint64
+	wg     sync.WaitGroup
+}
+
+// newStreamDrain creates a drain with no streams in flight.
+func newStreamDrain() *streamDrain {
+	shutdown, signal := context.WithCancel(context.Background())
+
+	return &streamDrain{shutdown: shutdown, signal: signal, active: make(map[uint64]context.CancelFunc)}
+}
+
+// track registers a stream with context ctx. It returns the context the stream must run with,
+// which is cancelled when the drain times out, and the function to call once the stream is done.
+// It fails with Unavailable once draining has started.
+func (d *streamDrain) track(ctx context.Context) (context.Context, func(), error) {
+	if d == nil {
+		return ctx, func() {}, nil
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	// Checked under the lock, so no stream is added once drain waits for the tracked ones.
+	if d.shutdown.Err() != nil {
+		return nil, nil, status.Error(codes.Unavailable, ErrShuttingDown.Error())
+	}
+
+	streamCtx, cancel := context.WithCancel(ctx)
+	id := d.nextID
+	d.nextID++
+	d.active[id] = cancel
+	d.wg.Add(1)
+
+	return streamCtx, func() {
+		d.mu.Lock()
+		delete(d.active, id)
+		d.mu.Unlock()
+
+		cancel()
+		d.wg.Done()
+	}, nil
+}
+
+// stopped returns why a stream running with ctx must stop: Unavailable once draining has started,
+// or the status of ctx's error once it is done. It returns nil while the stream may go on.
+func (d *streamDrain) stopped(ctx context.Context) error {
+	if d != nil && d.shutdown.Err() != nil {
+		return status.Error(codes.Unavailable, ErrShuttingDown.Error())
+	}
+
+	if err := ctx.Err(); err != nil {
+		return status.FromContextError(err).Err()
+	}
+
+	return nil
+}
+
+// draining returns a channel closed once draining starts, or nil for a nil drain.
+func (d *streamDrain) draining() <-chan struct{} {
+	if d == nil {
+		return nil
+	}
+
+	return d.shutdown.Done()
+}
+
+// drain signals the tracked streams to finish and waits up to timeout for them. Streams still
+// running then have their context cancelled, and drain reports false so the caller can close the
+// connections of streams blocked on a send.
+func (d *streamDrain) drain(timeout time.Duration) bool {
+	if d == nil {
+		return true
+	}
+
+	d.mu.Lock()
+	d.signal()
+	d.mu.Unlock()
+
+	finished := make(chan struct{})
+
+	go func() {
+		d.wg.Wait()
+		close(finished)
+	}()
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	select {
+	case <-finished:
+		return true
+	case <-timer.C:
+	}
+
+	d.mu.Lock()
+	for _, cancel := range d.active {
+		cancel()
+	}
+	d.mu.Unlock()
+
+	return false
+}
+
+// stopOnSignal waits for SIGINT or SIGTERM, then stops grpcServer gracefully while draining the
+// export streams, and force-closes every connection if the streams do not finish in drainTimeout.
+// It returns once grpcServer has stopped.
+func (s *GradesServer) stopOnSignal(grpcServer *grpc.Server, drainTimeout time.Duration) {
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGINT, syscall.SIGTERM)
+
+	received := <-signals
+	klog.InfoS("Shutting down", "signal", received.String(), "drain_timeout", drainTimeout)
+
+	s.readiness.shutdown()
+
+	stopped := make(chan struct{})
+
+	go func() {
+		grpcServer.GracefulStop()
+		close(stopped)
+	}()
+
+	if !s.streams.drain(drainTimeout) {
+		klog.InfoS("Export streams still open after the drain timeout, closing connections")
+		grpcServer.Stop()
+	}
+
+	<-stopped
+}
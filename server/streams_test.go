@@ -0,0 +1,169 @@
+package main
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	gpb "github.com/BetterGR/grades-microservice/protos"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestStreamLimiter(t *testing.T) {
+	assert.Nil(t, newStreamLimiter(0), "no cap admits every stream")
+
+	var unlimited *streamLimiter
+
+	release, err := unlimited.acquire()
+	require.NoError(t, err)
+	release()
+
+	limiter := newStreamLimiter(2)
+
+	first, err := limiter.acquire()
+	require.NoError(t, err)
+
+	_, err = limiter.acquire()
+	require.NoError(t, err)
+
+	_, err = limiter.acquire()
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	first()
+
+	_, err = limiter.acquire()
+	require.NoError(t, err, "a released slot is reused")
+}
+
+func TestStreamCourseGradesExportCap(t *testing.T) {
+	limiter := newStreamLimiter(1)
+	client := setupClient(t, func(s *GradesServer) {
+		s.exportStreams = limiter
+	})
+
+	grade := createTestGrade()
+	_, err := client.AddSingleGrade(context.Background(), &gpb.AddSingleGradeRequest{Token: "test-token", Grade: grade})
+	require.NoError(t, err)
+
+	request := &gpb.StreamCourseGradesRequest{
+		Token: "test-token", CourseID: grade.GetCourseID(), Semester: grade.GetSemester(),
+	}
+
+	// Another export holds the only slot.
+	limiter.slots <- struct{}{}
+
+	stream, err := client.StreamCourseGrades(context.Background(), request)
+	require.NoError(t, err)
+
+	_, err = stream.Recv()
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	assert.Contains(t, status.Convert(err).Message(), ErrTooManyStreams.Error())
+
+	<-limiter.slots
+
+	stream, err = client.StreamCourseGrades(context.Background(), request)
+	require.NoError(t, err)
+
+	received, err := stream.Recv()
+	require.NoError(t, err)
+	assert.Equal(t, grade.GetGradeID(), received.GetGradeID())
+
+	_, err = stream.Recv()
+	require.ErrorIs(t, err, io.EOF)
+	assert.Empty(t, limiter.slots, "a finished stream frees its slot")
+}
+
+// hangingDatabase hangs on course grade streams, like a long-running query, until the context of
+// the stream is cancelled.
+type hangingDatabase struct {
+	*MockDatabase
+	started chan struct{}
+}
+
+func (h *hangingDatabase) StreamCourseGrades(ctx context.Context, courseID, semester string,
+	fn func(*Grade) error,
+) error {
+	close(h.started)
+	<-ctx.Done()
+
+	return ctx.Err()
+}
+
+// discardStream accepts and drops every sent grade.
+type discardStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *discardStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *discardStream) Send(*gpb.SingleGrade) error {
+	return nil
+}
+
+func TestStreamDrainCancelsStreamsAfterTimeout(t *testing.T) {
+	db := &hangingDatabase{MockDatabase: NewMockDatabase(), started: make(chan struct{})}
+	server := &GradesServer{db: db, Claims: MockClaims{}, streams: newStreamDrain()}
+
+	done := make(chan error, 1)
+
+	go func() {
+		done <- server.StreamCourseGrades(&gpb.StreamCourseGradesRequest{CourseID: "c1", Semester: "Winter_2023"},
+			&discardStream{ctx: context.Background()})
+	}()
+
+	<-db.started
+
+	start := time.Now()
+	assert.False(t, server.streams.drain(20*time.Millisecond), "the stream ignores the signal")
+	assert.GreaterOrEqual(t, time.Since(start), 20*time.Millisecond)
+
+	select {
+	case err := <-done:
+		assert.Equal(t, codes.Unavailable, status.Code(err))
+		assert.Contains(t, status.Convert(err).Message(), ErrShuttingDown.Error())
+	case <-time.After(time.Second):
+		t.Fatal("the stream was not cancelled after the drain timeout")
+	}
+
+	err := server.StreamCourseGrades(&gpb.StreamCourseGradesRequest{CourseID: "c1", Semester: "Winter_2023"},
+		&discardStream{ctx: context.Background()})
+	assert.Equal(t, codes.Unavailable, status.Code(err), "no stream starts once draining")
+}
+
+func TestStreamDrainSignalsStreams(t *testing.T) {
+	drain := newStreamDrain()
+
+	ctx, done, err := drain.track(context.Background())
+	require.NoError(t, err)
+	require.NoError(t, drain.stopped(ctx))
+
+	finished := make(chan bool, 1)
+
+	go func() {
+		finished <- drain.drain(time.Minute)
+	}()
+
+	// The stream notices the signal at its next grade and finishes.
+	require.Eventually(t, func() bool { return drain.stopped(ctx) != nil }, time.Second, time.Millisecond)
+	assert.Equal(t, codes.Unavailable, status.Code(drain.stopped(ctx)))
+	assert.NoError(t, ctx.Err(), "the stream is not cancelled before the timeout")
+	done()
+
+	assert.True(t, <-finished)
+
+	var untracked *streamDrain
+
+	ctx, done, err = untracked.track(context.Background())
+	require.NoError(t, err)
+	done()
+	assert.NoError(t, untracked.stopped(ctx))
+	assert.True(t, untracked.drain(0))
+}
@@ -0,0 +1,118 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+var (
+	ErrUnknownRequestField    = errors.New("request has a field unknown to the server")
+	ErrDeprecatedRequestField = errors.New("request sets a deprecated field")
+)
+
+// checkRequestFields rejects a request carrying fields the server does not know, which the decoder
+// otherwise drops silently, or setting a deprecated field. Either means the client was built
+// against a different version of the protocol.
+func checkRequestFields(req interface{}) error {
+	if msg, ok := req.(proto.Message); ok {
+		return checkMessageFields(msg.ProtoReflect())
+	}
+
+	return nil
+}
+
+// checkMessageFields checks a message and its nested messages.
+func checkMessageFields(msg protoreflect.Message) error {
+	if unknown := msg.GetUnknown(); len(unknown) > 0 {
+		number, _, _ := protowire.ConsumeTag(unknown)
+
+		return fmt.Errorf("%w: field %d of %s", ErrUnknownRequestField, number, msg.Descriptor().FullName())
+	}
+
+	var err error
+
+	msg.Range(func(field protoreflect.FieldDescriptor, value protoreflect.Value) bool {
+		if options, ok := field.Options().(*descriptorpb.FieldOptions); ok && options.GetDeprecated() {
+			err = fmt.Errorf("%w: %s", ErrDeprecatedRequestField, field.FullName())
+
+			return false
+		}
+
+		switch {
+		case field.IsMap():
+			if field.MapValue().Kind() == protoreflect.MessageKind {
+				value.Map().Range(func(_ protoreflect.MapKey, entry protoreflect.Value) bool {
+					err = checkMessageFields(entry.Message())
+
+					return err == nil
+				})
+			}
+		case field.Kind() == protoreflect.MessageKind && field.IsList():
+			for i := 0; i < value.List().Len() && err == nil; i++ {
+				err = checkMessageFields(value.List().Get(i).Message())
+			}
+		case field.Kind() == protoreflect.MessageKind:
+			err = checkMessageFields(value.Message())
+		}
+
+		return err == nil
+	})
+
+	return err
+}
+
+// strictInterceptor rejects unary requests with unknown or deprecated fields, when enabled.
+func (s *GradesServer) strictInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, _ *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		if s.strictRequests {
+			if err := checkRequestFields(req); err != nil {
+				return nil, status.Error(codes.InvalidArgument, err.Error())
+			}
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// strictStreamInterceptor rejects streaming requests with unknown or deprecated fields as they are
+// received, when enabled.
+func (s *GradesServer) strictStreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, _ *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		if !s.strictRequests {
+			return handler(srv, stream)
+		}
+
+		return handler(srv, strictStream{stream})
+	}
+}
+
+// strictStream checks each request received on a stream.
+type strictStream struct {
+	grpc.ServerStream
+}
+
+// RecvMsg receives a request and checks its fields.
+func (st strictStream) RecvMsg(m interface{}) error {
+	if err := st.ServerStream.RecvMsg(m); err != nil {
+		return fmt.Errorf("failed to receive request: %w", err)
+	}
+
+	if err := checkRequestFields(m); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return nil
+}
@@ -0,0 +1,61 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	gpb "github.com/BetterGR/grades-microservice/protos"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+)
+
+// withStrayField adds a field the server does not know to msg, as a client built against a
+// different version of the protocol would send.
+func withStrayField[M proto.Message](msg M) M {
+	stray := protowire.AppendTag(nil, 99, protowire.BytesType)
+	stray = protowire.AppendString(stray, "student123")
+	msg.ProtoReflect().SetUnknown(stray)
+
+	return msg
+}
+
+func TestCheckRequestFields(t *testing.T) {
+	require.NoError(t, checkRequestFields(&gpb.AddSingleGradeRequest{Token: "t", Grade: createTestGrade()}))
+	require.NoError(t, checkRequestFields("not a message"))
+
+	err := checkRequestFields(withStrayField(&gpb.GetCourseGradesRequest{CourseID: "234218"}))
+	require.ErrorIs(t, err, ErrUnknownRequestField)
+	assert.Contains(t, err.Error(), "field 99")
+
+	// Unknown fields of nested messages are found too.
+	err = checkRequestFields(&gpb.AddSingleGradeRequest{Grade: withStrayField(createTestGrade())})
+	require.ErrorIs(t, err, ErrUnknownRequestField)
+}
+
+func TestStrictRequestsRejectStrayFields(t *testing.T) {
+	strict := setupClient(t, func(s *GradesServer) { s.strictRequests = true })
+	lenient := setupClient(t)
+
+	req := withStrayField(&gpb.GetCourseGradesRequest{Token: "test-token", CourseID: "234218", Semester: "Winter_2023"})
+
+	_, err := strict.GetCourseGrades(context.Background(), req)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = lenient.GetCourseGrades(context.Background(), req)
+	require.NoError(t, err, "stray fields are ignored unless strict")
+
+	_, err = strict.GetCourseGrades(context.Background(),
+		&gpb.GetCourseGradesRequest{Token: "test-token", CourseID: "234218", Semester: "Winter_2023"})
+	require.NoError(t, err)
+
+	stream, err := strict.StreamCourseGrades(context.Background(),
+		withStrayField(&gpb.StreamCourseGradesRequest{Token: "test-token", CourseID: "234218"}))
+	require.NoError(t, err)
+
+	_, err = stream.Recv()
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -0,0 +1,160 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"strconv"
+
+	gpb "github.com/BetterGR/grades-microservice/protos"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/klog/v2"
+)
+
+const (
+	// maxSyntheticGrades is the most grades a single GenerateTestGrades call creates.
+	maxSyntheticGrades = 100000
+	// syntheticItemsPerStudent is the number of items each synthetic student gets a grade for.
+	syntheticItemsPerStudent = 5
+	// syntheticPrefix starts the student and item IDs of synthetic grades.
+	syntheticPrefix = "synthetic-"
+	// syntheticGrader is the grader of every synthetic grade, so they can be found and removed.
+	syntheticGrader = "synthetic-load-test"
+)
+
+var (
+	ErrTestDataDisabled    = errors.New("test data generation is disabled, set ALLOW_TEST_DATA=true")
+	ErrSyntheticCount      = errors.New("invalid synthetic grade count")
+	ErrSyntheticValuesNone = errors.New("the grade scheme accepts none of the synthetic grade values")
+)
+
+// syntheticGradeTypes are the grade types synthetic grades are spread over.
+var syntheticGradeTypes = []string{"Homework", "Quiz", "Midterm", "Final", "Project"}
+
+// syntheticWordGrades are the values drawn for synthetic grades when numbers are not accepted, in
+// order of preference.
+var syntheticWordGrades = [][]string{
+	{"A+", "A", "A-", "B+", "B", "B-", "C+", "C", "C-", "D+", "D", "D-", "F"},
+	{"Pass", "Fail"},
+}
+
+// syntheticValues returns a function drawing grade values the validator accepts: whole numbers
+// between minNumericGrade and maxNumericGrade when the scheme allows them, otherwise letter grades,
+// otherwise pass/fail grades.
+func syntheticValues(validator *Validator, rng *rand.Rand) (func() string, error) {
+	if validator.ValidateGradeValue(strconv.Itoa(maxNumericGrade)) == nil {
+		return func() string {
+			return strconv.Itoa(minNumericGrade + rng.IntN(maxNumericGrade-minNumericGrade+1))
+		}, nil
+	}
+
+	for _, candidates := range syntheticWordGrades {
+		if validator.ValidateGradeValue(candidates[0]) == nil {
+			return func() string { return candidates[rng.IntN(len(candidates))] }, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w", ErrSyntheticValuesNone)
+}
+
+// syntheticGrades builds count synthetic grades of a course in a semester. Each student of the run
+// gets a grade for syntheticItemsPerStudent items, so the grades never share a natural key, and
+// every grade is tagged: the student and item IDs start with syntheticPrefix and the grader is
+// syntheticGrader.
+func syntheticGrades(courseID, semester, run string, count int, value func() string,
+	rng *rand.Rand,
+) []*gpb.SingleGrade {
+	grades := make([]*gpb.SingleGrade, 0, count)
+
+	for i := range count {
+		item := i % syntheticItemsPerStudent
+
+		grades = append(grades, &gpb.SingleGrade{
+			StudentID:  fmt.Sprintf("%s%s-%d", syntheticPrefix, run, i/syntheticItemsPerStudent),
+			CourseID:   courseID,
+			Semester:   semester,
+			GradeType:  syntheticGradeTypes[rng.IntN(len(syntheticGradeTypes))],
+			ItemID:     fmt.Sprintf("%sitem-%d", syntheticPrefix, item),
+			GradeValue: value(),
+			GradedBy:   syntheticGrader,
+			Comments:   "synthetic load test grade, run " + run,
+		})
+	}
+
+	return grades
+}
+
+// GenerateTestGrades inserts synthetic grades with random but valid values into a course, to load
+// the database before benchmarking the read and statistics paths. The grades are tagged for cleanup:
+// student and item IDs start with "synthetic-", the grader is "synthetic-load-test" and the comments
+// name the run, returned in the response. No events or notifications are sent for them. Only
+// available when ALLOW_TEST_DATA is set, and requires the admin role.
+func (s *GradesServer) GenerateTestGrades(ctx context.Context,
+	req *gpb.GenerateTestGradesRequest,
+) (*gpb.GenerateTestGradesResponse, error) {
+	claims, err := s.authorizeRoles(ctx, req.GetToken(), roleAdmin)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx = withActor(ctx, callerIdentity(claims))
+
+	logger := klog.FromContext(ctx)
+	logger.V(logLevelDebug).Info("Received request to generate test grades", "course_id", req.GetCourseID(),
+		"semester", req.GetSemester(), "count", req.GetCount())
+
+	if !s.allowTestData {
+		return nil, fmt.Errorf("failed to generate test grades: %w",
+			status.Error(codes.FailedPrecondition, ErrTestDataDisabled.Error()))
+	}
+
+	switch {
+	case req.GetCourseID() == "":
+		return nil, fmt.Errorf("failed to generate test grades: %w",
+			status.Error(codes.InvalidArgument, ErrCourseIDEmpty.Error()))
+	case req.GetSemester() == "":
+		return nil, fmt.Errorf("failed to generate test grades: %w",
+			status.Error(codes.InvalidArgument, ErrSemesterEmpty.Error()))
+	case req.GetCount() <= 0 || req.GetCount() > maxSyntheticGrades:
+		return nil, fmt.Errorf("failed to generate test grades: %w", status.Error(codes.InvalidArgument,
+			fmt.Sprintf("%v: %d, must be between 1 and %d", ErrSyntheticCount, req.GetCount(), maxSyntheticGrades)))
+	}
+
+	if err := s.validator.ValidateSemester(req.GetSemester()); err != nil {
+		return nil, fmt.Errorf("failed to generate test grades: %w", status.Error(codes.InvalidArgument, err.Error()))
+	}
+
+	rng := rand.New(rand.NewPCG(rand.Uint64(), rand.Uint64()))
+
+	value, err := syntheticValues(s.validator, rng)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate test grades: %w",
+			status.Error(codes.FailedPrecondition, err.Error()))
+	}
+
+	run := uuid.NewString()[:8]
+	grades := syntheticGrades(req.GetCourseID(), req.GetSemester(), run, int(req.GetCount()), value, rng)
+
+	// Insert in batches, so a large load neither holds one huge transaction nor loses everything
+	// inserted so far to a late failure.
+	created := 0
+
+	for start := 0; start < len(grades); start += maxBatchGrades {
+		added, err := s.db.AddGrades(ctx, grades[start:min(start+maxBatchGrades, len(grades))])
+		if err != nil {
+			logger.Error(err, "Failed to generate test grades", "run", run, "created", created)
+
+			return nil, fmt.Errorf("failed to generate test grades after creating %d: %w", created, err)
+		}
+
+		created += len(added)
+	}
+
+	logger.Info("Generated test grades", "course_id", req.GetCourseID(), "semester", req.GetSemester(),
+		"run", run, "created", created)
+
+	return &gpb.GenerateTestGradesResponse{CreatedCount: int32(created), Run: run}, nil
+}
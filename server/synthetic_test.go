@@ -0,0 +1,112 @@
+package main
+
+import (
+	"context"
+	"math/rand/v2"
+	"strings"
+	"testing"
+
+	gpb "github.com/BetterGR/grades-microservice/protos"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestGenerateTestGradesDisabled(t *testing.T) {
+	db := NewMockDatabase()
+	client := setupClient(t, func(s *GradesServer) {
+		s.db = db
+	})
+
+	_, err := client.GenerateTestGrades(context.Background(), &gpb.GenerateTestGradesRequest{
+		Token: "test-token", CourseID: "course-1", Semester: "Winter_2023", Count: 10,
+	})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	grades, err := db.GetCourseGrades(context.Background(), "course-1", "Winter_2023")
+	require.NoError(t, err)
+	assert.Empty(t, grades)
+}
+
+func TestGenerateTestGrades(t *testing.T) {
+	db := NewMockDatabase()
+	client := setupClient(t, func(s *GradesServer) {
+		s.db = db
+		s.allowTestData = true
+	})
+
+	resp, err := client.GenerateTestGrades(context.Background(), &gpb.GenerateTestGradesRequest{
+		Token: "test-token", CourseID: "course-1", Semester: "Winter_2023", Count: 12,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int32(12), resp.GetCreatedCount())
+	require.NotEmpty(t, resp.GetRun())
+
+	grades, err := db.GetCourseGrades(context.Background(), "course-1", "Winter_2023")
+	require.NoError(t, err)
+	require.Len(t, grades, 12)
+
+	keys := make(map[string]bool)
+
+	for _, grade := range grades {
+		assert.Equal(t, syntheticGrader, grade.GradedBy)
+		assert.True(t, strings.HasPrefix(grade.StudentID, syntheticPrefix+resp.GetRun()), grade.StudentID)
+		assert.True(t, strings.HasPrefix(grade.ItemID, syntheticPrefix), grade.ItemID)
+		assert.Contains(t, grade.Comments, resp.GetRun())
+		assert.Contains(t, syntheticGradeTypes, grade.GradeType)
+
+		_, numeric := parseNumericGrade(grade.GradeValue)
+		assert.True(t, numeric, grade.GradeValue)
+
+		key := grade.StudentID + "/" + grade.ItemID
+		assert.False(t, keys[key], "duplicate natural key %s", key)
+		keys[key] = true
+	}
+}
+
+func TestGenerateTestGradesInvalid(t *testing.T) {
+	client := setupClient(t, func(s *GradesServer) {
+		s.allowTestData = true
+	})
+
+	for name, req := range map[string]*gpb.GenerateTestGradesRequest{
+		"no course":   {CourseID: "", Semester: "Winter_2023", Count: 1},
+		"no semester": {CourseID: "course-1", Semester: "", Count: 1},
+		"zero count":  {CourseID: "course-1", Semester: "Winter_2023", Count: 0},
+		"over max":    {CourseID: "course-1", Semester: "Winter_2023", Count: maxSyntheticGrades + 1},
+	} {
+		req.Token = "test-token"
+
+		_, err := client.GenerateTestGrades(context.Background(), req)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err), name)
+	}
+}
+
+func TestGenerateTestGradesRequiresAdmin(t *testing.T) {
+	client := setupClient(t, func(s *GradesServer) {
+		s.allowTestData = true
+		s.Claims = RoleClaims{role: roleStaff}
+	})
+
+	_, err := client.GenerateTestGrades(context.Background(), &gpb.GenerateTestGradesRequest{
+		Token: "test-token", CourseID: "course-1", Semester: "Winter_2023", Count: 1,
+	})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestSyntheticValuesFollowScheme(t *testing.T) {
+	rng := rand.New(rand.NewPCG(1, 2))
+
+	for _, scheme := range []GradeScheme{schemeNumeric, schemeLetter, schemePassFail, schemeAuto} {
+		validator, err := newValidator(scheme, "")
+		require.NoError(t, err)
+
+		value, err := syntheticValues(validator, rng)
+		require.NoError(t, err, scheme)
+
+		for range 20 {
+			assert.NoError(t, validator.ValidateGradeValue(value()), scheme)
+		}
+	}
+}
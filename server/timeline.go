@@ -0,0 +1,83 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	gpb "github.com/BetterGR/grades-microservice/protos"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/klog/v2"
+)
+
+// valueTimeline assembles the value transitions of a grade from all of its versions, oldest first.
+// The oldest version is the creation; later versions that kept the value, such as comment edits,
+// are left out, so a grade whose value never changed has only its creation.
+func valueTimeline(versions []*Grade) []*gpb.GradeTransition {
+	type timedTransition struct {
+		at         time.Time
+		transition *gpb.GradeTransition
+	}
+
+	transitions := make([]timedTransition, 0, len(versions))
+
+	for i, version := range versions {
+		kind, at, from := provenanceCreated, version.GradedAt, ""
+		if i > 0 {
+			kind, at, from = provenanceUpdated, version.UpdatedAt, versions[i-1].GradeValue
+			if from == version.GradeValue {
+				continue
+			}
+		}
+
+		transitions = append(transitions, timedTransition{at: at, transition: &gpb.GradeTransition{
+			Kind:      kind,
+			FromValue: from,
+			ToValue:   version.GradeValue,
+			ChangedBy: version.GradedBy,
+			ChangedAt: at.UTC().Format(time.RFC3339Nano),
+			Version:   version.Version,
+		}})
+	}
+
+	// Versions are already in order, so a stable sort keeps changes made at the same instant in version order.
+	sort.SliceStable(transitions, func(i, j int) bool {
+		return transitions[i].at.Before(transitions[j].at)
+	})
+
+	timeline := make([]*gpb.GradeTransition, 0, len(transitions))
+	for _, transition := range transitions {
+		timeline = append(timeline, transition.transition)
+	}
+
+	return timeline
+}
+
+// GetGradeTimeline returns the creation of a grade followed by every change of its value, oldest
+// first, for rendering as a timeline. Requires the staff, auditor or admin role.
+func (s *GradesServer) GetGradeTimeline(ctx context.Context,
+	req *gpb.GetGradeTimelineRequest,
+) (*gpb.GetGradeTimelineResponse, error) {
+	if err := s.requireRole(ctx, req.GetToken(), roleStaff, roleAuditor, roleAdmin); err != nil {
+		return nil, err
+	}
+
+	logger := klog.FromContext(ctx)
+	logger.V(logLevelDebug).Info("Received request for grade timeline", "grade_id", req.GetGradeID())
+
+	versions, err := s.db.GetGradeVersions(ctx, req.GetGradeID())
+
+	switch {
+	case errors.Is(err, ErrGradeNotFound):
+		return nil, fmt.Errorf("failed to get grade timeline: %w", status.Error(codes.NotFound, err.Error()))
+	case errors.Is(err, ErrGradeIDEmpty):
+		return nil, fmt.Errorf("failed to get grade timeline: %w", status.Error(codes.InvalidArgument, err.Error()))
+	case err != nil:
+		return nil, fmt.Errorf("failed to get grade timeline: %w", err)
+	}
+
+	return &gpb.GetGradeTimelineResponse{Transitions: valueTimeline(versions)}, nil
+}
@@ -0,0 +1,79 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	gpb "github.com/BetterGR/grades-microservice/protos"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestValueTimeline(t *testing.T) {
+	created := time.Date(2024, 5, 1, 9, 0, 0, 0, time.UTC)
+
+	timeline := valueTimeline([]*Grade{
+		{GradeValue: "70", GradedBy: "ta", Version: 1, GradedAt: created, UpdatedAt: created},
+		{GradeValue: "70", GradedBy: "ta", Version: 2, GradedAt: created, UpdatedAt: created.Add(time.Hour)},
+		{GradeValue: "85", GradedBy: "lecturer", Version: 3, GradedAt: created, UpdatedAt: created.Add(3 * time.Hour)},
+		{GradeValue: "80", GradedBy: "lecturer", Version: 4, GradedAt: created, UpdatedAt: created.Add(2 * time.Hour)},
+	})
+
+	require.Len(t, timeline, 3, "a version keeping the value is not a transition")
+
+	assert.Equal(t, &gpb.GradeTransition{
+		Kind: provenanceCreated, ToValue: "70", ChangedBy: "ta", ChangedAt: "2024-05-01T09:00:00Z", Version: 1,
+	}, timeline[0])
+	assert.Equal(t, &gpb.GradeTransition{
+		Kind: provenanceUpdated, FromValue: "85", ToValue: "80", ChangedBy: "lecturer",
+		ChangedAt: "2024-05-01T11:00:00Z", Version: 4,
+	}, timeline[1], "transitions are ordered by time")
+	assert.Equal(t, "70", timeline[2].GetFromValue())
+	assert.Equal(t, "85", timeline[2].GetToValue())
+
+	only := valueTimeline([]*Grade{{GradeValue: "A", Version: 1, GradedAt: created}})
+	require.Len(t, only, 1)
+	assert.Equal(t, provenanceCreated, only[0].GetKind())
+}
+
+func TestGetGradeTimeline(t *testing.T) {
+	client := setupClient(t)
+	grade := createTestGrade()
+
+	_, err := client.AddSingleGrade(context.Background(), &gpb.AddSingleGradeRequest{Token: "test-token", Grade: grade})
+	require.NoError(t, err)
+
+	req := &gpb.GetGradeTimelineRequest{Token: "test-token", GradeID: grade.GetGradeID()}
+
+	resp, err := client.GetGradeTimeline(context.Background(), req)
+	require.NoError(t, err)
+	require.Len(t, resp.GetTransitions(), 1, "only the creation without updates")
+	assert.Equal(t, grade.GetGradeValue(), resp.GetTransitions()[0].GetToValue())
+
+	for _, value := range []string{"B", "C"} {
+		_, err = client.UpdateSingleGrade(context.Background(), &gpb.UpdateSingleGradeRequest{
+			Token: "test-token",
+			Grade: &gpb.SingleGrade{GradeID: grade.GetGradeID(), GradeValue: value, GradedBy: "Professor Y"},
+		})
+		require.NoError(t, err)
+	}
+
+	resp, err = client.GetGradeTimeline(context.Background(), req)
+	require.NoError(t, err)
+
+	transitions := resp.GetTransitions()
+	require.Len(t, transitions, 3)
+	assert.Equal(t, []string{"", "A", "B"},
+		[]string{transitions[0].GetFromValue(), transitions[1].GetFromValue(), transitions[2].GetFromValue()})
+	assert.Equal(t, []string{"A", "B", "C"},
+		[]string{transitions[0].GetToValue(), transitions[1].GetToValue(), transitions[2].GetToValue()})
+	assert.Equal(t, "Professor Y", transitions[2].GetChangedBy())
+
+	_, err = client.GetGradeTimeline(context.Background(), &gpb.GetGradeTimelineRequest{
+		Token: "test-token", GradeID: "missing",
+	})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
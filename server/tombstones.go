@@ -0,0 +1,106 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	gpb "github.com/BetterGR/grades-microservice/protos"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/klog/v2"
+)
+
+// defaultTombstoneRetentionDays is how many days removed grades stay recoverable by default.
+const defaultTombstoneRetentionDays = 30
+
+// tombstoneRetentionFromEnv reads TOMBSTONE_RETENTION_DAYS; negative values are treated as 0.
+func tombstoneRetentionFromEnv() time.Duration {
+	days := max(envInt("TOMBSTONE_RETENTION_DAYS", defaultTombstoneRetentionDays), 0)
+
+	return time.Duration(days) * 24 * time.Hour
+}
+
+// purgeTombstones permanently deletes the grades removed longer ago than the retention period.
+func (s *GradesServer) purgeTombstones(ctx context.Context) (int64, error) {
+	purged, err := s.db.PurgeTombstones(ctx, time.Now().Add(-s.tombstoneRetention))
+	if err != nil {
+		return purged, fmt.Errorf("failed to purge tombstones: %w", err)
+	}
+
+	return purged, nil
+}
+
+// runTombstonePurges purges expired tombstones every interval until the context is done.
+func (s *GradesServer) runTombstonePurges(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			purged, err := s.purgeTombstones(ctx)
+			if err != nil {
+				klog.ErrorS(err, "Failed to purge tombstones")
+
+				continue
+			}
+
+			if purged > 0 {
+				klog.V(logLevelDebug).InfoS("Purged tombstones", "purged", purged)
+			}
+		}
+	}
+}
+
+// PurgeTombstones permanently deletes the grades removed longer ago than TOMBSTONE_RETENTION_DAYS.
+// Requires the admin role.
+func (s *GradesServer) PurgeTombstones(ctx context.Context,
+	req *gpb.PurgeTombstonesRequest,
+) (*gpb.PurgeTombstonesResponse, error) {
+	if err := s.requireRole(ctx, req.GetToken(), roleAdmin); err != nil {
+		return nil, err
+	}
+
+	logger := klog.FromContext(ctx)
+	logger.V(logLevelDebug).Info("Received request to purge tombstones", "retention", s.tombstoneRetention)
+
+	purged, err := s.purgeTombstones(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &gpb.PurgeTombstonesResponse{PurgedCount: purged}, nil
+}
+
+// SoftDeleteStudentGrades removes every grade of a student in one transaction, for students removed
+// from the system. The grades stay recoverable as tombstones for the retention period, and each
+// removal is recorded in the audit log. Requires the admin role.
+func (s *GradesServer) SoftDeleteStudentGrades(ctx context.Context,
+	req *gpb.SoftDeleteStudentGradesRequest,
+) (*gpb.SoftDeleteStudentGradesResponse, error) {
+	claims, err := s.authorizeRoles(ctx, req.GetToken(), roleAdmin)
+	if err != nil {
+		return nil, err
+	}
+
+	logger := klog.FromContext(ctx)
+	logger.V(logLevelDebug).Info("Received request to soft-delete student grades", "student_id", req.GetStudentID())
+
+	gradeIDs, err := s.db.SoftDeleteStudentGrades(ctx, req.GetStudentID(), callerIdentity(claims))
+	if errors.Is(err, ErrStudentIDEmpty) {
+		return nil, fmt.Errorf("failed to soft-delete student grades: %w",
+			status.Error(codes.InvalidArgument, err.Error()))
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to soft-delete student grades: %w", err)
+	}
+
+	for _, gradeID := range gradeIDs {
+		s.publishEvent(ctx, newGradeEvent(eventGradeRemoved, &Grade{GradeID: gradeID, StudentID: req.GetStudentID()}))
+	}
+
+	return &gpb.SoftDeleteStudentGradesResponse{DeletedCount: int32(len(gradeIDs))}, nil
+}
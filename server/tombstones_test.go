@@ -0,0 +1,131 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	gpb "github.com/BetterGR/grades-microservice/protos"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestPurgeTombstonesKeepsRecentOnes(t *testing.T) {
+	mockDB := NewMockDatabase()
+	client := setupClient(t, func(s *GradesServer) {
+		s.db = mockDB
+		s.Claims = RoleClaims{role: roleAdmin}
+		s.tombstoneRetention = 30 * 24 * time.Hour
+	})
+
+	var gradeIDs []string
+
+	for range 3 {
+		grade := createTestGrade()
+		_, err := client.AddSingleGrade(context.Background(), &gpb.AddSingleGradeRequest{
+			Token: "test-token", Grade: grade,
+		})
+		require.NoError(t, err)
+
+		gradeIDs = append(gradeIDs, grade.GetGradeID())
+	}
+
+	for _, gradeID := range gradeIDs[:2] {
+		_, err := client.RemoveSingleGrade(context.Background(), &gpb.RemoveSingleGradeRequest{
+			Token: "test-token", GradeID: gradeID,
+		})
+		require.NoError(t, err)
+	}
+
+	// The first grade was removed past the retention window, the second within it.
+	mockDB.tombstones[gradeIDs[0]].DeletedAt = time.Now().AddDate(0, 0, -31)
+	mockDB.tombstones[gradeIDs[1]].DeletedAt = time.Now().AddDate(0, 0, -29)
+
+	resp, err := client.PurgeTombstones(context.Background(), &gpb.PurgeTombstonesRequest{Token: "test-token"})
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), resp.GetPurgedCount())
+
+	assert.NotContains(t, mockDB.tombstones, gradeIDs[0])
+	assert.Contains(t, mockDB.tombstones, gradeIDs[1])
+	assert.Contains(t, mockDB.grades, gradeIDs[2], "live grades are never purged")
+}
+
+func TestPurgeTombstonesRequiresAdmin(t *testing.T) {
+	client := setupClient(t, func(s *GradesServer) {
+		s.Claims = RoleClaims{role: roleStaff}
+	})
+
+	_, err := client.PurgeTombstones(context.Background(), &gpb.PurgeTombstonesRequest{Token: "test-token"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestSoftDeleteStudentGrades(t *testing.T) {
+	mockDB := NewMockDatabase()
+	adminToken := tokenFor("admin-1")
+	client := setupClient(t, func(s *GradesServer) {
+		s.db = mockDB
+		verifiedCaller(roleAdmin)(s)
+		s.tombstoneRetention = 30 * 24 * time.Hour
+	})
+
+	var gradeIDs []string
+
+	for _, studentID := range []string{"student-1", "student-1", "student-2"} {
+		grade := createTestGrade()
+		grade.StudentID = studentID
+		added, err := mockDB.AddGrade(context.Background(), grade)
+		require.NoError(t, err)
+
+		gradeIDs = append(gradeIDs, added.GradeID)
+	}
+
+	resp, err := client.SoftDeleteStudentGrades(context.Background(), &gpb.SoftDeleteStudentGradesRequest{
+		Token: adminToken, StudentID: "student-1",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), resp.GetDeletedCount())
+
+	for _, gradeID := range gradeIDs[:2] {
+		_, err := client.GetGrade(context.Background(), &gpb.GetGradeRequest{Token: adminToken, GradeID: gradeID})
+		assert.Equal(t, codes.NotFound, status.Code(err), "removed grades are hidden")
+
+		audit, err := mockDB.GetGradeAudit(context.Background(), gradeID)
+		require.NoError(t, err)
+		require.Len(t, audit, 1)
+		assert.Equal(t, auditActionDelete, audit[0].Action)
+		assert.Equal(t, "admin-1", audit[0].Actor)
+	}
+
+	assert.Contains(t, mockDB.grades, gradeIDs[2], "other students' grades are kept")
+
+	// Within the retention window the removed grades are kept as recoverable tombstones.
+	purged, err := client.PurgeTombstones(context.Background(), &gpb.PurgeTombstonesRequest{Token: adminToken})
+	require.NoError(t, err)
+	assert.Zero(t, purged.GetPurgedCount())
+	assert.Contains(t, mockDB.tombstones, gradeIDs[0])
+	assert.Contains(t, mockDB.tombstones, gradeIDs[1])
+
+	resp, err = client.SoftDeleteStudentGrades(context.Background(), &gpb.SoftDeleteStudentGradesRequest{
+		Token: adminToken, StudentID: "student-1",
+	})
+	require.NoError(t, err)
+	assert.Zero(t, resp.GetDeletedCount(), "removing again is a no-op")
+
+	_, err = client.SoftDeleteStudentGrades(context.Background(), &gpb.SoftDeleteStudentGradesRequest{
+		Token: adminToken,
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestSoftDeleteStudentGradesRequiresAdmin(t *testing.T) {
+	client := setupClient(t, func(s *GradesServer) {
+		s.Claims = RoleClaims{role: roleStaff}
+	})
+
+	_, err := client.SoftDeleteStudentGrades(context.Background(), &gpb.SoftDeleteStudentGradesRequest{
+		Token: "test-token", StudentID: "student-1",
+	})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
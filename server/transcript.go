@@ -0,0 +1,274 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+
+	gpb "github.com/BetterGR/grades-microservice/protos"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/klog/v2"
+)
+
+// defaultTranscriptTemplate lays out a transcript when TRANSCRIPT_TEMPLATE is unset. Lines starting
+// with "# " are headings and lines starting with "## " subheadings.
+const defaultTranscriptTemplate = `{{if .Institution}}# {{.Institution}}
+{{end}}# Academic Transcript
+Student: {{.StudentID}}
+Issued: {{.IssuedAt.Format "2006-01-02"}}
+{{range .Semesters}}
+## {{.Semester}}
+{{range .Courses}}{{.CourseID}}: {{if .Graded}}{{score .Score}}{{else}}not graded{{end}}
+{{end}}Semester GPA: {{gpa .GPA .CourseCount}}
+{{else}}
+No grades recorded.
+{{end}}
+Cumulative GPA: {{gpa .CumulativeGPA .CourseCount}}
+`
+
+var ErrTranscriptTemplate = errors.New("invalid transcript template")
+
+// transcriptCourse is a course on a transcript and the student's final score in it.
+type transcriptCourse struct {
+	CourseID string
+	Score    float64
+	// Graded is false for courses without numeric grades, which have no score.
+	Graded bool
+}
+
+// transcriptSemester is a semester on a transcript.
+type transcriptSemester struct {
+	Semester    string
+	Courses     []transcriptCourse
+	GPA         float64
+	CourseCount int
+}
+
+// studentTranscript is the structured transcript of a student, as handed to the template.
+type studentTranscript struct {
+	Institution   string
+	StudentID     string
+	IssuedAt      time.Time
+	Semesters     []transcriptSemester
+	CumulativeGPA float64
+	CourseCount   int
+}
+
+// semesterLess orders semesters named "<term>_<year>" by the academic year they belong to, then by
+// the position of their term in the academic calendar; other semesters go last, by name. Terms
+// missing from the calendar go last in the academic year starting in their calendar year.
+func semesterLess(calendar AcademicCalendar, a, b string) bool {
+	terms := calendar
+	if len(terms) == 0 {
+		terms, _ = parseAcademicCalendar(defaultAcademicTerms)
+	}
+
+	position := func(semester string) (int, int, bool) {
+		term, rawYear, found := strings.Cut(semester, "_")
+
+		year, err := strconv.Atoi(rawYear)
+		if !found || err != nil {
+			return 0, 0, false
+		}
+
+		for i, known := range terms {
+			if known.name == term {
+				return year - known.yearOffset, i, true
+			}
+		}
+
+		return year, len(terms), true
+	}
+
+	yearA, termA, okA := position(a)
+	yearB, termB, okB := position(b)
+
+	switch {
+	case okA != okB:
+		return okA
+	case yearA != yearB:
+		return yearA < yearB
+	case termA != termB:
+		return termA < termB
+	default:
+		return a < b
+	}
+}
+
+// buildTranscript groups a student's grades into semesters in calendar order, with the final score
+// of each course, the GPA of each semester and the cumulative GPA, rounded by rounder.
+func buildTranscript(studentID string, grades []*Grade, weights GradeWeights, calendar AcademicCalendar,
+	rounder GradeRounder,
+) *studentTranscript {
+	bySemester := make(map[string]map[string][]*Grade)
+
+	for _, grade := range grades {
+		if bySemester[grade.Semester] == nil {
+			bySemester[grade.Semester] = make(map[string][]*Grade)
+		}
+
+		bySemester[grade.Semester][grade.CourseID] = append(bySemester[grade.Semester][grade.CourseID], grade)
+	}
+
+	semesters := make([]string, 0, len(bySemester))
+	for semester := range bySemester {
+		semesters = append(semesters, semester)
+	}
+
+	sort.Slice(semesters, func(i, j int) bool {
+		return semesterLess(calendar, semesters[i], semesters[j])
+	})
+
+	transcript := &studentTranscript{StudentID: studentID}
+
+	for _, semester := range semesters {
+		entry := transcriptSemester{Semester: semester}
+
+		var semesterGrades []*Grade
+
+		for _, courseID := range sortedKeys(bySemester[semester]) {
+			courseGrades := bySemester[semester][courseID]
+			semesterGrades = append(semesterGrades, courseGrades...)
+
+			score, graded := courseScore(courseGrades, weights)
+			entry.Courses = append(entry.Courses, transcriptCourse{
+				CourseID: courseID, Score: rounder.RoundNumber(score), Graded: graded,
+			})
+		}
+
+		gpa, courses := computeGPA(semesterGrades, weights)
+		entry.GPA, entry.CourseCount = rounder.RoundNumber(gpa), courses
+
+		transcript.Semesters = append(transcript.Semesters, entry)
+	}
+
+	gpa, courses := computeGPA(grades, weights)
+	transcript.CumulativeGPA, transcript.CourseCount = rounder.RoundNumber(gpa), courses
+
+	return transcript
+}
+
+// sortedKeys returns the keys of a map of course grades, sorted.
+func sortedKeys(m map[string][]*Grade) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
+
+// transcriptRenderer renders transcripts as PDF documents, through a text template branded with the
+// name of the institution.
+type transcriptRenderer struct {
+	template    *template.Template
+	institution string
+}
+
+// newTranscriptRenderer parses the transcript template in file, or the default template when file
+// is empty.
+func newTranscriptRenderer(file, institution string) (*transcriptRenderer, error) {
+	text := defaultTranscriptTemplate
+
+	if file != "" {
+		content, err := os.ReadFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrTranscriptTemplate, err)
+		}
+
+		text = string(content)
+	}
+
+	tmpl, err := template.New("transcript").Funcs(template.FuncMap{
+		"score": func(score float64) string {
+			return strconv.FormatFloat(score, 'f', 1, 64)
+		},
+		"gpa": func(gpa float64, courses int) string {
+			if courses == 0 {
+				return "n/a"
+			}
+
+			return strconv.FormatFloat(gpa, 'f', 2, 64)
+		},
+	}).Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrTranscriptTemplate, err)
+	}
+
+	return &transcriptRenderer{template: tmpl, institution: institution}, nil
+}
+
+// render renders a transcript as a PDF document.
+func (r *transcriptRenderer) render(transcript *studentTranscript) ([]byte, error) {
+	transcript.Institution = r.institution
+
+	var text bytes.Buffer
+	if err := r.template.Execute(&text, transcript); err != nil {
+		return nil, fmt.Errorf("failed to render transcript: %w", err)
+	}
+
+	return renderPDF("Academic Transcript of "+transcript.StudentID, parsePDFLines(text.String())), nil
+}
+
+// ExportTranscriptPDF renders a student's transcript as a printable PDF document: the institution's
+// name, a section per semester listing the final score of each course and the semester GPA, and the
+// cumulative GPA. The layout comes from TRANSCRIPT_TEMPLATE and the branding from
+// TRANSCRIPT_INSTITUTION. A student without grades gets a transcript saying so. Students may only
+// export their own transcript; staff and admins may export anyone's.
+func (s *GradesServer) ExportTranscriptPDF(ctx context.Context,
+	req *gpb.ExportTranscriptPDFRequest,
+) (*gpb.ExportTranscriptPDFResponse, error) {
+	claims, err := s.getClaims(ctx, req.GetToken())
+	if err != nil {
+		return nil, fmt.Errorf("authentication failed: %w",
+			status.Error(codes.Unauthenticated, err.Error()))
+	}
+
+	if !claims.HasRole(roleStaff) && !claims.HasRole(roleAdmin) && callerIdentity(claims) != req.GetStudentID() {
+		return nil, fmt.Errorf("authorization failed: %w",
+			status.Error(codes.PermissionDenied, "students may only export their own transcript"))
+	}
+
+	logger := klog.FromContext(ctx)
+	logger.V(logLevelDebug).Info("Received request to export a transcript", "student_id", req.GetStudentID())
+
+	grades, err := s.db.GetStudentGrades(ctx, req.GetStudentID())
+	if errors.Is(err, ErrStudentIDEmpty) {
+		return nil, fmt.Errorf("failed to export transcript: %w", status.Error(codes.InvalidArgument, err.Error()))
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to export transcript: %w", err)
+	}
+
+	// Grades hidden from the caller must not show on the transcript.
+	embargo, err := s.loadEmbargo(ctx, gradeViewRole(claims))
+	if err != nil {
+		return nil, err
+	}
+
+	transcript := buildTranscript(req.GetStudentID(), embargo.released(grades), s.weights, s.calendar, s.rounder)
+	transcript.IssuedAt = time.Now().UTC()
+
+	renderer := s.transcripts
+	if renderer == nil {
+		if renderer, err = newTranscriptRenderer("", ""); err != nil {
+			return nil, fmt.Errorf("failed to export transcript: %w", err)
+		}
+	}
+
+	pdf, err := renderer.render(transcript)
+	if err != nil {
+		return nil, fmt.Errorf("failed to export transcript: %w", err)
+	}
+
+	return &gpb.ExportTranscriptPDFResponse{Pdf: pdf, Filename: "transcript-" + req.GetStudentID() + ".pdf"}, nil
+}
@@ -0,0 +1,100 @@
+package main
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	gpb "github.com/BetterGR/grades-microservice/protos"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestSemesterLess(t *testing.T) {
+	semesters := []string{"Spring_2024", "Fall_2023", "Spring_2023", "Other"}
+	calendar, err := parseAcademicCalendar("Fall:0,Spring:1")
+	require.NoError(t, err)
+
+	assert.True(t, semesterLess(calendar, "Spring_2023", "Fall_2023"))
+	assert.True(t, semesterLess(calendar, "Fall_2023", "Spring_2024"))
+	assert.False(t, semesterLess(calendar, semesters[3], semesters[0]), "unknown semesters go last")
+}
+
+func TestExportTranscriptPDF(t *testing.T) {
+	renderer, err := newTranscriptRenderer("", "Technion")
+	require.NoError(t, err)
+
+	db := NewMockDatabase()
+	client := setupClient(t, func(s *GradesServer) {
+		s.db = db
+		s.transcripts = renderer
+	})
+
+	for _, grade := range []*gpb.SingleGrade{
+		{StudentID: "s1", CourseID: "234218", Semester: "Spring_2024", GradeValue: "90"},
+		{StudentID: "s1", CourseID: "234218", Semester: "Spring_2024", GradeValue: "80"},
+		{StudentID: "s1", CourseID: "104031", Semester: "Fall_2023", GradeValue: "70"},
+		{StudentID: "s1", CourseID: "114071", Semester: "Fall_2023", GradeValue: "Pass"},
+	} {
+		_, err := db.AddGrade(context.Background(), grade)
+		require.NoError(t, err)
+	}
+
+	resp, err := client.ExportTranscriptPDF(context.Background(), &gpb.ExportTranscriptPDFRequest{
+		Token: "test-token", StudentID: "s1",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "transcript-s1.pdf", resp.GetFilename())
+
+	text := pdfText(t, resp.GetPdf())
+	require.GreaterOrEqual(t, len(text), 12)
+	assert.Equal(t, []string{"Technion", "Academic Transcript", "Student: s1"}, text[:3])
+	assert.Equal(t, []string{
+		"Fall_2023", "104031: 70.0", "114071: not graded", "Semester GPA: 70.00",
+		"Spring_2024", "234218: 85.0", "Semester GPA: 85.00",
+		"Cumulative GPA: 77.50",
+	}, text[4:])
+}
+
+func TestExportTranscriptPDFEmpty(t *testing.T) {
+	client := setupClient(t)
+
+	resp, err := client.ExportTranscriptPDF(context.Background(), &gpb.ExportTranscriptPDFRequest{
+		Token: "test-token", StudentID: "no-grades",
+	})
+	require.NoError(t, err)
+
+	text := pdfText(t, resp.GetPdf())
+	assert.Contains(t, text, "No grades recorded.")
+	assert.Contains(t, text, "Cumulative GPA: n/a")
+}
+
+func TestExportTranscriptPDFOwnOnly(t *testing.T) {
+	client := setupClient(t, func(s *GradesServer) {
+		verifiedCaller(roleStudent)(s)
+	})
+
+	_, err := client.ExportTranscriptPDF(context.Background(), &gpb.ExportTranscriptPDFRequest{
+		Token: tokenFor("s1"), StudentID: "s2",
+	})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestNewTranscriptRendererTemplateFile(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "transcript.tmpl")
+	require.NoError(t, os.WriteFile(file, []byte("# {{.Institution}}\nGPA {{gpa .CumulativeGPA .CourseCount}}\n"), 0o600))
+
+	renderer, err := newTranscriptRenderer(file, "Custom U")
+	require.NoError(t, err)
+
+	pdf, err := renderer.render(&studentTranscript{CumulativeGPA: 91.5, CourseCount: 1})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Custom U", "GPA 91.50"}, pdfText(t, pdf))
+
+	require.NoError(t, os.WriteFile(file, []byte("{{.Unclosed"), 0o600))
+	_, err = newTranscriptRenderer(file, "")
+	assert.ErrorIs(t, err, ErrTranscriptTemplate)
+}
@@ -0,0 +1,111 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	gpb "github.com/BetterGR/grades-microservice/protos"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// idFieldName matches the names of identifier fields, such as course_id or student_ids, in snake
+// or camel case.
+var idFieldName = regexp.MustCompile(`(?:^|_)ids?$|[a-z](?:ID|Id)s?$`)
+
+// isIDField reports whether a request field holds identifiers.
+func isIDField(field protoreflect.FieldDescriptor) bool {
+	return idFieldName.MatchString(string(field.Name()))
+}
+
+// trimRequest strips surrounding whitespace from the identifiers of a request and from every string
+// field of the grades it carries, so "student123 " matches the stored "student123".
+func trimRequest(req interface{}) {
+	if msg, ok := req.(proto.Message); ok {
+		trimMessage(msg.ProtoReflect(), false)
+	}
+}
+
+// trimMessage trims the string fields of a message and its nested messages. Every string field of a
+// grade is trimmed; elsewhere only identifiers are, with all set when inside a grade.
+func trimMessage(msg protoreflect.Message, all bool) {
+	if _, ok := msg.Interface().(*gpb.SingleGrade); ok {
+		all = true
+	}
+
+	msg.Range(func(field protoreflect.FieldDescriptor, value protoreflect.Value) bool {
+		switch {
+		case field.IsMap():
+			// Map entries, such as weights, are not identifiers.
+		case field.Kind() == protoreflect.MessageKind && field.IsList():
+			for i := range value.List().Len() {
+				trimMessage(value.List().Get(i).Message(), all)
+			}
+		case field.Kind() == protoreflect.MessageKind:
+			trimMessage(value.Message(), all)
+		case field.Kind() == protoreflect.StringKind && (all || isIDField(field)):
+			trimStringField(msg, field, value)
+		}
+
+		return true
+	})
+}
+
+// trimStringField trims a singular or repeated string field in place.
+func trimStringField(msg protoreflect.Message, field protoreflect.FieldDescriptor, value protoreflect.Value) {
+	if !field.IsList() {
+		msg.Set(field, protoreflect.ValueOfString(strings.TrimSpace(value.String())))
+
+		return
+	}
+
+	list := value.List()
+	for i := range list.Len() {
+		list.Set(i, protoreflect.ValueOfString(strings.TrimSpace(list.Get(i).String())))
+	}
+}
+
+// trimInterceptor trims the fields of unary requests before they reach the handlers, when enabled.
+func (s *GradesServer) trimInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, _ *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		if s.trimRequests {
+			trimRequest(req)
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// trimStreamInterceptor trims the fields of streaming requests as they are received, when enabled.
+func (s *GradesServer) trimStreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, _ *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		if !s.trimRequests {
+			return handler(srv, stream)
+		}
+
+		return handler(srv, trimmingStream{stream})
+	}
+}
+
+// trimmingStream trims each request received on a stream.
+type trimmingStream struct {
+	grpc.ServerStream
+}
+
+// RecvMsg receives a request and trims its fields.
+func (st trimmingStream) RecvMsg(m interface{}) error {
+	if err := st.ServerStream.RecvMsg(m); err != nil {
+		return fmt.Errorf("failed to receive request: %w", err)
+	}
+
+	trimRequest(m)
+
+	return nil
+}
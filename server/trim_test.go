@@ -0,0 +1,45 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	gpb "github.com/BetterGR/grades-microservice/protos"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTrimRequest(t *testing.T) {
+	add := &gpb.AddSingleGradeRequest{
+		Token: " token ",
+		Grade: &gpb.SingleGrade{StudentID: " student123 ", CourseID: "\tcourse\n", GradeValue: " 90 ", Comments: " ok "},
+	}
+	trimRequest(add)
+	assert.Equal(t, "student123", add.GetGrade().GetStudentID())
+	assert.Equal(t, "course", add.GetGrade().GetCourseID())
+	assert.Equal(t, "90", add.GetGrade().GetGradeValue(), "every string field of a grade is trimmed")
+	assert.Equal(t, "ok", add.GetGrade().GetComments())
+	assert.Equal(t, " token ", add.GetToken(), "only identifiers are trimmed outside grades")
+
+	multi := &gpb.GetMultiCourseGradesRequest{CourseIDs: []string{" c1", "c2 "}}
+	trimRequest(multi)
+	assert.Equal(t, []string{"c1", "c2"}, multi.GetCourseIDs())
+}
+
+func TestTrimmedStudentIDMatchesStoredGrade(t *testing.T) {
+	client := setupClient(t, func(s *GradesServer) {
+		s.trimRequests = true
+	})
+
+	grade := createTestGrade()
+	grade.StudentID = "student123"
+	_, err := client.AddSingleGrade(context.Background(), &gpb.AddSingleGradeRequest{Token: "test-token", Grade: grade})
+	require.NoError(t, err)
+
+	resp, err := client.GetStudentCourseGrades(context.Background(), &gpb.GetStudentCourseGradesRequest{
+		Token: "test-token", CourseID: grade.GetCourseID(), Semester: grade.GetSemester(), StudentID: "student123 ",
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.GetGrades(), 1)
+	assert.Equal(t, "student123", resp.GetGrades()[0].GetStudentID())
+}
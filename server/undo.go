@@ -0,0 +1,107 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	gpb "github.com/BetterGR/grades-microservice/protos"
+	ms "github.com/TekClinic/MicroService-Lib"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/klog/v2"
+)
+
+// defaultUndoWindow is how long a grader may undo their change when UNDO_WINDOW is unset.
+const defaultUndoWindow = 15 * time.Minute
+
+// authorizeUndo ensures the caller may undo the latest change of grade: admins may undo any change,
+// graders of the course only their own, within the undo window. The author of the change is the
+// caller the server recorded making it, never the grader named in the grade.
+func (s *GradesServer) authorizeUndo(claims ms.Claims, grade *Grade) error {
+	if claims.HasRole(roleAdmin) {
+		return nil
+	}
+
+	caller := callerIdentity(claims)
+	if !claims.HasRole(roleStaff) || caller == unknownCaller || grade.ChangedBy != caller {
+		return fmt.Errorf("authorization failed: %w",
+			status.Error(codes.PermissionDenied, "graders may only undo their own changes"))
+	}
+
+	if time.Since(grade.UpdatedAt) > s.undoWindow {
+		return fmt.Errorf("failed to undo grade change: %w", status.Error(codes.FailedPrecondition,
+			fmt.Sprintf("the change is older than the undo window of %s", s.undoWindow)))
+	}
+
+	return nil
+}
+
+// UndoGradeChange reverts a grade to the value it had before its latest change, for an "undo"
+// button next to the grade. The revert is itself recorded in the history, so undoing twice restores
+// the undone value. Graders may only undo their own changes made within the configured window;
+// admins may undo any change.
+func (s *GradesServer) UndoGradeChange(ctx context.Context,
+	req *gpb.UndoGradeChangeRequest,
+) (*gpb.UndoGradeChangeResponse, error) {
+	claims, err := s.getClaims(ctx, req.GetToken())
+	if err != nil {
+		return nil, fmt.Errorf("authentication failed: %w",
+			status.Error(codes.Unauthenticated, err.Error()))
+	}
+
+	ctx = withActor(ctx, callerIdentity(claims))
+
+	logger := klog.FromContext(ctx)
+	logger.V(logLevelDebug).Info("Received request to undo grade change", "grade_id", req.GetGradeID())
+
+	view, err := s.gradeView(ctx, claims)
+	if err != nil {
+		return nil, err
+	}
+
+	// The change to undo must be the latest one, so it is read from the primary.
+	grade, err := s.db.GetGradeByID(withPrimaryReads(ctx), req.GetGradeID())
+
+	switch {
+	case errors.Is(err, ErrGradeNotFound):
+		return nil, fmt.Errorf("failed to undo grade change: %w", status.Error(codes.NotFound, err.Error()))
+	case errors.Is(err, ErrGradeIDEmpty):
+		return nil, fmt.Errorf("failed to undo grade change: %w", status.Error(codes.InvalidArgument, err.Error()))
+	case err != nil:
+		return nil, fmt.Errorf("failed to undo grade change: %w", err)
+	}
+
+	if err := s.authorizeGrader(ctx, claims, grade.CourseID); err != nil {
+		return nil, err
+	}
+
+	if err := s.authorizeUndo(claims, grade); err != nil {
+		return nil, err
+	}
+
+	undone := grade.GradeValue
+
+	reverted, err := s.db.UndoGradeChange(ctx, grade.GradeID, grade.Version)
+
+	switch {
+	case errors.Is(err, ErrNoPriorGradeValue), errors.Is(err, ErrGradeUnderAppeal):
+		return nil, fmt.Errorf("failed to undo grade change: %w",
+			status.Error(codes.FailedPrecondition, err.Error()))
+	case errors.Is(err, ErrGradeVersionConflict):
+		return nil, fmt.Errorf("failed to undo grade change: %w", status.Error(codes.Aborted, err.Error()))
+	case errors.Is(err, ErrGradeNotFound):
+		return nil, fmt.Errorf("failed to undo grade change: %w", status.Error(codes.NotFound, err.Error()))
+	case err != nil:
+		return nil, fmt.Errorf("failed to undo grade change: %w", err)
+	}
+
+	s.publishEvent(ctx, newGradeEvent(eventGradeUpdated, reverted))
+	s.notifyStudent(ctx, reverted)
+
+	return &gpb.UndoGradeChangeResponse{
+		Grade:       s.gradeResponse(reverted, view),
+		UndoneValue: undone,
+	}, nil
+}
@@ -0,0 +1,154 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	gpb "github.com/BetterGR/grades-microservice/protos"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// addGradedGrade adds a grade by the grader with the given value history, oldest first.
+func addGradedGrade(t *testing.T, db *MockDatabase, graderID string, values ...string) *Grade {
+	t.Helper()
+
+	ctx := withActor(context.Background(), graderID)
+	grade := createTestGrade()
+	grade.GradedBy, grade.GradeValue = graderID, values[0]
+
+	added, err := db.AddGrade(ctx, grade)
+	require.NoError(t, err)
+
+	for _, value := range values[1:] {
+		added, err = db.UpdateGrade(ctx, &gpb.SingleGrade{GradeID: added.GradeID, GradeValue: value})
+		require.NoError(t, err)
+	}
+
+	return added
+}
+
+func TestUndoGradeChange(t *testing.T) {
+	db := NewMockDatabase()
+	client := setupClient(t, func(s *GradesServer) {
+		s.db = db
+		verifiedCaller(roleStaff)(s)
+		s.undoWindow = time.Hour
+	})
+
+	grade := addGradedGrade(t, db, "grader-1", "80", "85")
+
+	resp, err := client.UndoGradeChange(context.Background(), &gpb.UndoGradeChangeRequest{
+		Token: graderToken, GradeID: grade.GradeID,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "80", resp.GetGrade().GetGradeValue())
+	assert.Equal(t, "85", resp.GetUndoneValue())
+
+	versions, err := db.GetGradeVersions(context.Background(), grade.GradeID)
+	require.NoError(t, err)
+	require.Len(t, versions, 3, "the undo is a change of its own")
+	assert.Equal(t, "85", versions[1].GradeValue, "the undone value is kept in the history")
+
+	resp, err = client.UndoGradeChange(context.Background(), &gpb.UndoGradeChangeRequest{
+		Token: graderToken, GradeID: grade.GradeID,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "85", resp.GetGrade().GetGradeValue(), "undoing an undo restores the undone value")
+}
+
+func TestUndoGradeChangeWithoutPriorValue(t *testing.T) {
+	db := NewMockDatabase()
+	client := setupClient(t, func(s *GradesServer) {
+		s.db = db
+		verifiedCaller(roleStaff)(s)
+		s.undoWindow = time.Hour
+	})
+
+	grade := addGradedGrade(t, db, "grader-1", "80")
+
+	_, err := client.UndoGradeChange(context.Background(), &gpb.UndoGradeChangeRequest{
+		Token: graderToken, GradeID: grade.GradeID,
+	})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	stored, err := db.GetGradeByID(context.Background(), grade.GradeID)
+	require.NoError(t, err)
+	assert.Equal(t, "80", stored.GradeValue)
+	assert.Equal(t, int64(1), stored.Version)
+
+	_, err = client.UndoGradeChange(context.Background(), &gpb.UndoGradeChangeRequest{
+		Token: graderToken, GradeID: "missing",
+	})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestUndoGradeChangeAuthorization(t *testing.T) {
+	db := NewMockDatabase()
+	undo := func(role, subject string, window time.Duration, gradeID string) error {
+		client := setupClient(t, func(s *GradesServer) {
+			s.db = db
+			verifiedCaller(role)(s)
+			s.undoWindow = window
+		})
+
+		_, err := client.UndoGradeChange(context.Background(), &gpb.UndoGradeChangeRequest{
+			Token: tokenFor(subject), GradeID: gradeID,
+		})
+
+		return err
+	}
+
+	grade := addGradedGrade(t, db, "grader-1", "80", "85")
+	err := undo(roleStaff, "grader-2", time.Hour, grade.GradeID)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err), "graders may not undo others' changes")
+
+	err = undo(roleStudent, "grader-1", time.Hour, grade.GradeID)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	err = undo(roleStaff, "grader-1", time.Nanosecond, grade.GradeID)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err), "changes past the window cannot be undone")
+
+	require.NoError(t, undo(roleAdmin, "admin-1", time.Nanosecond, grade.GradeID),
+		"admins may undo any change")
+}
+
+func TestUndoGradeChangeAuthorizesRecordedAuthor(t *testing.T) {
+	db := NewMockDatabase()
+	client := setupClient(t, func(s *GradesServer) {
+		s.db = db
+		verifiedCaller(roleStaff)(s)
+		s.undoWindow = time.Hour
+	})
+	otherToken := tokenFor("grader-2")
+
+	grade := createTestGrade()
+	grade.GradedBy, grade.GradeValue = "grader-1", "80"
+	_, err := client.AddSingleGrade(context.Background(), &gpb.AddSingleGradeRequest{Token: graderToken, Grade: grade})
+	require.NoError(t, err)
+
+	// Another grader changes the grade, naming the first one as its grader.
+	_, err = client.UpdateSingleGrade(context.Background(), &gpb.UpdateSingleGradeRequest{
+		Token: otherToken, Grade: &gpb.SingleGrade{GradeID: grade.GetGradeID(), GradeValue: "60", GradedBy: "grader-1"},
+	})
+	require.NoError(t, err)
+
+	stored, err := db.GetGradeByID(context.Background(), grade.GetGradeID())
+	require.NoError(t, err)
+	assert.Equal(t, "grader-2", stored.ChangedBy, "the author is the authenticated caller")
+
+	undo := func(token string) error {
+		_, err := client.UndoGradeChange(context.Background(), &gpb.UndoGradeChangeRequest{
+			Token: token, GradeID: grade.GetGradeID(),
+		})
+
+		return err
+	}
+
+	assert.Equal(t, codes.PermissionDenied, status.Code(undo(graderToken)),
+		"graded_by does not make the change the original grader's")
+	require.NoError(t, undo(otherToken))
+}
@@ -0,0 +1,216 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+// Grading schemes, selected with GRADE_SCHEME.
+const (
+	// schemeAny accepts every grade value.
+	schemeAny GradeScheme = "any"
+	// schemeNumeric accepts numeric grades between minNumericGrade and maxNumericGrade.
+	schemeNumeric GradeScheme = "numeric"
+	// schemeLetter accepts letter and pass/fail grades.
+	schemeLetter GradeScheme = "letter"
+	// schemeAuto infers per grade whether it is numeric or a letter and applies the matching rules.
+	schemeAuto GradeScheme = "auto"
+	// schemePassFail accepts only pass and fail.
+	schemePassFail GradeScheme = "passfail"
+)
+
+// maxCachedGradeValues bounds the distinct grade values a Validator remembers the kind of.
+const maxCachedGradeValues = 4096
+
+// Bounds of numeric grades.
+const (
+	minNumericGrade = 0
+	maxNumericGrade = 100
+)
+
+// Kinds of grade values.
+const (
+	gradeKindNumeric = "numeric"
+	gradeKindLetter  = "letter"
+	gradeKindInvalid = "invalid"
+)
+
+var (
+	ErrGradeSchemeInvalid = errors.New("invalid grade scheme")
+	ErrGradeValueInvalid  = errors.New("invalid grade value")
+	ErrSemesterInvalid    = errors.New("invalid semester")
+)
+
+// letterGrades lists the accepted letter and pass/fail grades, in upper case.
+var letterGrades = map[string]bool{
+	"A+": true, "A": true, "A-": true,
+	"B+": true, "B": true, "B-": true,
+	"C+": true, "C": true, "C-": true,
+	"D+": true, "D": true, "D-": true,
+	"F":    true,
+	"PASS": true, "FAIL": true,
+}
+
+// GradeScheme validates grade values against a grading scheme. The zero value accepts every value.
+type GradeScheme string
+
+// parseGradeScheme parses a scheme name, treating an empty name as schemeAny.
+func parseGradeScheme(name string) (GradeScheme, error) {
+	switch scheme := GradeScheme(name); scheme {
+	case "", schemeAny:
+		return schemeAny, nil
+	case schemeNumeric, schemeLetter, schemeAuto, schemePassFail:
+		return scheme, nil
+	default:
+		return "", fmt.Errorf("%w: %q", ErrGradeSchemeInvalid, name)
+	}
+}
+
+// isLetterGrade reports whether a value is a letter or pass/fail grade, ignoring case.
+func isLetterGrade(value string) bool {
+	return letterGrades[strings.ToUpper(strings.TrimSpace(value))]
+}
+
+// isPassFailGrade reports whether a value is a pass or fail grade, ignoring case.
+func isPassFailGrade(value string) bool {
+	switch strings.ToUpper(strings.TrimSpace(value)) {
+	case "PASS", "FAIL":
+		return true
+	default:
+		return false
+	}
+}
+
+// classifyGradeValue reports whether a value is a valid numeric grade, a letter grade or neither.
+func classifyGradeValue(value string) string {
+	if number, ok := parseNumericGrade(value); ok {
+		if number < minNumericGrade || number > maxNumericGrade {
+			return gradeKindInvalid
+		}
+
+		return gradeKindNumeric
+	}
+
+	if isLetterGrade(value) {
+		return gradeKindLetter
+	}
+
+	return gradeKindInvalid
+}
+
+// Validate checks a grade value against the scheme.
+func (s GradeScheme) Validate(value string) error {
+	return s.check(value, classifyGradeValue(value))
+}
+
+// check checks a grade value of the given kind against the scheme.
+func (s GradeScheme) check(value, kind string) error {
+	switch s {
+	case schemeNumeric:
+		if kind != gradeKindNumeric {
+			return fmt.Errorf("%w: %q is not a number between %d and %d",
+				ErrGradeValueInvalid, value, minNumericGrade, maxNumericGrade)
+		}
+	case schemeLetter:
+		if kind != gradeKindLetter {
+			return fmt.Errorf("%w: %q is not a letter or pass/fail grade", ErrGradeValueInvalid, value)
+		}
+	case schemePassFail:
+		if !isPassFailGrade(value) {
+			return fmt.Errorf("%w: %q is not pass or fail", ErrGradeValueInvalid, value)
+		}
+	case schemeAuto:
+		if kind == gradeKindInvalid {
+			return fmt.Errorf("%w: %q is neither a number between %d and %d nor a letter grade",
+				ErrGradeValueInvalid, value, minNumericGrade, maxNumericGrade)
+		}
+	}
+
+	return nil
+}
+
+// Validator checks grade values and semesters before they are written, using the grading scheme
+// and semester pattern parsed once at startup. It remembers the kind of the grade values it has
+// seen, so high-volume imports don't classify the same values again. A Validator is safe for
+// concurrent use; a nil Validator accepts every value.
+type Validator struct {
+	scheme GradeScheme
+	// semester is the anchored pattern semesters must match; every semester is accepted when nil.
+	semester *regexp.Regexp
+	// kinds maps grade values to their kind, holding at most maxCachedGradeValues entries.
+	kinds  sync.Map // value -> kind
+	cached atomic.Int64
+}
+
+// newValidator builds a validator for a grading scheme and a semester pattern, an unanchored
+// regular expression that must match the whole semester. An empty pattern accepts every semester.
+func newValidator(scheme GradeScheme, semesterPattern string) (*Validator, error) {
+	validator := &Validator{scheme: scheme}
+
+	if semesterPattern != "" {
+		pattern, err := regexp.Compile("^(?:" + semesterPattern + ")$")
+		if err != nil {
+			return nil, fmt.Errorf("%w: %q: %w", ErrSemesterInvalid, semesterPattern, err)
+		}
+
+		validator.semester = pattern
+	}
+
+	return validator, nil
+}
+
+// classify returns the kind of a grade value, remembering it while the cache has room.
+func (v *Validator) classify(value string) string {
+	if kind, ok := v.kinds.Load(value); ok {
+		return kind.(string)
+	}
+
+	kind := classifyGradeValue(value)
+
+	if v.cached.Add(1) <= maxCachedGradeValues {
+		if _, loaded := v.kinds.LoadOrStore(value, kind); loaded {
+			v.cached.Add(-1)
+		}
+	} else {
+		v.cached.Add(-1)
+	}
+
+	return kind
+}
+
+// ValidateGradeValue checks a grade value against the grading scheme.
+func (v *Validator) ValidateGradeValue(value string) error {
+	if v == nil || v.scheme == "" || v.scheme == schemeAny {
+		return nil
+	}
+
+	return v.scheme.check(value, v.classify(value))
+}
+
+// ValidateGradeValueAs checks a grade value against a scheme other than the configured one, such as
+// the scheme of its grade type. An empty scheme means the configured one.
+func (v *Validator) ValidateGradeValueAs(scheme GradeScheme, value string) error {
+	switch {
+	case scheme == "":
+		return v.ValidateGradeValue(value)
+	case scheme == schemeAny:
+		return nil
+	case v == nil:
+		return scheme.Validate(value)
+	default:
+		return scheme.check(value, v.classify(value))
+	}
+}
+
+// ValidateSemester checks a semester against the semester pattern.
+func (v *Validator) ValidateSemester(semester string) error {
+	if v == nil || v.semester == nil || v.semester.MatchString(semester) {
+		return nil
+	}
+
+	return fmt.Errorf("%w: %q does not match %s", ErrSemesterInvalid, semester, v.semester)
+}
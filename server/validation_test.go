@@ -0,0 +1,191 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+
+	gpb "github.com/BetterGR/grades-microservice/protos"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClassifyGradeValue(t *testing.T) {
+	for value, want := range map[string]string{
+		"95":     gradeKindNumeric,
+		"0":      gradeKindNumeric,
+		"100":    gradeKindNumeric,
+		"72.5":   gradeKindNumeric,
+		"A":      gradeKindLetter,
+		"b+":     gradeKindLetter,
+		"Pass":   gradeKindLetter,
+		" fail ": gradeKindLetter,
+		"101":    gradeKindInvalid,
+		"-3":     gradeKindInvalid,
+		"E":      gradeKindInvalid,
+		"great":  gradeKindInvalid,
+		"":       gradeKindInvalid,
+	} {
+		assert.Equal(t, want, classifyGradeValue(value), value)
+	}
+}
+
+func TestGradeSchemeValidate(t *testing.T) {
+	values := []string{"88", "A-", "pass", "120", "excellent"}
+
+	tests := []struct {
+		scheme GradeScheme
+		valid  []bool
+	}{
+		{schemeAny, []bool{true, true, true, true, true}},
+		{schemeNumeric, []bool{true, false, false, false, false}},
+		{schemeLetter, []bool{false, true, true, false, false}},
+		{schemeAuto, []bool{true, true, true, false, false}},
+		{schemePassFail, []bool{false, false, true, false, false}},
+	}
+
+	for _, tt := range tests {
+		for i, value := range values {
+			err := tt.scheme.Validate(value)
+			if tt.valid[i] {
+				assert.NoError(t, err, "%s(%q)", tt.scheme, value)
+			} else {
+				assert.ErrorIs(t, err, ErrGradeValueInvalid, "%s(%q)", tt.scheme, value)
+			}
+		}
+	}
+}
+
+func TestParseGradeScheme(t *testing.T) {
+	scheme, err := parseGradeScheme("")
+	require.NoError(t, err)
+	assert.Equal(t, schemeAny, scheme)
+
+	scheme, err = parseGradeScheme("auto")
+	require.NoError(t, err)
+	assert.Equal(t, schemeAuto, scheme)
+
+	_, err = parseGradeScheme("percent")
+	require.ErrorIs(t, err, ErrGradeSchemeInvalid)
+}
+
+func TestSegmentGrades(t *testing.T) {
+	segments := segmentGrades([]*Grade{
+		{GradeValue: "90"}, {GradeValue: "Pass"}, {GradeValue: "B"}, {GradeValue: "abc"}, {GradeValue: "77.5"},
+	})
+
+	assert.Len(t, segments.numeric, 2)
+	assert.Len(t, segments.letter, 2)
+	require.Len(t, segments.invalid, 1)
+	assert.Equal(t, "abc", segments.invalid[0].GradeValue)
+}
+
+// validationBenchValues are the grade values validated by the tests and benchmarks: a few
+// distinct values repeated, as in a high-volume import.
+var validationBenchValues = []string{"88", "A-", "pass", "120", "excellent", " 72.5 ", "b+", "", "F", "100"}
+
+func TestValidatorMatchesGradeScheme(t *testing.T) {
+	for _, scheme := range []GradeScheme{"", schemeAny, schemeNumeric, schemeLetter, schemeAuto, schemePassFail} {
+		validator, err := newValidator(scheme, "")
+		require.NoError(t, err)
+
+		var wg sync.WaitGroup
+
+		// Validate concurrently, so the race detector covers the cache, and twice so cached kinds
+		// are checked as well.
+		for range 8 {
+			wg.Add(1)
+
+			go func() {
+				defer wg.Done()
+
+				for range 2 {
+					for _, value := range validationBenchValues {
+						assert.Equal(t, scheme.Validate(value), validator.ValidateGradeValue(value),
+							"%s(%q)", scheme, value)
+					}
+				}
+			}()
+		}
+
+		wg.Wait()
+	}
+
+	var validator *Validator
+	require.NoError(t, validator.ValidateGradeValue("anything"))
+	require.ErrorIs(t, validator.ValidateGradeValueAs(schemeNumeric, "anything"), ErrGradeValueInvalid)
+	require.NoError(t, validator.ValidateSemester("anything"))
+}
+
+func TestValidatorValidateGradeValueAs(t *testing.T) {
+	validator, err := newValidator(schemeNumeric, "")
+	require.NoError(t, err)
+
+	require.NoError(t, validator.ValidateGradeValueAs(schemePassFail, "pass"))
+	require.ErrorIs(t, validator.ValidateGradeValueAs(schemePassFail, "85"), ErrGradeValueInvalid)
+	require.NoError(t, validator.ValidateGradeValueAs(schemeAny, "excellent"))
+	require.NoError(t, validator.ValidateGradeValueAs("", "85"), "an empty scheme is the configured one")
+	require.ErrorIs(t, validator.ValidateGradeValueAs("", "pass"), ErrGradeValueInvalid)
+}
+
+func TestValidatorCacheIsBounded(t *testing.T) {
+	validator, err := newValidator(schemeNumeric, "")
+	require.NoError(t, err)
+
+	for i := range maxCachedGradeValues + 100 {
+		require.NoError(t, validator.ValidateGradeValue(fmt.Sprintf("%d.%d", i%100, i)))
+	}
+
+	assert.Equal(t, int64(maxCachedGradeValues), validator.cached.Load())
+	require.ErrorIs(t, validator.ValidateGradeValue("101"), ErrGradeValueInvalid)
+}
+
+func TestValidatorSemesterPattern(t *testing.T) {
+	validator, err := newValidator(schemeAny, `(Winter|Spring|Summer)_\d{4}`)
+	require.NoError(t, err)
+
+	require.NoError(t, validator.ValidateSemester("Winter_2024"))
+	require.ErrorIs(t, validator.ValidateSemester("Winter_2024x"), ErrSemesterInvalid)
+	require.ErrorIs(t, validator.ValidateSemester("Fall_2024"), ErrSemesterInvalid)
+	require.ErrorIs(t, validator.ValidateSemester(""), ErrSemesterInvalid)
+
+	_, err = newValidator(schemeAny, "(")
+	require.ErrorIs(t, err, ErrSemesterInvalid)
+
+	validator, err = newValidator(schemeAny, "")
+	require.NoError(t, err)
+	require.NoError(t, validator.ValidateSemester(""))
+}
+
+func TestAddSingleGradeRejectsInvalidSemester(t *testing.T) {
+	validator, err := newValidator(schemeAny, `Winter_\d{4}`)
+	require.NoError(t, err)
+
+	client := setupClient(t, func(s *GradesServer) { s.validator = validator })
+
+	grade := createTestGrade()
+	grade.Semester = "winter 24"
+	_, err = client.AddSingleGrade(context.Background(), &gpb.AddSingleGradeRequest{Token: "test-token", Grade: grade})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func BenchmarkGradeSchemeValidate(b *testing.B) {
+	for i := range b.N {
+		_ = schemeAuto.Validate(validationBenchValues[i%len(validationBenchValues)])
+	}
+}
+
+func BenchmarkValidatorValidateGradeValue(b *testing.B) {
+	validator, err := newValidator(schemeAuto, "")
+	require.NoError(b, err)
+
+	b.ResetTimer()
+
+	for i := range b.N {
+		_ = validator.ValidateGradeValue(validationBenchValues[i%len(validationBenchValues)])
+	}
+}
@@ -0,0 +1,133 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	gpb "github.com/BetterGR/grades-microservice/protos"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestGradeViewRole(t *testing.T) {
+	assert.Equal(t, roleAdmin, gradeViewRole(RoleClaims{role: roleAdmin}))
+	assert.Equal(t, roleAdmin, gradeViewRole(RoleClaims{role: roleAuditor}))
+	assert.Equal(t, roleStaff, gradeViewRole(RoleClaims{role: roleStaff}))
+	assert.Equal(t, roleStudent, gradeViewRole(RoleClaims{role: roleStudent}))
+	assert.Equal(t, roleStudent, gradeViewRole(RoleClaims{}))
+}
+
+func TestGetGradeShapedForRole(t *testing.T) {
+	db := NewMockDatabase()
+
+	admin := setupClient(t, func(s *GradesServer) { s.db = db })
+	added, err := admin.AddSingleGrade(context.Background(),
+		&gpb.AddSingleGradeRequest{Token: "test-token", Grade: createTestGrade()})
+	require.NoError(t, err)
+
+	getGrade := func(role string) *gpb.SingleGrade {
+		t.Helper()
+
+		client := setupClient(t, func(s *GradesServer) {
+			s.db = db
+			s.Claims = RoleClaims{role: role}
+		})
+
+		resp, err := client.GetGrade(context.Background(),
+			&gpb.GetGradeRequest{Token: "test-token", GradeID: added.GetGrade().GetGradeID()})
+		require.NoError(t, err)
+
+		return resp.GetGrade()
+	}
+
+	student := getGrade(roleStudent)
+	assert.Equal(t, "A", student.GetGradeValue())
+	assert.Equal(t, "Excellent work!", student.GetComments())
+	assert.NotEmpty(t, student.GetGradedAt())
+	assert.Empty(t, student.GetGradedBy(), "students must not see the grader")
+	assert.Equal(t, int64(1), student.GetVersion())
+	assert.NotEmpty(t, student.GetUpdatedAt())
+
+	grader := getGrade(roleStaff)
+	assert.Equal(t, "A", grader.GetGradeValue())
+	assert.Equal(t, "Professor X", grader.GetGradedBy())
+	assert.NotEmpty(t, grader.GetGradedAt())
+	assert.Equal(t, int64(1), grader.GetVersion())
+	assert.NotEmpty(t, grader.GetUpdatedAt())
+
+	for _, role := range []string{roleAdmin, roleAuditor} {
+		full := getGrade(role)
+		assert.Equal(t, "Professor X", full.GetGradedBy(), role)
+		assert.Equal(t, int64(1), full.GetVersion(), role)
+		assert.NotEmpty(t, full.GetUpdatedAt(), role)
+	}
+}
+
+func TestShapeGradeForRoleFieldSets(t *testing.T) {
+	gradedAt := timestamppb.New(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	updatedAt := timestamppb.New(time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC))
+
+	grade := &gpb.SingleGrade{
+		GradeID: "g1", StudentID: "s1", CourseID: "c1", Semester: "Winter_2023", GradeType: "Exam",
+		ItemID: "i1", GradeValue: "90", GradedBy: "grader", Comments: "Good", Version: 3, Flagged: true,
+		InstitutionID: "uni-a", GradedAt: gradedAt, UpdatedAt: updatedAt,
+	}
+
+	student := shapeGradeForRole(grade, roleStudent)
+	assert.Equal(t, &gpb.SingleGrade{
+		GradeID: "g1", StudentID: "s1", CourseID: "c1", Semester: "Winter_2023", GradeType: "Exam",
+		ItemID: "i1", GradeValue: "90", Comments: "Good", Version: 3, GradedAt: gradedAt,
+		UpdatedAt: updatedAt,
+	}, student)
+
+	grader := shapeGradeForRole(grade, roleStaff)
+	assert.Equal(t, &gpb.SingleGrade{
+		GradeID: "g1", StudentID: "s1", CourseID: "c1", Semester: "Winter_2023", GradeType: "Exam",
+		ItemID: "i1", GradeValue: "90", GradedBy: "grader", Comments: "Good", Version: 3, Flagged: true,
+		InstitutionID: "uni-a", GradedAt: gradedAt, UpdatedAt: updatedAt,
+	}, grader)
+
+	assert.Same(t, grade, shapeGradeForRole(grade, roleAdmin))
+	assert.Equal(t, student, shapeGradeForRole(grade, "unknown"), "unknown roles get the student view")
+}
+
+func TestGetGradeIfVersionNotForRole(t *testing.T) {
+	for _, role := range []string{roleStudent, roleStaff, roleAdmin} {
+		t.Run(role, func(t *testing.T) {
+			db := NewMockDatabase()
+			admin := setupClient(t, func(s *GradesServer) { s.db = db })
+			client := setupClient(t, func(s *GradesServer) {
+				s.db = db
+				s.Claims = RoleClaims{role: role}
+			})
+
+			added, err := admin.AddSingleGrade(context.Background(),
+				&gpb.AddSingleGradeRequest{Token: "test-token", Grade: createTestGrade()})
+			require.NoError(t, err)
+
+			req := &gpb.GetGradeRequest{Token: "test-token", GradeID: added.GetGrade().GetGradeID()}
+			first, err := client.GetGrade(context.Background(), req)
+			require.NoError(t, err)
+			require.NotZero(t, first.GetGrade().GetVersion(), "polling needs the version")
+			require.NotEmpty(t, first.GetGrade().GetUpdatedAt())
+
+			req.IfVersionNot = first.GetGrade().GetVersion()
+			unchanged, err := client.GetGrade(context.Background(), req)
+			require.NoError(t, err)
+			assert.True(t, unchanged.GetNotModified())
+
+			_, err = admin.UpdateSingleGrade(context.Background(), &gpb.UpdateSingleGradeRequest{
+				Token: "test-token", Grade: &gpb.SingleGrade{GradeID: req.GetGradeID(), GradeValue: "B"},
+			})
+			require.NoError(t, err)
+
+			changed, err := client.GetGrade(context.Background(), req)
+			require.NoError(t, err)
+			assert.False(t, changed.GetNotModified())
+			assert.Equal(t, "B", changed.GetGrade().GetGradeValue())
+			assert.Greater(t, changed.GetGrade().GetVersion(), req.GetIfVersionNot())
+		})
+	}
+}
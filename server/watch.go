@@ -0,0 +1,247 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	gpb "github.com/BetterGR/grades-microservice/protos"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/klog/v2"
+)
+
+// Grade change types streamed to watchers besides the grade event types.
+const (
+	// changeSnapshot carries a grade of the course as the watch started.
+	changeSnapshot = "snapshot"
+	// changeSnapshotComplete follows the last snapshot grade.
+	changeSnapshotComplete = "snapshot.complete"
+)
+
+// defaultWatchBuffer is the number of grade events held for a watcher by default before it is
+// dropped as lagging.
+const defaultWatchBuffer = 256
+
+var (
+	ErrWatchDisabled  = errors.New("watching grades is disabled")
+	ErrWatcherLagging = errors.New("watcher fell behind the grade changes, watch again")
+)
+
+// gradeWatcher is the subscription of a watch to the events of a course within an institution.
+type gradeWatcher struct {
+	institutionID string
+	courseID      string
+	semester      string
+	// events holds the events not yet sent to the client.
+	events chan *GradeEvent
+	// dropped is closed once the watcher falls behind and stops receiving events.
+	dropped chan struct{}
+}
+
+// wants reports whether the watcher receives an event of its institution: the events of its
+// course, and removals naming no course, which the watch filters by the grades it has seen.
+func (w *gradeWatcher) wants(event *GradeEvent) bool {
+	if event.InstitutionID != w.institutionID {
+		return false
+	}
+
+	if event.Type == eventGradeRemoved && event.CourseID == "" {
+		return true
+	}
+
+	return event.CourseID == w.courseID && event.Semester == w.semester
+}
+
+// gradeWatchers fans grade events out to the watches of courses. An event never waits for a
+// watcher: a watcher whose buffer is full is dropped, so one slow client cannot hold up writes or
+// other watchers, and its watch ends asking the client to watch again. A nil gradeWatchers
+// watches nothing.
+type gradeWatchers struct {
+	buffer int
+
+	mu       sync.Mutex
+	watchers map[*gradeWatcher]struct{}
+}
+
+// newGradeWatchers creates watchers holding up to buffer events each.
+func newGradeWatchers(buffer int) *gradeWatchers {
+	return &gradeWatchers{buffer: max(buffer, 1), watchers: make(map[*gradeWatcher]struct{})}
+}
+
+// subscribe starts receiving the events of an institution's course during a semester. It returns
+// the watcher and the function ending its subscription.
+func (w *gradeWatchers) subscribe(institutionID, courseID, semester string) (*gradeWatcher, func()) {
+	watcher := &gradeWatcher{
+		institutionID: institutionID,
+		courseID:      courseID,
+		semester:      semester,
+		events:        make(chan *GradeEvent, w.buffer),
+		dropped:       make(chan struct{}),
+	}
+
+	w.mu.Lock()
+	w.watchers[watcher] = struct{}{}
+	w.mu.Unlock()
+
+	return watcher, func() {
+		w.mu.Lock()
+		delete(w.watchers, watcher)
+		w.mu.Unlock()
+	}
+}
+
+// broadcast hands an event to every watcher wanting it, dropping those with a full buffer.
+func (w *gradeWatchers) broadcast(event *GradeEvent) {
+	if w == nil {
+		return
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	for watcher := range w.watchers {
+		if !watcher.wants(event) {
+			continue
+		}
+
+		select {
+		case watcher.events <- event:
+		default:
+			delete(w.watchers, watcher)
+			close(watcher.dropped)
+		}
+	}
+}
+
+// WatchCourseGrades streams the grades of a course during a semester, then pushes every grade
+// added, updated or removed as it happens, until the client cancels or shutdown starts. The watch
+// subscribes before reading the grades, so no change is missed in between; a change made while
+// they are read may be sent again after them. A client too slow to keep up is dropped with
+// ResourceExhausted and should watch again for a fresh snapshot. Requires the staff or admin role.
+func (s *GradesServer) WatchCourseGrades(req *gpb.WatchCourseGradesRequest,
+	stream gpb.GradesService_WatchCourseGradesServer,
+) error {
+	ctx := stream.Context()
+
+	claims, err := s.authorizeRoles(ctx, req.GetToken(), roleStaff, roleAdmin)
+	if err != nil {
+		return err
+	}
+
+	logger := klog.FromContext(ctx)
+	logger.V(logLevelDebug).Info("Received request to watch course grades", "course_id", req.GetCourseID(),
+		"semester", req.GetSemester())
+
+	if s.watchers == nil {
+		return fmt.Errorf("failed to watch course grades: %w",
+			status.Error(codes.Unimplemented, ErrWatchDisabled.Error()))
+	}
+
+	if req.GetCourseID() == "" {
+		return fmt.Errorf("failed to watch course grades: %w",
+			status.Error(codes.InvalidArgument, ErrCourseIDEmpty.Error()))
+	}
+
+	if err := s.authorizeGrader(ctx, claims, req.GetCourseID()); err != nil {
+		return err
+	}
+
+	ctx, done, err := s.streams.track(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to watch course grades: %w", err)
+	}
+	defer done()
+
+	view, err := s.gradeView(ctx, claims)
+	if err != nil {
+		return err
+	}
+
+	view.locale = req.GetLocale()
+
+	watcher, unsubscribe := s.watchers.subscribe(institutionFromContext(ctx), req.GetCourseID(), req.GetSemester())
+	defer unsubscribe()
+
+	// known holds the grades the client has, so removals naming no course are sent only for those.
+	known := make(map[string]struct{})
+
+	send := func(changeType string, grade *gpb.SingleGrade) error {
+		if err := stream.Send(&gpb.GradeChange{Type: changeType, Grade: grade}); err != nil {
+			if ctx.Err() != nil {
+				return s.streams.stopped(ctx)
+			}
+
+			return fmt.Errorf("failed to send grade change: %w", err)
+		}
+
+		return nil
+	}
+
+	err = s.db.StreamCourseGrades(ctx, req.GetCourseID(), req.GetSemester(), func(grade *Grade) error {
+		if len(view.embargo.released([]*Grade{grade})) == 0 {
+			return nil
+		}
+
+		known[grade.GradeID] = struct{}{}
+
+		return send(changeSnapshot, s.gradeResponse(grade, view))
+	})
+
+	switch {
+	case err != nil && ctx.Err() != nil:
+		return fmt.Errorf("failed to watch course grades: %w", s.streams.stopped(ctx))
+	case err != nil:
+		return fmt.Errorf("failed to watch course grades: %w", err)
+	}
+
+	if err := send(changeSnapshotComplete, nil); err != nil {
+		return fmt.Errorf("failed to watch course grades: %w", err)
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("failed to watch course grades: %w", s.streams.stopped(ctx))
+		case <-s.streams.draining():
+			return fmt.Errorf("failed to watch course grades: %w", s.streams.stopped(ctx))
+		case <-watcher.dropped:
+			return fmt.Errorf("failed to watch course grades: %w",
+				status.Error(codes.ResourceExhausted, ErrWatcherLagging.Error()))
+		case event := <-watcher.events:
+			grade, ok := s.watchedGrade(event, view, known)
+			if !ok {
+				continue
+			}
+
+			if err := send(event.Type, grade); err != nil {
+				return fmt.Errorf("failed to watch course grades: %w", err)
+			}
+		}
+	}
+}
+
+// watchedGrade returns the grade of an event as the watching client sees it, or false when the
+// client is not to see the event: changes to unreleased grades and removals of grades it does not
+// have.
+func (s *GradesServer) watchedGrade(event *GradeEvent, view gradeView,
+	known map[string]struct{},
+) (*gpb.SingleGrade, bool) {
+	if event.Type == eventGradeRemoved {
+		if _, ok := known[event.GradeID]; !ok {
+			return nil, false
+		}
+
+		delete(known, event.GradeID)
+
+		return &gpb.SingleGrade{GradeID: event.GradeID}, true
+	}
+
+	if event.grade == nil || len(view.embargo.released([]*Grade{event.grade})) == 0 {
+		return nil, false
+	}
+
+	known[event.GradeID] = struct{}{}
+
+	return s.gradeResponse(event.grade, view), true
+}
@@ -0,0 +1,148 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	gpb "github.com/BetterGR/grades-microservice/protos"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestWatchCourseGradesPushesWrites(t *testing.T) {
+	client := setupClient(t, func(s *GradesServer) {
+		s.watchers = newGradeWatchers(defaultWatchBuffer)
+	})
+
+	existing := createTestGrade()
+	_, err := client.AddSingleGrade(context.Background(), &gpb.AddSingleGradeRequest{Token: "test-token", Grade: existing})
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	watch, err := client.WatchCourseGrades(ctx, &gpb.WatchCourseGradesRequest{
+		Token: "test-token", CourseID: existing.GetCourseID(), Semester: existing.GetSemester(),
+	})
+	require.NoError(t, err)
+
+	change, err := watch.Recv()
+	require.NoError(t, err)
+	assert.Equal(t, changeSnapshot, change.GetType())
+	assert.Equal(t, existing.GetGradeID(), change.GetGrade().GetGradeID())
+
+	change, err = watch.Recv()
+	require.NoError(t, err)
+	assert.Equal(t, changeSnapshotComplete, change.GetType())
+
+	// Writes to other courses are not pushed.
+	_, err = client.AddSingleGrade(context.Background(), &gpb.AddSingleGradeRequest{
+		Token: "test-token", Grade: createTestGrade(),
+	})
+	require.NoError(t, err)
+
+	added := createTestGrade()
+	added.CourseID, added.GradeValue = existing.GetCourseID(), "B"
+	_, err = client.AddSingleGrade(context.Background(), &gpb.AddSingleGradeRequest{Token: "test-token", Grade: added})
+	require.NoError(t, err)
+
+	change, err = watch.Recv()
+	require.NoError(t, err)
+	assert.Equal(t, eventGradeAdded, change.GetType())
+	assert.Equal(t, added.GetGradeID(), change.GetGrade().GetGradeID())
+	assert.Equal(t, "B", change.GetGrade().GetGradeValue())
+
+	_, err = client.RemoveSingleGrade(context.Background(), &gpb.RemoveSingleGradeRequest{
+		Token: "test-token", GradeID: existing.GetGradeID(),
+	})
+	require.NoError(t, err)
+
+	change, err = watch.Recv()
+	require.NoError(t, err)
+	assert.Equal(t, eventGradeRemoved, change.GetType())
+	assert.Equal(t, existing.GetGradeID(), change.GetGrade().GetGradeID())
+
+	cancel()
+
+	_, err = watch.Recv()
+	assert.Equal(t, codes.Canceled, status.Code(err))
+}
+
+func TestGradeWatchersDropLaggingWatcher(t *testing.T) {
+	watchers := newGradeWatchers(1)
+
+	watcher, unsubscribe := watchers.subscribe("", "c1", "Winter_2023")
+	defer unsubscribe()
+
+	other, unsubscribeOther := watchers.subscribe("", "c2", "Winter_2023")
+	defer unsubscribeOther()
+
+	event := newGradeEvent(eventGradeUpdated, &Grade{GradeID: "g1", CourseID: "c1", Semester: "Winter_2023"})
+	watchers.broadcast(event)
+	assert.Len(t, watcher.events, 1)
+	assert.Empty(t, other.events, "events go to the watchers of their course")
+
+	// The buffer is full, so the next event drops the watcher instead of waiting for it.
+	watchers.broadcast(event)
+
+	select {
+	case <-watcher.dropped:
+	default:
+		t.Fatal("the lagging watcher was not dropped")
+	}
+
+	// Removals naming no course go to every watcher, which filter them by the grades they have.
+	watchers.broadcast(newGradeEvent(eventGradeRemoved, &Grade{GradeID: "g1"}))
+	assert.Len(t, other.events, 1)
+}
+
+func TestWatchCourseGradesRequiresStaff(t *testing.T) {
+	client := setupClient(t, func(s *GradesServer) {
+		s.watchers = newGradeWatchers(defaultWatchBuffer)
+		s.Claims = RoleClaims{role: roleStudent}
+	})
+
+	watch, err := client.WatchCourseGrades(context.Background(), &gpb.WatchCourseGradesRequest{
+		Token: "test-token", CourseID: "c1", Semester: "Winter_2023",
+	})
+	require.NoError(t, err)
+
+	_, err = watch.Recv()
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestGradeWatchersFilterInstitution(t *testing.T) {
+	watchers := newGradeWatchers(defaultWatchBuffer)
+
+	watcherA, unsubscribeA := watchers.subscribe("uni-a", "c1", "Winter_2023")
+	defer unsubscribeA()
+
+	watcherB, unsubscribeB := watchers.subscribe("uni-b", "c1", "Winter_2023")
+	defer unsubscribeB()
+
+	watchers.broadcast(newGradeEvent(eventGradeAdded,
+		&Grade{GradeID: "g1", CourseID: "c1", Semester: "Winter_2023", InstitutionID: "uni-a"}))
+	assert.Len(t, watcherA.events, 1)
+	assert.Empty(t, watcherB.events, "another institution's course of the same ID is not watched")
+
+	watchers.broadcast(newGradeEvent(eventGradeRemoved, &Grade{GradeID: "g1", InstitutionID: "uni-a"}))
+	assert.Len(t, watcherA.events, 2)
+	assert.Empty(t, watcherB.events, "removals stay within their institution")
+}
+
+func TestPublishEventScopesRemovalsToInstitution(t *testing.T) {
+	s := &GradesServer{watchers: newGradeWatchers(defaultWatchBuffer)}
+
+	watcher, unsubscribe := s.watchers.subscribe("uni-a", "c1", "Winter_2023")
+	defer unsubscribe()
+
+	s.publishEvent(withInstitution(context.Background(), "uni-b"),
+		newGradeEvent(eventGradeRemoved, &Grade{GradeID: "g1"}))
+	assert.Empty(t, watcher.events)
+
+	s.publishEvent(withInstitution(context.Background(), "uni-a"),
+		newGradeEvent(eventGradeRemoved, &Grade{GradeID: "g1"}))
+	assert.Len(t, watcher.events, 1)
+}
@@ -0,0 +1,240 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+var (
+	ErrGradeWeightsInvalid = errors.New("invalid grade weights")
+	ErrGradeWeightsMissing = errors.New("no grade weights configured")
+)
+
+// GradeWeights maps a course component, matched case-insensitively against the grade type, to its
+// share of the final grade. Weights are relative and need not add up to 100.
+type GradeWeights map[string]float64
+
+// parseGradeWeights parses a "component:weight[,component:weight]" spec such as
+// "homework:30,midterm:20,final:50". An empty spec yields no weights.
+func parseGradeWeights(spec string) (GradeWeights, error) {
+	weights := GradeWeights{}
+
+	for _, entry := range strings.Split(spec, ",") {
+		if strings.TrimSpace(entry) == "" {
+			continue
+		}
+
+		component, rawWeight, ok := strings.Cut(entry, ":")
+		if !ok {
+			return nil, fmt.Errorf("%w: entry %q is not component:weight", ErrGradeWeightsInvalid, entry)
+		}
+
+		weight, err := strconv.ParseFloat(strings.TrimSpace(rawWeight), 64)
+		if err != nil {
+			return nil, fmt.Errorf("%w: weight of %q: %w", ErrGradeWeightsInvalid, component, err)
+		}
+
+		weights[component] = weight
+	}
+
+	if len(weights) == 0 {
+		return weights, nil
+	}
+
+	return weights.normalize()
+}
+
+// normalize validates the weights and returns them keyed by lower-case component name.
+func (w GradeWeights) normalize() (GradeWeights, error) {
+	normalized := make(GradeWeights, len(w))
+
+	for component, weight := range w {
+		key := strings.ToLower(strings.TrimSpace(component))
+		if key == "" {
+			return nil, fmt.Errorf("%w: empty component name", ErrGradeWeightsInvalid)
+		}
+
+		if weight <= 0 || math.IsNaN(weight) || math.IsInf(weight, 0) {
+			return nil, fmt.Errorf("%w: weight of %q must be positive", ErrGradeWeightsInvalid, component)
+		}
+
+		if _, duplicate := normalized[key]; duplicate {
+			return nil, fmt.Errorf("%w: component %q listed twice", ErrGradeWeightsInvalid, component)
+		}
+
+		normalized[key] = weight
+	}
+
+	return normalized, nil
+}
+
+// gradeProjection is the outcome of projecting the score needed on the ungraded components.
+type gradeProjection struct {
+	// current is the weighted average of the graded components, 0 when nothing is graded yet.
+	current float64
+	// required is the score needed on every remaining component to reach the target.
+	// It is 0 when the target is already secured or nothing remains.
+	required float64
+	// reachable reports whether the target can still be reached with scores up to maxNumericGrade.
+	reachable bool
+	// remaining lists the weighted components without a numeric grade, sorted.
+	remaining []string
+}
+
+// projectRequiredGrade computes the score needed on the ungraded components of a course for the
+// weighted final grade to reach target. A component's score is the mean of its numeric grades;
+// letter grades and grade types without a weight are ignored. The weights must be normalized.
+func projectRequiredGrade(grades []*Grade, weights GradeWeights, target float64) gradeProjection {
+	sums := make(map[string]float64, len(weights))
+	counts := make(map[string]int, len(weights))
+
+	for _, grade := range segmentGrades(grades).numeric {
+		component := strings.ToLower(strings.TrimSpace(grade.GradeType))
+		if _, weighted := weights[component]; !weighted {
+			continue
+		}
+
+		value, _ := parseNumericGrade(grade.GradeValue)
+		sums[component] += value
+		counts[component]++
+	}
+
+	var (
+		projection                                         gradeProjection
+		totalWeight, gradedWeight, earned, remainingWeight float64
+	)
+
+	for component, weight := range weights {
+		totalWeight += weight
+
+		if counts[component] == 0 {
+			remainingWeight += weight
+			projection.remaining = append(projection.remaining, component)
+
+			continue
+		}
+
+		gradedWeight += weight
+		earned += weight * sums[component] / float64(counts[component])
+	}
+
+	sort.Strings(projection.remaining)
+
+	if gradedWeight > 0 {
+		projection.current = earned / gradedWeight
+	}
+
+	if remainingWeight == 0 {
+		projection.reachable = earned/totalWeight >= target
+
+		return projection
+	}
+
+	required := (target*totalWeight - earned) / remainingWeight
+	projection.reachable = required <= maxNumericGrade
+	projection.required = max(required, minNumericGrade)
+
+	return projection
+}
+
+// componentAverage is the class average of a single course component.
+type componentAverage struct {
+	component string
+	average   float64
+	weight    float64
+	count     int
+}
+
+// classAverage summarizes the numeric grades of a course, weighted by component and plain.
+type classAverage struct {
+	// weighted is the average of the component averages, each counting with its weight.
+	weighted float64
+	// unweighted is the plain mean of every numeric grade.
+	unweighted float64
+	// components lists the components that counted, sorted.
+	components []componentAverage
+	// excluded lists the components with numeric grades but no weight, sorted.
+	excluded []string
+	// count is the number of numeric grades.
+	count int
+}
+
+// computeClassAverage averages the numeric grades of a class by component, the lower-case grade
+// type, and weighs each component's average by its weight. Components without a weight are left
+// out, or given the mean of the weights when equalUnweighted is set, which makes every component
+// count equally when no weights are configured. The weights must be normalized.
+func computeClassAverage(grades []*Grade, weights GradeWeights, equalUnweighted bool) classAverage {
+	sums := make(map[string]float64)
+	counts := make(map[string]int)
+
+	var (
+		average classAverage
+		total   float64
+	)
+
+	for _, grade := range segmentGrades(grades).numeric {
+		component := strings.ToLower(strings.TrimSpace(grade.GradeType))
+		value, _ := parseNumericGrade(grade.GradeValue)
+
+		sums[component] += value
+		counts[component]++
+		total += value
+		average.count++
+	}
+
+	if average.count == 0 {
+		return average
+	}
+
+	average.unweighted = total / float64(average.count)
+
+	fallback := 1.0
+	if len(weights) > 0 {
+		fallback = 0
+		for _, weight := range weights {
+			fallback += weight
+		}
+
+		fallback /= float64(len(weights))
+	}
+
+	components := make([]string, 0, len(counts))
+	for component := range counts {
+		components = append(components, component)
+	}
+
+	sort.Strings(components)
+
+	var totalWeight, weightedSum float64
+
+	for _, component := range components {
+		weight, weighted := weights[component]
+		if !weighted {
+			if !equalUnweighted {
+				average.excluded = append(average.excluded, component)
+
+				continue
+			}
+
+			weight = fallback
+		}
+
+		componentMean := sums[component] / float64(counts[component])
+		average.components = append(average.components, componentAverage{
+			component: component, average: componentMean, weight: weight, count: counts[component],
+		})
+
+		totalWeight += weight
+		weightedSum += weight * componentMean
+	}
+
+	if totalWeight > 0 {
+		average.weighted = weightedSum / totalWeight
+	}
+
+	return average
+}
@@ -0,0 +1,189 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	gpb "github.com/BetterGR/grades-microservice/protos"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestParseGradeWeights(t *testing.T) {
+	weights, err := parseGradeWeights("Homework:30, midterm:20,final:50")
+	require.NoError(t, err)
+	assert.Equal(t, GradeWeights{"homework": 30, "midterm": 20, "final": 50}, weights)
+
+	weights, err = parseGradeWeights("")
+	require.NoError(t, err)
+	assert.Empty(t, weights)
+
+	for _, spec := range []string{"homework", "homework:abc", "homework:0", "homework:-5", ":10", "hw:10,HW:20"} {
+		_, err := parseGradeWeights(spec)
+		require.ErrorIs(t, err, ErrGradeWeightsInvalid, spec)
+	}
+}
+
+func TestProjectRequiredGrade(t *testing.T) {
+	weights := GradeWeights{"homework": 30, "midterm": 20, "final": 50}
+
+	tests := []struct {
+		name      string
+		grades    []*Grade
+		target    float64
+		current   float64
+		required  float64
+		reachable bool
+		remaining []string
+	}{
+		{
+			name: "reachable",
+			grades: []*Grade{
+				{GradeType: "Homework", GradeValue: "90"},
+				{GradeType: "homework", GradeValue: "100"},
+				{GradeType: "Midterm", GradeValue: "80"},
+			},
+			// (90*100 - (30*95 + 20*80)) / 50 = 91.
+			target: 90, current: 89, required: 91, reachable: true,
+			remaining: []string{"final"},
+		},
+		{
+			name:   "unreachable",
+			grades: []*Grade{{GradeType: "homework", GradeValue: "40"}, {GradeType: "midterm", GradeValue: "50"}},
+			// (95*100 - (30*40 + 20*50)) / 50 = 146.
+			target: 95, current: 44, required: 146, reachable: false,
+			remaining: []string{"final"},
+		},
+		{
+			name:   "already secured",
+			grades: []*Grade{{GradeType: "homework", GradeValue: "100"}, {GradeType: "midterm", GradeValue: "100"}},
+			target: 40, current: 100, required: 0, reachable: true,
+			remaining: []string{"final"},
+		},
+		{
+			// Letters and unweighted grade types are ignored.
+			name:   "nothing graded",
+			grades: []*Grade{{GradeType: "homework", GradeValue: "A"}, {GradeType: "quiz", GradeValue: "100"}},
+			target: 70, current: 0, required: 70, reachable: true,
+			remaining: []string{"final", "homework", "midterm"},
+		},
+		{
+			name: "no remaining components, reached",
+			grades: []*Grade{
+				{GradeType: "homework", GradeValue: "80"},
+				{GradeType: "midterm", GradeValue: "80"},
+				{GradeType: "final", GradeValue: "80"},
+			},
+			target: 80, current: 80, required: 0, reachable: true,
+		},
+		{
+			name: "no remaining components, missed",
+			grades: []*Grade{
+				{GradeType: "homework", GradeValue: "80"},
+				{GradeType: "midterm", GradeValue: "80"},
+				{GradeType: "final", GradeValue: "80"},
+			},
+			target: 85, current: 80, required: 0, reachable: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			projection := projectRequiredGrade(tt.grades, weights, tt.target)
+
+			assert.InDelta(t, tt.current, projection.current, 1e-9)
+			assert.InDelta(t, tt.required, projection.required, 1e-9)
+			assert.Equal(t, tt.reachable, projection.reachable)
+			assert.Equal(t, tt.remaining, projection.remaining)
+		})
+	}
+}
+
+func TestComputeClassAverage(t *testing.T) {
+	grades := []*Grade{
+		{GradeType: "Homework", GradeValue: "100"},
+		{GradeType: "homework", GradeValue: "90"},
+		{GradeType: "homework", GradeValue: "80"},
+		{GradeType: "final", GradeValue: "60"},
+		{GradeType: "quiz", GradeValue: "70"},
+		{GradeType: "final", GradeValue: "Pass"},
+	}
+	weights := GradeWeights{"homework": 25, "final": 75}
+
+	average := computeClassAverage(grades, weights, false)
+	assert.InDelta(t, 80, average.unweighted, 1e-9)
+	assert.InDelta(t, 0.25*90+0.75*60, average.weighted, 1e-9, "the final outweighs the homework")
+	assert.Equal(t, []string{"quiz"}, average.excluded)
+	assert.Equal(t, 5, average.count)
+	require.Len(t, average.components, 2)
+	assert.Equal(t, componentAverage{component: "final", average: 60, weight: 75, count: 1}, average.components[0])
+	assert.Equal(t, componentAverage{component: "homework", average: 90, weight: 25, count: 3},
+		average.components[1])
+
+	average = computeClassAverage(grades, weights, true)
+	assert.InDelta(t, (25*90+75*60+50*70)/150.0, average.weighted, 1e-9,
+		"the quiz counts with the mean weight")
+	assert.Empty(t, average.excluded)
+
+	average = computeClassAverage(grades, nil, true)
+	assert.InDelta(t, (90+60+70)/3.0, average.weighted, 1e-9, "every component counts equally")
+	assert.InDelta(t, 80, average.unweighted, 1e-9)
+
+	average = computeClassAverage(grades, nil, false)
+	assert.Zero(t, average.weighted)
+	assert.Equal(t, []string{"final", "homework", "quiz"}, average.excluded)
+
+	assert.Zero(t, computeClassAverage(nil, weights, true).count)
+}
+
+func TestProjectRequiredGradeRPC(t *testing.T) {
+	client := setupClient(t, func(s *GradesServer) {
+		s.weights = GradeWeights{"exam": 40, "final": 60}
+	})
+
+	grade := createTestGrade()
+	grade.GradeValue = "70"
+	_, err := client.AddSingleGrade(context.Background(), &gpb.AddSingleGradeRequest{Token: "test-token", Grade: grade})
+	require.NoError(t, err)
+
+	req := &gpb.ProjectRequiredGradeRequest{
+		Token:       "test-token",
+		CourseID:    grade.GetCourseID(),
+		Semester:    grade.GetSemester(),
+		StudentID:   grade.GetStudentID(),
+		TargetGrade: 82,
+	}
+
+	resp, err := client.ProjectRequiredGrade(context.Background(), req)
+	require.NoError(t, err)
+	assert.True(t, resp.GetReachable())
+	assert.InDelta(t, 90, resp.GetRequiredGrade(), 1e-9)
+	assert.InDelta(t, 70, resp.GetCurrentGrade(), 1e-9)
+	assert.Equal(t, []string{"final"}, resp.GetRemainingComponents())
+
+	// Weights in the request replace the configured ones.
+	req.Weights = map[string]float64{"Exam": 90, "Final": 10}
+	resp, err = client.ProjectRequiredGrade(context.Background(), req)
+	require.NoError(t, err)
+	assert.False(t, resp.GetReachable())
+
+	req.Weights = map[string]float64{"exam": -1}
+	_, err = client.ProjectRequiredGrade(context.Background(), req)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	req.Weights = nil
+	req.TargetGrade = 120
+	_, err = client.ProjectRequiredGrade(context.Background(), req)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestProjectRequiredGradeWithoutWeights(t *testing.T) {
+	client := setupClient(t)
+
+	_, err := client.ProjectRequiredGrade(context.Background(), &gpb.ProjectRequiredGradeRequest{
+		Token: "test-token", CourseID: "c1", StudentID: "s1", TargetGrade: 80,
+	})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+}